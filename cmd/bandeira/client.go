@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// client talks to the admin API of a running server.
+type client struct {
+	baseURL string
+	token   string
+	http    *http.Client
+}
+
+// apiError is a non-2xx admin API response.
+type apiError struct {
+	Status  int
+	Message string
+	Fields  map[string]string
+}
+
+func (e *apiError) Error() string {
+	if len(e.Fields) == 0 {
+		return fmt.Sprintf("%s (HTTP %d)", e.Message, e.Status)
+	}
+	return fmt.Sprintf("%s (HTTP %d): %v", e.Message, e.Status, e.Fields)
+}
+
+func newClient(baseURL, token string) *client {
+	return &client{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		http:    &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// do sends a request to /api/admin<path>. A non-nil body is sent as-is with
+// the given content type. The raw response body is returned on success.
+func (c *client) do(method, path string, body []byte, contentType string) ([]byte, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(method, c.baseURL+"/api/admin"+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= 300 {
+		apiErr := &apiError{Status: resp.StatusCode, Message: http.StatusText(resp.StatusCode)}
+		var payload struct {
+			Error  string            `json:"error"`
+			Fields map[string]string `json:"fields"`
+		}
+		if json.Unmarshal(data, &payload) == nil && payload.Error != "" {
+			apiErr.Message = payload.Error
+			apiErr.Fields = payload.Fields
+		}
+		return nil, apiErr
+	}
+
+	return data, nil
+}
+
+// json sends v encoded as JSON (if non-nil) and decodes the response into out.
+func (c *client) json(method, path string, v, out any) error {
+	var body []byte
+	if v != nil {
+		var err error
+		if body, err = json.Marshal(v); err != nil {
+			return err
+		}
+	}
+
+	data, err := c.do(method, path, body, "application/json")
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// projectID returns the project the admin token is scoped to.
+func (c *client) projectID() (int, error) {
+	var resp struct {
+		Items []struct {
+			ID int `json:"id"`
+		} `json:"items"`
+	}
+	if err := c.json(http.MethodGet, "/projects", nil, &resp); err != nil {
+		return 0, err
+	}
+	if len(resp.Items) == 0 {
+		return 0, fmt.Errorf("token has no project")
+	}
+	return resp.Items[0].ID, nil
+}
+
+// lookup returns the ID of the item called name in the list endpoint at path,
+// or 0 if there is none. The list is narrowed with q, which matches
+// substrings, so every page is read until the exact name turns up.
+func (c *client) lookup(path, name string) (int, error) {
+	params := url.Values{"q": {name}, "limit": {"200"}}
+	for {
+		var page struct {
+			Items []struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"items"`
+			NextCursor *string `json:"next_cursor"`
+		}
+		if err := c.json(http.MethodGet, path+"?"+params.Encode(), nil, &page); err != nil {
+			return 0, err
+		}
+		for _, item := range page.Items {
+			if item.Name == name {
+				return item.ID, nil
+			}
+		}
+		if page.NextCursor == nil {
+			return 0, nil
+		}
+		params.Set("cursor", *page.NextCursor)
+	}
+}
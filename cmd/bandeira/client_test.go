@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/pkg/handlers"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	"github.com/felipekafuri/bandeira/pkg/token"
+)
+
+var (
+	srv *httptest.Server
+	c   *services.Container
+)
+
+func TestMain(m *testing.M) {
+	config.SwitchEnvironment(config.EnvTest)
+
+	c = services.NewContainer()
+	if err := handlers.BuildRouter(c); err != nil {
+		panic(err)
+	}
+	srv = httptest.NewServer(c.Web)
+
+	exitVal := m.Run()
+
+	srv.Close()
+	if err := c.Shutdown(); err != nil {
+		panic(err)
+	}
+	os.Exit(exitVal)
+}
+
+// remoteApp returns an app in remote mode with an admin token for a new
+// project, and a buffer holding its output.
+func remoteApp(t *testing.T) (*app, *bytes.Buffer, int) {
+	t.Helper()
+	ctx := context.Background()
+	p := c.ORM.Project.Create().SetName("cli-" + t.Name()).SaveX(ctx)
+	raw, hashed, err := token.Generate()
+	require.NoError(t, err)
+	c.ORM.ApiToken.Create().
+		SetName("cli").
+		SetSecret(hashed).
+		SetPlainToken(raw).
+		SetTokenType(apitoken.TokenTypeAdmin).
+		SetProjectID(p.ID).
+		SaveX(ctx)
+
+	out := &bytes.Buffer{}
+	return &app{remote: newClient(srv.URL, raw), stdout: out}, out, p.ID
+}
+
+func TestRemote_FlagToggle(t *testing.T) {
+	a, out, projectID := remoteApp(t)
+	ctx := context.Background()
+	env := c.ORM.Environment.Create().SetName("production").SetType("production").SetProjectID(projectID).SaveX(ctx)
+	c.ORM.Environment.Create().SetName("production-eu").SetType("production").SetProjectID(projectID).SaveX(ctx)
+	// "checkout" is a substring of the other flag's name, so the search
+	// returns both and the exact name has to be picked.
+	c.ORM.Flag.Create().SetName("checkout-v2").SetFlagType("release").SetProjectID(projectID).SaveX(ctx)
+	f := c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(projectID).SaveX(ctx)
+
+	require.NoError(t, a.run([]string{"flag", "toggle", "-flag", "checkout", "-env", "production"}))
+
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	assert.Equal(t, true, result["enabled"])
+
+	fe := c.ORM.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(f.ID), flagenvironment.EnvironmentID(env.ID)).
+		OnlyX(ctx)
+	assert.True(t, fe.Enabled)
+
+	err := a.run([]string{"flag", "toggle", "-flag", "missing", "-env", "production"})
+	assert.EqualError(t, err, `flag "missing" not found`)
+	err = a.run([]string{"flag", "toggle", "-flag", "checkout", "-env", "staging"})
+	assert.EqualError(t, err, `environment "staging" not found`)
+}
+
+func TestRemote_FlagList(t *testing.T) {
+	a, out, projectID := remoteApp(t)
+	ctx := context.Background()
+	c.ORM.Environment.Create().SetName("production").SetType("production").SetProjectID(projectID).SaveX(ctx)
+	c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(projectID).SaveX(ctx)
+
+	require.NoError(t, a.run([]string{"flag", "list"}))
+
+	var result struct {
+		Flags []struct {
+			Name         string          `json:"name"`
+			Environments map[string]bool `json:"environments"`
+		} `json:"flags"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &result))
+	require.Len(t, result.Flags, 1)
+	assert.Equal(t, "checkout", result.Flags[0].Name)
+	assert.Equal(t, map[string]bool{"production": false}, result.Flags[0].Environments)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+func (a *app) dbBackup(args []string) error {
+	fs := flag.NewFlagSet("db backup", flag.ContinueOnError)
+	out := fs.String("o", "", "write a single backup to this path instead of the backup directory (no upload or retention)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("db backup"); err != nil {
+		return err
+	}
+
+	if *out == "" {
+		// Same pipeline as scheduled backups: directory, S3 upload, retention.
+		info, err := a.container().Backup.Run(context.Background())
+		if err != nil {
+			return err
+		}
+		return a.output(info)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0755); err != nil {
+		return err
+	}
+	if err := services.BackupDatabase(context.Background(), a.container().Database, *out); err != nil {
+		return err
+	}
+	info, err := os.Stat(*out)
+	if err != nil {
+		return err
+	}
+	return a.output(map[string]any{"path": *out, "size": info.Size()})
+}
+
+func (a *app) dbRestore(args []string) error {
+	fs := flag.NewFlagSet("db restore", flag.ContinueOnError)
+	file := fs.String("f", "", "backup file to restore (required)")
+	check := fs.Bool("check", false, "only validate the backup, do not restore it")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("db restore"); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-f is required")
+	}
+
+	version, err := services.ValidateBackup(*file)
+	if err != nil {
+		return err
+	}
+	if *check {
+		return a.output(map[string]any{"valid": true, "schema_version": version})
+	}
+
+	// The container is not started here: it would open (and migrate) the
+	// database that is about to be replaced.
+	cfg, err := config.GetConfig()
+	if err != nil {
+		return err
+	}
+	previous, err := services.RestoreBackup(cfg.Database.Connection, *file)
+	if err != nil {
+		return err
+	}
+
+	return a.output(map[string]any{
+		"restored":       *file,
+		"database":       services.DatabasePath(cfg.Database.Connection),
+		"previous":       previous,
+		"schema_version": version,
+	})
+}
+
+func (a *app) dbMigrate(args []string) error {
+	fs := flag.NewFlagSet("db migrate", flag.ContinueOnError)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("db migrate"); err != nil {
+		return err
+	}
+
+	// The container applies the schema migration on startup.
+	a.container()
+	return a.output(map[string]any{"ok": true, "schema_version": services.SchemaVersion})
+}
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+func (a *app) flagList(args []string) error {
+	fs := flag.NewFlagSet("flag list", flag.ContinueOnError)
+	projectRef := fs.String("project", "", "project ID or name (local mode)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+
+	doc, err := a.exportDocument(*projectRef)
+	if err != nil {
+		return err
+	}
+
+	items := make([]map[string]any, 0, len(doc.Flags))
+	for _, f := range doc.Flags {
+		// Every environment is listed, including those the flag has never
+		// been configured in (disabled).
+		enabled := make(map[string]bool, len(doc.Environments))
+		for _, e := range doc.Environments {
+			enabled[e.Name] = false
+		}
+		for _, fe := range f.Environments {
+			enabled[fe.Environment] = fe.Enabled
+		}
+		items = append(items, map[string]any{
+			"name":         f.Name,
+			"description":  f.Description,
+			"flag_type":    f.FlagType,
+			"environments": enabled,
+		})
+	}
+
+	return a.output(map[string]any{"flags": items})
+}
+
+func (a *app) flagToggle(args []string) error {
+	fs := flag.NewFlagSet("flag toggle", flag.ContinueOnError)
+	projectRef := fs.String("project", "", "project ID or name (local mode)")
+	name := fs.String("flag", "", "flag name (required)")
+	envName := fs.String("env", "", "environment name (required)")
+	enabled := fs.Bool("enabled", true, "enable (true) or disable (false) the flag")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *name == "" || *envName == "" {
+		return errors.New("-flag and -env are required")
+	}
+
+	if a.remote != nil {
+		return a.flagToggleRemote(*name, *envName, *enabled)
+	}
+
+	p, err := a.resolveProject(*projectRef)
+	if err != nil {
+		return err
+	}
+	orm := a.container().ORM
+	ctx := context.Background()
+
+	f, err := orm.Flag.Query().
+		Where(entflag.ProjectID(p.ID), entflag.Name(*name), entflag.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("flag %q not found", *name)
+	}
+	if err != nil {
+		return err
+	}
+
+	env, err := orm.Environment.Query().
+		Where(environment.ProjectID(p.ID), environment.Name(*envName), environment.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("environment %q not found", *envName)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Going through the flag service bumps the revision and records a
+	// version by "cli", like a change from the dashboard or the admin API.
+	ref := services.FlagEnvRef{ProjectID: p.ID, FlagID: f.ID, EnvironmentID: env.ID}
+	if _, err := a.container().Flags.SetEnabled(ctx, ref, *enabled, 0, "cli"); err != nil {
+		return err
+	}
+
+	return a.output(map[string]any{
+		"flag":        f.Name,
+		"environment": env.Name,
+		"enabled":     *enabled,
+	})
+}
+
+// flagToggleRemote resolves the flag and environment IDs by name, then
+// patches the flag environment through the admin API.
+func (a *app) flagToggleRemote(name, envName string, enabled bool) error {
+	projectID, err := a.remote.projectID()
+	if err != nil {
+		return err
+	}
+
+	flagID, err := a.remote.lookup(fmt.Sprintf("/projects/%d/flags", projectID), name)
+	if err != nil {
+		return err
+	}
+	if flagID == 0 {
+		return fmt.Errorf("flag %q not found", name)
+	}
+
+	envID, err := a.remote.lookup(fmt.Sprintf("/projects/%d/environments", projectID), envName)
+	if err != nil {
+		return err
+	}
+	if envID == 0 {
+		return fmt.Errorf("environment %q not found", envName)
+	}
+
+	path := fmt.Sprintf("/projects/%d/flags/%d/environments/%d", projectID, flagID, envID)
+	if err := a.remote.json(http.MethodPatch, path, map[string]any{"enabled": enabled}, nil); err != nil {
+		return err
+	}
+
+	return a.output(map[string]any{
+		"flag":        name,
+		"environment": envName,
+		"enabled":     enabled,
+	})
+}
@@ -0,0 +1,203 @@
+// Command bandeira is the operator CLI. Commands run against the local
+// database through services.Container, or against a running server through the
+// admin API when -url and -token (or BANDEIRA_URL and BANDEIRA_TOKEN) are set.
+// Results are written to stdout as JSON.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+const usage = `Usage: bandeira [-url URL -token TOKEN] <command> <subcommand> [flags]
+
+Commands:
+  user create|reset-password|set-role   Manage dashboard users (local only)
+  token create|revoke                   Manage API tokens
+  flag list|toggle                      List and toggle flags
+  project export|import                 Export or apply a project document
+  db backup|restore|migrate             Database maintenance (local only)
+  serve                                 Start the web server
+
+Without -url, commands use the local database from config.yaml. With -url and
+an admin token, commands go through the admin API of a running server, which
+also pushes changes to connected SDKs immediately.
+
+Run "bandeira <command> <subcommand> -h" for the flags of a subcommand.
+`
+
+// errUsage signals that usage has already been printed.
+var errUsage = errors.New("usage")
+
+// app holds the global state shared by all subcommands.
+type app struct {
+	remote *client
+	local  *services.Container
+	stdout io.Writer
+	stdin  io.Reader
+}
+
+func main() {
+	a := &app{stdout: os.Stdout, stdin: os.Stdin}
+
+	global := flag.NewFlagSet("bandeira", flag.ContinueOnError)
+	global.Usage = func() { fmt.Fprint(os.Stderr, usage) }
+	url := global.String("url", os.Getenv("BANDEIRA_URL"), "base URL of a running server (remote mode)")
+	token := global.String("token", os.Getenv("BANDEIRA_TOKEN"), "admin API token for remote mode")
+	if err := global.Parse(os.Args[1:]); err != nil {
+		os.Exit(2)
+	}
+
+	if *url != "" {
+		if *token == "" {
+			exit(errors.New("-token is required with -url"))
+		}
+		a.remote = newClient(*url, *token)
+	}
+
+	err := a.run(global.Args())
+	if a.local != nil {
+		if serr := a.local.Shutdown(); err == nil {
+			err = serr
+		}
+	}
+	exit(err)
+}
+
+func (a *app) run(args []string) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errUsage
+	}
+
+	commands := map[string]map[string]func([]string) error{
+		"user": {
+			"create":         a.userCreate,
+			"reset-password": a.userResetPassword,
+			"set-role":       a.userSetRole,
+		},
+		"token": {
+			"create": a.tokenCreate,
+			"revoke": a.tokenRevoke,
+		},
+		"flag": {
+			"list":   a.flagList,
+			"toggle": a.flagToggle,
+		},
+		"project": {
+			"export": a.projectExport,
+			"import": a.projectImport,
+		},
+		"db": {
+			"backup":  a.dbBackup,
+			"restore": a.dbRestore,
+			"migrate": a.dbMigrate,
+		},
+	}
+
+	if args[0] == "serve" {
+		return a.serve(args[1:])
+	}
+
+	group, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", args[0], usage)
+		return errUsage
+	}
+	if len(args) < 2 {
+		fmt.Fprintf(os.Stderr, "missing subcommand for %q\n\n%s", args[0], usage)
+		return errUsage
+	}
+	cmd, ok := group[args[1]]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown subcommand %q for %q\n\n%s", args[1], args[0], usage)
+		return errUsage
+	}
+	return cmd(args[2:])
+}
+
+// container lazily starts the local container. Commands call it only when not
+// in remote mode so that remote usage never touches the local database.
+func (a *app) container() *services.Container {
+	if a.local == nil {
+		a.local = services.NewHeadlessContainer()
+	}
+	return a.local
+}
+
+// localOnly rejects commands that have no admin API equivalent.
+func (a *app) localOnly(name string) error {
+	if a.remote != nil {
+		return fmt.Errorf("%s is only available against the local database", name)
+	}
+	return nil
+}
+
+// resolveProject finds a local project by ID or name.
+func (a *app) resolveProject(ref string) (*ent.Project, error) {
+	if ref == "" {
+		return nil, errors.New("-project is required")
+	}
+	orm := a.container().ORM
+	q := orm.Project.Query().Where(project.DeletedAtIsNil())
+	if id, err := strconv.Atoi(ref); err == nil {
+		q = q.Where(project.ID(id))
+	} else {
+		q = q.Where(project.Name(ref))
+	}
+	p, err := q.Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("project %q not found", ref)
+	}
+	return p, err
+}
+
+// parseFlags parses subcommand flags, printing usage on error.
+func parseFlags(fs *flag.FlagSet, args []string) error {
+	fs.SetOutput(os.Stderr)
+	if err := fs.Parse(args); err != nil {
+		return errUsage
+	}
+	return nil
+}
+
+// output writes v to stdout as indented JSON.
+func (a *app) output(v any) error {
+	enc := json.NewEncoder(a.stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// exit terminates the process, reporting err as JSON on stderr.
+func exit(err error) {
+	switch {
+	case err == nil:
+		os.Exit(0)
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	}
+
+	body := map[string]any{"error": err.Error()}
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		body["error"] = apiErr.Message
+		body["status"] = apiErr.Status
+		if len(apiErr.Fields) > 0 {
+			body["fields"] = apiErr.Fields
+		}
+	}
+	enc := json.NewEncoder(os.Stderr)
+	enc.SetIndent("", "  ")
+	enc.Encode(body)
+	os.Exit(1)
+}
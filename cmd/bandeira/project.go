@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+func (a *app) projectExport(args []string) error {
+	fs := flag.NewFlagSet("project export", flag.ContinueOnError)
+	projectRef := fs.String("project", "", "project ID or name (local mode)")
+	format := fs.String("format", declarative.FormatYAML, "output format: json or yaml")
+	out := fs.String("o", "", "write to this file instead of stdout")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *format != declarative.FormatJSON && *format != declarative.FormatYAML {
+		return fmt.Errorf("invalid format %q: must be json or yaml", *format)
+	}
+
+	doc, err := a.exportDocument(*projectRef)
+	if err != nil {
+		return err
+	}
+
+	data, err := declarative.Encode(doc, *format)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = a.stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0644); err != nil {
+		return err
+	}
+	return a.output(map[string]any{"path": *out, "bytes": len(data)})
+}
+
+func (a *app) projectImport(args []string) error {
+	fs := flag.NewFlagSet("project import", flag.ContinueOnError)
+	projectRef := fs.String("project", "", "project ID or name (local mode)")
+	file := fs.String("f", "", `document to apply, or "-" for stdin (required)`)
+	format := fs.String("format", "", "document format: json or yaml (default: from the file extension)")
+	dryRun := fs.Bool("dry-run", false, "print the diff without applying it")
+	prune := fs.Bool("prune", false, "delete environments, flags and configs missing from the document")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *file == "" {
+		return errors.New("-f is required")
+	}
+
+	var data []byte
+	var err error
+	if *file == "-" {
+		data, err = io.ReadAll(a.stdin)
+	} else {
+		data, err = os.ReadFile(*file)
+	}
+	if err != nil {
+		return err
+	}
+
+	if *format == "" {
+		*format = declarative.FormatJSON
+		if ext := filepath.Ext(*file); ext == ".yaml" || ext == ".yml" {
+			*format = declarative.FormatYAML
+		}
+	}
+
+	if a.remote != nil {
+		projectID, err := a.remote.projectID()
+		if err != nil {
+			return err
+		}
+		q := url.Values{}
+		q.Set("format", *format)
+		if *dryRun {
+			q.Set("dry_run", "true")
+		}
+		if *prune {
+			q.Set("prune", "true")
+		}
+		resp, err := a.remote.do(http.MethodPost,
+			fmt.Sprintf("/projects/%d/import?%s", projectID, q.Encode()),
+			data, "application/"+*format)
+		if err != nil {
+			return err
+		}
+		var out map[string]any
+		if err := json.Unmarshal(resp, &out); err != nil {
+			return err
+		}
+		return a.output(out)
+	}
+
+	p, err := a.resolveProject(*projectRef)
+	if err != nil {
+		return err
+	}
+
+	doc, err := declarative.Decode(data, *format)
+	if err != nil {
+		return err
+	}
+
+	diff, err := a.container().Flags.Apply(context.Background(), p.ID, doc, declarative.Options{
+		DryRun: *dryRun,
+		Prune:  *prune,
+		Actor:  "cli",
+	})
+	var verr *declarative.ValidationError
+	if errors.As(err, &verr) {
+		return &apiError{Status: http.StatusUnprocessableEntity, Message: "Validation failed", Fields: verr.Fields}
+	}
+	if err != nil {
+		return err
+	}
+
+	return a.output(map[string]any{
+		"dry_run": *dryRun,
+		"applied": !*dryRun,
+		"summary": diff.Summary(),
+		"changes": diff.Changes,
+	})
+}
+
+// exportDocument loads the project document locally or through the admin API.
+func (a *app) exportDocument(projectRef string) (*declarative.Document, error) {
+	if a.remote != nil {
+		projectID, err := a.remote.projectID()
+		if err != nil {
+			return nil, err
+		}
+		data, err := a.remote.do(http.MethodGet, fmt.Sprintf("/projects/%d/export?format=json", projectID), nil, "")
+		if err != nil {
+			return nil, err
+		}
+		return declarative.Decode(data, declarative.FormatJSON)
+	}
+
+	p, err := a.resolveProject(projectRef)
+	if err != nil {
+		return nil, err
+	}
+	return declarative.Export(context.Background(), a.container().ORM, p.ID)
+}
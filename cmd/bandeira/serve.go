@@ -0,0 +1,43 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"os/signal"
+
+	"github.com/felipekafuri/bandeira/pkg/handlers"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// serve runs the web server, same as cmd/web.
+func (a *app) serve(args []string) error {
+	fs := flag.NewFlagSet("serve", flag.ContinueOnError)
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("serve"); err != nil {
+		return err
+	}
+
+	// The full container is needed for the web framework and Inertia; it
+	// replaces the headless one and is shut down by main.
+	a.local = services.NewContainer()
+	if err := handlers.BuildRouter(a.local); err != nil {
+		return err
+	}
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- a.local.StartServer()
+	}()
+
+	// Wait for interrupt signal to gracefully shut down the server.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt)
+	select {
+	case <-quit:
+		return nil
+	case err := <-errs:
+		return err
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	"github.com/felipekafuri/bandeira/pkg/token"
+)
+
+func (a *app) tokenCreate(args []string) error {
+	fs := flag.NewFlagSet("token create", flag.ContinueOnError)
+	projectRef := fs.String("project", "", "project ID or name (local mode)")
+	name := fs.String("name", "", "token name (required)")
+	tokenType := fs.String("type", "client", "token type: client or admin")
+	envName := fs.String("env", "", "environment name (required for client tokens)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *name == "" {
+		return errors.New("-name is required")
+	}
+	if *tokenType != "client" && *tokenType != "admin" {
+		return fmt.Errorf("invalid type %q: must be client or admin", *tokenType)
+	}
+	if *tokenType == "client" && *envName == "" {
+		return errors.New("-env is required for client tokens")
+	}
+
+	if a.remote != nil {
+		var out map[string]any
+		err := a.remote.json(http.MethodPost, "/api-tokens", map[string]any{
+			"name":        *name,
+			"token_type":  *tokenType,
+			"environment": *envName,
+		}, &out)
+		if err != nil {
+			return err
+		}
+		return a.output(out)
+	}
+
+	p, err := a.resolveProject(*projectRef)
+	if err != nil {
+		return err
+	}
+	orm := a.container().ORM
+	ctx := context.Background()
+
+	envValue := ""
+	if *tokenType == "client" {
+		exists, err := orm.Environment.Query().
+			Where(environment.Name(*envName), environment.ProjectID(p.ID), environment.DeletedAtIsNil()).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("environment %q not found in project %q", *envName, p.Name)
+		}
+		envValue = *envName
+	}
+
+	raw, hashed, err := token.Generate()
+	if err != nil {
+		return err
+	}
+
+	t, err := orm.ApiToken.Create().
+		SetName(*name).
+		SetSecret(hashed).
+		SetPlainToken(raw).
+		SetTokenType(apitoken.TokenType(*tokenType)).
+		SetEnvironment(envValue).
+		SetProjectID(p.ID).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	return a.output(map[string]any{
+		"id":          t.ID,
+		"name":        t.Name,
+		"token_type":  string(t.TokenType),
+		"environment": t.Environment,
+		"project_id":  t.ProjectID,
+		"raw_token":   raw,
+		"created_at":  t.CreatedAt.Format(time.RFC3339),
+	})
+}
+
+func (a *app) tokenRevoke(args []string) error {
+	fs := flag.NewFlagSet("token revoke", flag.ContinueOnError)
+	id := fs.Int("id", 0, "token ID (required)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if *id == 0 {
+		return errors.New("-id is required")
+	}
+
+	if a.remote != nil {
+		if err := a.remote.json(http.MethodDelete, fmt.Sprintf("/api-tokens/%d", *id), nil, nil); err != nil {
+			return err
+		}
+		return a.output(map[string]any{"ok": true})
+	}
+
+	err := a.container().ORM.ApiToken.DeleteOneID(*id).Exec(context.Background())
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("token %d not found", *id)
+	}
+	if err != nil {
+		return err
+	}
+	return a.output(map[string]any{"ok": true})
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/user"
+)
+
+func (a *app) userCreate(args []string) error {
+	fs := flag.NewFlagSet("user create", flag.ContinueOnError)
+	email := fs.String("email", "", "email address (required)")
+	name := fs.String("name", "", "display name (required)")
+	password := fs.String("password", "", "password; a random one is generated and printed when empty")
+	role := fs.String("role", "viewer", "role: admin, editor or viewer")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("user create"); err != nil {
+		return err
+	}
+	if *email == "" || *name == "" {
+		return errors.New("-email and -name are required")
+	}
+	if err := user.RoleValidator(user.Role(*role)); err != nil {
+		return fmt.Errorf("invalid role %q: must be one of admin, editor, viewer", *role)
+	}
+
+	plain, generated, err := passwordOrRandom(*password)
+	if err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u, err := a.container().ORM.User.Create().
+		SetEmail(*email).
+		SetName(*name).
+		SetPassword(string(hash)).
+		SetRole(user.Role(*role)).
+		Save(context.Background())
+	if ent.IsConstraintError(err) {
+		return fmt.Errorf("a user with email %q already exists", *email)
+	}
+	if err != nil {
+		return err
+	}
+
+	out := userDTO(u)
+	if generated {
+		out["password"] = plain
+	}
+	return a.output(out)
+}
+
+func (a *app) userResetPassword(args []string) error {
+	fs := flag.NewFlagSet("user reset-password", flag.ContinueOnError)
+	email := fs.String("email", "", "email address (required)")
+	password := fs.String("password", "", "new password; a random one is generated and printed when empty")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("user reset-password"); err != nil {
+		return err
+	}
+
+	u, err := a.findUser(*email)
+	if err != nil {
+		return err
+	}
+
+	plain, generated, err := passwordOrRandom(*password)
+	if err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(plain), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u, err = u.Update().SetPassword(string(hash)).Save(context.Background())
+	if err != nil {
+		return err
+	}
+
+	out := userDTO(u)
+	if generated {
+		out["password"] = plain
+	}
+	return a.output(out)
+}
+
+func (a *app) userSetRole(args []string) error {
+	fs := flag.NewFlagSet("user set-role", flag.ContinueOnError)
+	email := fs.String("email", "", "email address (required)")
+	role := fs.String("role", "", "role: admin, editor or viewer (required)")
+	if err := parseFlags(fs, args); err != nil {
+		return err
+	}
+	if err := a.localOnly("user set-role"); err != nil {
+		return err
+	}
+	if err := user.RoleValidator(user.Role(*role)); err != nil {
+		return fmt.Errorf("invalid role %q: must be one of admin, editor, viewer", *role)
+	}
+
+	u, err := a.findUser(*email)
+	if err != nil {
+		return err
+	}
+
+	u, err = u.Update().SetRole(user.Role(*role)).Save(context.Background())
+	if err != nil {
+		return err
+	}
+	return a.output(userDTO(u))
+}
+
+func (a *app) findUser(email string) (*ent.User, error) {
+	if email == "" {
+		return nil, errors.New("-email is required")
+	}
+	u, err := a.container().ORM.User.Query().
+		Where(user.Email(email)).
+		Only(context.Background())
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("user %q not found", email)
+	}
+	return u, err
+}
+
+// passwordOrRandom returns the given password, or a random one when empty.
+func passwordOrRandom(password string) (string, bool, error) {
+	if password != "" {
+		return password, false, nil
+	}
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", false, err
+	}
+	return hex.EncodeToString(b), true, nil
+}
+
+func userDTO(u *ent.User) map[string]any {
+	return map[string]any{
+		"id":    u.ID,
+		"email": u.Email,
+		"name":  u.Name,
+		"role":  string(u.Role),
+	}
+}
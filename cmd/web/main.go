@@ -1,10 +1,6 @@
 package main
 
 import (
-	"crypto/tls"
-	"errors"
-	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
 
@@ -27,27 +23,7 @@ func main() {
 
 	// Start the server.
 	go func() {
-		srv := http.Server{
-			Addr:        fmt.Sprintf("%s:%d", c.Config.HTTP.Hostname, c.Config.HTTP.Port),
-			Handler:     c.Web,
-			ReadTimeout: c.Config.HTTP.ReadTimeout,
-			// WriteTimeout is 0 (disabled) to support SSE streaming.
-			// Per-request timeouts are enforced by the Echo timeout middleware.
-			IdleTimeout: c.Config.HTTP.IdleTimeout,
-		}
-
-		if c.Config.HTTP.TLS.Enabled {
-			certs, err := tls.LoadX509KeyPair(c.Config.HTTP.TLS.Certificate, c.Config.HTTP.TLS.Key)
-			fatal("cannot load TLS certificate", err)
-
-			srv.TLSConfig = &tls.Config{
-				Certificates: []tls.Certificate{certs},
-			}
-		}
-
-		if err := c.Web.StartServer(&srv); errors.Is(err, http.ErrServerClosed) {
-			fatal("shutting down the server", err)
-		}
+		fatal("server failed", c.StartServer())
 	}()
 
 	// Wait for interrupt signal to gracefully shut down the server.
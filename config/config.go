@@ -55,6 +55,12 @@ type (
 		Cache    CacheConfig
 		Database DatabaseConfig
 		Auth     AuthConfig
+		Backup   BackupConfig
+		Metrics  MetricsConfig
+		Tracing  TracingConfig
+		Stream   StreamConfig
+		Trash    TrashConfig
+		Rollout  RolloutConfig
 	}
 
 	// HTTPConfig stores HTTP configuration.
@@ -65,7 +71,10 @@ type (
 		WriteTimeout    time.Duration
 		IdleTimeout     time.Duration
 		ShutdownTimeout time.Duration
-		TLS             struct {
+		// DrainDelay is how long /readyz reports not ready on shutdown before
+		// the server stops, so load balancers can take the instance out.
+		DrainDelay time.Duration
+		TLS        struct {
 			Enabled     bool
 			Certificate string
 			Key         string
@@ -101,6 +110,78 @@ type (
 		AdminEmail    string
 		AdminPassword string
 	}
+
+	// BackupConfig stores the database backup configuration.
+	BackupConfig struct {
+		// Enabled turns on scheduled backups. On-demand backups are always available.
+		Enabled   bool
+		Interval  time.Duration
+		Directory string
+		Retention struct {
+			// Keep is the number of most recent backups to keep (0 keeps all).
+			Keep int
+			// MaxAge deletes backups older than this (0 disables).
+			MaxAge time.Duration
+		}
+		S3 S3Config
+	}
+
+	// MetricsConfig stores the Prometheus metrics endpoint configuration.
+	MetricsConfig struct {
+		Enabled bool
+		// Token, if set, must be sent as a bearer token to scrape /metrics.
+		Token string
+	}
+
+	// StreamConfig stores SSE stream configuration.
+	StreamConfig struct {
+		// DrainTimeout is how long streams may stay open on shutdown after
+		// clients have been asked to reconnect.
+		DrainTimeout time.Duration
+		// ReconnectDelay plus a random share of ReconnectJitter is the retry
+		// hint sent to each client on shutdown, to spread out reconnects.
+		ReconnectDelay  time.Duration
+		ReconnectJitter time.Duration
+	}
+
+	// TrashConfig stores the soft-deletion configuration.
+	TrashConfig struct {
+		// Retention is how long deleted projects, flags and environments can
+		// be restored before they are purged (0 keeps them until purged by
+		// hand).
+		Retention time.Duration
+		// PurgeInterval is how often expired items are purged.
+		PurgeInterval time.Duration
+	}
+
+	// RolloutConfig stores the rollout plan configuration.
+	RolloutConfig struct {
+		// Interval is how often running plans are checked for a step that is
+		// due (0 disables the worker).
+		Interval time.Duration
+	}
+
+	// TracingConfig stores the OpenTelemetry tracing configuration.
+	TracingConfig struct {
+		Enabled bool
+		// Endpoint is the OTLP/HTTP collector URL, e.g. http://localhost:4318.
+		Endpoint string
+		// SampleRatio is the fraction of new traces to sample (0 to 1).
+		SampleRatio float64
+		// ServiceName overrides the app name as the reported service name.
+		ServiceName string
+	}
+
+	// S3Config stores an optional S3-compatible upload target for backups.
+	S3Config struct {
+		Enabled   bool
+		Endpoint  string
+		Region    string
+		Bucket    string
+		Prefix    string
+		AccessKey string
+		SecretKey string
+	}
 )
 
 // GetConfig loads and returns configuration.
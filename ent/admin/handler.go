@@ -13,11 +13,17 @@ import (
 	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -42,16 +48,28 @@ func (h *Handler) Create(ctx echo.Context, entityType string) error {
 		return h.ApiTokenCreate(ctx)
 	case "Constraint":
 		return h.ConstraintCreate(ctx)
+	case "ContextField":
+		return h.ContextFieldCreate(ctx)
 	case "Environment":
 		return h.EnvironmentCreate(ctx)
 	case "Flag":
 		return h.FlagCreate(ctx)
 	case "FlagEnvironment":
 		return h.FlagEnvironmentCreate(ctx)
+	case "FlagEnvironmentVersion":
+		return h.FlagEnvironmentVersionCreate(ctx)
+	case "Prerequisite":
+		return h.PrerequisiteCreate(ctx)
 	case "Project":
 		return h.ProjectCreate(ctx)
+	case "RolloutPlan":
+		return h.RolloutPlanCreate(ctx)
 	case "Strategy":
 		return h.StrategyCreate(ctx)
+	case "StrategyDefinition":
+		return h.StrategyDefinitionCreate(ctx)
+	case "Tag":
+		return h.TagCreate(ctx)
 	case "User":
 		return h.UserCreate(ctx)
 	default:
@@ -65,16 +83,28 @@ func (h *Handler) Get(ctx echo.Context, entityType string, id int) (url.Values,
 		return h.ApiTokenGet(ctx, id)
 	case "Constraint":
 		return h.ConstraintGet(ctx, id)
+	case "ContextField":
+		return h.ContextFieldGet(ctx, id)
 	case "Environment":
 		return h.EnvironmentGet(ctx, id)
 	case "Flag":
 		return h.FlagGet(ctx, id)
 	case "FlagEnvironment":
 		return h.FlagEnvironmentGet(ctx, id)
+	case "FlagEnvironmentVersion":
+		return h.FlagEnvironmentVersionGet(ctx, id)
+	case "Prerequisite":
+		return h.PrerequisiteGet(ctx, id)
 	case "Project":
 		return h.ProjectGet(ctx, id)
+	case "RolloutPlan":
+		return h.RolloutPlanGet(ctx, id)
 	case "Strategy":
 		return h.StrategyGet(ctx, id)
+	case "StrategyDefinition":
+		return h.StrategyDefinitionGet(ctx, id)
+	case "Tag":
+		return h.TagGet(ctx, id)
 	case "User":
 		return h.UserGet(ctx, id)
 	default:
@@ -88,16 +118,28 @@ func (h *Handler) Delete(ctx echo.Context, entityType string, id int) error {
 		return h.ApiTokenDelete(ctx, id)
 	case "Constraint":
 		return h.ConstraintDelete(ctx, id)
+	case "ContextField":
+		return h.ContextFieldDelete(ctx, id)
 	case "Environment":
 		return h.EnvironmentDelete(ctx, id)
 	case "Flag":
 		return h.FlagDelete(ctx, id)
 	case "FlagEnvironment":
 		return h.FlagEnvironmentDelete(ctx, id)
+	case "FlagEnvironmentVersion":
+		return h.FlagEnvironmentVersionDelete(ctx, id)
+	case "Prerequisite":
+		return h.PrerequisiteDelete(ctx, id)
 	case "Project":
 		return h.ProjectDelete(ctx, id)
+	case "RolloutPlan":
+		return h.RolloutPlanDelete(ctx, id)
 	case "Strategy":
 		return h.StrategyDelete(ctx, id)
+	case "StrategyDefinition":
+		return h.StrategyDefinitionDelete(ctx, id)
+	case "Tag":
+		return h.TagDelete(ctx, id)
 	case "User":
 		return h.UserDelete(ctx, id)
 	default:
@@ -111,16 +153,28 @@ func (h *Handler) Update(ctx echo.Context, entityType string, id int) error {
 		return h.ApiTokenUpdate(ctx, id)
 	case "Constraint":
 		return h.ConstraintUpdate(ctx, id)
+	case "ContextField":
+		return h.ContextFieldUpdate(ctx, id)
 	case "Environment":
 		return h.EnvironmentUpdate(ctx, id)
 	case "Flag":
 		return h.FlagUpdate(ctx, id)
 	case "FlagEnvironment":
 		return h.FlagEnvironmentUpdate(ctx, id)
+	case "FlagEnvironmentVersion":
+		return h.FlagEnvironmentVersionUpdate(ctx, id)
+	case "Prerequisite":
+		return h.PrerequisiteUpdate(ctx, id)
 	case "Project":
 		return h.ProjectUpdate(ctx, id)
+	case "RolloutPlan":
+		return h.RolloutPlanUpdate(ctx, id)
 	case "Strategy":
 		return h.StrategyUpdate(ctx, id)
+	case "StrategyDefinition":
+		return h.StrategyDefinitionUpdate(ctx, id)
+	case "Tag":
+		return h.TagUpdate(ctx, id)
 	case "User":
 		return h.UserUpdate(ctx, id)
 	default:
@@ -134,16 +188,28 @@ func (h *Handler) List(ctx echo.Context, entityType string) (*EntityList, error)
 		return h.ApiTokenList(ctx)
 	case "Constraint":
 		return h.ConstraintList(ctx)
+	case "ContextField":
+		return h.ContextFieldList(ctx)
 	case "Environment":
 		return h.EnvironmentList(ctx)
 	case "Flag":
 		return h.FlagList(ctx)
 	case "FlagEnvironment":
 		return h.FlagEnvironmentList(ctx)
+	case "FlagEnvironmentVersion":
+		return h.FlagEnvironmentVersionList(ctx)
+	case "Prerequisite":
+		return h.PrerequisiteList(ctx)
 	case "Project":
 		return h.ProjectList(ctx)
+	case "RolloutPlan":
+		return h.RolloutPlanList(ctx)
 	case "Strategy":
 		return h.StrategyList(ctx)
+	case "StrategyDefinition":
+		return h.StrategyDefinitionList(ctx)
+	case "Tag":
+		return h.TagList(ctx)
 	case "User":
 		return h.UserList(ctx)
 	default:
@@ -414,6 +480,141 @@ func (h *Handler) ConstraintGet(ctx echo.Context, id int) (url.Values, error) {
 	return v, err
 }
 
+func (h *Handler) ContextFieldCreate(ctx echo.Context) error {
+	var payload ContextField
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.ContextField.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Type != nil {
+		op.SetType(*payload.Type)
+	}
+	if payload.LegalValues != nil {
+		op.SetLegalValues(*payload.LegalValues)
+	}
+	op.SetProjectID(payload.ProjectID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ContextFieldUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.ContextField.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload ContextField
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Type == nil {
+		var empty contextfield.Type
+		op.SetType(empty)
+	} else {
+		op.SetType(*payload.Type)
+	}
+	if payload.LegalValues == nil {
+		op.ClearLegalValues()
+	} else {
+		op.SetLegalValues(*payload.LegalValues)
+	}
+	op.SetProjectID(payload.ProjectID)
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ContextFieldDelete(ctx echo.Context, id int) error {
+	return h.client.ContextField.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ContextFieldList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.ContextField.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(contextfield.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+			"Type",
+			"Legal values",
+			"Project ID",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+				fmt.Sprint(res[i].Type),
+				fmt.Sprint(res[i].LegalValues),
+				fmt.Sprint(res[i].ProjectID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ContextFieldGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.ContextField.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	v.Set("type", fmt.Sprint(entity.Type))
+	v.Set("legal_values", fmt.Sprint(entity.LegalValues))
+	v.Set("project_id", fmt.Sprint(entity.ProjectID))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
 func (h *Handler) EnvironmentCreate(ctx echo.Context) error {
 	var payload Environment
 	if err := h.bind(ctx, &payload); err != nil {
@@ -433,6 +634,21 @@ func (h *Handler) EnvironmentCreate(ctx echo.Context) error {
 	if payload.UpdatedAt != nil {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	if payload.ExpectedMatchID != nil {
+		op.SetExpectedMatchID(*payload.ExpectedMatchID)
+	}
+	if payload.DeletedAt != nil {
+		op.SetDeletedAt(*payload.DeletedAt)
+	}
+	if payload.FrozenAt != nil {
+		op.SetFrozenAt(*payload.FrozenAt)
+	}
+	if payload.FrozenBy != nil {
+		op.SetFrozenBy(*payload.FrozenBy)
+	}
+	if payload.FreezeReason != nil {
+		op.SetFreezeReason(*payload.FreezeReason)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
@@ -464,6 +680,19 @@ func (h *Handler) EnvironmentUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	op.SetNillableExpectedMatchID(payload.ExpectedMatchID)
+	op.SetNillableDeletedAt(payload.DeletedAt)
+	op.SetNillableFrozenAt(payload.FrozenAt)
+	if payload.FrozenBy == nil {
+		op.ClearFrozenBy()
+	} else {
+		op.SetFrozenBy(*payload.FrozenBy)
+	}
+	if payload.FreezeReason == nil {
+		op.ClearFreezeReason()
+	} else {
+		op.SetFreezeReason(*payload.FreezeReason)
+	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
@@ -494,6 +723,11 @@ func (h *Handler) EnvironmentList(ctx echo.Context) (*EntityList, error) {
 			"Project ID",
 			"Created at",
 			"Updated at",
+			"Expected match ID",
+			"Deleted at",
+			"Frozen at",
+			"Frozen by",
+			"Freeze reason",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -510,6 +744,11 @@ func (h *Handler) EnvironmentList(ctx echo.Context) (*EntityList, error) {
 				fmt.Sprint(res[i].ProjectID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].ExpectedMatchID),
+				res[i].DeletedAt.Format(h.Config.TimeFormat),
+				res[i].FrozenAt.Format(h.Config.TimeFormat),
+				res[i].FrozenBy,
+				res[i].FreezeReason,
 			},
 		})
 	}
@@ -529,6 +768,11 @@ func (h *Handler) EnvironmentGet(ctx echo.Context, id int) (url.Values, error) {
 	v.Set("sort_order", fmt.Sprint(entity.SortOrder))
 	v.Set("project_id", fmt.Sprint(entity.ProjectID))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("expected_match_id", fmt.Sprint(entity.ExpectedMatchID))
+	v.Set("deleted_at", entity.DeletedAt.Format(dateTimeFormat))
+	v.Set("frozen_at", entity.FrozenAt.Format(dateTimeFormat))
+	v.Set("frozen_by", entity.FrozenBy)
+	v.Set("freeze_reason", entity.FreezeReason)
 	return v, err
 }
 
@@ -551,6 +795,18 @@ func (h *Handler) FlagCreate(ctx echo.Context) error {
 	if payload.UpdatedAt != nil {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	if payload.DeletedAt != nil {
+		op.SetDeletedAt(*payload.DeletedAt)
+	}
+	if payload.Owner != nil {
+		op.SetOwner(*payload.Owner)
+	}
+	if payload.Links != nil {
+		op.SetLinks(*payload.Links)
+	}
+	if payload.Metadata != nil {
+		op.SetMetadata(*payload.Metadata)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
@@ -581,6 +837,22 @@ func (h *Handler) FlagUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	op.SetNillableDeletedAt(payload.DeletedAt)
+	if payload.Owner == nil {
+		op.ClearOwner()
+	} else {
+		op.SetOwner(*payload.Owner)
+	}
+	if payload.Links == nil {
+		op.ClearLinks()
+	} else {
+		op.SetLinks(*payload.Links)
+	}
+	if payload.Metadata == nil {
+		op.ClearMetadata()
+	} else {
+		op.SetMetadata(*payload.Metadata)
+	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
@@ -611,6 +883,10 @@ func (h *Handler) FlagList(ctx echo.Context) (*EntityList, error) {
 			"Project ID",
 			"Created at",
 			"Updated at",
+			"Deleted at",
+			"Owner",
+			"Links",
+			"Metadata",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -627,6 +903,10 @@ func (h *Handler) FlagList(ctx echo.Context) (*EntityList, error) {
 				fmt.Sprint(res[i].ProjectID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				res[i].DeletedAt.Format(h.Config.TimeFormat),
+				res[i].Owner,
+				fmt.Sprint(res[i].Links),
+				fmt.Sprint(res[i].Metadata),
 			},
 		})
 	}
@@ -646,6 +926,10 @@ func (h *Handler) FlagGet(ctx echo.Context, id int) (url.Values, error) {
 	v.Set("flag_type", fmt.Sprint(entity.FlagType))
 	v.Set("project_id", fmt.Sprint(entity.ProjectID))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("deleted_at", entity.DeletedAt.Format(dateTimeFormat))
+	v.Set("owner", entity.Owner)
+	v.Set("links", fmt.Sprint(entity.Links))
+	v.Set("metadata", fmt.Sprint(entity.Metadata))
 	return v, err
 }
 
@@ -665,6 +949,9 @@ func (h *Handler) FlagEnvironmentCreate(ctx echo.Context) error {
 	if payload.UpdatedAt != nil {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	if payload.Revision != nil {
+		op.SetRevision(*payload.Revision)
+	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
@@ -690,6 +977,12 @@ func (h *Handler) FlagEnvironmentUpdate(ctx echo.Context, id int) error {
 	} else {
 		op.SetUpdatedAt(*payload.UpdatedAt)
 	}
+	if payload.Revision == nil {
+		var empty int
+		op.SetRevision(empty)
+	} else {
+		op.SetRevision(*payload.Revision)
+	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
@@ -719,6 +1012,7 @@ func (h *Handler) FlagEnvironmentList(ctx echo.Context) (*EntityList, error) {
 			"Environment ID",
 			"Created at",
 			"Updated at",
+			"Revision",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -734,6 +1028,7 @@ func (h *Handler) FlagEnvironmentList(ctx echo.Context) (*EntityList, error) {
 				fmt.Sprint(res[i].EnvironmentID),
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
 				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				fmt.Sprint(res[i].Revision),
 			},
 		})
 	}
@@ -752,70 +1047,62 @@ func (h *Handler) FlagEnvironmentGet(ctx echo.Context, id int) (url.Values, erro
 	v.Set("flag_id", fmt.Sprint(entity.FlagID))
 	v.Set("environment_id", fmt.Sprint(entity.EnvironmentID))
 	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("revision", fmt.Sprint(entity.Revision))
 	return v, err
 }
 
-func (h *Handler) ProjectCreate(ctx echo.Context) error {
-	var payload Project
+func (h *Handler) FlagEnvironmentVersionCreate(ctx echo.Context) error {
+	var payload FlagEnvironmentVersion
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.Project.Create()
-	op.SetName(payload.Name)
-	if payload.Description != nil {
-		op.SetDescription(*payload.Description)
+	op := h.client.FlagEnvironmentVersion.Create()
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	op.SetVersion(payload.Version)
+	op.SetSnapshot(payload.Snapshot)
+	if payload.Actor != nil {
+		op.SetActor(*payload.Actor)
 	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+	if payload.Reason != nil {
+		op.SetReason(*payload.Reason)
 	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) ProjectUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.Project.Get(ctx.Request().Context(), id)
+func (h *Handler) FlagEnvironmentVersionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.FlagEnvironmentVersion.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload Project
+	var payload FlagEnvironmentVersion
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetName(payload.Name)
-	if payload.Description == nil {
-		op.ClearDescription()
-	} else {
-		op.SetDescription(*payload.Description)
-	}
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
-	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) ProjectDelete(ctx echo.Context, id int) error {
-	return h.client.Project.DeleteOneID(id).
+func (h *Handler) FlagEnvironmentVersionDelete(ctx echo.Context, id int) error {
+	return h.client.FlagEnvironmentVersion.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) ProjectList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) FlagEnvironmentVersionList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.Project.
+	res, err := h.client.FlagEnvironmentVersion.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(project.ByID(sql.OrderDesc())).
+		Order(flagenvironmentversion.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -824,10 +1111,12 @@ func (h *Handler) ProjectList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Name",
-			"Description",
+			"Flag environment ID",
+			"Version",
+			"Snapshot",
+			"Actor",
 			"Created at",
-			"Updated at",
+			"Reason",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -838,10 +1127,12 @@ func (h *Handler) ProjectList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].Name,
-				res[i].Description,
+				fmt.Sprint(res[i].FlagEnvironmentID),
+				fmt.Sprint(res[i].Version),
+				fmt.Sprint(res[i].Snapshot),
+				res[i].Actor,
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				res[i].Reason,
 			},
 		})
 	}
@@ -849,91 +1140,73 @@ func (h *Handler) ProjectList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) ProjectGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.Project.Get(ctx.Request().Context(), id)
+func (h *Handler) FlagEnvironmentVersionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.FlagEnvironmentVersion.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("name", entity.Name)
-	v.Set("description", entity.Description)
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("flag_environment_id", fmt.Sprint(entity.FlagEnvironmentID))
 	return v, err
 }
 
-func (h *Handler) StrategyCreate(ctx echo.Context) error {
-	var payload Strategy
+func (h *Handler) PrerequisiteCreate(ctx echo.Context) error {
+	var payload Prerequisite
 	if err := h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
-	op := h.client.Strategy.Create()
-	op.SetName(payload.Name)
-	if payload.Parameters != nil {
-		op.SetParameters(*payload.Parameters)
-	}
-	if payload.SortOrder != nil {
-		op.SetSortOrder(*payload.SortOrder)
-	}
+	op := h.client.Prerequisite.Create()
 	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	op.SetParentFlagID(payload.ParentFlagID)
+	op.SetEnabled(payload.Enabled)
+	if payload.Variant != nil {
+		op.SetVariant(*payload.Variant)
+	}
 	if payload.CreatedAt != nil {
 		op.SetCreatedAt(*payload.CreatedAt)
 	}
-	if payload.UpdatedAt != nil {
-		op.SetUpdatedAt(*payload.UpdatedAt)
-	}
 	_, err := op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) StrategyUpdate(ctx echo.Context, id int) error {
-	entity, err := h.client.Strategy.Get(ctx.Request().Context(), id)
+func (h *Handler) PrerequisiteUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Prerequisite.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return err
 	}
 
-	var payload Strategy
+	var payload Prerequisite
 	if err = h.bind(ctx, &payload); err != nil {
 		return err
 	}
 
 	op := entity.Update()
-	op.SetName(payload.Name)
-	if payload.Parameters == nil {
-		op.ClearParameters()
-	} else {
-		op.SetParameters(*payload.Parameters)
-	}
-	if payload.SortOrder == nil {
-		var empty int
-		op.SetSortOrder(empty)
-	} else {
-		op.SetSortOrder(*payload.SortOrder)
-	}
 	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
-	if payload.UpdatedAt == nil {
-		var empty time.Time
-		op.SetUpdatedAt(empty)
+	op.SetParentFlagID(payload.ParentFlagID)
+	op.SetEnabled(payload.Enabled)
+	if payload.Variant == nil {
+		op.ClearVariant()
 	} else {
-		op.SetUpdatedAt(*payload.UpdatedAt)
+		op.SetVariant(*payload.Variant)
 	}
 	_, err = op.Save(ctx.Request().Context())
 	return err
 }
 
-func (h *Handler) StrategyDelete(ctx echo.Context, id int) error {
-	return h.client.Strategy.DeleteOneID(id).
+func (h *Handler) PrerequisiteDelete(ctx echo.Context, id int) error {
+	return h.client.Prerequisite.DeleteOneID(id).
 		Exec(ctx.Request().Context())
 }
 
-func (h *Handler) StrategyList(ctx echo.Context) (*EntityList, error) {
+func (h *Handler) PrerequisiteList(ctx echo.Context) (*EntityList, error) {
 	page, offset := h.getPageAndOffset(ctx)
-	res, err := h.client.Strategy.
+	res, err := h.client.Prerequisite.
 		Query().
 		Limit(h.Config.ItemsPerPage + 1).
 		Offset(offset).
-		Order(strategy.ByID(sql.OrderDesc())).
+		Order(prerequisite.ByID(sql.OrderDesc())).
 		All(ctx.Request().Context())
 
 	if err != nil {
@@ -942,12 +1215,11 @@ func (h *Handler) StrategyList(ctx echo.Context) (*EntityList, error) {
 
 	list := &EntityList{
 		Columns: []string{
-			"Name",
-			"Parameters",
-			"Sort order",
 			"Flag environment ID",
+			"Parent flag ID",
+			"Enabled",
+			"Variant",
 			"Created at",
-			"Updated at",
 		},
 		Entities:    make([]EntityValues, 0, len(res)),
 		Page:        page,
@@ -958,12 +1230,11 @@ func (h *Handler) StrategyList(ctx echo.Context) (*EntityList, error) {
 		list.Entities = append(list.Entities, EntityValues{
 			ID: res[i].ID,
 			Values: []string{
-				res[i].Name,
-				fmt.Sprint(res[i].Parameters),
-				fmt.Sprint(res[i].SortOrder),
 				fmt.Sprint(res[i].FlagEnvironmentID),
+				fmt.Sprint(res[i].ParentFlagID),
+				fmt.Sprint(res[i].Enabled),
+				res[i].Variant,
 				res[i].CreatedAt.Format(h.Config.TimeFormat),
-				res[i].UpdatedAt.Format(h.Config.TimeFormat),
 			},
 		})
 	}
@@ -971,18 +1242,667 @@ func (h *Handler) StrategyList(ctx echo.Context) (*EntityList, error) {
 	return list, err
 }
 
-func (h *Handler) StrategyGet(ctx echo.Context, id int) (url.Values, error) {
-	entity, err := h.client.Strategy.Get(ctx.Request().Context(), id)
+func (h *Handler) PrerequisiteGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Prerequisite.Get(ctx.Request().Context(), id)
 	if err != nil {
 		return nil, err
 	}
 
 	v := url.Values{}
-	v.Set("name", entity.Name)
-	v.Set("parameters", fmt.Sprint(entity.Parameters))
-	v.Set("sort_order", fmt.Sprint(entity.SortOrder))
 	v.Set("flag_environment_id", fmt.Sprint(entity.FlagEnvironmentID))
-	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("parent_flag_id", fmt.Sprint(entity.ParentFlagID))
+	v.Set("enabled", fmt.Sprint(entity.Enabled))
+	v.Set("variant", entity.Variant)
+	return v, err
+}
+
+func (h *Handler) ProjectCreate(ctx echo.Context) error {
+	var payload Project
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Project.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	if payload.DeletedAt != nil {
+		op.SetDeletedAt(*payload.DeletedAt)
+	}
+	if payload.FrozenAt != nil {
+		op.SetFrozenAt(*payload.FrozenAt)
+	}
+	if payload.FrozenBy != nil {
+		op.SetFrozenBy(*payload.FrozenBy)
+	}
+	if payload.FreezeReason != nil {
+		op.SetFreezeReason(*payload.FreezeReason)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ProjectUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Project.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Project
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	op.SetNillableDeletedAt(payload.DeletedAt)
+	op.SetNillableFrozenAt(payload.FrozenAt)
+	if payload.FrozenBy == nil {
+		op.ClearFrozenBy()
+	} else {
+		op.SetFrozenBy(*payload.FrozenBy)
+	}
+	if payload.FreezeReason == nil {
+		op.ClearFreezeReason()
+	} else {
+		op.SetFreezeReason(*payload.FreezeReason)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) ProjectDelete(ctx echo.Context, id int) error {
+	return h.client.Project.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) ProjectList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Project.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(project.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+			"Created at",
+			"Updated at",
+			"Deleted at",
+			"Frozen at",
+			"Frozen by",
+			"Freeze reason",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+				res[i].DeletedAt.Format(h.Config.TimeFormat),
+				res[i].FrozenAt.Format(h.Config.TimeFormat),
+				res[i].FrozenBy,
+				res[i].FreezeReason,
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) ProjectGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Project.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	v.Set("deleted_at", entity.DeletedAt.Format(dateTimeFormat))
+	v.Set("frozen_at", entity.FrozenAt.Format(dateTimeFormat))
+	v.Set("frozen_by", entity.FrozenBy)
+	v.Set("freeze_reason", entity.FreezeReason)
+	return v, err
+}
+
+func (h *Handler) RolloutPlanCreate(ctx echo.Context) error {
+	var payload RolloutPlan
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.RolloutPlan.Create()
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	op.SetStrategyID(payload.StrategyID)
+	op.SetSteps(payload.Steps)
+	if payload.CurrentStep != nil {
+		op.SetCurrentStep(*payload.CurrentStep)
+	}
+	if payload.Status != nil {
+		op.SetStatus(*payload.Status)
+	}
+	if payload.StepStartedAt != nil {
+		op.SetStepStartedAt(*payload.StepStartedAt)
+	}
+	if payload.PausedAt != nil {
+		op.SetPausedAt(*payload.PausedAt)
+	}
+	if payload.StatusReason != nil {
+		op.SetStatusReason(*payload.StatusReason)
+	}
+	if payload.CreatedBy != nil {
+		op.SetCreatedBy(*payload.CreatedBy)
+	}
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RolloutPlanUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.RolloutPlan.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload RolloutPlan
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	op.SetStrategyID(payload.StrategyID)
+	op.SetSteps(payload.Steps)
+	if payload.CurrentStep == nil {
+		var empty int
+		op.SetCurrentStep(empty)
+	} else {
+		op.SetCurrentStep(*payload.CurrentStep)
+	}
+	if payload.Status == nil {
+		var empty rolloutplan.Status
+		op.SetStatus(empty)
+	} else {
+		op.SetStatus(*payload.Status)
+	}
+	if payload.StepStartedAt == nil {
+		var empty time.Time
+		op.SetStepStartedAt(empty)
+	} else {
+		op.SetStepStartedAt(*payload.StepStartedAt)
+	}
+	op.SetNillablePausedAt(payload.PausedAt)
+	if payload.StatusReason == nil {
+		op.ClearStatusReason()
+	} else {
+		op.SetStatusReason(*payload.StatusReason)
+	}
+	if payload.CreatedBy == nil {
+		op.ClearCreatedBy()
+	} else {
+		op.SetCreatedBy(*payload.CreatedBy)
+	}
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) RolloutPlanDelete(ctx echo.Context, id int) error {
+	return h.client.RolloutPlan.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) RolloutPlanList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.RolloutPlan.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(rolloutplan.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Flag environment ID",
+			"Strategy ID",
+			"Steps",
+			"Current step",
+			"Status",
+			"Step started at",
+			"Paused at",
+			"Status reason",
+			"Created by",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				fmt.Sprint(res[i].FlagEnvironmentID),
+				fmt.Sprint(res[i].StrategyID),
+				fmt.Sprint(res[i].Steps),
+				fmt.Sprint(res[i].CurrentStep),
+				fmt.Sprint(res[i].Status),
+				res[i].StepStartedAt.Format(h.Config.TimeFormat),
+				res[i].PausedAt.Format(h.Config.TimeFormat),
+				res[i].StatusReason,
+				res[i].CreatedBy,
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) RolloutPlanGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.RolloutPlan.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("flag_environment_id", fmt.Sprint(entity.FlagEnvironmentID))
+	v.Set("strategy_id", fmt.Sprint(entity.StrategyID))
+	v.Set("steps", fmt.Sprint(entity.Steps))
+	v.Set("current_step", fmt.Sprint(entity.CurrentStep))
+	v.Set("status", fmt.Sprint(entity.Status))
+	v.Set("step_started_at", entity.StepStartedAt.Format(dateTimeFormat))
+	v.Set("paused_at", entity.PausedAt.Format(dateTimeFormat))
+	v.Set("status_reason", entity.StatusReason)
+	v.Set("created_by", entity.CreatedBy)
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) StrategyCreate(ctx echo.Context) error {
+	var payload Strategy
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Strategy.Create()
+	op.SetName(payload.Name)
+	if payload.Parameters != nil {
+		op.SetParameters(*payload.Parameters)
+	}
+	if payload.SortOrder != nil {
+		op.SetSortOrder(*payload.SortOrder)
+	}
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) StrategyUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Strategy.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Strategy
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Parameters == nil {
+		op.ClearParameters()
+	} else {
+		op.SetParameters(*payload.Parameters)
+	}
+	if payload.SortOrder == nil {
+		var empty int
+		op.SetSortOrder(empty)
+	} else {
+		op.SetSortOrder(*payload.SortOrder)
+	}
+	op.SetFlagEnvironmentID(payload.FlagEnvironmentID)
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) StrategyDelete(ctx echo.Context, id int) error {
+	return h.client.Strategy.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) StrategyList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Strategy.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(strategy.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Parameters",
+			"Sort order",
+			"Flag environment ID",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				fmt.Sprint(res[i].Parameters),
+				fmt.Sprint(res[i].SortOrder),
+				fmt.Sprint(res[i].FlagEnvironmentID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) StrategyGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Strategy.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("parameters", fmt.Sprint(entity.Parameters))
+	v.Set("sort_order", fmt.Sprint(entity.SortOrder))
+	v.Set("flag_environment_id", fmt.Sprint(entity.FlagEnvironmentID))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) StrategyDefinitionCreate(ctx echo.Context) error {
+	var payload StrategyDefinition
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.StrategyDefinition.Create()
+	op.SetName(payload.Name)
+	if payload.Description != nil {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Parameters != nil {
+		op.SetParameters(*payload.Parameters)
+	}
+	op.SetProjectID(payload.ProjectID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	if payload.UpdatedAt != nil {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) StrategyDefinitionUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.StrategyDefinition.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload StrategyDefinition
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	if payload.Description == nil {
+		op.ClearDescription()
+	} else {
+		op.SetDescription(*payload.Description)
+	}
+	if payload.Parameters == nil {
+		op.ClearParameters()
+	} else {
+		op.SetParameters(*payload.Parameters)
+	}
+	op.SetProjectID(payload.ProjectID)
+	if payload.UpdatedAt == nil {
+		var empty time.Time
+		op.SetUpdatedAt(empty)
+	} else {
+		op.SetUpdatedAt(*payload.UpdatedAt)
+	}
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) StrategyDefinitionDelete(ctx echo.Context, id int) error {
+	return h.client.StrategyDefinition.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) StrategyDefinitionList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.StrategyDefinition.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(strategydefinition.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Description",
+			"Parameters",
+			"Project ID",
+			"Created at",
+			"Updated at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				res[i].Description,
+				fmt.Sprint(res[i].Parameters),
+				fmt.Sprint(res[i].ProjectID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+				res[i].UpdatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) StrategyDefinitionGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.StrategyDefinition.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("description", entity.Description)
+	v.Set("parameters", fmt.Sprint(entity.Parameters))
+	v.Set("project_id", fmt.Sprint(entity.ProjectID))
+	v.Set("updated_at", entity.UpdatedAt.Format(dateTimeFormat))
+	return v, err
+}
+
+func (h *Handler) TagCreate(ctx echo.Context) error {
+	var payload Tag
+	if err := h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := h.client.Tag.Create()
+	op.SetName(payload.Name)
+	op.SetProjectID(payload.ProjectID)
+	if payload.CreatedAt != nil {
+		op.SetCreatedAt(*payload.CreatedAt)
+	}
+	_, err := op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) TagUpdate(ctx echo.Context, id int) error {
+	entity, err := h.client.Tag.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return err
+	}
+
+	var payload Tag
+	if err = h.bind(ctx, &payload); err != nil {
+		return err
+	}
+
+	op := entity.Update()
+	op.SetName(payload.Name)
+	op.SetProjectID(payload.ProjectID)
+	_, err = op.Save(ctx.Request().Context())
+	return err
+}
+
+func (h *Handler) TagDelete(ctx echo.Context, id int) error {
+	return h.client.Tag.DeleteOneID(id).
+		Exec(ctx.Request().Context())
+}
+
+func (h *Handler) TagList(ctx echo.Context) (*EntityList, error) {
+	page, offset := h.getPageAndOffset(ctx)
+	res, err := h.client.Tag.
+		Query().
+		Limit(h.Config.ItemsPerPage + 1).
+		Offset(offset).
+		Order(tag.ByID(sql.OrderDesc())).
+		All(ctx.Request().Context())
+
+	if err != nil {
+		return nil, err
+	}
+
+	list := &EntityList{
+		Columns: []string{
+			"Name",
+			"Project ID",
+			"Created at",
+		},
+		Entities:    make([]EntityValues, 0, len(res)),
+		Page:        page,
+		HasNextPage: len(res) > h.Config.ItemsPerPage,
+	}
+
+	for i := 0; i <= len(res)-1; i++ {
+		list.Entities = append(list.Entities, EntityValues{
+			ID: res[i].ID,
+			Values: []string{
+				res[i].Name,
+				fmt.Sprint(res[i].ProjectID),
+				res[i].CreatedAt.Format(h.Config.TimeFormat),
+			},
+		})
+	}
+
+	return list, err
+}
+
+func (h *Handler) TagGet(ctx echo.Context, id int) (url.Values, error) {
+	entity, err := h.client.Tag.Get(ctx.Request().Context(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	v := url.Values{}
+	v.Set("name", entity.Name)
+	v.Set("project_id", fmt.Sprint(entity.ProjectID))
 	return v, err
 }
 
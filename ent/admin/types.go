@@ -2,12 +2,16 @@
 package admin
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -34,22 +38,41 @@ type Constraint struct {
 	UpdatedAt       *time.Time          `form:"updated_at"`
 }
 
+type ContextField struct {
+	Name        string             `form:"name"`
+	Description *string            `form:"description"`
+	Type        *contextfield.Type `form:"type"`
+	LegalValues *[]string          `form:"legal_values"`
+	ProjectID   int                `form:"project_id"`
+	CreatedAt   *time.Time         `form:"created_at"`
+	UpdatedAt   *time.Time         `form:"updated_at"`
+}
+
 type Environment struct {
-	Name      string           `form:"name"`
-	Type      environment.Type `form:"type"`
-	SortOrder *int             `form:"sort_order"`
-	ProjectID int              `form:"project_id"`
-	CreatedAt *time.Time       `form:"created_at"`
-	UpdatedAt *time.Time       `form:"updated_at"`
+	Name            string           `form:"name"`
+	Type            environment.Type `form:"type"`
+	SortOrder       *int             `form:"sort_order"`
+	ProjectID       int              `form:"project_id"`
+	CreatedAt       *time.Time       `form:"created_at"`
+	UpdatedAt       *time.Time       `form:"updated_at"`
+	ExpectedMatchID *int             `form:"expected_match_id"`
+	DeletedAt       *time.Time       `form:"deleted_at"`
+	FrozenAt        *time.Time       `form:"frozen_at"`
+	FrozenBy        *string          `form:"frozen_by"`
+	FreezeReason    *string          `form:"freeze_reason"`
 }
 
 type Flag struct {
-	Name        string        `form:"name"`
-	Description *string       `form:"description"`
-	FlagType    flag.FlagType `form:"flag_type"`
-	ProjectID   int           `form:"project_id"`
-	CreatedAt   *time.Time    `form:"created_at"`
-	UpdatedAt   *time.Time    `form:"updated_at"`
+	Name        string             `form:"name"`
+	Description *string            `form:"description"`
+	FlagType    flag.FlagType      `form:"flag_type"`
+	ProjectID   int                `form:"project_id"`
+	CreatedAt   *time.Time         `form:"created_at"`
+	UpdatedAt   *time.Time         `form:"updated_at"`
+	DeletedAt   *time.Time         `form:"deleted_at"`
+	Owner       *string            `form:"owner"`
+	Links       *[]schema.Link     `form:"links"`
+	Metadata    *map[string]string `form:"metadata"`
 }
 
 type FlagEnvironment struct {
@@ -58,13 +81,49 @@ type FlagEnvironment struct {
 	EnvironmentID int        `form:"environment_id"`
 	CreatedAt     *time.Time `form:"created_at"`
 	UpdatedAt     *time.Time `form:"updated_at"`
+	Revision      *int       `form:"revision"`
+}
+
+type FlagEnvironmentVersion struct {
+	FlagEnvironmentID int             `form:"flag_environment_id"`
+	Version           int             `form:"version"`
+	Snapshot          json.RawMessage `form:"snapshot"`
+	Actor             *string         `form:"actor"`
+	CreatedAt         *time.Time      `form:"created_at"`
+	Reason            *string         `form:"reason"`
+}
+
+type Prerequisite struct {
+	FlagEnvironmentID int        `form:"flag_environment_id"`
+	ParentFlagID      int        `form:"parent_flag_id"`
+	Enabled           bool       `form:"enabled"`
+	Variant           *string    `form:"variant"`
+	CreatedAt         *time.Time `form:"created_at"`
 }
 
 type Project struct {
-	Name        string     `form:"name"`
-	Description *string    `form:"description"`
-	CreatedAt   *time.Time `form:"created_at"`
-	UpdatedAt   *time.Time `form:"updated_at"`
+	Name         string     `form:"name"`
+	Description  *string    `form:"description"`
+	CreatedAt    *time.Time `form:"created_at"`
+	UpdatedAt    *time.Time `form:"updated_at"`
+	DeletedAt    *time.Time `form:"deleted_at"`
+	FrozenAt     *time.Time `form:"frozen_at"`
+	FrozenBy     *string    `form:"frozen_by"`
+	FreezeReason *string    `form:"freeze_reason"`
+}
+
+type RolloutPlan struct {
+	FlagEnvironmentID int                  `form:"flag_environment_id"`
+	StrategyID        int                  `form:"strategy_id"`
+	Steps             []schema.RolloutStep `form:"steps"`
+	CurrentStep       *int                 `form:"current_step"`
+	Status            *rolloutplan.Status  `form:"status"`
+	StepStartedAt     *time.Time           `form:"step_started_at"`
+	PausedAt          *time.Time           `form:"paused_at"`
+	StatusReason      *string              `form:"status_reason"`
+	CreatedBy         *string              `form:"created_by"`
+	CreatedAt         *time.Time           `form:"created_at"`
+	UpdatedAt         *time.Time           `form:"updated_at"`
 }
 
 type Strategy struct {
@@ -76,6 +135,21 @@ type Strategy struct {
 	UpdatedAt         *time.Time              `form:"updated_at"`
 }
 
+type StrategyDefinition struct {
+	Name        string                      `form:"name"`
+	Description *string                     `form:"description"`
+	Parameters  *[]schema.StrategyParameter `form:"parameters"`
+	ProjectID   int                         `form:"project_id"`
+	CreatedAt   *time.Time                  `form:"created_at"`
+	UpdatedAt   *time.Time                  `form:"updated_at"`
+}
+
+type Tag struct {
+	Name      string     `form:"name"`
+	ProjectID int        `form:"project_id"`
+	CreatedAt *time.Time `form:"created_at"`
+}
+
 type User struct {
 	Email     string     `form:"email"`
 	Password  *string    `form:"password"`
@@ -107,11 +181,17 @@ func GetEntityTypeNames() []string {
 	return []string{
 		"ApiToken",
 		"Constraint",
+		"ContextField",
 		"Environment",
 		"Flag",
 		"FlagEnvironment",
+		"FlagEnvironmentVersion",
+		"Prerequisite",
 		"Project",
+		"RolloutPlan",
 		"Strategy",
+		"StrategyDefinition",
+		"Tag",
 		"User",
 	}
 }
@@ -17,11 +17,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -34,16 +40,28 @@ type Client struct {
 	ApiToken *ApiTokenClient
 	// Constraint is the client for interacting with the Constraint builders.
 	Constraint *ConstraintClient
+	// ContextField is the client for interacting with the ContextField builders.
+	ContextField *ContextFieldClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
 	// Flag is the client for interacting with the Flag builders.
 	Flag *FlagClient
 	// FlagEnvironment is the client for interacting with the FlagEnvironment builders.
 	FlagEnvironment *FlagEnvironmentClient
+	// FlagEnvironmentVersion is the client for interacting with the FlagEnvironmentVersion builders.
+	FlagEnvironmentVersion *FlagEnvironmentVersionClient
+	// Prerequisite is the client for interacting with the Prerequisite builders.
+	Prerequisite *PrerequisiteClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// RolloutPlan is the client for interacting with the RolloutPlan builders.
+	RolloutPlan *RolloutPlanClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
+	// StrategyDefinition is the client for interacting with the StrategyDefinition builders.
+	StrategyDefinition *StrategyDefinitionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -59,11 +77,17 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ApiToken = NewApiTokenClient(c.config)
 	c.Constraint = NewConstraintClient(c.config)
+	c.ContextField = NewContextFieldClient(c.config)
 	c.Environment = NewEnvironmentClient(c.config)
 	c.Flag = NewFlagClient(c.config)
 	c.FlagEnvironment = NewFlagEnvironmentClient(c.config)
+	c.FlagEnvironmentVersion = NewFlagEnvironmentVersionClient(c.config)
+	c.Prerequisite = NewPrerequisiteClient(c.config)
 	c.Project = NewProjectClient(c.config)
+	c.RolloutPlan = NewRolloutPlanClient(c.config)
 	c.Strategy = NewStrategyClient(c.config)
+	c.StrategyDefinition = NewStrategyDefinitionClient(c.config)
+	c.Tag = NewTagClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -155,16 +179,22 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		ApiToken:        NewApiTokenClient(cfg),
-		Constraint:      NewConstraintClient(cfg),
-		Environment:     NewEnvironmentClient(cfg),
-		Flag:            NewFlagClient(cfg),
-		FlagEnvironment: NewFlagEnvironmentClient(cfg),
-		Project:         NewProjectClient(cfg),
-		Strategy:        NewStrategyClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		ApiToken:               NewApiTokenClient(cfg),
+		Constraint:             NewConstraintClient(cfg),
+		ContextField:           NewContextFieldClient(cfg),
+		Environment:            NewEnvironmentClient(cfg),
+		Flag:                   NewFlagClient(cfg),
+		FlagEnvironment:        NewFlagEnvironmentClient(cfg),
+		FlagEnvironmentVersion: NewFlagEnvironmentVersionClient(cfg),
+		Prerequisite:           NewPrerequisiteClient(cfg),
+		Project:                NewProjectClient(cfg),
+		RolloutPlan:            NewRolloutPlanClient(cfg),
+		Strategy:               NewStrategyClient(cfg),
+		StrategyDefinition:     NewStrategyDefinitionClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -182,16 +212,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:             ctx,
-		config:          cfg,
-		ApiToken:        NewApiTokenClient(cfg),
-		Constraint:      NewConstraintClient(cfg),
-		Environment:     NewEnvironmentClient(cfg),
-		Flag:            NewFlagClient(cfg),
-		FlagEnvironment: NewFlagEnvironmentClient(cfg),
-		Project:         NewProjectClient(cfg),
-		Strategy:        NewStrategyClient(cfg),
-		User:            NewUserClient(cfg),
+		ctx:                    ctx,
+		config:                 cfg,
+		ApiToken:               NewApiTokenClient(cfg),
+		Constraint:             NewConstraintClient(cfg),
+		ContextField:           NewContextFieldClient(cfg),
+		Environment:            NewEnvironmentClient(cfg),
+		Flag:                   NewFlagClient(cfg),
+		FlagEnvironment:        NewFlagEnvironmentClient(cfg),
+		FlagEnvironmentVersion: NewFlagEnvironmentVersionClient(cfg),
+		Prerequisite:           NewPrerequisiteClient(cfg),
+		Project:                NewProjectClient(cfg),
+		RolloutPlan:            NewRolloutPlanClient(cfg),
+		Strategy:               NewStrategyClient(cfg),
+		StrategyDefinition:     NewStrategyDefinitionClient(cfg),
+		Tag:                    NewTagClient(cfg),
+		User:                   NewUserClient(cfg),
 	}, nil
 }
 
@@ -221,8 +257,9 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ApiToken, c.Constraint, c.Environment, c.Flag, c.FlagEnvironment, c.Project,
-		c.Strategy, c.User,
+		c.ApiToken, c.Constraint, c.ContextField, c.Environment, c.Flag,
+		c.FlagEnvironment, c.FlagEnvironmentVersion, c.Prerequisite, c.Project,
+		c.RolloutPlan, c.Strategy, c.StrategyDefinition, c.Tag, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -232,8 +269,9 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ApiToken, c.Constraint, c.Environment, c.Flag, c.FlagEnvironment, c.Project,
-		c.Strategy, c.User,
+		c.ApiToken, c.Constraint, c.ContextField, c.Environment, c.Flag,
+		c.FlagEnvironment, c.FlagEnvironmentVersion, c.Prerequisite, c.Project,
+		c.RolloutPlan, c.Strategy, c.StrategyDefinition, c.Tag, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -246,16 +284,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ApiToken.mutate(ctx, m)
 	case *ConstraintMutation:
 		return c.Constraint.mutate(ctx, m)
+	case *ContextFieldMutation:
+		return c.ContextField.mutate(ctx, m)
 	case *EnvironmentMutation:
 		return c.Environment.mutate(ctx, m)
 	case *FlagMutation:
 		return c.Flag.mutate(ctx, m)
 	case *FlagEnvironmentMutation:
 		return c.FlagEnvironment.mutate(ctx, m)
+	case *FlagEnvironmentVersionMutation:
+		return c.FlagEnvironmentVersion.mutate(ctx, m)
+	case *PrerequisiteMutation:
+		return c.Prerequisite.mutate(ctx, m)
 	case *ProjectMutation:
 		return c.Project.mutate(ctx, m)
+	case *RolloutPlanMutation:
+		return c.RolloutPlan.mutate(ctx, m)
 	case *StrategyMutation:
 		return c.Strategy.mutate(ctx, m)
+	case *StrategyDefinitionMutation:
+		return c.StrategyDefinition.mutate(ctx, m)
+	case *TagMutation:
+		return c.Tag.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -577,6 +627,155 @@ func (c *ConstraintClient) mutate(ctx context.Context, m *ConstraintMutation) (V
 	}
 }
 
+// ContextFieldClient is a client for the ContextField schema.
+type ContextFieldClient struct {
+	config
+}
+
+// NewContextFieldClient returns a client for the ContextField from the given config.
+func NewContextFieldClient(c config) *ContextFieldClient {
+	return &ContextFieldClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `contextfield.Hooks(f(g(h())))`.
+func (c *ContextFieldClient) Use(hooks ...Hook) {
+	c.hooks.ContextField = append(c.hooks.ContextField, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `contextfield.Intercept(f(g(h())))`.
+func (c *ContextFieldClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ContextField = append(c.inters.ContextField, interceptors...)
+}
+
+// Create returns a builder for creating a ContextField entity.
+func (c *ContextFieldClient) Create() *ContextFieldCreate {
+	mutation := newContextFieldMutation(c.config, OpCreate)
+	return &ContextFieldCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ContextField entities.
+func (c *ContextFieldClient) CreateBulk(builders ...*ContextFieldCreate) *ContextFieldCreateBulk {
+	return &ContextFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ContextFieldClient) MapCreateBulk(slice any, setFunc func(*ContextFieldCreate, int)) *ContextFieldCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ContextFieldCreateBulk{err: fmt.Errorf("calling to ContextFieldClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ContextFieldCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ContextFieldCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ContextField.
+func (c *ContextFieldClient) Update() *ContextFieldUpdate {
+	mutation := newContextFieldMutation(c.config, OpUpdate)
+	return &ContextFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ContextFieldClient) UpdateOne(_m *ContextField) *ContextFieldUpdateOne {
+	mutation := newContextFieldMutation(c.config, OpUpdateOne, withContextField(_m))
+	return &ContextFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ContextFieldClient) UpdateOneID(id int) *ContextFieldUpdateOne {
+	mutation := newContextFieldMutation(c.config, OpUpdateOne, withContextFieldID(id))
+	return &ContextFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ContextField.
+func (c *ContextFieldClient) Delete() *ContextFieldDelete {
+	mutation := newContextFieldMutation(c.config, OpDelete)
+	return &ContextFieldDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ContextFieldClient) DeleteOne(_m *ContextField) *ContextFieldDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ContextFieldClient) DeleteOneID(id int) *ContextFieldDeleteOne {
+	builder := c.Delete().Where(contextfield.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ContextFieldDeleteOne{builder}
+}
+
+// Query returns a query builder for ContextField.
+func (c *ContextFieldClient) Query() *ContextFieldQuery {
+	return &ContextFieldQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeContextField},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ContextField entity by its id.
+func (c *ContextFieldClient) Get(ctx context.Context, id int) (*ContextField, error) {
+	return c.Query().Where(contextfield.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ContextFieldClient) GetX(ctx context.Context, id int) *ContextField {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a ContextField.
+func (c *ContextFieldClient) QueryProject(_m *ContextField) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(contextfield.Table, contextfield.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, contextfield.ProjectTable, contextfield.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ContextFieldClient) Hooks() []Hook {
+	return c.hooks.ContextField
+}
+
+// Interceptors returns the client interceptors.
+func (c *ContextFieldClient) Interceptors() []Interceptor {
+	return c.inters.ContextField
+}
+
+func (c *ContextFieldClient) mutate(ctx context.Context, m *ContextFieldMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ContextFieldCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ContextFieldUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ContextFieldUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ContextFieldDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ContextField mutation op: %q", m.Op())
+	}
+}
+
 // EnvironmentClient is a client for the Environment schema.
 type EnvironmentClient struct {
 	config
@@ -717,6 +916,38 @@ func (c *EnvironmentClient) QueryFlagEnvironments(_m *Environment) *FlagEnvironm
 	return query
 }
 
+// QueryExpectedMatch queries the expected_match edge of a Environment.
+func (c *EnvironmentClient) QueryExpectedMatch(_m *Environment) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, environment.ExpectedMatchTable, environment.ExpectedMatchColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryMatchedBy queries the matched_by edge of a Environment.
+func (c *EnvironmentClient) QueryMatchedBy(_m *Environment) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.MatchedByTable, environment.MatchedByColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *EnvironmentClient) Hooks() []Hook {
 	return c.hooks.Environment
@@ -882,6 +1113,38 @@ func (c *FlagClient) QueryFlagEnvironments(_m *Flag) *FlagEnvironmentQuery {
 	return query
 }
 
+// QueryDependents queries the dependents edge of a Flag.
+func (c *FlagClient) QueryDependents(_m *Flag) *PrerequisiteQuery {
+	query := (&PrerequisiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flag.Table, flag.FieldID, id),
+			sqlgraph.To(prerequisite.Table, prerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flag.DependentsTable, flag.DependentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Flag.
+func (c *FlagClient) QueryTags(_m *Flag) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flag.Table, flag.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, flag.TagsTable, flag.TagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FlagClient) Hooks() []Hook {
 	return c.hooks.Flag
@@ -1063,6 +1326,54 @@ func (c *FlagEnvironmentClient) QueryStrategies(_m *FlagEnvironment) *StrategyQu
 	return query
 }
 
+// QueryPrerequisites queries the prerequisites edge of a FlagEnvironment.
+func (c *FlagEnvironmentClient) QueryPrerequisites(_m *FlagEnvironment) *PrerequisiteQuery {
+	query := (&PrerequisiteClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, id),
+			sqlgraph.To(prerequisite.Table, prerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.PrerequisitesTable, flagenvironment.PrerequisitesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVersions queries the versions edge of a FlagEnvironment.
+func (c *FlagEnvironmentClient) QueryVersions(_m *FlagEnvironment) *FlagEnvironmentVersionQuery {
+	query := (&FlagEnvironmentVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, id),
+			sqlgraph.To(flagenvironmentversion.Table, flagenvironmentversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.VersionsTable, flagenvironment.VersionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRolloutPlans queries the rollout_plans edge of a FlagEnvironment.
+func (c *FlagEnvironmentClient) QueryRolloutPlans(_m *FlagEnvironment) *RolloutPlanQuery {
+	query := (&RolloutPlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, id),
+			sqlgraph.To(rolloutplan.Table, rolloutplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.RolloutPlansTable, flagenvironment.RolloutPlansColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *FlagEnvironmentClient) Hooks() []Hook {
 	return c.hooks.FlagEnvironment
@@ -1088,107 +1399,107 @@ func (c *FlagEnvironmentClient) mutate(ctx context.Context, m *FlagEnvironmentMu
 	}
 }
 
-// ProjectClient is a client for the Project schema.
-type ProjectClient struct {
+// FlagEnvironmentVersionClient is a client for the FlagEnvironmentVersion schema.
+type FlagEnvironmentVersionClient struct {
 	config
 }
 
-// NewProjectClient returns a client for the Project from the given config.
-func NewProjectClient(c config) *ProjectClient {
-	return &ProjectClient{config: c}
+// NewFlagEnvironmentVersionClient returns a client for the FlagEnvironmentVersion from the given config.
+func NewFlagEnvironmentVersionClient(c config) *FlagEnvironmentVersionClient {
+	return &FlagEnvironmentVersionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
-func (c *ProjectClient) Use(hooks ...Hook) {
-	c.hooks.Project = append(c.hooks.Project, hooks...)
+// A call to `Use(f, g, h)` equals to `flagenvironmentversion.Hooks(f(g(h())))`.
+func (c *FlagEnvironmentVersionClient) Use(hooks ...Hook) {
+	c.hooks.FlagEnvironmentVersion = append(c.hooks.FlagEnvironmentVersion, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
-func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Project = append(c.inters.Project, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `flagenvironmentversion.Intercept(f(g(h())))`.
+func (c *FlagEnvironmentVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.FlagEnvironmentVersion = append(c.inters.FlagEnvironmentVersion, interceptors...)
 }
 
-// Create returns a builder for creating a Project entity.
-func (c *ProjectClient) Create() *ProjectCreate {
-	mutation := newProjectMutation(c.config, OpCreate)
-	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a FlagEnvironmentVersion entity.
+func (c *FlagEnvironmentVersionClient) Create() *FlagEnvironmentVersionCreate {
+	mutation := newFlagEnvironmentVersionMutation(c.config, OpCreate)
+	return &FlagEnvironmentVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Project entities.
-func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of FlagEnvironmentVersion entities.
+func (c *FlagEnvironmentVersionClient) CreateBulk(builders ...*FlagEnvironmentVersionCreate) *FlagEnvironmentVersionCreateBulk {
+	return &FlagEnvironmentVersionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+func (c *FlagEnvironmentVersionClient) MapCreateBulk(slice any, setFunc func(*FlagEnvironmentVersionCreate, int)) *FlagEnvironmentVersionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &FlagEnvironmentVersionCreateBulk{err: fmt.Errorf("calling to FlagEnvironmentVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ProjectCreate, rv.Len())
+	builders := make([]*FlagEnvironmentVersionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ProjectCreateBulk{config: c.config, builders: builders}
+	return &FlagEnvironmentVersionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Project.
-func (c *ProjectClient) Update() *ProjectUpdate {
-	mutation := newProjectMutation(c.config, OpUpdate)
-	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for FlagEnvironmentVersion.
+func (c *FlagEnvironmentVersionClient) Update() *FlagEnvironmentVersionUpdate {
+	mutation := newFlagEnvironmentVersionMutation(c.config, OpUpdate)
+	return &FlagEnvironmentVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ProjectClient) UpdateOne(_m *Project) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(_m))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FlagEnvironmentVersionClient) UpdateOne(_m *FlagEnvironmentVersion) *FlagEnvironmentVersionUpdateOne {
+	mutation := newFlagEnvironmentVersionMutation(c.config, OpUpdateOne, withFlagEnvironmentVersion(_m))
+	return &FlagEnvironmentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
-	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
-	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *FlagEnvironmentVersionClient) UpdateOneID(id int) *FlagEnvironmentVersionUpdateOne {
+	mutation := newFlagEnvironmentVersionMutation(c.config, OpUpdateOne, withFlagEnvironmentVersionID(id))
+	return &FlagEnvironmentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Project.
-func (c *ProjectClient) Delete() *ProjectDelete {
-	mutation := newProjectMutation(c.config, OpDelete)
-	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for FlagEnvironmentVersion.
+func (c *FlagEnvironmentVersionClient) Delete() *FlagEnvironmentVersionDelete {
+	mutation := newFlagEnvironmentVersionMutation(c.config, OpDelete)
+	return &FlagEnvironmentVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ProjectClient) DeleteOne(_m *Project) *ProjectDeleteOne {
+func (c *FlagEnvironmentVersionClient) DeleteOne(_m *FlagEnvironmentVersion) *FlagEnvironmentVersionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
-	builder := c.Delete().Where(project.ID(id))
+func (c *FlagEnvironmentVersionClient) DeleteOneID(id int) *FlagEnvironmentVersionDeleteOne {
+	builder := c.Delete().Where(flagenvironmentversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ProjectDeleteOne{builder}
+	return &FlagEnvironmentVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for Project.
-func (c *ProjectClient) Query() *ProjectQuery {
-	return &ProjectQuery{
+// Query returns a query builder for FlagEnvironmentVersion.
+func (c *FlagEnvironmentVersionClient) Query() *FlagEnvironmentVersionQuery {
+	return &FlagEnvironmentVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeProject},
+		ctx:    &QueryContext{Type: TypeFlagEnvironmentVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Project entity by its id.
-func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
-	return c.Query().Where(project.ID(id)).Only(ctx)
+// Get returns a FlagEnvironmentVersion entity by its id.
+func (c *FlagEnvironmentVersionClient) Get(ctx context.Context, id int) (*FlagEnvironmentVersion, error) {
+	return c.Query().Where(flagenvironmentversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+func (c *FlagEnvironmentVersionClient) GetX(ctx context.Context, id int) *FlagEnvironmentVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1196,15 +1507,15 @@ func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
 	return obj
 }
 
-// QueryEnvironments queries the environments edge of a Project.
-func (c *ProjectClient) QueryEnvironments(_m *Project) *EnvironmentQuery {
-	query := (&EnvironmentClient{config: c.config}).Query()
+// QueryFlagEnvironment queries the flag_environment edge of a FlagEnvironmentVersion.
+func (c *FlagEnvironmentVersionClient) QueryFlagEnvironment(_m *FlagEnvironmentVersion) *FlagEnvironmentQuery {
+	query := (&FlagEnvironmentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(environment.Table, environment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.EnvironmentsTable, project.EnvironmentsColumn),
+			sqlgraph.From(flagenvironmentversion.Table, flagenvironmentversion.FieldID, id),
+			sqlgraph.To(flagenvironment.Table, flagenvironment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, flagenvironmentversion.FlagEnvironmentTable, flagenvironmentversion.FlagEnvironmentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1212,60 +1523,571 @@ func (c *ProjectClient) QueryEnvironments(_m *Project) *EnvironmentQuery {
 	return query
 }
 
-// QueryFlags queries the flags edge of a Project.
-func (c *ProjectClient) QueryFlags(_m *Project) *FlagQuery {
-	query := (&FlagClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(flag.Table, flag.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.FlagsTable, project.FlagsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *FlagEnvironmentVersionClient) Hooks() []Hook {
+	return c.hooks.FlagEnvironmentVersion
 }
 
-// QueryAPITokens queries the api_tokens edge of a Project.
-func (c *ProjectClient) QueryAPITokens(_m *Project) *ApiTokenQuery {
-	query := (&ApiTokenClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(project.Table, project.FieldID, id),
-			sqlgraph.To(apitoken.Table, apitoken.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, project.APITokensTable, project.APITokensColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *FlagEnvironmentVersionClient) Interceptors() []Interceptor {
+	return c.inters.FlagEnvironmentVersion
 }
 
-// Hooks returns the client hooks.
-func (c *ProjectClient) Hooks() []Hook {
+func (c *FlagEnvironmentVersionClient) mutate(ctx context.Context, m *FlagEnvironmentVersionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&FlagEnvironmentVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&FlagEnvironmentVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&FlagEnvironmentVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&FlagEnvironmentVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown FlagEnvironmentVersion mutation op: %q", m.Op())
+	}
+}
+
+// PrerequisiteClient is a client for the Prerequisite schema.
+type PrerequisiteClient struct {
+	config
+}
+
+// NewPrerequisiteClient returns a client for the Prerequisite from the given config.
+func NewPrerequisiteClient(c config) *PrerequisiteClient {
+	return &PrerequisiteClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `prerequisite.Hooks(f(g(h())))`.
+func (c *PrerequisiteClient) Use(hooks ...Hook) {
+	c.hooks.Prerequisite = append(c.hooks.Prerequisite, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `prerequisite.Intercept(f(g(h())))`.
+func (c *PrerequisiteClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Prerequisite = append(c.inters.Prerequisite, interceptors...)
+}
+
+// Create returns a builder for creating a Prerequisite entity.
+func (c *PrerequisiteClient) Create() *PrerequisiteCreate {
+	mutation := newPrerequisiteMutation(c.config, OpCreate)
+	return &PrerequisiteCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Prerequisite entities.
+func (c *PrerequisiteClient) CreateBulk(builders ...*PrerequisiteCreate) *PrerequisiteCreateBulk {
+	return &PrerequisiteCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PrerequisiteClient) MapCreateBulk(slice any, setFunc func(*PrerequisiteCreate, int)) *PrerequisiteCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PrerequisiteCreateBulk{err: fmt.Errorf("calling to PrerequisiteClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PrerequisiteCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PrerequisiteCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Prerequisite.
+func (c *PrerequisiteClient) Update() *PrerequisiteUpdate {
+	mutation := newPrerequisiteMutation(c.config, OpUpdate)
+	return &PrerequisiteUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PrerequisiteClient) UpdateOne(_m *Prerequisite) *PrerequisiteUpdateOne {
+	mutation := newPrerequisiteMutation(c.config, OpUpdateOne, withPrerequisite(_m))
+	return &PrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PrerequisiteClient) UpdateOneID(id int) *PrerequisiteUpdateOne {
+	mutation := newPrerequisiteMutation(c.config, OpUpdateOne, withPrerequisiteID(id))
+	return &PrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Prerequisite.
+func (c *PrerequisiteClient) Delete() *PrerequisiteDelete {
+	mutation := newPrerequisiteMutation(c.config, OpDelete)
+	return &PrerequisiteDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PrerequisiteClient) DeleteOne(_m *Prerequisite) *PrerequisiteDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PrerequisiteClient) DeleteOneID(id int) *PrerequisiteDeleteOne {
+	builder := c.Delete().Where(prerequisite.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PrerequisiteDeleteOne{builder}
+}
+
+// Query returns a query builder for Prerequisite.
+func (c *PrerequisiteClient) Query() *PrerequisiteQuery {
+	return &PrerequisiteQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePrerequisite},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Prerequisite entity by its id.
+func (c *PrerequisiteClient) Get(ctx context.Context, id int) (*Prerequisite, error) {
+	return c.Query().Where(prerequisite.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PrerequisiteClient) GetX(ctx context.Context, id int) *Prerequisite {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlagEnvironment queries the flag_environment edge of a Prerequisite.
+func (c *PrerequisiteClient) QueryFlagEnvironment(_m *Prerequisite) *FlagEnvironmentQuery {
+	query := (&FlagEnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(prerequisite.Table, prerequisite.FieldID, id),
+			sqlgraph.To(flagenvironment.Table, flagenvironment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, prerequisite.FlagEnvironmentTable, prerequisite.FlagEnvironmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParentFlag queries the parent_flag edge of a Prerequisite.
+func (c *PrerequisiteClient) QueryParentFlag(_m *Prerequisite) *FlagQuery {
+	query := (&FlagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(prerequisite.Table, prerequisite.FieldID, id),
+			sqlgraph.To(flag.Table, flag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, prerequisite.ParentFlagTable, prerequisite.ParentFlagColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PrerequisiteClient) Hooks() []Hook {
+	return c.hooks.Prerequisite
+}
+
+// Interceptors returns the client interceptors.
+func (c *PrerequisiteClient) Interceptors() []Interceptor {
+	return c.inters.Prerequisite
+}
+
+func (c *PrerequisiteClient) mutate(ctx context.Context, m *PrerequisiteMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PrerequisiteCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PrerequisiteUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PrerequisiteUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PrerequisiteDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Prerequisite mutation op: %q", m.Op())
+	}
+}
+
+// ProjectClient is a client for the Project schema.
+type ProjectClient struct {
+	config
+}
+
+// NewProjectClient returns a client for the Project from the given config.
+func NewProjectClient(c config) *ProjectClient {
+	return &ProjectClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `project.Hooks(f(g(h())))`.
+func (c *ProjectClient) Use(hooks ...Hook) {
+	c.hooks.Project = append(c.hooks.Project, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `project.Intercept(f(g(h())))`.
+func (c *ProjectClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Project = append(c.inters.Project, interceptors...)
+}
+
+// Create returns a builder for creating a Project entity.
+func (c *ProjectClient) Create() *ProjectCreate {
+	mutation := newProjectMutation(c.config, OpCreate)
+	return &ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Project entities.
+func (c *ProjectClient) CreateBulk(builders ...*ProjectCreate) *ProjectCreateBulk {
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProjectClient) MapCreateBulk(slice any, setFunc func(*ProjectCreate, int)) *ProjectCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProjectCreateBulk{err: fmt.Errorf("calling to ProjectClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProjectCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProjectCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Project.
+func (c *ProjectClient) Update() *ProjectUpdate {
+	mutation := newProjectMutation(c.config, OpUpdate)
+	return &ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProjectClient) UpdateOne(_m *Project) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProject(_m))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProjectClient) UpdateOneID(id int) *ProjectUpdateOne {
+	mutation := newProjectMutation(c.config, OpUpdateOne, withProjectID(id))
+	return &ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Project.
+func (c *ProjectClient) Delete() *ProjectDelete {
+	mutation := newProjectMutation(c.config, OpDelete)
+	return &ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProjectClient) DeleteOne(_m *Project) *ProjectDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProjectClient) DeleteOneID(id int) *ProjectDeleteOne {
+	builder := c.Delete().Where(project.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProjectDeleteOne{builder}
+}
+
+// Query returns a query builder for Project.
+func (c *ProjectClient) Query() *ProjectQuery {
+	return &ProjectQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProject},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Project entity by its id.
+func (c *ProjectClient) Get(ctx context.Context, id int) (*Project, error) {
+	return c.Query().Where(project.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProjectClient) GetX(ctx context.Context, id int) *Project {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryEnvironments queries the environments edge of a Project.
+func (c *ProjectClient) QueryEnvironments(_m *Project) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.EnvironmentsTable, project.EnvironmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFlags queries the flags edge of a Project.
+func (c *ProjectClient) QueryFlags(_m *Project) *FlagQuery {
+	query := (&FlagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(flag.Table, flag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.FlagsTable, project.FlagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAPITokens queries the api_tokens edge of a Project.
+func (c *ProjectClient) QueryAPITokens(_m *Project) *ApiTokenQuery {
+	query := (&ApiTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(apitoken.Table, apitoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.APITokensTable, project.APITokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTags queries the tags edge of a Project.
+func (c *ProjectClient) QueryTags(_m *Project) *TagQuery {
+	query := (&TagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TagsTable, project.TagsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStrategyDefinitions queries the strategy_definitions edge of a Project.
+func (c *ProjectClient) QueryStrategyDefinitions(_m *Project) *StrategyDefinitionQuery {
+	query := (&StrategyDefinitionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(strategydefinition.Table, strategydefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.StrategyDefinitionsTable, project.StrategyDefinitionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryContextFields queries the context_fields edge of a Project.
+func (c *ProjectClient) QueryContextFields(_m *Project) *ContextFieldQuery {
+	query := (&ContextFieldClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, id),
+			sqlgraph.To(contextfield.Table, contextfield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ContextFieldsTable, project.ContextFieldsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ProjectClient) Hooks() []Hook {
 	return c.hooks.Project
 }
 
 // Interceptors returns the client interceptors.
-func (c *ProjectClient) Interceptors() []Interceptor {
-	return c.inters.Project
+func (c *ProjectClient) Interceptors() []Interceptor {
+	return c.inters.Project
+}
+
+func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+	}
+}
+
+// RolloutPlanClient is a client for the RolloutPlan schema.
+type RolloutPlanClient struct {
+	config
+}
+
+// NewRolloutPlanClient returns a client for the RolloutPlan from the given config.
+func NewRolloutPlanClient(c config) *RolloutPlanClient {
+	return &RolloutPlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rolloutplan.Hooks(f(g(h())))`.
+func (c *RolloutPlanClient) Use(hooks ...Hook) {
+	c.hooks.RolloutPlan = append(c.hooks.RolloutPlan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rolloutplan.Intercept(f(g(h())))`.
+func (c *RolloutPlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RolloutPlan = append(c.inters.RolloutPlan, interceptors...)
+}
+
+// Create returns a builder for creating a RolloutPlan entity.
+func (c *RolloutPlanClient) Create() *RolloutPlanCreate {
+	mutation := newRolloutPlanMutation(c.config, OpCreate)
+	return &RolloutPlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RolloutPlan entities.
+func (c *RolloutPlanClient) CreateBulk(builders ...*RolloutPlanCreate) *RolloutPlanCreateBulk {
+	return &RolloutPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RolloutPlanClient) MapCreateBulk(slice any, setFunc func(*RolloutPlanCreate, int)) *RolloutPlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RolloutPlanCreateBulk{err: fmt.Errorf("calling to RolloutPlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RolloutPlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RolloutPlanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RolloutPlan.
+func (c *RolloutPlanClient) Update() *RolloutPlanUpdate {
+	mutation := newRolloutPlanMutation(c.config, OpUpdate)
+	return &RolloutPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RolloutPlanClient) UpdateOne(_m *RolloutPlan) *RolloutPlanUpdateOne {
+	mutation := newRolloutPlanMutation(c.config, OpUpdateOne, withRolloutPlan(_m))
+	return &RolloutPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RolloutPlanClient) UpdateOneID(id int) *RolloutPlanUpdateOne {
+	mutation := newRolloutPlanMutation(c.config, OpUpdateOne, withRolloutPlanID(id))
+	return &RolloutPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RolloutPlan.
+func (c *RolloutPlanClient) Delete() *RolloutPlanDelete {
+	mutation := newRolloutPlanMutation(c.config, OpDelete)
+	return &RolloutPlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RolloutPlanClient) DeleteOne(_m *RolloutPlan) *RolloutPlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RolloutPlanClient) DeleteOneID(id int) *RolloutPlanDeleteOne {
+	builder := c.Delete().Where(rolloutplan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RolloutPlanDeleteOne{builder}
+}
+
+// Query returns a query builder for RolloutPlan.
+func (c *RolloutPlanClient) Query() *RolloutPlanQuery {
+	return &RolloutPlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRolloutPlan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RolloutPlan entity by its id.
+func (c *RolloutPlanClient) Get(ctx context.Context, id int) (*RolloutPlan, error) {
+	return c.Query().Where(rolloutplan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RolloutPlanClient) GetX(ctx context.Context, id int) *RolloutPlan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFlagEnvironment queries the flag_environment edge of a RolloutPlan.
+func (c *RolloutPlanClient) QueryFlagEnvironment(_m *RolloutPlan) *FlagEnvironmentQuery {
+	query := (&FlagEnvironmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(rolloutplan.Table, rolloutplan.FieldID, id),
+			sqlgraph.To(flagenvironment.Table, flagenvironment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, rolloutplan.FlagEnvironmentTable, rolloutplan.FlagEnvironmentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RolloutPlanClient) Hooks() []Hook {
+	return c.hooks.RolloutPlan
+}
+
+// Interceptors returns the client interceptors.
+func (c *RolloutPlanClient) Interceptors() []Interceptor {
+	return c.inters.RolloutPlan
 }
 
-func (c *ProjectClient) mutate(ctx context.Context, m *ProjectMutation) (Value, error) {
+func (c *RolloutPlanClient) mutate(ctx context.Context, m *RolloutPlanMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ProjectCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolloutPlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ProjectUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolloutPlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ProjectUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RolloutPlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ProjectDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RolloutPlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Project mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RolloutPlan mutation op: %q", m.Op())
 	}
 }
 
@@ -1434,6 +2256,320 @@ func (c *StrategyClient) mutate(ctx context.Context, m *StrategyMutation) (Value
 	}
 }
 
+// StrategyDefinitionClient is a client for the StrategyDefinition schema.
+type StrategyDefinitionClient struct {
+	config
+}
+
+// NewStrategyDefinitionClient returns a client for the StrategyDefinition from the given config.
+func NewStrategyDefinitionClient(c config) *StrategyDefinitionClient {
+	return &StrategyDefinitionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `strategydefinition.Hooks(f(g(h())))`.
+func (c *StrategyDefinitionClient) Use(hooks ...Hook) {
+	c.hooks.StrategyDefinition = append(c.hooks.StrategyDefinition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `strategydefinition.Intercept(f(g(h())))`.
+func (c *StrategyDefinitionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.StrategyDefinition = append(c.inters.StrategyDefinition, interceptors...)
+}
+
+// Create returns a builder for creating a StrategyDefinition entity.
+func (c *StrategyDefinitionClient) Create() *StrategyDefinitionCreate {
+	mutation := newStrategyDefinitionMutation(c.config, OpCreate)
+	return &StrategyDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of StrategyDefinition entities.
+func (c *StrategyDefinitionClient) CreateBulk(builders ...*StrategyDefinitionCreate) *StrategyDefinitionCreateBulk {
+	return &StrategyDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *StrategyDefinitionClient) MapCreateBulk(slice any, setFunc func(*StrategyDefinitionCreate, int)) *StrategyDefinitionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &StrategyDefinitionCreateBulk{err: fmt.Errorf("calling to StrategyDefinitionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*StrategyDefinitionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &StrategyDefinitionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for StrategyDefinition.
+func (c *StrategyDefinitionClient) Update() *StrategyDefinitionUpdate {
+	mutation := newStrategyDefinitionMutation(c.config, OpUpdate)
+	return &StrategyDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *StrategyDefinitionClient) UpdateOne(_m *StrategyDefinition) *StrategyDefinitionUpdateOne {
+	mutation := newStrategyDefinitionMutation(c.config, OpUpdateOne, withStrategyDefinition(_m))
+	return &StrategyDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *StrategyDefinitionClient) UpdateOneID(id int) *StrategyDefinitionUpdateOne {
+	mutation := newStrategyDefinitionMutation(c.config, OpUpdateOne, withStrategyDefinitionID(id))
+	return &StrategyDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for StrategyDefinition.
+func (c *StrategyDefinitionClient) Delete() *StrategyDefinitionDelete {
+	mutation := newStrategyDefinitionMutation(c.config, OpDelete)
+	return &StrategyDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *StrategyDefinitionClient) DeleteOne(_m *StrategyDefinition) *StrategyDefinitionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *StrategyDefinitionClient) DeleteOneID(id int) *StrategyDefinitionDeleteOne {
+	builder := c.Delete().Where(strategydefinition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &StrategyDefinitionDeleteOne{builder}
+}
+
+// Query returns a query builder for StrategyDefinition.
+func (c *StrategyDefinitionClient) Query() *StrategyDefinitionQuery {
+	return &StrategyDefinitionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeStrategyDefinition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a StrategyDefinition entity by its id.
+func (c *StrategyDefinitionClient) Get(ctx context.Context, id int) (*StrategyDefinition, error) {
+	return c.Query().Where(strategydefinition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *StrategyDefinitionClient) GetX(ctx context.Context, id int) *StrategyDefinition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a StrategyDefinition.
+func (c *StrategyDefinitionClient) QueryProject(_m *StrategyDefinition) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategydefinition.Table, strategydefinition.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, strategydefinition.ProjectTable, strategydefinition.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *StrategyDefinitionClient) Hooks() []Hook {
+	return c.hooks.StrategyDefinition
+}
+
+// Interceptors returns the client interceptors.
+func (c *StrategyDefinitionClient) Interceptors() []Interceptor {
+	return c.inters.StrategyDefinition
+}
+
+func (c *StrategyDefinitionClient) mutate(ctx context.Context, m *StrategyDefinitionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&StrategyDefinitionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&StrategyDefinitionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&StrategyDefinitionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&StrategyDefinitionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown StrategyDefinition mutation op: %q", m.Op())
+	}
+}
+
+// TagClient is a client for the Tag schema.
+type TagClient struct {
+	config
+}
+
+// NewTagClient returns a client for the Tag from the given config.
+func NewTagClient(c config) *TagClient {
+	return &TagClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tag.Hooks(f(g(h())))`.
+func (c *TagClient) Use(hooks ...Hook) {
+	c.hooks.Tag = append(c.hooks.Tag, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tag.Intercept(f(g(h())))`.
+func (c *TagClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tag = append(c.inters.Tag, interceptors...)
+}
+
+// Create returns a builder for creating a Tag entity.
+func (c *TagClient) Create() *TagCreate {
+	mutation := newTagMutation(c.config, OpCreate)
+	return &TagCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tag entities.
+func (c *TagClient) CreateBulk(builders ...*TagCreate) *TagCreateBulk {
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TagClient) MapCreateBulk(slice any, setFunc func(*TagCreate, int)) *TagCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TagCreateBulk{err: fmt.Errorf("calling to TagClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TagCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TagCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tag.
+func (c *TagClient) Update() *TagUpdate {
+	mutation := newTagMutation(c.config, OpUpdate)
+	return &TagUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TagClient) UpdateOne(_m *Tag) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTag(_m))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TagClient) UpdateOneID(id int) *TagUpdateOne {
+	mutation := newTagMutation(c.config, OpUpdateOne, withTagID(id))
+	return &TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tag.
+func (c *TagClient) Delete() *TagDelete {
+	mutation := newTagMutation(c.config, OpDelete)
+	return &TagDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TagClient) DeleteOne(_m *Tag) *TagDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TagClient) DeleteOneID(id int) *TagDeleteOne {
+	builder := c.Delete().Where(tag.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TagDeleteOne{builder}
+}
+
+// Query returns a query builder for Tag.
+func (c *TagClient) Query() *TagQuery {
+	return &TagQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTag},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tag entity by its id.
+func (c *TagClient) Get(ctx context.Context, id int) (*Tag, error) {
+	return c.Query().Where(tag.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TagClient) GetX(ctx context.Context, id int) *Tag {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryProject queries the project edge of a Tag.
+func (c *TagClient) QueryProject(_m *Tag) *ProjectQuery {
+	query := (&ProjectClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(project.Table, project.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, tag.ProjectTable, tag.ProjectColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryFlags queries the flags edge of a Tag.
+func (c *TagClient) QueryFlags(_m *Tag) *FlagQuery {
+	query := (&FlagClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tag.Table, tag.FieldID, id),
+			sqlgraph.To(flag.Table, flag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, tag.FlagsTable, tag.FlagsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TagClient) Hooks() []Hook {
+	return c.hooks.Tag
+}
+
+// Interceptors returns the client interceptors.
+func (c *TagClient) Interceptors() []Interceptor {
+	return c.inters.Tag
+}
+
+func (c *TagClient) mutate(ctx context.Context, m *TagMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TagCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TagUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TagUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TagDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tag mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1586,11 +2722,13 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ApiToken, Constraint, Environment, Flag, FlagEnvironment, Project, Strategy,
-		User []ent.Hook
+		ApiToken, Constraint, ContextField, Environment, Flag, FlagEnvironment,
+		FlagEnvironmentVersion, Prerequisite, Project, RolloutPlan, Strategy,
+		StrategyDefinition, Tag, User []ent.Hook
 	}
 	inters struct {
-		ApiToken, Constraint, Environment, Flag, FlagEnvironment, Project, Strategy,
-		User []ent.Interceptor
+		ApiToken, Constraint, ContextField, Environment, Flag, FlagEnvironment,
+		FlagEnvironmentVersion, Prerequisite, Project, RolloutPlan, Strategy,
+		StrategyDefinition, Tag, User []ent.Interceptor
 	}
 )
@@ -97,6 +97,13 @@ const (
 	OperatorNUM_LTE         Operator = "NUM_LTE"
 	OperatorDATE_AFTER      Operator = "DATE_AFTER"
 	OperatorDATE_BEFORE     Operator = "DATE_BEFORE"
+	OperatorSEMVER_EQ       Operator = "SEMVER_EQ"
+	OperatorSEMVER_GT       Operator = "SEMVER_GT"
+	OperatorSEMVER_LT       Operator = "SEMVER_LT"
+	OperatorREGEX           Operator = "REGEX"
+	OperatorIN_CIDR         Operator = "IN_CIDR"
+	OperatorANY_OF          Operator = "ANY_OF"
+	OperatorALL_OF          Operator = "ALL_OF"
 )
 
 func (o Operator) String() string {
@@ -106,7 +113,7 @@ func (o Operator) String() string {
 // OperatorValidator is a validator for the "operator" field enum values. It is called by the builders before save.
 func OperatorValidator(o Operator) error {
 	switch o {
-	case OperatorIN, OperatorNOT_IN, OperatorSTR_CONTAINS, OperatorSTR_STARTS_WITH, OperatorSTR_ENDS_WITH, OperatorNUM_EQ, OperatorNUM_GT, OperatorNUM_GTE, OperatorNUM_LT, OperatorNUM_LTE, OperatorDATE_AFTER, OperatorDATE_BEFORE:
+	case OperatorIN, OperatorNOT_IN, OperatorSTR_CONTAINS, OperatorSTR_STARTS_WITH, OperatorSTR_ENDS_WITH, OperatorNUM_EQ, OperatorNUM_GT, OperatorNUM_GTE, OperatorNUM_LT, OperatorNUM_LTE, OperatorDATE_AFTER, OperatorDATE_BEFORE, OperatorSEMVER_EQ, OperatorSEMVER_GT, OperatorSEMVER_LT, OperatorREGEX, OperatorIN_CIDR, OperatorANY_OF, OperatorALL_OF:
 		return nil
 	default:
 		return fmt.Errorf("constraint: invalid enum value for operator field: %q", o)
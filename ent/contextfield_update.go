@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/project"
+)
+
+// ContextFieldUpdate is the builder for updating ContextField entities.
+type ContextFieldUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ContextFieldMutation
+}
+
+// Where appends a list predicates to the ContextFieldUpdate builder.
+func (_u *ContextFieldUpdate) Where(ps ...predicate.ContextField) *ContextFieldUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *ContextFieldUpdate) SetName(v string) *ContextFieldUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ContextFieldUpdate) SetNillableName(v *string) *ContextFieldUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ContextFieldUpdate) SetDescription(v string) *ContextFieldUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ContextFieldUpdate) SetNillableDescription(v *string) *ContextFieldUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ContextFieldUpdate) ClearDescription() *ContextFieldUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ContextFieldUpdate) SetType(v contextfield.Type) *ContextFieldUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ContextFieldUpdate) SetNillableType(v *contextfield.Type) *ContextFieldUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetLegalValues sets the "legal_values" field.
+func (_u *ContextFieldUpdate) SetLegalValues(v []string) *ContextFieldUpdate {
+	_u.mutation.SetLegalValues(v)
+	return _u
+}
+
+// AppendLegalValues appends value to the "legal_values" field.
+func (_u *ContextFieldUpdate) AppendLegalValues(v []string) *ContextFieldUpdate {
+	_u.mutation.AppendLegalValues(v)
+	return _u
+}
+
+// ClearLegalValues clears the value of the "legal_values" field.
+func (_u *ContextFieldUpdate) ClearLegalValues() *ContextFieldUpdate {
+	_u.mutation.ClearLegalValues()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *ContextFieldUpdate) SetProjectID(v int) *ContextFieldUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *ContextFieldUpdate) SetNillableProjectID(v *int) *ContextFieldUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContextFieldUpdate) SetUpdatedAt(v time.Time) *ContextFieldUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ContextFieldUpdate) SetProject(v *Project) *ContextFieldUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the ContextFieldMutation object of the builder.
+func (_u *ContextFieldUpdate) Mutation() *ContextFieldMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ContextFieldUpdate) ClearProject() *ContextFieldUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ContextFieldUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContextFieldUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ContextFieldUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContextFieldUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContextFieldUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contextfield.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContextFieldUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := contextfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContextField.type": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContextField.project"`)
+	}
+	return nil
+}
+
+func (_u *ContextFieldUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contextfield.Table, contextfield.Columns, sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(contextfield.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(contextfield.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(contextfield.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(contextfield.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LegalValues(); ok {
+		_spec.SetField(contextfield.FieldLegalValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLegalValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contextfield.FieldLegalValues, value)
+		})
+	}
+	if _u.mutation.LegalValuesCleared() {
+		_spec.ClearField(contextfield.FieldLegalValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contextfield.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contextfield.ProjectTable,
+			Columns: []string{contextfield.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contextfield.ProjectTable,
+			Columns: []string{contextfield.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contextfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ContextFieldUpdateOne is the builder for updating a single ContextField entity.
+type ContextFieldUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ContextFieldMutation
+}
+
+// SetName sets the "name" field.
+func (_u *ContextFieldUpdateOne) SetName(v string) *ContextFieldUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *ContextFieldUpdateOne) SetNillableName(v *string) *ContextFieldUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *ContextFieldUpdateOne) SetDescription(v string) *ContextFieldUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *ContextFieldUpdateOne) SetNillableDescription(v *string) *ContextFieldUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *ContextFieldUpdateOne) ClearDescription() *ContextFieldUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *ContextFieldUpdateOne) SetType(v contextfield.Type) *ContextFieldUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *ContextFieldUpdateOne) SetNillableType(v *contextfield.Type) *ContextFieldUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetLegalValues sets the "legal_values" field.
+func (_u *ContextFieldUpdateOne) SetLegalValues(v []string) *ContextFieldUpdateOne {
+	_u.mutation.SetLegalValues(v)
+	return _u
+}
+
+// AppendLegalValues appends value to the "legal_values" field.
+func (_u *ContextFieldUpdateOne) AppendLegalValues(v []string) *ContextFieldUpdateOne {
+	_u.mutation.AppendLegalValues(v)
+	return _u
+}
+
+// ClearLegalValues clears the value of the "legal_values" field.
+func (_u *ContextFieldUpdateOne) ClearLegalValues() *ContextFieldUpdateOne {
+	_u.mutation.ClearLegalValues()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *ContextFieldUpdateOne) SetProjectID(v int) *ContextFieldUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *ContextFieldUpdateOne) SetNillableProjectID(v *int) *ContextFieldUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ContextFieldUpdateOne) SetUpdatedAt(v time.Time) *ContextFieldUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *ContextFieldUpdateOne) SetProject(v *Project) *ContextFieldUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the ContextFieldMutation object of the builder.
+func (_u *ContextFieldUpdateOne) Mutation() *ContextFieldMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *ContextFieldUpdateOne) ClearProject() *ContextFieldUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Where appends a list predicates to the ContextFieldUpdate builder.
+func (_u *ContextFieldUpdateOne) Where(ps ...predicate.ContextField) *ContextFieldUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ContextFieldUpdateOne) Select(field string, fields ...string) *ContextFieldUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ContextField entity.
+func (_u *ContextFieldUpdateOne) Save(ctx context.Context) (*ContextField, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ContextFieldUpdateOne) SaveX(ctx context.Context) *ContextField {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ContextFieldUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ContextFieldUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ContextFieldUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := contextfield.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ContextFieldUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := contextfield.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "ContextField.type": %w`, err)}
+		}
+	}
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ContextField.project"`)
+	}
+	return nil
+}
+
+func (_u *ContextFieldUpdateOne) sqlSave(ctx context.Context) (_node *ContextField, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(contextfield.Table, contextfield.Columns, sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ContextField.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, contextfield.FieldID)
+		for _, f := range fields {
+			if !contextfield.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != contextfield.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(contextfield.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(contextfield.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(contextfield.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(contextfield.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LegalValues(); ok {
+		_spec.SetField(contextfield.FieldLegalValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLegalValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, contextfield.FieldLegalValues, value)
+		})
+	}
+	if _u.mutation.LegalValuesCleared() {
+		_spec.ClearField(contextfield.FieldLegalValues, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(contextfield.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contextfield.ProjectTable,
+			Columns: []string{contextfield.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   contextfield.ProjectTable,
+			Columns: []string{contextfield.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ContextField{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{contextfield.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,11 +14,17 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -80,14 +86,20 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			apitoken.Table:        apitoken.ValidColumn,
-			constraint.Table:      constraint.ValidColumn,
-			environment.Table:     environment.ValidColumn,
-			flag.Table:            flag.ValidColumn,
-			flagenvironment.Table: flagenvironment.ValidColumn,
-			project.Table:         project.ValidColumn,
-			strategy.Table:        strategy.ValidColumn,
-			user.Table:            user.ValidColumn,
+			apitoken.Table:               apitoken.ValidColumn,
+			constraint.Table:             constraint.ValidColumn,
+			contextfield.Table:           contextfield.ValidColumn,
+			environment.Table:            environment.ValidColumn,
+			flag.Table:                   flag.ValidColumn,
+			flagenvironment.Table:        flagenvironment.ValidColumn,
+			flagenvironmentversion.Table: flagenvironmentversion.ValidColumn,
+			prerequisite.Table:           prerequisite.ValidColumn,
+			project.Table:                project.ValidColumn,
+			rolloutplan.Table:            rolloutplan.ValidColumn,
+			strategy.Table:               strategy.ValidColumn,
+			strategydefinition.Table:     strategydefinition.ValidColumn,
+			tag.Table:                    tag.ValidColumn,
+			user.Table:                   user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
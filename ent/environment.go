@@ -30,6 +30,16 @@ type Environment struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ExpectedMatchID holds the value of the "expected_match_id" field.
+	ExpectedMatchID *int `json:"expected_match_id,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// FrozenAt holds the value of the "frozen_at" field.
+	FrozenAt *time.Time `json:"frozen_at,omitempty"`
+	// FrozenBy holds the value of the "frozen_by" field.
+	FrozenBy string `json:"frozen_by,omitempty"`
+	// FreezeReason holds the value of the "freeze_reason" field.
+	FreezeReason string `json:"freeze_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the EnvironmentQuery when eager-loading is set.
 	Edges        EnvironmentEdges `json:"edges"`
@@ -42,9 +52,13 @@ type EnvironmentEdges struct {
 	Project *Project `json:"project,omitempty"`
 	// FlagEnvironments holds the value of the flag_environments edge.
 	FlagEnvironments []*FlagEnvironment `json:"flag_environments,omitempty"`
+	// ExpectedMatch holds the value of the expected_match edge.
+	ExpectedMatch *Environment `json:"expected_match,omitempty"`
+	// MatchedBy holds the value of the matched_by edge.
+	MatchedBy []*Environment `json:"matched_by,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -67,16 +81,36 @@ func (e EnvironmentEdges) FlagEnvironmentsOrErr() ([]*FlagEnvironment, error) {
 	return nil, &NotLoadedError{edge: "flag_environments"}
 }
 
+// ExpectedMatchOrErr returns the ExpectedMatch value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EnvironmentEdges) ExpectedMatchOrErr() (*Environment, error) {
+	if e.ExpectedMatch != nil {
+		return e.ExpectedMatch, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: environment.Label}
+	}
+	return nil, &NotLoadedError{edge: "expected_match"}
+}
+
+// MatchedByOrErr returns the MatchedBy value or an error if the edge
+// was not loaded in eager-loading.
+func (e EnvironmentEdges) MatchedByOrErr() ([]*Environment, error) {
+	if e.loadedTypes[3] {
+		return e.MatchedBy, nil
+	}
+	return nil, &NotLoadedError{edge: "matched_by"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Environment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case environment.FieldID, environment.FieldSortOrder, environment.FieldProjectID:
+		case environment.FieldID, environment.FieldSortOrder, environment.FieldProjectID, environment.FieldExpectedMatchID:
 			values[i] = new(sql.NullInt64)
-		case environment.FieldName, environment.FieldType:
+		case environment.FieldName, environment.FieldType, environment.FieldFrozenBy, environment.FieldFreezeReason:
 			values[i] = new(sql.NullString)
-		case environment.FieldCreatedAt, environment.FieldUpdatedAt:
+		case environment.FieldCreatedAt, environment.FieldUpdatedAt, environment.FieldDeletedAt, environment.FieldFrozenAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +169,39 @@ func (_m *Environment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case environment.FieldExpectedMatchID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field expected_match_id", values[i])
+			} else if value.Valid {
+				_m.ExpectedMatchID = new(int)
+				*_m.ExpectedMatchID = int(value.Int64)
+			}
+		case environment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case environment.FieldFrozenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_at", values[i])
+			} else if value.Valid {
+				_m.FrozenAt = new(time.Time)
+				*_m.FrozenAt = value.Time
+			}
+		case environment.FieldFrozenBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_by", values[i])
+			} else if value.Valid {
+				_m.FrozenBy = value.String
+			}
+		case environment.FieldFreezeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field freeze_reason", values[i])
+			} else if value.Valid {
+				_m.FreezeReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +225,16 @@ func (_m *Environment) QueryFlagEnvironments() *FlagEnvironmentQuery {
 	return NewEnvironmentClient(_m.config).QueryFlagEnvironments(_m)
 }
 
+// QueryExpectedMatch queries the "expected_match" edge of the Environment entity.
+func (_m *Environment) QueryExpectedMatch() *EnvironmentQuery {
+	return NewEnvironmentClient(_m.config).QueryExpectedMatch(_m)
+}
+
+// QueryMatchedBy queries the "matched_by" edge of the Environment entity.
+func (_m *Environment) QueryMatchedBy() *EnvironmentQuery {
+	return NewEnvironmentClient(_m.config).QueryMatchedBy(_m)
+}
+
 // Update returns a builder for updating this Environment.
 // Note that you need to call Environment.Unwrap() before calling this method if this Environment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +275,27 @@ func (_m *Environment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ExpectedMatchID; v != nil {
+		builder.WriteString("expected_match_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FrozenAt; v != nil {
+		builder.WriteString("frozen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("frozen_by=")
+	builder.WriteString(_m.FrozenBy)
+	builder.WriteString(", ")
+	builder.WriteString("freeze_reason=")
+	builder.WriteString(_m.FreezeReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -27,10 +27,24 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldExpectedMatchID holds the string denoting the expected_match_id field in the database.
+	FieldExpectedMatchID = "expected_match_id"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFrozenAt holds the string denoting the frozen_at field in the database.
+	FieldFrozenAt = "frozen_at"
+	// FieldFrozenBy holds the string denoting the frozen_by field in the database.
+	FieldFrozenBy = "frozen_by"
+	// FieldFreezeReason holds the string denoting the freeze_reason field in the database.
+	FieldFreezeReason = "freeze_reason"
 	// EdgeProject holds the string denoting the project edge name in mutations.
 	EdgeProject = "project"
 	// EdgeFlagEnvironments holds the string denoting the flag_environments edge name in mutations.
 	EdgeFlagEnvironments = "flag_environments"
+	// EdgeExpectedMatch holds the string denoting the expected_match edge name in mutations.
+	EdgeExpectedMatch = "expected_match"
+	// EdgeMatchedBy holds the string denoting the matched_by edge name in mutations.
+	EdgeMatchedBy = "matched_by"
 	// Table holds the table name of the environment in the database.
 	Table = "environments"
 	// ProjectTable is the table that holds the project relation/edge.
@@ -47,6 +61,14 @@ const (
 	FlagEnvironmentsInverseTable = "flag_environments"
 	// FlagEnvironmentsColumn is the table column denoting the flag_environments relation/edge.
 	FlagEnvironmentsColumn = "environment_id"
+	// ExpectedMatchTable is the table that holds the expected_match relation/edge.
+	ExpectedMatchTable = "environments"
+	// ExpectedMatchColumn is the table column denoting the expected_match relation/edge.
+	ExpectedMatchColumn = "expected_match_id"
+	// MatchedByTable is the table that holds the matched_by relation/edge.
+	MatchedByTable = "environments"
+	// MatchedByColumn is the table column denoting the matched_by relation/edge.
+	MatchedByColumn = "expected_match_id"
 )
 
 // Columns holds all SQL columns for environment fields.
@@ -58,6 +80,11 @@ var Columns = []string{
 	FieldProjectID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldExpectedMatchID,
+	FieldDeletedAt,
+	FieldFrozenAt,
+	FieldFrozenBy,
+	FieldFreezeReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -143,6 +170,31 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByExpectedMatchID orders the results by the expected_match_id field.
+func ByExpectedMatchID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpectedMatchID, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFrozenAt orders the results by the frozen_at field.
+func ByFrozenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrozenAt, opts...).ToFunc()
+}
+
+// ByFrozenBy orders the results by the frozen_by field.
+func ByFrozenBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrozenBy, opts...).ToFunc()
+}
+
+// ByFreezeReason orders the results by the freeze_reason field.
+func ByFreezeReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreezeReason, opts...).ToFunc()
+}
+
 // ByProjectField orders the results by project field.
 func ByProjectField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -163,6 +215,27 @@ func ByFlagEnvironments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 		sqlgraph.OrderByNeighborTerms(s, newFlagEnvironmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByExpectedMatchField orders the results by expected_match field.
+func ByExpectedMatchField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newExpectedMatchStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByMatchedByCount orders the results by matched_by count.
+func ByMatchedByCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMatchedByStep(), opts...)
+	}
+}
+
+// ByMatchedBy orders the results by matched_by terms.
+func ByMatchedBy(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMatchedByStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newProjectStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -177,3 +250,17 @@ func newFlagEnvironmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, FlagEnvironmentsTable, FlagEnvironmentsColumn),
 	)
 }
+func newExpectedMatchStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ExpectedMatchTable, ExpectedMatchColumn),
+	)
+}
+func newMatchedByStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MatchedByTable, MatchedByColumn),
+	)
+}
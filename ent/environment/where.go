@@ -80,6 +80,31 @@ func UpdatedAt(v time.Time) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// ExpectedMatchID applies equality check predicate on the "expected_match_id" field. It's identical to ExpectedMatchIDEQ.
+func ExpectedMatchID(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldExpectedMatchID, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FrozenAt applies equality check predicate on the "frozen_at" field. It's identical to FrozenAtEQ.
+func FrozenAt(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenBy applies equality check predicate on the "frozen_by" field. It's identical to FrozenByEQ.
+func FrozenBy(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFrozenBy, v))
+}
+
+// FreezeReason applies equality check predicate on the "freeze_reason" field. It's identical to FreezeReasonEQ.
+func FreezeReason(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFreezeReason, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Environment {
 	return predicate.Environment(sql.FieldEQ(FieldName, v))
@@ -305,6 +330,286 @@ func UpdatedAtLTE(v time.Time) predicate.Environment {
 	return predicate.Environment(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// ExpectedMatchIDEQ applies the EQ predicate on the "expected_match_id" field.
+func ExpectedMatchIDEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldExpectedMatchID, v))
+}
+
+// ExpectedMatchIDNEQ applies the NEQ predicate on the "expected_match_id" field.
+func ExpectedMatchIDNEQ(v int) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldExpectedMatchID, v))
+}
+
+// ExpectedMatchIDIn applies the In predicate on the "expected_match_id" field.
+func ExpectedMatchIDIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldExpectedMatchID, vs...))
+}
+
+// ExpectedMatchIDNotIn applies the NotIn predicate on the "expected_match_id" field.
+func ExpectedMatchIDNotIn(vs ...int) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldExpectedMatchID, vs...))
+}
+
+// ExpectedMatchIDIsNil applies the IsNil predicate on the "expected_match_id" field.
+func ExpectedMatchIDIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldExpectedMatchID))
+}
+
+// ExpectedMatchIDNotNil applies the NotNil predicate on the "expected_match_id" field.
+func ExpectedMatchIDNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldExpectedMatchID))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FrozenAtEQ applies the EQ predicate on the "frozen_at" field.
+func FrozenAtEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtNEQ applies the NEQ predicate on the "frozen_at" field.
+func FrozenAtNEQ(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtIn applies the In predicate on the "frozen_at" field.
+func FrozenAtIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtNotIn applies the NotIn predicate on the "frozen_at" field.
+func FrozenAtNotIn(vs ...time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtGT applies the GT predicate on the "frozen_at" field.
+func FrozenAtGT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldFrozenAt, v))
+}
+
+// FrozenAtGTE applies the GTE predicate on the "frozen_at" field.
+func FrozenAtGTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldFrozenAt, v))
+}
+
+// FrozenAtLT applies the LT predicate on the "frozen_at" field.
+func FrozenAtLT(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldFrozenAt, v))
+}
+
+// FrozenAtLTE applies the LTE predicate on the "frozen_at" field.
+func FrozenAtLTE(v time.Time) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldFrozenAt, v))
+}
+
+// FrozenAtIsNil applies the IsNil predicate on the "frozen_at" field.
+func FrozenAtIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldFrozenAt))
+}
+
+// FrozenAtNotNil applies the NotNil predicate on the "frozen_at" field.
+func FrozenAtNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldFrozenAt))
+}
+
+// FrozenByEQ applies the EQ predicate on the "frozen_by" field.
+func FrozenByEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFrozenBy, v))
+}
+
+// FrozenByNEQ applies the NEQ predicate on the "frozen_by" field.
+func FrozenByNEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldFrozenBy, v))
+}
+
+// FrozenByIn applies the In predicate on the "frozen_by" field.
+func FrozenByIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldFrozenBy, vs...))
+}
+
+// FrozenByNotIn applies the NotIn predicate on the "frozen_by" field.
+func FrozenByNotIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldFrozenBy, vs...))
+}
+
+// FrozenByGT applies the GT predicate on the "frozen_by" field.
+func FrozenByGT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldFrozenBy, v))
+}
+
+// FrozenByGTE applies the GTE predicate on the "frozen_by" field.
+func FrozenByGTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldFrozenBy, v))
+}
+
+// FrozenByLT applies the LT predicate on the "frozen_by" field.
+func FrozenByLT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldFrozenBy, v))
+}
+
+// FrozenByLTE applies the LTE predicate on the "frozen_by" field.
+func FrozenByLTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldFrozenBy, v))
+}
+
+// FrozenByContains applies the Contains predicate on the "frozen_by" field.
+func FrozenByContains(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContains(FieldFrozenBy, v))
+}
+
+// FrozenByHasPrefix applies the HasPrefix predicate on the "frozen_by" field.
+func FrozenByHasPrefix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasPrefix(FieldFrozenBy, v))
+}
+
+// FrozenByHasSuffix applies the HasSuffix predicate on the "frozen_by" field.
+func FrozenByHasSuffix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasSuffix(FieldFrozenBy, v))
+}
+
+// FrozenByIsNil applies the IsNil predicate on the "frozen_by" field.
+func FrozenByIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldFrozenBy))
+}
+
+// FrozenByNotNil applies the NotNil predicate on the "frozen_by" field.
+func FrozenByNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldFrozenBy))
+}
+
+// FrozenByEqualFold applies the EqualFold predicate on the "frozen_by" field.
+func FrozenByEqualFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEqualFold(FieldFrozenBy, v))
+}
+
+// FrozenByContainsFold applies the ContainsFold predicate on the "frozen_by" field.
+func FrozenByContainsFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContainsFold(FieldFrozenBy, v))
+}
+
+// FreezeReasonEQ applies the EQ predicate on the "freeze_reason" field.
+func FreezeReasonEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEQ(FieldFreezeReason, v))
+}
+
+// FreezeReasonNEQ applies the NEQ predicate on the "freeze_reason" field.
+func FreezeReasonNEQ(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldNEQ(FieldFreezeReason, v))
+}
+
+// FreezeReasonIn applies the In predicate on the "freeze_reason" field.
+func FreezeReasonIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldIn(FieldFreezeReason, vs...))
+}
+
+// FreezeReasonNotIn applies the NotIn predicate on the "freeze_reason" field.
+func FreezeReasonNotIn(vs ...string) predicate.Environment {
+	return predicate.Environment(sql.FieldNotIn(FieldFreezeReason, vs...))
+}
+
+// FreezeReasonGT applies the GT predicate on the "freeze_reason" field.
+func FreezeReasonGT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGT(FieldFreezeReason, v))
+}
+
+// FreezeReasonGTE applies the GTE predicate on the "freeze_reason" field.
+func FreezeReasonGTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldGTE(FieldFreezeReason, v))
+}
+
+// FreezeReasonLT applies the LT predicate on the "freeze_reason" field.
+func FreezeReasonLT(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLT(FieldFreezeReason, v))
+}
+
+// FreezeReasonLTE applies the LTE predicate on the "freeze_reason" field.
+func FreezeReasonLTE(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldLTE(FieldFreezeReason, v))
+}
+
+// FreezeReasonContains applies the Contains predicate on the "freeze_reason" field.
+func FreezeReasonContains(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContains(FieldFreezeReason, v))
+}
+
+// FreezeReasonHasPrefix applies the HasPrefix predicate on the "freeze_reason" field.
+func FreezeReasonHasPrefix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasPrefix(FieldFreezeReason, v))
+}
+
+// FreezeReasonHasSuffix applies the HasSuffix predicate on the "freeze_reason" field.
+func FreezeReasonHasSuffix(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldHasSuffix(FieldFreezeReason, v))
+}
+
+// FreezeReasonIsNil applies the IsNil predicate on the "freeze_reason" field.
+func FreezeReasonIsNil() predicate.Environment {
+	return predicate.Environment(sql.FieldIsNull(FieldFreezeReason))
+}
+
+// FreezeReasonNotNil applies the NotNil predicate on the "freeze_reason" field.
+func FreezeReasonNotNil() predicate.Environment {
+	return predicate.Environment(sql.FieldNotNull(FieldFreezeReason))
+}
+
+// FreezeReasonEqualFold applies the EqualFold predicate on the "freeze_reason" field.
+func FreezeReasonEqualFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldEqualFold(FieldFreezeReason, v))
+}
+
+// FreezeReasonContainsFold applies the ContainsFold predicate on the "freeze_reason" field.
+func FreezeReasonContainsFold(v string) predicate.Environment {
+	return predicate.Environment(sql.FieldContainsFold(FieldFreezeReason, v))
+}
+
 // HasProject applies the HasEdge predicate on the "project" edge.
 func HasProject() predicate.Environment {
 	return predicate.Environment(func(s *sql.Selector) {
@@ -351,6 +656,52 @@ func HasFlagEnvironmentsWith(preds ...predicate.FlagEnvironment) predicate.Envir
 	})
 }
 
+// HasExpectedMatch applies the HasEdge predicate on the "expected_match" edge.
+func HasExpectedMatch() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ExpectedMatchTable, ExpectedMatchColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExpectedMatchWith applies the HasEdge predicate on the "expected_match" edge with a given conditions (other predicates).
+func HasExpectedMatchWith(preds ...predicate.Environment) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newExpectedMatchStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasMatchedBy applies the HasEdge predicate on the "matched_by" edge.
+func HasMatchedBy() predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MatchedByTable, MatchedByColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMatchedByWith applies the HasEdge predicate on the "matched_by" edge with a given conditions (other predicates).
+func HasMatchedByWith(preds ...predicate.Environment) predicate.Environment {
+	return predicate.Environment(func(s *sql.Selector) {
+		step := newMatchedByStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Environment) predicate.Environment {
 	return predicate.Environment(sql.AndPredicates(predicates...))
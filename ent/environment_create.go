@@ -82,6 +82,76 @@ func (_c *EnvironmentCreate) SetNillableUpdatedAt(v *time.Time) *EnvironmentCrea
 	return _c
 }
 
+// SetExpectedMatchID sets the "expected_match_id" field.
+func (_c *EnvironmentCreate) SetExpectedMatchID(v int) *EnvironmentCreate {
+	_c.mutation.SetExpectedMatchID(v)
+	return _c
+}
+
+// SetNillableExpectedMatchID sets the "expected_match_id" field if the given value is not nil.
+func (_c *EnvironmentCreate) SetNillableExpectedMatchID(v *int) *EnvironmentCreate {
+	if v != nil {
+		_c.SetExpectedMatchID(*v)
+	}
+	return _c
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *EnvironmentCreate) SetDeletedAt(v time.Time) *EnvironmentCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *EnvironmentCreate) SetNillableDeletedAt(v *time.Time) *EnvironmentCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_c *EnvironmentCreate) SetFrozenAt(v time.Time) *EnvironmentCreate {
+	_c.mutation.SetFrozenAt(v)
+	return _c
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_c *EnvironmentCreate) SetNillableFrozenAt(v *time.Time) *EnvironmentCreate {
+	if v != nil {
+		_c.SetFrozenAt(*v)
+	}
+	return _c
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_c *EnvironmentCreate) SetFrozenBy(v string) *EnvironmentCreate {
+	_c.mutation.SetFrozenBy(v)
+	return _c
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_c *EnvironmentCreate) SetNillableFrozenBy(v *string) *EnvironmentCreate {
+	if v != nil {
+		_c.SetFrozenBy(*v)
+	}
+	return _c
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_c *EnvironmentCreate) SetFreezeReason(v string) *EnvironmentCreate {
+	_c.mutation.SetFreezeReason(v)
+	return _c
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_c *EnvironmentCreate) SetNillableFreezeReason(v *string) *EnvironmentCreate {
+	if v != nil {
+		_c.SetFreezeReason(*v)
+	}
+	return _c
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_c *EnvironmentCreate) SetProject(v *Project) *EnvironmentCreate {
 	return _c.SetProjectID(v.ID)
@@ -102,6 +172,26 @@ func (_c *EnvironmentCreate) AddFlagEnvironments(v ...*FlagEnvironment) *Environ
 	return _c.AddFlagEnvironmentIDs(ids...)
 }
 
+// SetExpectedMatch sets the "expected_match" edge to the Environment entity.
+func (_c *EnvironmentCreate) SetExpectedMatch(v *Environment) *EnvironmentCreate {
+	return _c.SetExpectedMatchID(v.ID)
+}
+
+// AddMatchedByIDs adds the "matched_by" edge to the Environment entity by IDs.
+func (_c *EnvironmentCreate) AddMatchedByIDs(ids ...int) *EnvironmentCreate {
+	_c.mutation.AddMatchedByIDs(ids...)
+	return _c
+}
+
+// AddMatchedBy adds the "matched_by" edges to the Environment entity.
+func (_c *EnvironmentCreate) AddMatchedBy(v ...*Environment) *EnvironmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddMatchedByIDs(ids...)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (_c *EnvironmentCreate) Mutation() *EnvironmentMutation {
 	return _c.mutation
@@ -225,6 +315,22 @@ func (_c *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(environment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.FrozenAt(); ok {
+		_spec.SetField(environment.FieldFrozenAt, field.TypeTime, value)
+		_node.FrozenAt = &value
+	}
+	if value, ok := _c.mutation.FrozenBy(); ok {
+		_spec.SetField(environment.FieldFrozenBy, field.TypeString, value)
+		_node.FrozenBy = value
+	}
+	if value, ok := _c.mutation.FreezeReason(); ok {
+		_spec.SetField(environment.FieldFreezeReason, field.TypeString, value)
+		_node.FreezeReason = value
+	}
 	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -258,6 +364,39 @@ func (_c *EnvironmentCreate) createSpec() (*Environment, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.ExpectedMatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.ExpectedMatchTable,
+			Columns: []string{environment.ExpectedMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ExpectedMatchID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.MatchedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
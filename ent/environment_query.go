@@ -27,6 +27,8 @@ type EnvironmentQuery struct {
 	predicates           []predicate.Environment
 	withProject          *ProjectQuery
 	withFlagEnvironments *FlagEnvironmentQuery
+	withExpectedMatch    *EnvironmentQuery
+	withMatchedBy        *EnvironmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +109,50 @@ func (_q *EnvironmentQuery) QueryFlagEnvironments() *FlagEnvironmentQuery {
 	return query
 }
 
+// QueryExpectedMatch chains the current query on the "expected_match" edge.
+func (_q *EnvironmentQuery) QueryExpectedMatch() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, environment.ExpectedMatchTable, environment.ExpectedMatchColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryMatchedBy chains the current query on the "matched_by" edge.
+func (_q *EnvironmentQuery) QueryMatchedBy() *EnvironmentQuery {
+	query := (&EnvironmentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(environment.Table, environment.FieldID, selector),
+			sqlgraph.To(environment.Table, environment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, environment.MatchedByTable, environment.MatchedByColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Environment entity from the query.
 // Returns a *NotFoundError when no Environment was found.
 func (_q *EnvironmentQuery) First(ctx context.Context) (*Environment, error) {
@@ -301,6 +347,8 @@ func (_q *EnvironmentQuery) Clone() *EnvironmentQuery {
 		predicates:           append([]predicate.Environment{}, _q.predicates...),
 		withProject:          _q.withProject.Clone(),
 		withFlagEnvironments: _q.withFlagEnvironments.Clone(),
+		withExpectedMatch:    _q.withExpectedMatch.Clone(),
+		withMatchedBy:        _q.withMatchedBy.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +377,28 @@ func (_q *EnvironmentQuery) WithFlagEnvironments(opts ...func(*FlagEnvironmentQu
 	return _q
 }
 
+// WithExpectedMatch tells the query-builder to eager-load the nodes that are connected to
+// the "expected_match" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvironmentQuery) WithExpectedMatch(opts ...func(*EnvironmentQuery)) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withExpectedMatch = query
+	return _q
+}
+
+// WithMatchedBy tells the query-builder to eager-load the nodes that are connected to
+// the "matched_by" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EnvironmentQuery) WithMatchedBy(opts ...func(*EnvironmentQuery)) *EnvironmentQuery {
+	query := (&EnvironmentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withMatchedBy = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +477,11 @@ func (_q *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 	var (
 		nodes       = []*Environment{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withProject != nil,
 			_q.withFlagEnvironments != nil,
+			_q.withExpectedMatch != nil,
+			_q.withMatchedBy != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -445,6 +517,19 @@ func (_q *EnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*
 			return nil, err
 		}
 	}
+	if query := _q.withExpectedMatch; query != nil {
+		if err := _q.loadExpectedMatch(ctx, query, nodes, nil,
+			func(n *Environment, e *Environment) { n.Edges.ExpectedMatch = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withMatchedBy; query != nil {
+		if err := _q.loadMatchedBy(ctx, query, nodes,
+			func(n *Environment) { n.Edges.MatchedBy = []*Environment{} },
+			func(n *Environment, e *Environment) { n.Edges.MatchedBy = append(n.Edges.MatchedBy, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -507,6 +592,71 @@ func (_q *EnvironmentQuery) loadFlagEnvironments(ctx context.Context, query *Fla
 	}
 	return nil
 }
+func (_q *EnvironmentQuery) loadExpectedMatch(ctx context.Context, query *EnvironmentQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *Environment)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Environment)
+	for i := range nodes {
+		if nodes[i].ExpectedMatchID == nil {
+			continue
+		}
+		fk := *nodes[i].ExpectedMatchID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(environment.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "expected_match_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EnvironmentQuery) loadMatchedBy(ctx context.Context, query *EnvironmentQuery, nodes []*Environment, init func(*Environment), assign func(*Environment, *Environment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Environment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(environment.FieldExpectedMatchID)
+	}
+	query.Where(predicate.Environment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(environment.MatchedByColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ExpectedMatchID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "expected_match_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "expected_match_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EnvironmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -536,6 +686,9 @@ func (_q *EnvironmentQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withProject != nil {
 			_spec.Node.AddColumnOnce(environment.FieldProjectID)
 		}
+		if _q.withExpectedMatch != nil {
+			_spec.Node.AddColumnOnce(environment.FieldExpectedMatchID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
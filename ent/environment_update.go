@@ -99,6 +99,106 @@ func (_u *EnvironmentUpdate) SetUpdatedAt(v time.Time) *EnvironmentUpdate {
 	return _u
 }
 
+// SetExpectedMatchID sets the "expected_match_id" field.
+func (_u *EnvironmentUpdate) SetExpectedMatchID(v int) *EnvironmentUpdate {
+	_u.mutation.SetExpectedMatchID(v)
+	return _u
+}
+
+// SetNillableExpectedMatchID sets the "expected_match_id" field if the given value is not nil.
+func (_u *EnvironmentUpdate) SetNillableExpectedMatchID(v *int) *EnvironmentUpdate {
+	if v != nil {
+		_u.SetExpectedMatchID(*v)
+	}
+	return _u
+}
+
+// ClearExpectedMatchID clears the value of the "expected_match_id" field.
+func (_u *EnvironmentUpdate) ClearExpectedMatchID() *EnvironmentUpdate {
+	_u.mutation.ClearExpectedMatchID()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EnvironmentUpdate) SetDeletedAt(v time.Time) *EnvironmentUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EnvironmentUpdate) SetNillableDeletedAt(v *time.Time) *EnvironmentUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EnvironmentUpdate) ClearDeletedAt() *EnvironmentUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_u *EnvironmentUpdate) SetFrozenAt(v time.Time) *EnvironmentUpdate {
+	_u.mutation.SetFrozenAt(v)
+	return _u
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_u *EnvironmentUpdate) SetNillableFrozenAt(v *time.Time) *EnvironmentUpdate {
+	if v != nil {
+		_u.SetFrozenAt(*v)
+	}
+	return _u
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (_u *EnvironmentUpdate) ClearFrozenAt() *EnvironmentUpdate {
+	_u.mutation.ClearFrozenAt()
+	return _u
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_u *EnvironmentUpdate) SetFrozenBy(v string) *EnvironmentUpdate {
+	_u.mutation.SetFrozenBy(v)
+	return _u
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_u *EnvironmentUpdate) SetNillableFrozenBy(v *string) *EnvironmentUpdate {
+	if v != nil {
+		_u.SetFrozenBy(*v)
+	}
+	return _u
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (_u *EnvironmentUpdate) ClearFrozenBy() *EnvironmentUpdate {
+	_u.mutation.ClearFrozenBy()
+	return _u
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_u *EnvironmentUpdate) SetFreezeReason(v string) *EnvironmentUpdate {
+	_u.mutation.SetFreezeReason(v)
+	return _u
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_u *EnvironmentUpdate) SetNillableFreezeReason(v *string) *EnvironmentUpdate {
+	if v != nil {
+		_u.SetFreezeReason(*v)
+	}
+	return _u
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (_u *EnvironmentUpdate) ClearFreezeReason() *EnvironmentUpdate {
+	_u.mutation.ClearFreezeReason()
+	return _u
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_u *EnvironmentUpdate) SetProject(v *Project) *EnvironmentUpdate {
 	return _u.SetProjectID(v.ID)
@@ -119,6 +219,26 @@ func (_u *EnvironmentUpdate) AddFlagEnvironments(v ...*FlagEnvironment) *Environ
 	return _u.AddFlagEnvironmentIDs(ids...)
 }
 
+// SetExpectedMatch sets the "expected_match" edge to the Environment entity.
+func (_u *EnvironmentUpdate) SetExpectedMatch(v *Environment) *EnvironmentUpdate {
+	return _u.SetExpectedMatchID(v.ID)
+}
+
+// AddMatchedByIDs adds the "matched_by" edge to the Environment entity by IDs.
+func (_u *EnvironmentUpdate) AddMatchedByIDs(ids ...int) *EnvironmentUpdate {
+	_u.mutation.AddMatchedByIDs(ids...)
+	return _u
+}
+
+// AddMatchedBy adds the "matched_by" edges to the Environment entity.
+func (_u *EnvironmentUpdate) AddMatchedBy(v ...*Environment) *EnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatchedByIDs(ids...)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (_u *EnvironmentUpdate) Mutation() *EnvironmentMutation {
 	return _u.mutation
@@ -151,6 +271,33 @@ func (_u *EnvironmentUpdate) RemoveFlagEnvironments(v ...*FlagEnvironment) *Envi
 	return _u.RemoveFlagEnvironmentIDs(ids...)
 }
 
+// ClearExpectedMatch clears the "expected_match" edge to the Environment entity.
+func (_u *EnvironmentUpdate) ClearExpectedMatch() *EnvironmentUpdate {
+	_u.mutation.ClearExpectedMatch()
+	return _u
+}
+
+// ClearMatchedBy clears all "matched_by" edges to the Environment entity.
+func (_u *EnvironmentUpdate) ClearMatchedBy() *EnvironmentUpdate {
+	_u.mutation.ClearMatchedBy()
+	return _u
+}
+
+// RemoveMatchedByIDs removes the "matched_by" edge to Environment entities by IDs.
+func (_u *EnvironmentUpdate) RemoveMatchedByIDs(ids ...int) *EnvironmentUpdate {
+	_u.mutation.RemoveMatchedByIDs(ids...)
+	return _u
+}
+
+// RemoveMatchedBy removes "matched_by" edges to Environment entities.
+func (_u *EnvironmentUpdate) RemoveMatchedBy(v ...*Environment) *EnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatchedByIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EnvironmentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -227,6 +374,30 @@ func (_u *EnvironmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(environment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(environment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenAt(); ok {
+		_spec.SetField(environment.FieldFrozenAt, field.TypeTime, value)
+	}
+	if _u.mutation.FrozenAtCleared() {
+		_spec.ClearField(environment.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenBy(); ok {
+		_spec.SetField(environment.FieldFrozenBy, field.TypeString, value)
+	}
+	if _u.mutation.FrozenByCleared() {
+		_spec.ClearField(environment.FieldFrozenBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.FreezeReason(); ok {
+		_spec.SetField(environment.FieldFreezeReason, field.TypeString, value)
+	}
+	if _u.mutation.FreezeReasonCleared() {
+		_spec.ClearField(environment.FieldFreezeReason, field.TypeString)
+	}
 	if _u.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -301,6 +472,80 @@ func (_u *EnvironmentUpdate) sqlSave(ctx context.Context) (_node int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ExpectedMatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.ExpectedMatchTable,
+			Columns: []string{environment.ExpectedMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExpectedMatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.ExpectedMatchTable,
+			Columns: []string{environment.ExpectedMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatchedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatchedByIDs(); len(nodes) > 0 && !_u.mutation.MatchedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{environment.Label}
@@ -390,6 +635,106 @@ func (_u *EnvironmentUpdateOne) SetUpdatedAt(v time.Time) *EnvironmentUpdateOne
 	return _u
 }
 
+// SetExpectedMatchID sets the "expected_match_id" field.
+func (_u *EnvironmentUpdateOne) SetExpectedMatchID(v int) *EnvironmentUpdateOne {
+	_u.mutation.SetExpectedMatchID(v)
+	return _u
+}
+
+// SetNillableExpectedMatchID sets the "expected_match_id" field if the given value is not nil.
+func (_u *EnvironmentUpdateOne) SetNillableExpectedMatchID(v *int) *EnvironmentUpdateOne {
+	if v != nil {
+		_u.SetExpectedMatchID(*v)
+	}
+	return _u
+}
+
+// ClearExpectedMatchID clears the value of the "expected_match_id" field.
+func (_u *EnvironmentUpdateOne) ClearExpectedMatchID() *EnvironmentUpdateOne {
+	_u.mutation.ClearExpectedMatchID()
+	return _u
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *EnvironmentUpdateOne) SetDeletedAt(v time.Time) *EnvironmentUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *EnvironmentUpdateOne) SetNillableDeletedAt(v *time.Time) *EnvironmentUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *EnvironmentUpdateOne) ClearDeletedAt() *EnvironmentUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_u *EnvironmentUpdateOne) SetFrozenAt(v time.Time) *EnvironmentUpdateOne {
+	_u.mutation.SetFrozenAt(v)
+	return _u
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_u *EnvironmentUpdateOne) SetNillableFrozenAt(v *time.Time) *EnvironmentUpdateOne {
+	if v != nil {
+		_u.SetFrozenAt(*v)
+	}
+	return _u
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (_u *EnvironmentUpdateOne) ClearFrozenAt() *EnvironmentUpdateOne {
+	_u.mutation.ClearFrozenAt()
+	return _u
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_u *EnvironmentUpdateOne) SetFrozenBy(v string) *EnvironmentUpdateOne {
+	_u.mutation.SetFrozenBy(v)
+	return _u
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_u *EnvironmentUpdateOne) SetNillableFrozenBy(v *string) *EnvironmentUpdateOne {
+	if v != nil {
+		_u.SetFrozenBy(*v)
+	}
+	return _u
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (_u *EnvironmentUpdateOne) ClearFrozenBy() *EnvironmentUpdateOne {
+	_u.mutation.ClearFrozenBy()
+	return _u
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_u *EnvironmentUpdateOne) SetFreezeReason(v string) *EnvironmentUpdateOne {
+	_u.mutation.SetFreezeReason(v)
+	return _u
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_u *EnvironmentUpdateOne) SetNillableFreezeReason(v *string) *EnvironmentUpdateOne {
+	if v != nil {
+		_u.SetFreezeReason(*v)
+	}
+	return _u
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (_u *EnvironmentUpdateOne) ClearFreezeReason() *EnvironmentUpdateOne {
+	_u.mutation.ClearFreezeReason()
+	return _u
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_u *EnvironmentUpdateOne) SetProject(v *Project) *EnvironmentUpdateOne {
 	return _u.SetProjectID(v.ID)
@@ -410,6 +755,26 @@ func (_u *EnvironmentUpdateOne) AddFlagEnvironments(v ...*FlagEnvironment) *Envi
 	return _u.AddFlagEnvironmentIDs(ids...)
 }
 
+// SetExpectedMatch sets the "expected_match" edge to the Environment entity.
+func (_u *EnvironmentUpdateOne) SetExpectedMatch(v *Environment) *EnvironmentUpdateOne {
+	return _u.SetExpectedMatchID(v.ID)
+}
+
+// AddMatchedByIDs adds the "matched_by" edge to the Environment entity by IDs.
+func (_u *EnvironmentUpdateOne) AddMatchedByIDs(ids ...int) *EnvironmentUpdateOne {
+	_u.mutation.AddMatchedByIDs(ids...)
+	return _u
+}
+
+// AddMatchedBy adds the "matched_by" edges to the Environment entity.
+func (_u *EnvironmentUpdateOne) AddMatchedBy(v ...*Environment) *EnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddMatchedByIDs(ids...)
+}
+
 // Mutation returns the EnvironmentMutation object of the builder.
 func (_u *EnvironmentUpdateOne) Mutation() *EnvironmentMutation {
 	return _u.mutation
@@ -442,6 +807,33 @@ func (_u *EnvironmentUpdateOne) RemoveFlagEnvironments(v ...*FlagEnvironment) *E
 	return _u.RemoveFlagEnvironmentIDs(ids...)
 }
 
+// ClearExpectedMatch clears the "expected_match" edge to the Environment entity.
+func (_u *EnvironmentUpdateOne) ClearExpectedMatch() *EnvironmentUpdateOne {
+	_u.mutation.ClearExpectedMatch()
+	return _u
+}
+
+// ClearMatchedBy clears all "matched_by" edges to the Environment entity.
+func (_u *EnvironmentUpdateOne) ClearMatchedBy() *EnvironmentUpdateOne {
+	_u.mutation.ClearMatchedBy()
+	return _u
+}
+
+// RemoveMatchedByIDs removes the "matched_by" edge to Environment entities by IDs.
+func (_u *EnvironmentUpdateOne) RemoveMatchedByIDs(ids ...int) *EnvironmentUpdateOne {
+	_u.mutation.RemoveMatchedByIDs(ids...)
+	return _u
+}
+
+// RemoveMatchedBy removes "matched_by" edges to Environment entities.
+func (_u *EnvironmentUpdateOne) RemoveMatchedBy(v ...*Environment) *EnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveMatchedByIDs(ids...)
+}
+
 // Where appends a list predicates to the EnvironmentUpdate builder.
 func (_u *EnvironmentUpdateOne) Where(ps ...predicate.Environment) *EnvironmentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -548,6 +940,30 @@ func (_u *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environment
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(environment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(environment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(environment.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenAt(); ok {
+		_spec.SetField(environment.FieldFrozenAt, field.TypeTime, value)
+	}
+	if _u.mutation.FrozenAtCleared() {
+		_spec.ClearField(environment.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenBy(); ok {
+		_spec.SetField(environment.FieldFrozenBy, field.TypeString, value)
+	}
+	if _u.mutation.FrozenByCleared() {
+		_spec.ClearField(environment.FieldFrozenBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.FreezeReason(); ok {
+		_spec.SetField(environment.FieldFreezeReason, field.TypeString, value)
+	}
+	if _u.mutation.FreezeReasonCleared() {
+		_spec.ClearField(environment.FieldFreezeReason, field.TypeString)
+	}
 	if _u.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -622,6 +1038,80 @@ func (_u *EnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *Environment
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.ExpectedMatchCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.ExpectedMatchTable,
+			Columns: []string{environment.ExpectedMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ExpectedMatchIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   environment.ExpectedMatchTable,
+			Columns: []string{environment.ExpectedMatchColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.MatchedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedMatchedByIDs(); len(nodes) > 0 && !_u.mutation.MatchedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.MatchedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   environment.MatchedByTable,
+			Columns: []string{environment.MatchedByColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(environment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Environment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/schema"
 )
 
 // Flag is the model entity for the Flag schema.
@@ -30,6 +32,14 @@ type Flag struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// Owner holds the value of the "owner" field.
+	Owner string `json:"owner,omitempty"`
+	// Links holds the value of the "links" field.
+	Links []schema.Link `json:"links,omitempty"`
+	// Metadata holds the value of the "metadata" field.
+	Metadata map[string]string `json:"metadata,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FlagQuery when eager-loading is set.
 	Edges        FlagEdges `json:"edges"`
@@ -42,9 +52,13 @@ type FlagEdges struct {
 	Project *Project `json:"project,omitempty"`
 	// FlagEnvironments holds the value of the flag_environments edge.
 	FlagEnvironments []*FlagEnvironment `json:"flag_environments,omitempty"`
+	// Dependents holds the value of the dependents edge.
+	Dependents []*Prerequisite `json:"dependents,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [4]bool
 }
 
 // ProjectOrErr returns the Project value or an error if the edge
@@ -67,16 +81,36 @@ func (e FlagEdges) FlagEnvironmentsOrErr() ([]*FlagEnvironment, error) {
 	return nil, &NotLoadedError{edge: "flag_environments"}
 }
 
+// DependentsOrErr returns the Dependents value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlagEdges) DependentsOrErr() ([]*Prerequisite, error) {
+	if e.loadedTypes[2] {
+		return e.Dependents, nil
+	}
+	return nil, &NotLoadedError{edge: "dependents"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlagEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Flag) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case flag.FieldLinks, flag.FieldMetadata:
+			values[i] = new([]byte)
 		case flag.FieldID, flag.FieldProjectID:
 			values[i] = new(sql.NullInt64)
-		case flag.FieldName, flag.FieldDescription, flag.FieldFlagType:
+		case flag.FieldName, flag.FieldDescription, flag.FieldFlagType, flag.FieldOwner:
 			values[i] = new(sql.NullString)
-		case flag.FieldCreatedAt, flag.FieldUpdatedAt:
+		case flag.FieldCreatedAt, flag.FieldUpdatedAt, flag.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -135,6 +169,35 @@ func (_m *Flag) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case flag.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case flag.FieldOwner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field owner", values[i])
+			} else if value.Valid {
+				_m.Owner = value.String
+			}
+		case flag.FieldLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Links); err != nil {
+					return fmt.Errorf("unmarshal field links: %w", err)
+				}
+			}
+		case flag.FieldMetadata:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field metadata", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Metadata); err != nil {
+					return fmt.Errorf("unmarshal field metadata: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -158,6 +221,16 @@ func (_m *Flag) QueryFlagEnvironments() *FlagEnvironmentQuery {
 	return NewFlagClient(_m.config).QueryFlagEnvironments(_m)
 }
 
+// QueryDependents queries the "dependents" edge of the Flag entity.
+func (_m *Flag) QueryDependents() *PrerequisiteQuery {
+	return NewFlagClient(_m.config).QueryDependents(_m)
+}
+
+// QueryTags queries the "tags" edge of the Flag entity.
+func (_m *Flag) QueryTags() *TagQuery {
+	return NewFlagClient(_m.config).QueryTags(_m)
+}
+
 // Update returns a builder for updating this Flag.
 // Note that you need to call Flag.Unwrap() before calling this method if this Flag
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -198,6 +271,20 @@ func (_m *Flag) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("owner=")
+	builder.WriteString(_m.Owner)
+	builder.WriteString(", ")
+	builder.WriteString("links=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Links))
+	builder.WriteString(", ")
+	builder.WriteString("metadata=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Metadata))
 	builder.WriteByte(')')
 	return builder.String()
 }
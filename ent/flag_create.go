@@ -12,7 +12,10 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // FlagCreate is the builder for creating a Flag entity.
@@ -82,6 +85,46 @@ func (_c *FlagCreate) SetNillableUpdatedAt(v *time.Time) *FlagCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *FlagCreate) SetDeletedAt(v time.Time) *FlagCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *FlagCreate) SetNillableDeletedAt(v *time.Time) *FlagCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetOwner sets the "owner" field.
+func (_c *FlagCreate) SetOwner(v string) *FlagCreate {
+	_c.mutation.SetOwner(v)
+	return _c
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_c *FlagCreate) SetNillableOwner(v *string) *FlagCreate {
+	if v != nil {
+		_c.SetOwner(*v)
+	}
+	return _c
+}
+
+// SetLinks sets the "links" field.
+func (_c *FlagCreate) SetLinks(v []schema.Link) *FlagCreate {
+	_c.mutation.SetLinks(v)
+	return _c
+}
+
+// SetMetadata sets the "metadata" field.
+func (_c *FlagCreate) SetMetadata(v map[string]string) *FlagCreate {
+	_c.mutation.SetMetadata(v)
+	return _c
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_c *FlagCreate) SetProject(v *Project) *FlagCreate {
 	return _c.SetProjectID(v.ID)
@@ -102,6 +145,36 @@ func (_c *FlagCreate) AddFlagEnvironments(v ...*FlagEnvironment) *FlagCreate {
 	return _c.AddFlagEnvironmentIDs(ids...)
 }
 
+// AddDependentIDs adds the "dependents" edge to the Prerequisite entity by IDs.
+func (_c *FlagCreate) AddDependentIDs(ids ...int) *FlagCreate {
+	_c.mutation.AddDependentIDs(ids...)
+	return _c
+}
+
+// AddDependents adds the "dependents" edges to the Prerequisite entity.
+func (_c *FlagCreate) AddDependents(v ...*Prerequisite) *FlagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDependentIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *FlagCreate) AddTagIDs(ids ...int) *FlagCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *FlagCreate) AddTags(v ...*Tag) *FlagCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
 // Mutation returns the FlagMutation object of the builder.
 func (_c *FlagCreate) Mutation() *FlagMutation {
 	return _c.mutation
@@ -218,6 +291,22 @@ func (_c *FlagCreate) createSpec() (*Flag, *sqlgraph.CreateSpec) {
 		_spec.SetField(flag.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(flag.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.Owner(); ok {
+		_spec.SetField(flag.FieldOwner, field.TypeString, value)
+		_node.Owner = value
+	}
+	if value, ok := _c.mutation.Links(); ok {
+		_spec.SetField(flag.FieldLinks, field.TypeJSON, value)
+		_node.Links = value
+	}
+	if value, ok := _c.mutation.Metadata(); ok {
+		_spec.SetField(flag.FieldMetadata, field.TypeJSON, value)
+		_node.Metadata = value
+	}
 	if nodes := _c.mutation.ProjectIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -251,6 +340,38 @@ func (_c *FlagCreate) createSpec() (*Flag, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
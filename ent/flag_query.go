@@ -15,7 +15,9 @@ import (
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
 	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // FlagQuery is the builder for querying Flag entities.
@@ -27,6 +29,8 @@ type FlagQuery struct {
 	predicates           []predicate.Flag
 	withProject          *ProjectQuery
 	withFlagEnvironments *FlagEnvironmentQuery
+	withDependents       *PrerequisiteQuery
+	withTags             *TagQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +111,50 @@ func (_q *FlagQuery) QueryFlagEnvironments() *FlagEnvironmentQuery {
 	return query
 }
 
+// QueryDependents chains the current query on the "dependents" edge.
+func (_q *FlagQuery) QueryDependents() *PrerequisiteQuery {
+	query := (&PrerequisiteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flag.Table, flag.FieldID, selector),
+			sqlgraph.To(prerequisite.Table, prerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flag.DependentsTable, flag.DependentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *FlagQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flag.Table, flag.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, flag.TagsTable, flag.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Flag entity from the query.
 // Returns a *NotFoundError when no Flag was found.
 func (_q *FlagQuery) First(ctx context.Context) (*Flag, error) {
@@ -301,6 +349,8 @@ func (_q *FlagQuery) Clone() *FlagQuery {
 		predicates:           append([]predicate.Flag{}, _q.predicates...),
 		withProject:          _q.withProject.Clone(),
 		withFlagEnvironments: _q.withFlagEnvironments.Clone(),
+		withDependents:       _q.withDependents.Clone(),
+		withTags:             _q.withTags.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +379,28 @@ func (_q *FlagQuery) WithFlagEnvironments(opts ...func(*FlagEnvironmentQuery)) *
 	return _q
 }
 
+// WithDependents tells the query-builder to eager-load the nodes that are connected to
+// the "dependents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlagQuery) WithDependents(opts ...func(*PrerequisiteQuery)) *FlagQuery {
+	query := (&PrerequisiteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDependents = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlagQuery) WithTags(opts ...func(*TagQuery)) *FlagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +479,11 @@ func (_q *FlagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Flag, e
 	var (
 		nodes       = []*Flag{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [4]bool{
 			_q.withProject != nil,
 			_q.withFlagEnvironments != nil,
+			_q.withDependents != nil,
+			_q.withTags != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -443,6 +517,20 @@ func (_q *FlagQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Flag, e
 			return nil, err
 		}
 	}
+	if query := _q.withDependents; query != nil {
+		if err := _q.loadDependents(ctx, query, nodes,
+			func(n *Flag) { n.Edges.Dependents = []*Prerequisite{} },
+			func(n *Flag, e *Prerequisite) { n.Edges.Dependents = append(n.Edges.Dependents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Flag) { n.Edges.Tags = []*Tag{} },
+			func(n *Flag, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -505,6 +593,97 @@ func (_q *FlagQuery) loadFlagEnvironments(ctx context.Context, query *FlagEnviro
 	}
 	return nil
 }
+func (_q *FlagQuery) loadDependents(ctx context.Context, query *PrerequisiteQuery, nodes []*Flag, init func(*Flag), assign func(*Flag, *Prerequisite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Flag)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(prerequisite.FieldParentFlagID)
+	}
+	query.Where(predicate.Prerequisite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flag.DependentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ParentFlagID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "parent_flag_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlagQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Flag, init func(*Flag), assign func(*Flag, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Flag)
+	nids := make(map[int]map[*Flag]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(flag.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(flag.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(flag.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(flag.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Flag]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *FlagQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -10,11 +10,15 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
 	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // FlagUpdate is the builder for updating Flag entities.
@@ -98,6 +102,76 @@ func (_u *FlagUpdate) SetUpdatedAt(v time.Time) *FlagUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FlagUpdate) SetDeletedAt(v time.Time) *FlagUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FlagUpdate) SetNillableDeletedAt(v *time.Time) *FlagUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FlagUpdate) ClearDeletedAt() *FlagUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *FlagUpdate) SetOwner(v string) *FlagUpdate {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *FlagUpdate) SetNillableOwner(v *string) *FlagUpdate {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *FlagUpdate) ClearOwner() *FlagUpdate {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *FlagUpdate) SetLinks(v []schema.Link) *FlagUpdate {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *FlagUpdate) AppendLinks(v []schema.Link) *FlagUpdate {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *FlagUpdate) ClearLinks() *FlagUpdate {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *FlagUpdate) SetMetadata(v map[string]string) *FlagUpdate {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *FlagUpdate) ClearMetadata() *FlagUpdate {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_u *FlagUpdate) SetProject(v *Project) *FlagUpdate {
 	return _u.SetProjectID(v.ID)
@@ -118,6 +192,36 @@ func (_u *FlagUpdate) AddFlagEnvironments(v ...*FlagEnvironment) *FlagUpdate {
 	return _u.AddFlagEnvironmentIDs(ids...)
 }
 
+// AddDependentIDs adds the "dependents" edge to the Prerequisite entity by IDs.
+func (_u *FlagUpdate) AddDependentIDs(ids ...int) *FlagUpdate {
+	_u.mutation.AddDependentIDs(ids...)
+	return _u
+}
+
+// AddDependents adds the "dependents" edges to the Prerequisite entity.
+func (_u *FlagUpdate) AddDependents(v ...*Prerequisite) *FlagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDependentIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *FlagUpdate) AddTagIDs(ids ...int) *FlagUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *FlagUpdate) AddTags(v ...*Tag) *FlagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
 // Mutation returns the FlagMutation object of the builder.
 func (_u *FlagUpdate) Mutation() *FlagMutation {
 	return _u.mutation
@@ -150,6 +254,48 @@ func (_u *FlagUpdate) RemoveFlagEnvironments(v ...*FlagEnvironment) *FlagUpdate
 	return _u.RemoveFlagEnvironmentIDs(ids...)
 }
 
+// ClearDependents clears all "dependents" edges to the Prerequisite entity.
+func (_u *FlagUpdate) ClearDependents() *FlagUpdate {
+	_u.mutation.ClearDependents()
+	return _u
+}
+
+// RemoveDependentIDs removes the "dependents" edge to Prerequisite entities by IDs.
+func (_u *FlagUpdate) RemoveDependentIDs(ids ...int) *FlagUpdate {
+	_u.mutation.RemoveDependentIDs(ids...)
+	return _u
+}
+
+// RemoveDependents removes "dependents" edges to Prerequisite entities.
+func (_u *FlagUpdate) RemoveDependents(v ...*Prerequisite) *FlagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDependentIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *FlagUpdate) ClearTags() *FlagUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *FlagUpdate) RemoveTagIDs(ids ...int) *FlagUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *FlagUpdate) RemoveTags(v ...*Tag) *FlagUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FlagUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -226,6 +372,35 @@ func (_u *FlagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flag.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(flag.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(flag.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(flag.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(flag.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(flag.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flag.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(flag.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(flag.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(flag.FieldMetadata, field.TypeJSON)
+	}
 	if _u.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -300,6 +475,96 @@ func (_u *FlagUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !_u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{flag.Label}
@@ -388,6 +653,76 @@ func (_u *FlagUpdateOne) SetUpdatedAt(v time.Time) *FlagUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *FlagUpdateOne) SetDeletedAt(v time.Time) *FlagUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *FlagUpdateOne) SetNillableDeletedAt(v *time.Time) *FlagUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *FlagUpdateOne) ClearDeletedAt() *FlagUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetOwner sets the "owner" field.
+func (_u *FlagUpdateOne) SetOwner(v string) *FlagUpdateOne {
+	_u.mutation.SetOwner(v)
+	return _u
+}
+
+// SetNillableOwner sets the "owner" field if the given value is not nil.
+func (_u *FlagUpdateOne) SetNillableOwner(v *string) *FlagUpdateOne {
+	if v != nil {
+		_u.SetOwner(*v)
+	}
+	return _u
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (_u *FlagUpdateOne) ClearOwner() *FlagUpdateOne {
+	_u.mutation.ClearOwner()
+	return _u
+}
+
+// SetLinks sets the "links" field.
+func (_u *FlagUpdateOne) SetLinks(v []schema.Link) *FlagUpdateOne {
+	_u.mutation.SetLinks(v)
+	return _u
+}
+
+// AppendLinks appends value to the "links" field.
+func (_u *FlagUpdateOne) AppendLinks(v []schema.Link) *FlagUpdateOne {
+	_u.mutation.AppendLinks(v)
+	return _u
+}
+
+// ClearLinks clears the value of the "links" field.
+func (_u *FlagUpdateOne) ClearLinks() *FlagUpdateOne {
+	_u.mutation.ClearLinks()
+	return _u
+}
+
+// SetMetadata sets the "metadata" field.
+func (_u *FlagUpdateOne) SetMetadata(v map[string]string) *FlagUpdateOne {
+	_u.mutation.SetMetadata(v)
+	return _u
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (_u *FlagUpdateOne) ClearMetadata() *FlagUpdateOne {
+	_u.mutation.ClearMetadata()
+	return _u
+}
+
 // SetProject sets the "project" edge to the Project entity.
 func (_u *FlagUpdateOne) SetProject(v *Project) *FlagUpdateOne {
 	return _u.SetProjectID(v.ID)
@@ -408,6 +743,36 @@ func (_u *FlagUpdateOne) AddFlagEnvironments(v ...*FlagEnvironment) *FlagUpdateO
 	return _u.AddFlagEnvironmentIDs(ids...)
 }
 
+// AddDependentIDs adds the "dependents" edge to the Prerequisite entity by IDs.
+func (_u *FlagUpdateOne) AddDependentIDs(ids ...int) *FlagUpdateOne {
+	_u.mutation.AddDependentIDs(ids...)
+	return _u
+}
+
+// AddDependents adds the "dependents" edges to the Prerequisite entity.
+func (_u *FlagUpdateOne) AddDependents(v ...*Prerequisite) *FlagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDependentIDs(ids...)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *FlagUpdateOne) AddTagIDs(ids ...int) *FlagUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *FlagUpdateOne) AddTags(v ...*Tag) *FlagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
 // Mutation returns the FlagMutation object of the builder.
 func (_u *FlagUpdateOne) Mutation() *FlagMutation {
 	return _u.mutation
@@ -440,6 +805,48 @@ func (_u *FlagUpdateOne) RemoveFlagEnvironments(v ...*FlagEnvironment) *FlagUpda
 	return _u.RemoveFlagEnvironmentIDs(ids...)
 }
 
+// ClearDependents clears all "dependents" edges to the Prerequisite entity.
+func (_u *FlagUpdateOne) ClearDependents() *FlagUpdateOne {
+	_u.mutation.ClearDependents()
+	return _u
+}
+
+// RemoveDependentIDs removes the "dependents" edge to Prerequisite entities by IDs.
+func (_u *FlagUpdateOne) RemoveDependentIDs(ids ...int) *FlagUpdateOne {
+	_u.mutation.RemoveDependentIDs(ids...)
+	return _u
+}
+
+// RemoveDependents removes "dependents" edges to Prerequisite entities.
+func (_u *FlagUpdateOne) RemoveDependents(v ...*Prerequisite) *FlagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDependentIDs(ids...)
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *FlagUpdateOne) ClearTags() *FlagUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *FlagUpdateOne) RemoveTagIDs(ids ...int) *FlagUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *FlagUpdateOne) RemoveTags(v ...*Tag) *FlagUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
 // Where appends a list predicates to the FlagUpdate builder.
 func (_u *FlagUpdateOne) Where(ps ...predicate.Flag) *FlagUpdateOne {
 	_u.mutation.Where(ps...)
@@ -546,6 +953,35 @@ func (_u *FlagUpdateOne) sqlSave(ctx context.Context) (_node *Flag, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flag.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(flag.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(flag.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Owner(); ok {
+		_spec.SetField(flag.FieldOwner, field.TypeString, value)
+	}
+	if _u.mutation.OwnerCleared() {
+		_spec.ClearField(flag.FieldOwner, field.TypeString)
+	}
+	if value, ok := _u.mutation.Links(); ok {
+		_spec.SetField(flag.FieldLinks, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedLinks(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, flag.FieldLinks, value)
+		})
+	}
+	if _u.mutation.LinksCleared() {
+		_spec.ClearField(flag.FieldLinks, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Metadata(); ok {
+		_spec.SetField(flag.FieldMetadata, field.TypeJSON, value)
+	}
+	if _u.mutation.MetadataCleared() {
+		_spec.ClearField(flag.FieldMetadata, field.TypeJSON)
+	}
 	if _u.mutation.ProjectCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -620,6 +1056,96 @@ func (_u *FlagUpdateOne) sqlSave(ctx context.Context) (_node *Flag, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDependentsIDs(); len(nodes) > 0 && !_u.mutation.DependentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DependentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flag.DependentsTable,
+			Columns: []string{flag.DependentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   flag.TagsTable,
+			Columns: flag.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Flag{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
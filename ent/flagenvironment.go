@@ -29,6 +29,8 @@ type FlagEnvironment struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Revision holds the value of the "revision" field.
+	Revision int `json:"revision,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the FlagEnvironmentQuery when eager-loading is set.
 	Edges        FlagEnvironmentEdges `json:"edges"`
@@ -43,9 +45,15 @@ type FlagEnvironmentEdges struct {
 	Environment *Environment `json:"environment,omitempty"`
 	// Strategies holds the value of the strategies edge.
 	Strategies []*Strategy `json:"strategies,omitempty"`
+	// Prerequisites holds the value of the prerequisites edge.
+	Prerequisites []*Prerequisite `json:"prerequisites,omitempty"`
+	// Versions holds the value of the versions edge.
+	Versions []*FlagEnvironmentVersion `json:"versions,omitempty"`
+	// RolloutPlans holds the value of the rollout_plans edge.
+	RolloutPlans []*RolloutPlan `json:"rollout_plans,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // FlagOrErr returns the Flag value or an error if the edge
@@ -79,6 +87,33 @@ func (e FlagEnvironmentEdges) StrategiesOrErr() ([]*Strategy, error) {
 	return nil, &NotLoadedError{edge: "strategies"}
 }
 
+// PrerequisitesOrErr returns the Prerequisites value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlagEnvironmentEdges) PrerequisitesOrErr() ([]*Prerequisite, error) {
+	if e.loadedTypes[3] {
+		return e.Prerequisites, nil
+	}
+	return nil, &NotLoadedError{edge: "prerequisites"}
+}
+
+// VersionsOrErr returns the Versions value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlagEnvironmentEdges) VersionsOrErr() ([]*FlagEnvironmentVersion, error) {
+	if e.loadedTypes[4] {
+		return e.Versions, nil
+	}
+	return nil, &NotLoadedError{edge: "versions"}
+}
+
+// RolloutPlansOrErr returns the RolloutPlans value or an error if the edge
+// was not loaded in eager-loading.
+func (e FlagEnvironmentEdges) RolloutPlansOrErr() ([]*RolloutPlan, error) {
+	if e.loadedTypes[5] {
+		return e.RolloutPlans, nil
+	}
+	return nil, &NotLoadedError{edge: "rollout_plans"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*FlagEnvironment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -86,7 +121,7 @@ func (*FlagEnvironment) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case flagenvironment.FieldEnabled:
 			values[i] = new(sql.NullBool)
-		case flagenvironment.FieldID, flagenvironment.FieldFlagID, flagenvironment.FieldEnvironmentID:
+		case flagenvironment.FieldID, flagenvironment.FieldFlagID, flagenvironment.FieldEnvironmentID, flagenvironment.FieldRevision:
 			values[i] = new(sql.NullInt64)
 		case flagenvironment.FieldCreatedAt, flagenvironment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -141,6 +176,12 @@ func (_m *FlagEnvironment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case flagenvironment.FieldRevision:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision", values[i])
+			} else if value.Valid {
+				_m.Revision = int(value.Int64)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -169,6 +210,21 @@ func (_m *FlagEnvironment) QueryStrategies() *StrategyQuery {
 	return NewFlagEnvironmentClient(_m.config).QueryStrategies(_m)
 }
 
+// QueryPrerequisites queries the "prerequisites" edge of the FlagEnvironment entity.
+func (_m *FlagEnvironment) QueryPrerequisites() *PrerequisiteQuery {
+	return NewFlagEnvironmentClient(_m.config).QueryPrerequisites(_m)
+}
+
+// QueryVersions queries the "versions" edge of the FlagEnvironment entity.
+func (_m *FlagEnvironment) QueryVersions() *FlagEnvironmentVersionQuery {
+	return NewFlagEnvironmentClient(_m.config).QueryVersions(_m)
+}
+
+// QueryRolloutPlans queries the "rollout_plans" edge of the FlagEnvironment entity.
+func (_m *FlagEnvironment) QueryRolloutPlans() *RolloutPlanQuery {
+	return NewFlagEnvironmentClient(_m.config).QueryRolloutPlans(_m)
+}
+
 // Update returns a builder for updating this FlagEnvironment.
 // Note that you need to call FlagEnvironment.Unwrap() before calling this method if this FlagEnvironment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +262,9 @@ func (_m *FlagEnvironment) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("revision=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Revision))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,12 +24,20 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldRevision holds the string denoting the revision field in the database.
+	FieldRevision = "revision"
 	// EdgeFlag holds the string denoting the flag edge name in mutations.
 	EdgeFlag = "flag"
 	// EdgeEnvironment holds the string denoting the environment edge name in mutations.
 	EdgeEnvironment = "environment"
 	// EdgeStrategies holds the string denoting the strategies edge name in mutations.
 	EdgeStrategies = "strategies"
+	// EdgePrerequisites holds the string denoting the prerequisites edge name in mutations.
+	EdgePrerequisites = "prerequisites"
+	// EdgeVersions holds the string denoting the versions edge name in mutations.
+	EdgeVersions = "versions"
+	// EdgeRolloutPlans holds the string denoting the rollout_plans edge name in mutations.
+	EdgeRolloutPlans = "rollout_plans"
 	// Table holds the table name of the flagenvironment in the database.
 	Table = "flag_environments"
 	// FlagTable is the table that holds the flag relation/edge.
@@ -53,6 +61,27 @@ const (
 	StrategiesInverseTable = "strategies"
 	// StrategiesColumn is the table column denoting the strategies relation/edge.
 	StrategiesColumn = "flag_environment_id"
+	// PrerequisitesTable is the table that holds the prerequisites relation/edge.
+	PrerequisitesTable = "prerequisites"
+	// PrerequisitesInverseTable is the table name for the Prerequisite entity.
+	// It exists in this package in order to avoid circular dependency with the "prerequisite" package.
+	PrerequisitesInverseTable = "prerequisites"
+	// PrerequisitesColumn is the table column denoting the prerequisites relation/edge.
+	PrerequisitesColumn = "flag_environment_id"
+	// VersionsTable is the table that holds the versions relation/edge.
+	VersionsTable = "flag_environment_versions"
+	// VersionsInverseTable is the table name for the FlagEnvironmentVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "flagenvironmentversion" package.
+	VersionsInverseTable = "flag_environment_versions"
+	// VersionsColumn is the table column denoting the versions relation/edge.
+	VersionsColumn = "flag_environment_id"
+	// RolloutPlansTable is the table that holds the rollout_plans relation/edge.
+	RolloutPlansTable = "rollout_plans"
+	// RolloutPlansInverseTable is the table name for the RolloutPlan entity.
+	// It exists in this package in order to avoid circular dependency with the "rolloutplan" package.
+	RolloutPlansInverseTable = "rollout_plans"
+	// RolloutPlansColumn is the table column denoting the rollout_plans relation/edge.
+	RolloutPlansColumn = "flag_environment_id"
 )
 
 // Columns holds all SQL columns for flagenvironment fields.
@@ -63,6 +92,7 @@ var Columns = []string{
 	FieldEnvironmentID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldRevision,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -84,6 +114,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRevision holds the default value on creation for the "revision" field.
+	DefaultRevision int
 )
 
 // OrderOption defines the ordering options for the FlagEnvironment queries.
@@ -119,6 +151,11 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByRevision orders the results by the revision field.
+func ByRevision(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevision, opts...).ToFunc()
+}
+
 // ByFlagField orders the results by flag field.
 func ByFlagField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -146,6 +183,48 @@ func ByStrategies(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newStrategiesStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPrerequisitesCount orders the results by prerequisites count.
+func ByPrerequisitesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrerequisitesStep(), opts...)
+	}
+}
+
+// ByPrerequisites orders the results by prerequisites terms.
+func ByPrerequisites(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrerequisitesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVersionsCount orders the results by versions count.
+func ByVersionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVersionsStep(), opts...)
+	}
+}
+
+// ByVersions orders the results by versions terms.
+func ByVersions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRolloutPlansCount orders the results by rollout_plans count.
+func ByRolloutPlansCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRolloutPlansStep(), opts...)
+	}
+}
+
+// ByRolloutPlans orders the results by rollout_plans terms.
+func ByRolloutPlans(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRolloutPlansStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newFlagStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -167,3 +246,24 @@ func newStrategiesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, StrategiesTable, StrategiesColumn),
 	)
 }
+func newPrerequisitesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrerequisitesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PrerequisitesTable, PrerequisitesColumn),
+	)
+}
+func newVersionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+	)
+}
+func newRolloutPlansStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RolloutPlansInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RolloutPlansTable, RolloutPlansColumn),
+	)
+}
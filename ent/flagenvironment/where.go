@@ -80,6 +80,11 @@ func UpdatedAt(v time.Time) predicate.FlagEnvironment {
 	return predicate.FlagEnvironment(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// Revision applies equality check predicate on the "revision" field. It's identical to RevisionEQ.
+func Revision(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldEQ(FieldRevision, v))
+}
+
 // EnabledEQ applies the EQ predicate on the "enabled" field.
 func EnabledEQ(v bool) predicate.FlagEnvironment {
 	return predicate.FlagEnvironment(sql.FieldEQ(FieldEnabled, v))
@@ -210,6 +215,46 @@ func UpdatedAtLTE(v time.Time) predicate.FlagEnvironment {
 	return predicate.FlagEnvironment(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// RevisionEQ applies the EQ predicate on the "revision" field.
+func RevisionEQ(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldEQ(FieldRevision, v))
+}
+
+// RevisionNEQ applies the NEQ predicate on the "revision" field.
+func RevisionNEQ(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldNEQ(FieldRevision, v))
+}
+
+// RevisionIn applies the In predicate on the "revision" field.
+func RevisionIn(vs ...int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldIn(FieldRevision, vs...))
+}
+
+// RevisionNotIn applies the NotIn predicate on the "revision" field.
+func RevisionNotIn(vs ...int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldNotIn(FieldRevision, vs...))
+}
+
+// RevisionGT applies the GT predicate on the "revision" field.
+func RevisionGT(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldGT(FieldRevision, v))
+}
+
+// RevisionGTE applies the GTE predicate on the "revision" field.
+func RevisionGTE(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldGTE(FieldRevision, v))
+}
+
+// RevisionLT applies the LT predicate on the "revision" field.
+func RevisionLT(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldLT(FieldRevision, v))
+}
+
+// RevisionLTE applies the LTE predicate on the "revision" field.
+func RevisionLTE(v int) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(sql.FieldLTE(FieldRevision, v))
+}
+
 // HasFlag applies the HasEdge predicate on the "flag" edge.
 func HasFlag() predicate.FlagEnvironment {
 	return predicate.FlagEnvironment(func(s *sql.Selector) {
@@ -279,6 +324,75 @@ func HasStrategiesWith(preds ...predicate.Strategy) predicate.FlagEnvironment {
 	})
 }
 
+// HasPrerequisites applies the HasEdge predicate on the "prerequisites" edge.
+func HasPrerequisites() predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PrerequisitesTable, PrerequisitesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrerequisitesWith applies the HasEdge predicate on the "prerequisites" edge with a given conditions (other predicates).
+func HasPrerequisitesWith(preds ...predicate.Prerequisite) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := newPrerequisitesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVersions applies the HasEdge predicate on the "versions" edge.
+func HasVersions() predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VersionsTable, VersionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionsWith applies the HasEdge predicate on the "versions" edge with a given conditions (other predicates).
+func HasVersionsWith(preds ...predicate.FlagEnvironmentVersion) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := newVersionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRolloutPlans applies the HasEdge predicate on the "rollout_plans" edge.
+func HasRolloutPlans() predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RolloutPlansTable, RolloutPlansColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRolloutPlansWith applies the HasEdge predicate on the "rollout_plans" edge with a given conditions (other predicates).
+func HasRolloutPlansWith(preds ...predicate.RolloutPlan) predicate.FlagEnvironment {
+	return predicate.FlagEnvironment(func(s *sql.Selector) {
+		step := newRolloutPlansStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.FlagEnvironment) predicate.FlagEnvironment {
 	return predicate.FlagEnvironment(sql.AndPredicates(predicates...))
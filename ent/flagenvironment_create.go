@@ -13,6 +13,9 @@ import (
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
 )
 
@@ -77,6 +80,20 @@ func (_c *FlagEnvironmentCreate) SetNillableUpdatedAt(v *time.Time) *FlagEnviron
 	return _c
 }
 
+// SetRevision sets the "revision" field.
+func (_c *FlagEnvironmentCreate) SetRevision(v int) *FlagEnvironmentCreate {
+	_c.mutation.SetRevision(v)
+	return _c
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_c *FlagEnvironmentCreate) SetNillableRevision(v *int) *FlagEnvironmentCreate {
+	if v != nil {
+		_c.SetRevision(*v)
+	}
+	return _c
+}
+
 // SetFlag sets the "flag" edge to the Flag entity.
 func (_c *FlagEnvironmentCreate) SetFlag(v *Flag) *FlagEnvironmentCreate {
 	return _c.SetFlagID(v.ID)
@@ -102,6 +119,51 @@ func (_c *FlagEnvironmentCreate) AddStrategies(v ...*Strategy) *FlagEnvironmentC
 	return _c.AddStrategyIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the Prerequisite entity by IDs.
+func (_c *FlagEnvironmentCreate) AddPrerequisiteIDs(ids ...int) *FlagEnvironmentCreate {
+	_c.mutation.AddPrerequisiteIDs(ids...)
+	return _c
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the Prerequisite entity.
+func (_c *FlagEnvironmentCreate) AddPrerequisites(v ...*Prerequisite) *FlagEnvironmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPrerequisiteIDs(ids...)
+}
+
+// AddVersionIDs adds the "versions" edge to the FlagEnvironmentVersion entity by IDs.
+func (_c *FlagEnvironmentCreate) AddVersionIDs(ids ...int) *FlagEnvironmentCreate {
+	_c.mutation.AddVersionIDs(ids...)
+	return _c
+}
+
+// AddVersions adds the "versions" edges to the FlagEnvironmentVersion entity.
+func (_c *FlagEnvironmentCreate) AddVersions(v ...*FlagEnvironmentVersion) *FlagEnvironmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVersionIDs(ids...)
+}
+
+// AddRolloutPlanIDs adds the "rollout_plans" edge to the RolloutPlan entity by IDs.
+func (_c *FlagEnvironmentCreate) AddRolloutPlanIDs(ids ...int) *FlagEnvironmentCreate {
+	_c.mutation.AddRolloutPlanIDs(ids...)
+	return _c
+}
+
+// AddRolloutPlans adds the "rollout_plans" edges to the RolloutPlan entity.
+func (_c *FlagEnvironmentCreate) AddRolloutPlans(v ...*RolloutPlan) *FlagEnvironmentCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRolloutPlanIDs(ids...)
+}
+
 // Mutation returns the FlagEnvironmentMutation object of the builder.
 func (_c *FlagEnvironmentCreate) Mutation() *FlagEnvironmentMutation {
 	return _c.mutation
@@ -149,6 +211,10 @@ func (_c *FlagEnvironmentCreate) defaults() {
 		v := flagenvironment.DefaultUpdatedAt()
 		_c.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		v := flagenvironment.DefaultRevision
+		_c.mutation.SetRevision(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -168,6 +234,9 @@ func (_c *FlagEnvironmentCreate) check() error {
 	if _, ok := _c.mutation.UpdatedAt(); !ok {
 		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "FlagEnvironment.updated_at"`)}
 	}
+	if _, ok := _c.mutation.Revision(); !ok {
+		return &ValidationError{Name: "revision", err: errors.New(`ent: missing required field "FlagEnvironment.revision"`)}
+	}
 	if len(_c.mutation.FlagIDs()) == 0 {
 		return &ValidationError{Name: "flag", err: errors.New(`ent: missing required edge "FlagEnvironment.flag"`)}
 	}
@@ -212,6 +281,10 @@ func (_c *FlagEnvironmentCreate) createSpec() (*FlagEnvironment, *sqlgraph.Creat
 		_spec.SetField(flagenvironment.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.Revision(); ok {
+		_spec.SetField(flagenvironment.FieldRevision, field.TypeInt, value)
+		_node.Revision = value
+	}
 	if nodes := _c.mutation.FlagIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -262,6 +335,54 @@ func (_c *FlagEnvironmentCreate) createSpec() (*FlagEnvironment, *sqlgraph.Creat
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.RolloutPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -15,20 +15,26 @@ import (
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
 	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
 )
 
 // FlagEnvironmentQuery is the builder for querying FlagEnvironment entities.
 type FlagEnvironmentQuery struct {
 	config
-	ctx             *QueryContext
-	order           []flagenvironment.OrderOption
-	inters          []Interceptor
-	predicates      []predicate.FlagEnvironment
-	withFlag        *FlagQuery
-	withEnvironment *EnvironmentQuery
-	withStrategies  *StrategyQuery
+	ctx               *QueryContext
+	order             []flagenvironment.OrderOption
+	inters            []Interceptor
+	predicates        []predicate.FlagEnvironment
+	withFlag          *FlagQuery
+	withEnvironment   *EnvironmentQuery
+	withStrategies    *StrategyQuery
+	withPrerequisites *PrerequisiteQuery
+	withVersions      *FlagEnvironmentVersionQuery
+	withRolloutPlans  *RolloutPlanQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +137,72 @@ func (_q *FlagEnvironmentQuery) QueryStrategies() *StrategyQuery {
 	return query
 }
 
+// QueryPrerequisites chains the current query on the "prerequisites" edge.
+func (_q *FlagEnvironmentQuery) QueryPrerequisites() *PrerequisiteQuery {
+	query := (&PrerequisiteClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, selector),
+			sqlgraph.To(prerequisite.Table, prerequisite.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.PrerequisitesTable, flagenvironment.PrerequisitesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVersions chains the current query on the "versions" edge.
+func (_q *FlagEnvironmentQuery) QueryVersions() *FlagEnvironmentVersionQuery {
+	query := (&FlagEnvironmentVersionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, selector),
+			sqlgraph.To(flagenvironmentversion.Table, flagenvironmentversion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.VersionsTable, flagenvironment.VersionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRolloutPlans chains the current query on the "rollout_plans" edge.
+func (_q *FlagEnvironmentQuery) QueryRolloutPlans() *RolloutPlanQuery {
+	query := (&RolloutPlanClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(flagenvironment.Table, flagenvironment.FieldID, selector),
+			sqlgraph.To(rolloutplan.Table, rolloutplan.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, flagenvironment.RolloutPlansTable, flagenvironment.RolloutPlansColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first FlagEnvironment entity from the query.
 // Returns a *NotFoundError when no FlagEnvironment was found.
 func (_q *FlagEnvironmentQuery) First(ctx context.Context) (*FlagEnvironment, error) {
@@ -318,14 +390,17 @@ func (_q *FlagEnvironmentQuery) Clone() *FlagEnvironmentQuery {
 		return nil
 	}
 	return &FlagEnvironmentQuery{
-		config:          _q.config,
-		ctx:             _q.ctx.Clone(),
-		order:           append([]flagenvironment.OrderOption{}, _q.order...),
-		inters:          append([]Interceptor{}, _q.inters...),
-		predicates:      append([]predicate.FlagEnvironment{}, _q.predicates...),
-		withFlag:        _q.withFlag.Clone(),
-		withEnvironment: _q.withEnvironment.Clone(),
-		withStrategies:  _q.withStrategies.Clone(),
+		config:            _q.config,
+		ctx:               _q.ctx.Clone(),
+		order:             append([]flagenvironment.OrderOption{}, _q.order...),
+		inters:            append([]Interceptor{}, _q.inters...),
+		predicates:        append([]predicate.FlagEnvironment{}, _q.predicates...),
+		withFlag:          _q.withFlag.Clone(),
+		withEnvironment:   _q.withEnvironment.Clone(),
+		withStrategies:    _q.withStrategies.Clone(),
+		withPrerequisites: _q.withPrerequisites.Clone(),
+		withVersions:      _q.withVersions.Clone(),
+		withRolloutPlans:  _q.withRolloutPlans.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +440,39 @@ func (_q *FlagEnvironmentQuery) WithStrategies(opts ...func(*StrategyQuery)) *Fl
 	return _q
 }
 
+// WithPrerequisites tells the query-builder to eager-load the nodes that are connected to
+// the "prerequisites" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlagEnvironmentQuery) WithPrerequisites(opts ...func(*PrerequisiteQuery)) *FlagEnvironmentQuery {
+	query := (&PrerequisiteClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrerequisites = query
+	return _q
+}
+
+// WithVersions tells the query-builder to eager-load the nodes that are connected to
+// the "versions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlagEnvironmentQuery) WithVersions(opts ...func(*FlagEnvironmentVersionQuery)) *FlagEnvironmentQuery {
+	query := (&FlagEnvironmentVersionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVersions = query
+	return _q
+}
+
+// WithRolloutPlans tells the query-builder to eager-load the nodes that are connected to
+// the "rollout_plans" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *FlagEnvironmentQuery) WithRolloutPlans(opts ...func(*RolloutPlanQuery)) *FlagEnvironmentQuery {
+	query := (&RolloutPlanClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRolloutPlans = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +551,13 @@ func (_q *FlagEnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 	var (
 		nodes       = []*FlagEnvironment{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withFlag != nil,
 			_q.withEnvironment != nil,
 			_q.withStrategies != nil,
+			_q.withPrerequisites != nil,
+			_q.withVersions != nil,
+			_q.withRolloutPlans != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -486,6 +597,27 @@ func (_q *FlagEnvironmentQuery) sqlAll(ctx context.Context, hooks ...queryHook)
 			return nil, err
 		}
 	}
+	if query := _q.withPrerequisites; query != nil {
+		if err := _q.loadPrerequisites(ctx, query, nodes,
+			func(n *FlagEnvironment) { n.Edges.Prerequisites = []*Prerequisite{} },
+			func(n *FlagEnvironment, e *Prerequisite) { n.Edges.Prerequisites = append(n.Edges.Prerequisites, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVersions; query != nil {
+		if err := _q.loadVersions(ctx, query, nodes,
+			func(n *FlagEnvironment) { n.Edges.Versions = []*FlagEnvironmentVersion{} },
+			func(n *FlagEnvironment, e *FlagEnvironmentVersion) { n.Edges.Versions = append(n.Edges.Versions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withRolloutPlans; query != nil {
+		if err := _q.loadRolloutPlans(ctx, query, nodes,
+			func(n *FlagEnvironment) { n.Edges.RolloutPlans = []*RolloutPlan{} },
+			func(n *FlagEnvironment, e *RolloutPlan) { n.Edges.RolloutPlans = append(n.Edges.RolloutPlans, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -577,6 +709,96 @@ func (_q *FlagEnvironmentQuery) loadStrategies(ctx context.Context, query *Strat
 	}
 	return nil
 }
+func (_q *FlagEnvironmentQuery) loadPrerequisites(ctx context.Context, query *PrerequisiteQuery, nodes []*FlagEnvironment, init func(*FlagEnvironment), assign func(*FlagEnvironment, *Prerequisite)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FlagEnvironment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(prerequisite.FieldFlagEnvironmentID)
+	}
+	query.Where(predicate.Prerequisite(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flagenvironment.PrerequisitesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlagEnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flag_environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlagEnvironmentQuery) loadVersions(ctx context.Context, query *FlagEnvironmentVersionQuery, nodes []*FlagEnvironment, init func(*FlagEnvironment), assign func(*FlagEnvironment, *FlagEnvironmentVersion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FlagEnvironment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(flagenvironmentversion.FieldFlagEnvironmentID)
+	}
+	query.Where(predicate.FlagEnvironmentVersion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flagenvironment.VersionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlagEnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flag_environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *FlagEnvironmentQuery) loadRolloutPlans(ctx context.Context, query *RolloutPlanQuery, nodes []*FlagEnvironment, init func(*FlagEnvironment), assign func(*FlagEnvironment, *RolloutPlan)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*FlagEnvironment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(rolloutplan.FieldFlagEnvironmentID)
+	}
+	query.Where(predicate.RolloutPlan(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(flagenvironment.RolloutPlansColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.FlagEnvironmentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "flag_environment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *FlagEnvironmentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -14,7 +14,10 @@ import (
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
 	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/strategy"
 )
 
@@ -79,6 +82,27 @@ func (_u *FlagEnvironmentUpdate) SetUpdatedAt(v time.Time) *FlagEnvironmentUpdat
 	return _u
 }
 
+// SetRevision sets the "revision" field.
+func (_u *FlagEnvironmentUpdate) SetRevision(v int) *FlagEnvironmentUpdate {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FlagEnvironmentUpdate) SetNillableRevision(v *int) *FlagEnvironmentUpdate {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FlagEnvironmentUpdate) AddRevision(v int) *FlagEnvironmentUpdate {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
 // SetFlag sets the "flag" edge to the Flag entity.
 func (_u *FlagEnvironmentUpdate) SetFlag(v *Flag) *FlagEnvironmentUpdate {
 	return _u.SetFlagID(v.ID)
@@ -104,6 +128,51 @@ func (_u *FlagEnvironmentUpdate) AddStrategies(v ...*Strategy) *FlagEnvironmentU
 	return _u.AddStrategyIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the Prerequisite entity by IDs.
+func (_u *FlagEnvironmentUpdate) AddPrerequisiteIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.AddPrerequisiteIDs(ids...)
+	return _u
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the Prerequisite entity.
+func (_u *FlagEnvironmentUpdate) AddPrerequisites(v ...*Prerequisite) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrerequisiteIDs(ids...)
+}
+
+// AddVersionIDs adds the "versions" edge to the FlagEnvironmentVersion entity by IDs.
+func (_u *FlagEnvironmentUpdate) AddVersionIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the FlagEnvironmentVersion entity.
+func (_u *FlagEnvironmentUpdate) AddVersions(v ...*FlagEnvironmentVersion) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
+// AddRolloutPlanIDs adds the "rollout_plans" edge to the RolloutPlan entity by IDs.
+func (_u *FlagEnvironmentUpdate) AddRolloutPlanIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.AddRolloutPlanIDs(ids...)
+	return _u
+}
+
+// AddRolloutPlans adds the "rollout_plans" edges to the RolloutPlan entity.
+func (_u *FlagEnvironmentUpdate) AddRolloutPlans(v ...*RolloutPlan) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRolloutPlanIDs(ids...)
+}
+
 // Mutation returns the FlagEnvironmentMutation object of the builder.
 func (_u *FlagEnvironmentUpdate) Mutation() *FlagEnvironmentMutation {
 	return _u.mutation
@@ -142,6 +211,69 @@ func (_u *FlagEnvironmentUpdate) RemoveStrategies(v ...*Strategy) *FlagEnvironme
 	return _u.RemoveStrategyIDs(ids...)
 }
 
+// ClearPrerequisites clears all "prerequisites" edges to the Prerequisite entity.
+func (_u *FlagEnvironmentUpdate) ClearPrerequisites() *FlagEnvironmentUpdate {
+	_u.mutation.ClearPrerequisites()
+	return _u
+}
+
+// RemovePrerequisiteIDs removes the "prerequisites" edge to Prerequisite entities by IDs.
+func (_u *FlagEnvironmentUpdate) RemovePrerequisiteIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.RemovePrerequisiteIDs(ids...)
+	return _u
+}
+
+// RemovePrerequisites removes "prerequisites" edges to Prerequisite entities.
+func (_u *FlagEnvironmentUpdate) RemovePrerequisites(v ...*Prerequisite) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrerequisiteIDs(ids...)
+}
+
+// ClearVersions clears all "versions" edges to the FlagEnvironmentVersion entity.
+func (_u *FlagEnvironmentUpdate) ClearVersions() *FlagEnvironmentUpdate {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to FlagEnvironmentVersion entities by IDs.
+func (_u *FlagEnvironmentUpdate) RemoveVersionIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to FlagEnvironmentVersion entities.
+func (_u *FlagEnvironmentUpdate) RemoveVersions(v ...*FlagEnvironmentVersion) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// ClearRolloutPlans clears all "rollout_plans" edges to the RolloutPlan entity.
+func (_u *FlagEnvironmentUpdate) ClearRolloutPlans() *FlagEnvironmentUpdate {
+	_u.mutation.ClearRolloutPlans()
+	return _u
+}
+
+// RemoveRolloutPlanIDs removes the "rollout_plans" edge to RolloutPlan entities by IDs.
+func (_u *FlagEnvironmentUpdate) RemoveRolloutPlanIDs(ids ...int) *FlagEnvironmentUpdate {
+	_u.mutation.RemoveRolloutPlanIDs(ids...)
+	return _u
+}
+
+// RemoveRolloutPlans removes "rollout_plans" edges to RolloutPlan entities.
+func (_u *FlagEnvironmentUpdate) RemoveRolloutPlans(v ...*RolloutPlan) *FlagEnvironmentUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRolloutPlanIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *FlagEnvironmentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -207,6 +339,12 @@ func (_u *FlagEnvironmentUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flagenvironment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(flagenvironment.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(flagenvironment.FieldRevision, field.TypeInt, value)
+	}
 	if _u.mutation.FlagCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -310,6 +448,141 @@ func (_u *FlagEnvironmentUpdate) sqlSave(ctx context.Context) (_node int, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrerequisitesIDs(); len(nodes) > 0 && !_u.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolloutPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolloutPlansIDs(); len(nodes) > 0 && !_u.mutation.RolloutPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolloutPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{flagenvironment.Label}
@@ -378,6 +651,27 @@ func (_u *FlagEnvironmentUpdateOne) SetUpdatedAt(v time.Time) *FlagEnvironmentUp
 	return _u
 }
 
+// SetRevision sets the "revision" field.
+func (_u *FlagEnvironmentUpdateOne) SetRevision(v int) *FlagEnvironmentUpdateOne {
+	_u.mutation.ResetRevision()
+	_u.mutation.SetRevision(v)
+	return _u
+}
+
+// SetNillableRevision sets the "revision" field if the given value is not nil.
+func (_u *FlagEnvironmentUpdateOne) SetNillableRevision(v *int) *FlagEnvironmentUpdateOne {
+	if v != nil {
+		_u.SetRevision(*v)
+	}
+	return _u
+}
+
+// AddRevision adds value to the "revision" field.
+func (_u *FlagEnvironmentUpdateOne) AddRevision(v int) *FlagEnvironmentUpdateOne {
+	_u.mutation.AddRevision(v)
+	return _u
+}
+
 // SetFlag sets the "flag" edge to the Flag entity.
 func (_u *FlagEnvironmentUpdateOne) SetFlag(v *Flag) *FlagEnvironmentUpdateOne {
 	return _u.SetFlagID(v.ID)
@@ -403,6 +697,51 @@ func (_u *FlagEnvironmentUpdateOne) AddStrategies(v ...*Strategy) *FlagEnvironme
 	return _u.AddStrategyIDs(ids...)
 }
 
+// AddPrerequisiteIDs adds the "prerequisites" edge to the Prerequisite entity by IDs.
+func (_u *FlagEnvironmentUpdateOne) AddPrerequisiteIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.AddPrerequisiteIDs(ids...)
+	return _u
+}
+
+// AddPrerequisites adds the "prerequisites" edges to the Prerequisite entity.
+func (_u *FlagEnvironmentUpdateOne) AddPrerequisites(v ...*Prerequisite) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPrerequisiteIDs(ids...)
+}
+
+// AddVersionIDs adds the "versions" edge to the FlagEnvironmentVersion entity by IDs.
+func (_u *FlagEnvironmentUpdateOne) AddVersionIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.AddVersionIDs(ids...)
+	return _u
+}
+
+// AddVersions adds the "versions" edges to the FlagEnvironmentVersion entity.
+func (_u *FlagEnvironmentUpdateOne) AddVersions(v ...*FlagEnvironmentVersion) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVersionIDs(ids...)
+}
+
+// AddRolloutPlanIDs adds the "rollout_plans" edge to the RolloutPlan entity by IDs.
+func (_u *FlagEnvironmentUpdateOne) AddRolloutPlanIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.AddRolloutPlanIDs(ids...)
+	return _u
+}
+
+// AddRolloutPlans adds the "rollout_plans" edges to the RolloutPlan entity.
+func (_u *FlagEnvironmentUpdateOne) AddRolloutPlans(v ...*RolloutPlan) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRolloutPlanIDs(ids...)
+}
+
 // Mutation returns the FlagEnvironmentMutation object of the builder.
 func (_u *FlagEnvironmentUpdateOne) Mutation() *FlagEnvironmentMutation {
 	return _u.mutation
@@ -441,6 +780,69 @@ func (_u *FlagEnvironmentUpdateOne) RemoveStrategies(v ...*Strategy) *FlagEnviro
 	return _u.RemoveStrategyIDs(ids...)
 }
 
+// ClearPrerequisites clears all "prerequisites" edges to the Prerequisite entity.
+func (_u *FlagEnvironmentUpdateOne) ClearPrerequisites() *FlagEnvironmentUpdateOne {
+	_u.mutation.ClearPrerequisites()
+	return _u
+}
+
+// RemovePrerequisiteIDs removes the "prerequisites" edge to Prerequisite entities by IDs.
+func (_u *FlagEnvironmentUpdateOne) RemovePrerequisiteIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.RemovePrerequisiteIDs(ids...)
+	return _u
+}
+
+// RemovePrerequisites removes "prerequisites" edges to Prerequisite entities.
+func (_u *FlagEnvironmentUpdateOne) RemovePrerequisites(v ...*Prerequisite) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePrerequisiteIDs(ids...)
+}
+
+// ClearVersions clears all "versions" edges to the FlagEnvironmentVersion entity.
+func (_u *FlagEnvironmentUpdateOne) ClearVersions() *FlagEnvironmentUpdateOne {
+	_u.mutation.ClearVersions()
+	return _u
+}
+
+// RemoveVersionIDs removes the "versions" edge to FlagEnvironmentVersion entities by IDs.
+func (_u *FlagEnvironmentUpdateOne) RemoveVersionIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.RemoveVersionIDs(ids...)
+	return _u
+}
+
+// RemoveVersions removes "versions" edges to FlagEnvironmentVersion entities.
+func (_u *FlagEnvironmentUpdateOne) RemoveVersions(v ...*FlagEnvironmentVersion) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVersionIDs(ids...)
+}
+
+// ClearRolloutPlans clears all "rollout_plans" edges to the RolloutPlan entity.
+func (_u *FlagEnvironmentUpdateOne) ClearRolloutPlans() *FlagEnvironmentUpdateOne {
+	_u.mutation.ClearRolloutPlans()
+	return _u
+}
+
+// RemoveRolloutPlanIDs removes the "rollout_plans" edge to RolloutPlan entities by IDs.
+func (_u *FlagEnvironmentUpdateOne) RemoveRolloutPlanIDs(ids ...int) *FlagEnvironmentUpdateOne {
+	_u.mutation.RemoveRolloutPlanIDs(ids...)
+	return _u
+}
+
+// RemoveRolloutPlans removes "rollout_plans" edges to RolloutPlan entities.
+func (_u *FlagEnvironmentUpdateOne) RemoveRolloutPlans(v ...*RolloutPlan) *FlagEnvironmentUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRolloutPlanIDs(ids...)
+}
+
 // Where appends a list predicates to the FlagEnvironmentUpdate builder.
 func (_u *FlagEnvironmentUpdateOne) Where(ps ...predicate.FlagEnvironment) *FlagEnvironmentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -536,6 +938,12 @@ func (_u *FlagEnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *FlagEnv
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(flagenvironment.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.Revision(); ok {
+		_spec.SetField(flagenvironment.FieldRevision, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevision(); ok {
+		_spec.AddField(flagenvironment.FieldRevision, field.TypeInt, value)
+	}
 	if _u.mutation.FlagCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -639,6 +1047,141 @@ func (_u *FlagEnvironmentUpdateOne) sqlSave(ctx context.Context) (_node *FlagEnv
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPrerequisitesIDs(); len(nodes) > 0 && !_u.mutation.PrerequisitesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrerequisitesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.PrerequisitesTable,
+			Columns: []string{flagenvironment.PrerequisitesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVersionsIDs(); len(nodes) > 0 && !_u.mutation.VersionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VersionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.VersionsTable,
+			Columns: []string{flagenvironment.VersionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.RolloutPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRolloutPlansIDs(); len(nodes) > 0 && !_u.mutation.RolloutPlansCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RolloutPlansIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   flagenvironment.RolloutPlansTable,
+			Columns: []string{flagenvironment.RolloutPlansColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &FlagEnvironment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+)
+
+// FlagEnvironmentVersion is the model entity for the FlagEnvironmentVersion schema.
+type FlagEnvironmentVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FlagEnvironmentID holds the value of the "flag_environment_id" field.
+	FlagEnvironmentID int `json:"flag_environment_id,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// Snapshot holds the value of the "snapshot" field.
+	Snapshot json.RawMessage `json:"snapshot,omitempty"`
+	// Actor holds the value of the "actor" field.
+	Actor string `json:"actor,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FlagEnvironmentVersionQuery when eager-loading is set.
+	Edges        FlagEnvironmentVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FlagEnvironmentVersionEdges holds the relations/edges for other nodes in the graph.
+type FlagEnvironmentVersionEdges struct {
+	// FlagEnvironment holds the value of the flag_environment edge.
+	FlagEnvironment *FlagEnvironment `json:"flag_environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlagEnvironmentOrErr returns the FlagEnvironment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FlagEnvironmentVersionEdges) FlagEnvironmentOrErr() (*FlagEnvironment, error) {
+	if e.FlagEnvironment != nil {
+		return e.FlagEnvironment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flagenvironment.Label}
+	}
+	return nil, &NotLoadedError{edge: "flag_environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*FlagEnvironmentVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case flagenvironmentversion.FieldSnapshot:
+			values[i] = new([]byte)
+		case flagenvironmentversion.FieldID, flagenvironmentversion.FieldFlagEnvironmentID, flagenvironmentversion.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case flagenvironmentversion.FieldActor, flagenvironmentversion.FieldReason:
+			values[i] = new(sql.NullString)
+		case flagenvironmentversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the FlagEnvironmentVersion fields.
+func (_m *FlagEnvironmentVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case flagenvironmentversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case flagenvironmentversion.FieldFlagEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flag_environment_id", values[i])
+			} else if value.Valid {
+				_m.FlagEnvironmentID = int(value.Int64)
+			}
+		case flagenvironmentversion.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				_m.Version = int(value.Int64)
+			}
+		case flagenvironmentversion.FieldSnapshot:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field snapshot", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Snapshot); err != nil {
+					return fmt.Errorf("unmarshal field snapshot: %w", err)
+				}
+			}
+		case flagenvironmentversion.FieldActor:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field actor", values[i])
+			} else if value.Valid {
+				_m.Actor = value.String
+			}
+		case flagenvironmentversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case flagenvironmentversion.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the FlagEnvironmentVersion.
+// This includes values selected through modifiers, order, etc.
+func (_m *FlagEnvironmentVersion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlagEnvironment queries the "flag_environment" edge of the FlagEnvironmentVersion entity.
+func (_m *FlagEnvironmentVersion) QueryFlagEnvironment() *FlagEnvironmentQuery {
+	return NewFlagEnvironmentVersionClient(_m.config).QueryFlagEnvironment(_m)
+}
+
+// Update returns a builder for updating this FlagEnvironmentVersion.
+// Note that you need to call FlagEnvironmentVersion.Unwrap() before calling this method if this FlagEnvironmentVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *FlagEnvironmentVersion) Update() *FlagEnvironmentVersionUpdateOne {
+	return NewFlagEnvironmentVersionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the FlagEnvironmentVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *FlagEnvironmentVersion) Unwrap() *FlagEnvironmentVersion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: FlagEnvironmentVersion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *FlagEnvironmentVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("FlagEnvironmentVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flag_environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlagEnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Version))
+	builder.WriteString(", ")
+	builder.WriteString("snapshot=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Snapshot))
+	builder.WriteString(", ")
+	builder.WriteString("actor=")
+	builder.WriteString(_m.Actor)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// FlagEnvironmentVersions is a parsable slice of FlagEnvironmentVersion.
+type FlagEnvironmentVersions []*FlagEnvironmentVersion
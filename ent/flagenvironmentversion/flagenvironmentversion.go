@@ -0,0 +1,113 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flagenvironmentversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the flagenvironmentversion type in the database.
+	Label = "flag_environment_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlagEnvironmentID holds the string denoting the flag_environment_id field in the database.
+	FieldFlagEnvironmentID = "flag_environment_id"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldSnapshot holds the string denoting the snapshot field in the database.
+	FieldSnapshot = "snapshot"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// EdgeFlagEnvironment holds the string denoting the flag_environment edge name in mutations.
+	EdgeFlagEnvironment = "flag_environment"
+	// Table holds the table name of the flagenvironmentversion in the database.
+	Table = "flag_environment_versions"
+	// FlagEnvironmentTable is the table that holds the flag_environment relation/edge.
+	FlagEnvironmentTable = "flag_environment_versions"
+	// FlagEnvironmentInverseTable is the table name for the FlagEnvironment entity.
+	// It exists in this package in order to avoid circular dependency with the "flagenvironment" package.
+	FlagEnvironmentInverseTable = "flag_environments"
+	// FlagEnvironmentColumn is the table column denoting the flag_environment relation/edge.
+	FlagEnvironmentColumn = "flag_environment_id"
+)
+
+// Columns holds all SQL columns for flagenvironmentversion fields.
+var Columns = []string{
+	FieldID,
+	FieldFlagEnvironmentID,
+	FieldVersion,
+	FieldSnapshot,
+	FieldActor,
+	FieldCreatedAt,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the FlagEnvironmentVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentID orders the results by the flag_environment_id field.
+func ByFlagEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlagEnvironmentID, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentField orders the results by flag_environment field.
+func ByFlagEnvironmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlagEnvironmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlagEnvironmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlagEnvironmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+	)
+}
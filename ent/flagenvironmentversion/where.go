@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package flagenvironmentversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLTE(FieldID, id))
+}
+
+// FlagEnvironmentID applies equality check predicate on the "flag_environment_id" field. It's identical to FlagEnvironmentIDEQ.
+func FlagEnvironmentID(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldActor, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldReason, v))
+}
+
+// FlagEnvironmentIDEQ applies the EQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDEQ(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDNEQ applies the NEQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNEQ(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDIn applies the In predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDIn(vs ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldFlagEnvironmentID, vs...))
+}
+
+// FlagEnvironmentIDNotIn applies the NotIn predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNotIn(vs ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldFlagEnvironmentID, vs...))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLTE(FieldVersion, v))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldContainsFold(FieldActor, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.FieldContainsFold(FieldReason, v))
+}
+
+// HasFlagEnvironment applies the HasEdge predicate on the "flag_environment" edge.
+func HasFlagEnvironment() predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlagEnvironmentWith applies the HasEdge predicate on the "flag_environment" edge with a given conditions (other predicates).
+func HasFlagEnvironmentWith(preds ...predicate.FlagEnvironment) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(func(s *sql.Selector) {
+		step := newFlagEnvironmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.FlagEnvironmentVersion) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.FlagEnvironmentVersion) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.FlagEnvironmentVersion) predicate.FlagEnvironmentVersion {
+	return predicate.FlagEnvironmentVersion(sql.NotPredicates(p))
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+)
+
+// FlagEnvironmentVersionCreate is the builder for creating a FlagEnvironmentVersion entity.
+type FlagEnvironmentVersionCreate struct {
+	config
+	mutation *FlagEnvironmentVersionMutation
+	hooks    []Hook
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_c *FlagEnvironmentVersionCreate) SetFlagEnvironmentID(v int) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetFlagEnvironmentID(v)
+	return _c
+}
+
+// SetVersion sets the "version" field.
+func (_c *FlagEnvironmentVersionCreate) SetVersion(v int) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetVersion(v)
+	return _c
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (_c *FlagEnvironmentVersionCreate) SetSnapshot(v json.RawMessage) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetSnapshot(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *FlagEnvironmentVersionCreate) SetActor(v string) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *FlagEnvironmentVersionCreate) SetNillableActor(v *string) *FlagEnvironmentVersionCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *FlagEnvironmentVersionCreate) SetCreatedAt(v time.Time) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *FlagEnvironmentVersionCreate) SetNillableCreatedAt(v *time.Time) *FlagEnvironmentVersionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *FlagEnvironmentVersionCreate) SetReason(v string) *FlagEnvironmentVersionCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *FlagEnvironmentVersionCreate) SetNillableReason(v *string) *FlagEnvironmentVersionCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_c *FlagEnvironmentVersionCreate) SetFlagEnvironment(v *FlagEnvironment) *FlagEnvironmentVersionCreate {
+	return _c.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the FlagEnvironmentVersionMutation object of the builder.
+func (_c *FlagEnvironmentVersionCreate) Mutation() *FlagEnvironmentVersionMutation {
+	return _c.mutation
+}
+
+// Save creates the FlagEnvironmentVersion in the database.
+func (_c *FlagEnvironmentVersionCreate) Save(ctx context.Context) (*FlagEnvironmentVersion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *FlagEnvironmentVersionCreate) SaveX(ctx context.Context) *FlagEnvironmentVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlagEnvironmentVersionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlagEnvironmentVersionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *FlagEnvironmentVersionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := flagenvironmentversion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *FlagEnvironmentVersionCreate) check() error {
+	if _, ok := _c.mutation.FlagEnvironmentID(); !ok {
+		return &ValidationError{Name: "flag_environment_id", err: errors.New(`ent: missing required field "FlagEnvironmentVersion.flag_environment_id"`)}
+	}
+	if _, ok := _c.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "FlagEnvironmentVersion.version"`)}
+	}
+	if _, ok := _c.mutation.Snapshot(); !ok {
+		return &ValidationError{Name: "snapshot", err: errors.New(`ent: missing required field "FlagEnvironmentVersion.snapshot"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "FlagEnvironmentVersion.created_at"`)}
+	}
+	if len(_c.mutation.FlagEnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "flag_environment", err: errors.New(`ent: missing required edge "FlagEnvironmentVersion.flag_environment"`)}
+	}
+	return nil
+}
+
+func (_c *FlagEnvironmentVersionCreate) sqlSave(ctx context.Context) (*FlagEnvironmentVersion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *FlagEnvironmentVersionCreate) createSpec() (*FlagEnvironmentVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &FlagEnvironmentVersion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(flagenvironmentversion.Table, sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Version(); ok {
+		_spec.SetField(flagenvironmentversion.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := _c.mutation.Snapshot(); ok {
+		_spec.SetField(flagenvironmentversion.FieldSnapshot, field.TypeJSON, value)
+		_node.Snapshot = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(flagenvironmentversion.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(flagenvironmentversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(flagenvironmentversion.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if nodes := _c.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flagenvironmentversion.FlagEnvironmentTable,
+			Columns: []string{flagenvironmentversion.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlagEnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FlagEnvironmentVersionCreateBulk is the builder for creating many FlagEnvironmentVersion entities in bulk.
+type FlagEnvironmentVersionCreateBulk struct {
+	config
+	err      error
+	builders []*FlagEnvironmentVersionCreate
+}
+
+// Save creates the FlagEnvironmentVersion entities in the database.
+func (_c *FlagEnvironmentVersionCreateBulk) Save(ctx context.Context) ([]*FlagEnvironmentVersion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*FlagEnvironmentVersion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FlagEnvironmentVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *FlagEnvironmentVersionCreateBulk) SaveX(ctx context.Context) []*FlagEnvironmentVersion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *FlagEnvironmentVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *FlagEnvironmentVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+)
+
+// FlagEnvironmentVersionDelete is the builder for deleting a FlagEnvironmentVersion entity.
+type FlagEnvironmentVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *FlagEnvironmentVersionMutation
+}
+
+// Where appends a list predicates to the FlagEnvironmentVersionDelete builder.
+func (_d *FlagEnvironmentVersionDelete) Where(ps ...predicate.FlagEnvironmentVersion) *FlagEnvironmentVersionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *FlagEnvironmentVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlagEnvironmentVersionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *FlagEnvironmentVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(flagenvironmentversion.Table, sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// FlagEnvironmentVersionDeleteOne is the builder for deleting a single FlagEnvironmentVersion entity.
+type FlagEnvironmentVersionDeleteOne struct {
+	_d *FlagEnvironmentVersionDelete
+}
+
+// Where appends a list predicates to the FlagEnvironmentVersionDelete builder.
+func (_d *FlagEnvironmentVersionDeleteOne) Where(ps ...predicate.FlagEnvironmentVersion) *FlagEnvironmentVersionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *FlagEnvironmentVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{flagenvironmentversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *FlagEnvironmentVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
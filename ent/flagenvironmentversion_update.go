@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+)
+
+// FlagEnvironmentVersionUpdate is the builder for updating FlagEnvironmentVersion entities.
+type FlagEnvironmentVersionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FlagEnvironmentVersionMutation
+}
+
+// Where appends a list predicates to the FlagEnvironmentVersionUpdate builder.
+func (_u *FlagEnvironmentVersionUpdate) Where(ps ...predicate.FlagEnvironmentVersion) *FlagEnvironmentVersionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *FlagEnvironmentVersionUpdate) SetFlagEnvironmentID(v int) *FlagEnvironmentVersionUpdate {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *FlagEnvironmentVersionUpdate) SetNillableFlagEnvironmentID(v *int) *FlagEnvironmentVersionUpdate {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *FlagEnvironmentVersionUpdate) SetFlagEnvironment(v *FlagEnvironment) *FlagEnvironmentVersionUpdate {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the FlagEnvironmentVersionMutation object of the builder.
+func (_u *FlagEnvironmentVersionUpdate) Mutation() *FlagEnvironmentVersionMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *FlagEnvironmentVersionUpdate) ClearFlagEnvironment() *FlagEnvironmentVersionUpdate {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *FlagEnvironmentVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlagEnvironmentVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *FlagEnvironmentVersionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlagEnvironmentVersionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlagEnvironmentVersionUpdate) check() error {
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlagEnvironmentVersion.flag_environment"`)
+	}
+	return nil
+}
+
+func (_u *FlagEnvironmentVersionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flagenvironmentversion.Table, flagenvironmentversion.Columns, sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(flagenvironmentversion.FieldActor, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(flagenvironmentversion.FieldReason, field.TypeString)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flagenvironmentversion.FlagEnvironmentTable,
+			Columns: []string{flagenvironmentversion.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flagenvironmentversion.FlagEnvironmentTable,
+			Columns: []string{flagenvironmentversion.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flagenvironmentversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// FlagEnvironmentVersionUpdateOne is the builder for updating a single FlagEnvironmentVersion entity.
+type FlagEnvironmentVersionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FlagEnvironmentVersionMutation
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *FlagEnvironmentVersionUpdateOne) SetFlagEnvironmentID(v int) *FlagEnvironmentVersionUpdateOne {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *FlagEnvironmentVersionUpdateOne) SetNillableFlagEnvironmentID(v *int) *FlagEnvironmentVersionUpdateOne {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *FlagEnvironmentVersionUpdateOne) SetFlagEnvironment(v *FlagEnvironment) *FlagEnvironmentVersionUpdateOne {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the FlagEnvironmentVersionMutation object of the builder.
+func (_u *FlagEnvironmentVersionUpdateOne) Mutation() *FlagEnvironmentVersionMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *FlagEnvironmentVersionUpdateOne) ClearFlagEnvironment() *FlagEnvironmentVersionUpdateOne {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// Where appends a list predicates to the FlagEnvironmentVersionUpdate builder.
+func (_u *FlagEnvironmentVersionUpdateOne) Where(ps ...predicate.FlagEnvironmentVersion) *FlagEnvironmentVersionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *FlagEnvironmentVersionUpdateOne) Select(field string, fields ...string) *FlagEnvironmentVersionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated FlagEnvironmentVersion entity.
+func (_u *FlagEnvironmentVersionUpdateOne) Save(ctx context.Context) (*FlagEnvironmentVersion, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *FlagEnvironmentVersionUpdateOne) SaveX(ctx context.Context) *FlagEnvironmentVersion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *FlagEnvironmentVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *FlagEnvironmentVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *FlagEnvironmentVersionUpdateOne) check() error {
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "FlagEnvironmentVersion.flag_environment"`)
+	}
+	return nil
+}
+
+func (_u *FlagEnvironmentVersionUpdateOne) sqlSave(ctx context.Context) (_node *FlagEnvironmentVersion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(flagenvironmentversion.Table, flagenvironmentversion.Columns, sqlgraph.NewFieldSpec(flagenvironmentversion.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "FlagEnvironmentVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, flagenvironmentversion.FieldID)
+		for _, f := range fields {
+			if !flagenvironmentversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != flagenvironmentversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(flagenvironmentversion.FieldActor, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(flagenvironmentversion.FieldReason, field.TypeString)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flagenvironmentversion.FlagEnvironmentTable,
+			Columns: []string{flagenvironmentversion.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   flagenvironmentversion.FlagEnvironmentTable,
+			Columns: []string{flagenvironmentversion.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &FlagEnvironmentVersion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{flagenvironmentversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
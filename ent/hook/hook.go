@@ -33,6 +33,18 @@ func (f ConstraintFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConstraintMutation", m)
 }
 
+// The ContextFieldFunc type is an adapter to allow the use of ordinary
+// function as ContextField mutator.
+type ContextFieldFunc func(context.Context, *ent.ContextFieldMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ContextFieldFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ContextFieldMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ContextFieldMutation", m)
+}
+
 // The EnvironmentFunc type is an adapter to allow the use of ordinary
 // function as Environment mutator.
 type EnvironmentFunc func(context.Context, *ent.EnvironmentMutation) (ent.Value, error)
@@ -69,6 +81,30 @@ func (f FlagEnvironmentFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlagEnvironmentMutation", m)
 }
 
+// The FlagEnvironmentVersionFunc type is an adapter to allow the use of ordinary
+// function as FlagEnvironmentVersion mutator.
+type FlagEnvironmentVersionFunc func(context.Context, *ent.FlagEnvironmentVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f FlagEnvironmentVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.FlagEnvironmentVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.FlagEnvironmentVersionMutation", m)
+}
+
+// The PrerequisiteFunc type is an adapter to allow the use of ordinary
+// function as Prerequisite mutator.
+type PrerequisiteFunc func(context.Context, *ent.PrerequisiteMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PrerequisiteFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PrerequisiteMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PrerequisiteMutation", m)
+}
+
 // The ProjectFunc type is an adapter to allow the use of ordinary
 // function as Project mutator.
 type ProjectFunc func(context.Context, *ent.ProjectMutation) (ent.Value, error)
@@ -81,6 +117,18 @@ func (f ProjectFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProjectMutation", m)
 }
 
+// The RolloutPlanFunc type is an adapter to allow the use of ordinary
+// function as RolloutPlan mutator.
+type RolloutPlanFunc func(context.Context, *ent.RolloutPlanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RolloutPlanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RolloutPlanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RolloutPlanMutation", m)
+}
+
 // The StrategyFunc type is an adapter to allow the use of ordinary
 // function as Strategy mutator.
 type StrategyFunc func(context.Context, *ent.StrategyMutation) (ent.Value, error)
@@ -93,6 +141,30 @@ func (f StrategyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StrategyMutation", m)
 }
 
+// The StrategyDefinitionFunc type is an adapter to allow the use of ordinary
+// function as StrategyDefinition mutator.
+type StrategyDefinitionFunc func(context.Context, *ent.StrategyDefinitionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f StrategyDefinitionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.StrategyDefinitionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StrategyDefinitionMutation", m)
+}
+
+// The TagFunc type is an adapter to allow the use of ordinary
+// function as Tag mutator.
+type TagFunc func(context.Context, *ent.TagMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TagFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TagMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TagMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -45,7 +45,7 @@ var (
 	ConstraintsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "context_name", Type: field.TypeString},
-		{Name: "operator", Type: field.TypeEnum, Enums: []string{"IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH", "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE", "DATE_AFTER", "DATE_BEFORE"}},
+		{Name: "operator", Type: field.TypeEnum, Enums: []string{"IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH", "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE", "DATE_AFTER", "DATE_BEFORE", "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT", "REGEX", "IN_CIDR", "ANY_OF", "ALL_OF"}},
 		{Name: "values", Type: field.TypeJSON},
 		{Name: "inverted", Type: field.TypeBool, Default: false},
 		{Name: "case_insensitive", Type: field.TypeBool, Default: false},
@@ -67,6 +67,38 @@ var (
 			},
 		},
 	}
+	// ContextFieldsColumns holds the columns for the "context_fields" table.
+	ContextFieldsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"string", "number", "date", "semver", "ip"}, Default: "string"},
+		{Name: "legal_values", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// ContextFieldsTable holds the schema information for the "context_fields" table.
+	ContextFieldsTable = &schema.Table{
+		Name:       "context_fields",
+		Columns:    ContextFieldsColumns,
+		PrimaryKey: []*schema.Column{ContextFieldsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "context_fields_projects_context_fields",
+				Columns:    []*schema.Column{ContextFieldsColumns[7]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "contextfield_name_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{ContextFieldsColumns[1], ContextFieldsColumns[7]},
+			},
+		},
+	}
 	// EnvironmentsColumns holds the columns for the "environments" table.
 	EnvironmentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -75,6 +107,11 @@ var (
 		{Name: "sort_order", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "frozen_by", Type: field.TypeString, Nullable: true},
+		{Name: "freeze_reason", Type: field.TypeString, Nullable: true},
+		{Name: "expected_match_id", Type: field.TypeInt, Nullable: true},
 		{Name: "project_id", Type: field.TypeInt},
 	}
 	// EnvironmentsTable holds the schema information for the "environments" table.
@@ -83,9 +120,15 @@ var (
 		Columns:    EnvironmentsColumns,
 		PrimaryKey: []*schema.Column{EnvironmentsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "environments_environments_matched_by",
+				Columns:    []*schema.Column{EnvironmentsColumns[10]},
+				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "environments_projects_environments",
-				Columns:    []*schema.Column{EnvironmentsColumns[6]},
+				Columns:    []*schema.Column{EnvironmentsColumns[11]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -94,7 +137,7 @@ var (
 			{
 				Name:    "environment_name_project_id",
 				Unique:  true,
-				Columns: []*schema.Column{EnvironmentsColumns[1], EnvironmentsColumns[6]},
+				Columns: []*schema.Column{EnvironmentsColumns[1], EnvironmentsColumns[11]},
 			},
 		},
 	}
@@ -106,6 +149,10 @@ var (
 		{Name: "flag_type", Type: field.TypeEnum, Enums: []string{"release", "experiment", "operational", "kill_switch"}},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "owner", Type: field.TypeString, Nullable: true},
+		{Name: "links", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "project_id", Type: field.TypeInt},
 	}
 	// FlagsTable holds the schema information for the "flags" table.
@@ -116,7 +163,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "flags_projects_flags",
-				Columns:    []*schema.Column{FlagsColumns[6]},
+				Columns:    []*schema.Column{FlagsColumns[10]},
 				RefColumns: []*schema.Column{ProjectsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -125,7 +172,7 @@ var (
 			{
 				Name:    "flag_name_project_id",
 				Unique:  true,
-				Columns: []*schema.Column{FlagsColumns[1], FlagsColumns[6]},
+				Columns: []*schema.Column{FlagsColumns[1], FlagsColumns[10]},
 			},
 		},
 	}
@@ -135,6 +182,7 @@ var (
 		{Name: "enabled", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "revision", Type: field.TypeInt, Default: 1},
 		{Name: "environment_id", Type: field.TypeInt},
 		{Name: "flag_id", Type: field.TypeInt},
 	}
@@ -146,13 +194,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "flag_environments_environments_flag_environments",
-				Columns:    []*schema.Column{FlagEnvironmentsColumns[4]},
+				Columns:    []*schema.Column{FlagEnvironmentsColumns[5]},
 				RefColumns: []*schema.Column{EnvironmentsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "flag_environments_flags_flag_environments",
-				Columns:    []*schema.Column{FlagEnvironmentsColumns[5]},
+				Columns:    []*schema.Column{FlagEnvironmentsColumns[6]},
 				RefColumns: []*schema.Column{FlagsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -161,7 +209,74 @@ var (
 			{
 				Name:    "flagenvironment_flag_id_environment_id",
 				Unique:  true,
-				Columns: []*schema.Column{FlagEnvironmentsColumns[5], FlagEnvironmentsColumns[4]},
+				Columns: []*schema.Column{FlagEnvironmentsColumns[6], FlagEnvironmentsColumns[5]},
+			},
+		},
+	}
+	// FlagEnvironmentVersionsColumns holds the columns for the "flag_environment_versions" table.
+	FlagEnvironmentVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "version", Type: field.TypeInt},
+		{Name: "snapshot", Type: field.TypeJSON},
+		{Name: "actor", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reason", Type: field.TypeString, Nullable: true},
+		{Name: "flag_environment_id", Type: field.TypeInt},
+	}
+	// FlagEnvironmentVersionsTable holds the schema information for the "flag_environment_versions" table.
+	FlagEnvironmentVersionsTable = &schema.Table{
+		Name:       "flag_environment_versions",
+		Columns:    FlagEnvironmentVersionsColumns,
+		PrimaryKey: []*schema.Column{FlagEnvironmentVersionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flag_environment_versions_flag_environments_versions",
+				Columns:    []*schema.Column{FlagEnvironmentVersionsColumns[6]},
+				RefColumns: []*schema.Column{FlagEnvironmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "flagenvironmentversion_flag_environment_id_version",
+				Unique:  true,
+				Columns: []*schema.Column{FlagEnvironmentVersionsColumns[6], FlagEnvironmentVersionsColumns[1]},
+			},
+		},
+	}
+	// PrerequisitesColumns holds the columns for the "prerequisites" table.
+	PrerequisitesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "variant", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "parent_flag_id", Type: field.TypeInt},
+		{Name: "flag_environment_id", Type: field.TypeInt},
+	}
+	// PrerequisitesTable holds the schema information for the "prerequisites" table.
+	PrerequisitesTable = &schema.Table{
+		Name:       "prerequisites",
+		Columns:    PrerequisitesColumns,
+		PrimaryKey: []*schema.Column{PrerequisitesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "prerequisites_flags_dependents",
+				Columns:    []*schema.Column{PrerequisitesColumns[4]},
+				RefColumns: []*schema.Column{FlagsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "prerequisites_flag_environments_prerequisites",
+				Columns:    []*schema.Column{PrerequisitesColumns[5]},
+				RefColumns: []*schema.Column{FlagEnvironmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "prerequisite_flag_environment_id_parent_flag_id",
+				Unique:  true,
+				Columns: []*schema.Column{PrerequisitesColumns[5], PrerequisitesColumns[4]},
 			},
 		},
 	}
@@ -172,6 +287,10 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "frozen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "frozen_by", Type: field.TypeString, Nullable: true},
+		{Name: "freeze_reason", Type: field.TypeString, Nullable: true},
 	}
 	// ProjectsTable holds the schema information for the "projects" table.
 	ProjectsTable = &schema.Table{
@@ -179,6 +298,47 @@ var (
 		Columns:    ProjectsColumns,
 		PrimaryKey: []*schema.Column{ProjectsColumns[0]},
 	}
+	// RolloutPlansColumns holds the columns for the "rollout_plans" table.
+	RolloutPlansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "strategy_id", Type: field.TypeInt},
+		{Name: "steps", Type: field.TypeJSON},
+		{Name: "current_step", Type: field.TypeInt, Default: 0},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "paused", "completed", "aborted"}, Default: "running"},
+		{Name: "step_started_at", Type: field.TypeTime},
+		{Name: "paused_at", Type: field.TypeTime, Nullable: true},
+		{Name: "status_reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "flag_environment_id", Type: field.TypeInt},
+	}
+	// RolloutPlansTable holds the schema information for the "rollout_plans" table.
+	RolloutPlansTable = &schema.Table{
+		Name:       "rollout_plans",
+		Columns:    RolloutPlansColumns,
+		PrimaryKey: []*schema.Column{RolloutPlansColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "rollout_plans_flag_environments_rollout_plans",
+				Columns:    []*schema.Column{RolloutPlansColumns[11]},
+				RefColumns: []*schema.Column{FlagEnvironmentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "rolloutplan_strategy_id",
+				Unique:  false,
+				Columns: []*schema.Column{RolloutPlansColumns[1]},
+			},
+			{
+				Name:    "rolloutplan_status",
+				Unique:  false,
+				Columns: []*schema.Column{RolloutPlansColumns[4]},
+			},
+		},
+	}
 	// StrategiesColumns holds the columns for the "strategies" table.
 	StrategiesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -203,6 +363,65 @@ var (
 			},
 		},
 	}
+	// StrategyDefinitionsColumns holds the columns for the "strategy_definitions" table.
+	StrategyDefinitionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "parameters", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// StrategyDefinitionsTable holds the schema information for the "strategy_definitions" table.
+	StrategyDefinitionsTable = &schema.Table{
+		Name:       "strategy_definitions",
+		Columns:    StrategyDefinitionsColumns,
+		PrimaryKey: []*schema.Column{StrategyDefinitionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "strategy_definitions_projects_strategy_definitions",
+				Columns:    []*schema.Column{StrategyDefinitionsColumns[6]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "strategydefinition_name_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{StrategyDefinitionsColumns[1], StrategyDefinitionsColumns[6]},
+			},
+		},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "project_id", Type: field.TypeInt},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "tags_projects_tags",
+				Columns:    []*schema.Column{TagsColumns[3]},
+				RefColumns: []*schema.Column{ProjectsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tag_name_project_id",
+				Unique:  true,
+				Columns: []*schema.Column{TagsColumns[1], TagsColumns[3]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -219,16 +438,48 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// FlagTagsColumns holds the columns for the "flag_tags" table.
+	FlagTagsColumns = []*schema.Column{
+		{Name: "flag_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// FlagTagsTable holds the schema information for the "flag_tags" table.
+	FlagTagsTable = &schema.Table{
+		Name:       "flag_tags",
+		Columns:    FlagTagsColumns,
+		PrimaryKey: []*schema.Column{FlagTagsColumns[0], FlagTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "flag_tags_flag_id",
+				Columns:    []*schema.Column{FlagTagsColumns[0]},
+				RefColumns: []*schema.Column{FlagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "flag_tags_tag_id",
+				Columns:    []*schema.Column{FlagTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		APITokensTable,
 		ConstraintsTable,
+		ContextFieldsTable,
 		EnvironmentsTable,
 		FlagsTable,
 		FlagEnvironmentsTable,
+		FlagEnvironmentVersionsTable,
+		PrerequisitesTable,
 		ProjectsTable,
+		RolloutPlansTable,
 		StrategiesTable,
+		StrategyDefinitionsTable,
+		TagsTable,
 		UsersTable,
+		FlagTagsTable,
 	}
 )
 
@@ -236,9 +487,19 @@ func init() {
 	APITokensTable.ForeignKeys[0].RefTable = ProjectsTable
 	APITokensTable.ForeignKeys[1].RefTable = UsersTable
 	ConstraintsTable.ForeignKeys[0].RefTable = StrategiesTable
-	EnvironmentsTable.ForeignKeys[0].RefTable = ProjectsTable
+	ContextFieldsTable.ForeignKeys[0].RefTable = ProjectsTable
+	EnvironmentsTable.ForeignKeys[0].RefTable = EnvironmentsTable
+	EnvironmentsTable.ForeignKeys[1].RefTable = ProjectsTable
 	FlagsTable.ForeignKeys[0].RefTable = ProjectsTable
 	FlagEnvironmentsTable.ForeignKeys[0].RefTable = EnvironmentsTable
 	FlagEnvironmentsTable.ForeignKeys[1].RefTable = FlagsTable
+	FlagEnvironmentVersionsTable.ForeignKeys[0].RefTable = FlagEnvironmentsTable
+	PrerequisitesTable.ForeignKeys[0].RefTable = FlagsTable
+	PrerequisitesTable.ForeignKeys[1].RefTable = FlagEnvironmentsTable
+	RolloutPlansTable.ForeignKeys[0].RefTable = FlagEnvironmentsTable
 	StrategiesTable.ForeignKeys[0].RefTable = FlagEnvironmentsTable
+	StrategyDefinitionsTable.ForeignKeys[0].RefTable = ProjectsTable
+	TagsTable.ForeignKeys[0].RefTable = ProjectsTable
+	FlagTagsTable.ForeignKeys[0].RefTable = FlagsTable
+	FlagTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
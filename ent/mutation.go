@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
@@ -13,12 +14,19 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
 	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -31,14 +39,20 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeApiToken        = "ApiToken"
-	TypeConstraint      = "Constraint"
-	TypeEnvironment     = "Environment"
-	TypeFlag            = "Flag"
-	TypeFlagEnvironment = "FlagEnvironment"
-	TypeProject         = "Project"
-	TypeStrategy        = "Strategy"
-	TypeUser            = "User"
+	TypeApiToken               = "ApiToken"
+	TypeConstraint             = "Constraint"
+	TypeContextField           = "ContextField"
+	TypeEnvironment            = "Environment"
+	TypeFlag                   = "Flag"
+	TypeFlagEnvironment        = "FlagEnvironment"
+	TypeFlagEnvironmentVersion = "FlagEnvironmentVersion"
+	TypePrerequisite           = "Prerequisite"
+	TypeProject                = "Project"
+	TypeRolloutPlan            = "RolloutPlan"
+	TypeStrategy               = "Strategy"
+	TypeStrategyDefinition     = "StrategyDefinition"
+	TypeTag                    = "Tag"
+	TypeUser                   = "User"
 )
 
 // ApiTokenMutation represents an operation that mutates the ApiToken nodes in the graph.
@@ -1733,40 +1747,38 @@ func (m *ConstraintMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown Constraint edge %s", name)
 }
 
-// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
-type EnvironmentMutation struct {
+// ContextFieldMutation represents an operation that mutates the ContextField nodes in the graph.
+type ContextFieldMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	name                     *string
-	_type                    *environment.Type
-	sort_order               *int
-	addsort_order            *int
-	created_at               *time.Time
-	updated_at               *time.Time
-	clearedFields            map[string]struct{}
-	project                  *int
-	clearedproject           bool
-	flag_environments        map[int]struct{}
-	removedflag_environments map[int]struct{}
-	clearedflag_environments bool
-	done                     bool
-	oldValue                 func(context.Context) (*Environment, error)
-	predicates               []predicate.Environment
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	description        *string
+	_type              *contextfield.Type
+	legal_values       *[]string
+	appendlegal_values []string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	project            *int
+	clearedproject     bool
+	done               bool
+	oldValue           func(context.Context) (*ContextField, error)
+	predicates         []predicate.ContextField
 }
 
-var _ ent.Mutation = (*EnvironmentMutation)(nil)
+var _ ent.Mutation = (*ContextFieldMutation)(nil)
 
-// environmentOption allows management of the mutation configuration using functional options.
-type environmentOption func(*EnvironmentMutation)
+// contextfieldOption allows management of the mutation configuration using functional options.
+type contextfieldOption func(*ContextFieldMutation)
 
-// newEnvironmentMutation creates new mutation for the Environment entity.
-func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
-	m := &EnvironmentMutation{
+// newContextFieldMutation creates new mutation for the ContextField entity.
+func newContextFieldMutation(c config, op Op, opts ...contextfieldOption) *ContextFieldMutation {
+	m := &ContextFieldMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEnvironment,
+		typ:           TypeContextField,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1775,20 +1787,20 @@ func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *Environ
 	return m
 }
 
-// withEnvironmentID sets the ID field of the mutation.
-func withEnvironmentID(id int) environmentOption {
-	return func(m *EnvironmentMutation) {
+// withContextFieldID sets the ID field of the mutation.
+func withContextFieldID(id int) contextfieldOption {
+	return func(m *ContextFieldMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Environment
+			value *ContextField
 		)
-		m.oldValue = func(ctx context.Context) (*Environment, error) {
+		m.oldValue = func(ctx context.Context) (*ContextField, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Environment.Get(ctx, id)
+					value, err = m.Client().ContextField.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1797,10 +1809,10 @@ func withEnvironmentID(id int) environmentOption {
 	}
 }
 
-// withEnvironment sets the old Environment of the mutation.
-func withEnvironment(node *Environment) environmentOption {
-	return func(m *EnvironmentMutation) {
-		m.oldValue = func(context.Context) (*Environment, error) {
+// withContextField sets the old ContextField of the mutation.
+func withContextField(node *ContextField) contextfieldOption {
+	return func(m *ContextFieldMutation) {
+		m.oldValue = func(context.Context) (*ContextField, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1809,7 +1821,7 @@ func withEnvironment(node *Environment) environmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EnvironmentMutation) Client() *Client {
+func (m ContextFieldMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1817,7 +1829,7 @@ func (m EnvironmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EnvironmentMutation) Tx() (*Tx, error) {
+func (m ContextFieldMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1828,7 +1840,7 @@ func (m EnvironmentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EnvironmentMutation) ID() (id int, exists bool) {
+func (m *ContextFieldMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1839,7 +1851,7 @@ func (m *EnvironmentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *ContextFieldMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1848,19 +1860,19 @@ func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ContextField.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *EnvironmentMutation) SetName(s string) {
+func (m *ContextFieldMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *EnvironmentMutation) Name() (r string, exists bool) {
+func (m *ContextFieldMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1868,10 +1880,10 @@ func (m *EnvironmentMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ContextFieldMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1886,17 +1898,66 @@ func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error)
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *EnvironmentMutation) ResetName() {
+func (m *ContextFieldMutation) ResetName() {
 	m.name = nil
 }
 
+// SetDescription sets the "description" field.
+func (m *ContextFieldMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ContextFieldMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ContextFieldMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ContextFieldMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[contextfield.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ContextFieldMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[contextfield.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ContextFieldMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, contextfield.FieldDescription)
+}
+
 // SetType sets the "type" field.
-func (m *EnvironmentMutation) SetType(e environment.Type) {
-	m._type = &e
+func (m *ContextFieldMutation) SetType(c contextfield.Type) {
+	m._type = &c
 }
 
 // GetType returns the value of the "type" field in the mutation.
-func (m *EnvironmentMutation) GetType() (r environment.Type, exists bool) {
+func (m *ContextFieldMutation) GetType() (r contextfield.Type, exists bool) {
 	v := m._type
 	if v == nil {
 		return
@@ -1904,10 +1965,10 @@ func (m *EnvironmentMutation) GetType() (r environment.Type, exists bool) {
 	return *v, true
 }
 
-// OldType returns the old "type" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldType(ctx context.Context) (v environment.Type, err error) {
+func (m *ContextFieldMutation) OldType(ctx context.Context) (v contextfield.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
@@ -1922,73 +1983,82 @@ func (m *EnvironmentMutation) OldType(ctx context.Context) (v environment.Type,
 }
 
 // ResetType resets all changes to the "type" field.
-func (m *EnvironmentMutation) ResetType() {
+func (m *ContextFieldMutation) ResetType() {
 	m._type = nil
 }
 
-// SetSortOrder sets the "sort_order" field.
-func (m *EnvironmentMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
+// SetLegalValues sets the "legal_values" field.
+func (m *ContextFieldMutation) SetLegalValues(s []string) {
+	m.legal_values = &s
+	m.appendlegal_values = nil
 }
 
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *EnvironmentMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
+// LegalValues returns the value of the "legal_values" field in the mutation.
+func (m *ContextFieldMutation) LegalValues() (r []string, exists bool) {
+	v := m.legal_values
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSortOrder returns the old "sort_order" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldLegalValues returns the old "legal_values" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+func (m *ContextFieldMutation) OldLegalValues(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+		return v, errors.New("OldLegalValues is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+		return v, errors.New("OldLegalValues requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+		return v, fmt.Errorf("querying old value for OldLegalValues: %w", err)
 	}
-	return oldValue.SortOrder, nil
+	return oldValue.LegalValues, nil
 }
 
-// AddSortOrder adds i to the "sort_order" field.
-func (m *EnvironmentMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
+// AppendLegalValues adds s to the "legal_values" field.
+func (m *ContextFieldMutation) AppendLegalValues(s []string) {
+	m.appendlegal_values = append(m.appendlegal_values, s...)
 }
 
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *EnvironmentMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
+// AppendedLegalValues returns the list of values that were appended to the "legal_values" field in this mutation.
+func (m *ContextFieldMutation) AppendedLegalValues() ([]string, bool) {
+	if len(m.appendlegal_values) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendlegal_values, true
 }
 
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *EnvironmentMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
+// ClearLegalValues clears the value of the "legal_values" field.
+func (m *ContextFieldMutation) ClearLegalValues() {
+	m.legal_values = nil
+	m.appendlegal_values = nil
+	m.clearedFields[contextfield.FieldLegalValues] = struct{}{}
+}
+
+// LegalValuesCleared returns if the "legal_values" field was cleared in this mutation.
+func (m *ContextFieldMutation) LegalValuesCleared() bool {
+	_, ok := m.clearedFields[contextfield.FieldLegalValues]
+	return ok
+}
+
+// ResetLegalValues resets all changes to the "legal_values" field.
+func (m *ContextFieldMutation) ResetLegalValues() {
+	m.legal_values = nil
+	m.appendlegal_values = nil
+	delete(m.clearedFields, contextfield.FieldLegalValues)
 }
 
 // SetProjectID sets the "project_id" field.
-func (m *EnvironmentMutation) SetProjectID(i int) {
+func (m *ContextFieldMutation) SetProjectID(i int) {
 	m.project = &i
 }
 
 // ProjectID returns the value of the "project_id" field in the mutation.
-func (m *EnvironmentMutation) ProjectID() (r int, exists bool) {
+func (m *ContextFieldMutation) ProjectID() (r int, exists bool) {
 	v := m.project
 	if v == nil {
 		return
@@ -1996,10 +2066,10 @@ func (m *EnvironmentMutation) ProjectID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldProjectID returns the old "project_id" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldProjectID(ctx context.Context) (v int, err error) {
+func (m *ContextFieldMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
@@ -2014,17 +2084,17 @@ func (m *EnvironmentMutation) OldProjectID(ctx context.Context) (v int, err erro
 }
 
 // ResetProjectID resets all changes to the "project_id" field.
-func (m *EnvironmentMutation) ResetProjectID() {
+func (m *ContextFieldMutation) ResetProjectID() {
 	m.project = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *EnvironmentMutation) SetCreatedAt(t time.Time) {
+func (m *ContextFieldMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ContextFieldMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2032,10 +2102,10 @@ func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContextFieldMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2050,17 +2120,17 @@ func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EnvironmentMutation) ResetCreatedAt() {
+func (m *ContextFieldMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *EnvironmentMutation) SetUpdatedAt(t time.Time) {
+func (m *ContextFieldMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *ContextFieldMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2068,10 +2138,10 @@ func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Environment entity.
-// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ContextField entity.
+// If the ContextField object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ContextFieldMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2086,25 +2156,25 @@ func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EnvironmentMutation) ResetUpdatedAt() {
+func (m *ContextFieldMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // ClearProject clears the "project" edge to the Project entity.
-func (m *EnvironmentMutation) ClearProject() {
+func (m *ContextFieldMutation) ClearProject() {
 	m.clearedproject = true
-	m.clearedFields[environment.FieldProjectID] = struct{}{}
+	m.clearedFields[contextfield.FieldProjectID] = struct{}{}
 }
 
 // ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *EnvironmentMutation) ProjectCleared() bool {
+func (m *ContextFieldMutation) ProjectCleared() bool {
 	return m.clearedproject
 }
 
 // ProjectIDs returns the "project" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProjectID instead. It exists only for internal usage by the builders.
-func (m *EnvironmentMutation) ProjectIDs() (ids []int) {
+func (m *ContextFieldMutation) ProjectIDs() (ids []int) {
 	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2112,74 +2182,20 @@ func (m *EnvironmentMutation) ProjectIDs() (ids []int) {
 }
 
 // ResetProject resets all changes to the "project" edge.
-func (m *EnvironmentMutation) ResetProject() {
+func (m *ContextFieldMutation) ResetProject() {
 	m.project = nil
 	m.clearedproject = false
 }
 
-// AddFlagEnvironmentIDs adds the "flag_environments" edge to the FlagEnvironment entity by ids.
-func (m *EnvironmentMutation) AddFlagEnvironmentIDs(ids ...int) {
-	if m.flag_environments == nil {
-		m.flag_environments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.flag_environments[ids[i]] = struct{}{}
-	}
-}
-
-// ClearFlagEnvironments clears the "flag_environments" edge to the FlagEnvironment entity.
-func (m *EnvironmentMutation) ClearFlagEnvironments() {
-	m.clearedflag_environments = true
-}
-
-// FlagEnvironmentsCleared reports if the "flag_environments" edge to the FlagEnvironment entity was cleared.
-func (m *EnvironmentMutation) FlagEnvironmentsCleared() bool {
-	return m.clearedflag_environments
-}
-
-// RemoveFlagEnvironmentIDs removes the "flag_environments" edge to the FlagEnvironment entity by IDs.
-func (m *EnvironmentMutation) RemoveFlagEnvironmentIDs(ids ...int) {
-	if m.removedflag_environments == nil {
-		m.removedflag_environments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.flag_environments, ids[i])
-		m.removedflag_environments[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedFlagEnvironments returns the removed IDs of the "flag_environments" edge to the FlagEnvironment entity.
-func (m *EnvironmentMutation) RemovedFlagEnvironmentsIDs() (ids []int) {
-	for id := range m.removedflag_environments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// FlagEnvironmentsIDs returns the "flag_environments" edge IDs in the mutation.
-func (m *EnvironmentMutation) FlagEnvironmentsIDs() (ids []int) {
-	for id := range m.flag_environments {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetFlagEnvironments resets all changes to the "flag_environments" edge.
-func (m *EnvironmentMutation) ResetFlagEnvironments() {
-	m.flag_environments = nil
-	m.clearedflag_environments = false
-	m.removedflag_environments = nil
-}
-
-// Where appends a list predicates to the EnvironmentMutation builder.
-func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+// Where appends a list predicates to the ContextFieldMutation builder.
+func (m *ContextFieldMutation) Where(ps ...predicate.ContextField) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ContextFieldMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Environment, len(ps))
+func (m *ContextFieldMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ContextField, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2187,42 +2203,45 @@ func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *EnvironmentMutation) Op() Op {
+func (m *ContextFieldMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *EnvironmentMutation) SetOp(op Op) {
+func (m *ContextFieldMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Environment).
-func (m *EnvironmentMutation) Type() string {
+// Type returns the node type of this mutation (ContextField).
+func (m *ContextFieldMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *EnvironmentMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *ContextFieldMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.name != nil {
-		fields = append(fields, environment.FieldName)
+		fields = append(fields, contextfield.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, contextfield.FieldDescription)
 	}
 	if m._type != nil {
-		fields = append(fields, environment.FieldType)
+		fields = append(fields, contextfield.FieldType)
 	}
-	if m.sort_order != nil {
-		fields = append(fields, environment.FieldSortOrder)
+	if m.legal_values != nil {
+		fields = append(fields, contextfield.FieldLegalValues)
 	}
 	if m.project != nil {
-		fields = append(fields, environment.FieldProjectID)
+		fields = append(fields, contextfield.FieldProjectID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, environment.FieldCreatedAt)
+		fields = append(fields, contextfield.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, environment.FieldUpdatedAt)
+		fields = append(fields, contextfield.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2230,19 +2249,21 @@ func (m *EnvironmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
+func (m *ContextFieldMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case environment.FieldName:
+	case contextfield.FieldName:
 		return m.Name()
-	case environment.FieldType:
+	case contextfield.FieldDescription:
+		return m.Description()
+	case contextfield.FieldType:
 		return m.GetType()
-	case environment.FieldSortOrder:
-		return m.SortOrder()
-	case environment.FieldProjectID:
+	case contextfield.FieldLegalValues:
+		return m.LegalValues()
+	case contextfield.FieldProjectID:
 		return m.ProjectID()
-	case environment.FieldCreatedAt:
+	case contextfield.FieldCreatedAt:
 		return m.CreatedAt()
-	case environment.FieldUpdatedAt:
+	case contextfield.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -2251,65 +2272,74 @@ func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ContextFieldMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case environment.FieldName:
+	case contextfield.FieldName:
 		return m.OldName(ctx)
-	case environment.FieldType:
+	case contextfield.FieldDescription:
+		return m.OldDescription(ctx)
+	case contextfield.FieldType:
 		return m.OldType(ctx)
-	case environment.FieldSortOrder:
-		return m.OldSortOrder(ctx)
-	case environment.FieldProjectID:
+	case contextfield.FieldLegalValues:
+		return m.OldLegalValues(ctx)
+	case contextfield.FieldProjectID:
 		return m.OldProjectID(ctx)
-	case environment.FieldCreatedAt:
+	case contextfield.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case environment.FieldUpdatedAt:
+	case contextfield.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Environment field %s", name)
+	return nil, fmt.Errorf("unknown ContextField field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
+func (m *ContextFieldMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case environment.FieldName:
+	case contextfield.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case environment.FieldType:
-		v, ok := value.(environment.Type)
+	case contextfield.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case contextfield.FieldType:
+		v, ok := value.(contextfield.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetType(v)
 		return nil
-	case environment.FieldSortOrder:
-		v, ok := value.(int)
+	case contextfield.FieldLegalValues:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSortOrder(v)
+		m.SetLegalValues(v)
 		return nil
-	case environment.FieldProjectID:
+	case contextfield.FieldProjectID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProjectID(v)
 		return nil
-	case environment.FieldCreatedAt:
+	case contextfield.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case environment.FieldUpdatedAt:
+	case contextfield.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2317,26 +2347,21 @@ func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown ContextField field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EnvironmentMutation) AddedFields() []string {
+func (m *ContextFieldMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort_order != nil {
-		fields = append(fields, environment.FieldSortOrder)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ContextFieldMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case environment.FieldSortOrder:
-		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -2344,199 +2369,192 @@ func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
+func (m *ContextFieldMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case environment.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSortOrder(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Environment numeric field %s", name)
+	return fmt.Errorf("unknown ContextField numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *EnvironmentMutation) ClearedFields() []string {
-	return nil
+func (m *ContextFieldMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(contextfield.FieldDescription) {
+		fields = append(fields, contextfield.FieldDescription)
+	}
+	if m.FieldCleared(contextfield.FieldLegalValues) {
+		fields = append(fields, contextfield.FieldLegalValues)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *EnvironmentMutation) FieldCleared(name string) bool {
+func (m *ContextFieldMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Environment nullable field %s", name)
+func (m *ContextFieldMutation) ClearField(name string) error {
+	switch name {
+	case contextfield.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case contextfield.FieldLegalValues:
+		m.ClearLegalValues()
+		return nil
+	}
+	return fmt.Errorf("unknown ContextField nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *EnvironmentMutation) ResetField(name string) error {
+func (m *ContextFieldMutation) ResetField(name string) error {
 	switch name {
-	case environment.FieldName:
+	case contextfield.FieldName:
 		m.ResetName()
 		return nil
-	case environment.FieldType:
+	case contextfield.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case contextfield.FieldType:
 		m.ResetType()
 		return nil
-	case environment.FieldSortOrder:
-		m.ResetSortOrder()
+	case contextfield.FieldLegalValues:
+		m.ResetLegalValues()
 		return nil
-	case environment.FieldProjectID:
+	case contextfield.FieldProjectID:
 		m.ResetProjectID()
 		return nil
-	case environment.FieldCreatedAt:
+	case contextfield.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case environment.FieldUpdatedAt:
+	case contextfield.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment field %s", name)
+	return fmt.Errorf("unknown ContextField field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EnvironmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ContextFieldMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.project != nil {
-		edges = append(edges, environment.EdgeProject)
-	}
-	if m.flag_environments != nil {
-		edges = append(edges, environment.EdgeFlagEnvironments)
+		edges = append(edges, contextfield.EdgeProject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
+func (m *ContextFieldMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case environment.EdgeProject:
+	case contextfield.EdgeProject:
 		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
-	case environment.EdgeFlagEnvironments:
-		ids := make([]ent.Value, 0, len(m.flag_environments))
-		for id := range m.flag_environments {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EnvironmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedflag_environments != nil {
-		edges = append(edges, environment.EdgeFlagEnvironments)
-	}
+func (m *ContextFieldMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case environment.EdgeFlagEnvironments:
-		ids := make([]ent.Value, 0, len(m.removedflag_environments))
-		for id := range m.removedflag_environments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ContextFieldMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EnvironmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ContextFieldMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.clearedproject {
-		edges = append(edges, environment.EdgeProject)
-	}
-	if m.clearedflag_environments {
-		edges = append(edges, environment.EdgeFlagEnvironments)
+		edges = append(edges, contextfield.EdgeProject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *EnvironmentMutation) EdgeCleared(name string) bool {
+func (m *ContextFieldMutation) EdgeCleared(name string) bool {
 	switch name {
-	case environment.EdgeProject:
+	case contextfield.EdgeProject:
 		return m.clearedproject
-	case environment.EdgeFlagEnvironments:
-		return m.clearedflag_environments
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *EnvironmentMutation) ClearEdge(name string) error {
+func (m *ContextFieldMutation) ClearEdge(name string) error {
 	switch name {
-	case environment.EdgeProject:
+	case contextfield.EdgeProject:
 		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Environment unique edge %s", name)
+	return fmt.Errorf("unknown ContextField unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *EnvironmentMutation) ResetEdge(name string) error {
+func (m *ContextFieldMutation) ResetEdge(name string) error {
 	switch name {
-	case environment.EdgeProject:
+	case contextfield.EdgeProject:
 		m.ResetProject()
 		return nil
-	case environment.EdgeFlagEnvironments:
-		m.ResetFlagEnvironments()
-		return nil
 	}
-	return fmt.Errorf("unknown Environment edge %s", name)
+	return fmt.Errorf("unknown ContextField edge %s", name)
 }
 
-// FlagMutation represents an operation that mutates the Flag nodes in the graph.
-type FlagMutation struct {
+// EnvironmentMutation represents an operation that mutates the Environment nodes in the graph.
+type EnvironmentMutation struct {
 	config
 	op                       Op
 	typ                      string
 	id                       *int
 	name                     *string
-	description              *string
-	flag_type                *flag.FlagType
+	_type                    *environment.Type
+	sort_order               *int
+	addsort_order            *int
 	created_at               *time.Time
 	updated_at               *time.Time
+	deleted_at               *time.Time
+	frozen_at                *time.Time
+	frozen_by                *string
+	freeze_reason            *string
 	clearedFields            map[string]struct{}
 	project                  *int
 	clearedproject           bool
 	flag_environments        map[int]struct{}
 	removedflag_environments map[int]struct{}
 	clearedflag_environments bool
+	expected_match           *int
+	clearedexpected_match    bool
+	matched_by               map[int]struct{}
+	removedmatched_by        map[int]struct{}
+	clearedmatched_by        bool
 	done                     bool
-	oldValue                 func(context.Context) (*Flag, error)
-	predicates               []predicate.Flag
+	oldValue                 func(context.Context) (*Environment, error)
+	predicates               []predicate.Environment
 }
 
-var _ ent.Mutation = (*FlagMutation)(nil)
+var _ ent.Mutation = (*EnvironmentMutation)(nil)
 
-// flagOption allows management of the mutation configuration using functional options.
-type flagOption func(*FlagMutation)
+// environmentOption allows management of the mutation configuration using functional options.
+type environmentOption func(*EnvironmentMutation)
 
-// newFlagMutation creates new mutation for the Flag entity.
-func newFlagMutation(c config, op Op, opts ...flagOption) *FlagMutation {
-	m := &FlagMutation{
+// newEnvironmentMutation creates new mutation for the Environment entity.
+func newEnvironmentMutation(c config, op Op, opts ...environmentOption) *EnvironmentMutation {
+	m := &EnvironmentMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFlag,
+		typ:           TypeEnvironment,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2545,20 +2563,20 @@ func newFlagMutation(c config, op Op, opts ...flagOption) *FlagMutation {
 	return m
 }
 
-// withFlagID sets the ID field of the mutation.
-func withFlagID(id int) flagOption {
-	return func(m *FlagMutation) {
+// withEnvironmentID sets the ID field of the mutation.
+func withEnvironmentID(id int) environmentOption {
+	return func(m *EnvironmentMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Flag
+			value *Environment
 		)
-		m.oldValue = func(ctx context.Context) (*Flag, error) {
+		m.oldValue = func(ctx context.Context) (*Environment, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Flag.Get(ctx, id)
+					value, err = m.Client().Environment.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2567,10 +2585,10 @@ func withFlagID(id int) flagOption {
 	}
 }
 
-// withFlag sets the old Flag of the mutation.
-func withFlag(node *Flag) flagOption {
-	return func(m *FlagMutation) {
-		m.oldValue = func(context.Context) (*Flag, error) {
+// withEnvironment sets the old Environment of the mutation.
+func withEnvironment(node *Environment) environmentOption {
+	return func(m *EnvironmentMutation) {
+		m.oldValue = func(context.Context) (*Environment, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2579,7 +2597,7 @@ func withFlag(node *Flag) flagOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FlagMutation) Client() *Client {
+func (m EnvironmentMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2587,7 +2605,7 @@ func (m FlagMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FlagMutation) Tx() (*Tx, error) {
+func (m EnvironmentMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2598,7 +2616,7 @@ func (m FlagMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FlagMutation) ID() (id int, exists bool) {
+func (m *EnvironmentMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2609,7 +2627,7 @@ func (m *FlagMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FlagMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *EnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2618,19 +2636,19 @@ func (m *FlagMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Flag.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Environment.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *FlagMutation) SetName(s string) {
+func (m *EnvironmentMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *FlagMutation) Name() (r string, exists bool) {
+func (m *EnvironmentMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -2638,10 +2656,10 @@ func (m *FlagMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *EnvironmentMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -2656,102 +2674,109 @@ func (m *FlagMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *FlagMutation) ResetName() {
+func (m *EnvironmentMutation) ResetName() {
 	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *FlagMutation) SetDescription(s string) {
-	m.description = &s
+// SetType sets the "type" field.
+func (m *EnvironmentMutation) SetType(e environment.Type) {
+	m._type = &e
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *FlagMutation) Description() (r string, exists bool) {
-	v := m.description
+// GetType returns the value of the "type" field in the mutation.
+func (m *EnvironmentMutation) GetType() (r environment.Type, exists bool) {
+	v := m._type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldType returns the old "type" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *EnvironmentMutation) OldType(ctx context.Context) (v environment.Type, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
 	}
-	return oldValue.Description, nil
-}
-
-// ClearDescription clears the value of the "description" field.
-func (m *FlagMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[flag.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *FlagMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[flag.FieldDescription]
-	return ok
+	return oldValue.Type, nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *FlagMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, flag.FieldDescription)
+// ResetType resets all changes to the "type" field.
+func (m *EnvironmentMutation) ResetType() {
+	m._type = nil
 }
 
-// SetFlagType sets the "flag_type" field.
-func (m *FlagMutation) SetFlagType(ft flag.FlagType) {
-	m.flag_type = &ft
+// SetSortOrder sets the "sort_order" field.
+func (m *EnvironmentMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
 }
 
-// FlagType returns the value of the "flag_type" field in the mutation.
-func (m *FlagMutation) FlagType() (r flag.FlagType, exists bool) {
-	v := m.flag_type
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *EnvironmentMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlagType returns the old "flag_type" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldSortOrder returns the old "sort_order" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldFlagType(ctx context.Context) (v flag.FlagType, err error) {
+func (m *EnvironmentMutation) OldSortOrder(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlagType is only allowed on UpdateOne operations")
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlagType requires an ID field in the mutation")
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlagType: %w", err)
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
 	}
-	return oldValue.FlagType, nil
-}
-
-// ResetFlagType resets all changes to the "flag_type" field.
-func (m *FlagMutation) ResetFlagType() {
-	m.flag_type = nil
+	return oldValue.SortOrder, nil
 }
 
-// SetProjectID sets the "project_id" field.
-func (m *FlagMutation) SetProjectID(i int) {
-	m.project = &i
-}
+// AddSortOrder adds i to the "sort_order" field.
+func (m *EnvironmentMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *EnvironmentMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *EnvironmentMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetProjectID sets the "project_id" field.
+func (m *EnvironmentMutation) SetProjectID(i int) {
+	m.project = &i
+}
 
 // ProjectID returns the value of the "project_id" field in the mutation.
-func (m *FlagMutation) ProjectID() (r int, exists bool) {
+func (m *EnvironmentMutation) ProjectID() (r int, exists bool) {
 	v := m.project
 	if v == nil {
 		return
@@ -2759,10 +2784,10 @@ func (m *FlagMutation) ProjectID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldProjectID returns the old "project_id" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldProjectID(ctx context.Context) (v int, err error) {
+func (m *EnvironmentMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
@@ -2777,17 +2802,17 @@ func (m *FlagMutation) OldProjectID(ctx context.Context) (v int, err error) {
 }
 
 // ResetProjectID resets all changes to the "project_id" field.
-func (m *FlagMutation) ResetProjectID() {
+func (m *EnvironmentMutation) ResetProjectID() {
 	m.project = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *FlagMutation) SetCreatedAt(t time.Time) {
+func (m *EnvironmentMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FlagMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *EnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2795,10 +2820,10 @@ func (m *FlagMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2813,17 +2838,17 @@ func (m *FlagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FlagMutation) ResetCreatedAt() {
+func (m *EnvironmentMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *FlagMutation) SetUpdatedAt(t time.Time) {
+func (m *EnvironmentMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FlagMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *EnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -2831,10 +2856,10 @@ func (m *FlagMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Flag entity.
-// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *EnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -2849,25 +2874,270 @@ func (m *FlagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FlagMutation) ResetUpdatedAt() {
+func (m *EnvironmentMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
+// SetExpectedMatchID sets the "expected_match_id" field.
+func (m *EnvironmentMutation) SetExpectedMatchID(i int) {
+	m.expected_match = &i
+}
+
+// ExpectedMatchID returns the value of the "expected_match_id" field in the mutation.
+func (m *EnvironmentMutation) ExpectedMatchID() (r int, exists bool) {
+	v := m.expected_match
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpectedMatchID returns the old "expected_match_id" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldExpectedMatchID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpectedMatchID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpectedMatchID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpectedMatchID: %w", err)
+	}
+	return oldValue.ExpectedMatchID, nil
+}
+
+// ClearExpectedMatchID clears the value of the "expected_match_id" field.
+func (m *EnvironmentMutation) ClearExpectedMatchID() {
+	m.expected_match = nil
+	m.clearedFields[environment.FieldExpectedMatchID] = struct{}{}
+}
+
+// ExpectedMatchIDCleared returns if the "expected_match_id" field was cleared in this mutation.
+func (m *EnvironmentMutation) ExpectedMatchIDCleared() bool {
+	_, ok := m.clearedFields[environment.FieldExpectedMatchID]
+	return ok
+}
+
+// ResetExpectedMatchID resets all changes to the "expected_match_id" field.
+func (m *EnvironmentMutation) ResetExpectedMatchID() {
+	m.expected_match = nil
+	delete(m.clearedFields, environment.FieldExpectedMatchID)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *EnvironmentMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *EnvironmentMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *EnvironmentMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[environment.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *EnvironmentMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[environment.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *EnvironmentMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, environment.FieldDeletedAt)
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (m *EnvironmentMutation) SetFrozenAt(t time.Time) {
+	m.frozen_at = &t
+}
+
+// FrozenAt returns the value of the "frozen_at" field in the mutation.
+func (m *EnvironmentMutation) FrozenAt() (r time.Time, exists bool) {
+	v := m.frozen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrozenAt returns the old "frozen_at" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldFrozenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrozenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrozenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrozenAt: %w", err)
+	}
+	return oldValue.FrozenAt, nil
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (m *EnvironmentMutation) ClearFrozenAt() {
+	m.frozen_at = nil
+	m.clearedFields[environment.FieldFrozenAt] = struct{}{}
+}
+
+// FrozenAtCleared returns if the "frozen_at" field was cleared in this mutation.
+func (m *EnvironmentMutation) FrozenAtCleared() bool {
+	_, ok := m.clearedFields[environment.FieldFrozenAt]
+	return ok
+}
+
+// ResetFrozenAt resets all changes to the "frozen_at" field.
+func (m *EnvironmentMutation) ResetFrozenAt() {
+	m.frozen_at = nil
+	delete(m.clearedFields, environment.FieldFrozenAt)
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (m *EnvironmentMutation) SetFrozenBy(s string) {
+	m.frozen_by = &s
+}
+
+// FrozenBy returns the value of the "frozen_by" field in the mutation.
+func (m *EnvironmentMutation) FrozenBy() (r string, exists bool) {
+	v := m.frozen_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrozenBy returns the old "frozen_by" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldFrozenBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrozenBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrozenBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrozenBy: %w", err)
+	}
+	return oldValue.FrozenBy, nil
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (m *EnvironmentMutation) ClearFrozenBy() {
+	m.frozen_by = nil
+	m.clearedFields[environment.FieldFrozenBy] = struct{}{}
+}
+
+// FrozenByCleared returns if the "frozen_by" field was cleared in this mutation.
+func (m *EnvironmentMutation) FrozenByCleared() bool {
+	_, ok := m.clearedFields[environment.FieldFrozenBy]
+	return ok
+}
+
+// ResetFrozenBy resets all changes to the "frozen_by" field.
+func (m *EnvironmentMutation) ResetFrozenBy() {
+	m.frozen_by = nil
+	delete(m.clearedFields, environment.FieldFrozenBy)
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (m *EnvironmentMutation) SetFreezeReason(s string) {
+	m.freeze_reason = &s
+}
+
+// FreezeReason returns the value of the "freeze_reason" field in the mutation.
+func (m *EnvironmentMutation) FreezeReason() (r string, exists bool) {
+	v := m.freeze_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFreezeReason returns the old "freeze_reason" field's value of the Environment entity.
+// If the Environment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnvironmentMutation) OldFreezeReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreezeReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreezeReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreezeReason: %w", err)
+	}
+	return oldValue.FreezeReason, nil
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (m *EnvironmentMutation) ClearFreezeReason() {
+	m.freeze_reason = nil
+	m.clearedFields[environment.FieldFreezeReason] = struct{}{}
+}
+
+// FreezeReasonCleared returns if the "freeze_reason" field was cleared in this mutation.
+func (m *EnvironmentMutation) FreezeReasonCleared() bool {
+	_, ok := m.clearedFields[environment.FieldFreezeReason]
+	return ok
+}
+
+// ResetFreezeReason resets all changes to the "freeze_reason" field.
+func (m *EnvironmentMutation) ResetFreezeReason() {
+	m.freeze_reason = nil
+	delete(m.clearedFields, environment.FieldFreezeReason)
+}
+
 // ClearProject clears the "project" edge to the Project entity.
-func (m *FlagMutation) ClearProject() {
+func (m *EnvironmentMutation) ClearProject() {
 	m.clearedproject = true
-	m.clearedFields[flag.FieldProjectID] = struct{}{}
+	m.clearedFields[environment.FieldProjectID] = struct{}{}
 }
 
 // ProjectCleared reports if the "project" edge to the Project entity was cleared.
-func (m *FlagMutation) ProjectCleared() bool {
+func (m *EnvironmentMutation) ProjectCleared() bool {
 	return m.clearedproject
 }
 
 // ProjectIDs returns the "project" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ProjectID instead. It exists only for internal usage by the builders.
-func (m *FlagMutation) ProjectIDs() (ids []int) {
+func (m *EnvironmentMutation) ProjectIDs() (ids []int) {
 	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2875,13 +3145,13 @@ func (m *FlagMutation) ProjectIDs() (ids []int) {
 }
 
 // ResetProject resets all changes to the "project" edge.
-func (m *FlagMutation) ResetProject() {
+func (m *EnvironmentMutation) ResetProject() {
 	m.project = nil
 	m.clearedproject = false
 }
 
 // AddFlagEnvironmentIDs adds the "flag_environments" edge to the FlagEnvironment entity by ids.
-func (m *FlagMutation) AddFlagEnvironmentIDs(ids ...int) {
+func (m *EnvironmentMutation) AddFlagEnvironmentIDs(ids ...int) {
 	if m.flag_environments == nil {
 		m.flag_environments = make(map[int]struct{})
 	}
@@ -2891,17 +3161,17 @@ func (m *FlagMutation) AddFlagEnvironmentIDs(ids ...int) {
 }
 
 // ClearFlagEnvironments clears the "flag_environments" edge to the FlagEnvironment entity.
-func (m *FlagMutation) ClearFlagEnvironments() {
+func (m *EnvironmentMutation) ClearFlagEnvironments() {
 	m.clearedflag_environments = true
 }
 
 // FlagEnvironmentsCleared reports if the "flag_environments" edge to the FlagEnvironment entity was cleared.
-func (m *FlagMutation) FlagEnvironmentsCleared() bool {
+func (m *EnvironmentMutation) FlagEnvironmentsCleared() bool {
 	return m.clearedflag_environments
 }
 
 // RemoveFlagEnvironmentIDs removes the "flag_environments" edge to the FlagEnvironment entity by IDs.
-func (m *FlagMutation) RemoveFlagEnvironmentIDs(ids ...int) {
+func (m *EnvironmentMutation) RemoveFlagEnvironmentIDs(ids ...int) {
 	if m.removedflag_environments == nil {
 		m.removedflag_environments = make(map[int]struct{})
 	}
@@ -2912,7 +3182,7 @@ func (m *FlagMutation) RemoveFlagEnvironmentIDs(ids ...int) {
 }
 
 // RemovedFlagEnvironments returns the removed IDs of the "flag_environments" edge to the FlagEnvironment entity.
-func (m *FlagMutation) RemovedFlagEnvironmentsIDs() (ids []int) {
+func (m *EnvironmentMutation) RemovedFlagEnvironmentsIDs() (ids []int) {
 	for id := range m.removedflag_environments {
 		ids = append(ids, id)
 	}
@@ -2920,7 +3190,7 @@ func (m *FlagMutation) RemovedFlagEnvironmentsIDs() (ids []int) {
 }
 
 // FlagEnvironmentsIDs returns the "flag_environments" edge IDs in the mutation.
-func (m *FlagMutation) FlagEnvironmentsIDs() (ids []int) {
+func (m *EnvironmentMutation) FlagEnvironmentsIDs() (ids []int) {
 	for id := range m.flag_environments {
 		ids = append(ids, id)
 	}
@@ -2928,64 +3198,160 @@ func (m *FlagMutation) FlagEnvironmentsIDs() (ids []int) {
 }
 
 // ResetFlagEnvironments resets all changes to the "flag_environments" edge.
-func (m *FlagMutation) ResetFlagEnvironments() {
+func (m *EnvironmentMutation) ResetFlagEnvironments() {
 	m.flag_environments = nil
 	m.clearedflag_environments = false
 	m.removedflag_environments = nil
 }
 
-// Where appends a list predicates to the FlagMutation builder.
-func (m *FlagMutation) Where(ps ...predicate.Flag) {
-	m.predicates = append(m.predicates, ps...)
+// ClearExpectedMatch clears the "expected_match" edge to the Environment entity.
+func (m *EnvironmentMutation) ClearExpectedMatch() {
+	m.clearedexpected_match = true
+	m.clearedFields[environment.FieldExpectedMatchID] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the FlagMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FlagMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Flag, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ExpectedMatchCleared reports if the "expected_match" edge to the Environment entity was cleared.
+func (m *EnvironmentMutation) ExpectedMatchCleared() bool {
+	return m.ExpectedMatchIDCleared() || m.clearedexpected_match
+}
+
+// ExpectedMatchIDs returns the "expected_match" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ExpectedMatchID instead. It exists only for internal usage by the builders.
+func (m *EnvironmentMutation) ExpectedMatchIDs() (ids []int) {
+	if id := m.expected_match; id != nil {
+		ids = append(ids, *id)
 	}
-	m.Where(p...)
+	return
 }
 
-// Op returns the operation name.
-func (m *FlagMutation) Op() Op {
-	return m.op
+// ResetExpectedMatch resets all changes to the "expected_match" edge.
+func (m *EnvironmentMutation) ResetExpectedMatch() {
+	m.expected_match = nil
+	m.clearedexpected_match = false
 }
 
-// SetOp allows setting the mutation operation.
-func (m *FlagMutation) SetOp(op Op) {
-	m.op = op
+// AddMatchedByIDs adds the "matched_by" edge to the Environment entity by ids.
+func (m *EnvironmentMutation) AddMatchedByIDs(ids ...int) {
+	if m.matched_by == nil {
+		m.matched_by = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.matched_by[ids[i]] = struct{}{}
+	}
 }
 
-// Type returns the node type of this mutation (Flag).
-func (m *FlagMutation) Type() string {
-	return m.typ
+// ClearMatchedBy clears the "matched_by" edge to the Environment entity.
+func (m *EnvironmentMutation) ClearMatchedBy() {
+	m.clearedmatched_by = true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *FlagMutation) Fields() []string {
-	fields := make([]string, 0, 6)
-	if m.name != nil {
-		fields = append(fields, flag.FieldName)
+// MatchedByCleared reports if the "matched_by" edge to the Environment entity was cleared.
+func (m *EnvironmentMutation) MatchedByCleared() bool {
+	return m.clearedmatched_by
+}
+
+// RemoveMatchedByIDs removes the "matched_by" edge to the Environment entity by IDs.
+func (m *EnvironmentMutation) RemoveMatchedByIDs(ids ...int) {
+	if m.removedmatched_by == nil {
+		m.removedmatched_by = make(map[int]struct{})
 	}
-	if m.description != nil {
-		fields = append(fields, flag.FieldDescription)
+	for i := range ids {
+		delete(m.matched_by, ids[i])
+		m.removedmatched_by[ids[i]] = struct{}{}
 	}
-	if m.flag_type != nil {
-		fields = append(fields, flag.FieldFlagType)
+}
+
+// RemovedMatchedBy returns the removed IDs of the "matched_by" edge to the Environment entity.
+func (m *EnvironmentMutation) RemovedMatchedByIDs() (ids []int) {
+	for id := range m.removedmatched_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MatchedByIDs returns the "matched_by" edge IDs in the mutation.
+func (m *EnvironmentMutation) MatchedByIDs() (ids []int) {
+	for id := range m.matched_by {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMatchedBy resets all changes to the "matched_by" edge.
+func (m *EnvironmentMutation) ResetMatchedBy() {
+	m.matched_by = nil
+	m.clearedmatched_by = false
+	m.removedmatched_by = nil
+}
+
+// Where appends a list predicates to the EnvironmentMutation builder.
+func (m *EnvironmentMutation) Where(ps ...predicate.Environment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnvironmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Environment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnvironmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnvironmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Environment).
+func (m *EnvironmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnvironmentMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.name != nil {
+		fields = append(fields, environment.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, environment.FieldType)
+	}
+	if m.sort_order != nil {
+		fields = append(fields, environment.FieldSortOrder)
 	}
 	if m.project != nil {
-		fields = append(fields, flag.FieldProjectID)
+		fields = append(fields, environment.FieldProjectID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, flag.FieldCreatedAt)
+		fields = append(fields, environment.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, flag.FieldUpdatedAt)
+		fields = append(fields, environment.FieldUpdatedAt)
+	}
+	if m.expected_match != nil {
+		fields = append(fields, environment.FieldExpectedMatchID)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, environment.FieldDeletedAt)
+	}
+	if m.frozen_at != nil {
+		fields = append(fields, environment.FieldFrozenAt)
+	}
+	if m.frozen_by != nil {
+		fields = append(fields, environment.FieldFrozenBy)
+	}
+	if m.freeze_reason != nil {
+		fields = append(fields, environment.FieldFreezeReason)
 	}
 	return fields
 }
@@ -2993,20 +3359,30 @@ func (m *FlagMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FlagMutation) Field(name string) (ent.Value, bool) {
+func (m *EnvironmentMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case flag.FieldName:
+	case environment.FieldName:
 		return m.Name()
-	case flag.FieldDescription:
-		return m.Description()
-	case flag.FieldFlagType:
-		return m.FlagType()
-	case flag.FieldProjectID:
+	case environment.FieldType:
+		return m.GetType()
+	case environment.FieldSortOrder:
+		return m.SortOrder()
+	case environment.FieldProjectID:
 		return m.ProjectID()
-	case flag.FieldCreatedAt:
+	case environment.FieldCreatedAt:
 		return m.CreatedAt()
-	case flag.FieldUpdatedAt:
+	case environment.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case environment.FieldExpectedMatchID:
+		return m.ExpectedMatchID()
+	case environment.FieldDeletedAt:
+		return m.DeletedAt()
+	case environment.FieldFrozenAt:
+		return m.FrozenAt()
+	case environment.FieldFrozenBy:
+		return m.FrozenBy()
+	case environment.FieldFreezeReason:
+		return m.FreezeReason()
 	}
 	return nil, false
 }
@@ -3014,87 +3390,137 @@ func (m *FlagMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FlagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case flag.FieldName:
+	case environment.FieldName:
 		return m.OldName(ctx)
-	case flag.FieldDescription:
-		return m.OldDescription(ctx)
-	case flag.FieldFlagType:
-		return m.OldFlagType(ctx)
-	case flag.FieldProjectID:
+	case environment.FieldType:
+		return m.OldType(ctx)
+	case environment.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case environment.FieldProjectID:
 		return m.OldProjectID(ctx)
-	case flag.FieldCreatedAt:
+	case environment.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case flag.FieldUpdatedAt:
+	case environment.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case environment.FieldExpectedMatchID:
+		return m.OldExpectedMatchID(ctx)
+	case environment.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case environment.FieldFrozenAt:
+		return m.OldFrozenAt(ctx)
+	case environment.FieldFrozenBy:
+		return m.OldFrozenBy(ctx)
+	case environment.FieldFreezeReason:
+		return m.OldFreezeReason(ctx)
 	}
-	return nil, fmt.Errorf("unknown Flag field %s", name)
+	return nil, fmt.Errorf("unknown Environment field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlagMutation) SetField(name string, value ent.Value) error {
+func (m *EnvironmentMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case flag.FieldName:
+	case environment.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case flag.FieldDescription:
-		v, ok := value.(string)
+	case environment.FieldType:
+		v, ok := value.(environment.Type)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetType(v)
 		return nil
-	case flag.FieldFlagType:
-		v, ok := value.(flag.FlagType)
+	case environment.FieldSortOrder:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlagType(v)
+		m.SetSortOrder(v)
 		return nil
-	case flag.FieldProjectID:
+	case environment.FieldProjectID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetProjectID(v)
 		return nil
-	case flag.FieldCreatedAt:
+	case environment.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case flag.FieldUpdatedAt:
+	case environment.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
+	case environment.FieldExpectedMatchID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpectedMatchID(v)
+		return nil
+	case environment.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case environment.FieldFrozenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrozenAt(v)
+		return nil
+	case environment.FieldFrozenBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrozenBy(v)
+		return nil
+	case environment.FieldFreezeReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreezeReason(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Flag field %s", name)
+	return fmt.Errorf("unknown Environment field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FlagMutation) AddedFields() []string {
+func (m *EnvironmentMutation) AddedFields() []string {
 	var fields []string
+	if m.addsort_order != nil {
+		fields = append(fields, environment.FieldSortOrder)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FlagMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EnvironmentMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case environment.FieldSortOrder:
+		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -3102,201 +3528,302 @@ func (m *FlagMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlagMutation) AddField(name string, value ent.Value) error {
+func (m *EnvironmentMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case environment.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Flag numeric field %s", name)
+	return fmt.Errorf("unknown Environment numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FlagMutation) ClearedFields() []string {
+func (m *EnvironmentMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(flag.FieldDescription) {
-		fields = append(fields, flag.FieldDescription)
+	if m.FieldCleared(environment.FieldExpectedMatchID) {
+		fields = append(fields, environment.FieldExpectedMatchID)
+	}
+	if m.FieldCleared(environment.FieldDeletedAt) {
+		fields = append(fields, environment.FieldDeletedAt)
+	}
+	if m.FieldCleared(environment.FieldFrozenAt) {
+		fields = append(fields, environment.FieldFrozenAt)
+	}
+	if m.FieldCleared(environment.FieldFrozenBy) {
+		fields = append(fields, environment.FieldFrozenBy)
+	}
+	if m.FieldCleared(environment.FieldFreezeReason) {
+		fields = append(fields, environment.FieldFreezeReason)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FlagMutation) FieldCleared(name string) bool {
+func (m *EnvironmentMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FlagMutation) ClearField(name string) error {
+func (m *EnvironmentMutation) ClearField(name string) error {
 	switch name {
-	case flag.FieldDescription:
-		m.ClearDescription()
+	case environment.FieldExpectedMatchID:
+		m.ClearExpectedMatchID()
+		return nil
+	case environment.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case environment.FieldFrozenAt:
+		m.ClearFrozenAt()
+		return nil
+	case environment.FieldFrozenBy:
+		m.ClearFrozenBy()
+		return nil
+	case environment.FieldFreezeReason:
+		m.ClearFreezeReason()
 		return nil
 	}
-	return fmt.Errorf("unknown Flag nullable field %s", name)
+	return fmt.Errorf("unknown Environment nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FlagMutation) ResetField(name string) error {
+func (m *EnvironmentMutation) ResetField(name string) error {
 	switch name {
-	case flag.FieldName:
+	case environment.FieldName:
 		m.ResetName()
 		return nil
-	case flag.FieldDescription:
-		m.ResetDescription()
+	case environment.FieldType:
+		m.ResetType()
 		return nil
-	case flag.FieldFlagType:
-		m.ResetFlagType()
+	case environment.FieldSortOrder:
+		m.ResetSortOrder()
 		return nil
-	case flag.FieldProjectID:
+	case environment.FieldProjectID:
 		m.ResetProjectID()
 		return nil
-	case flag.FieldCreatedAt:
+	case environment.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case flag.FieldUpdatedAt:
+	case environment.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case environment.FieldExpectedMatchID:
+		m.ResetExpectedMatchID()
+		return nil
+	case environment.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case environment.FieldFrozenAt:
+		m.ResetFrozenAt()
+		return nil
+	case environment.FieldFrozenBy:
+		m.ResetFrozenBy()
+		return nil
+	case environment.FieldFreezeReason:
+		m.ResetFreezeReason()
+		return nil
 	}
-	return fmt.Errorf("unknown Flag field %s", name)
+	return fmt.Errorf("unknown Environment field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FlagMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *EnvironmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.project != nil {
-		edges = append(edges, flag.EdgeProject)
+		edges = append(edges, environment.EdgeProject)
 	}
 	if m.flag_environments != nil {
-		edges = append(edges, flag.EdgeFlagEnvironments)
+		edges = append(edges, environment.EdgeFlagEnvironments)
+	}
+	if m.expected_match != nil {
+		edges = append(edges, environment.EdgeExpectedMatch)
+	}
+	if m.matched_by != nil {
+		edges = append(edges, environment.EdgeMatchedBy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FlagMutation) AddedIDs(name string) []ent.Value {
+func (m *EnvironmentMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case flag.EdgeProject:
+	case environment.EdgeProject:
 		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
-	case flag.EdgeFlagEnvironments:
+	case environment.EdgeFlagEnvironments:
 		ids := make([]ent.Value, 0, len(m.flag_environments))
 		for id := range m.flag_environments {
 			ids = append(ids, id)
 		}
 		return ids
+	case environment.EdgeExpectedMatch:
+		if id := m.expected_match; id != nil {
+			return []ent.Value{*id}
+		}
+	case environment.EdgeMatchedBy:
+		ids := make([]ent.Value, 0, len(m.matched_by))
+		for id := range m.matched_by {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FlagMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *EnvironmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.removedflag_environments != nil {
-		edges = append(edges, flag.EdgeFlagEnvironments)
+		edges = append(edges, environment.EdgeFlagEnvironments)
+	}
+	if m.removedmatched_by != nil {
+		edges = append(edges, environment.EdgeMatchedBy)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FlagMutation) RemovedIDs(name string) []ent.Value {
+func (m *EnvironmentMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case flag.EdgeFlagEnvironments:
+	case environment.EdgeFlagEnvironments:
 		ids := make([]ent.Value, 0, len(m.removedflag_environments))
 		for id := range m.removedflag_environments {
 			ids = append(ids, id)
 		}
 		return ids
+	case environment.EdgeMatchedBy:
+		ids := make([]ent.Value, 0, len(m.removedmatched_by))
+		for id := range m.removedmatched_by {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FlagMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *EnvironmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
 	if m.clearedproject {
-		edges = append(edges, flag.EdgeProject)
+		edges = append(edges, environment.EdgeProject)
 	}
 	if m.clearedflag_environments {
-		edges = append(edges, flag.EdgeFlagEnvironments)
+		edges = append(edges, environment.EdgeFlagEnvironments)
+	}
+	if m.clearedexpected_match {
+		edges = append(edges, environment.EdgeExpectedMatch)
+	}
+	if m.clearedmatched_by {
+		edges = append(edges, environment.EdgeMatchedBy)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FlagMutation) EdgeCleared(name string) bool {
+func (m *EnvironmentMutation) EdgeCleared(name string) bool {
 	switch name {
-	case flag.EdgeProject:
+	case environment.EdgeProject:
 		return m.clearedproject
-	case flag.EdgeFlagEnvironments:
+	case environment.EdgeFlagEnvironments:
 		return m.clearedflag_environments
+	case environment.EdgeExpectedMatch:
+		return m.clearedexpected_match
+	case environment.EdgeMatchedBy:
+		return m.clearedmatched_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FlagMutation) ClearEdge(name string) error {
+func (m *EnvironmentMutation) ClearEdge(name string) error {
 	switch name {
-	case flag.EdgeProject:
+	case environment.EdgeProject:
 		m.ClearProject()
 		return nil
+	case environment.EdgeExpectedMatch:
+		m.ClearExpectedMatch()
+		return nil
 	}
-	return fmt.Errorf("unknown Flag unique edge %s", name)
+	return fmt.Errorf("unknown Environment unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FlagMutation) ResetEdge(name string) error {
+func (m *EnvironmentMutation) ResetEdge(name string) error {
 	switch name {
-	case flag.EdgeProject:
+	case environment.EdgeProject:
 		m.ResetProject()
 		return nil
-	case flag.EdgeFlagEnvironments:
+	case environment.EdgeFlagEnvironments:
 		m.ResetFlagEnvironments()
 		return nil
+	case environment.EdgeExpectedMatch:
+		m.ResetExpectedMatch()
+		return nil
+	case environment.EdgeMatchedBy:
+		m.ResetMatchedBy()
+		return nil
 	}
-	return fmt.Errorf("unknown Flag edge %s", name)
+	return fmt.Errorf("unknown Environment edge %s", name)
 }
 
-// FlagEnvironmentMutation represents an operation that mutates the FlagEnvironment nodes in the graph.
-type FlagEnvironmentMutation struct {
+// FlagMutation represents an operation that mutates the Flag nodes in the graph.
+type FlagMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *int
-	enabled            *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	flag               *int
-	clearedflag        bool
-	environment        *int
-	clearedenvironment bool
-	strategies         map[int]struct{}
-	removedstrategies  map[int]struct{}
-	clearedstrategies  bool
-	done               bool
-	oldValue           func(context.Context) (*FlagEnvironment, error)
-	predicates         []predicate.FlagEnvironment
+	op                       Op
+	typ                      string
+	id                       *int
+	name                     *string
+	description              *string
+	flag_type                *flag.FlagType
+	created_at               *time.Time
+	updated_at               *time.Time
+	deleted_at               *time.Time
+	owner                    *string
+	links                    *[]schema.Link
+	appendlinks              []schema.Link
+	metadata                 *map[string]string
+	clearedFields            map[string]struct{}
+	project                  *int
+	clearedproject           bool
+	flag_environments        map[int]struct{}
+	removedflag_environments map[int]struct{}
+	clearedflag_environments bool
+	dependents               map[int]struct{}
+	removeddependents        map[int]struct{}
+	cleareddependents        bool
+	tags                     map[int]struct{}
+	removedtags              map[int]struct{}
+	clearedtags              bool
+	done                     bool
+	oldValue                 func(context.Context) (*Flag, error)
+	predicates               []predicate.Flag
 }
 
-var _ ent.Mutation = (*FlagEnvironmentMutation)(nil)
+var _ ent.Mutation = (*FlagMutation)(nil)
 
-// flagenvironmentOption allows management of the mutation configuration using functional options.
-type flagenvironmentOption func(*FlagEnvironmentMutation)
+// flagOption allows management of the mutation configuration using functional options.
+type flagOption func(*FlagMutation)
 
-// newFlagEnvironmentMutation creates new mutation for the FlagEnvironment entity.
-func newFlagEnvironmentMutation(c config, op Op, opts ...flagenvironmentOption) *FlagEnvironmentMutation {
-	m := &FlagEnvironmentMutation{
+// newFlagMutation creates new mutation for the Flag entity.
+func newFlagMutation(c config, op Op, opts ...flagOption) *FlagMutation {
+	m := &FlagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFlagEnvironment,
+		typ:           TypeFlag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3305,20 +3832,20 @@ func newFlagEnvironmentMutation(c config, op Op, opts ...flagenvironmentOption)
 	return m
 }
 
-// withFlagEnvironmentID sets the ID field of the mutation.
-func withFlagEnvironmentID(id int) flagenvironmentOption {
-	return func(m *FlagEnvironmentMutation) {
+// withFlagID sets the ID field of the mutation.
+func withFlagID(id int) flagOption {
+	return func(m *FlagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FlagEnvironment
+			value *Flag
 		)
-		m.oldValue = func(ctx context.Context) (*FlagEnvironment, error) {
+		m.oldValue = func(ctx context.Context) (*Flag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FlagEnvironment.Get(ctx, id)
+					value, err = m.Client().Flag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3327,10 +3854,10 @@ func withFlagEnvironmentID(id int) flagenvironmentOption {
 	}
 }
 
-// withFlagEnvironment sets the old FlagEnvironment of the mutation.
-func withFlagEnvironment(node *FlagEnvironment) flagenvironmentOption {
-	return func(m *FlagEnvironmentMutation) {
-		m.oldValue = func(context.Context) (*FlagEnvironment, error) {
+// withFlag sets the old Flag of the mutation.
+func withFlag(node *Flag) flagOption {
+	return func(m *FlagMutation) {
+		m.oldValue = func(context.Context) (*Flag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3339,7 +3866,7 @@ func withFlagEnvironment(node *FlagEnvironment) flagenvironmentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FlagEnvironmentMutation) Client() *Client {
+func (m FlagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3347,7 +3874,7 @@ func (m FlagEnvironmentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FlagEnvironmentMutation) Tx() (*Tx, error) {
+func (m FlagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3358,7 +3885,7 @@ func (m FlagEnvironmentMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FlagEnvironmentMutation) ID() (id int, exists bool) {
+func (m *FlagMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3369,7 +3896,7 @@ func (m *FlagEnvironmentMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FlagEnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *FlagMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3378,174 +3905,223 @@ func (m *FlagEnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FlagEnvironment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Flag.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEnabled sets the "enabled" field.
-func (m *FlagEnvironmentMutation) SetEnabled(b bool) {
-	m.enabled = &b
+// SetName sets the "name" field.
+func (m *FlagMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Enabled returns the value of the "enabled" field in the mutation.
-func (m *FlagEnvironmentMutation) Enabled() (r bool, exists bool) {
-	v := m.enabled
+// Name returns the value of the "name" field in the mutation.
+func (m *FlagMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnabled returns the old "enabled" field's value of the FlagEnvironment entity.
-// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagEnvironmentMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+func (m *FlagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnabled requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Enabled, nil
+	return oldValue.Name, nil
 }
 
-// ResetEnabled resets all changes to the "enabled" field.
-func (m *FlagEnvironmentMutation) ResetEnabled() {
-	m.enabled = nil
+// ResetName resets all changes to the "name" field.
+func (m *FlagMutation) ResetName() {
+	m.name = nil
 }
 
-// SetFlagID sets the "flag_id" field.
-func (m *FlagEnvironmentMutation) SetFlagID(i int) {
-	m.flag = &i
+// SetDescription sets the "description" field.
+func (m *FlagMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// FlagID returns the value of the "flag_id" field in the mutation.
-func (m *FlagEnvironmentMutation) FlagID() (r int, exists bool) {
-	v := m.flag
+// Description returns the value of the "description" field in the mutation.
+func (m *FlagMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlagID returns the old "flag_id" field's value of the FlagEnvironment entity.
-// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagEnvironmentMutation) OldFlagID(ctx context.Context) (v int, err error) {
+func (m *FlagMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlagID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlagID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlagID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.FlagID, nil
+	return oldValue.Description, nil
 }
 
-// ResetFlagID resets all changes to the "flag_id" field.
-func (m *FlagEnvironmentMutation) ResetFlagID() {
-	m.flag = nil
+// ClearDescription clears the value of the "description" field.
+func (m *FlagMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[flag.FieldDescription] = struct{}{}
 }
 
-// SetEnvironmentID sets the "environment_id" field.
-func (m *FlagEnvironmentMutation) SetEnvironmentID(i int) {
-	m.environment = &i
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *FlagMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[flag.FieldDescription]
+	return ok
 }
 
-// EnvironmentID returns the value of the "environment_id" field in the mutation.
-func (m *FlagEnvironmentMutation) EnvironmentID() (r int, exists bool) {
-	v := m.environment
+// ResetDescription resets all changes to the "description" field.
+func (m *FlagMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, flag.FieldDescription)
+}
+
+// SetFlagType sets the "flag_type" field.
+func (m *FlagMutation) SetFlagType(ft flag.FlagType) {
+	m.flag_type = &ft
+}
+
+// FlagType returns the value of the "flag_type" field in the mutation.
+func (m *FlagMutation) FlagType() (r flag.FlagType, exists bool) {
+	v := m.flag_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEnvironmentID returns the old "environment_id" field's value of the FlagEnvironment entity.
-// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// OldFlagType returns the old "flag_type" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagEnvironmentMutation) OldEnvironmentID(ctx context.Context) (v int, err error) {
+func (m *FlagMutation) OldFlagType(ctx context.Context) (v flag.FlagType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEnvironmentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldFlagType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEnvironmentID requires an ID field in the mutation")
+		return v, errors.New("OldFlagType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEnvironmentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldFlagType: %w", err)
 	}
-	return oldValue.EnvironmentID, nil
+	return oldValue.FlagType, nil
 }
 
-// ResetEnvironmentID resets all changes to the "environment_id" field.
-func (m *FlagEnvironmentMutation) ResetEnvironmentID() {
-	m.environment = nil
+// ResetFlagType resets all changes to the "flag_type" field.
+func (m *FlagMutation) ResetFlagType() {
+	m.flag_type = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FlagEnvironmentMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetProjectID sets the "project_id" field.
+func (m *FlagMutation) SetProjectID(i int) {
+	m.project = &i
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FlagEnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *FlagMutation) ProjectID() (r int, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FlagEnvironment entity.
-// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagEnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FlagMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ProjectID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FlagEnvironmentMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *FlagMutation) ResetProjectID() {
+	m.project = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *FlagEnvironmentMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *FlagMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *FlagEnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlagMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the FlagEnvironment entity.
-// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FlagEnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FlagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlagMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlagMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlagMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3560,127 +4136,6196 @@ func (m *FlagEnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *FlagEnvironmentMutation) ResetUpdatedAt() {
+func (m *FlagMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearFlag clears the "flag" edge to the Flag entity.
-func (m *FlagEnvironmentMutation) ClearFlag() {
-	m.clearedflag = true
-	m.clearedFields[flagenvironment.FieldFlagID] = struct{}{}
+// SetDeletedAt sets the "deleted_at" field.
+func (m *FlagMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// FlagCleared reports if the "flag" edge to the Flag entity was cleared.
-func (m *FlagEnvironmentMutation) FlagCleared() bool {
-	return m.clearedflag
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *FlagMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// FlagIDs returns the "flag" edge IDs in the mutation.
+// OldDeletedAt returns the old "deleted_at" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *FlagMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[flag.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *FlagMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[flag.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *FlagMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, flag.FieldDeletedAt)
+}
+
+// SetOwner sets the "owner" field.
+func (m *FlagMutation) SetOwner(s string) {
+	m.owner = &s
+}
+
+// Owner returns the value of the "owner" field in the mutation.
+func (m *FlagMutation) Owner() (r string, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwner returns the old "owner" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagMutation) OldOwner(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwner is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwner requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwner: %w", err)
+	}
+	return oldValue.Owner, nil
+}
+
+// ClearOwner clears the value of the "owner" field.
+func (m *FlagMutation) ClearOwner() {
+	m.owner = nil
+	m.clearedFields[flag.FieldOwner] = struct{}{}
+}
+
+// OwnerCleared returns if the "owner" field was cleared in this mutation.
+func (m *FlagMutation) OwnerCleared() bool {
+	_, ok := m.clearedFields[flag.FieldOwner]
+	return ok
+}
+
+// ResetOwner resets all changes to the "owner" field.
+func (m *FlagMutation) ResetOwner() {
+	m.owner = nil
+	delete(m.clearedFields, flag.FieldOwner)
+}
+
+// SetLinks sets the "links" field.
+func (m *FlagMutation) SetLinks(s []schema.Link) {
+	m.links = &s
+	m.appendlinks = nil
+}
+
+// Links returns the value of the "links" field in the mutation.
+func (m *FlagMutation) Links() (r []schema.Link, exists bool) {
+	v := m.links
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLinks returns the old "links" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagMutation) OldLinks(ctx context.Context) (v []schema.Link, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLinks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLinks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLinks: %w", err)
+	}
+	return oldValue.Links, nil
+}
+
+// AppendLinks adds s to the "links" field.
+func (m *FlagMutation) AppendLinks(s []schema.Link) {
+	m.appendlinks = append(m.appendlinks, s...)
+}
+
+// AppendedLinks returns the list of values that were appended to the "links" field in this mutation.
+func (m *FlagMutation) AppendedLinks() ([]schema.Link, bool) {
+	if len(m.appendlinks) == 0 {
+		return nil, false
+	}
+	return m.appendlinks, true
+}
+
+// ClearLinks clears the value of the "links" field.
+func (m *FlagMutation) ClearLinks() {
+	m.links = nil
+	m.appendlinks = nil
+	m.clearedFields[flag.FieldLinks] = struct{}{}
+}
+
+// LinksCleared returns if the "links" field was cleared in this mutation.
+func (m *FlagMutation) LinksCleared() bool {
+	_, ok := m.clearedFields[flag.FieldLinks]
+	return ok
+}
+
+// ResetLinks resets all changes to the "links" field.
+func (m *FlagMutation) ResetLinks() {
+	m.links = nil
+	m.appendlinks = nil
+	delete(m.clearedFields, flag.FieldLinks)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *FlagMutation) SetMetadata(value map[string]string) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *FlagMutation) Metadata() (r map[string]string, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the Flag entity.
+// If the Flag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagMutation) OldMetadata(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *FlagMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[flag.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *FlagMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[flag.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *FlagMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, flag.FieldMetadata)
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (m *FlagMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[flag.FieldProjectID] = struct{}{}
+}
+
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *FlagMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FlagID instead. It exists only for internal usage by the builders.
-func (m *FlagEnvironmentMutation) FlagIDs() (ids []int) {
-	if id := m.flag; id != nil {
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *FlagMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFlag resets all changes to the "flag" edge.
-func (m *FlagEnvironmentMutation) ResetFlag() {
-	m.flag = nil
-	m.clearedflag = false
+// ResetProject resets all changes to the "project" edge.
+func (m *FlagMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
 }
 
-// ClearEnvironment clears the "environment" edge to the Environment entity.
-func (m *FlagEnvironmentMutation) ClearEnvironment() {
-	m.clearedenvironment = true
-	m.clearedFields[flagenvironment.FieldEnvironmentID] = struct{}{}
+// AddFlagEnvironmentIDs adds the "flag_environments" edge to the FlagEnvironment entity by ids.
+func (m *FlagMutation) AddFlagEnvironmentIDs(ids ...int) {
+	if m.flag_environments == nil {
+		m.flag_environments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.flag_environments[ids[i]] = struct{}{}
+	}
 }
 
-// EnvironmentCleared reports if the "environment" edge to the Environment entity was cleared.
-func (m *FlagEnvironmentMutation) EnvironmentCleared() bool {
-	return m.clearedenvironment
+// ClearFlagEnvironments clears the "flag_environments" edge to the FlagEnvironment entity.
+func (m *FlagMutation) ClearFlagEnvironments() {
+	m.clearedflag_environments = true
 }
 
-// EnvironmentIDs returns the "environment" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EnvironmentID instead. It exists only for internal usage by the builders.
-func (m *FlagEnvironmentMutation) EnvironmentIDs() (ids []int) {
-	if id := m.environment; id != nil {
-		ids = append(ids, *id)
+// FlagEnvironmentsCleared reports if the "flag_environments" edge to the FlagEnvironment entity was cleared.
+func (m *FlagMutation) FlagEnvironmentsCleared() bool {
+	return m.clearedflag_environments
+}
+
+// RemoveFlagEnvironmentIDs removes the "flag_environments" edge to the FlagEnvironment entity by IDs.
+func (m *FlagMutation) RemoveFlagEnvironmentIDs(ids ...int) {
+	if m.removedflag_environments == nil {
+		m.removedflag_environments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.flag_environments, ids[i])
+		m.removedflag_environments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFlagEnvironments returns the removed IDs of the "flag_environments" edge to the FlagEnvironment entity.
+func (m *FlagMutation) RemovedFlagEnvironmentsIDs() (ids []int) {
+	for id := range m.removedflag_environments {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetEnvironment resets all changes to the "environment" edge.
-func (m *FlagEnvironmentMutation) ResetEnvironment() {
-	m.environment = nil
-	m.clearedenvironment = false
+// FlagEnvironmentsIDs returns the "flag_environments" edge IDs in the mutation.
+func (m *FlagMutation) FlagEnvironmentsIDs() (ids []int) {
+	for id := range m.flag_environments {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// AddStrategyIDs adds the "strategies" edge to the Strategy entity by ids.
-func (m *FlagEnvironmentMutation) AddStrategyIDs(ids ...int) {
-	if m.strategies == nil {
-		m.strategies = make(map[int]struct{})
+// ResetFlagEnvironments resets all changes to the "flag_environments" edge.
+func (m *FlagMutation) ResetFlagEnvironments() {
+	m.flag_environments = nil
+	m.clearedflag_environments = false
+	m.removedflag_environments = nil
+}
+
+// AddDependentIDs adds the "dependents" edge to the Prerequisite entity by ids.
+func (m *FlagMutation) AddDependentIDs(ids ...int) {
+	if m.dependents == nil {
+		m.dependents = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.strategies[ids[i]] = struct{}{}
+		m.dependents[ids[i]] = struct{}{}
 	}
 }
 
-// ClearStrategies clears the "strategies" edge to the Strategy entity.
-func (m *FlagEnvironmentMutation) ClearStrategies() {
-	m.clearedstrategies = true
+// ClearDependents clears the "dependents" edge to the Prerequisite entity.
+func (m *FlagMutation) ClearDependents() {
+	m.cleareddependents = true
+}
+
+// DependentsCleared reports if the "dependents" edge to the Prerequisite entity was cleared.
+func (m *FlagMutation) DependentsCleared() bool {
+	return m.cleareddependents
+}
+
+// RemoveDependentIDs removes the "dependents" edge to the Prerequisite entity by IDs.
+func (m *FlagMutation) RemoveDependentIDs(ids ...int) {
+	if m.removeddependents == nil {
+		m.removeddependents = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.dependents, ids[i])
+		m.removeddependents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDependents returns the removed IDs of the "dependents" edge to the Prerequisite entity.
+func (m *FlagMutation) RemovedDependentsIDs() (ids []int) {
+	for id := range m.removeddependents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DependentsIDs returns the "dependents" edge IDs in the mutation.
+func (m *FlagMutation) DependentsIDs() (ids []int) {
+	for id := range m.dependents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDependents resets all changes to the "dependents" edge.
+func (m *FlagMutation) ResetDependents() {
+	m.dependents = nil
+	m.cleareddependents = false
+	m.removeddependents = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *FlagMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *FlagMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *FlagMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *FlagMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *FlagMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *FlagMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *FlagMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// Where appends a list predicates to the FlagMutation builder.
+func (m *FlagMutation) Where(ps ...predicate.Flag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Flag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Flag).
+func (m *FlagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlagMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.name != nil {
+		fields = append(fields, flag.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, flag.FieldDescription)
+	}
+	if m.flag_type != nil {
+		fields = append(fields, flag.FieldFlagType)
+	}
+	if m.project != nil {
+		fields = append(fields, flag.FieldProjectID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flag.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flag.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, flag.FieldDeletedAt)
+	}
+	if m.owner != nil {
+		fields = append(fields, flag.FieldOwner)
+	}
+	if m.links != nil {
+		fields = append(fields, flag.FieldLinks)
+	}
+	if m.metadata != nil {
+		fields = append(fields, flag.FieldMetadata)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flag.FieldName:
+		return m.Name()
+	case flag.FieldDescription:
+		return m.Description()
+	case flag.FieldFlagType:
+		return m.FlagType()
+	case flag.FieldProjectID:
+		return m.ProjectID()
+	case flag.FieldCreatedAt:
+		return m.CreatedAt()
+	case flag.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case flag.FieldDeletedAt:
+		return m.DeletedAt()
+	case flag.FieldOwner:
+		return m.Owner()
+	case flag.FieldLinks:
+		return m.Links()
+	case flag.FieldMetadata:
+		return m.Metadata()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flag.FieldName:
+		return m.OldName(ctx)
+	case flag.FieldDescription:
+		return m.OldDescription(ctx)
+	case flag.FieldFlagType:
+		return m.OldFlagType(ctx)
+	case flag.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case flag.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flag.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case flag.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case flag.FieldOwner:
+		return m.OldOwner(ctx)
+	case flag.FieldLinks:
+		return m.OldLinks(ctx)
+	case flag.FieldMetadata:
+		return m.OldMetadata(ctx)
+	}
+	return nil, fmt.Errorf("unknown Flag field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flag.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case flag.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case flag.FieldFlagType:
+		v, ok := value.(flag.FlagType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlagType(v)
+		return nil
+	case flag.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case flag.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flag.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case flag.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case flag.FieldOwner:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwner(v)
+		return nil
+	case flag.FieldLinks:
+		v, ok := value.([]schema.Link)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLinks(v)
+		return nil
+	case flag.FieldMetadata:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetadata(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Flag field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlagMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlagMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Flag numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlagMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flag.FieldDescription) {
+		fields = append(fields, flag.FieldDescription)
+	}
+	if m.FieldCleared(flag.FieldDeletedAt) {
+		fields = append(fields, flag.FieldDeletedAt)
+	}
+	if m.FieldCleared(flag.FieldOwner) {
+		fields = append(fields, flag.FieldOwner)
+	}
+	if m.FieldCleared(flag.FieldLinks) {
+		fields = append(fields, flag.FieldLinks)
+	}
+	if m.FieldCleared(flag.FieldMetadata) {
+		fields = append(fields, flag.FieldMetadata)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlagMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlagMutation) ClearField(name string) error {
+	switch name {
+	case flag.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case flag.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case flag.FieldOwner:
+		m.ClearOwner()
+		return nil
+	case flag.FieldLinks:
+		m.ClearLinks()
+		return nil
+	case flag.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Flag nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlagMutation) ResetField(name string) error {
+	switch name {
+	case flag.FieldName:
+		m.ResetName()
+		return nil
+	case flag.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case flag.FieldFlagType:
+		m.ResetFlagType()
+		return nil
+	case flag.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case flag.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flag.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case flag.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case flag.FieldOwner:
+		m.ResetOwner()
+		return nil
+	case flag.FieldLinks:
+		m.ResetLinks()
+		return nil
+	case flag.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	}
+	return fmt.Errorf("unknown Flag field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.project != nil {
+		edges = append(edges, flag.EdgeProject)
+	}
+	if m.flag_environments != nil {
+		edges = append(edges, flag.EdgeFlagEnvironments)
+	}
+	if m.dependents != nil {
+		edges = append(edges, flag.EdgeDependents)
+	}
+	if m.tags != nil {
+		edges = append(edges, flag.EdgeTags)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flag.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
+		}
+	case flag.EdgeFlagEnvironments:
+		ids := make([]ent.Value, 0, len(m.flag_environments))
+		for id := range m.flag_environments {
+			ids = append(ids, id)
+		}
+		return ids
+	case flag.EdgeDependents:
+		ids := make([]ent.Value, 0, len(m.dependents))
+		for id := range m.dependents {
+			ids = append(ids, id)
+		}
+		return ids
+	case flag.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedflag_environments != nil {
+		edges = append(edges, flag.EdgeFlagEnvironments)
+	}
+	if m.removeddependents != nil {
+		edges = append(edges, flag.EdgeDependents)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, flag.EdgeTags)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case flag.EdgeFlagEnvironments:
+		ids := make([]ent.Value, 0, len(m.removedflag_environments))
+		for id := range m.removedflag_environments {
+			ids = append(ids, id)
+		}
+		return ids
+	case flag.EdgeDependents:
+		ids := make([]ent.Value, 0, len(m.removeddependents))
+		for id := range m.removeddependents {
+			ids = append(ids, id)
+		}
+		return ids
+	case flag.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedproject {
+		edges = append(edges, flag.EdgeProject)
+	}
+	if m.clearedflag_environments {
+		edges = append(edges, flag.EdgeFlagEnvironments)
+	}
+	if m.cleareddependents {
+		edges = append(edges, flag.EdgeDependents)
+	}
+	if m.clearedtags {
+		edges = append(edges, flag.EdgeTags)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flag.EdgeProject:
+		return m.clearedproject
+	case flag.EdgeFlagEnvironments:
+		return m.clearedflag_environments
+	case flag.EdgeDependents:
+		return m.cleareddependents
+	case flag.EdgeTags:
+		return m.clearedtags
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlagMutation) ClearEdge(name string) error {
+	switch name {
+	case flag.EdgeProject:
+		m.ClearProject()
+		return nil
+	}
+	return fmt.Errorf("unknown Flag unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlagMutation) ResetEdge(name string) error {
+	switch name {
+	case flag.EdgeProject:
+		m.ResetProject()
+		return nil
+	case flag.EdgeFlagEnvironments:
+		m.ResetFlagEnvironments()
+		return nil
+	case flag.EdgeDependents:
+		m.ResetDependents()
+		return nil
+	case flag.EdgeTags:
+		m.ResetTags()
+		return nil
+	}
+	return fmt.Errorf("unknown Flag edge %s", name)
+}
+
+// FlagEnvironmentMutation represents an operation that mutates the FlagEnvironment nodes in the graph.
+type FlagEnvironmentMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	enabled              *bool
+	created_at           *time.Time
+	updated_at           *time.Time
+	revision             *int
+	addrevision          *int
+	clearedFields        map[string]struct{}
+	flag                 *int
+	clearedflag          bool
+	environment          *int
+	clearedenvironment   bool
+	strategies           map[int]struct{}
+	removedstrategies    map[int]struct{}
+	clearedstrategies    bool
+	prerequisites        map[int]struct{}
+	removedprerequisites map[int]struct{}
+	clearedprerequisites bool
+	versions             map[int]struct{}
+	removedversions      map[int]struct{}
+	clearedversions      bool
+	rollout_plans        map[int]struct{}
+	removedrollout_plans map[int]struct{}
+	clearedrollout_plans bool
+	done                 bool
+	oldValue             func(context.Context) (*FlagEnvironment, error)
+	predicates           []predicate.FlagEnvironment
+}
+
+var _ ent.Mutation = (*FlagEnvironmentMutation)(nil)
+
+// flagenvironmentOption allows management of the mutation configuration using functional options.
+type flagenvironmentOption func(*FlagEnvironmentMutation)
+
+// newFlagEnvironmentMutation creates new mutation for the FlagEnvironment entity.
+func newFlagEnvironmentMutation(c config, op Op, opts ...flagenvironmentOption) *FlagEnvironmentMutation {
+	m := &FlagEnvironmentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlagEnvironment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlagEnvironmentID sets the ID field of the mutation.
+func withFlagEnvironmentID(id int) flagenvironmentOption {
+	return func(m *FlagEnvironmentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlagEnvironment
+		)
+		m.oldValue = func(ctx context.Context) (*FlagEnvironment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlagEnvironment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlagEnvironment sets the old FlagEnvironment of the mutation.
+func withFlagEnvironment(node *FlagEnvironment) flagenvironmentOption {
+	return func(m *FlagEnvironmentMutation) {
+		m.oldValue = func(context.Context) (*FlagEnvironment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlagEnvironmentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlagEnvironmentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlagEnvironmentMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlagEnvironmentMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlagEnvironment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *FlagEnvironmentMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *FlagEnvironmentMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *FlagEnvironmentMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetFlagID sets the "flag_id" field.
+func (m *FlagEnvironmentMutation) SetFlagID(i int) {
+	m.flag = &i
+}
+
+// FlagID returns the value of the "flag_id" field in the mutation.
+func (m *FlagEnvironmentMutation) FlagID() (r int, exists bool) {
+	v := m.flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlagID returns the old "flag_id" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldFlagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlagID: %w", err)
+	}
+	return oldValue.FlagID, nil
+}
+
+// ResetFlagID resets all changes to the "flag_id" field.
+func (m *FlagEnvironmentMutation) ResetFlagID() {
+	m.flag = nil
+}
+
+// SetEnvironmentID sets the "environment_id" field.
+func (m *FlagEnvironmentMutation) SetEnvironmentID(i int) {
+	m.environment = &i
+}
+
+// EnvironmentID returns the value of the "environment_id" field in the mutation.
+func (m *FlagEnvironmentMutation) EnvironmentID() (r int, exists bool) {
+	v := m.environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnvironmentID returns the old "environment_id" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnvironmentID: %w", err)
+	}
+	return oldValue.EnvironmentID, nil
+}
+
+// ResetEnvironmentID resets all changes to the "environment_id" field.
+func (m *FlagEnvironmentMutation) ResetEnvironmentID() {
+	m.environment = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlagEnvironmentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlagEnvironmentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlagEnvironmentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *FlagEnvironmentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *FlagEnvironmentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *FlagEnvironmentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetRevision sets the "revision" field.
+func (m *FlagEnvironmentMutation) SetRevision(i int) {
+	m.revision = &i
+	m.addrevision = nil
+}
+
+// Revision returns the value of the "revision" field in the mutation.
+func (m *FlagEnvironmentMutation) Revision() (r int, exists bool) {
+	v := m.revision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevision returns the old "revision" field's value of the FlagEnvironment entity.
+// If the FlagEnvironment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentMutation) OldRevision(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevision is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevision requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevision: %w", err)
+	}
+	return oldValue.Revision, nil
+}
+
+// AddRevision adds i to the "revision" field.
+func (m *FlagEnvironmentMutation) AddRevision(i int) {
+	if m.addrevision != nil {
+		*m.addrevision += i
+	} else {
+		m.addrevision = &i
+	}
+}
+
+// AddedRevision returns the value that was added to the "revision" field in this mutation.
+func (m *FlagEnvironmentMutation) AddedRevision() (r int, exists bool) {
+	v := m.addrevision
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevision resets all changes to the "revision" field.
+func (m *FlagEnvironmentMutation) ResetRevision() {
+	m.revision = nil
+	m.addrevision = nil
+}
+
+// ClearFlag clears the "flag" edge to the Flag entity.
+func (m *FlagEnvironmentMutation) ClearFlag() {
+	m.clearedflag = true
+	m.clearedFields[flagenvironment.FieldFlagID] = struct{}{}
+}
+
+// FlagCleared reports if the "flag" edge to the Flag entity was cleared.
+func (m *FlagEnvironmentMutation) FlagCleared() bool {
+	return m.clearedflag
+}
+
+// FlagIDs returns the "flag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlagID instead. It exists only for internal usage by the builders.
+func (m *FlagEnvironmentMutation) FlagIDs() (ids []int) {
+	if id := m.flag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlag resets all changes to the "flag" edge.
+func (m *FlagEnvironmentMutation) ResetFlag() {
+	m.flag = nil
+	m.clearedflag = false
+}
+
+// ClearEnvironment clears the "environment" edge to the Environment entity.
+func (m *FlagEnvironmentMutation) ClearEnvironment() {
+	m.clearedenvironment = true
+	m.clearedFields[flagenvironment.FieldEnvironmentID] = struct{}{}
+}
+
+// EnvironmentCleared reports if the "environment" edge to the Environment entity was cleared.
+func (m *FlagEnvironmentMutation) EnvironmentCleared() bool {
+	return m.clearedenvironment
+}
+
+// EnvironmentIDs returns the "environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EnvironmentID instead. It exists only for internal usage by the builders.
+func (m *FlagEnvironmentMutation) EnvironmentIDs() (ids []int) {
+	if id := m.environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEnvironment resets all changes to the "environment" edge.
+func (m *FlagEnvironmentMutation) ResetEnvironment() {
+	m.environment = nil
+	m.clearedenvironment = false
+}
+
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by ids.
+func (m *FlagEnvironmentMutation) AddStrategyIDs(ids ...int) {
+	if m.strategies == nil {
+		m.strategies = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.strategies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStrategies clears the "strategies" edge to the Strategy entity.
+func (m *FlagEnvironmentMutation) ClearStrategies() {
+	m.clearedstrategies = true
+}
+
+// StrategiesCleared reports if the "strategies" edge to the Strategy entity was cleared.
+func (m *FlagEnvironmentMutation) StrategiesCleared() bool {
+	return m.clearedstrategies
+}
+
+// RemoveStrategyIDs removes the "strategies" edge to the Strategy entity by IDs.
+func (m *FlagEnvironmentMutation) RemoveStrategyIDs(ids ...int) {
+	if m.removedstrategies == nil {
+		m.removedstrategies = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.strategies, ids[i])
+		m.removedstrategies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStrategies returns the removed IDs of the "strategies" edge to the Strategy entity.
+func (m *FlagEnvironmentMutation) RemovedStrategiesIDs() (ids []int) {
+	for id := range m.removedstrategies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StrategiesIDs returns the "strategies" edge IDs in the mutation.
+func (m *FlagEnvironmentMutation) StrategiesIDs() (ids []int) {
+	for id := range m.strategies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStrategies resets all changes to the "strategies" edge.
+func (m *FlagEnvironmentMutation) ResetStrategies() {
+	m.strategies = nil
+	m.clearedstrategies = false
+	m.removedstrategies = nil
+}
+
+// AddPrerequisiteIDs adds the "prerequisites" edge to the Prerequisite entity by ids.
+func (m *FlagEnvironmentMutation) AddPrerequisiteIDs(ids ...int) {
+	if m.prerequisites == nil {
+		m.prerequisites = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.prerequisites[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPrerequisites clears the "prerequisites" edge to the Prerequisite entity.
+func (m *FlagEnvironmentMutation) ClearPrerequisites() {
+	m.clearedprerequisites = true
+}
+
+// PrerequisitesCleared reports if the "prerequisites" edge to the Prerequisite entity was cleared.
+func (m *FlagEnvironmentMutation) PrerequisitesCleared() bool {
+	return m.clearedprerequisites
+}
+
+// RemovePrerequisiteIDs removes the "prerequisites" edge to the Prerequisite entity by IDs.
+func (m *FlagEnvironmentMutation) RemovePrerequisiteIDs(ids ...int) {
+	if m.removedprerequisites == nil {
+		m.removedprerequisites = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.prerequisites, ids[i])
+		m.removedprerequisites[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPrerequisites returns the removed IDs of the "prerequisites" edge to the Prerequisite entity.
+func (m *FlagEnvironmentMutation) RemovedPrerequisitesIDs() (ids []int) {
+	for id := range m.removedprerequisites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PrerequisitesIDs returns the "prerequisites" edge IDs in the mutation.
+func (m *FlagEnvironmentMutation) PrerequisitesIDs() (ids []int) {
+	for id := range m.prerequisites {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPrerequisites resets all changes to the "prerequisites" edge.
+func (m *FlagEnvironmentMutation) ResetPrerequisites() {
+	m.prerequisites = nil
+	m.clearedprerequisites = false
+	m.removedprerequisites = nil
+}
+
+// AddVersionIDs adds the "versions" edge to the FlagEnvironmentVersion entity by ids.
+func (m *FlagEnvironmentMutation) AddVersionIDs(ids ...int) {
+	if m.versions == nil {
+		m.versions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.versions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVersions clears the "versions" edge to the FlagEnvironmentVersion entity.
+func (m *FlagEnvironmentMutation) ClearVersions() {
+	m.clearedversions = true
+}
+
+// VersionsCleared reports if the "versions" edge to the FlagEnvironmentVersion entity was cleared.
+func (m *FlagEnvironmentMutation) VersionsCleared() bool {
+	return m.clearedversions
+}
+
+// RemoveVersionIDs removes the "versions" edge to the FlagEnvironmentVersion entity by IDs.
+func (m *FlagEnvironmentMutation) RemoveVersionIDs(ids ...int) {
+	if m.removedversions == nil {
+		m.removedversions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.versions, ids[i])
+		m.removedversions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVersions returns the removed IDs of the "versions" edge to the FlagEnvironmentVersion entity.
+func (m *FlagEnvironmentMutation) RemovedVersionsIDs() (ids []int) {
+	for id := range m.removedversions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VersionsIDs returns the "versions" edge IDs in the mutation.
+func (m *FlagEnvironmentMutation) VersionsIDs() (ids []int) {
+	for id := range m.versions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVersions resets all changes to the "versions" edge.
+func (m *FlagEnvironmentMutation) ResetVersions() {
+	m.versions = nil
+	m.clearedversions = false
+	m.removedversions = nil
+}
+
+// AddRolloutPlanIDs adds the "rollout_plans" edge to the RolloutPlan entity by ids.
+func (m *FlagEnvironmentMutation) AddRolloutPlanIDs(ids ...int) {
+	if m.rollout_plans == nil {
+		m.rollout_plans = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.rollout_plans[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRolloutPlans clears the "rollout_plans" edge to the RolloutPlan entity.
+func (m *FlagEnvironmentMutation) ClearRolloutPlans() {
+	m.clearedrollout_plans = true
+}
+
+// RolloutPlansCleared reports if the "rollout_plans" edge to the RolloutPlan entity was cleared.
+func (m *FlagEnvironmentMutation) RolloutPlansCleared() bool {
+	return m.clearedrollout_plans
+}
+
+// RemoveRolloutPlanIDs removes the "rollout_plans" edge to the RolloutPlan entity by IDs.
+func (m *FlagEnvironmentMutation) RemoveRolloutPlanIDs(ids ...int) {
+	if m.removedrollout_plans == nil {
+		m.removedrollout_plans = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.rollout_plans, ids[i])
+		m.removedrollout_plans[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRolloutPlans returns the removed IDs of the "rollout_plans" edge to the RolloutPlan entity.
+func (m *FlagEnvironmentMutation) RemovedRolloutPlansIDs() (ids []int) {
+	for id := range m.removedrollout_plans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolloutPlansIDs returns the "rollout_plans" edge IDs in the mutation.
+func (m *FlagEnvironmentMutation) RolloutPlansIDs() (ids []int) {
+	for id := range m.rollout_plans {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRolloutPlans resets all changes to the "rollout_plans" edge.
+func (m *FlagEnvironmentMutation) ResetRolloutPlans() {
+	m.rollout_plans = nil
+	m.clearedrollout_plans = false
+	m.removedrollout_plans = nil
+}
+
+// Where appends a list predicates to the FlagEnvironmentMutation builder.
+func (m *FlagEnvironmentMutation) Where(ps ...predicate.FlagEnvironment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlagEnvironmentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlagEnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlagEnvironment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlagEnvironmentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlagEnvironmentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlagEnvironment).
+func (m *FlagEnvironmentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlagEnvironmentMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.enabled != nil {
+		fields = append(fields, flagenvironment.FieldEnabled)
+	}
+	if m.flag != nil {
+		fields = append(fields, flagenvironment.FieldFlagID)
+	}
+	if m.environment != nil {
+		fields = append(fields, flagenvironment.FieldEnvironmentID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flagenvironment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, flagenvironment.FieldUpdatedAt)
+	}
+	if m.revision != nil {
+		fields = append(fields, flagenvironment.FieldRevision)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlagEnvironmentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flagenvironment.FieldEnabled:
+		return m.Enabled()
+	case flagenvironment.FieldFlagID:
+		return m.FlagID()
+	case flagenvironment.FieldEnvironmentID:
+		return m.EnvironmentID()
+	case flagenvironment.FieldCreatedAt:
+		return m.CreatedAt()
+	case flagenvironment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case flagenvironment.FieldRevision:
+		return m.Revision()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlagEnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flagenvironment.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case flagenvironment.FieldFlagID:
+		return m.OldFlagID(ctx)
+	case flagenvironment.FieldEnvironmentID:
+		return m.OldEnvironmentID(ctx)
+	case flagenvironment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flagenvironment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case flagenvironment.FieldRevision:
+		return m.OldRevision(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlagEnvironment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagEnvironmentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flagenvironment.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case flagenvironment.FieldFlagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlagID(v)
+		return nil
+	case flagenvironment.FieldEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnvironmentID(v)
+		return nil
+	case flagenvironment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flagenvironment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case flagenvironment.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlagEnvironmentMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision != nil {
+		fields = append(fields, flagenvironment.FieldRevision)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlagEnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flagenvironment.FieldRevision:
+		return m.AddedRevision()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagEnvironmentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flagenvironment.FieldRevision:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevision(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlagEnvironmentMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlagEnvironmentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlagEnvironmentMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FlagEnvironment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlagEnvironmentMutation) ResetField(name string) error {
+	switch name {
+	case flagenvironment.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case flagenvironment.FieldFlagID:
+		m.ResetFlagID()
+		return nil
+	case flagenvironment.FieldEnvironmentID:
+		m.ResetEnvironmentID()
+		return nil
+	case flagenvironment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flagenvironment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case flagenvironment.FieldRevision:
+		m.ResetRevision()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlagEnvironmentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.flag != nil {
+		edges = append(edges, flagenvironment.EdgeFlag)
+	}
+	if m.environment != nil {
+		edges = append(edges, flagenvironment.EdgeEnvironment)
+	}
+	if m.strategies != nil {
+		edges = append(edges, flagenvironment.EdgeStrategies)
+	}
+	if m.prerequisites != nil {
+		edges = append(edges, flagenvironment.EdgePrerequisites)
+	}
+	if m.versions != nil {
+		edges = append(edges, flagenvironment.EdgeVersions)
+	}
+	if m.rollout_plans != nil {
+		edges = append(edges, flagenvironment.EdgeRolloutPlans)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlagEnvironmentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flagenvironment.EdgeFlag:
+		if id := m.flag; id != nil {
+			return []ent.Value{*id}
+		}
+	case flagenvironment.EdgeEnvironment:
+		if id := m.environment; id != nil {
+			return []ent.Value{*id}
+		}
+	case flagenvironment.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.strategies))
+		for id := range m.strategies {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgePrerequisites:
+		ids := make([]ent.Value, 0, len(m.prerequisites))
+		for id := range m.prerequisites {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.versions))
+		for id := range m.versions {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgeRolloutPlans:
+		ids := make([]ent.Value, 0, len(m.rollout_plans))
+		for id := range m.rollout_plans {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlagEnvironmentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedstrategies != nil {
+		edges = append(edges, flagenvironment.EdgeStrategies)
+	}
+	if m.removedprerequisites != nil {
+		edges = append(edges, flagenvironment.EdgePrerequisites)
+	}
+	if m.removedversions != nil {
+		edges = append(edges, flagenvironment.EdgeVersions)
+	}
+	if m.removedrollout_plans != nil {
+		edges = append(edges, flagenvironment.EdgeRolloutPlans)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlagEnvironmentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case flagenvironment.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.removedstrategies))
+		for id := range m.removedstrategies {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgePrerequisites:
+		ids := make([]ent.Value, 0, len(m.removedprerequisites))
+		for id := range m.removedprerequisites {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgeVersions:
+		ids := make([]ent.Value, 0, len(m.removedversions))
+		for id := range m.removedversions {
+			ids = append(ids, id)
+		}
+		return ids
+	case flagenvironment.EdgeRolloutPlans:
+		ids := make([]ent.Value, 0, len(m.removedrollout_plans))
+		for id := range m.removedrollout_plans {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlagEnvironmentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedflag {
+		edges = append(edges, flagenvironment.EdgeFlag)
+	}
+	if m.clearedenvironment {
+		edges = append(edges, flagenvironment.EdgeEnvironment)
+	}
+	if m.clearedstrategies {
+		edges = append(edges, flagenvironment.EdgeStrategies)
+	}
+	if m.clearedprerequisites {
+		edges = append(edges, flagenvironment.EdgePrerequisites)
+	}
+	if m.clearedversions {
+		edges = append(edges, flagenvironment.EdgeVersions)
+	}
+	if m.clearedrollout_plans {
+		edges = append(edges, flagenvironment.EdgeRolloutPlans)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlagEnvironmentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flagenvironment.EdgeFlag:
+		return m.clearedflag
+	case flagenvironment.EdgeEnvironment:
+		return m.clearedenvironment
+	case flagenvironment.EdgeStrategies:
+		return m.clearedstrategies
+	case flagenvironment.EdgePrerequisites:
+		return m.clearedprerequisites
+	case flagenvironment.EdgeVersions:
+		return m.clearedversions
+	case flagenvironment.EdgeRolloutPlans:
+		return m.clearedrollout_plans
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlagEnvironmentMutation) ClearEdge(name string) error {
+	switch name {
+	case flagenvironment.EdgeFlag:
+		m.ClearFlag()
+		return nil
+	case flagenvironment.EdgeEnvironment:
+		m.ClearEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlagEnvironmentMutation) ResetEdge(name string) error {
+	switch name {
+	case flagenvironment.EdgeFlag:
+		m.ResetFlag()
+		return nil
+	case flagenvironment.EdgeEnvironment:
+		m.ResetEnvironment()
+		return nil
+	case flagenvironment.EdgeStrategies:
+		m.ResetStrategies()
+		return nil
+	case flagenvironment.EdgePrerequisites:
+		m.ResetPrerequisites()
+		return nil
+	case flagenvironment.EdgeVersions:
+		m.ResetVersions()
+		return nil
+	case flagenvironment.EdgeRolloutPlans:
+		m.ResetRolloutPlans()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironment edge %s", name)
+}
+
+// FlagEnvironmentVersionMutation represents an operation that mutates the FlagEnvironmentVersion nodes in the graph.
+type FlagEnvironmentVersionMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	version                 *int
+	addversion              *int
+	snapshot                *json.RawMessage
+	appendsnapshot          json.RawMessage
+	actor                   *string
+	created_at              *time.Time
+	reason                  *string
+	clearedFields           map[string]struct{}
+	flag_environment        *int
+	clearedflag_environment bool
+	done                    bool
+	oldValue                func(context.Context) (*FlagEnvironmentVersion, error)
+	predicates              []predicate.FlagEnvironmentVersion
+}
+
+var _ ent.Mutation = (*FlagEnvironmentVersionMutation)(nil)
+
+// flagenvironmentversionOption allows management of the mutation configuration using functional options.
+type flagenvironmentversionOption func(*FlagEnvironmentVersionMutation)
+
+// newFlagEnvironmentVersionMutation creates new mutation for the FlagEnvironmentVersion entity.
+func newFlagEnvironmentVersionMutation(c config, op Op, opts ...flagenvironmentversionOption) *FlagEnvironmentVersionMutation {
+	m := &FlagEnvironmentVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeFlagEnvironmentVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withFlagEnvironmentVersionID sets the ID field of the mutation.
+func withFlagEnvironmentVersionID(id int) flagenvironmentversionOption {
+	return func(m *FlagEnvironmentVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *FlagEnvironmentVersion
+		)
+		m.oldValue = func(ctx context.Context) (*FlagEnvironmentVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().FlagEnvironmentVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withFlagEnvironmentVersion sets the old FlagEnvironmentVersion of the mutation.
+func withFlagEnvironmentVersion(node *FlagEnvironmentVersion) flagenvironmentversionOption {
+	return func(m *FlagEnvironmentVersionMutation) {
+		m.oldValue = func(context.Context) (*FlagEnvironmentVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m FlagEnvironmentVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m FlagEnvironmentVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *FlagEnvironmentVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *FlagEnvironmentVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().FlagEnvironmentVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (m *FlagEnvironmentVersionMutation) SetFlagEnvironmentID(i int) {
+	m.flag_environment = &i
+}
+
+// FlagEnvironmentID returns the value of the "flag_environment_id" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) FlagEnvironmentID() (r int, exists bool) {
+	v := m.flag_environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlagEnvironmentID returns the old "flag_environment_id" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldFlagEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlagEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlagEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlagEnvironmentID: %w", err)
+	}
+	return oldValue.FlagEnvironmentID, nil
+}
+
+// ResetFlagEnvironmentID resets all changes to the "flag_environment_id" field.
+func (m *FlagEnvironmentVersionMutation) ResetFlagEnvironmentID() {
+	m.flag_environment = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *FlagEnvironmentVersionMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *FlagEnvironmentVersionMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *FlagEnvironmentVersionMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *FlagEnvironmentVersionMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetSnapshot sets the "snapshot" field.
+func (m *FlagEnvironmentVersionMutation) SetSnapshot(jm json.RawMessage) {
+	m.snapshot = &jm
+	m.appendsnapshot = nil
+}
+
+// Snapshot returns the value of the "snapshot" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) Snapshot() (r json.RawMessage, exists bool) {
+	v := m.snapshot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSnapshot returns the old "snapshot" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldSnapshot(ctx context.Context) (v json.RawMessage, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSnapshot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSnapshot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSnapshot: %w", err)
+	}
+	return oldValue.Snapshot, nil
+}
+
+// AppendSnapshot adds jm to the "snapshot" field.
+func (m *FlagEnvironmentVersionMutation) AppendSnapshot(jm json.RawMessage) {
+	m.appendsnapshot = append(m.appendsnapshot, jm...)
+}
+
+// AppendedSnapshot returns the list of values that were appended to the "snapshot" field in this mutation.
+func (m *FlagEnvironmentVersionMutation) AppendedSnapshot() (json.RawMessage, bool) {
+	if len(m.appendsnapshot) == 0 {
+		return nil, false
+	}
+	return m.appendsnapshot, true
+}
+
+// ResetSnapshot resets all changes to the "snapshot" field.
+func (m *FlagEnvironmentVersionMutation) ResetSnapshot() {
+	m.snapshot = nil
+	m.appendsnapshot = nil
+}
+
+// SetActor sets the "actor" field.
+func (m *FlagEnvironmentVersionMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *FlagEnvironmentVersionMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[flagenvironmentversion.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *FlagEnvironmentVersionMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[flagenvironmentversion.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *FlagEnvironmentVersionMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, flagenvironmentversion.FieldActor)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *FlagEnvironmentVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *FlagEnvironmentVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *FlagEnvironmentVersionMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *FlagEnvironmentVersionMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the FlagEnvironmentVersion entity.
+// If the FlagEnvironmentVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *FlagEnvironmentVersionMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *FlagEnvironmentVersionMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[flagenvironmentversion.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *FlagEnvironmentVersionMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[flagenvironmentversion.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *FlagEnvironmentVersionMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, flagenvironmentversion.FieldReason)
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (m *FlagEnvironmentVersionMutation) ClearFlagEnvironment() {
+	m.clearedflag_environment = true
+	m.clearedFields[flagenvironmentversion.FieldFlagEnvironmentID] = struct{}{}
+}
+
+// FlagEnvironmentCleared reports if the "flag_environment" edge to the FlagEnvironment entity was cleared.
+func (m *FlagEnvironmentVersionMutation) FlagEnvironmentCleared() bool {
+	return m.clearedflag_environment
+}
+
+// FlagEnvironmentIDs returns the "flag_environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlagEnvironmentID instead. It exists only for internal usage by the builders.
+func (m *FlagEnvironmentVersionMutation) FlagEnvironmentIDs() (ids []int) {
+	if id := m.flag_environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlagEnvironment resets all changes to the "flag_environment" edge.
+func (m *FlagEnvironmentVersionMutation) ResetFlagEnvironment() {
+	m.flag_environment = nil
+	m.clearedflag_environment = false
+}
+
+// Where appends a list predicates to the FlagEnvironmentVersionMutation builder.
+func (m *FlagEnvironmentVersionMutation) Where(ps ...predicate.FlagEnvironmentVersion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the FlagEnvironmentVersionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *FlagEnvironmentVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FlagEnvironmentVersion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *FlagEnvironmentVersionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *FlagEnvironmentVersionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (FlagEnvironmentVersion).
+func (m *FlagEnvironmentVersionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *FlagEnvironmentVersionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.flag_environment != nil {
+		fields = append(fields, flagenvironmentversion.FieldFlagEnvironmentID)
+	}
+	if m.version != nil {
+		fields = append(fields, flagenvironmentversion.FieldVersion)
+	}
+	if m.snapshot != nil {
+		fields = append(fields, flagenvironmentversion.FieldSnapshot)
+	}
+	if m.actor != nil {
+		fields = append(fields, flagenvironmentversion.FieldActor)
+	}
+	if m.created_at != nil {
+		fields = append(fields, flagenvironmentversion.FieldCreatedAt)
+	}
+	if m.reason != nil {
+		fields = append(fields, flagenvironmentversion.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *FlagEnvironmentVersionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case flagenvironmentversion.FieldFlagEnvironmentID:
+		return m.FlagEnvironmentID()
+	case flagenvironmentversion.FieldVersion:
+		return m.Version()
+	case flagenvironmentversion.FieldSnapshot:
+		return m.Snapshot()
+	case flagenvironmentversion.FieldActor:
+		return m.Actor()
+	case flagenvironmentversion.FieldCreatedAt:
+		return m.CreatedAt()
+	case flagenvironmentversion.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *FlagEnvironmentVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case flagenvironmentversion.FieldFlagEnvironmentID:
+		return m.OldFlagEnvironmentID(ctx)
+	case flagenvironmentversion.FieldVersion:
+		return m.OldVersion(ctx)
+	case flagenvironmentversion.FieldSnapshot:
+		return m.OldSnapshot(ctx)
+	case flagenvironmentversion.FieldActor:
+		return m.OldActor(ctx)
+	case flagenvironmentversion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case flagenvironmentversion.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown FlagEnvironmentVersion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagEnvironmentVersionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case flagenvironmentversion.FieldFlagEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlagEnvironmentID(v)
+		return nil
+	case flagenvironmentversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersion(v)
+		return nil
+	case flagenvironmentversion.FieldSnapshot:
+		v, ok := value.(json.RawMessage)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSnapshot(v)
+		return nil
+	case flagenvironmentversion.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case flagenvironmentversion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case flagenvironmentversion.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *FlagEnvironmentVersionMutation) AddedFields() []string {
+	var fields []string
+	if m.addversion != nil {
+		fields = append(fields, flagenvironmentversion.FieldVersion)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *FlagEnvironmentVersionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case flagenvironmentversion.FieldVersion:
+		return m.AddedVersion()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *FlagEnvironmentVersionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case flagenvironmentversion.FieldVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVersion(v)
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *FlagEnvironmentVersionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(flagenvironmentversion.FieldActor) {
+		fields = append(fields, flagenvironmentversion.FieldActor)
+	}
+	if m.FieldCleared(flagenvironmentversion.FieldReason) {
+		fields = append(fields, flagenvironmentversion.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *FlagEnvironmentVersionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *FlagEnvironmentVersionMutation) ClearField(name string) error {
+	switch name {
+	case flagenvironmentversion.FieldActor:
+		m.ClearActor()
+		return nil
+	case flagenvironmentversion.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *FlagEnvironmentVersionMutation) ResetField(name string) error {
+	switch name {
+	case flagenvironmentversion.FieldFlagEnvironmentID:
+		m.ResetFlagEnvironmentID()
+		return nil
+	case flagenvironmentversion.FieldVersion:
+		m.ResetVersion()
+		return nil
+	case flagenvironmentversion.FieldSnapshot:
+		m.ResetSnapshot()
+		return nil
+	case flagenvironmentversion.FieldActor:
+		m.ResetActor()
+		return nil
+	case flagenvironmentversion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case flagenvironmentversion.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *FlagEnvironmentVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flag_environment != nil {
+		edges = append(edges, flagenvironmentversion.EdgeFlagEnvironment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *FlagEnvironmentVersionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case flagenvironmentversion.EdgeFlagEnvironment:
+		if id := m.flag_environment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *FlagEnvironmentVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *FlagEnvironmentVersionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *FlagEnvironmentVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflag_environment {
+		edges = append(edges, flagenvironmentversion.EdgeFlagEnvironment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *FlagEnvironmentVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case flagenvironmentversion.EdgeFlagEnvironment:
+		return m.clearedflag_environment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *FlagEnvironmentVersionMutation) ClearEdge(name string) error {
+	switch name {
+	case flagenvironmentversion.EdgeFlagEnvironment:
+		m.ClearFlagEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *FlagEnvironmentVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case flagenvironmentversion.EdgeFlagEnvironment:
+		m.ResetFlagEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown FlagEnvironmentVersion edge %s", name)
+}
+
+// PrerequisiteMutation represents an operation that mutates the Prerequisite nodes in the graph.
+type PrerequisiteMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	enabled                 *bool
+	variant                 *string
+	created_at              *time.Time
+	clearedFields           map[string]struct{}
+	flag_environment        *int
+	clearedflag_environment bool
+	parent_flag             *int
+	clearedparent_flag      bool
+	done                    bool
+	oldValue                func(context.Context) (*Prerequisite, error)
+	predicates              []predicate.Prerequisite
+}
+
+var _ ent.Mutation = (*PrerequisiteMutation)(nil)
+
+// prerequisiteOption allows management of the mutation configuration using functional options.
+type prerequisiteOption func(*PrerequisiteMutation)
+
+// newPrerequisiteMutation creates new mutation for the Prerequisite entity.
+func newPrerequisiteMutation(c config, op Op, opts ...prerequisiteOption) *PrerequisiteMutation {
+	m := &PrerequisiteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePrerequisite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPrerequisiteID sets the ID field of the mutation.
+func withPrerequisiteID(id int) prerequisiteOption {
+	return func(m *PrerequisiteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Prerequisite
+		)
+		m.oldValue = func(ctx context.Context) (*Prerequisite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Prerequisite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPrerequisite sets the old Prerequisite of the mutation.
+func withPrerequisite(node *Prerequisite) prerequisiteOption {
+	return func(m *PrerequisiteMutation) {
+		m.oldValue = func(context.Context) (*Prerequisite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PrerequisiteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PrerequisiteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PrerequisiteMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PrerequisiteMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Prerequisite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (m *PrerequisiteMutation) SetFlagEnvironmentID(i int) {
+	m.flag_environment = &i
+}
+
+// FlagEnvironmentID returns the value of the "flag_environment_id" field in the mutation.
+func (m *PrerequisiteMutation) FlagEnvironmentID() (r int, exists bool) {
+	v := m.flag_environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlagEnvironmentID returns the old "flag_environment_id" field's value of the Prerequisite entity.
+// If the Prerequisite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrerequisiteMutation) OldFlagEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlagEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlagEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlagEnvironmentID: %w", err)
+	}
+	return oldValue.FlagEnvironmentID, nil
+}
+
+// ResetFlagEnvironmentID resets all changes to the "flag_environment_id" field.
+func (m *PrerequisiteMutation) ResetFlagEnvironmentID() {
+	m.flag_environment = nil
+}
+
+// SetParentFlagID sets the "parent_flag_id" field.
+func (m *PrerequisiteMutation) SetParentFlagID(i int) {
+	m.parent_flag = &i
+}
+
+// ParentFlagID returns the value of the "parent_flag_id" field in the mutation.
+func (m *PrerequisiteMutation) ParentFlagID() (r int, exists bool) {
+	v := m.parent_flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentFlagID returns the old "parent_flag_id" field's value of the Prerequisite entity.
+// If the Prerequisite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrerequisiteMutation) OldParentFlagID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentFlagID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentFlagID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentFlagID: %w", err)
+	}
+	return oldValue.ParentFlagID, nil
+}
+
+// ResetParentFlagID resets all changes to the "parent_flag_id" field.
+func (m *PrerequisiteMutation) ResetParentFlagID() {
+	m.parent_flag = nil
+}
+
+// SetEnabled sets the "enabled" field.
+func (m *PrerequisiteMutation) SetEnabled(b bool) {
+	m.enabled = &b
+}
+
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *PrerequisiteMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEnabled returns the old "enabled" field's value of the Prerequisite entity.
+// If the Prerequisite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrerequisiteMutation) OldEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
+	}
+	return oldValue.Enabled, nil
+}
+
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *PrerequisiteMutation) ResetEnabled() {
+	m.enabled = nil
+}
+
+// SetVariant sets the "variant" field.
+func (m *PrerequisiteMutation) SetVariant(s string) {
+	m.variant = &s
+}
+
+// Variant returns the value of the "variant" field in the mutation.
+func (m *PrerequisiteMutation) Variant() (r string, exists bool) {
+	v := m.variant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariant returns the old "variant" field's value of the Prerequisite entity.
+// If the Prerequisite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrerequisiteMutation) OldVariant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariant: %w", err)
+	}
+	return oldValue.Variant, nil
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (m *PrerequisiteMutation) ClearVariant() {
+	m.variant = nil
+	m.clearedFields[prerequisite.FieldVariant] = struct{}{}
+}
+
+// VariantCleared returns if the "variant" field was cleared in this mutation.
+func (m *PrerequisiteMutation) VariantCleared() bool {
+	_, ok := m.clearedFields[prerequisite.FieldVariant]
+	return ok
+}
+
+// ResetVariant resets all changes to the "variant" field.
+func (m *PrerequisiteMutation) ResetVariant() {
+	m.variant = nil
+	delete(m.clearedFields, prerequisite.FieldVariant)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PrerequisiteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PrerequisiteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Prerequisite entity.
+// If the Prerequisite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PrerequisiteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PrerequisiteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (m *PrerequisiteMutation) ClearFlagEnvironment() {
+	m.clearedflag_environment = true
+	m.clearedFields[prerequisite.FieldFlagEnvironmentID] = struct{}{}
+}
+
+// FlagEnvironmentCleared reports if the "flag_environment" edge to the FlagEnvironment entity was cleared.
+func (m *PrerequisiteMutation) FlagEnvironmentCleared() bool {
+	return m.clearedflag_environment
+}
+
+// FlagEnvironmentIDs returns the "flag_environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlagEnvironmentID instead. It exists only for internal usage by the builders.
+func (m *PrerequisiteMutation) FlagEnvironmentIDs() (ids []int) {
+	if id := m.flag_environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlagEnvironment resets all changes to the "flag_environment" edge.
+func (m *PrerequisiteMutation) ResetFlagEnvironment() {
+	m.flag_environment = nil
+	m.clearedflag_environment = false
+}
+
+// ClearParentFlag clears the "parent_flag" edge to the Flag entity.
+func (m *PrerequisiteMutation) ClearParentFlag() {
+	m.clearedparent_flag = true
+	m.clearedFields[prerequisite.FieldParentFlagID] = struct{}{}
+}
+
+// ParentFlagCleared reports if the "parent_flag" edge to the Flag entity was cleared.
+func (m *PrerequisiteMutation) ParentFlagCleared() bool {
+	return m.clearedparent_flag
+}
+
+// ParentFlagIDs returns the "parent_flag" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentFlagID instead. It exists only for internal usage by the builders.
+func (m *PrerequisiteMutation) ParentFlagIDs() (ids []int) {
+	if id := m.parent_flag; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParentFlag resets all changes to the "parent_flag" edge.
+func (m *PrerequisiteMutation) ResetParentFlag() {
+	m.parent_flag = nil
+	m.clearedparent_flag = false
+}
+
+// Where appends a list predicates to the PrerequisiteMutation builder.
+func (m *PrerequisiteMutation) Where(ps ...predicate.Prerequisite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PrerequisiteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PrerequisiteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Prerequisite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PrerequisiteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PrerequisiteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Prerequisite).
+func (m *PrerequisiteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PrerequisiteMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.flag_environment != nil {
+		fields = append(fields, prerequisite.FieldFlagEnvironmentID)
+	}
+	if m.parent_flag != nil {
+		fields = append(fields, prerequisite.FieldParentFlagID)
+	}
+	if m.enabled != nil {
+		fields = append(fields, prerequisite.FieldEnabled)
+	}
+	if m.variant != nil {
+		fields = append(fields, prerequisite.FieldVariant)
+	}
+	if m.created_at != nil {
+		fields = append(fields, prerequisite.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PrerequisiteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case prerequisite.FieldFlagEnvironmentID:
+		return m.FlagEnvironmentID()
+	case prerequisite.FieldParentFlagID:
+		return m.ParentFlagID()
+	case prerequisite.FieldEnabled:
+		return m.Enabled()
+	case prerequisite.FieldVariant:
+		return m.Variant()
+	case prerequisite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PrerequisiteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case prerequisite.FieldFlagEnvironmentID:
+		return m.OldFlagEnvironmentID(ctx)
+	case prerequisite.FieldParentFlagID:
+		return m.OldParentFlagID(ctx)
+	case prerequisite.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case prerequisite.FieldVariant:
+		return m.OldVariant(ctx)
+	case prerequisite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Prerequisite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrerequisiteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case prerequisite.FieldFlagEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlagEnvironmentID(v)
+		return nil
+	case prerequisite.FieldParentFlagID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentFlagID(v)
+		return nil
+	case prerequisite.FieldEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabled(v)
+		return nil
+	case prerequisite.FieldVariant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariant(v)
+		return nil
+	case prerequisite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Prerequisite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PrerequisiteMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PrerequisiteMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PrerequisiteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Prerequisite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PrerequisiteMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(prerequisite.FieldVariant) {
+		fields = append(fields, prerequisite.FieldVariant)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PrerequisiteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PrerequisiteMutation) ClearField(name string) error {
+	switch name {
+	case prerequisite.FieldVariant:
+		m.ClearVariant()
+		return nil
+	}
+	return fmt.Errorf("unknown Prerequisite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PrerequisiteMutation) ResetField(name string) error {
+	switch name {
+	case prerequisite.FieldFlagEnvironmentID:
+		m.ResetFlagEnvironmentID()
+		return nil
+	case prerequisite.FieldParentFlagID:
+		m.ResetParentFlagID()
+		return nil
+	case prerequisite.FieldEnabled:
+		m.ResetEnabled()
+		return nil
+	case prerequisite.FieldVariant:
+		m.ResetVariant()
+		return nil
+	case prerequisite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Prerequisite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PrerequisiteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.flag_environment != nil {
+		edges = append(edges, prerequisite.EdgeFlagEnvironment)
+	}
+	if m.parent_flag != nil {
+		edges = append(edges, prerequisite.EdgeParentFlag)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PrerequisiteMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case prerequisite.EdgeFlagEnvironment:
+		if id := m.flag_environment; id != nil {
+			return []ent.Value{*id}
+		}
+	case prerequisite.EdgeParentFlag:
+		if id := m.parent_flag; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PrerequisiteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PrerequisiteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PrerequisiteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedflag_environment {
+		edges = append(edges, prerequisite.EdgeFlagEnvironment)
+	}
+	if m.clearedparent_flag {
+		edges = append(edges, prerequisite.EdgeParentFlag)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PrerequisiteMutation) EdgeCleared(name string) bool {
+	switch name {
+	case prerequisite.EdgeFlagEnvironment:
+		return m.clearedflag_environment
+	case prerequisite.EdgeParentFlag:
+		return m.clearedparent_flag
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PrerequisiteMutation) ClearEdge(name string) error {
+	switch name {
+	case prerequisite.EdgeFlagEnvironment:
+		m.ClearFlagEnvironment()
+		return nil
+	case prerequisite.EdgeParentFlag:
+		m.ClearParentFlag()
+		return nil
+	}
+	return fmt.Errorf("unknown Prerequisite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PrerequisiteMutation) ResetEdge(name string) error {
+	switch name {
+	case prerequisite.EdgeFlagEnvironment:
+		m.ResetFlagEnvironment()
+		return nil
+	case prerequisite.EdgeParentFlag:
+		m.ResetParentFlag()
+		return nil
+	}
+	return fmt.Errorf("unknown Prerequisite edge %s", name)
+}
+
+// ProjectMutation represents an operation that mutates the Project nodes in the graph.
+type ProjectMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *int
+	name                        *string
+	description                 *string
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	deleted_at                  *time.Time
+	frozen_at                   *time.Time
+	frozen_by                   *string
+	freeze_reason               *string
+	clearedFields               map[string]struct{}
+	environments                map[int]struct{}
+	removedenvironments         map[int]struct{}
+	clearedenvironments         bool
+	flags                       map[int]struct{}
+	removedflags                map[int]struct{}
+	clearedflags                bool
+	api_tokens                  map[int]struct{}
+	removedapi_tokens           map[int]struct{}
+	clearedapi_tokens           bool
+	tags                        map[int]struct{}
+	removedtags                 map[int]struct{}
+	clearedtags                 bool
+	strategy_definitions        map[int]struct{}
+	removedstrategy_definitions map[int]struct{}
+	clearedstrategy_definitions bool
+	context_fields              map[int]struct{}
+	removedcontext_fields       map[int]struct{}
+	clearedcontext_fields       bool
+	done                        bool
+	oldValue                    func(context.Context) (*Project, error)
+	predicates                  []predicate.Project
+}
+
+var _ ent.Mutation = (*ProjectMutation)(nil)
+
+// projectOption allows management of the mutation configuration using functional options.
+type projectOption func(*ProjectMutation)
+
+// newProjectMutation creates new mutation for the Project entity.
+func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
+	m := &ProjectMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProject,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProjectID sets the ID field of the mutation.
+func withProjectID(id int) projectOption {
+	return func(m *ProjectMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Project
+		)
+		m.oldValue = func(ctx context.Context) (*Project, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Project.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProject sets the old Project of the mutation.
+func withProject(node *Project) projectOption {
+	return func(m *ProjectMutation) {
+		m.oldValue = func(context.Context) (*Project, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProjectMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProjectMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProjectMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *ProjectMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProjectMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProjectMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *ProjectMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *ProjectMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *ProjectMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[project.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *ProjectMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[project.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *ProjectMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, project.FieldDescription)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProjectMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProjectMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProjectMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *ProjectMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *ProjectMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *ProjectMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[project.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *ProjectMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *ProjectMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, project.FieldDeletedAt)
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (m *ProjectMutation) SetFrozenAt(t time.Time) {
+	m.frozen_at = &t
+}
+
+// FrozenAt returns the value of the "frozen_at" field in the mutation.
+func (m *ProjectMutation) FrozenAt() (r time.Time, exists bool) {
+	v := m.frozen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrozenAt returns the old "frozen_at" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldFrozenAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrozenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrozenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrozenAt: %w", err)
+	}
+	return oldValue.FrozenAt, nil
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (m *ProjectMutation) ClearFrozenAt() {
+	m.frozen_at = nil
+	m.clearedFields[project.FieldFrozenAt] = struct{}{}
+}
+
+// FrozenAtCleared returns if the "frozen_at" field was cleared in this mutation.
+func (m *ProjectMutation) FrozenAtCleared() bool {
+	_, ok := m.clearedFields[project.FieldFrozenAt]
+	return ok
+}
+
+// ResetFrozenAt resets all changes to the "frozen_at" field.
+func (m *ProjectMutation) ResetFrozenAt() {
+	m.frozen_at = nil
+	delete(m.clearedFields, project.FieldFrozenAt)
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (m *ProjectMutation) SetFrozenBy(s string) {
+	m.frozen_by = &s
+}
+
+// FrozenBy returns the value of the "frozen_by" field in the mutation.
+func (m *ProjectMutation) FrozenBy() (r string, exists bool) {
+	v := m.frozen_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFrozenBy returns the old "frozen_by" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldFrozenBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrozenBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrozenBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrozenBy: %w", err)
+	}
+	return oldValue.FrozenBy, nil
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (m *ProjectMutation) ClearFrozenBy() {
+	m.frozen_by = nil
+	m.clearedFields[project.FieldFrozenBy] = struct{}{}
+}
+
+// FrozenByCleared returns if the "frozen_by" field was cleared in this mutation.
+func (m *ProjectMutation) FrozenByCleared() bool {
+	_, ok := m.clearedFields[project.FieldFrozenBy]
+	return ok
+}
+
+// ResetFrozenBy resets all changes to the "frozen_by" field.
+func (m *ProjectMutation) ResetFrozenBy() {
+	m.frozen_by = nil
+	delete(m.clearedFields, project.FieldFrozenBy)
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (m *ProjectMutation) SetFreezeReason(s string) {
+	m.freeze_reason = &s
+}
+
+// FreezeReason returns the value of the "freeze_reason" field in the mutation.
+func (m *ProjectMutation) FreezeReason() (r string, exists bool) {
+	v := m.freeze_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFreezeReason returns the old "freeze_reason" field's value of the Project entity.
+// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProjectMutation) OldFreezeReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFreezeReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFreezeReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFreezeReason: %w", err)
+	}
+	return oldValue.FreezeReason, nil
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (m *ProjectMutation) ClearFreezeReason() {
+	m.freeze_reason = nil
+	m.clearedFields[project.FieldFreezeReason] = struct{}{}
+}
+
+// FreezeReasonCleared returns if the "freeze_reason" field was cleared in this mutation.
+func (m *ProjectMutation) FreezeReasonCleared() bool {
+	_, ok := m.clearedFields[project.FieldFreezeReason]
+	return ok
+}
+
+// ResetFreezeReason resets all changes to the "freeze_reason" field.
+func (m *ProjectMutation) ResetFreezeReason() {
+	m.freeze_reason = nil
+	delete(m.clearedFields, project.FieldFreezeReason)
+}
+
+// AddEnvironmentIDs adds the "environments" edge to the Environment entity by ids.
+func (m *ProjectMutation) AddEnvironmentIDs(ids ...int) {
+	if m.environments == nil {
+		m.environments = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.environments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEnvironments clears the "environments" edge to the Environment entity.
+func (m *ProjectMutation) ClearEnvironments() {
+	m.clearedenvironments = true
+}
+
+// EnvironmentsCleared reports if the "environments" edge to the Environment entity was cleared.
+func (m *ProjectMutation) EnvironmentsCleared() bool {
+	return m.clearedenvironments
+}
+
+// RemoveEnvironmentIDs removes the "environments" edge to the Environment entity by IDs.
+func (m *ProjectMutation) RemoveEnvironmentIDs(ids ...int) {
+	if m.removedenvironments == nil {
+		m.removedenvironments = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.environments, ids[i])
+		m.removedenvironments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEnvironments returns the removed IDs of the "environments" edge to the Environment entity.
+func (m *ProjectMutation) RemovedEnvironmentsIDs() (ids []int) {
+	for id := range m.removedenvironments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EnvironmentsIDs returns the "environments" edge IDs in the mutation.
+func (m *ProjectMutation) EnvironmentsIDs() (ids []int) {
+	for id := range m.environments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEnvironments resets all changes to the "environments" edge.
+func (m *ProjectMutation) ResetEnvironments() {
+	m.environments = nil
+	m.clearedenvironments = false
+	m.removedenvironments = nil
+}
+
+// AddFlagIDs adds the "flags" edge to the Flag entity by ids.
+func (m *ProjectMutation) AddFlagIDs(ids ...int) {
+	if m.flags == nil {
+		m.flags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.flags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFlags clears the "flags" edge to the Flag entity.
+func (m *ProjectMutation) ClearFlags() {
+	m.clearedflags = true
+}
+
+// FlagsCleared reports if the "flags" edge to the Flag entity was cleared.
+func (m *ProjectMutation) FlagsCleared() bool {
+	return m.clearedflags
+}
+
+// RemoveFlagIDs removes the "flags" edge to the Flag entity by IDs.
+func (m *ProjectMutation) RemoveFlagIDs(ids ...int) {
+	if m.removedflags == nil {
+		m.removedflags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.flags, ids[i])
+		m.removedflags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFlags returns the removed IDs of the "flags" edge to the Flag entity.
+func (m *ProjectMutation) RemovedFlagsIDs() (ids []int) {
+	for id := range m.removedflags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FlagsIDs returns the "flags" edge IDs in the mutation.
+func (m *ProjectMutation) FlagsIDs() (ids []int) {
+	for id := range m.flags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFlags resets all changes to the "flags" edge.
+func (m *ProjectMutation) ResetFlags() {
+	m.flags = nil
+	m.clearedflags = false
+	m.removedflags = nil
+}
+
+// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by ids.
+func (m *ProjectMutation) AddAPITokenIDs(ids ...int) {
+	if m.api_tokens == nil {
+		m.api_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.api_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
+func (m *ProjectMutation) ClearAPITokens() {
+	m.clearedapi_tokens = true
+}
+
+// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
+func (m *ProjectMutation) APITokensCleared() bool {
+	return m.clearedapi_tokens
+}
+
+// RemoveAPITokenIDs removes the "api_tokens" edge to the ApiToken entity by IDs.
+func (m *ProjectMutation) RemoveAPITokenIDs(ids ...int) {
+	if m.removedapi_tokens == nil {
+		m.removedapi_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.api_tokens, ids[i])
+		m.removedapi_tokens[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the ApiToken entity.
+func (m *ProjectMutation) RemovedAPITokensIDs() (ids []int) {
+	for id := range m.removedapi_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
+func (m *ProjectMutation) APITokensIDs() (ids []int) {
+	for id := range m.api_tokens {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPITokens resets all changes to the "api_tokens" edge.
+func (m *ProjectMutation) ResetAPITokens() {
+	m.api_tokens = nil
+	m.clearedapi_tokens = false
+	m.removedapi_tokens = nil
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *ProjectMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *ProjectMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *ProjectMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *ProjectMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *ProjectMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *ProjectMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *ProjectMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// AddStrategyDefinitionIDs adds the "strategy_definitions" edge to the StrategyDefinition entity by ids.
+func (m *ProjectMutation) AddStrategyDefinitionIDs(ids ...int) {
+	if m.strategy_definitions == nil {
+		m.strategy_definitions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.strategy_definitions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearStrategyDefinitions clears the "strategy_definitions" edge to the StrategyDefinition entity.
+func (m *ProjectMutation) ClearStrategyDefinitions() {
+	m.clearedstrategy_definitions = true
+}
+
+// StrategyDefinitionsCleared reports if the "strategy_definitions" edge to the StrategyDefinition entity was cleared.
+func (m *ProjectMutation) StrategyDefinitionsCleared() bool {
+	return m.clearedstrategy_definitions
+}
+
+// RemoveStrategyDefinitionIDs removes the "strategy_definitions" edge to the StrategyDefinition entity by IDs.
+func (m *ProjectMutation) RemoveStrategyDefinitionIDs(ids ...int) {
+	if m.removedstrategy_definitions == nil {
+		m.removedstrategy_definitions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.strategy_definitions, ids[i])
+		m.removedstrategy_definitions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStrategyDefinitions returns the removed IDs of the "strategy_definitions" edge to the StrategyDefinition entity.
+func (m *ProjectMutation) RemovedStrategyDefinitionsIDs() (ids []int) {
+	for id := range m.removedstrategy_definitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// StrategyDefinitionsIDs returns the "strategy_definitions" edge IDs in the mutation.
+func (m *ProjectMutation) StrategyDefinitionsIDs() (ids []int) {
+	for id := range m.strategy_definitions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetStrategyDefinitions resets all changes to the "strategy_definitions" edge.
+func (m *ProjectMutation) ResetStrategyDefinitions() {
+	m.strategy_definitions = nil
+	m.clearedstrategy_definitions = false
+	m.removedstrategy_definitions = nil
+}
+
+// AddContextFieldIDs adds the "context_fields" edge to the ContextField entity by ids.
+func (m *ProjectMutation) AddContextFieldIDs(ids ...int) {
+	if m.context_fields == nil {
+		m.context_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.context_fields[ids[i]] = struct{}{}
+	}
+}
+
+// ClearContextFields clears the "context_fields" edge to the ContextField entity.
+func (m *ProjectMutation) ClearContextFields() {
+	m.clearedcontext_fields = true
+}
+
+// ContextFieldsCleared reports if the "context_fields" edge to the ContextField entity was cleared.
+func (m *ProjectMutation) ContextFieldsCleared() bool {
+	return m.clearedcontext_fields
+}
+
+// RemoveContextFieldIDs removes the "context_fields" edge to the ContextField entity by IDs.
+func (m *ProjectMutation) RemoveContextFieldIDs(ids ...int) {
+	if m.removedcontext_fields == nil {
+		m.removedcontext_fields = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.context_fields, ids[i])
+		m.removedcontext_fields[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedContextFields returns the removed IDs of the "context_fields" edge to the ContextField entity.
+func (m *ProjectMutation) RemovedContextFieldsIDs() (ids []int) {
+	for id := range m.removedcontext_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ContextFieldsIDs returns the "context_fields" edge IDs in the mutation.
+func (m *ProjectMutation) ContextFieldsIDs() (ids []int) {
+	for id := range m.context_fields {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetContextFields resets all changes to the "context_fields" edge.
+func (m *ProjectMutation) ResetContextFields() {
+	m.context_fields = nil
+	m.clearedcontext_fields = false
+	m.removedcontext_fields = nil
+}
+
+// Where appends a list predicates to the ProjectMutation builder.
+func (m *ProjectMutation) Where(ps ...predicate.Project) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Project, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProjectMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProjectMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Project).
+func (m *ProjectMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProjectMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, project.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.created_at != nil {
+		fields = append(fields, project.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, project.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	if m.frozen_at != nil {
+		fields = append(fields, project.FieldFrozenAt)
+	}
+	if m.frozen_by != nil {
+		fields = append(fields, project.FieldFrozenBy)
+	}
+	if m.freeze_reason != nil {
+		fields = append(fields, project.FieldFreezeReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case project.FieldName:
+		return m.Name()
+	case project.FieldDescription:
+		return m.Description()
+	case project.FieldCreatedAt:
+		return m.CreatedAt()
+	case project.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case project.FieldDeletedAt:
+		return m.DeletedAt()
+	case project.FieldFrozenAt:
+		return m.FrozenAt()
+	case project.FieldFrozenBy:
+		return m.FrozenBy()
+	case project.FieldFreezeReason:
+		return m.FreezeReason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case project.FieldName:
+		return m.OldName(ctx)
+	case project.FieldDescription:
+		return m.OldDescription(ctx)
+	case project.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case project.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case project.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case project.FieldFrozenAt:
+		return m.OldFrozenAt(ctx)
+	case project.FieldFrozenBy:
+		return m.OldFrozenBy(ctx)
+	case project.FieldFreezeReason:
+		return m.OldFreezeReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown Project field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case project.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case project.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case project.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case project.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case project.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case project.FieldFrozenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrozenAt(v)
+		return nil
+	case project.FieldFrozenBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFrozenBy(v)
+		return nil
+	case project.FieldFreezeReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFreezeReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProjectMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProjectMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(project.FieldDescription) {
+		fields = append(fields, project.FieldDescription)
+	}
+	if m.FieldCleared(project.FieldDeletedAt) {
+		fields = append(fields, project.FieldDeletedAt)
+	}
+	if m.FieldCleared(project.FieldFrozenAt) {
+		fields = append(fields, project.FieldFrozenAt)
+	}
+	if m.FieldCleared(project.FieldFrozenBy) {
+		fields = append(fields, project.FieldFrozenBy)
+	}
+	if m.FieldCleared(project.FieldFreezeReason) {
+		fields = append(fields, project.FieldFreezeReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProjectMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProjectMutation) ClearField(name string) error {
+	switch name {
+	case project.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case project.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case project.FieldFrozenAt:
+		m.ClearFrozenAt()
+		return nil
+	case project.FieldFrozenBy:
+		m.ClearFrozenBy()
+		return nil
+	case project.FieldFreezeReason:
+		m.ClearFreezeReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Project nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProjectMutation) ResetField(name string) error {
+	switch name {
+	case project.FieldName:
+		m.ResetName()
+		return nil
+	case project.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case project.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case project.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case project.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case project.FieldFrozenAt:
+		m.ResetFrozenAt()
+		return nil
+	case project.FieldFrozenBy:
+		m.ResetFrozenBy()
+		return nil
+	case project.FieldFreezeReason:
+		m.ResetFreezeReason()
+		return nil
+	}
+	return fmt.Errorf("unknown Project field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProjectMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.environments != nil {
+		edges = append(edges, project.EdgeEnvironments)
+	}
+	if m.flags != nil {
+		edges = append(edges, project.EdgeFlags)
+	}
+	if m.api_tokens != nil {
+		edges = append(edges, project.EdgeAPITokens)
+	}
+	if m.tags != nil {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.strategy_definitions != nil {
+		edges = append(edges, project.EdgeStrategyDefinitions)
+	}
+	if m.context_fields != nil {
+		edges = append(edges, project.EdgeContextFields)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.environments))
+		for id := range m.environments {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeFlags:
+		ids := make([]ent.Value, 0, len(m.flags))
+		for id := range m.flags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.api_tokens))
+		for id := range m.api_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeStrategyDefinitions:
+		ids := make([]ent.Value, 0, len(m.strategy_definitions))
+		for id := range m.strategy_definitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeContextFields:
+		ids := make([]ent.Value, 0, len(m.context_fields))
+		for id := range m.context_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProjectMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedenvironments != nil {
+		edges = append(edges, project.EdgeEnvironments)
+	}
+	if m.removedflags != nil {
+		edges = append(edges, project.EdgeFlags)
+	}
+	if m.removedapi_tokens != nil {
+		edges = append(edges, project.EdgeAPITokens)
+	}
+	if m.removedtags != nil {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.removedstrategy_definitions != nil {
+		edges = append(edges, project.EdgeStrategyDefinitions)
+	}
+	if m.removedcontext_fields != nil {
+		edges = append(edges, project.EdgeContextFields)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case project.EdgeEnvironments:
+		ids := make([]ent.Value, 0, len(m.removedenvironments))
+		for id := range m.removedenvironments {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeFlags:
+		ids := make([]ent.Value, 0, len(m.removedflags))
+		for id := range m.removedflags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeAPITokens:
+		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
+		for id := range m.removedapi_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeStrategyDefinitions:
+		ids := make([]ent.Value, 0, len(m.removedstrategy_definitions))
+		for id := range m.removedstrategy_definitions {
+			ids = append(ids, id)
+		}
+		return ids
+	case project.EdgeContextFields:
+		ids := make([]ent.Value, 0, len(m.removedcontext_fields))
+		for id := range m.removedcontext_fields {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProjectMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedenvironments {
+		edges = append(edges, project.EdgeEnvironments)
+	}
+	if m.clearedflags {
+		edges = append(edges, project.EdgeFlags)
+	}
+	if m.clearedapi_tokens {
+		edges = append(edges, project.EdgeAPITokens)
+	}
+	if m.clearedtags {
+		edges = append(edges, project.EdgeTags)
+	}
+	if m.clearedstrategy_definitions {
+		edges = append(edges, project.EdgeStrategyDefinitions)
+	}
+	if m.clearedcontext_fields {
+		edges = append(edges, project.EdgeContextFields)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProjectMutation) EdgeCleared(name string) bool {
+	switch name {
+	case project.EdgeEnvironments:
+		return m.clearedenvironments
+	case project.EdgeFlags:
+		return m.clearedflags
+	case project.EdgeAPITokens:
+		return m.clearedapi_tokens
+	case project.EdgeTags:
+		return m.clearedtags
+	case project.EdgeStrategyDefinitions:
+		return m.clearedstrategy_definitions
+	case project.EdgeContextFields:
+		return m.clearedcontext_fields
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProjectMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Project unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProjectMutation) ResetEdge(name string) error {
+	switch name {
+	case project.EdgeEnvironments:
+		m.ResetEnvironments()
+		return nil
+	case project.EdgeFlags:
+		m.ResetFlags()
+		return nil
+	case project.EdgeAPITokens:
+		m.ResetAPITokens()
+		return nil
+	case project.EdgeTags:
+		m.ResetTags()
+		return nil
+	case project.EdgeStrategyDefinitions:
+		m.ResetStrategyDefinitions()
+		return nil
+	case project.EdgeContextFields:
+		m.ResetContextFields()
+		return nil
+	}
+	return fmt.Errorf("unknown Project edge %s", name)
+}
+
+// RolloutPlanMutation represents an operation that mutates the RolloutPlan nodes in the graph.
+type RolloutPlanMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	strategy_id             *int
+	addstrategy_id          *int
+	steps                   *[]schema.RolloutStep
+	appendsteps             []schema.RolloutStep
+	current_step            *int
+	addcurrent_step         *int
+	status                  *rolloutplan.Status
+	step_started_at         *time.Time
+	paused_at               *time.Time
+	status_reason           *string
+	created_by              *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	flag_environment        *int
+	clearedflag_environment bool
+	done                    bool
+	oldValue                func(context.Context) (*RolloutPlan, error)
+	predicates              []predicate.RolloutPlan
+}
+
+var _ ent.Mutation = (*RolloutPlanMutation)(nil)
+
+// rolloutplanOption allows management of the mutation configuration using functional options.
+type rolloutplanOption func(*RolloutPlanMutation)
+
+// newRolloutPlanMutation creates new mutation for the RolloutPlan entity.
+func newRolloutPlanMutation(c config, op Op, opts ...rolloutplanOption) *RolloutPlanMutation {
+	m := &RolloutPlanMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRolloutPlan,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRolloutPlanID sets the ID field of the mutation.
+func withRolloutPlanID(id int) rolloutplanOption {
+	return func(m *RolloutPlanMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RolloutPlan
+		)
+		m.oldValue = func(ctx context.Context) (*RolloutPlan, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RolloutPlan.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRolloutPlan sets the old RolloutPlan of the mutation.
+func withRolloutPlan(node *RolloutPlan) rolloutplanOption {
+	return func(m *RolloutPlanMutation) {
+		m.oldValue = func(context.Context) (*RolloutPlan, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RolloutPlanMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RolloutPlanMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RolloutPlanMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RolloutPlanMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RolloutPlan.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (m *RolloutPlanMutation) SetFlagEnvironmentID(i int) {
+	m.flag_environment = &i
+}
+
+// FlagEnvironmentID returns the value of the "flag_environment_id" field in the mutation.
+func (m *RolloutPlanMutation) FlagEnvironmentID() (r int, exists bool) {
+	v := m.flag_environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlagEnvironmentID returns the old "flag_environment_id" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldFlagEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlagEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlagEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlagEnvironmentID: %w", err)
+	}
+	return oldValue.FlagEnvironmentID, nil
+}
+
+// ResetFlagEnvironmentID resets all changes to the "flag_environment_id" field.
+func (m *RolloutPlanMutation) ResetFlagEnvironmentID() {
+	m.flag_environment = nil
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *RolloutPlanMutation) SetStrategyID(i int) {
+	m.strategy_id = &i
+	m.addstrategy_id = nil
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *RolloutPlanMutation) StrategyID() (r int, exists bool) {
+	v := m.strategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldStrategyID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// AddStrategyID adds i to the "strategy_id" field.
+func (m *RolloutPlanMutation) AddStrategyID(i int) {
+	if m.addstrategy_id != nil {
+		*m.addstrategy_id += i
+	} else {
+		m.addstrategy_id = &i
+	}
+}
+
+// AddedStrategyID returns the value that was added to the "strategy_id" field in this mutation.
+func (m *RolloutPlanMutation) AddedStrategyID() (r int, exists bool) {
+	v := m.addstrategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *RolloutPlanMutation) ResetStrategyID() {
+	m.strategy_id = nil
+	m.addstrategy_id = nil
+}
+
+// SetSteps sets the "steps" field.
+func (m *RolloutPlanMutation) SetSteps(ss []schema.RolloutStep) {
+	m.steps = &ss
+	m.appendsteps = nil
+}
+
+// Steps returns the value of the "steps" field in the mutation.
+func (m *RolloutPlanMutation) Steps() (r []schema.RolloutStep, exists bool) {
+	v := m.steps
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSteps returns the old "steps" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldSteps(ctx context.Context) (v []schema.RolloutStep, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSteps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSteps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSteps: %w", err)
+	}
+	return oldValue.Steps, nil
+}
+
+// AppendSteps adds ss to the "steps" field.
+func (m *RolloutPlanMutation) AppendSteps(ss []schema.RolloutStep) {
+	m.appendsteps = append(m.appendsteps, ss...)
+}
+
+// AppendedSteps returns the list of values that were appended to the "steps" field in this mutation.
+func (m *RolloutPlanMutation) AppendedSteps() ([]schema.RolloutStep, bool) {
+	if len(m.appendsteps) == 0 {
+		return nil, false
+	}
+	return m.appendsteps, true
+}
+
+// ResetSteps resets all changes to the "steps" field.
+func (m *RolloutPlanMutation) ResetSteps() {
+	m.steps = nil
+	m.appendsteps = nil
+}
+
+// SetCurrentStep sets the "current_step" field.
+func (m *RolloutPlanMutation) SetCurrentStep(i int) {
+	m.current_step = &i
+	m.addcurrent_step = nil
+}
+
+// CurrentStep returns the value of the "current_step" field in the mutation.
+func (m *RolloutPlanMutation) CurrentStep() (r int, exists bool) {
+	v := m.current_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentStep returns the old "current_step" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldCurrentStep(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentStep is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentStep requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentStep: %w", err)
+	}
+	return oldValue.CurrentStep, nil
+}
+
+// AddCurrentStep adds i to the "current_step" field.
+func (m *RolloutPlanMutation) AddCurrentStep(i int) {
+	if m.addcurrent_step != nil {
+		*m.addcurrent_step += i
+	} else {
+		m.addcurrent_step = &i
+	}
+}
+
+// AddedCurrentStep returns the value that was added to the "current_step" field in this mutation.
+func (m *RolloutPlanMutation) AddedCurrentStep() (r int, exists bool) {
+	v := m.addcurrent_step
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCurrentStep resets all changes to the "current_step" field.
+func (m *RolloutPlanMutation) ResetCurrentStep() {
+	m.current_step = nil
+	m.addcurrent_step = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RolloutPlanMutation) SetStatus(r rolloutplan.Status) {
+	m.status = &r
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RolloutPlanMutation) Status() (r rolloutplan.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldStatus(ctx context.Context) (v rolloutplan.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RolloutPlanMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetStepStartedAt sets the "step_started_at" field.
+func (m *RolloutPlanMutation) SetStepStartedAt(t time.Time) {
+	m.step_started_at = &t
+}
+
+// StepStartedAt returns the value of the "step_started_at" field in the mutation.
+func (m *RolloutPlanMutation) StepStartedAt() (r time.Time, exists bool) {
+	v := m.step_started_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStepStartedAt returns the old "step_started_at" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldStepStartedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStepStartedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStepStartedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStepStartedAt: %w", err)
+	}
+	return oldValue.StepStartedAt, nil
+}
+
+// ResetStepStartedAt resets all changes to the "step_started_at" field.
+func (m *RolloutPlanMutation) ResetStepStartedAt() {
+	m.step_started_at = nil
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (m *RolloutPlanMutation) SetPausedAt(t time.Time) {
+	m.paused_at = &t
+}
+
+// PausedAt returns the value of the "paused_at" field in the mutation.
+func (m *RolloutPlanMutation) PausedAt() (r time.Time, exists bool) {
+	v := m.paused_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPausedAt returns the old "paused_at" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldPausedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPausedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPausedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPausedAt: %w", err)
+	}
+	return oldValue.PausedAt, nil
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (m *RolloutPlanMutation) ClearPausedAt() {
+	m.paused_at = nil
+	m.clearedFields[rolloutplan.FieldPausedAt] = struct{}{}
+}
+
+// PausedAtCleared returns if the "paused_at" field was cleared in this mutation.
+func (m *RolloutPlanMutation) PausedAtCleared() bool {
+	_, ok := m.clearedFields[rolloutplan.FieldPausedAt]
+	return ok
+}
+
+// ResetPausedAt resets all changes to the "paused_at" field.
+func (m *RolloutPlanMutation) ResetPausedAt() {
+	m.paused_at = nil
+	delete(m.clearedFields, rolloutplan.FieldPausedAt)
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (m *RolloutPlanMutation) SetStatusReason(s string) {
+	m.status_reason = &s
+}
+
+// StatusReason returns the value of the "status_reason" field in the mutation.
+func (m *RolloutPlanMutation) StatusReason() (r string, exists bool) {
+	v := m.status_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatusReason returns the old "status_reason" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldStatusReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatusReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatusReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatusReason: %w", err)
+	}
+	return oldValue.StatusReason, nil
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (m *RolloutPlanMutation) ClearStatusReason() {
+	m.status_reason = nil
+	m.clearedFields[rolloutplan.FieldStatusReason] = struct{}{}
+}
+
+// StatusReasonCleared returns if the "status_reason" field was cleared in this mutation.
+func (m *RolloutPlanMutation) StatusReasonCleared() bool {
+	_, ok := m.clearedFields[rolloutplan.FieldStatusReason]
+	return ok
+}
+
+// ResetStatusReason resets all changes to the "status_reason" field.
+func (m *RolloutPlanMutation) ResetStatusReason() {
+	m.status_reason = nil
+	delete(m.clearedFields, rolloutplan.FieldStatusReason)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *RolloutPlanMutation) SetCreatedBy(s string) {
+	m.created_by = &s
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *RolloutPlanMutation) CreatedBy() (r string, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldCreatedBy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *RolloutPlanMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.clearedFields[rolloutplan.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *RolloutPlanMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[rolloutplan.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *RolloutPlanMutation) ResetCreatedBy() {
+	m.created_by = nil
+	delete(m.clearedFields, rolloutplan.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RolloutPlanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RolloutPlanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RolloutPlanMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RolloutPlanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RolloutPlanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RolloutPlan entity.
+// If the RolloutPlan object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RolloutPlanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RolloutPlanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (m *RolloutPlanMutation) ClearFlagEnvironment() {
+	m.clearedflag_environment = true
+	m.clearedFields[rolloutplan.FieldFlagEnvironmentID] = struct{}{}
+}
+
+// FlagEnvironmentCleared reports if the "flag_environment" edge to the FlagEnvironment entity was cleared.
+func (m *RolloutPlanMutation) FlagEnvironmentCleared() bool {
+	return m.clearedflag_environment
+}
+
+// FlagEnvironmentIDs returns the "flag_environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlagEnvironmentID instead. It exists only for internal usage by the builders.
+func (m *RolloutPlanMutation) FlagEnvironmentIDs() (ids []int) {
+	if id := m.flag_environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlagEnvironment resets all changes to the "flag_environment" edge.
+func (m *RolloutPlanMutation) ResetFlagEnvironment() {
+	m.flag_environment = nil
+	m.clearedflag_environment = false
+}
+
+// Where appends a list predicates to the RolloutPlanMutation builder.
+func (m *RolloutPlanMutation) Where(ps ...predicate.RolloutPlan) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RolloutPlanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RolloutPlanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RolloutPlan, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RolloutPlanMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RolloutPlanMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RolloutPlan).
+func (m *RolloutPlanMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RolloutPlanMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.flag_environment != nil {
+		fields = append(fields, rolloutplan.FieldFlagEnvironmentID)
+	}
+	if m.strategy_id != nil {
+		fields = append(fields, rolloutplan.FieldStrategyID)
+	}
+	if m.steps != nil {
+		fields = append(fields, rolloutplan.FieldSteps)
+	}
+	if m.current_step != nil {
+		fields = append(fields, rolloutplan.FieldCurrentStep)
+	}
+	if m.status != nil {
+		fields = append(fields, rolloutplan.FieldStatus)
+	}
+	if m.step_started_at != nil {
+		fields = append(fields, rolloutplan.FieldStepStartedAt)
+	}
+	if m.paused_at != nil {
+		fields = append(fields, rolloutplan.FieldPausedAt)
+	}
+	if m.status_reason != nil {
+		fields = append(fields, rolloutplan.FieldStatusReason)
+	}
+	if m.created_by != nil {
+		fields = append(fields, rolloutplan.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, rolloutplan.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rolloutplan.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RolloutPlanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rolloutplan.FieldFlagEnvironmentID:
+		return m.FlagEnvironmentID()
+	case rolloutplan.FieldStrategyID:
+		return m.StrategyID()
+	case rolloutplan.FieldSteps:
+		return m.Steps()
+	case rolloutplan.FieldCurrentStep:
+		return m.CurrentStep()
+	case rolloutplan.FieldStatus:
+		return m.Status()
+	case rolloutplan.FieldStepStartedAt:
+		return m.StepStartedAt()
+	case rolloutplan.FieldPausedAt:
+		return m.PausedAt()
+	case rolloutplan.FieldStatusReason:
+		return m.StatusReason()
+	case rolloutplan.FieldCreatedBy:
+		return m.CreatedBy()
+	case rolloutplan.FieldCreatedAt:
+		return m.CreatedAt()
+	case rolloutplan.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RolloutPlanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rolloutplan.FieldFlagEnvironmentID:
+		return m.OldFlagEnvironmentID(ctx)
+	case rolloutplan.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case rolloutplan.FieldSteps:
+		return m.OldSteps(ctx)
+	case rolloutplan.FieldCurrentStep:
+		return m.OldCurrentStep(ctx)
+	case rolloutplan.FieldStatus:
+		return m.OldStatus(ctx)
+	case rolloutplan.FieldStepStartedAt:
+		return m.OldStepStartedAt(ctx)
+	case rolloutplan.FieldPausedAt:
+		return m.OldPausedAt(ctx)
+	case rolloutplan.FieldStatusReason:
+		return m.OldStatusReason(ctx)
+	case rolloutplan.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case rolloutplan.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case rolloutplan.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RolloutPlan field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolloutPlanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rolloutplan.FieldFlagEnvironmentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlagEnvironmentID(v)
+		return nil
+	case rolloutplan.FieldStrategyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case rolloutplan.FieldSteps:
+		v, ok := value.([]schema.RolloutStep)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSteps(v)
+		return nil
+	case rolloutplan.FieldCurrentStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentStep(v)
+		return nil
+	case rolloutplan.FieldStatus:
+		v, ok := value.(rolloutplan.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case rolloutplan.FieldStepStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStepStartedAt(v)
+		return nil
+	case rolloutplan.FieldPausedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPausedAt(v)
+		return nil
+	case rolloutplan.FieldStatusReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatusReason(v)
+		return nil
+	case rolloutplan.FieldCreatedBy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case rolloutplan.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case rolloutplan.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RolloutPlanMutation) AddedFields() []string {
+	var fields []string
+	if m.addstrategy_id != nil {
+		fields = append(fields, rolloutplan.FieldStrategyID)
+	}
+	if m.addcurrent_step != nil {
+		fields = append(fields, rolloutplan.FieldCurrentStep)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RolloutPlanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case rolloutplan.FieldStrategyID:
+		return m.AddedStrategyID()
+	case rolloutplan.FieldCurrentStep:
+		return m.AddedCurrentStep()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RolloutPlanMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case rolloutplan.FieldStrategyID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStrategyID(v)
+		return nil
+	case rolloutplan.FieldCurrentStep:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCurrentStep(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RolloutPlanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(rolloutplan.FieldPausedAt) {
+		fields = append(fields, rolloutplan.FieldPausedAt)
+	}
+	if m.FieldCleared(rolloutplan.FieldStatusReason) {
+		fields = append(fields, rolloutplan.FieldStatusReason)
+	}
+	if m.FieldCleared(rolloutplan.FieldCreatedBy) {
+		fields = append(fields, rolloutplan.FieldCreatedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RolloutPlanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RolloutPlanMutation) ClearField(name string) error {
+	switch name {
+	case rolloutplan.FieldPausedAt:
+		m.ClearPausedAt()
+		return nil
+	case rolloutplan.FieldStatusReason:
+		m.ClearStatusReason()
+		return nil
+	case rolloutplan.FieldCreatedBy:
+		m.ClearCreatedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RolloutPlanMutation) ResetField(name string) error {
+	switch name {
+	case rolloutplan.FieldFlagEnvironmentID:
+		m.ResetFlagEnvironmentID()
+		return nil
+	case rolloutplan.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case rolloutplan.FieldSteps:
+		m.ResetSteps()
+		return nil
+	case rolloutplan.FieldCurrentStep:
+		m.ResetCurrentStep()
+		return nil
+	case rolloutplan.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case rolloutplan.FieldStepStartedAt:
+		m.ResetStepStartedAt()
+		return nil
+	case rolloutplan.FieldPausedAt:
+		m.ResetPausedAt()
+		return nil
+	case rolloutplan.FieldStatusReason:
+		m.ResetStatusReason()
+		return nil
+	case rolloutplan.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case rolloutplan.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case rolloutplan.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RolloutPlanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.flag_environment != nil {
+		edges = append(edges, rolloutplan.EdgeFlagEnvironment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RolloutPlanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case rolloutplan.EdgeFlagEnvironment:
+		if id := m.flag_environment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RolloutPlanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RolloutPlanMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RolloutPlanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedflag_environment {
+		edges = append(edges, rolloutplan.EdgeFlagEnvironment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RolloutPlanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case rolloutplan.EdgeFlagEnvironment:
+		return m.clearedflag_environment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RolloutPlanMutation) ClearEdge(name string) error {
+	switch name {
+	case rolloutplan.EdgeFlagEnvironment:
+		m.ClearFlagEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RolloutPlanMutation) ResetEdge(name string) error {
+	switch name {
+	case rolloutplan.EdgeFlagEnvironment:
+		m.ResetFlagEnvironment()
+		return nil
+	}
+	return fmt.Errorf("unknown RolloutPlan edge %s", name)
+}
+
+// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
+type StrategyMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	parameters              *map[string]interface{}
+	sort_order              *int
+	addsort_order           *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	flag_environment        *int
+	clearedflag_environment bool
+	constraints             map[int]struct{}
+	removedconstraints      map[int]struct{}
+	clearedconstraints      bool
+	done                    bool
+	oldValue                func(context.Context) (*Strategy, error)
+	predicates              []predicate.Strategy
+}
+
+var _ ent.Mutation = (*StrategyMutation)(nil)
+
+// strategyOption allows management of the mutation configuration using functional options.
+type strategyOption func(*StrategyMutation)
+
+// newStrategyMutation creates new mutation for the Strategy entity.
+func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
+	m := &StrategyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyID sets the ID field of the mutation.
+func withStrategyID(id int) strategyOption {
+	return func(m *StrategyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Strategy
+		)
+		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Strategy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategy sets the old Strategy of the mutation.
+func withStrategy(node *Strategy) strategyOption {
+	return func(m *StrategyMutation) {
+		m.oldValue = func(context.Context) (*Strategy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *StrategyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StrategyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StrategyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetParameters sets the "parameters" field.
+func (m *StrategyMutation) SetParameters(value map[string]interface{}) {
+	m.parameters = &value
+}
+
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *StrategyMutation) Parameters() (r map[string]interface{}, exists bool) {
+	v := m.parameters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParameters returns the old "parameters" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldParameters(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParameters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
+	}
+	return oldValue.Parameters, nil
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (m *StrategyMutation) ClearParameters() {
+	m.parameters = nil
+	m.clearedFields[strategy.FieldParameters] = struct{}{}
+}
+
+// ParametersCleared returns if the "parameters" field was cleared in this mutation.
+func (m *StrategyMutation) ParametersCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldParameters]
+	return ok
+}
+
+// ResetParameters resets all changes to the "parameters" field.
+func (m *StrategyMutation) ResetParameters() {
+	m.parameters = nil
+	delete(m.clearedFields, strategy.FieldParameters)
+}
+
+// SetSortOrder sets the "sort_order" field.
+func (m *StrategyMutation) SetSortOrder(i int) {
+	m.sort_order = &i
+	m.addsort_order = nil
+}
+
+// SortOrder returns the value of the "sort_order" field in the mutation.
+func (m *StrategyMutation) SortOrder() (r int, exists bool) {
+	v := m.sort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSortOrder returns the old "sort_order" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSortOrder(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSortOrder requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
+	}
+	return oldValue.SortOrder, nil
+}
+
+// AddSortOrder adds i to the "sort_order" field.
+func (m *StrategyMutation) AddSortOrder(i int) {
+	if m.addsort_order != nil {
+		*m.addsort_order += i
+	} else {
+		m.addsort_order = &i
+	}
+}
+
+// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
+func (m *StrategyMutation) AddedSortOrder() (r int, exists bool) {
+	v := m.addsort_order
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSortOrder resets all changes to the "sort_order" field.
+func (m *StrategyMutation) ResetSortOrder() {
+	m.sort_order = nil
+	m.addsort_order = nil
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (m *StrategyMutation) SetFlagEnvironmentID(i int) {
+	m.flag_environment = &i
+}
+
+// FlagEnvironmentID returns the value of the "flag_environment_id" field in the mutation.
+func (m *StrategyMutation) FlagEnvironmentID() (r int, exists bool) {
+	v := m.flag_environment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlagEnvironmentID returns the old "flag_environment_id" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldFlagEnvironmentID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlagEnvironmentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlagEnvironmentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlagEnvironmentID: %w", err)
+	}
+	return oldValue.FlagEnvironmentID, nil
+}
+
+// ResetFlagEnvironmentID resets all changes to the "flag_environment_id" field.
+func (m *StrategyMutation) ResetFlagEnvironmentID() {
+	m.flag_environment = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (m *StrategyMutation) ClearFlagEnvironment() {
+	m.clearedflag_environment = true
+	m.clearedFields[strategy.FieldFlagEnvironmentID] = struct{}{}
+}
+
+// FlagEnvironmentCleared reports if the "flag_environment" edge to the FlagEnvironment entity was cleared.
+func (m *StrategyMutation) FlagEnvironmentCleared() bool {
+	return m.clearedflag_environment
+}
+
+// FlagEnvironmentIDs returns the "flag_environment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FlagEnvironmentID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) FlagEnvironmentIDs() (ids []int) {
+	if id := m.flag_environment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFlagEnvironment resets all changes to the "flag_environment" edge.
+func (m *StrategyMutation) ResetFlagEnvironment() {
+	m.flag_environment = nil
+	m.clearedflag_environment = false
+}
+
+// AddConstraintIDs adds the "constraints" edge to the Constraint entity by ids.
+func (m *StrategyMutation) AddConstraintIDs(ids ...int) {
+	if m.constraints == nil {
+		m.constraints = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.constraints[ids[i]] = struct{}{}
+	}
 }
 
-// StrategiesCleared reports if the "strategies" edge to the Strategy entity was cleared.
-func (m *FlagEnvironmentMutation) StrategiesCleared() bool {
-	return m.clearedstrategies
+// ClearConstraints clears the "constraints" edge to the Constraint entity.
+func (m *StrategyMutation) ClearConstraints() {
+	m.clearedconstraints = true
 }
 
-// RemoveStrategyIDs removes the "strategies" edge to the Strategy entity by IDs.
-func (m *FlagEnvironmentMutation) RemoveStrategyIDs(ids ...int) {
-	if m.removedstrategies == nil {
-		m.removedstrategies = make(map[int]struct{})
+// ConstraintsCleared reports if the "constraints" edge to the Constraint entity was cleared.
+func (m *StrategyMutation) ConstraintsCleared() bool {
+	return m.clearedconstraints
+}
+
+// RemoveConstraintIDs removes the "constraints" edge to the Constraint entity by IDs.
+func (m *StrategyMutation) RemoveConstraintIDs(ids ...int) {
+	if m.removedconstraints == nil {
+		m.removedconstraints = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.strategies, ids[i])
-		m.removedstrategies[ids[i]] = struct{}{}
+		delete(m.constraints, ids[i])
+		m.removedconstraints[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedStrategies returns the removed IDs of the "strategies" edge to the Strategy entity.
-func (m *FlagEnvironmentMutation) RemovedStrategiesIDs() (ids []int) {
-	for id := range m.removedstrategies {
+// RemovedConstraints returns the removed IDs of the "constraints" edge to the Constraint entity.
+func (m *StrategyMutation) RemovedConstraintsIDs() (ids []int) {
+	for id := range m.removedconstraints {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// StrategiesIDs returns the "strategies" edge IDs in the mutation.
-func (m *FlagEnvironmentMutation) StrategiesIDs() (ids []int) {
-	for id := range m.strategies {
+// ConstraintsIDs returns the "constraints" edge IDs in the mutation.
+func (m *StrategyMutation) ConstraintsIDs() (ids []int) {
+	for id := range m.constraints {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetStrategies resets all changes to the "strategies" edge.
-func (m *FlagEnvironmentMutation) ResetStrategies() {
-	m.strategies = nil
-	m.clearedstrategies = false
-	m.removedstrategies = nil
+// ResetConstraints resets all changes to the "constraints" edge.
+func (m *StrategyMutation) ResetConstraints() {
+	m.constraints = nil
+	m.clearedconstraints = false
+	m.removedconstraints = nil
 }
 
-// Where appends a list predicates to the FlagEnvironmentMutation builder.
-func (m *FlagEnvironmentMutation) Where(ps ...predicate.FlagEnvironment) {
+// Where appends a list predicates to the StrategyMutation builder.
+func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FlagEnvironmentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FlagEnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FlagEnvironment, len(ps))
+func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Strategy, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3688,39 +10333,42 @@ func (m *FlagEnvironmentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FlagEnvironmentMutation) Op() Op {
+func (m *StrategyMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FlagEnvironmentMutation) SetOp(op Op) {
+func (m *StrategyMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FlagEnvironment).
-func (m *FlagEnvironmentMutation) Type() string {
+// Type returns the node type of this mutation (Strategy).
+func (m *StrategyMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FlagEnvironmentMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.enabled != nil {
-		fields = append(fields, flagenvironment.FieldEnabled)
+func (m *StrategyMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, strategy.FieldName)
 	}
-	if m.flag != nil {
-		fields = append(fields, flagenvironment.FieldFlagID)
+	if m.parameters != nil {
+		fields = append(fields, strategy.FieldParameters)
 	}
-	if m.environment != nil {
-		fields = append(fields, flagenvironment.FieldEnvironmentID)
+	if m.sort_order != nil {
+		fields = append(fields, strategy.FieldSortOrder)
+	}
+	if m.flag_environment != nil {
+		fields = append(fields, strategy.FieldFlagEnvironmentID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, flagenvironment.FieldCreatedAt)
+		fields = append(fields, strategy.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, flagenvironment.FieldUpdatedAt)
+		fields = append(fields, strategy.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3728,17 +10376,19 @@ func (m *FlagEnvironmentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FlagEnvironmentMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case flagenvironment.FieldEnabled:
-		return m.Enabled()
-	case flagenvironment.FieldFlagID:
-		return m.FlagID()
-	case flagenvironment.FieldEnvironmentID:
-		return m.EnvironmentID()
-	case flagenvironment.FieldCreatedAt:
+	case strategy.FieldName:
+		return m.Name()
+	case strategy.FieldParameters:
+		return m.Parameters()
+	case strategy.FieldSortOrder:
+		return m.SortOrder()
+	case strategy.FieldFlagEnvironmentID:
+		return m.FlagEnvironmentID()
+	case strategy.FieldCreatedAt:
 		return m.CreatedAt()
-	case flagenvironment.FieldUpdatedAt:
+	case strategy.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3747,56 +10397,65 @@ func (m *FlagEnvironmentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FlagEnvironmentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case flagenvironment.FieldEnabled:
-		return m.OldEnabled(ctx)
-	case flagenvironment.FieldFlagID:
-		return m.OldFlagID(ctx)
-	case flagenvironment.FieldEnvironmentID:
-		return m.OldEnvironmentID(ctx)
-	case flagenvironment.FieldCreatedAt:
+	case strategy.FieldName:
+		return m.OldName(ctx)
+	case strategy.FieldParameters:
+		return m.OldParameters(ctx)
+	case strategy.FieldSortOrder:
+		return m.OldSortOrder(ctx)
+	case strategy.FieldFlagEnvironmentID:
+		return m.OldFlagEnvironmentID(ctx)
+	case strategy.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case flagenvironment.FieldUpdatedAt:
+	case strategy.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FlagEnvironment field %s", name)
+	return nil, fmt.Errorf("unknown Strategy field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlagEnvironmentMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case flagenvironment.FieldEnabled:
-		v, ok := value.(bool)
+	case strategy.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnabled(v)
+		m.SetName(v)
 		return nil
-	case flagenvironment.FieldFlagID:
+	case strategy.FieldParameters:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case strategy.FieldSortOrder:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlagID(v)
+		m.SetSortOrder(v)
 		return nil
-	case flagenvironment.FieldEnvironmentID:
+	case strategy.FieldFlagEnvironmentID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEnvironmentID(v)
+		m.SetFlagEnvironmentID(v)
 		return nil
-	case flagenvironment.FieldCreatedAt:
+	case strategy.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case flagenvironment.FieldUpdatedAt:
+	case strategy.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3804,21 +10463,26 @@ func (m *FlagEnvironmentMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FlagEnvironment field %s", name)
+	return fmt.Errorf("unknown Strategy field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FlagEnvironmentMutation) AddedFields() []string {
+func (m *StrategyMutation) AddedFields() []string {
 	var fields []string
+	if m.addsort_order != nil {
+		fields = append(fields, strategy.FieldSortOrder)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FlagEnvironmentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case strategy.FieldSortOrder:
+		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -3826,84 +10490,96 @@ func (m *FlagEnvironmentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FlagEnvironmentMutation) AddField(name string, value ent.Value) error {
+func (m *StrategyMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case strategy.FieldSortOrder:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSortOrder(v)
+		return nil
 	}
-	return fmt.Errorf("unknown FlagEnvironment numeric field %s", name)
+	return fmt.Errorf("unknown Strategy numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FlagEnvironmentMutation) ClearedFields() []string {
-	return nil
+func (m *StrategyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategy.FieldParameters) {
+		fields = append(fields, strategy.FieldParameters)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FlagEnvironmentMutation) FieldCleared(name string) bool {
+func (m *StrategyMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FlagEnvironmentMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FlagEnvironment nullable field %s", name)
+func (m *StrategyMutation) ClearField(name string) error {
+	switch name {
+	case strategy.FieldParameters:
+		m.ClearParameters()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FlagEnvironmentMutation) ResetField(name string) error {
+func (m *StrategyMutation) ResetField(name string) error {
 	switch name {
-	case flagenvironment.FieldEnabled:
-		m.ResetEnabled()
+	case strategy.FieldName:
+		m.ResetName()
 		return nil
-	case flagenvironment.FieldFlagID:
-		m.ResetFlagID()
+	case strategy.FieldParameters:
+		m.ResetParameters()
 		return nil
-	case flagenvironment.FieldEnvironmentID:
-		m.ResetEnvironmentID()
+	case strategy.FieldSortOrder:
+		m.ResetSortOrder()
 		return nil
-	case flagenvironment.FieldCreatedAt:
+	case strategy.FieldFlagEnvironmentID:
+		m.ResetFlagEnvironmentID()
+		return nil
+	case strategy.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case flagenvironment.FieldUpdatedAt:
+	case strategy.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FlagEnvironment field %s", name)
+	return fmt.Errorf("unknown Strategy field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FlagEnvironmentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.flag != nil {
-		edges = append(edges, flagenvironment.EdgeFlag)
-	}
-	if m.environment != nil {
-		edges = append(edges, flagenvironment.EdgeEnvironment)
+func (m *StrategyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.flag_environment != nil {
+		edges = append(edges, strategy.EdgeFlagEnvironment)
 	}
-	if m.strategies != nil {
-		edges = append(edges, flagenvironment.EdgeStrategies)
+	if m.constraints != nil {
+		edges = append(edges, strategy.EdgeConstraints)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FlagEnvironmentMutation) AddedIDs(name string) []ent.Value {
+func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case flagenvironment.EdgeFlag:
-		if id := m.flag; id != nil {
-			return []ent.Value{*id}
-		}
-	case flagenvironment.EdgeEnvironment:
-		if id := m.environment; id != nil {
+	case strategy.EdgeFlagEnvironment:
+		if id := m.flag_environment; id != nil {
 			return []ent.Value{*id}
 		}
-	case flagenvironment.EdgeStrategies:
-		ids := make([]ent.Value, 0, len(m.strategies))
-		for id := range m.strategies {
+	case strategy.EdgeConstraints:
+		ids := make([]ent.Value, 0, len(m.constraints))
+		for id := range m.constraints {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3912,21 +10588,21 @@ func (m *FlagEnvironmentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FlagEnvironmentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedstrategies != nil {
-		edges = append(edges, flagenvironment.EdgeStrategies)
+func (m *StrategyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedconstraints != nil {
+		edges = append(edges, strategy.EdgeConstraints)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FlagEnvironmentMutation) RemovedIDs(name string) []ent.Value {
+func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case flagenvironment.EdgeStrategies:
-		ids := make([]ent.Value, 0, len(m.removedstrategies))
-		for id := range m.removedstrategies {
+	case strategy.EdgeConstraints:
+		ids := make([]ent.Value, 0, len(m.removedconstraints))
+		for id := range m.removedconstraints {
 			ids = append(ids, id)
 		}
 		return ids
@@ -3935,101 +10611,85 @@ func (m *FlagEnvironmentMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FlagEnvironmentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedflag {
-		edges = append(edges, flagenvironment.EdgeFlag)
-	}
-	if m.clearedenvironment {
-		edges = append(edges, flagenvironment.EdgeEnvironment)
+func (m *StrategyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedflag_environment {
+		edges = append(edges, strategy.EdgeFlagEnvironment)
 	}
-	if m.clearedstrategies {
-		edges = append(edges, flagenvironment.EdgeStrategies)
+	if m.clearedconstraints {
+		edges = append(edges, strategy.EdgeConstraints)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FlagEnvironmentMutation) EdgeCleared(name string) bool {
+func (m *StrategyMutation) EdgeCleared(name string) bool {
 	switch name {
-	case flagenvironment.EdgeFlag:
-		return m.clearedflag
-	case flagenvironment.EdgeEnvironment:
-		return m.clearedenvironment
-	case flagenvironment.EdgeStrategies:
-		return m.clearedstrategies
+	case strategy.EdgeFlagEnvironment:
+		return m.clearedflag_environment
+	case strategy.EdgeConstraints:
+		return m.clearedconstraints
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FlagEnvironmentMutation) ClearEdge(name string) error {
+func (m *StrategyMutation) ClearEdge(name string) error {
 	switch name {
-	case flagenvironment.EdgeFlag:
-		m.ClearFlag()
-		return nil
-	case flagenvironment.EdgeEnvironment:
-		m.ClearEnvironment()
+	case strategy.EdgeFlagEnvironment:
+		m.ClearFlagEnvironment()
 		return nil
 	}
-	return fmt.Errorf("unknown FlagEnvironment unique edge %s", name)
+	return fmt.Errorf("unknown Strategy unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FlagEnvironmentMutation) ResetEdge(name string) error {
+func (m *StrategyMutation) ResetEdge(name string) error {
 	switch name {
-	case flagenvironment.EdgeFlag:
-		m.ResetFlag()
-		return nil
-	case flagenvironment.EdgeEnvironment:
-		m.ResetEnvironment()
+	case strategy.EdgeFlagEnvironment:
+		m.ResetFlagEnvironment()
 		return nil
-	case flagenvironment.EdgeStrategies:
-		m.ResetStrategies()
+	case strategy.EdgeConstraints:
+		m.ResetConstraints()
 		return nil
 	}
-	return fmt.Errorf("unknown FlagEnvironment edge %s", name)
+	return fmt.Errorf("unknown Strategy edge %s", name)
 }
 
-// ProjectMutation represents an operation that mutates the Project nodes in the graph.
-type ProjectMutation struct {
+// StrategyDefinitionMutation represents an operation that mutates the StrategyDefinition nodes in the graph.
+type StrategyDefinitionMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	name                *string
-	description         *string
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	environments        map[int]struct{}
-	removedenvironments map[int]struct{}
-	clearedenvironments bool
-	flags               map[int]struct{}
-	removedflags        map[int]struct{}
-	clearedflags        bool
-	api_tokens          map[int]struct{}
-	removedapi_tokens   map[int]struct{}
-	clearedapi_tokens   bool
-	done                bool
-	oldValue            func(context.Context) (*Project, error)
-	predicates          []predicate.Project
+	op               Op
+	typ              string
+	id               *int
+	name             *string
+	description      *string
+	parameters       *[]schema.StrategyParameter
+	appendparameters []schema.StrategyParameter
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	project          *int
+	clearedproject   bool
+	done             bool
+	oldValue         func(context.Context) (*StrategyDefinition, error)
+	predicates       []predicate.StrategyDefinition
 }
 
-var _ ent.Mutation = (*ProjectMutation)(nil)
+var _ ent.Mutation = (*StrategyDefinitionMutation)(nil)
 
-// projectOption allows management of the mutation configuration using functional options.
-type projectOption func(*ProjectMutation)
+// strategydefinitionOption allows management of the mutation configuration using functional options.
+type strategydefinitionOption func(*StrategyDefinitionMutation)
 
-// newProjectMutation creates new mutation for the Project entity.
-func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation {
-	m := &ProjectMutation{
+// newStrategyDefinitionMutation creates new mutation for the StrategyDefinition entity.
+func newStrategyDefinitionMutation(c config, op Op, opts ...strategydefinitionOption) *StrategyDefinitionMutation {
+	m := &StrategyDefinitionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProject,
+		typ:           TypeStrategyDefinition,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4038,20 +10698,20 @@ func newProjectMutation(c config, op Op, opts ...projectOption) *ProjectMutation
 	return m
 }
 
-// withProjectID sets the ID field of the mutation.
-func withProjectID(id int) projectOption {
-	return func(m *ProjectMutation) {
+// withStrategyDefinitionID sets the ID field of the mutation.
+func withStrategyDefinitionID(id int) strategydefinitionOption {
+	return func(m *StrategyDefinitionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Project
+			value *StrategyDefinition
 		)
-		m.oldValue = func(ctx context.Context) (*Project, error) {
+		m.oldValue = func(ctx context.Context) (*StrategyDefinition, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Project.Get(ctx, id)
+					value, err = m.Client().StrategyDefinition.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4060,10 +10720,10 @@ func withProjectID(id int) projectOption {
 	}
 }
 
-// withProject sets the old Project of the mutation.
-func withProject(node *Project) projectOption {
-	return func(m *ProjectMutation) {
-		m.oldValue = func(context.Context) (*Project, error) {
+// withStrategyDefinition sets the old StrategyDefinition of the mutation.
+func withStrategyDefinition(node *StrategyDefinition) strategydefinitionOption {
+	return func(m *StrategyDefinitionMutation) {
+		m.oldValue = func(context.Context) (*StrategyDefinition, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4072,7 +10732,7 @@ func withProject(node *Project) projectOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProjectMutation) Client() *Client {
+func (m StrategyDefinitionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4080,7 +10740,7 @@ func (m ProjectMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProjectMutation) Tx() (*Tx, error) {
+func (m StrategyDefinitionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4091,7 +10751,7 @@ func (m ProjectMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProjectMutation) ID() (id int, exists bool) {
+func (m *StrategyDefinitionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4102,7 +10762,7 @@ func (m *ProjectMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *StrategyDefinitionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4111,19 +10771,19 @@ func (m *ProjectMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Project.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StrategyDefinition.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *ProjectMutation) SetName(s string) {
+func (m *StrategyDefinitionMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *ProjectMutation) Name() (r string, exists bool) {
+func (m *StrategyDefinitionMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4131,10 +10791,10 @@ func (m *ProjectMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *StrategyDefinitionMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4149,17 +10809,17 @@ func (m *ProjectMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *ProjectMutation) ResetName() {
+func (m *StrategyDefinitionMutation) ResetName() {
 	m.name = nil
 }
 
 // SetDescription sets the "description" field.
-func (m *ProjectMutation) SetDescription(s string) {
+func (m *StrategyDefinitionMutation) SetDescription(s string) {
 	m.description = &s
 }
 
 // Description returns the value of the "description" field in the mutation.
-func (m *ProjectMutation) Description() (r string, exists bool) {
+func (m *StrategyDefinitionMutation) Description() (r string, exists bool) {
 	v := m.description
 	if v == nil {
 		return
@@ -4167,10 +10827,10 @@ func (m *ProjectMutation) Description() (r string, exists bool) {
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *StrategyDefinitionMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
@@ -4185,266 +10845,232 @@ func (m *ProjectMutation) OldDescription(ctx context.Context) (v string, err err
 }
 
 // ClearDescription clears the value of the "description" field.
-func (m *ProjectMutation) ClearDescription() {
+func (m *StrategyDefinitionMutation) ClearDescription() {
 	m.description = nil
-	m.clearedFields[project.FieldDescription] = struct{}{}
+	m.clearedFields[strategydefinition.FieldDescription] = struct{}{}
 }
 
 // DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *ProjectMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[project.FieldDescription]
+func (m *StrategyDefinitionMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[strategydefinition.FieldDescription]
 	return ok
 }
 
 // ResetDescription resets all changes to the "description" field.
-func (m *ProjectMutation) ResetDescription() {
+func (m *StrategyDefinitionMutation) ResetDescription() {
 	m.description = nil
-	delete(m.clearedFields, project.FieldDescription)
+	delete(m.clearedFields, strategydefinition.FieldDescription)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProjectMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetParameters sets the "parameters" field.
+func (m *StrategyDefinitionMutation) SetParameters(sp []schema.StrategyParameter) {
+	m.parameters = &sp
+	m.appendparameters = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProjectMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *StrategyDefinitionMutation) Parameters() (r []schema.StrategyParameter, exists bool) {
+	v := m.parameters
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
+// OldParameters returns the old "parameters" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *StrategyDefinitionMutation) OldParameters(ctx context.Context) (v []schema.StrategyParameter, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldParameters requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProjectMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *ProjectMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+	return oldValue.Parameters, nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProjectMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendParameters adds sp to the "parameters" field.
+func (m *StrategyDefinitionMutation) AppendParameters(sp []schema.StrategyParameter) {
+	m.appendparameters = append(m.appendparameters, sp...)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Project entity.
-// If the Project object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProjectMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// AppendedParameters returns the list of values that were appended to the "parameters" field in this mutation.
+func (m *StrategyDefinitionMutation) AppendedParameters() ([]schema.StrategyParameter, bool) {
+	if len(m.appendparameters) == 0 {
+		return nil, false
 	}
-	return oldValue.UpdatedAt, nil
+	return m.appendparameters, true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProjectMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddEnvironmentIDs adds the "environments" edge to the Environment entity by ids.
-func (m *ProjectMutation) AddEnvironmentIDs(ids ...int) {
-	if m.environments == nil {
-		m.environments = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.environments[ids[i]] = struct{}{}
-	}
+// ClearParameters clears the value of the "parameters" field.
+func (m *StrategyDefinitionMutation) ClearParameters() {
+	m.parameters = nil
+	m.appendparameters = nil
+	m.clearedFields[strategydefinition.FieldParameters] = struct{}{}
 }
 
-// ClearEnvironments clears the "environments" edge to the Environment entity.
-func (m *ProjectMutation) ClearEnvironments() {
-	m.clearedenvironments = true
+// ParametersCleared returns if the "parameters" field was cleared in this mutation.
+func (m *StrategyDefinitionMutation) ParametersCleared() bool {
+	_, ok := m.clearedFields[strategydefinition.FieldParameters]
+	return ok
 }
 
-// EnvironmentsCleared reports if the "environments" edge to the Environment entity was cleared.
-func (m *ProjectMutation) EnvironmentsCleared() bool {
-	return m.clearedenvironments
+// ResetParameters resets all changes to the "parameters" field.
+func (m *StrategyDefinitionMutation) ResetParameters() {
+	m.parameters = nil
+	m.appendparameters = nil
+	delete(m.clearedFields, strategydefinition.FieldParameters)
 }
 
-// RemoveEnvironmentIDs removes the "environments" edge to the Environment entity by IDs.
-func (m *ProjectMutation) RemoveEnvironmentIDs(ids ...int) {
-	if m.removedenvironments == nil {
-		m.removedenvironments = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.environments, ids[i])
-		m.removedenvironments[ids[i]] = struct{}{}
-	}
+// SetProjectID sets the "project_id" field.
+func (m *StrategyDefinitionMutation) SetProjectID(i int) {
+	m.project = &i
 }
 
-// RemovedEnvironments returns the removed IDs of the "environments" edge to the Environment entity.
-func (m *ProjectMutation) RemovedEnvironmentsIDs() (ids []int) {
-	for id := range m.removedenvironments {
-		ids = append(ids, id)
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *StrategyDefinitionMutation) ProjectID() (r int, exists bool) {
+	v := m.project
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// EnvironmentsIDs returns the "environments" edge IDs in the mutation.
-func (m *ProjectMutation) EnvironmentsIDs() (ids []int) {
-	for id := range m.environments {
-		ids = append(ids, id)
+// OldProjectID returns the old "project_id" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyDefinitionMutation) OldProjectID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// ResetEnvironments resets all changes to the "environments" edge.
-func (m *ProjectMutation) ResetEnvironments() {
-	m.environments = nil
-	m.clearedenvironments = false
-	m.removedenvironments = nil
-}
-
-// AddFlagIDs adds the "flags" edge to the Flag entity by ids.
-func (m *ProjectMutation) AddFlagIDs(ids ...int) {
-	if m.flags == nil {
-		m.flags = make(map[int]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
-	for i := range ids {
-		m.flags[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
+	return oldValue.ProjectID, nil
 }
 
-// ClearFlags clears the "flags" edge to the Flag entity.
-func (m *ProjectMutation) ClearFlags() {
-	m.clearedflags = true
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *StrategyDefinitionMutation) ResetProjectID() {
+	m.project = nil
 }
 
-// FlagsCleared reports if the "flags" edge to the Flag entity was cleared.
-func (m *ProjectMutation) FlagsCleared() bool {
-	return m.clearedflags
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyDefinitionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveFlagIDs removes the "flags" edge to the Flag entity by IDs.
-func (m *ProjectMutation) RemoveFlagIDs(ids ...int) {
-	if m.removedflags == nil {
-		m.removedflags = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.flags, ids[i])
-		m.removedflags[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyDefinitionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedFlags returns the removed IDs of the "flags" edge to the Flag entity.
-func (m *ProjectMutation) RemovedFlagsIDs() (ids []int) {
-	for id := range m.removedflags {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyDefinitionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// FlagsIDs returns the "flags" edge IDs in the mutation.
-func (m *ProjectMutation) FlagsIDs() (ids []int) {
-	for id := range m.flags {
-		ids = append(ids, id)
-	}
-	return
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyDefinitionMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ResetFlags resets all changes to the "flags" edge.
-func (m *ProjectMutation) ResetFlags() {
-	m.flags = nil
-	m.clearedflags = false
-	m.removedflags = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyDefinitionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// AddAPITokenIDs adds the "api_tokens" edge to the ApiToken entity by ids.
-func (m *ProjectMutation) AddAPITokenIDs(ids ...int) {
-	if m.api_tokens == nil {
-		m.api_tokens = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.api_tokens[ids[i]] = struct{}{}
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyDefinitionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAPITokens clears the "api_tokens" edge to the ApiToken entity.
-func (m *ProjectMutation) ClearAPITokens() {
-	m.clearedapi_tokens = true
+// OldUpdatedAt returns the old "updated_at" field's value of the StrategyDefinition entity.
+// If the StrategyDefinition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyDefinitionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// APITokensCleared reports if the "api_tokens" edge to the ApiToken entity was cleared.
-func (m *ProjectMutation) APITokensCleared() bool {
-	return m.clearedapi_tokens
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyDefinitionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// RemoveAPITokenIDs removes the "api_tokens" edge to the ApiToken entity by IDs.
-func (m *ProjectMutation) RemoveAPITokenIDs(ids ...int) {
-	if m.removedapi_tokens == nil {
-		m.removedapi_tokens = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.api_tokens, ids[i])
-		m.removedapi_tokens[ids[i]] = struct{}{}
-	}
+// ClearProject clears the "project" edge to the Project entity.
+func (m *StrategyDefinitionMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[strategydefinition.FieldProjectID] = struct{}{}
 }
 
-// RemovedAPITokens returns the removed IDs of the "api_tokens" edge to the ApiToken entity.
-func (m *ProjectMutation) RemovedAPITokensIDs() (ids []int) {
-	for id := range m.removedapi_tokens {
-		ids = append(ids, id)
-	}
-	return
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *StrategyDefinitionMutation) ProjectCleared() bool {
+	return m.clearedproject
 }
 
-// APITokensIDs returns the "api_tokens" edge IDs in the mutation.
-func (m *ProjectMutation) APITokensIDs() (ids []int) {
-	for id := range m.api_tokens {
-		ids = append(ids, id)
+// ProjectIDs returns the "project" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *StrategyDefinitionMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetAPITokens resets all changes to the "api_tokens" edge.
-func (m *ProjectMutation) ResetAPITokens() {
-	m.api_tokens = nil
-	m.clearedapi_tokens = false
-	m.removedapi_tokens = nil
+// ResetProject resets all changes to the "project" edge.
+func (m *StrategyDefinitionMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
 }
 
-// Where appends a list predicates to the ProjectMutation builder.
-func (m *ProjectMutation) Where(ps ...predicate.Project) {
+// Where appends a list predicates to the StrategyDefinitionMutation builder.
+func (m *StrategyDefinitionMutation) Where(ps ...predicate.StrategyDefinition) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProjectMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyDefinitionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Project, len(ps))
+func (m *StrategyDefinitionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyDefinition, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4452,36 +11078,42 @@ func (m *ProjectMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProjectMutation) Op() Op {
+func (m *StrategyDefinitionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProjectMutation) SetOp(op Op) {
+func (m *StrategyDefinitionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Project).
-func (m *ProjectMutation) Type() string {
+// Type returns the node type of this mutation (StrategyDefinition).
+func (m *StrategyDefinitionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProjectMutation) Fields() []string {
-	fields := make([]string, 0, 4)
+func (m *StrategyDefinitionMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.name != nil {
-		fields = append(fields, project.FieldName)
+		fields = append(fields, strategydefinition.FieldName)
 	}
 	if m.description != nil {
-		fields = append(fields, project.FieldDescription)
+		fields = append(fields, strategydefinition.FieldDescription)
+	}
+	if m.parameters != nil {
+		fields = append(fields, strategydefinition.FieldParameters)
+	}
+	if m.project != nil {
+		fields = append(fields, strategydefinition.FieldProjectID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, project.FieldCreatedAt)
+		fields = append(fields, strategydefinition.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, project.FieldUpdatedAt)
+		fields = append(fields, strategydefinition.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4489,15 +11121,19 @@ func (m *ProjectMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyDefinitionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case project.FieldName:
+	case strategydefinition.FieldName:
 		return m.Name()
-	case project.FieldDescription:
+	case strategydefinition.FieldDescription:
 		return m.Description()
-	case project.FieldCreatedAt:
+	case strategydefinition.FieldParameters:
+		return m.Parameters()
+	case strategydefinition.FieldProjectID:
+		return m.ProjectID()
+	case strategydefinition.FieldCreatedAt:
 		return m.CreatedAt()
-	case project.FieldUpdatedAt:
+	case strategydefinition.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4506,47 +11142,65 @@ func (m *ProjectMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProjectMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyDefinitionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case project.FieldName:
+	case strategydefinition.FieldName:
 		return m.OldName(ctx)
-	case project.FieldDescription:
+	case strategydefinition.FieldDescription:
 		return m.OldDescription(ctx)
-	case project.FieldCreatedAt:
+	case strategydefinition.FieldParameters:
+		return m.OldParameters(ctx)
+	case strategydefinition.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case strategydefinition.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case project.FieldUpdatedAt:
+	case strategydefinition.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Project field %s", name)
+	return nil, fmt.Errorf("unknown StrategyDefinition field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyDefinitionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case project.FieldName:
+	case strategydefinition.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case project.FieldDescription:
+	case strategydefinition.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetDescription(v)
 		return nil
-	case project.FieldCreatedAt:
+	case strategydefinition.FieldParameters:
+		v, ok := value.([]schema.StrategyParameter)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case strategydefinition.FieldProjectID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProjectID(v)
+		return nil
+	case strategydefinition.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case project.FieldUpdatedAt:
+	case strategydefinition.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4554,249 +11208,198 @@ func (m *ProjectMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown StrategyDefinition field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProjectMutation) AddedFields() []string {
-	return nil
+func (m *StrategyDefinitionMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProjectMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StrategyDefinitionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProjectMutation) AddField(name string, value ent.Value) error {
+func (m *StrategyDefinitionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Project numeric field %s", name)
+	return fmt.Errorf("unknown StrategyDefinition numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProjectMutation) ClearedFields() []string {
+func (m *StrategyDefinitionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(project.FieldDescription) {
-		fields = append(fields, project.FieldDescription)
+	if m.FieldCleared(strategydefinition.FieldDescription) {
+		fields = append(fields, strategydefinition.FieldDescription)
+	}
+	if m.FieldCleared(strategydefinition.FieldParameters) {
+		fields = append(fields, strategydefinition.FieldParameters)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProjectMutation) FieldCleared(name string) bool {
+func (m *StrategyDefinitionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProjectMutation) ClearField(name string) error {
+func (m *StrategyDefinitionMutation) ClearField(name string) error {
 	switch name {
-	case project.FieldDescription:
+	case strategydefinition.FieldDescription:
 		m.ClearDescription()
 		return nil
+	case strategydefinition.FieldParameters:
+		m.ClearParameters()
+		return nil
 	}
-	return fmt.Errorf("unknown Project nullable field %s", name)
+	return fmt.Errorf("unknown StrategyDefinition nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProjectMutation) ResetField(name string) error {
+func (m *StrategyDefinitionMutation) ResetField(name string) error {
 	switch name {
-	case project.FieldName:
+	case strategydefinition.FieldName:
 		m.ResetName()
 		return nil
-	case project.FieldDescription:
+	case strategydefinition.FieldDescription:
 		m.ResetDescription()
 		return nil
-	case project.FieldCreatedAt:
+	case strategydefinition.FieldParameters:
+		m.ResetParameters()
+		return nil
+	case strategydefinition.FieldProjectID:
+		m.ResetProjectID()
+		return nil
+	case strategydefinition.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case project.FieldUpdatedAt:
+	case strategydefinition.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Project field %s", name)
+	return fmt.Errorf("unknown StrategyDefinition field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProjectMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.environments != nil {
-		edges = append(edges, project.EdgeEnvironments)
-	}
-	if m.flags != nil {
-		edges = append(edges, project.EdgeFlags)
-	}
-	if m.api_tokens != nil {
-		edges = append(edges, project.EdgeAPITokens)
+func (m *StrategyDefinitionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.project != nil {
+		edges = append(edges, strategydefinition.EdgeProject)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProjectMutation) AddedIDs(name string) []ent.Value {
+func (m *StrategyDefinitionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case project.EdgeEnvironments:
-		ids := make([]ent.Value, 0, len(m.environments))
-		for id := range m.environments {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeFlags:
-		ids := make([]ent.Value, 0, len(m.flags))
-		for id := range m.flags {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.api_tokens))
-		for id := range m.api_tokens {
-			ids = append(ids, id)
+	case strategydefinition.EdgeProject:
+		if id := m.project; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProjectMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedenvironments != nil {
-		edges = append(edges, project.EdgeEnvironments)
-	}
-	if m.removedflags != nil {
-		edges = append(edges, project.EdgeFlags)
-	}
-	if m.removedapi_tokens != nil {
-		edges = append(edges, project.EdgeAPITokens)
-	}
+func (m *StrategyDefinitionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProjectMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case project.EdgeEnvironments:
-		ids := make([]ent.Value, 0, len(m.removedenvironments))
-		for id := range m.removedenvironments {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeFlags:
-		ids := make([]ent.Value, 0, len(m.removedflags))
-		for id := range m.removedflags {
-			ids = append(ids, id)
-		}
-		return ids
-	case project.EdgeAPITokens:
-		ids := make([]ent.Value, 0, len(m.removedapi_tokens))
-		for id := range m.removedapi_tokens {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StrategyDefinitionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProjectMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedenvironments {
-		edges = append(edges, project.EdgeEnvironments)
-	}
-	if m.clearedflags {
-		edges = append(edges, project.EdgeFlags)
-	}
-	if m.clearedapi_tokens {
-		edges = append(edges, project.EdgeAPITokens)
+func (m *StrategyDefinitionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedproject {
+		edges = append(edges, strategydefinition.EdgeProject)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProjectMutation) EdgeCleared(name string) bool {
+func (m *StrategyDefinitionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case project.EdgeEnvironments:
-		return m.clearedenvironments
-	case project.EdgeFlags:
-		return m.clearedflags
-	case project.EdgeAPITokens:
-		return m.clearedapi_tokens
+	case strategydefinition.EdgeProject:
+		return m.clearedproject
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProjectMutation) ClearEdge(name string) error {
+func (m *StrategyDefinitionMutation) ClearEdge(name string) error {
 	switch name {
+	case strategydefinition.EdgeProject:
+		m.ClearProject()
+		return nil
 	}
-	return fmt.Errorf("unknown Project unique edge %s", name)
+	return fmt.Errorf("unknown StrategyDefinition unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProjectMutation) ResetEdge(name string) error {
+func (m *StrategyDefinitionMutation) ResetEdge(name string) error {
 	switch name {
-	case project.EdgeEnvironments:
-		m.ResetEnvironments()
-		return nil
-	case project.EdgeFlags:
-		m.ResetFlags()
-		return nil
-	case project.EdgeAPITokens:
-		m.ResetAPITokens()
+	case strategydefinition.EdgeProject:
+		m.ResetProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Project edge %s", name)
+	return fmt.Errorf("unknown StrategyDefinition edge %s", name)
 }
 
-// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
-type StrategyMutation struct {
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	name                    *string
-	parameters              *map[string]interface{}
-	sort_order              *int
-	addsort_order           *int
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	flag_environment        *int
-	clearedflag_environment bool
-	constraints             map[int]struct{}
-	removedconstraints      map[int]struct{}
-	clearedconstraints      bool
-	done                    bool
-	oldValue                func(context.Context) (*Strategy, error)
-	predicates              []predicate.Strategy
+	op             Op
+	typ            string
+	id             *int
+	name           *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	project        *int
+	clearedproject bool
+	flags          map[int]struct{}
+	removedflags   map[int]struct{}
+	clearedflags   bool
+	done           bool
+	oldValue       func(context.Context) (*Tag, error)
+	predicates     []predicate.Tag
 }
 
-var _ ent.Mutation = (*StrategyMutation)(nil)
+var _ ent.Mutation = (*TagMutation)(nil)
 
-// strategyOption allows management of the mutation configuration using functional options.
-type strategyOption func(*StrategyMutation)
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
 
-// newStrategyMutation creates new mutation for the Strategy entity.
-func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
-	m := &StrategyMutation{
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStrategy,
+		typ:           TypeTag,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4805,20 +11408,20 @@ func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutat
 	return m
 }
 
-// withStrategyID sets the ID field of the mutation.
-func withStrategyID(id int) strategyOption {
-	return func(m *StrategyMutation) {
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Strategy
+			value *Tag
 		)
-		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Strategy.Get(ctx, id)
+					value, err = m.Client().Tag.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4827,10 +11430,10 @@ func withStrategyID(id int) strategyOption {
 	}
 }
 
-// withStrategy sets the old Strategy of the mutation.
-func withStrategy(node *Strategy) strategyOption {
-	return func(m *StrategyMutation) {
-		m.oldValue = func(context.Context) (*Strategy, error) {
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4839,7 +11442,7 @@ func withStrategy(node *Strategy) strategyOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyMutation) Client() *Client {
+func (m TagMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4847,7 +11450,7 @@ func (m StrategyMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StrategyMutation) Tx() (*Tx, error) {
+func (m TagMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4858,7 +11461,7 @@ func (m StrategyMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StrategyMutation) ID() (id int, exists bool) {
+func (m *TagMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4869,7 +11472,7 @@ func (m *StrategyMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4878,19 +11481,19 @@ func (m *StrategyMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *StrategyMutation) SetName(s string) {
+func (m *TagMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *StrategyMutation) Name() (r string, exists bool) {
+func (m *TagMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -4898,10 +11501,10 @@ func (m *StrategyMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -4916,158 +11519,53 @@ func (m *StrategyMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *StrategyMutation) ResetName() {
+func (m *TagMutation) ResetName() {
 	m.name = nil
 }
 
-// SetParameters sets the "parameters" field.
-func (m *StrategyMutation) SetParameters(value map[string]interface{}) {
-	m.parameters = &value
-}
-
-// Parameters returns the value of the "parameters" field in the mutation.
-func (m *StrategyMutation) Parameters() (r map[string]interface{}, exists bool) {
-	v := m.parameters
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldParameters returns the old "parameters" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldParameters(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParameters requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
-	}
-	return oldValue.Parameters, nil
-}
-
-// ClearParameters clears the value of the "parameters" field.
-func (m *StrategyMutation) ClearParameters() {
-	m.parameters = nil
-	m.clearedFields[strategy.FieldParameters] = struct{}{}
-}
-
-// ParametersCleared returns if the "parameters" field was cleared in this mutation.
-func (m *StrategyMutation) ParametersCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldParameters]
-	return ok
-}
-
-// ResetParameters resets all changes to the "parameters" field.
-func (m *StrategyMutation) ResetParameters() {
-	m.parameters = nil
-	delete(m.clearedFields, strategy.FieldParameters)
-}
-
-// SetSortOrder sets the "sort_order" field.
-func (m *StrategyMutation) SetSortOrder(i int) {
-	m.sort_order = &i
-	m.addsort_order = nil
-}
-
-// SortOrder returns the value of the "sort_order" field in the mutation.
-func (m *StrategyMutation) SortOrder() (r int, exists bool) {
-	v := m.sort_order
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldSortOrder returns the old "sort_order" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSortOrder(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSortOrder is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSortOrder requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSortOrder: %w", err)
-	}
-	return oldValue.SortOrder, nil
-}
-
-// AddSortOrder adds i to the "sort_order" field.
-func (m *StrategyMutation) AddSortOrder(i int) {
-	if m.addsort_order != nil {
-		*m.addsort_order += i
-	} else {
-		m.addsort_order = &i
-	}
-}
-
-// AddedSortOrder returns the value that was added to the "sort_order" field in this mutation.
-func (m *StrategyMutation) AddedSortOrder() (r int, exists bool) {
-	v := m.addsort_order
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetSortOrder resets all changes to the "sort_order" field.
-func (m *StrategyMutation) ResetSortOrder() {
-	m.sort_order = nil
-	m.addsort_order = nil
-}
-
-// SetFlagEnvironmentID sets the "flag_environment_id" field.
-func (m *StrategyMutation) SetFlagEnvironmentID(i int) {
-	m.flag_environment = &i
+// SetProjectID sets the "project_id" field.
+func (m *TagMutation) SetProjectID(i int) {
+	m.project = &i
 }
 
-// FlagEnvironmentID returns the value of the "flag_environment_id" field in the mutation.
-func (m *StrategyMutation) FlagEnvironmentID() (r int, exists bool) {
-	v := m.flag_environment
+// ProjectID returns the value of the "project_id" field in the mutation.
+func (m *TagMutation) ProjectID() (r int, exists bool) {
+	v := m.project
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldFlagEnvironmentID returns the old "flag_environment_id" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldProjectID returns the old "project_id" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldFlagEnvironmentID(ctx context.Context) (v int, err error) {
+func (m *TagMutation) OldProjectID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFlagEnvironmentID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProjectID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFlagEnvironmentID requires an ID field in the mutation")
+		return v, errors.New("OldProjectID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFlagEnvironmentID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProjectID: %w", err)
 	}
-	return oldValue.FlagEnvironmentID, nil
+	return oldValue.ProjectID, nil
 }
 
-// ResetFlagEnvironmentID resets all changes to the "flag_environment_id" field.
-func (m *StrategyMutation) ResetFlagEnvironmentID() {
-	m.flag_environment = nil
+// ResetProjectID resets all changes to the "project_id" field.
+func (m *TagMutation) ResetProjectID() {
+	m.project = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *StrategyMutation) SetCreatedAt(t time.Time) {
+func (m *TagMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StrategyMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *TagMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5075,10 +11573,10 @@ func (m *StrategyMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TagMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5092,137 +11590,101 @@ func (m *StrategyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err e
 	return oldValue.CreatedAt, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StrategyMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StrategyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
-
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StrategyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StrategyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
-func (m *StrategyMutation) ClearFlagEnvironment() {
-	m.clearedflag_environment = true
-	m.clearedFields[strategy.FieldFlagEnvironmentID] = struct{}{}
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TagMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// FlagEnvironmentCleared reports if the "flag_environment" edge to the FlagEnvironment entity was cleared.
-func (m *StrategyMutation) FlagEnvironmentCleared() bool {
-	return m.clearedflag_environment
+// ClearProject clears the "project" edge to the Project entity.
+func (m *TagMutation) ClearProject() {
+	m.clearedproject = true
+	m.clearedFields[tag.FieldProjectID] = struct{}{}
 }
 
-// FlagEnvironmentIDs returns the "flag_environment" edge IDs in the mutation.
+// ProjectCleared reports if the "project" edge to the Project entity was cleared.
+func (m *TagMutation) ProjectCleared() bool {
+	return m.clearedproject
+}
+
+// ProjectIDs returns the "project" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// FlagEnvironmentID instead. It exists only for internal usage by the builders.
-func (m *StrategyMutation) FlagEnvironmentIDs() (ids []int) {
-	if id := m.flag_environment; id != nil {
+// ProjectID instead. It exists only for internal usage by the builders.
+func (m *TagMutation) ProjectIDs() (ids []int) {
+	if id := m.project; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetFlagEnvironment resets all changes to the "flag_environment" edge.
-func (m *StrategyMutation) ResetFlagEnvironment() {
-	m.flag_environment = nil
-	m.clearedflag_environment = false
+// ResetProject resets all changes to the "project" edge.
+func (m *TagMutation) ResetProject() {
+	m.project = nil
+	m.clearedproject = false
 }
 
-// AddConstraintIDs adds the "constraints" edge to the Constraint entity by ids.
-func (m *StrategyMutation) AddConstraintIDs(ids ...int) {
-	if m.constraints == nil {
-		m.constraints = make(map[int]struct{})
+// AddFlagIDs adds the "flags" edge to the Flag entity by ids.
+func (m *TagMutation) AddFlagIDs(ids ...int) {
+	if m.flags == nil {
+		m.flags = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.constraints[ids[i]] = struct{}{}
+		m.flags[ids[i]] = struct{}{}
 	}
 }
 
-// ClearConstraints clears the "constraints" edge to the Constraint entity.
-func (m *StrategyMutation) ClearConstraints() {
-	m.clearedconstraints = true
+// ClearFlags clears the "flags" edge to the Flag entity.
+func (m *TagMutation) ClearFlags() {
+	m.clearedflags = true
 }
 
-// ConstraintsCleared reports if the "constraints" edge to the Constraint entity was cleared.
-func (m *StrategyMutation) ConstraintsCleared() bool {
-	return m.clearedconstraints
+// FlagsCleared reports if the "flags" edge to the Flag entity was cleared.
+func (m *TagMutation) FlagsCleared() bool {
+	return m.clearedflags
 }
 
-// RemoveConstraintIDs removes the "constraints" edge to the Constraint entity by IDs.
-func (m *StrategyMutation) RemoveConstraintIDs(ids ...int) {
-	if m.removedconstraints == nil {
-		m.removedconstraints = make(map[int]struct{})
+// RemoveFlagIDs removes the "flags" edge to the Flag entity by IDs.
+func (m *TagMutation) RemoveFlagIDs(ids ...int) {
+	if m.removedflags == nil {
+		m.removedflags = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.constraints, ids[i])
-		m.removedconstraints[ids[i]] = struct{}{}
+		delete(m.flags, ids[i])
+		m.removedflags[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedConstraints returns the removed IDs of the "constraints" edge to the Constraint entity.
-func (m *StrategyMutation) RemovedConstraintsIDs() (ids []int) {
-	for id := range m.removedconstraints {
+// RemovedFlags returns the removed IDs of the "flags" edge to the Flag entity.
+func (m *TagMutation) RemovedFlagsIDs() (ids []int) {
+	for id := range m.removedflags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ConstraintsIDs returns the "constraints" edge IDs in the mutation.
-func (m *StrategyMutation) ConstraintsIDs() (ids []int) {
-	for id := range m.constraints {
+// FlagsIDs returns the "flags" edge IDs in the mutation.
+func (m *TagMutation) FlagsIDs() (ids []int) {
+	for id := range m.flags {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetConstraints resets all changes to the "constraints" edge.
-func (m *StrategyMutation) ResetConstraints() {
-	m.constraints = nil
-	m.clearedconstraints = false
-	m.removedconstraints = nil
+// ResetFlags resets all changes to the "flags" edge.
+func (m *TagMutation) ResetFlags() {
+	m.flags = nil
+	m.clearedflags = false
+	m.removedflags = nil
 }
 
-// Where appends a list predicates to the StrategyMutation builder.
-func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Strategy, len(ps))
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5230,42 +11692,33 @@ func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyMutation) Op() Op {
+func (m *TagMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyMutation) SetOp(op Op) {
+func (m *TagMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Strategy).
-func (m *StrategyMutation) Type() string {
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 3)
 	if m.name != nil {
-		fields = append(fields, strategy.FieldName)
-	}
-	if m.parameters != nil {
-		fields = append(fields, strategy.FieldParameters)
-	}
-	if m.sort_order != nil {
-		fields = append(fields, strategy.FieldSortOrder)
+		fields = append(fields, tag.FieldName)
 	}
-	if m.flag_environment != nil {
-		fields = append(fields, strategy.FieldFlagEnvironmentID)
+	if m.project != nil {
+		fields = append(fields, tag.FieldProjectID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, strategy.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, strategy.FieldUpdatedAt)
+		fields = append(fields, tag.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5273,20 +11726,14 @@ func (m *StrategyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldName:
+	case tag.FieldName:
 		return m.Name()
-	case strategy.FieldParameters:
-		return m.Parameters()
-	case strategy.FieldSortOrder:
-		return m.SortOrder()
-	case strategy.FieldFlagEnvironmentID:
-		return m.FlagEnvironmentID()
-	case strategy.FieldCreatedAt:
+	case tag.FieldProjectID:
+		return m.ProjectID()
+	case tag.FieldCreatedAt:
 		return m.CreatedAt()
-	case strategy.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5294,92 +11741,60 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategy.FieldName:
+	case tag.FieldName:
 		return m.OldName(ctx)
-	case strategy.FieldParameters:
-		return m.OldParameters(ctx)
-	case strategy.FieldSortOrder:
-		return m.OldSortOrder(ctx)
-	case strategy.FieldFlagEnvironmentID:
-		return m.OldFlagEnvironmentID(ctx)
-	case strategy.FieldCreatedAt:
+	case tag.FieldProjectID:
+		return m.OldProjectID(ctx)
+	case tag.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case strategy.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Strategy field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldName:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case strategy.FieldParameters:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetParameters(v)
-		return nil
-	case strategy.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSortOrder(v)
-		return nil
-	case strategy.FieldFlagEnvironmentID:
+	case tag.FieldProjectID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetFlagEnvironmentID(v)
+		m.SetProjectID(v)
 		return nil
-	case strategy.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case strategy.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyMutation) AddedFields() []string {
+func (m *TagMutation) AddedFields() []string {
 	var fields []string
-	if m.addsort_order != nil {
-		fields = append(fields, strategy.FieldSortOrder)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldSortOrder:
-		return m.AddedSortOrder()
 	}
 	return nil, false
 }
@@ -5387,96 +11802,71 @@ func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldSortOrder:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSortOrder(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Strategy numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(strategy.FieldParameters) {
-		fields = append(fields, strategy.FieldParameters)
-	}
-	return fields
+func (m *TagMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyMutation) ClearField(name string) error {
-	switch name {
-	case strategy.FieldParameters:
-		m.ClearParameters()
-		return nil
-	}
-	return fmt.Errorf("unknown Strategy nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case strategy.FieldName:
+	case tag.FieldName:
 		m.ResetName()
 		return nil
-	case strategy.FieldParameters:
-		m.ResetParameters()
-		return nil
-	case strategy.FieldSortOrder:
-		m.ResetSortOrder()
-		return nil
-	case strategy.FieldFlagEnvironmentID:
-		m.ResetFlagEnvironmentID()
+	case tag.FieldProjectID:
+		m.ResetProjectID()
 		return nil
-	case strategy.FieldCreatedAt:
+	case tag.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case strategy.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyMutation) AddedEdges() []string {
+func (m *TagMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.flag_environment != nil {
-		edges = append(edges, strategy.EdgeFlagEnvironment)
+	if m.project != nil {
+		edges = append(edges, tag.EdgeProject)
 	}
-	if m.constraints != nil {
-		edges = append(edges, strategy.EdgeConstraints)
+	if m.flags != nil {
+		edges = append(edges, tag.EdgeFlags)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case strategy.EdgeFlagEnvironment:
-		if id := m.flag_environment; id != nil {
+	case tag.EdgeProject:
+		if id := m.project; id != nil {
 			return []ent.Value{*id}
 		}
-	case strategy.EdgeConstraints:
-		ids := make([]ent.Value, 0, len(m.constraints))
-		for id := range m.constraints {
+	case tag.EdgeFlags:
+		ids := make([]ent.Value, 0, len(m.flags))
+		for id := range m.flags {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5485,21 +11875,21 @@ func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyMutation) RemovedEdges() []string {
+func (m *TagMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedconstraints != nil {
-		edges = append(edges, strategy.EdgeConstraints)
+	if m.removedflags != nil {
+		edges = append(edges, tag.EdgeFlags)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case strategy.EdgeConstraints:
-		ids := make([]ent.Value, 0, len(m.removedconstraints))
-		for id := range m.removedconstraints {
+	case tag.EdgeFlags:
+		ids := make([]ent.Value, 0, len(m.removedflags))
+		for id := range m.removedflags {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5508,52 +11898,52 @@ func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyMutation) ClearedEdges() []string {
+func (m *TagMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedflag_environment {
-		edges = append(edges, strategy.EdgeFlagEnvironment)
+	if m.clearedproject {
+		edges = append(edges, tag.EdgeProject)
 	}
-	if m.clearedconstraints {
-		edges = append(edges, strategy.EdgeConstraints)
+	if m.clearedflags {
+		edges = append(edges, tag.EdgeFlags)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
 	switch name {
-	case strategy.EdgeFlagEnvironment:
-		return m.clearedflag_environment
-	case strategy.EdgeConstraints:
-		return m.clearedconstraints
+	case tag.EdgeProject:
+		return m.clearedproject
+	case tag.EdgeFlags:
+		return m.clearedflags
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyMutation) ClearEdge(name string) error {
+func (m *TagMutation) ClearEdge(name string) error {
 	switch name {
-	case strategy.EdgeFlagEnvironment:
-		m.ClearFlagEnvironment()
+	case tag.EdgeProject:
+		m.ClearProject()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy unique edge %s", name)
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyMutation) ResetEdge(name string) error {
+func (m *TagMutation) ResetEdge(name string) error {
 	switch name {
-	case strategy.EdgeFlagEnvironment:
-		m.ResetFlagEnvironment()
+	case tag.EdgeProject:
+		m.ResetProject()
 		return nil
-	case strategy.EdgeConstraints:
-		m.ResetConstraints()
+	case tag.EdgeFlags:
+		m.ResetFlags()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy edge %s", name)
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
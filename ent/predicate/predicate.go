@@ -12,6 +12,9 @@ type ApiToken func(*sql.Selector)
 // Constraint is the predicate function for constraint builders.
 type Constraint func(*sql.Selector)
 
+// ContextField is the predicate function for contextfield builders.
+type ContextField func(*sql.Selector)
+
 // Environment is the predicate function for environment builders.
 type Environment func(*sql.Selector)
 
@@ -21,11 +24,26 @@ type Flag func(*sql.Selector)
 // FlagEnvironment is the predicate function for flagenvironment builders.
 type FlagEnvironment func(*sql.Selector)
 
+// FlagEnvironmentVersion is the predicate function for flagenvironmentversion builders.
+type FlagEnvironmentVersion func(*sql.Selector)
+
+// Prerequisite is the predicate function for prerequisite builders.
+type Prerequisite func(*sql.Selector)
+
 // Project is the predicate function for project builders.
 type Project func(*sql.Selector)
 
+// RolloutPlan is the predicate function for rolloutplan builders.
+type RolloutPlan func(*sql.Selector)
+
 // Strategy is the predicate function for strategy builders.
 type Strategy func(*sql.Selector)
 
+// StrategyDefinition is the predicate function for strategydefinition builders.
+type StrategyDefinition func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
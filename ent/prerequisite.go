@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+)
+
+// Prerequisite is the model entity for the Prerequisite schema.
+type Prerequisite struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FlagEnvironmentID holds the value of the "flag_environment_id" field.
+	FlagEnvironmentID int `json:"flag_environment_id,omitempty"`
+	// ParentFlagID holds the value of the "parent_flag_id" field.
+	ParentFlagID int `json:"parent_flag_id,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Variant holds the value of the "variant" field.
+	Variant string `json:"variant,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PrerequisiteQuery when eager-loading is set.
+	Edges        PrerequisiteEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PrerequisiteEdges holds the relations/edges for other nodes in the graph.
+type PrerequisiteEdges struct {
+	// FlagEnvironment holds the value of the flag_environment edge.
+	FlagEnvironment *FlagEnvironment `json:"flag_environment,omitempty"`
+	// ParentFlag holds the value of the parent_flag edge.
+	ParentFlag *Flag `json:"parent_flag,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// FlagEnvironmentOrErr returns the FlagEnvironment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PrerequisiteEdges) FlagEnvironmentOrErr() (*FlagEnvironment, error) {
+	if e.FlagEnvironment != nil {
+		return e.FlagEnvironment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flagenvironment.Label}
+	}
+	return nil, &NotLoadedError{edge: "flag_environment"}
+}
+
+// ParentFlagOrErr returns the ParentFlag value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PrerequisiteEdges) ParentFlagOrErr() (*Flag, error) {
+	if e.ParentFlag != nil {
+		return e.ParentFlag, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: flag.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent_flag"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Prerequisite) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case prerequisite.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case prerequisite.FieldID, prerequisite.FieldFlagEnvironmentID, prerequisite.FieldParentFlagID:
+			values[i] = new(sql.NullInt64)
+		case prerequisite.FieldVariant:
+			values[i] = new(sql.NullString)
+		case prerequisite.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Prerequisite fields.
+func (_m *Prerequisite) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case prerequisite.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case prerequisite.FieldFlagEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flag_environment_id", values[i])
+			} else if value.Valid {
+				_m.FlagEnvironmentID = int(value.Int64)
+			}
+		case prerequisite.FieldParentFlagID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_flag_id", values[i])
+			} else if value.Valid {
+				_m.ParentFlagID = int(value.Int64)
+			}
+		case prerequisite.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case prerequisite.FieldVariant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field variant", values[i])
+			} else if value.Valid {
+				_m.Variant = value.String
+			}
+		case prerequisite.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Prerequisite.
+// This includes values selected through modifiers, order, etc.
+func (_m *Prerequisite) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlagEnvironment queries the "flag_environment" edge of the Prerequisite entity.
+func (_m *Prerequisite) QueryFlagEnvironment() *FlagEnvironmentQuery {
+	return NewPrerequisiteClient(_m.config).QueryFlagEnvironment(_m)
+}
+
+// QueryParentFlag queries the "parent_flag" edge of the Prerequisite entity.
+func (_m *Prerequisite) QueryParentFlag() *FlagQuery {
+	return NewPrerequisiteClient(_m.config).QueryParentFlag(_m)
+}
+
+// Update returns a builder for updating this Prerequisite.
+// Note that you need to call Prerequisite.Unwrap() before calling this method if this Prerequisite
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Prerequisite) Update() *PrerequisiteUpdateOne {
+	return NewPrerequisiteClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Prerequisite entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Prerequisite) Unwrap() *Prerequisite {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Prerequisite is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Prerequisite) String() string {
+	var builder strings.Builder
+	builder.WriteString("Prerequisite(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flag_environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlagEnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("parent_flag_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentFlagID))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("variant=")
+	builder.WriteString(_m.Variant)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Prerequisites is a parsable slice of Prerequisite.
+type Prerequisites []*Prerequisite
@@ -0,0 +1,135 @@
+// Code generated by ent, DO NOT EDIT.
+
+package prerequisite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the prerequisite type in the database.
+	Label = "prerequisite"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlagEnvironmentID holds the string denoting the flag_environment_id field in the database.
+	FieldFlagEnvironmentID = "flag_environment_id"
+	// FieldParentFlagID holds the string denoting the parent_flag_id field in the database.
+	FieldParentFlagID = "parent_flag_id"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldVariant holds the string denoting the variant field in the database.
+	FieldVariant = "variant"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeFlagEnvironment holds the string denoting the flag_environment edge name in mutations.
+	EdgeFlagEnvironment = "flag_environment"
+	// EdgeParentFlag holds the string denoting the parent_flag edge name in mutations.
+	EdgeParentFlag = "parent_flag"
+	// Table holds the table name of the prerequisite in the database.
+	Table = "prerequisites"
+	// FlagEnvironmentTable is the table that holds the flag_environment relation/edge.
+	FlagEnvironmentTable = "prerequisites"
+	// FlagEnvironmentInverseTable is the table name for the FlagEnvironment entity.
+	// It exists in this package in order to avoid circular dependency with the "flagenvironment" package.
+	FlagEnvironmentInverseTable = "flag_environments"
+	// FlagEnvironmentColumn is the table column denoting the flag_environment relation/edge.
+	FlagEnvironmentColumn = "flag_environment_id"
+	// ParentFlagTable is the table that holds the parent_flag relation/edge.
+	ParentFlagTable = "prerequisites"
+	// ParentFlagInverseTable is the table name for the Flag entity.
+	// It exists in this package in order to avoid circular dependency with the "flag" package.
+	ParentFlagInverseTable = "flags"
+	// ParentFlagColumn is the table column denoting the parent_flag relation/edge.
+	ParentFlagColumn = "parent_flag_id"
+)
+
+// Columns holds all SQL columns for prerequisite fields.
+var Columns = []string{
+	FieldID,
+	FieldFlagEnvironmentID,
+	FieldParentFlagID,
+	FieldEnabled,
+	FieldVariant,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Prerequisite queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentID orders the results by the flag_environment_id field.
+func ByFlagEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlagEnvironmentID, opts...).ToFunc()
+}
+
+// ByParentFlagID orders the results by the parent_flag_id field.
+func ByParentFlagID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentFlagID, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// ByVariant orders the results by the variant field.
+func ByVariant(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVariant, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentField orders the results by flag_environment field.
+func ByFlagEnvironmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlagEnvironmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentFlagField orders the results by parent_flag field.
+func ByParentFlagField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentFlagStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlagEnvironmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlagEnvironmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+	)
+}
+func newParentFlagStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ParentFlagInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentFlagTable, ParentFlagColumn),
+	)
+}
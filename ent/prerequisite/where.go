@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package prerequisite
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLTE(FieldID, id))
+}
+
+// FlagEnvironmentID applies equality check predicate on the "flag_environment_id" field. It's identical to FlagEnvironmentIDEQ.
+func FlagEnvironmentID(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// ParentFlagID applies equality check predicate on the "parent_flag_id" field. It's identical to ParentFlagIDEQ.
+func ParentFlagID(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldParentFlagID, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Variant applies equality check predicate on the "variant" field. It's identical to VariantEQ.
+func Variant(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldVariant, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FlagEnvironmentIDEQ applies the EQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDEQ(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDNEQ applies the NEQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNEQ(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDIn applies the In predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDIn(vs ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIn(FieldFlagEnvironmentID, vs...))
+}
+
+// FlagEnvironmentIDNotIn applies the NotIn predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNotIn(vs ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotIn(FieldFlagEnvironmentID, vs...))
+}
+
+// ParentFlagIDEQ applies the EQ predicate on the "parent_flag_id" field.
+func ParentFlagIDEQ(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldParentFlagID, v))
+}
+
+// ParentFlagIDNEQ applies the NEQ predicate on the "parent_flag_id" field.
+func ParentFlagIDNEQ(v int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldParentFlagID, v))
+}
+
+// ParentFlagIDIn applies the In predicate on the "parent_flag_id" field.
+func ParentFlagIDIn(vs ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIn(FieldParentFlagID, vs...))
+}
+
+// ParentFlagIDNotIn applies the NotIn predicate on the "parent_flag_id" field.
+func ParentFlagIDNotIn(vs ...int) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotIn(FieldParentFlagID, vs...))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// VariantEQ applies the EQ predicate on the "variant" field.
+func VariantEQ(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldVariant, v))
+}
+
+// VariantNEQ applies the NEQ predicate on the "variant" field.
+func VariantNEQ(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldVariant, v))
+}
+
+// VariantIn applies the In predicate on the "variant" field.
+func VariantIn(vs ...string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIn(FieldVariant, vs...))
+}
+
+// VariantNotIn applies the NotIn predicate on the "variant" field.
+func VariantNotIn(vs ...string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotIn(FieldVariant, vs...))
+}
+
+// VariantGT applies the GT predicate on the "variant" field.
+func VariantGT(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGT(FieldVariant, v))
+}
+
+// VariantGTE applies the GTE predicate on the "variant" field.
+func VariantGTE(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGTE(FieldVariant, v))
+}
+
+// VariantLT applies the LT predicate on the "variant" field.
+func VariantLT(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLT(FieldVariant, v))
+}
+
+// VariantLTE applies the LTE predicate on the "variant" field.
+func VariantLTE(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLTE(FieldVariant, v))
+}
+
+// VariantContains applies the Contains predicate on the "variant" field.
+func VariantContains(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldContains(FieldVariant, v))
+}
+
+// VariantHasPrefix applies the HasPrefix predicate on the "variant" field.
+func VariantHasPrefix(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldHasPrefix(FieldVariant, v))
+}
+
+// VariantHasSuffix applies the HasSuffix predicate on the "variant" field.
+func VariantHasSuffix(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldHasSuffix(FieldVariant, v))
+}
+
+// VariantIsNil applies the IsNil predicate on the "variant" field.
+func VariantIsNil() predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIsNull(FieldVariant))
+}
+
+// VariantNotNil applies the NotNil predicate on the "variant" field.
+func VariantNotNil() predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotNull(FieldVariant))
+}
+
+// VariantEqualFold applies the EqualFold predicate on the "variant" field.
+func VariantEqualFold(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEqualFold(FieldVariant, v))
+}
+
+// VariantContainsFold applies the ContainsFold predicate on the "variant" field.
+func VariantContainsFold(v string) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldContainsFold(FieldVariant, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFlagEnvironment applies the HasEdge predicate on the "flag_environment" edge.
+func HasFlagEnvironment() predicate.Prerequisite {
+	return predicate.Prerequisite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlagEnvironmentWith applies the HasEdge predicate on the "flag_environment" edge with a given conditions (other predicates).
+func HasFlagEnvironmentWith(preds ...predicate.FlagEnvironment) predicate.Prerequisite {
+	return predicate.Prerequisite(func(s *sql.Selector) {
+		step := newFlagEnvironmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParentFlag applies the HasEdge predicate on the "parent_flag" edge.
+func HasParentFlag() predicate.Prerequisite {
+	return predicate.Prerequisite(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentFlagTable, ParentFlagColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentFlagWith applies the HasEdge predicate on the "parent_flag" edge with a given conditions (other predicates).
+func HasParentFlagWith(preds ...predicate.Flag) predicate.Prerequisite {
+	return predicate.Prerequisite(func(s *sql.Selector) {
+		step := newParentFlagStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Prerequisite) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Prerequisite) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Prerequisite) predicate.Prerequisite {
+	return predicate.Prerequisite(sql.NotPredicates(p))
+}
@@ -0,0 +1,315 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+)
+
+// PrerequisiteCreate is the builder for creating a Prerequisite entity.
+type PrerequisiteCreate struct {
+	config
+	mutation *PrerequisiteMutation
+	hooks    []Hook
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_c *PrerequisiteCreate) SetFlagEnvironmentID(v int) *PrerequisiteCreate {
+	_c.mutation.SetFlagEnvironmentID(v)
+	return _c
+}
+
+// SetParentFlagID sets the "parent_flag_id" field.
+func (_c *PrerequisiteCreate) SetParentFlagID(v int) *PrerequisiteCreate {
+	_c.mutation.SetParentFlagID(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *PrerequisiteCreate) SetEnabled(v bool) *PrerequisiteCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *PrerequisiteCreate) SetNillableEnabled(v *bool) *PrerequisiteCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetVariant sets the "variant" field.
+func (_c *PrerequisiteCreate) SetVariant(v string) *PrerequisiteCreate {
+	_c.mutation.SetVariant(v)
+	return _c
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_c *PrerequisiteCreate) SetNillableVariant(v *string) *PrerequisiteCreate {
+	if v != nil {
+		_c.SetVariant(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PrerequisiteCreate) SetCreatedAt(v time.Time) *PrerequisiteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PrerequisiteCreate) SetNillableCreatedAt(v *time.Time) *PrerequisiteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_c *PrerequisiteCreate) SetFlagEnvironment(v *FlagEnvironment) *PrerequisiteCreate {
+	return _c.SetFlagEnvironmentID(v.ID)
+}
+
+// SetParentFlag sets the "parent_flag" edge to the Flag entity.
+func (_c *PrerequisiteCreate) SetParentFlag(v *Flag) *PrerequisiteCreate {
+	return _c.SetParentFlagID(v.ID)
+}
+
+// Mutation returns the PrerequisiteMutation object of the builder.
+func (_c *PrerequisiteCreate) Mutation() *PrerequisiteMutation {
+	return _c.mutation
+}
+
+// Save creates the Prerequisite in the database.
+func (_c *PrerequisiteCreate) Save(ctx context.Context) (*Prerequisite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PrerequisiteCreate) SaveX(ctx context.Context) *Prerequisite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrerequisiteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrerequisiteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PrerequisiteCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := prerequisite.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := prerequisite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PrerequisiteCreate) check() error {
+	if _, ok := _c.mutation.FlagEnvironmentID(); !ok {
+		return &ValidationError{Name: "flag_environment_id", err: errors.New(`ent: missing required field "Prerequisite.flag_environment_id"`)}
+	}
+	if _, ok := _c.mutation.ParentFlagID(); !ok {
+		return &ValidationError{Name: "parent_flag_id", err: errors.New(`ent: missing required field "Prerequisite.parent_flag_id"`)}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Prerequisite.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Prerequisite.created_at"`)}
+	}
+	if len(_c.mutation.FlagEnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "flag_environment", err: errors.New(`ent: missing required edge "Prerequisite.flag_environment"`)}
+	}
+	if len(_c.mutation.ParentFlagIDs()) == 0 {
+		return &ValidationError{Name: "parent_flag", err: errors.New(`ent: missing required edge "Prerequisite.parent_flag"`)}
+	}
+	return nil
+}
+
+func (_c *PrerequisiteCreate) sqlSave(ctx context.Context) (*Prerequisite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PrerequisiteCreate) createSpec() (*Prerequisite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Prerequisite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(prerequisite.Table, sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(prerequisite.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.Variant(); ok {
+		_spec.SetField(prerequisite.FieldVariant, field.TypeString, value)
+		_node.Variant = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(prerequisite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.FlagEnvironmentTable,
+			Columns: []string{prerequisite.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlagEnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentFlagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.ParentFlagTable,
+			Columns: []string{prerequisite.ParentFlagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentFlagID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PrerequisiteCreateBulk is the builder for creating many Prerequisite entities in bulk.
+type PrerequisiteCreateBulk struct {
+	config
+	err      error
+	builders []*PrerequisiteCreate
+}
+
+// Save creates the Prerequisite entities in the database.
+func (_c *PrerequisiteCreateBulk) Save(ctx context.Context) ([]*Prerequisite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Prerequisite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PrerequisiteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PrerequisiteCreateBulk) SaveX(ctx context.Context) []*Prerequisite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PrerequisiteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PrerequisiteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,507 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+)
+
+// PrerequisiteUpdate is the builder for updating Prerequisite entities.
+type PrerequisiteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PrerequisiteMutation
+}
+
+// Where appends a list predicates to the PrerequisiteUpdate builder.
+func (_u *PrerequisiteUpdate) Where(ps ...predicate.Prerequisite) *PrerequisiteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *PrerequisiteUpdate) SetFlagEnvironmentID(v int) *PrerequisiteUpdate {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *PrerequisiteUpdate) SetNillableFlagEnvironmentID(v *int) *PrerequisiteUpdate {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetParentFlagID sets the "parent_flag_id" field.
+func (_u *PrerequisiteUpdate) SetParentFlagID(v int) *PrerequisiteUpdate {
+	_u.mutation.SetParentFlagID(v)
+	return _u
+}
+
+// SetNillableParentFlagID sets the "parent_flag_id" field if the given value is not nil.
+func (_u *PrerequisiteUpdate) SetNillableParentFlagID(v *int) *PrerequisiteUpdate {
+	if v != nil {
+		_u.SetParentFlagID(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *PrerequisiteUpdate) SetEnabled(v bool) *PrerequisiteUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *PrerequisiteUpdate) SetNillableEnabled(v *bool) *PrerequisiteUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *PrerequisiteUpdate) SetVariant(v string) *PrerequisiteUpdate {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *PrerequisiteUpdate) SetNillableVariant(v *string) *PrerequisiteUpdate {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (_u *PrerequisiteUpdate) ClearVariant() *PrerequisiteUpdate {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *PrerequisiteUpdate) SetFlagEnvironment(v *FlagEnvironment) *PrerequisiteUpdate {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// SetParentFlag sets the "parent_flag" edge to the Flag entity.
+func (_u *PrerequisiteUpdate) SetParentFlag(v *Flag) *PrerequisiteUpdate {
+	return _u.SetParentFlagID(v.ID)
+}
+
+// Mutation returns the PrerequisiteMutation object of the builder.
+func (_u *PrerequisiteUpdate) Mutation() *PrerequisiteMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *PrerequisiteUpdate) ClearFlagEnvironment() *PrerequisiteUpdate {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// ClearParentFlag clears the "parent_flag" edge to the Flag entity.
+func (_u *PrerequisiteUpdate) ClearParentFlag() *PrerequisiteUpdate {
+	_u.mutation.ClearParentFlag()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PrerequisiteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrerequisiteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PrerequisiteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrerequisiteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrerequisiteUpdate) check() error {
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Prerequisite.flag_environment"`)
+	}
+	if _u.mutation.ParentFlagCleared() && len(_u.mutation.ParentFlagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Prerequisite.parent_flag"`)
+	}
+	return nil
+}
+
+func (_u *PrerequisiteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prerequisite.Table, prerequisite.Columns, sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(prerequisite.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(prerequisite.FieldVariant, field.TypeString, value)
+	}
+	if _u.mutation.VariantCleared() {
+		_spec.ClearField(prerequisite.FieldVariant, field.TypeString)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.FlagEnvironmentTable,
+			Columns: []string{prerequisite.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.FlagEnvironmentTable,
+			Columns: []string{prerequisite.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentFlagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.ParentFlagTable,
+			Columns: []string{prerequisite.ParentFlagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentFlagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.ParentFlagTable,
+			Columns: []string{prerequisite.ParentFlagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prerequisite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PrerequisiteUpdateOne is the builder for updating a single Prerequisite entity.
+type PrerequisiteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PrerequisiteMutation
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *PrerequisiteUpdateOne) SetFlagEnvironmentID(v int) *PrerequisiteUpdateOne {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *PrerequisiteUpdateOne) SetNillableFlagEnvironmentID(v *int) *PrerequisiteUpdateOne {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetParentFlagID sets the "parent_flag_id" field.
+func (_u *PrerequisiteUpdateOne) SetParentFlagID(v int) *PrerequisiteUpdateOne {
+	_u.mutation.SetParentFlagID(v)
+	return _u
+}
+
+// SetNillableParentFlagID sets the "parent_flag_id" field if the given value is not nil.
+func (_u *PrerequisiteUpdateOne) SetNillableParentFlagID(v *int) *PrerequisiteUpdateOne {
+	if v != nil {
+		_u.SetParentFlagID(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *PrerequisiteUpdateOne) SetEnabled(v bool) *PrerequisiteUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *PrerequisiteUpdateOne) SetNillableEnabled(v *bool) *PrerequisiteUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetVariant sets the "variant" field.
+func (_u *PrerequisiteUpdateOne) SetVariant(v string) *PrerequisiteUpdateOne {
+	_u.mutation.SetVariant(v)
+	return _u
+}
+
+// SetNillableVariant sets the "variant" field if the given value is not nil.
+func (_u *PrerequisiteUpdateOne) SetNillableVariant(v *string) *PrerequisiteUpdateOne {
+	if v != nil {
+		_u.SetVariant(*v)
+	}
+	return _u
+}
+
+// ClearVariant clears the value of the "variant" field.
+func (_u *PrerequisiteUpdateOne) ClearVariant() *PrerequisiteUpdateOne {
+	_u.mutation.ClearVariant()
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *PrerequisiteUpdateOne) SetFlagEnvironment(v *FlagEnvironment) *PrerequisiteUpdateOne {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// SetParentFlag sets the "parent_flag" edge to the Flag entity.
+func (_u *PrerequisiteUpdateOne) SetParentFlag(v *Flag) *PrerequisiteUpdateOne {
+	return _u.SetParentFlagID(v.ID)
+}
+
+// Mutation returns the PrerequisiteMutation object of the builder.
+func (_u *PrerequisiteUpdateOne) Mutation() *PrerequisiteMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *PrerequisiteUpdateOne) ClearFlagEnvironment() *PrerequisiteUpdateOne {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// ClearParentFlag clears the "parent_flag" edge to the Flag entity.
+func (_u *PrerequisiteUpdateOne) ClearParentFlag() *PrerequisiteUpdateOne {
+	_u.mutation.ClearParentFlag()
+	return _u
+}
+
+// Where appends a list predicates to the PrerequisiteUpdate builder.
+func (_u *PrerequisiteUpdateOne) Where(ps ...predicate.Prerequisite) *PrerequisiteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PrerequisiteUpdateOne) Select(field string, fields ...string) *PrerequisiteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Prerequisite entity.
+func (_u *PrerequisiteUpdateOne) Save(ctx context.Context) (*Prerequisite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PrerequisiteUpdateOne) SaveX(ctx context.Context) *Prerequisite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PrerequisiteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PrerequisiteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PrerequisiteUpdateOne) check() error {
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Prerequisite.flag_environment"`)
+	}
+	if _u.mutation.ParentFlagCleared() && len(_u.mutation.ParentFlagIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Prerequisite.parent_flag"`)
+	}
+	return nil
+}
+
+func (_u *PrerequisiteUpdateOne) sqlSave(ctx context.Context) (_node *Prerequisite, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prerequisite.Table, prerequisite.Columns, sqlgraph.NewFieldSpec(prerequisite.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Prerequisite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, prerequisite.FieldID)
+		for _, f := range fields {
+			if !prerequisite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != prerequisite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(prerequisite.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Variant(); ok {
+		_spec.SetField(prerequisite.FieldVariant, field.TypeString, value)
+	}
+	if _u.mutation.VariantCleared() {
+		_spec.ClearField(prerequisite.FieldVariant, field.TypeString)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.FlagEnvironmentTable,
+			Columns: []string{prerequisite.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.FlagEnvironmentTable,
+			Columns: []string{prerequisite.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentFlagCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.ParentFlagTable,
+			Columns: []string{prerequisite.ParentFlagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentFlagIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prerequisite.ParentFlagTable,
+			Columns: []string{prerequisite.ParentFlagColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Prerequisite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prerequisite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
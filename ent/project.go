@@ -25,6 +25,14 @@ type Project struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// DeletedAt holds the value of the "deleted_at" field.
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	// FrozenAt holds the value of the "frozen_at" field.
+	FrozenAt *time.Time `json:"frozen_at,omitempty"`
+	// FrozenBy holds the value of the "frozen_by" field.
+	FrozenBy string `json:"frozen_by,omitempty"`
+	// FreezeReason holds the value of the "freeze_reason" field.
+	FreezeReason string `json:"freeze_reason,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ProjectQuery when eager-loading is set.
 	Edges        ProjectEdges `json:"edges"`
@@ -39,9 +47,15 @@ type ProjectEdges struct {
 	Flags []*Flag `json:"flags,omitempty"`
 	// APITokens holds the value of the api_tokens edge.
 	APITokens []*ApiToken `json:"api_tokens,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// StrategyDefinitions holds the value of the strategy_definitions edge.
+	StrategyDefinitions []*StrategyDefinition `json:"strategy_definitions,omitempty"`
+	// ContextFields holds the value of the context_fields edge.
+	ContextFields []*ContextField `json:"context_fields,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // EnvironmentsOrErr returns the Environments value or an error if the edge
@@ -71,6 +85,33 @@ func (e ProjectEdges) APITokensOrErr() ([]*ApiToken, error) {
 	return nil, &NotLoadedError{edge: "api_tokens"}
 }
 
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[3] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// StrategyDefinitionsOrErr returns the StrategyDefinitions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) StrategyDefinitionsOrErr() ([]*StrategyDefinition, error) {
+	if e.loadedTypes[4] {
+		return e.StrategyDefinitions, nil
+	}
+	return nil, &NotLoadedError{edge: "strategy_definitions"}
+}
+
+// ContextFieldsOrErr returns the ContextFields value or an error if the edge
+// was not loaded in eager-loading.
+func (e ProjectEdges) ContextFieldsOrErr() ([]*ContextField, error) {
+	if e.loadedTypes[5] {
+		return e.ContextFields, nil
+	}
+	return nil, &NotLoadedError{edge: "context_fields"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Project) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -78,9 +119,9 @@ func (*Project) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case project.FieldID:
 			values[i] = new(sql.NullInt64)
-		case project.FieldName, project.FieldDescription:
+		case project.FieldName, project.FieldDescription, project.FieldFrozenBy, project.FieldFreezeReason:
 			values[i] = new(sql.NullString)
-		case project.FieldCreatedAt, project.FieldUpdatedAt:
+		case project.FieldCreatedAt, project.FieldUpdatedAt, project.FieldDeletedAt, project.FieldFrozenAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -127,6 +168,32 @@ func (_m *Project) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdatedAt = value.Time
 			}
+		case project.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
+		case project.FieldFrozenAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_at", values[i])
+			} else if value.Valid {
+				_m.FrozenAt = new(time.Time)
+				*_m.FrozenAt = value.Time
+			}
+		case project.FieldFrozenBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field frozen_by", values[i])
+			} else if value.Valid {
+				_m.FrozenBy = value.String
+			}
+		case project.FieldFreezeReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field freeze_reason", values[i])
+			} else if value.Valid {
+				_m.FreezeReason = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -155,6 +222,21 @@ func (_m *Project) QueryAPITokens() *ApiTokenQuery {
 	return NewProjectClient(_m.config).QueryAPITokens(_m)
 }
 
+// QueryTags queries the "tags" edge of the Project entity.
+func (_m *Project) QueryTags() *TagQuery {
+	return NewProjectClient(_m.config).QueryTags(_m)
+}
+
+// QueryStrategyDefinitions queries the "strategy_definitions" edge of the Project entity.
+func (_m *Project) QueryStrategyDefinitions() *StrategyDefinitionQuery {
+	return NewProjectClient(_m.config).QueryStrategyDefinitions(_m)
+}
+
+// QueryContextFields queries the "context_fields" edge of the Project entity.
+func (_m *Project) QueryContextFields() *ContextFieldQuery {
+	return NewProjectClient(_m.config).QueryContextFields(_m)
+}
+
 // Update returns a builder for updating this Project.
 // Note that you need to call Project.Unwrap() before calling this method if this Project
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -189,6 +271,22 @@ func (_m *Project) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("updated_at=")
 	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.FrozenAt; v != nil {
+		builder.WriteString("frozen_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("frozen_by=")
+	builder.WriteString(_m.FrozenBy)
+	builder.WriteString(", ")
+	builder.WriteString("freeze_reason=")
+	builder.WriteString(_m.FreezeReason)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -22,12 +22,26 @@ const (
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
+	// FieldFrozenAt holds the string denoting the frozen_at field in the database.
+	FieldFrozenAt = "frozen_at"
+	// FieldFrozenBy holds the string denoting the frozen_by field in the database.
+	FieldFrozenBy = "frozen_by"
+	// FieldFreezeReason holds the string denoting the freeze_reason field in the database.
+	FieldFreezeReason = "freeze_reason"
 	// EdgeEnvironments holds the string denoting the environments edge name in mutations.
 	EdgeEnvironments = "environments"
 	// EdgeFlags holds the string denoting the flags edge name in mutations.
 	EdgeFlags = "flags"
 	// EdgeAPITokens holds the string denoting the api_tokens edge name in mutations.
 	EdgeAPITokens = "api_tokens"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeStrategyDefinitions holds the string denoting the strategy_definitions edge name in mutations.
+	EdgeStrategyDefinitions = "strategy_definitions"
+	// EdgeContextFields holds the string denoting the context_fields edge name in mutations.
+	EdgeContextFields = "context_fields"
 	// Table holds the table name of the project in the database.
 	Table = "projects"
 	// EnvironmentsTable is the table that holds the environments relation/edge.
@@ -51,6 +65,27 @@ const (
 	APITokensInverseTable = "api_tokens"
 	// APITokensColumn is the table column denoting the api_tokens relation/edge.
 	APITokensColumn = "project_id"
+	// TagsTable is the table that holds the tags relation/edge.
+	TagsTable = "tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// TagsColumn is the table column denoting the tags relation/edge.
+	TagsColumn = "project_id"
+	// StrategyDefinitionsTable is the table that holds the strategy_definitions relation/edge.
+	StrategyDefinitionsTable = "strategy_definitions"
+	// StrategyDefinitionsInverseTable is the table name for the StrategyDefinition entity.
+	// It exists in this package in order to avoid circular dependency with the "strategydefinition" package.
+	StrategyDefinitionsInverseTable = "strategy_definitions"
+	// StrategyDefinitionsColumn is the table column denoting the strategy_definitions relation/edge.
+	StrategyDefinitionsColumn = "project_id"
+	// ContextFieldsTable is the table that holds the context_fields relation/edge.
+	ContextFieldsTable = "context_fields"
+	// ContextFieldsInverseTable is the table name for the ContextField entity.
+	// It exists in this package in order to avoid circular dependency with the "contextfield" package.
+	ContextFieldsInverseTable = "context_fields"
+	// ContextFieldsColumn is the table column denoting the context_fields relation/edge.
+	ContextFieldsColumn = "project_id"
 )
 
 // Columns holds all SQL columns for project fields.
@@ -60,6 +95,10 @@ var Columns = []string{
 	FieldDescription,
 	FieldCreatedAt,
 	FieldUpdatedAt,
+	FieldDeletedAt,
+	FieldFrozenAt,
+	FieldFrozenBy,
+	FieldFreezeReason,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -109,6 +148,26 @@ func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
 
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
+// ByFrozenAt orders the results by the frozen_at field.
+func ByFrozenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrozenAt, opts...).ToFunc()
+}
+
+// ByFrozenBy orders the results by the frozen_by field.
+func ByFrozenBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrozenBy, opts...).ToFunc()
+}
+
+// ByFreezeReason orders the results by the freeze_reason field.
+func ByFreezeReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFreezeReason, opts...).ToFunc()
+}
+
 // ByEnvironmentsCount orders the results by environments count.
 func ByEnvironmentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -150,6 +209,48 @@ func ByAPITokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newAPITokensStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByStrategyDefinitionsCount orders the results by strategy_definitions count.
+func ByStrategyDefinitionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newStrategyDefinitionsStep(), opts...)
+	}
+}
+
+// ByStrategyDefinitions orders the results by strategy_definitions terms.
+func ByStrategyDefinitions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStrategyDefinitionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByContextFieldsCount orders the results by context_fields count.
+func ByContextFieldsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newContextFieldsStep(), opts...)
+	}
+}
+
+// ByContextFields orders the results by context_fields terms.
+func ByContextFields(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newContextFieldsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEnvironmentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -171,3 +272,24 @@ func newAPITokensStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, APITokensTable, APITokensColumn),
 	)
 }
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+	)
+}
+func newStrategyDefinitionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StrategyDefinitionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, StrategyDefinitionsTable, StrategyDefinitionsColumn),
+	)
+}
+func newContextFieldsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ContextFieldsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ContextFieldsTable, ContextFieldsColumn),
+	)
+}
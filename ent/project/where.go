@@ -75,6 +75,26 @@ func UpdatedAt(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldUpdatedAt, v))
 }
 
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// FrozenAt applies equality check predicate on the "frozen_at" field. It's identical to FrozenAtEQ.
+func FrozenAt(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenBy applies equality check predicate on the "frozen_by" field. It's identical to FrozenByEQ.
+func FrozenBy(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFrozenBy, v))
+}
+
+// FreezeReason applies equality check predicate on the "freeze_reason" field. It's identical to FreezeReasonEQ.
+func FreezeReason(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFreezeReason, v))
+}
+
 // NameEQ applies the EQ predicate on the "name" field.
 func NameEQ(v string) predicate.Project {
 	return predicate.Project(sql.FieldEQ(FieldName, v))
@@ -295,6 +315,256 @@ func UpdatedAtLTE(v time.Time) predicate.Project {
 	return predicate.Project(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldDeletedAt))
+}
+
+// FrozenAtEQ applies the EQ predicate on the "frozen_at" field.
+func FrozenAtEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtNEQ applies the NEQ predicate on the "frozen_at" field.
+func FrozenAtNEQ(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldFrozenAt, v))
+}
+
+// FrozenAtIn applies the In predicate on the "frozen_at" field.
+func FrozenAtIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtNotIn applies the NotIn predicate on the "frozen_at" field.
+func FrozenAtNotIn(vs ...time.Time) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldFrozenAt, vs...))
+}
+
+// FrozenAtGT applies the GT predicate on the "frozen_at" field.
+func FrozenAtGT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldFrozenAt, v))
+}
+
+// FrozenAtGTE applies the GTE predicate on the "frozen_at" field.
+func FrozenAtGTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldFrozenAt, v))
+}
+
+// FrozenAtLT applies the LT predicate on the "frozen_at" field.
+func FrozenAtLT(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldFrozenAt, v))
+}
+
+// FrozenAtLTE applies the LTE predicate on the "frozen_at" field.
+func FrozenAtLTE(v time.Time) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldFrozenAt, v))
+}
+
+// FrozenAtIsNil applies the IsNil predicate on the "frozen_at" field.
+func FrozenAtIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldFrozenAt))
+}
+
+// FrozenAtNotNil applies the NotNil predicate on the "frozen_at" field.
+func FrozenAtNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldFrozenAt))
+}
+
+// FrozenByEQ applies the EQ predicate on the "frozen_by" field.
+func FrozenByEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFrozenBy, v))
+}
+
+// FrozenByNEQ applies the NEQ predicate on the "frozen_by" field.
+func FrozenByNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldFrozenBy, v))
+}
+
+// FrozenByIn applies the In predicate on the "frozen_by" field.
+func FrozenByIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldFrozenBy, vs...))
+}
+
+// FrozenByNotIn applies the NotIn predicate on the "frozen_by" field.
+func FrozenByNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldFrozenBy, vs...))
+}
+
+// FrozenByGT applies the GT predicate on the "frozen_by" field.
+func FrozenByGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldFrozenBy, v))
+}
+
+// FrozenByGTE applies the GTE predicate on the "frozen_by" field.
+func FrozenByGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldFrozenBy, v))
+}
+
+// FrozenByLT applies the LT predicate on the "frozen_by" field.
+func FrozenByLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldFrozenBy, v))
+}
+
+// FrozenByLTE applies the LTE predicate on the "frozen_by" field.
+func FrozenByLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldFrozenBy, v))
+}
+
+// FrozenByContains applies the Contains predicate on the "frozen_by" field.
+func FrozenByContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldFrozenBy, v))
+}
+
+// FrozenByHasPrefix applies the HasPrefix predicate on the "frozen_by" field.
+func FrozenByHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldFrozenBy, v))
+}
+
+// FrozenByHasSuffix applies the HasSuffix predicate on the "frozen_by" field.
+func FrozenByHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldFrozenBy, v))
+}
+
+// FrozenByIsNil applies the IsNil predicate on the "frozen_by" field.
+func FrozenByIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldFrozenBy))
+}
+
+// FrozenByNotNil applies the NotNil predicate on the "frozen_by" field.
+func FrozenByNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldFrozenBy))
+}
+
+// FrozenByEqualFold applies the EqualFold predicate on the "frozen_by" field.
+func FrozenByEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldFrozenBy, v))
+}
+
+// FrozenByContainsFold applies the ContainsFold predicate on the "frozen_by" field.
+func FrozenByContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldFrozenBy, v))
+}
+
+// FreezeReasonEQ applies the EQ predicate on the "freeze_reason" field.
+func FreezeReasonEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldEQ(FieldFreezeReason, v))
+}
+
+// FreezeReasonNEQ applies the NEQ predicate on the "freeze_reason" field.
+func FreezeReasonNEQ(v string) predicate.Project {
+	return predicate.Project(sql.FieldNEQ(FieldFreezeReason, v))
+}
+
+// FreezeReasonIn applies the In predicate on the "freeze_reason" field.
+func FreezeReasonIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldIn(FieldFreezeReason, vs...))
+}
+
+// FreezeReasonNotIn applies the NotIn predicate on the "freeze_reason" field.
+func FreezeReasonNotIn(vs ...string) predicate.Project {
+	return predicate.Project(sql.FieldNotIn(FieldFreezeReason, vs...))
+}
+
+// FreezeReasonGT applies the GT predicate on the "freeze_reason" field.
+func FreezeReasonGT(v string) predicate.Project {
+	return predicate.Project(sql.FieldGT(FieldFreezeReason, v))
+}
+
+// FreezeReasonGTE applies the GTE predicate on the "freeze_reason" field.
+func FreezeReasonGTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldGTE(FieldFreezeReason, v))
+}
+
+// FreezeReasonLT applies the LT predicate on the "freeze_reason" field.
+func FreezeReasonLT(v string) predicate.Project {
+	return predicate.Project(sql.FieldLT(FieldFreezeReason, v))
+}
+
+// FreezeReasonLTE applies the LTE predicate on the "freeze_reason" field.
+func FreezeReasonLTE(v string) predicate.Project {
+	return predicate.Project(sql.FieldLTE(FieldFreezeReason, v))
+}
+
+// FreezeReasonContains applies the Contains predicate on the "freeze_reason" field.
+func FreezeReasonContains(v string) predicate.Project {
+	return predicate.Project(sql.FieldContains(FieldFreezeReason, v))
+}
+
+// FreezeReasonHasPrefix applies the HasPrefix predicate on the "freeze_reason" field.
+func FreezeReasonHasPrefix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasPrefix(FieldFreezeReason, v))
+}
+
+// FreezeReasonHasSuffix applies the HasSuffix predicate on the "freeze_reason" field.
+func FreezeReasonHasSuffix(v string) predicate.Project {
+	return predicate.Project(sql.FieldHasSuffix(FieldFreezeReason, v))
+}
+
+// FreezeReasonIsNil applies the IsNil predicate on the "freeze_reason" field.
+func FreezeReasonIsNil() predicate.Project {
+	return predicate.Project(sql.FieldIsNull(FieldFreezeReason))
+}
+
+// FreezeReasonNotNil applies the NotNil predicate on the "freeze_reason" field.
+func FreezeReasonNotNil() predicate.Project {
+	return predicate.Project(sql.FieldNotNull(FieldFreezeReason))
+}
+
+// FreezeReasonEqualFold applies the EqualFold predicate on the "freeze_reason" field.
+func FreezeReasonEqualFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldEqualFold(FieldFreezeReason, v))
+}
+
+// FreezeReasonContainsFold applies the ContainsFold predicate on the "freeze_reason" field.
+func FreezeReasonContainsFold(v string) predicate.Project {
+	return predicate.Project(sql.FieldContainsFold(FieldFreezeReason, v))
+}
+
 // HasEnvironments applies the HasEdge predicate on the "environments" edge.
 func HasEnvironments() predicate.Project {
 	return predicate.Project(func(s *sql.Selector) {
@@ -364,6 +634,75 @@ func HasAPITokensWith(preds ...predicate.ApiToken) predicate.Project {
 	})
 }
 
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TagsTable, TagsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStrategyDefinitions applies the HasEdge predicate on the "strategy_definitions" edge.
+func HasStrategyDefinitions() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, StrategyDefinitionsTable, StrategyDefinitionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStrategyDefinitionsWith applies the HasEdge predicate on the "strategy_definitions" edge with a given conditions (other predicates).
+func HasStrategyDefinitionsWith(preds ...predicate.StrategyDefinition) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newStrategyDefinitionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasContextFields applies the HasEdge predicate on the "context_fields" edge.
+func HasContextFields() predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ContextFieldsTable, ContextFieldsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasContextFieldsWith applies the HasEdge predicate on the "context_fields" edge with a given conditions (other predicates).
+func HasContextFieldsWith(preds ...predicate.ContextField) predicate.Project {
+	return predicate.Project(func(s *sql.Selector) {
+		step := newContextFieldsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Project) predicate.Project {
 	return predicate.Project(sql.AndPredicates(predicates...))
@@ -11,9 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // ProjectCreate is the builder for creating a Project entity.
@@ -71,6 +74,62 @@ func (_c *ProjectCreate) SetNillableUpdatedAt(v *time.Time) *ProjectCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *ProjectCreate) SetDeletedAt(v time.Time) *ProjectCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableDeletedAt(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_c *ProjectCreate) SetFrozenAt(v time.Time) *ProjectCreate {
+	_c.mutation.SetFrozenAt(v)
+	return _c
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableFrozenAt(v *time.Time) *ProjectCreate {
+	if v != nil {
+		_c.SetFrozenAt(*v)
+	}
+	return _c
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_c *ProjectCreate) SetFrozenBy(v string) *ProjectCreate {
+	_c.mutation.SetFrozenBy(v)
+	return _c
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableFrozenBy(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetFrozenBy(*v)
+	}
+	return _c
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_c *ProjectCreate) SetFreezeReason(v string) *ProjectCreate {
+	_c.mutation.SetFreezeReason(v)
+	return _c
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_c *ProjectCreate) SetNillableFreezeReason(v *string) *ProjectCreate {
+	if v != nil {
+		_c.SetFreezeReason(*v)
+	}
+	return _c
+}
+
 // AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
 func (_c *ProjectCreate) AddEnvironmentIDs(ids ...int) *ProjectCreate {
 	_c.mutation.AddEnvironmentIDs(ids...)
@@ -116,6 +175,51 @@ func (_c *ProjectCreate) AddAPITokens(v ...*ApiToken) *ProjectCreate {
 	return _c.AddAPITokenIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *ProjectCreate) AddTagIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *ProjectCreate) AddTags(v ...*Tag) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// AddStrategyDefinitionIDs adds the "strategy_definitions" edge to the StrategyDefinition entity by IDs.
+func (_c *ProjectCreate) AddStrategyDefinitionIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddStrategyDefinitionIDs(ids...)
+	return _c
+}
+
+// AddStrategyDefinitions adds the "strategy_definitions" edges to the StrategyDefinition entity.
+func (_c *ProjectCreate) AddStrategyDefinitions(v ...*StrategyDefinition) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddStrategyDefinitionIDs(ids...)
+}
+
+// AddContextFieldIDs adds the "context_fields" edge to the ContextField entity by IDs.
+func (_c *ProjectCreate) AddContextFieldIDs(ids ...int) *ProjectCreate {
+	_c.mutation.AddContextFieldIDs(ids...)
+	return _c
+}
+
+// AddContextFields adds the "context_fields" edges to the ContextField entity.
+func (_c *ProjectCreate) AddContextFields(v ...*ContextField) *ProjectCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddContextFieldIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_c *ProjectCreate) Mutation() *ProjectMutation {
 	return _c.mutation
@@ -214,6 +318,22 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
+	if value, ok := _c.mutation.FrozenAt(); ok {
+		_spec.SetField(project.FieldFrozenAt, field.TypeTime, value)
+		_node.FrozenAt = &value
+	}
+	if value, ok := _c.mutation.FrozenBy(); ok {
+		_spec.SetField(project.FieldFrozenBy, field.TypeString, value)
+		_node.FrozenBy = value
+	}
+	if value, ok := _c.mutation.FreezeReason(); ok {
+		_spec.SetField(project.FieldFreezeReason, field.TypeString, value)
+		_node.FreezeReason = value
+	}
 	if nodes := _c.mutation.EnvironmentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -262,6 +382,54 @@ func (_c *ProjectCreate) createSpec() (*Project, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StrategyDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ContextFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
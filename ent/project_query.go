@@ -13,22 +13,28 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // ProjectQuery is the builder for querying Project entities.
 type ProjectQuery struct {
 	config
-	ctx              *QueryContext
-	order            []project.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Project
-	withEnvironments *EnvironmentQuery
-	withFlags        *FlagQuery
-	withAPITokens    *ApiTokenQuery
+	ctx                     *QueryContext
+	order                   []project.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Project
+	withEnvironments        *EnvironmentQuery
+	withFlags               *FlagQuery
+	withAPITokens           *ApiTokenQuery
+	withTags                *TagQuery
+	withStrategyDefinitions *StrategyDefinitionQuery
+	withContextFields       *ContextFieldQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -131,6 +137,72 @@ func (_q *ProjectQuery) QueryAPITokens() *ApiTokenQuery {
 	return query
 }
 
+// QueryTags chains the current query on the "tags" edge.
+func (_q *ProjectQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.TagsTable, project.TagsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryStrategyDefinitions chains the current query on the "strategy_definitions" edge.
+func (_q *ProjectQuery) QueryStrategyDefinitions() *StrategyDefinitionQuery {
+	query := (&StrategyDefinitionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(strategydefinition.Table, strategydefinition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.StrategyDefinitionsTable, project.StrategyDefinitionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryContextFields chains the current query on the "context_fields" edge.
+func (_q *ProjectQuery) QueryContextFields() *ContextFieldQuery {
+	query := (&ContextFieldClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(project.Table, project.FieldID, selector),
+			sqlgraph.To(contextfield.Table, contextfield.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, project.ContextFieldsTable, project.ContextFieldsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Project entity from the query.
 // Returns a *NotFoundError when no Project was found.
 func (_q *ProjectQuery) First(ctx context.Context) (*Project, error) {
@@ -318,14 +390,17 @@ func (_q *ProjectQuery) Clone() *ProjectQuery {
 		return nil
 	}
 	return &ProjectQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]project.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Project{}, _q.predicates...),
-		withEnvironments: _q.withEnvironments.Clone(),
-		withFlags:        _q.withFlags.Clone(),
-		withAPITokens:    _q.withAPITokens.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]project.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.Project{}, _q.predicates...),
+		withEnvironments:        _q.withEnvironments.Clone(),
+		withFlags:               _q.withFlags.Clone(),
+		withAPITokens:           _q.withAPITokens.Clone(),
+		withTags:                _q.withTags.Clone(),
+		withStrategyDefinitions: _q.withStrategyDefinitions.Clone(),
+		withContextFields:       _q.withContextFields.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -365,6 +440,39 @@ func (_q *ProjectQuery) WithAPITokens(opts ...func(*ApiTokenQuery)) *ProjectQuer
 	return _q
 }
 
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithTags(opts ...func(*TagQuery)) *ProjectQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithStrategyDefinitions tells the query-builder to eager-load the nodes that are connected to
+// the "strategy_definitions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithStrategyDefinitions(opts ...func(*StrategyDefinitionQuery)) *ProjectQuery {
+	query := (&StrategyDefinitionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withStrategyDefinitions = query
+	return _q
+}
+
+// WithContextFields tells the query-builder to eager-load the nodes that are connected to
+// the "context_fields" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *ProjectQuery) WithContextFields(opts ...func(*ContextFieldQuery)) *ProjectQuery {
+	query := (&ContextFieldClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withContextFields = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -443,10 +551,13 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 	var (
 		nodes       = []*Project{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withEnvironments != nil,
 			_q.withFlags != nil,
 			_q.withAPITokens != nil,
+			_q.withTags != nil,
+			_q.withStrategyDefinitions != nil,
+			_q.withContextFields != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +599,29 @@ func (_q *ProjectQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Proj
 			return nil, err
 		}
 	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Project) { n.Edges.Tags = []*Tag{} },
+			func(n *Project, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withStrategyDefinitions; query != nil {
+		if err := _q.loadStrategyDefinitions(ctx, query, nodes,
+			func(n *Project) { n.Edges.StrategyDefinitions = []*StrategyDefinition{} },
+			func(n *Project, e *StrategyDefinition) {
+				n.Edges.StrategyDefinitions = append(n.Edges.StrategyDefinitions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withContextFields; query != nil {
+		if err := _q.loadContextFields(ctx, query, nodes,
+			func(n *Project) { n.Edges.ContextFields = []*ContextField{} },
+			func(n *Project, e *ContextField) { n.Edges.ContextFields = append(n.Edges.ContextFields, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -581,6 +715,96 @@ func (_q *ProjectQuery) loadAPITokens(ctx context.Context, query *ApiTokenQuery,
 	}
 	return nil
 }
+func (_q *ProjectQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Project, init func(*Project), assign func(*Project, *Tag)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(tag.FieldProjectID)
+	}
+	query.Where(predicate.Tag(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.TagsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProjectQuery) loadStrategyDefinitions(ctx context.Context, query *StrategyDefinitionQuery, nodes []*Project, init func(*Project), assign func(*Project, *StrategyDefinition)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(strategydefinition.FieldProjectID)
+	}
+	query.Where(predicate.StrategyDefinition(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.StrategyDefinitionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *ProjectQuery) loadContextFields(ctx context.Context, query *ContextFieldQuery, nodes []*Project, init func(*Project), assign func(*Project, *ContextField)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Project)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(contextfield.FieldProjectID)
+	}
+	query.Where(predicate.ContextField(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(project.ContextFieldsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ProjectID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "project_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *ProjectQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
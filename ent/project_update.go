@@ -12,10 +12,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 )
 
 // ProjectUpdate is the builder for updating Project entities.
@@ -71,6 +74,86 @@ func (_u *ProjectUpdate) SetUpdatedAt(v time.Time) *ProjectUpdate {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProjectUpdate) SetDeletedAt(v time.Time) *ProjectUpdate {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableDeletedAt(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProjectUpdate) ClearDeletedAt() *ProjectUpdate {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_u *ProjectUpdate) SetFrozenAt(v time.Time) *ProjectUpdate {
+	_u.mutation.SetFrozenAt(v)
+	return _u
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableFrozenAt(v *time.Time) *ProjectUpdate {
+	if v != nil {
+		_u.SetFrozenAt(*v)
+	}
+	return _u
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (_u *ProjectUpdate) ClearFrozenAt() *ProjectUpdate {
+	_u.mutation.ClearFrozenAt()
+	return _u
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_u *ProjectUpdate) SetFrozenBy(v string) *ProjectUpdate {
+	_u.mutation.SetFrozenBy(v)
+	return _u
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableFrozenBy(v *string) *ProjectUpdate {
+	if v != nil {
+		_u.SetFrozenBy(*v)
+	}
+	return _u
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (_u *ProjectUpdate) ClearFrozenBy() *ProjectUpdate {
+	_u.mutation.ClearFrozenBy()
+	return _u
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_u *ProjectUpdate) SetFreezeReason(v string) *ProjectUpdate {
+	_u.mutation.SetFreezeReason(v)
+	return _u
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_u *ProjectUpdate) SetNillableFreezeReason(v *string) *ProjectUpdate {
+	if v != nil {
+		_u.SetFreezeReason(*v)
+	}
+	return _u
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (_u *ProjectUpdate) ClearFreezeReason() *ProjectUpdate {
+	_u.mutation.ClearFreezeReason()
+	return _u
+}
+
 // AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
 func (_u *ProjectUpdate) AddEnvironmentIDs(ids ...int) *ProjectUpdate {
 	_u.mutation.AddEnvironmentIDs(ids...)
@@ -116,6 +199,51 @@ func (_u *ProjectUpdate) AddAPITokens(v ...*ApiToken) *ProjectUpdate {
 	return _u.AddAPITokenIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *ProjectUpdate) AddTagIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *ProjectUpdate) AddTags(v ...*Tag) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddStrategyDefinitionIDs adds the "strategy_definitions" edge to the StrategyDefinition entity by IDs.
+func (_u *ProjectUpdate) AddStrategyDefinitionIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddStrategyDefinitionIDs(ids...)
+	return _u
+}
+
+// AddStrategyDefinitions adds the "strategy_definitions" edges to the StrategyDefinition entity.
+func (_u *ProjectUpdate) AddStrategyDefinitions(v ...*StrategyDefinition) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStrategyDefinitionIDs(ids...)
+}
+
+// AddContextFieldIDs adds the "context_fields" edge to the ContextField entity by IDs.
+func (_u *ProjectUpdate) AddContextFieldIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.AddContextFieldIDs(ids...)
+	return _u
+}
+
+// AddContextFields adds the "context_fields" edges to the ContextField entity.
+func (_u *ProjectUpdate) AddContextFields(v ...*ContextField) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContextFieldIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_u *ProjectUpdate) Mutation() *ProjectMutation {
 	return _u.mutation
@@ -184,6 +312,69 @@ func (_u *ProjectUpdate) RemoveAPITokens(v ...*ApiToken) *ProjectUpdate {
 	return _u.RemoveAPITokenIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *ProjectUpdate) ClearTags() *ProjectUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *ProjectUpdate) RemoveTagIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *ProjectUpdate) RemoveTags(v ...*Tag) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearStrategyDefinitions clears all "strategy_definitions" edges to the StrategyDefinition entity.
+func (_u *ProjectUpdate) ClearStrategyDefinitions() *ProjectUpdate {
+	_u.mutation.ClearStrategyDefinitions()
+	return _u
+}
+
+// RemoveStrategyDefinitionIDs removes the "strategy_definitions" edge to StrategyDefinition entities by IDs.
+func (_u *ProjectUpdate) RemoveStrategyDefinitionIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveStrategyDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveStrategyDefinitions removes "strategy_definitions" edges to StrategyDefinition entities.
+func (_u *ProjectUpdate) RemoveStrategyDefinitions(v ...*StrategyDefinition) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStrategyDefinitionIDs(ids...)
+}
+
+// ClearContextFields clears all "context_fields" edges to the ContextField entity.
+func (_u *ProjectUpdate) ClearContextFields() *ProjectUpdate {
+	_u.mutation.ClearContextFields()
+	return _u
+}
+
+// RemoveContextFieldIDs removes the "context_fields" edge to ContextField entities by IDs.
+func (_u *ProjectUpdate) RemoveContextFieldIDs(ids ...int) *ProjectUpdate {
+	_u.mutation.RemoveContextFieldIDs(ids...)
+	return _u
+}
+
+// RemoveContextFields removes "context_fields" edges to ContextField entities.
+func (_u *ProjectUpdate) RemoveContextFields(v ...*ContextField) *ProjectUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContextFieldIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *ProjectUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -241,6 +432,30 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenAt(); ok {
+		_spec.SetField(project.FieldFrozenAt, field.TypeTime, value)
+	}
+	if _u.mutation.FrozenAtCleared() {
+		_spec.ClearField(project.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenBy(); ok {
+		_spec.SetField(project.FieldFrozenBy, field.TypeString, value)
+	}
+	if _u.mutation.FrozenByCleared() {
+		_spec.ClearField(project.FieldFrozenBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.FreezeReason(); ok {
+		_spec.SetField(project.FieldFreezeReason, field.TypeString, value)
+	}
+	if _u.mutation.FreezeReasonCleared() {
+		_spec.ClearField(project.FieldFreezeReason, field.TypeString)
+	}
 	if _u.mutation.EnvironmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -376,6 +591,141 @@ func (_u *ProjectUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StrategyDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStrategyDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.StrategyDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContextFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContextFieldsIDs(); len(nodes) > 0 && !_u.mutation.ContextFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContextFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{project.Label}
@@ -436,6 +786,86 @@ func (_u *ProjectUpdateOne) SetUpdatedAt(v time.Time) *ProjectUpdateOne {
 	return _u
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_u *ProjectUpdateOne) SetDeletedAt(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetDeletedAt(v)
+	return _u
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableDeletedAt(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetDeletedAt(*v)
+	}
+	return _u
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (_u *ProjectUpdateOne) ClearDeletedAt() *ProjectUpdateOne {
+	_u.mutation.ClearDeletedAt()
+	return _u
+}
+
+// SetFrozenAt sets the "frozen_at" field.
+func (_u *ProjectUpdateOne) SetFrozenAt(v time.Time) *ProjectUpdateOne {
+	_u.mutation.SetFrozenAt(v)
+	return _u
+}
+
+// SetNillableFrozenAt sets the "frozen_at" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableFrozenAt(v *time.Time) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetFrozenAt(*v)
+	}
+	return _u
+}
+
+// ClearFrozenAt clears the value of the "frozen_at" field.
+func (_u *ProjectUpdateOne) ClearFrozenAt() *ProjectUpdateOne {
+	_u.mutation.ClearFrozenAt()
+	return _u
+}
+
+// SetFrozenBy sets the "frozen_by" field.
+func (_u *ProjectUpdateOne) SetFrozenBy(v string) *ProjectUpdateOne {
+	_u.mutation.SetFrozenBy(v)
+	return _u
+}
+
+// SetNillableFrozenBy sets the "frozen_by" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableFrozenBy(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetFrozenBy(*v)
+	}
+	return _u
+}
+
+// ClearFrozenBy clears the value of the "frozen_by" field.
+func (_u *ProjectUpdateOne) ClearFrozenBy() *ProjectUpdateOne {
+	_u.mutation.ClearFrozenBy()
+	return _u
+}
+
+// SetFreezeReason sets the "freeze_reason" field.
+func (_u *ProjectUpdateOne) SetFreezeReason(v string) *ProjectUpdateOne {
+	_u.mutation.SetFreezeReason(v)
+	return _u
+}
+
+// SetNillableFreezeReason sets the "freeze_reason" field if the given value is not nil.
+func (_u *ProjectUpdateOne) SetNillableFreezeReason(v *string) *ProjectUpdateOne {
+	if v != nil {
+		_u.SetFreezeReason(*v)
+	}
+	return _u
+}
+
+// ClearFreezeReason clears the value of the "freeze_reason" field.
+func (_u *ProjectUpdateOne) ClearFreezeReason() *ProjectUpdateOne {
+	_u.mutation.ClearFreezeReason()
+	return _u
+}
+
 // AddEnvironmentIDs adds the "environments" edge to the Environment entity by IDs.
 func (_u *ProjectUpdateOne) AddEnvironmentIDs(ids ...int) *ProjectUpdateOne {
 	_u.mutation.AddEnvironmentIDs(ids...)
@@ -481,6 +911,51 @@ func (_u *ProjectUpdateOne) AddAPITokens(v ...*ApiToken) *ProjectUpdateOne {
 	return _u.AddAPITokenIDs(ids...)
 }
 
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *ProjectUpdateOne) AddTagIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *ProjectUpdateOne) AddTags(v ...*Tag) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// AddStrategyDefinitionIDs adds the "strategy_definitions" edge to the StrategyDefinition entity by IDs.
+func (_u *ProjectUpdateOne) AddStrategyDefinitionIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddStrategyDefinitionIDs(ids...)
+	return _u
+}
+
+// AddStrategyDefinitions adds the "strategy_definitions" edges to the StrategyDefinition entity.
+func (_u *ProjectUpdateOne) AddStrategyDefinitions(v ...*StrategyDefinition) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddStrategyDefinitionIDs(ids...)
+}
+
+// AddContextFieldIDs adds the "context_fields" edge to the ContextField entity by IDs.
+func (_u *ProjectUpdateOne) AddContextFieldIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.AddContextFieldIDs(ids...)
+	return _u
+}
+
+// AddContextFields adds the "context_fields" edges to the ContextField entity.
+func (_u *ProjectUpdateOne) AddContextFields(v ...*ContextField) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddContextFieldIDs(ids...)
+}
+
 // Mutation returns the ProjectMutation object of the builder.
 func (_u *ProjectUpdateOne) Mutation() *ProjectMutation {
 	return _u.mutation
@@ -549,6 +1024,69 @@ func (_u *ProjectUpdateOne) RemoveAPITokens(v ...*ApiToken) *ProjectUpdateOne {
 	return _u.RemoveAPITokenIDs(ids...)
 }
 
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *ProjectUpdateOne) ClearTags() *ProjectUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *ProjectUpdateOne) RemoveTagIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *ProjectUpdateOne) RemoveTags(v ...*Tag) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearStrategyDefinitions clears all "strategy_definitions" edges to the StrategyDefinition entity.
+func (_u *ProjectUpdateOne) ClearStrategyDefinitions() *ProjectUpdateOne {
+	_u.mutation.ClearStrategyDefinitions()
+	return _u
+}
+
+// RemoveStrategyDefinitionIDs removes the "strategy_definitions" edge to StrategyDefinition entities by IDs.
+func (_u *ProjectUpdateOne) RemoveStrategyDefinitionIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveStrategyDefinitionIDs(ids...)
+	return _u
+}
+
+// RemoveStrategyDefinitions removes "strategy_definitions" edges to StrategyDefinition entities.
+func (_u *ProjectUpdateOne) RemoveStrategyDefinitions(v ...*StrategyDefinition) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveStrategyDefinitionIDs(ids...)
+}
+
+// ClearContextFields clears all "context_fields" edges to the ContextField entity.
+func (_u *ProjectUpdateOne) ClearContextFields() *ProjectUpdateOne {
+	_u.mutation.ClearContextFields()
+	return _u
+}
+
+// RemoveContextFieldIDs removes the "context_fields" edge to ContextField entities by IDs.
+func (_u *ProjectUpdateOne) RemoveContextFieldIDs(ids ...int) *ProjectUpdateOne {
+	_u.mutation.RemoveContextFieldIDs(ids...)
+	return _u
+}
+
+// RemoveContextFields removes "context_fields" edges to ContextField entities.
+func (_u *ProjectUpdateOne) RemoveContextFields(v ...*ContextField) *ProjectUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveContextFieldIDs(ids...)
+}
+
 // Where appends a list predicates to the ProjectUpdate builder.
 func (_u *ProjectUpdateOne) Where(ps ...predicate.Project) *ProjectUpdateOne {
 	_u.mutation.Where(ps...)
@@ -636,6 +1174,30 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(project.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.DeletedAt(); ok {
+		_spec.SetField(project.FieldDeletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.DeletedAtCleared() {
+		_spec.ClearField(project.FieldDeletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenAt(); ok {
+		_spec.SetField(project.FieldFrozenAt, field.TypeTime, value)
+	}
+	if _u.mutation.FrozenAtCleared() {
+		_spec.ClearField(project.FieldFrozenAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.FrozenBy(); ok {
+		_spec.SetField(project.FieldFrozenBy, field.TypeString, value)
+	}
+	if _u.mutation.FrozenByCleared() {
+		_spec.ClearField(project.FieldFrozenBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.FreezeReason(); ok {
+		_spec.SetField(project.FieldFreezeReason, field.TypeString, value)
+	}
+	if _u.mutation.FreezeReasonCleared() {
+		_spec.ClearField(project.FieldFreezeReason, field.TypeString)
+	}
 	if _u.mutation.EnvironmentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -771,6 +1333,141 @@ func (_u *ProjectUpdateOne) sqlSave(ctx context.Context) (_node *Project, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.TagsTable,
+			Columns: []string{project.TagsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StrategyDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedStrategyDefinitionsIDs(); len(nodes) > 0 && !_u.mutation.StrategyDefinitionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyDefinitionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.StrategyDefinitionsTable,
+			Columns: []string{project.StrategyDefinitionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ContextFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedContextFieldsIDs(); len(nodes) > 0 && !_u.mutation.ContextFieldsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ContextFieldsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   project.ContextFieldsTable,
+			Columns: []string{project.ContextFieldsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(contextfield.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Project{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
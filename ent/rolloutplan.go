@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
+)
+
+// RolloutPlan is the model entity for the RolloutPlan schema.
+type RolloutPlan struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// FlagEnvironmentID holds the value of the "flag_environment_id" field.
+	FlagEnvironmentID int `json:"flag_environment_id,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID int `json:"strategy_id,omitempty"`
+	// Steps holds the value of the "steps" field.
+	Steps []schema.RolloutStep `json:"steps,omitempty"`
+	// CurrentStep holds the value of the "current_step" field.
+	CurrentStep int `json:"current_step,omitempty"`
+	// Status holds the value of the "status" field.
+	Status rolloutplan.Status `json:"status,omitempty"`
+	// StepStartedAt holds the value of the "step_started_at" field.
+	StepStartedAt time.Time `json:"step_started_at,omitempty"`
+	// PausedAt holds the value of the "paused_at" field.
+	PausedAt *time.Time `json:"paused_at,omitempty"`
+	// StatusReason holds the value of the "status_reason" field.
+	StatusReason string `json:"status_reason,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy string `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RolloutPlanQuery when eager-loading is set.
+	Edges        RolloutPlanEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RolloutPlanEdges holds the relations/edges for other nodes in the graph.
+type RolloutPlanEdges struct {
+	// FlagEnvironment holds the value of the flag_environment edge.
+	FlagEnvironment *FlagEnvironment `json:"flag_environment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FlagEnvironmentOrErr returns the FlagEnvironment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RolloutPlanEdges) FlagEnvironmentOrErr() (*FlagEnvironment, error) {
+	if e.FlagEnvironment != nil {
+		return e.FlagEnvironment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: flagenvironment.Label}
+	}
+	return nil, &NotLoadedError{edge: "flag_environment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RolloutPlan) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rolloutplan.FieldSteps:
+			values[i] = new([]byte)
+		case rolloutplan.FieldID, rolloutplan.FieldFlagEnvironmentID, rolloutplan.FieldStrategyID, rolloutplan.FieldCurrentStep:
+			values[i] = new(sql.NullInt64)
+		case rolloutplan.FieldStatus, rolloutplan.FieldStatusReason, rolloutplan.FieldCreatedBy:
+			values[i] = new(sql.NullString)
+		case rolloutplan.FieldStepStartedAt, rolloutplan.FieldPausedAt, rolloutplan.FieldCreatedAt, rolloutplan.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RolloutPlan fields.
+func (_m *RolloutPlan) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rolloutplan.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case rolloutplan.FieldFlagEnvironmentID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flag_environment_id", values[i])
+			} else if value.Valid {
+				_m.FlagEnvironmentID = int(value.Int64)
+			}
+		case rolloutplan.FieldStrategyID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value.Valid {
+				_m.StrategyID = int(value.Int64)
+			}
+		case rolloutplan.FieldSteps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field steps", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Steps); err != nil {
+					return fmt.Errorf("unmarshal field steps: %w", err)
+				}
+			}
+		case rolloutplan.FieldCurrentStep:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field current_step", values[i])
+			} else if value.Valid {
+				_m.CurrentStep = int(value.Int64)
+			}
+		case rolloutplan.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = rolloutplan.Status(value.String)
+			}
+		case rolloutplan.FieldStepStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field step_started_at", values[i])
+			} else if value.Valid {
+				_m.StepStartedAt = value.Time
+			}
+		case rolloutplan.FieldPausedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field paused_at", values[i])
+			} else if value.Valid {
+				_m.PausedAt = new(time.Time)
+				*_m.PausedAt = value.Time
+			}
+		case rolloutplan.FieldStatusReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status_reason", values[i])
+			} else if value.Valid {
+				_m.StatusReason = value.String
+			}
+		case rolloutplan.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				_m.CreatedBy = value.String
+			}
+		case rolloutplan.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case rolloutplan.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RolloutPlan.
+// This includes values selected through modifiers, order, etc.
+func (_m *RolloutPlan) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryFlagEnvironment queries the "flag_environment" edge of the RolloutPlan entity.
+func (_m *RolloutPlan) QueryFlagEnvironment() *FlagEnvironmentQuery {
+	return NewRolloutPlanClient(_m.config).QueryFlagEnvironment(_m)
+}
+
+// Update returns a builder for updating this RolloutPlan.
+// Note that you need to call RolloutPlan.Unwrap() before calling this method if this RolloutPlan
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RolloutPlan) Update() *RolloutPlanUpdateOne {
+	return NewRolloutPlanClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RolloutPlan entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RolloutPlan) Unwrap() *RolloutPlan {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RolloutPlan is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RolloutPlan) String() string {
+	var builder strings.Builder
+	builder.WriteString("RolloutPlan(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("flag_environment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlagEnvironmentID))
+	builder.WriteString(", ")
+	builder.WriteString("strategy_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StrategyID))
+	builder.WriteString(", ")
+	builder.WriteString("steps=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Steps))
+	builder.WriteString(", ")
+	builder.WriteString("current_step=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CurrentStep))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("step_started_at=")
+	builder.WriteString(_m.StepStartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.PausedAt; v != nil {
+		builder.WriteString("paused_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status_reason=")
+	builder.WriteString(_m.StatusReason)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(_m.CreatedBy)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RolloutPlans is a parsable slice of RolloutPlan.
+type RolloutPlans []*RolloutPlan
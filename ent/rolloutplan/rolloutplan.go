@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolloutplan
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the rolloutplan type in the database.
+	Label = "rollout_plan"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFlagEnvironmentID holds the string denoting the flag_environment_id field in the database.
+	FieldFlagEnvironmentID = "flag_environment_id"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldSteps holds the string denoting the steps field in the database.
+	FieldSteps = "steps"
+	// FieldCurrentStep holds the string denoting the current_step field in the database.
+	FieldCurrentStep = "current_step"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldStepStartedAt holds the string denoting the step_started_at field in the database.
+	FieldStepStartedAt = "step_started_at"
+	// FieldPausedAt holds the string denoting the paused_at field in the database.
+	FieldPausedAt = "paused_at"
+	// FieldStatusReason holds the string denoting the status_reason field in the database.
+	FieldStatusReason = "status_reason"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeFlagEnvironment holds the string denoting the flag_environment edge name in mutations.
+	EdgeFlagEnvironment = "flag_environment"
+	// Table holds the table name of the rolloutplan in the database.
+	Table = "rollout_plans"
+	// FlagEnvironmentTable is the table that holds the flag_environment relation/edge.
+	FlagEnvironmentTable = "rollout_plans"
+	// FlagEnvironmentInverseTable is the table name for the FlagEnvironment entity.
+	// It exists in this package in order to avoid circular dependency with the "flagenvironment" package.
+	FlagEnvironmentInverseTable = "flag_environments"
+	// FlagEnvironmentColumn is the table column denoting the flag_environment relation/edge.
+	FlagEnvironmentColumn = "flag_environment_id"
+)
+
+// Columns holds all SQL columns for rolloutplan fields.
+var Columns = []string{
+	FieldID,
+	FieldFlagEnvironmentID,
+	FieldStrategyID,
+	FieldSteps,
+	FieldCurrentStep,
+	FieldStatus,
+	FieldStepStartedAt,
+	FieldPausedAt,
+	FieldStatusReason,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCurrentStep holds the default value on creation for the "current_step" field.
+	DefaultCurrentStep int
+	// DefaultStepStartedAt holds the default value on creation for the "step_started_at" field.
+	DefaultStepStartedAt func() time.Time
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusRunning is the default value of the Status enum.
+const DefaultStatus = StatusRunning
+
+// Status values.
+const (
+	StatusRunning   Status = "running"
+	StatusPaused    Status = "paused"
+	StatusCompleted Status = "completed"
+	StatusAborted   Status = "aborted"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusRunning, StatusPaused, StatusCompleted, StatusAborted:
+		return nil
+	default:
+		return fmt.Errorf("rolloutplan: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the RolloutPlan queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentID orders the results by the flag_environment_id field.
+func ByFlagEnvironmentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlagEnvironmentID, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// ByCurrentStep orders the results by the current_step field.
+func ByCurrentStep(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrentStep, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByStepStartedAt orders the results by the step_started_at field.
+func ByStepStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStepStartedAt, opts...).ToFunc()
+}
+
+// ByPausedAt orders the results by the paused_at field.
+func ByPausedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPausedAt, opts...).ToFunc()
+}
+
+// ByStatusReason orders the results by the status_reason field.
+func ByStatusReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatusReason, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFlagEnvironmentField orders the results by flag_environment field.
+func ByFlagEnvironmentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFlagEnvironmentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newFlagEnvironmentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FlagEnvironmentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+	)
+}
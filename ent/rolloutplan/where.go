@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rolloutplan
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldID, id))
+}
+
+// FlagEnvironmentID applies equality check predicate on the "flag_environment_id" field. It's identical to FlagEnvironmentIDEQ.
+func FlagEnvironmentID(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// CurrentStep applies equality check predicate on the "current_step" field. It's identical to CurrentStepEQ.
+func CurrentStep(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCurrentStep, v))
+}
+
+// StepStartedAt applies equality check predicate on the "step_started_at" field. It's identical to StepStartedAtEQ.
+func StepStartedAt(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStepStartedAt, v))
+}
+
+// PausedAt applies equality check predicate on the "paused_at" field. It's identical to PausedAtEQ.
+func PausedAt(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// StatusReason applies equality check predicate on the "status_reason" field. It's identical to StatusReasonEQ.
+func StatusReason(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// FlagEnvironmentIDEQ applies the EQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDNEQ applies the NEQ predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldFlagEnvironmentID, v))
+}
+
+// FlagEnvironmentIDIn applies the In predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldFlagEnvironmentID, vs...))
+}
+
+// FlagEnvironmentIDNotIn applies the NotIn predicate on the "flag_environment_id" field.
+func FlagEnvironmentIDNotIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldFlagEnvironmentID, vs...))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDGT applies the GT predicate on the "strategy_id" field.
+func StrategyIDGT(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldStrategyID, v))
+}
+
+// StrategyIDGTE applies the GTE predicate on the "strategy_id" field.
+func StrategyIDGTE(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldStrategyID, v))
+}
+
+// StrategyIDLT applies the LT predicate on the "strategy_id" field.
+func StrategyIDLT(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldStrategyID, v))
+}
+
+// StrategyIDLTE applies the LTE predicate on the "strategy_id" field.
+func StrategyIDLTE(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldStrategyID, v))
+}
+
+// CurrentStepEQ applies the EQ predicate on the "current_step" field.
+func CurrentStepEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCurrentStep, v))
+}
+
+// CurrentStepNEQ applies the NEQ predicate on the "current_step" field.
+func CurrentStepNEQ(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldCurrentStep, v))
+}
+
+// CurrentStepIn applies the In predicate on the "current_step" field.
+func CurrentStepIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldCurrentStep, vs...))
+}
+
+// CurrentStepNotIn applies the NotIn predicate on the "current_step" field.
+func CurrentStepNotIn(vs ...int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldCurrentStep, vs...))
+}
+
+// CurrentStepGT applies the GT predicate on the "current_step" field.
+func CurrentStepGT(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldCurrentStep, v))
+}
+
+// CurrentStepGTE applies the GTE predicate on the "current_step" field.
+func CurrentStepGTE(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldCurrentStep, v))
+}
+
+// CurrentStepLT applies the LT predicate on the "current_step" field.
+func CurrentStepLT(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldCurrentStep, v))
+}
+
+// CurrentStepLTE applies the LTE predicate on the "current_step" field.
+func CurrentStepLTE(v int) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldCurrentStep, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StepStartedAtEQ applies the EQ predicate on the "step_started_at" field.
+func StepStartedAtEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStepStartedAt, v))
+}
+
+// StepStartedAtNEQ applies the NEQ predicate on the "step_started_at" field.
+func StepStartedAtNEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldStepStartedAt, v))
+}
+
+// StepStartedAtIn applies the In predicate on the "step_started_at" field.
+func StepStartedAtIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldStepStartedAt, vs...))
+}
+
+// StepStartedAtNotIn applies the NotIn predicate on the "step_started_at" field.
+func StepStartedAtNotIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldStepStartedAt, vs...))
+}
+
+// StepStartedAtGT applies the GT predicate on the "step_started_at" field.
+func StepStartedAtGT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldStepStartedAt, v))
+}
+
+// StepStartedAtGTE applies the GTE predicate on the "step_started_at" field.
+func StepStartedAtGTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldStepStartedAt, v))
+}
+
+// StepStartedAtLT applies the LT predicate on the "step_started_at" field.
+func StepStartedAtLT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldStepStartedAt, v))
+}
+
+// StepStartedAtLTE applies the LTE predicate on the "step_started_at" field.
+func StepStartedAtLTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldStepStartedAt, v))
+}
+
+// PausedAtEQ applies the EQ predicate on the "paused_at" field.
+func PausedAtEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldPausedAt, v))
+}
+
+// PausedAtNEQ applies the NEQ predicate on the "paused_at" field.
+func PausedAtNEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldPausedAt, v))
+}
+
+// PausedAtIn applies the In predicate on the "paused_at" field.
+func PausedAtIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldPausedAt, vs...))
+}
+
+// PausedAtNotIn applies the NotIn predicate on the "paused_at" field.
+func PausedAtNotIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldPausedAt, vs...))
+}
+
+// PausedAtGT applies the GT predicate on the "paused_at" field.
+func PausedAtGT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldPausedAt, v))
+}
+
+// PausedAtGTE applies the GTE predicate on the "paused_at" field.
+func PausedAtGTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldPausedAt, v))
+}
+
+// PausedAtLT applies the LT predicate on the "paused_at" field.
+func PausedAtLT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldPausedAt, v))
+}
+
+// PausedAtLTE applies the LTE predicate on the "paused_at" field.
+func PausedAtLTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldPausedAt, v))
+}
+
+// PausedAtIsNil applies the IsNil predicate on the "paused_at" field.
+func PausedAtIsNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIsNull(FieldPausedAt))
+}
+
+// PausedAtNotNil applies the NotNil predicate on the "paused_at" field.
+func PausedAtNotNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotNull(FieldPausedAt))
+}
+
+// StatusReasonEQ applies the EQ predicate on the "status_reason" field.
+func StatusReasonEQ(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldStatusReason, v))
+}
+
+// StatusReasonNEQ applies the NEQ predicate on the "status_reason" field.
+func StatusReasonNEQ(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldStatusReason, v))
+}
+
+// StatusReasonIn applies the In predicate on the "status_reason" field.
+func StatusReasonIn(vs ...string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonNotIn applies the NotIn predicate on the "status_reason" field.
+func StatusReasonNotIn(vs ...string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldStatusReason, vs...))
+}
+
+// StatusReasonGT applies the GT predicate on the "status_reason" field.
+func StatusReasonGT(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldStatusReason, v))
+}
+
+// StatusReasonGTE applies the GTE predicate on the "status_reason" field.
+func StatusReasonGTE(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldStatusReason, v))
+}
+
+// StatusReasonLT applies the LT predicate on the "status_reason" field.
+func StatusReasonLT(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldStatusReason, v))
+}
+
+// StatusReasonLTE applies the LTE predicate on the "status_reason" field.
+func StatusReasonLTE(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldStatusReason, v))
+}
+
+// StatusReasonContains applies the Contains predicate on the "status_reason" field.
+func StatusReasonContains(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldContains(FieldStatusReason, v))
+}
+
+// StatusReasonHasPrefix applies the HasPrefix predicate on the "status_reason" field.
+func StatusReasonHasPrefix(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldHasPrefix(FieldStatusReason, v))
+}
+
+// StatusReasonHasSuffix applies the HasSuffix predicate on the "status_reason" field.
+func StatusReasonHasSuffix(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldHasSuffix(FieldStatusReason, v))
+}
+
+// StatusReasonIsNil applies the IsNil predicate on the "status_reason" field.
+func StatusReasonIsNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIsNull(FieldStatusReason))
+}
+
+// StatusReasonNotNil applies the NotNil predicate on the "status_reason" field.
+func StatusReasonNotNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotNull(FieldStatusReason))
+}
+
+// StatusReasonEqualFold applies the EqualFold predicate on the "status_reason" field.
+func StatusReasonEqualFold(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEqualFold(FieldStatusReason, v))
+}
+
+// StatusReasonContainsFold applies the ContainsFold predicate on the "status_reason" field.
+func StatusReasonContainsFold(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldContainsFold(FieldStatusReason, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByContains applies the Contains predicate on the "created_by" field.
+func CreatedByContains(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldContains(FieldCreatedBy, v))
+}
+
+// CreatedByHasPrefix applies the HasPrefix predicate on the "created_by" field.
+func CreatedByHasPrefix(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldHasPrefix(FieldCreatedBy, v))
+}
+
+// CreatedByHasSuffix applies the HasSuffix predicate on the "created_by" field.
+func CreatedByHasSuffix(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldHasSuffix(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedByEqualFold applies the EqualFold predicate on the "created_by" field.
+func CreatedByEqualFold(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEqualFold(FieldCreatedBy, v))
+}
+
+// CreatedByContainsFold applies the ContainsFold predicate on the "created_by" field.
+func CreatedByContainsFold(v string) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldContainsFold(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasFlagEnvironment applies the HasEdge predicate on the "flag_environment" edge.
+func HasFlagEnvironment() predicate.RolloutPlan {
+	return predicate.RolloutPlan(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FlagEnvironmentTable, FlagEnvironmentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFlagEnvironmentWith applies the HasEdge predicate on the "flag_environment" edge with a given conditions (other predicates).
+func HasFlagEnvironmentWith(preds ...predicate.FlagEnvironment) predicate.RolloutPlan {
+	return predicate.RolloutPlan(func(s *sql.Selector) {
+		step := newFlagEnvironmentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RolloutPlan) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RolloutPlan) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RolloutPlan) predicate.RolloutPlan {
+	return predicate.RolloutPlan(sql.NotPredicates(p))
+}
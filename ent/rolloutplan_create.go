@@ -0,0 +1,423 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
+)
+
+// RolloutPlanCreate is the builder for creating a RolloutPlan entity.
+type RolloutPlanCreate struct {
+	config
+	mutation *RolloutPlanMutation
+	hooks    []Hook
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_c *RolloutPlanCreate) SetFlagEnvironmentID(v int) *RolloutPlanCreate {
+	_c.mutation.SetFlagEnvironmentID(v)
+	return _c
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *RolloutPlanCreate) SetStrategyID(v int) *RolloutPlanCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetSteps sets the "steps" field.
+func (_c *RolloutPlanCreate) SetSteps(v []schema.RolloutStep) *RolloutPlanCreate {
+	_c.mutation.SetSteps(v)
+	return _c
+}
+
+// SetCurrentStep sets the "current_step" field.
+func (_c *RolloutPlanCreate) SetCurrentStep(v int) *RolloutPlanCreate {
+	_c.mutation.SetCurrentStep(v)
+	return _c
+}
+
+// SetNillableCurrentStep sets the "current_step" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableCurrentStep(v *int) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetCurrentStep(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *RolloutPlanCreate) SetStatus(v rolloutplan.Status) *RolloutPlanCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableStatus(v *rolloutplan.Status) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetStepStartedAt sets the "step_started_at" field.
+func (_c *RolloutPlanCreate) SetStepStartedAt(v time.Time) *RolloutPlanCreate {
+	_c.mutation.SetStepStartedAt(v)
+	return _c
+}
+
+// SetNillableStepStartedAt sets the "step_started_at" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableStepStartedAt(v *time.Time) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetStepStartedAt(*v)
+	}
+	return _c
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_c *RolloutPlanCreate) SetPausedAt(v time.Time) *RolloutPlanCreate {
+	_c.mutation.SetPausedAt(v)
+	return _c
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillablePausedAt(v *time.Time) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetPausedAt(*v)
+	}
+	return _c
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_c *RolloutPlanCreate) SetStatusReason(v string) *RolloutPlanCreate {
+	_c.mutation.SetStatusReason(v)
+	return _c
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableStatusReason(v *string) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetStatusReason(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *RolloutPlanCreate) SetCreatedBy(v string) *RolloutPlanCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableCreatedBy(v *string) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetCreatedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RolloutPlanCreate) SetCreatedAt(v time.Time) *RolloutPlanCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableCreatedAt(v *time.Time) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RolloutPlanCreate) SetUpdatedAt(v time.Time) *RolloutPlanCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RolloutPlanCreate) SetNillableUpdatedAt(v *time.Time) *RolloutPlanCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_c *RolloutPlanCreate) SetFlagEnvironment(v *FlagEnvironment) *RolloutPlanCreate {
+	return _c.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the RolloutPlanMutation object of the builder.
+func (_c *RolloutPlanCreate) Mutation() *RolloutPlanMutation {
+	return _c.mutation
+}
+
+// Save creates the RolloutPlan in the database.
+func (_c *RolloutPlanCreate) Save(ctx context.Context) (*RolloutPlan, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RolloutPlanCreate) SaveX(ctx context.Context) *RolloutPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RolloutPlanCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RolloutPlanCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RolloutPlanCreate) defaults() {
+	if _, ok := _c.mutation.CurrentStep(); !ok {
+		v := rolloutplan.DefaultCurrentStep
+		_c.mutation.SetCurrentStep(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := rolloutplan.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.StepStartedAt(); !ok {
+		v := rolloutplan.DefaultStepStartedAt()
+		_c.mutation.SetStepStartedAt(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := rolloutplan.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := rolloutplan.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RolloutPlanCreate) check() error {
+	if _, ok := _c.mutation.FlagEnvironmentID(); !ok {
+		return &ValidationError{Name: "flag_environment_id", err: errors.New(`ent: missing required field "RolloutPlan.flag_environment_id"`)}
+	}
+	if _, ok := _c.mutation.StrategyID(); !ok {
+		return &ValidationError{Name: "strategy_id", err: errors.New(`ent: missing required field "RolloutPlan.strategy_id"`)}
+	}
+	if _, ok := _c.mutation.Steps(); !ok {
+		return &ValidationError{Name: "steps", err: errors.New(`ent: missing required field "RolloutPlan.steps"`)}
+	}
+	if _, ok := _c.mutation.CurrentStep(); !ok {
+		return &ValidationError{Name: "current_step", err: errors.New(`ent: missing required field "RolloutPlan.current_step"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RolloutPlan.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := rolloutplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RolloutPlan.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StepStartedAt(); !ok {
+		return &ValidationError{Name: "step_started_at", err: errors.New(`ent: missing required field "RolloutPlan.step_started_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RolloutPlan.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RolloutPlan.updated_at"`)}
+	}
+	if len(_c.mutation.FlagEnvironmentIDs()) == 0 {
+		return &ValidationError{Name: "flag_environment", err: errors.New(`ent: missing required edge "RolloutPlan.flag_environment"`)}
+	}
+	return nil
+}
+
+func (_c *RolloutPlanCreate) sqlSave(ctx context.Context) (*RolloutPlan, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RolloutPlanCreate) createSpec() (*RolloutPlan, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RolloutPlan{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(rolloutplan.Table, sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StrategyID(); ok {
+		_spec.SetField(rolloutplan.FieldStrategyID, field.TypeInt, value)
+		_node.StrategyID = value
+	}
+	if value, ok := _c.mutation.Steps(); ok {
+		_spec.SetField(rolloutplan.FieldSteps, field.TypeJSON, value)
+		_node.Steps = value
+	}
+	if value, ok := _c.mutation.CurrentStep(); ok {
+		_spec.SetField(rolloutplan.FieldCurrentStep, field.TypeInt, value)
+		_node.CurrentStep = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(rolloutplan.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.StepStartedAt(); ok {
+		_spec.SetField(rolloutplan.FieldStepStartedAt, field.TypeTime, value)
+		_node.StepStartedAt = value
+	}
+	if value, ok := _c.mutation.PausedAt(); ok {
+		_spec.SetField(rolloutplan.FieldPausedAt, field.TypeTime, value)
+		_node.PausedAt = &value
+	}
+	if value, ok := _c.mutation.StatusReason(); ok {
+		_spec.SetField(rolloutplan.FieldStatusReason, field.TypeString, value)
+		_node.StatusReason = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(rolloutplan.FieldCreatedBy, field.TypeString, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(rolloutplan.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolloutplan.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolloutplan.FlagEnvironmentTable,
+			Columns: []string{rolloutplan.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FlagEnvironmentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RolloutPlanCreateBulk is the builder for creating many RolloutPlan entities in bulk.
+type RolloutPlanCreateBulk struct {
+	config
+	err      error
+	builders []*RolloutPlanCreate
+}
+
+// Save creates the RolloutPlan entities in the database.
+func (_c *RolloutPlanCreateBulk) Save(ctx context.Context) ([]*RolloutPlan, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RolloutPlan, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RolloutPlanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RolloutPlanCreateBulk) SaveX(ctx context.Context) []*RolloutPlan {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RolloutPlanCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RolloutPlanCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
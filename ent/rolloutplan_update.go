@@ -0,0 +1,727 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
+)
+
+// RolloutPlanUpdate is the builder for updating RolloutPlan entities.
+type RolloutPlanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RolloutPlanMutation
+}
+
+// Where appends a list predicates to the RolloutPlanUpdate builder.
+func (_u *RolloutPlanUpdate) Where(ps ...predicate.RolloutPlan) *RolloutPlanUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *RolloutPlanUpdate) SetFlagEnvironmentID(v int) *RolloutPlanUpdate {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableFlagEnvironmentID(v *int) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *RolloutPlanUpdate) SetStrategyID(v int) *RolloutPlanUpdate {
+	_u.mutation.ResetStrategyID()
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableStrategyID(v *int) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// AddStrategyID adds value to the "strategy_id" field.
+func (_u *RolloutPlanUpdate) AddStrategyID(v int) *RolloutPlanUpdate {
+	_u.mutation.AddStrategyID(v)
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *RolloutPlanUpdate) SetSteps(v []schema.RolloutStep) *RolloutPlanUpdate {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *RolloutPlanUpdate) AppendSteps(v []schema.RolloutStep) *RolloutPlanUpdate {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetCurrentStep sets the "current_step" field.
+func (_u *RolloutPlanUpdate) SetCurrentStep(v int) *RolloutPlanUpdate {
+	_u.mutation.ResetCurrentStep()
+	_u.mutation.SetCurrentStep(v)
+	return _u
+}
+
+// SetNillableCurrentStep sets the "current_step" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableCurrentStep(v *int) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetCurrentStep(*v)
+	}
+	return _u
+}
+
+// AddCurrentStep adds value to the "current_step" field.
+func (_u *RolloutPlanUpdate) AddCurrentStep(v int) *RolloutPlanUpdate {
+	_u.mutation.AddCurrentStep(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RolloutPlanUpdate) SetStatus(v rolloutplan.Status) *RolloutPlanUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableStatus(v *rolloutplan.Status) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStepStartedAt sets the "step_started_at" field.
+func (_u *RolloutPlanUpdate) SetStepStartedAt(v time.Time) *RolloutPlanUpdate {
+	_u.mutation.SetStepStartedAt(v)
+	return _u
+}
+
+// SetNillableStepStartedAt sets the "step_started_at" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableStepStartedAt(v *time.Time) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetStepStartedAt(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *RolloutPlanUpdate) SetPausedAt(v time.Time) *RolloutPlanUpdate {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillablePausedAt(v *time.Time) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *RolloutPlanUpdate) ClearPausedAt() *RolloutPlanUpdate {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *RolloutPlanUpdate) SetStatusReason(v string) *RolloutPlanUpdate {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableStatusReason(v *string) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *RolloutPlanUpdate) ClearStatusReason() *RolloutPlanUpdate {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RolloutPlanUpdate) SetCreatedBy(v string) *RolloutPlanUpdate {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RolloutPlanUpdate) SetNillableCreatedBy(v *string) *RolloutPlanUpdate {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RolloutPlanUpdate) ClearCreatedBy() *RolloutPlanUpdate {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RolloutPlanUpdate) SetUpdatedAt(v time.Time) *RolloutPlanUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *RolloutPlanUpdate) SetFlagEnvironment(v *FlagEnvironment) *RolloutPlanUpdate {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the RolloutPlanMutation object of the builder.
+func (_u *RolloutPlanUpdate) Mutation() *RolloutPlanMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *RolloutPlanUpdate) ClearFlagEnvironment() *RolloutPlanUpdate {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RolloutPlanUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RolloutPlanUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RolloutPlanUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RolloutPlanUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RolloutPlanUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rolloutplan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RolloutPlanUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := rolloutplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RolloutPlan.status": %w`, err)}
+		}
+	}
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RolloutPlan.flag_environment"`)
+	}
+	return nil
+}
+
+func (_u *RolloutPlanUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolloutplan.Table, rolloutplan.Columns, sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(rolloutplan.FieldStrategyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStrategyID(); ok {
+		_spec.AddField(rolloutplan.FieldStrategyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(rolloutplan.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rolloutplan.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.CurrentStep(); ok {
+		_spec.SetField(rolloutplan.FieldCurrentStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentStep(); ok {
+		_spec.AddField(rolloutplan.FieldCurrentStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(rolloutplan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StepStartedAt(); ok {
+		_spec.SetField(rolloutplan.FieldStepStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(rolloutplan.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(rolloutplan.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(rolloutplan.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(rolloutplan.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(rolloutplan.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(rolloutplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolloutplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolloutplan.FlagEnvironmentTable,
+			Columns: []string{rolloutplan.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolloutplan.FlagEnvironmentTable,
+			Columns: []string{rolloutplan.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolloutplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RolloutPlanUpdateOne is the builder for updating a single RolloutPlan entity.
+type RolloutPlanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RolloutPlanMutation
+}
+
+// SetFlagEnvironmentID sets the "flag_environment_id" field.
+func (_u *RolloutPlanUpdateOne) SetFlagEnvironmentID(v int) *RolloutPlanUpdateOne {
+	_u.mutation.SetFlagEnvironmentID(v)
+	return _u
+}
+
+// SetNillableFlagEnvironmentID sets the "flag_environment_id" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableFlagEnvironmentID(v *int) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetFlagEnvironmentID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *RolloutPlanUpdateOne) SetStrategyID(v int) *RolloutPlanUpdateOne {
+	_u.mutation.ResetStrategyID()
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableStrategyID(v *int) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// AddStrategyID adds value to the "strategy_id" field.
+func (_u *RolloutPlanUpdateOne) AddStrategyID(v int) *RolloutPlanUpdateOne {
+	_u.mutation.AddStrategyID(v)
+	return _u
+}
+
+// SetSteps sets the "steps" field.
+func (_u *RolloutPlanUpdateOne) SetSteps(v []schema.RolloutStep) *RolloutPlanUpdateOne {
+	_u.mutation.SetSteps(v)
+	return _u
+}
+
+// AppendSteps appends value to the "steps" field.
+func (_u *RolloutPlanUpdateOne) AppendSteps(v []schema.RolloutStep) *RolloutPlanUpdateOne {
+	_u.mutation.AppendSteps(v)
+	return _u
+}
+
+// SetCurrentStep sets the "current_step" field.
+func (_u *RolloutPlanUpdateOne) SetCurrentStep(v int) *RolloutPlanUpdateOne {
+	_u.mutation.ResetCurrentStep()
+	_u.mutation.SetCurrentStep(v)
+	return _u
+}
+
+// SetNillableCurrentStep sets the "current_step" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableCurrentStep(v *int) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetCurrentStep(*v)
+	}
+	return _u
+}
+
+// AddCurrentStep adds value to the "current_step" field.
+func (_u *RolloutPlanUpdateOne) AddCurrentStep(v int) *RolloutPlanUpdateOne {
+	_u.mutation.AddCurrentStep(v)
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *RolloutPlanUpdateOne) SetStatus(v rolloutplan.Status) *RolloutPlanUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableStatus(v *rolloutplan.Status) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetStepStartedAt sets the "step_started_at" field.
+func (_u *RolloutPlanUpdateOne) SetStepStartedAt(v time.Time) *RolloutPlanUpdateOne {
+	_u.mutation.SetStepStartedAt(v)
+	return _u
+}
+
+// SetNillableStepStartedAt sets the "step_started_at" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableStepStartedAt(v *time.Time) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetStepStartedAt(*v)
+	}
+	return _u
+}
+
+// SetPausedAt sets the "paused_at" field.
+func (_u *RolloutPlanUpdateOne) SetPausedAt(v time.Time) *RolloutPlanUpdateOne {
+	_u.mutation.SetPausedAt(v)
+	return _u
+}
+
+// SetNillablePausedAt sets the "paused_at" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillablePausedAt(v *time.Time) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetPausedAt(*v)
+	}
+	return _u
+}
+
+// ClearPausedAt clears the value of the "paused_at" field.
+func (_u *RolloutPlanUpdateOne) ClearPausedAt() *RolloutPlanUpdateOne {
+	_u.mutation.ClearPausedAt()
+	return _u
+}
+
+// SetStatusReason sets the "status_reason" field.
+func (_u *RolloutPlanUpdateOne) SetStatusReason(v string) *RolloutPlanUpdateOne {
+	_u.mutation.SetStatusReason(v)
+	return _u
+}
+
+// SetNillableStatusReason sets the "status_reason" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableStatusReason(v *string) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetStatusReason(*v)
+	}
+	return _u
+}
+
+// ClearStatusReason clears the value of the "status_reason" field.
+func (_u *RolloutPlanUpdateOne) ClearStatusReason() *RolloutPlanUpdateOne {
+	_u.mutation.ClearStatusReason()
+	return _u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_u *RolloutPlanUpdateOne) SetCreatedBy(v string) *RolloutPlanUpdateOne {
+	_u.mutation.SetCreatedBy(v)
+	return _u
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (_u *RolloutPlanUpdateOne) SetNillableCreatedBy(v *string) *RolloutPlanUpdateOne {
+	if v != nil {
+		_u.SetCreatedBy(*v)
+	}
+	return _u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (_u *RolloutPlanUpdateOne) ClearCreatedBy() *RolloutPlanUpdateOne {
+	_u.mutation.ClearCreatedBy()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RolloutPlanUpdateOne) SetUpdatedAt(v time.Time) *RolloutPlanUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetFlagEnvironment sets the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *RolloutPlanUpdateOne) SetFlagEnvironment(v *FlagEnvironment) *RolloutPlanUpdateOne {
+	return _u.SetFlagEnvironmentID(v.ID)
+}
+
+// Mutation returns the RolloutPlanMutation object of the builder.
+func (_u *RolloutPlanUpdateOne) Mutation() *RolloutPlanMutation {
+	return _u.mutation
+}
+
+// ClearFlagEnvironment clears the "flag_environment" edge to the FlagEnvironment entity.
+func (_u *RolloutPlanUpdateOne) ClearFlagEnvironment() *RolloutPlanUpdateOne {
+	_u.mutation.ClearFlagEnvironment()
+	return _u
+}
+
+// Where appends a list predicates to the RolloutPlanUpdate builder.
+func (_u *RolloutPlanUpdateOne) Where(ps ...predicate.RolloutPlan) *RolloutPlanUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RolloutPlanUpdateOne) Select(field string, fields ...string) *RolloutPlanUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RolloutPlan entity.
+func (_u *RolloutPlanUpdateOne) Save(ctx context.Context) (*RolloutPlan, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RolloutPlanUpdateOne) SaveX(ctx context.Context) *RolloutPlan {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RolloutPlanUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RolloutPlanUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RolloutPlanUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := rolloutplan.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RolloutPlanUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := rolloutplan.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "RolloutPlan.status": %w`, err)}
+		}
+	}
+	if _u.mutation.FlagEnvironmentCleared() && len(_u.mutation.FlagEnvironmentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RolloutPlan.flag_environment"`)
+	}
+	return nil
+}
+
+func (_u *RolloutPlanUpdateOne) sqlSave(ctx context.Context) (_node *RolloutPlan, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(rolloutplan.Table, rolloutplan.Columns, sqlgraph.NewFieldSpec(rolloutplan.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RolloutPlan.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rolloutplan.FieldID)
+		for _, f := range fields {
+			if !rolloutplan.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rolloutplan.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(rolloutplan.FieldStrategyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStrategyID(); ok {
+		_spec.AddField(rolloutplan.FieldStrategyID, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Steps(); ok {
+		_spec.SetField(rolloutplan.FieldSteps, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSteps(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, rolloutplan.FieldSteps, value)
+		})
+	}
+	if value, ok := _u.mutation.CurrentStep(); ok {
+		_spec.SetField(rolloutplan.FieldCurrentStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCurrentStep(); ok {
+		_spec.AddField(rolloutplan.FieldCurrentStep, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(rolloutplan.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StepStartedAt(); ok {
+		_spec.SetField(rolloutplan.FieldStepStartedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PausedAt(); ok {
+		_spec.SetField(rolloutplan.FieldPausedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PausedAtCleared() {
+		_spec.ClearField(rolloutplan.FieldPausedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.StatusReason(); ok {
+		_spec.SetField(rolloutplan.FieldStatusReason, field.TypeString, value)
+	}
+	if _u.mutation.StatusReasonCleared() {
+		_spec.ClearField(rolloutplan.FieldStatusReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.CreatedBy(); ok {
+		_spec.SetField(rolloutplan.FieldCreatedBy, field.TypeString, value)
+	}
+	if _u.mutation.CreatedByCleared() {
+		_spec.ClearField(rolloutplan.FieldCreatedBy, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(rolloutplan.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.FlagEnvironmentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolloutplan.FlagEnvironmentTable,
+			Columns: []string{rolloutplan.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FlagEnvironmentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   rolloutplan.FlagEnvironmentTable,
+			Columns: []string{rolloutplan.FlagEnvironmentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(flagenvironment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RolloutPlan{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rolloutplan.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,12 +7,18 @@ import (
 
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	"github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
 	"github.com/felipekafuri/bandeira/ent/schema"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/ent/user"
 )
 
@@ -56,6 +62,18 @@ func init() {
 	constraint.DefaultUpdatedAt = constraintDescUpdatedAt.Default.(func() time.Time)
 	// constraint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	constraint.UpdateDefaultUpdatedAt = constraintDescUpdatedAt.UpdateDefault.(func() time.Time)
+	contextfieldFields := schema.ContextField{}.Fields()
+	_ = contextfieldFields
+	// contextfieldDescCreatedAt is the schema descriptor for created_at field.
+	contextfieldDescCreatedAt := contextfieldFields[5].Descriptor()
+	// contextfield.DefaultCreatedAt holds the default value on creation for the created_at field.
+	contextfield.DefaultCreatedAt = contextfieldDescCreatedAt.Default.(func() time.Time)
+	// contextfieldDescUpdatedAt is the schema descriptor for updated_at field.
+	contextfieldDescUpdatedAt := contextfieldFields[6].Descriptor()
+	// contextfield.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	contextfield.DefaultUpdatedAt = contextfieldDescUpdatedAt.Default.(func() time.Time)
+	// contextfield.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	contextfield.UpdateDefaultUpdatedAt = contextfieldDescUpdatedAt.UpdateDefault.(func() time.Time)
 	environmentFields := schema.Environment{}.Fields()
 	_ = environmentFields
 	// environmentDescSortOrder is the schema descriptor for sort_order field.
@@ -100,6 +118,26 @@ func init() {
 	flagenvironment.DefaultUpdatedAt = flagenvironmentDescUpdatedAt.Default.(func() time.Time)
 	// flagenvironment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	flagenvironment.UpdateDefaultUpdatedAt = flagenvironmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// flagenvironmentDescRevision is the schema descriptor for revision field.
+	flagenvironmentDescRevision := flagenvironmentFields[5].Descriptor()
+	// flagenvironment.DefaultRevision holds the default value on creation for the revision field.
+	flagenvironment.DefaultRevision = flagenvironmentDescRevision.Default.(int)
+	flagenvironmentversionFields := schema.FlagEnvironmentVersion{}.Fields()
+	_ = flagenvironmentversionFields
+	// flagenvironmentversionDescCreatedAt is the schema descriptor for created_at field.
+	flagenvironmentversionDescCreatedAt := flagenvironmentversionFields[4].Descriptor()
+	// flagenvironmentversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	flagenvironmentversion.DefaultCreatedAt = flagenvironmentversionDescCreatedAt.Default.(func() time.Time)
+	prerequisiteFields := schema.Prerequisite{}.Fields()
+	_ = prerequisiteFields
+	// prerequisiteDescEnabled is the schema descriptor for enabled field.
+	prerequisiteDescEnabled := prerequisiteFields[2].Descriptor()
+	// prerequisite.DefaultEnabled holds the default value on creation for the enabled field.
+	prerequisite.DefaultEnabled = prerequisiteDescEnabled.Default.(bool)
+	// prerequisiteDescCreatedAt is the schema descriptor for created_at field.
+	prerequisiteDescCreatedAt := prerequisiteFields[4].Descriptor()
+	// prerequisite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	prerequisite.DefaultCreatedAt = prerequisiteDescCreatedAt.Default.(func() time.Time)
 	projectFields := schema.Project{}.Fields()
 	_ = projectFields
 	// projectDescCreatedAt is the schema descriptor for created_at field.
@@ -112,6 +150,26 @@ func init() {
 	project.DefaultUpdatedAt = projectDescUpdatedAt.Default.(func() time.Time)
 	// project.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	project.UpdateDefaultUpdatedAt = projectDescUpdatedAt.UpdateDefault.(func() time.Time)
+	rolloutplanFields := schema.RolloutPlan{}.Fields()
+	_ = rolloutplanFields
+	// rolloutplanDescCurrentStep is the schema descriptor for current_step field.
+	rolloutplanDescCurrentStep := rolloutplanFields[3].Descriptor()
+	// rolloutplan.DefaultCurrentStep holds the default value on creation for the current_step field.
+	rolloutplan.DefaultCurrentStep = rolloutplanDescCurrentStep.Default.(int)
+	// rolloutplanDescStepStartedAt is the schema descriptor for step_started_at field.
+	rolloutplanDescStepStartedAt := rolloutplanFields[5].Descriptor()
+	// rolloutplan.DefaultStepStartedAt holds the default value on creation for the step_started_at field.
+	rolloutplan.DefaultStepStartedAt = rolloutplanDescStepStartedAt.Default.(func() time.Time)
+	// rolloutplanDescCreatedAt is the schema descriptor for created_at field.
+	rolloutplanDescCreatedAt := rolloutplanFields[9].Descriptor()
+	// rolloutplan.DefaultCreatedAt holds the default value on creation for the created_at field.
+	rolloutplan.DefaultCreatedAt = rolloutplanDescCreatedAt.Default.(func() time.Time)
+	// rolloutplanDescUpdatedAt is the schema descriptor for updated_at field.
+	rolloutplanDescUpdatedAt := rolloutplanFields[10].Descriptor()
+	// rolloutplan.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rolloutplan.DefaultUpdatedAt = rolloutplanDescUpdatedAt.Default.(func() time.Time)
+	// rolloutplan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rolloutplan.UpdateDefaultUpdatedAt = rolloutplanDescUpdatedAt.UpdateDefault.(func() time.Time)
 	strategyFields := schema.Strategy{}.Fields()
 	_ = strategyFields
 	// strategyDescSortOrder is the schema descriptor for sort_order field.
@@ -128,6 +186,24 @@ func init() {
 	strategy.DefaultUpdatedAt = strategyDescUpdatedAt.Default.(func() time.Time)
 	// strategy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	strategy.UpdateDefaultUpdatedAt = strategyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	strategydefinitionFields := schema.StrategyDefinition{}.Fields()
+	_ = strategydefinitionFields
+	// strategydefinitionDescCreatedAt is the schema descriptor for created_at field.
+	strategydefinitionDescCreatedAt := strategydefinitionFields[4].Descriptor()
+	// strategydefinition.DefaultCreatedAt holds the default value on creation for the created_at field.
+	strategydefinition.DefaultCreatedAt = strategydefinitionDescCreatedAt.Default.(func() time.Time)
+	// strategydefinitionDescUpdatedAt is the schema descriptor for updated_at field.
+	strategydefinitionDescUpdatedAt := strategydefinitionFields[5].Descriptor()
+	// strategydefinition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	strategydefinition.DefaultUpdatedAt = strategydefinitionDescUpdatedAt.Default.(func() time.Time)
+	// strategydefinition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	strategydefinition.UpdateDefaultUpdatedAt = strategydefinitionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescCreatedAt is the schema descriptor for created_at field.
+	tagDescCreatedAt := tagFields[2].Descriptor()
+	// tag.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tag.DefaultCreatedAt = tagDescCreatedAt.Default.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
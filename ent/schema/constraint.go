@@ -21,6 +21,9 @@ func (Constraint) Fields() []ent.Field {
 			"STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH",
 			"NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE",
 			"DATE_AFTER", "DATE_BEFORE",
+			"SEMVER_EQ", "SEMVER_GT", "SEMVER_LT",
+			"REGEX", "IN_CIDR",
+			"ANY_OF", "ALL_OF",
 		),
 		field.JSON("values", []string{}),
 		field.Bool("inverted").Default(false),
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ContextField holds the schema definition for the ContextField entity: a
+// field of the evaluation context that constraints of a project may test.
+type ContextField struct {
+	ent.Schema
+}
+
+func (ContextField) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("description").Optional(),
+		field.Enum("type").Values("string", "number", "date", "semver", "ip").Default("string"),
+		// legal_values, when set, are the only values constraints may list.
+		field.JSON("legal_values", []string{}).Optional(),
+		field.Int("project_id"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (ContextField) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("context_fields").
+			Field("project_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (ContextField) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "project_id").Unique(),
+	}
+}
@@ -22,6 +22,18 @@ func (Environment) Fields() []ent.Field {
 		field.Int("project_id"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// expected_match_id declares that this environment should have the
+		// same flag configuration as another one (e.g. staging mirrors
+		// production); differences are reported as drift.
+		field.Int("expected_match_id").Optional().Nillable(),
+		// deleted_at marks an environment in the trash.
+		field.Time("deleted_at").Optional().Nillable(),
+		// frozen_at is set while the environment is frozen: changes by users other
+		// than admins are rejected until it is lifted. frozen_by and
+		// freeze_reason record who froze it and why.
+		field.Time("frozen_at").Optional().Nillable(),
+		field.String("frozen_by").Optional(),
+		field.String("freeze_reason").Optional(),
 	}
 }
 
@@ -33,6 +45,10 @@ func (Environment) Edges() []ent.Edge {
 			Required().
 			Unique(),
 		edge.To("flag_environments", FlagEnvironment.Type),
+		edge.To("matched_by", Environment.Type).
+			From("expected_match").
+			Field("expected_match_id").
+			Unique(),
 	}
 }
 
@@ -9,6 +9,13 @@ import (
 	"entgo.io/ent/schema/index"
 )
 
+// Link points from a flag to a related resource such as a ticket, a pull
+// request or a design doc.
+type Link struct {
+	Title string `json:"title,omitempty"`
+	URL   string `json:"url"`
+}
+
 // Flag holds the schema definition for the Flag entity.
 type Flag struct {
 	ent.Schema
@@ -22,6 +29,15 @@ func (Flag) Fields() []ent.Field {
 		field.Int("project_id"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// deleted_at marks a flag in the trash: it leaves the client payload
+		// but keeps its name until purged.
+		field.Time("deleted_at").Optional().Nillable(),
+		// owner is the user or team responsible for the flag.
+		field.String("owner").Optional(),
+		field.JSON("links", []Link{}).Optional(),
+		// metadata holds arbitrary key/value pairs for tooling that wants to
+		// attach its own data to a flag.
+		field.JSON("metadata", map[string]string{}).Optional(),
 	}
 }
 
@@ -33,6 +49,9 @@ func (Flag) Edges() []ent.Edge {
 			Required().
 			Unique(),
 		edge.To("flag_environments", FlagEnvironment.Type),
+		// dependents are the prerequisites that name this flag as parent.
+		edge.To("dependents", Prerequisite.Type),
+		edge.To("tags", Tag.Type),
 	}
 }
 
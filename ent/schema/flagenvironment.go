@@ -21,6 +21,9 @@ func (FlagEnvironment) Fields() []ent.Field {
 		field.Int("environment_id"),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// revision is bumped by every change to the config (enabled state,
+		// strategies, prerequisites) and guards against lost updates.
+		field.Int("revision").Default(1),
 	}
 }
 
@@ -37,6 +40,9 @@ func (FlagEnvironment) Edges() []ent.Edge {
 			Required().
 			Unique(),
 		edge.To("strategies", Strategy.Type),
+		edge.To("prerequisites", Prerequisite.Type),
+		edge.To("versions", FlagEnvironmentVersion.Type),
+		edge.To("rollout_plans", RolloutPlan.Type),
 	}
 }
 
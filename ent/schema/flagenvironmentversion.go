@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FlagEnvironmentVersion holds the schema definition for the
+// FlagEnvironmentVersion entity. It is an immutable snapshot of a flag's full
+// configuration in one environment, recorded on every change.
+type FlagEnvironmentVersion struct {
+	ent.Schema
+}
+
+func (FlagEnvironmentVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("flag_environment_id"),
+		// version numbers the snapshots of one flag environment from 1.
+		field.Int("version").Immutable(),
+		// snapshot is the configuration in its declarative document form:
+		// enabled, strategies with parameters and constraints, and
+		// prerequisites.
+		field.JSON("snapshot", json.RawMessage{}).Immutable(),
+		// actor describes who made the change, e.g. a user's email or an
+		// admin token's name.
+		field.String("actor").Optional().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		// reason is why the change was made, when given, e.g. for a kill
+		// switch pulled during an incident.
+		field.String("reason").Optional().Immutable(),
+	}
+}
+
+func (FlagEnvironmentVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flag_environment", FlagEnvironment.Type).
+			Ref("versions").
+			Field("flag_environment_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (FlagEnvironmentVersion) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("flag_environment_id", "version").Unique(),
+	}
+}
@@ -0,0 +1,51 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Prerequisite holds the schema definition for the Prerequisite entity. It
+// makes a flag's configuration in one environment depend on the state of
+// another flag of the same project in that environment.
+type Prerequisite struct {
+	ent.Schema
+}
+
+func (Prerequisite) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("flag_environment_id"),
+		field.Int("parent_flag_id"),
+		// enabled is the state the parent flag must evaluate to.
+		field.Bool("enabled").Default(true),
+		// variant optionally requires the parent flag to resolve to this
+		// variant, for SDKs that support variants.
+		field.String("variant").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Prerequisite) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flag_environment", FlagEnvironment.Type).
+			Ref("prerequisites").
+			Field("flag_environment_id").
+			Required().
+			Unique(),
+		edge.From("parent_flag", Flag.Type).
+			Ref("dependents").
+			Field("parent_flag_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (Prerequisite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("flag_environment_id", "parent_flag_id").Unique(),
+	}
+}
@@ -19,6 +19,15 @@ func (Project) Fields() []ent.Field {
 		field.String("description").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+		// deleted_at is set while the project is in the trash. Its tokens
+		// stop working until it is restored.
+		field.Time("deleted_at").Optional().Nillable(),
+		// frozen_at is set while the project is frozen: changes by users other
+		// than admins are rejected until it is lifted. frozen_by and
+		// freeze_reason record who froze it and why.
+		field.Time("frozen_at").Optional().Nillable(),
+		field.String("frozen_by").Optional(),
+		field.String("freeze_reason").Optional(),
 	}
 }
 
@@ -27,5 +36,8 @@ func (Project) Edges() []ent.Edge {
 		edge.To("environments", Environment.Type),
 		edge.To("flags", Flag.Type),
 		edge.To("api_tokens", ApiToken.Type),
+		edge.To("tags", Tag.Type),
+		edge.To("strategy_definitions", StrategyDefinition.Type),
+		edge.To("context_fields", ContextField.Type),
 	}
 }
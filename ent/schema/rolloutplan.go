@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RolloutStep is one step of a rollout plan: the percentage to roll out to,
+// and how long to hold it before the next step.
+type RolloutStep struct {
+	Percentage  int `json:"percentage"`
+	HoldSeconds int `json:"hold_seconds"`
+}
+
+// RolloutPlan holds the schema definition for the RolloutPlan entity. It
+// ramps up the rollout parameter of a gradualRollout strategy in steps.
+type RolloutPlan struct {
+	ent.Schema
+}
+
+func (RolloutPlan) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("flag_environment_id"),
+		// strategy_id is not an edge: imports and version restores replace
+		// strategies wholesale, and a plan whose strategy is gone is aborted
+		// rather than blocking the change.
+		field.Int("strategy_id"),
+		field.JSON("steps", []RolloutStep{}),
+		// current_step is the index of the step applied last.
+		field.Int("current_step").Default(0),
+		field.Enum("status").Values("running", "paused", "completed", "aborted").Default("running"),
+		// step_started_at is when the current step was applied; holds are
+		// counted from it, less the time spent paused.
+		field.Time("step_started_at").Default(time.Now),
+		field.Time("paused_at").Optional().Nillable(),
+		// status_reason says why the plan was paused or aborted, e.g. when
+		// the rollout was changed by hand.
+		field.String("status_reason").Optional(),
+		field.String("created_by").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (RolloutPlan) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("flag_environment", FlagEnvironment.Type).
+			Ref("rollout_plans").
+			Field("flag_environment_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (RolloutPlan) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("strategy_id"),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// StrategyParameter declares a parameter of a custom strategy.
+type StrategyParameter struct {
+	Name        string   `json:"name"`
+	Label       string   `json:"label,omitempty"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required,omitempty"`
+	Min         *float64 `json:"min,omitempty"`
+	Max         *float64 `json:"max,omitempty"`
+	Values      []string `json:"values,omitempty"`
+	Default     any      `json:"default,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+// StrategyDefinition holds the schema definition for the StrategyDefinition
+// entity: a custom strategy of a project that its SDKs implement in addition
+// to the built-in ones.
+type StrategyDefinition struct {
+	ent.Schema
+}
+
+func (StrategyDefinition) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.String("description").Optional(),
+		field.JSON("parameters", []StrategyParameter{}).Optional(),
+		field.Int("project_id"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
+
+func (StrategyDefinition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("strategy_definitions").
+			Field("project_id").
+			Required().
+			Unique(),
+	}
+}
+
+func (StrategyDefinition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "project_id").Unique(),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Tag holds the schema definition for the Tag entity. Tags are scoped to a
+// project and group its flags, e.g. by team or platform.
+type Tag struct {
+	ent.Schema
+}
+
+func (Tag) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		field.Int("project_id"),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Tag) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("project", Project.Type).
+			Ref("tags").
+			Field("project_id").
+			Required().
+			Unique(),
+		edge.From("flags", Flag.Type).
+			Ref("tags"),
+	}
+}
+
+func (Tag) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", "project_id").Unique(),
+	}
+}
@@ -0,0 +1,489 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+)
+
+// StrategyDefinitionUpdate is the builder for updating StrategyDefinition entities.
+type StrategyDefinitionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *StrategyDefinitionMutation
+}
+
+// Where appends a list predicates to the StrategyDefinitionUpdate builder.
+func (_u *StrategyDefinitionUpdate) Where(ps ...predicate.StrategyDefinition) *StrategyDefinitionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *StrategyDefinitionUpdate) SetName(v string) *StrategyDefinitionUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdate) SetNillableName(v *string) *StrategyDefinitionUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StrategyDefinitionUpdate) SetDescription(v string) *StrategyDefinitionUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdate) SetNillableDescription(v *string) *StrategyDefinitionUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *StrategyDefinitionUpdate) ClearDescription() *StrategyDefinitionUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *StrategyDefinitionUpdate) SetParameters(v []schema.StrategyParameter) *StrategyDefinitionUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// AppendParameters appends value to the "parameters" field.
+func (_u *StrategyDefinitionUpdate) AppendParameters(v []schema.StrategyParameter) *StrategyDefinitionUpdate {
+	_u.mutation.AppendParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *StrategyDefinitionUpdate) ClearParameters() *StrategyDefinitionUpdate {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *StrategyDefinitionUpdate) SetProjectID(v int) *StrategyDefinitionUpdate {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdate) SetNillableProjectID(v *int) *StrategyDefinitionUpdate {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StrategyDefinitionUpdate) SetUpdatedAt(v time.Time) *StrategyDefinitionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *StrategyDefinitionUpdate) SetProject(v *Project) *StrategyDefinitionUpdate {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the StrategyDefinitionMutation object of the builder.
+func (_u *StrategyDefinitionUpdate) Mutation() *StrategyDefinitionMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *StrategyDefinitionUpdate) ClearProject() *StrategyDefinitionUpdate {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *StrategyDefinitionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StrategyDefinitionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *StrategyDefinitionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StrategyDefinitionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StrategyDefinitionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := strategydefinition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StrategyDefinitionUpdate) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StrategyDefinition.project"`)
+	}
+	return nil
+}
+
+func (_u *StrategyDefinitionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(strategydefinition.Table, strategydefinition.Columns, sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(strategydefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(strategydefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(strategydefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(strategydefinition.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParameters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, strategydefinition.FieldParameters, value)
+		})
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(strategydefinition.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(strategydefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategydefinition.ProjectTable,
+			Columns: []string{strategydefinition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategydefinition.ProjectTable,
+			Columns: []string{strategydefinition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{strategydefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// StrategyDefinitionUpdateOne is the builder for updating a single StrategyDefinition entity.
+type StrategyDefinitionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *StrategyDefinitionMutation
+}
+
+// SetName sets the "name" field.
+func (_u *StrategyDefinitionUpdateOne) SetName(v string) *StrategyDefinitionUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdateOne) SetNillableName(v *string) *StrategyDefinitionUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *StrategyDefinitionUpdateOne) SetDescription(v string) *StrategyDefinitionUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdateOne) SetNillableDescription(v *string) *StrategyDefinitionUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *StrategyDefinitionUpdateOne) ClearDescription() *StrategyDefinitionUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *StrategyDefinitionUpdateOne) SetParameters(v []schema.StrategyParameter) *StrategyDefinitionUpdateOne {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// AppendParameters appends value to the "parameters" field.
+func (_u *StrategyDefinitionUpdateOne) AppendParameters(v []schema.StrategyParameter) *StrategyDefinitionUpdateOne {
+	_u.mutation.AppendParameters(v)
+	return _u
+}
+
+// ClearParameters clears the value of the "parameters" field.
+func (_u *StrategyDefinitionUpdateOne) ClearParameters() *StrategyDefinitionUpdateOne {
+	_u.mutation.ClearParameters()
+	return _u
+}
+
+// SetProjectID sets the "project_id" field.
+func (_u *StrategyDefinitionUpdateOne) SetProjectID(v int) *StrategyDefinitionUpdateOne {
+	_u.mutation.SetProjectID(v)
+	return _u
+}
+
+// SetNillableProjectID sets the "project_id" field if the given value is not nil.
+func (_u *StrategyDefinitionUpdateOne) SetNillableProjectID(v *int) *StrategyDefinitionUpdateOne {
+	if v != nil {
+		_u.SetProjectID(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *StrategyDefinitionUpdateOne) SetUpdatedAt(v time.Time) *StrategyDefinitionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetProject sets the "project" edge to the Project entity.
+func (_u *StrategyDefinitionUpdateOne) SetProject(v *Project) *StrategyDefinitionUpdateOne {
+	return _u.SetProjectID(v.ID)
+}
+
+// Mutation returns the StrategyDefinitionMutation object of the builder.
+func (_u *StrategyDefinitionUpdateOne) Mutation() *StrategyDefinitionMutation {
+	return _u.mutation
+}
+
+// ClearProject clears the "project" edge to the Project entity.
+func (_u *StrategyDefinitionUpdateOne) ClearProject() *StrategyDefinitionUpdateOne {
+	_u.mutation.ClearProject()
+	return _u
+}
+
+// Where appends a list predicates to the StrategyDefinitionUpdate builder.
+func (_u *StrategyDefinitionUpdateOne) Where(ps ...predicate.StrategyDefinition) *StrategyDefinitionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *StrategyDefinitionUpdateOne) Select(field string, fields ...string) *StrategyDefinitionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated StrategyDefinition entity.
+func (_u *StrategyDefinitionUpdateOne) Save(ctx context.Context) (*StrategyDefinition, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *StrategyDefinitionUpdateOne) SaveX(ctx context.Context) *StrategyDefinition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *StrategyDefinitionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *StrategyDefinitionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *StrategyDefinitionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := strategydefinition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *StrategyDefinitionUpdateOne) check() error {
+	if _u.mutation.ProjectCleared() && len(_u.mutation.ProjectIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "StrategyDefinition.project"`)
+	}
+	return nil
+}
+
+func (_u *StrategyDefinitionUpdateOne) sqlSave(ctx context.Context) (_node *StrategyDefinition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(strategydefinition.Table, strategydefinition.Columns, sqlgraph.NewFieldSpec(strategydefinition.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "StrategyDefinition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, strategydefinition.FieldID)
+		for _, f := range fields {
+			if !strategydefinition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != strategydefinition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(strategydefinition.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(strategydefinition.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(strategydefinition.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(strategydefinition.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedParameters(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, strategydefinition.FieldParameters, value)
+		})
+	}
+	if _u.mutation.ParametersCleared() {
+		_spec.ClearField(strategydefinition.FieldParameters, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(strategydefinition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ProjectCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategydefinition.ProjectTable,
+			Columns: []string{strategydefinition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ProjectIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   strategydefinition.ProjectTable,
+			Columns: []string{strategydefinition.ProjectColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(project.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &StrategyDefinition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{strategydefinition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
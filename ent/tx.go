@@ -16,16 +16,28 @@ type Tx struct {
 	ApiToken *ApiTokenClient
 	// Constraint is the client for interacting with the Constraint builders.
 	Constraint *ConstraintClient
+	// ContextField is the client for interacting with the ContextField builders.
+	ContextField *ContextFieldClient
 	// Environment is the client for interacting with the Environment builders.
 	Environment *EnvironmentClient
 	// Flag is the client for interacting with the Flag builders.
 	Flag *FlagClient
 	// FlagEnvironment is the client for interacting with the FlagEnvironment builders.
 	FlagEnvironment *FlagEnvironmentClient
+	// FlagEnvironmentVersion is the client for interacting with the FlagEnvironmentVersion builders.
+	FlagEnvironmentVersion *FlagEnvironmentVersionClient
+	// Prerequisite is the client for interacting with the Prerequisite builders.
+	Prerequisite *PrerequisiteClient
 	// Project is the client for interacting with the Project builders.
 	Project *ProjectClient
+	// RolloutPlan is the client for interacting with the RolloutPlan builders.
+	RolloutPlan *RolloutPlanClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
+	// StrategyDefinition is the client for interacting with the StrategyDefinition builders.
+	StrategyDefinition *StrategyDefinitionClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -161,11 +173,17 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.ApiToken = NewApiTokenClient(tx.config)
 	tx.Constraint = NewConstraintClient(tx.config)
+	tx.ContextField = NewContextFieldClient(tx.config)
 	tx.Environment = NewEnvironmentClient(tx.config)
 	tx.Flag = NewFlagClient(tx.config)
 	tx.FlagEnvironment = NewFlagEnvironmentClient(tx.config)
+	tx.FlagEnvironmentVersion = NewFlagEnvironmentVersionClient(tx.config)
+	tx.Prerequisite = NewPrerequisiteClient(tx.config)
 	tx.Project = NewProjectClient(tx.config)
+	tx.RolloutPlan = NewRolloutPlanClient(tx.config)
 	tx.Strategy = NewStrategyClient(tx.config)
+	tx.StrategyDefinition = NewStrategyDefinitionClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
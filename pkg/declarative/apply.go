@@ -0,0 +1,861 @@
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+)
+
+// Change actions.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Change kinds.
+const (
+	KindProject         = "project"
+	KindEnvironment     = "environment"
+	KindContextField    = "context_field"
+	KindFlag            = "flag"
+	KindFlagEnvironment = "flag_environment"
+)
+
+type (
+	// Options controls how a document is applied.
+	Options struct {
+		// DryRun computes the diff without persisting anything.
+		DryRun bool
+
+		// Prune deletes environments, context fields, flags and flag
+		// environment configs that exist in the project but are absent from
+		// the document.
+		Prune bool
+
+		// Actor is recorded on the flag environment versions created by
+		// the run, e.g. a user's email or an admin token's name.
+		Actor string
+	}
+
+	// FieldChange describes a single field that differs between the current
+	// state and the document.
+	FieldChange struct {
+		Field string `json:"field"`
+		From  any    `json:"from"`
+		To    any    `json:"to"`
+	}
+
+	// Change is one create, update or delete of a project resource.
+	Change struct {
+		Action      string        `json:"action"`
+		Kind        string        `json:"kind"`
+		Name        string        `json:"name"`
+		Environment string        `json:"environment,omitempty"`
+		Fields      []FieldChange `json:"fields,omitempty"`
+	}
+
+	// Diff is the ordered list of changes needed to reach the document state.
+	Diff struct {
+		Changes []Change `json:"changes"`
+
+		// FlagEnvironments are the configs a committed run changed, in ID
+		// order, for the caller to announce. Empty on a dry run.
+		FlagEnvironments []FlagEnvironmentChange `json:"-"`
+	}
+
+	// FlagEnvironmentChange says what a run changed in one flag environment
+	// config and the revision it left the config at.
+	FlagEnvironmentChange struct {
+		ID            int
+		FlagID        int
+		Flag          string
+		EnvironmentID int
+		Environment   string
+		Revision      int
+
+		// Enabled is the new state when it changed.
+		Enabled               *bool
+		StrategiesReplaced    bool
+		PrerequisitesReplaced bool
+	}
+
+	// ValidationError is returned by Apply when the document is invalid.
+	ValidationError struct {
+		Fields map[string]string
+	}
+)
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("declarative: document has %d invalid field(s)", len(e.Fields))
+}
+
+// Empty reports whether the diff contains no changes.
+func (d *Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// Summary counts the changes per action.
+func (d *Diff) Summary() map[string]int {
+	s := map[string]int{ActionCreate: 0, ActionUpdate: 0, ActionDelete: 0}
+	for _, c := range d.Changes {
+		s[c.Action]++
+	}
+	return s
+}
+
+// Apply reconciles a project with the document inside a single transaction
+// and returns the changes made. With DryRun set, the transaction is rolled back
+// and the returned diff describes what would have changed.
+func Apply(ctx context.Context, orm *ent.Client, projectID int, doc *Document, opts Options) (*Diff, error) {
+	reg, err := ProjectRegistry(ctx, orm, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Prune {
+		// Only the fields the document declares survive the run.
+		reg.ContextFields = BuiltinContextFields()
+	}
+	if fields := doc.ValidateFor(reg); len(fields) > 0 {
+		return nil, &ValidationError{Fields: fields}
+	}
+
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{
+		ctx:       ctx,
+		client:    tx.Client(),
+		projectID: projectID,
+		opts:      opts,
+		diff:      &Diff{Changes: []Change{}},
+	}
+	if err := a.run(doc); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !opts.DryRun {
+		if err := a.recordVersions(); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if opts.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return a.diff, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a.diff, nil
+}
+
+type applier struct {
+	ctx       context.Context
+	client    *ent.Client
+	projectID int
+	opts      Options
+	diff      *Diff
+	touched   map[int]*FlagEnvironmentChange
+	expected  map[int]int
+}
+
+func (a *applier) record(c Change) {
+	a.diff.Changes = append(a.diff.Changes, c)
+}
+
+func (a *applier) run(doc *Document) error {
+	if err := a.applyProject(doc.Project); err != nil {
+		return err
+	}
+
+	envIDs, err := a.applyEnvironments(doc.Environments)
+	if err != nil {
+		return err
+	}
+	if err := a.applyExpectedMatches(doc.Environments, envIDs); err != nil {
+		return err
+	}
+	if err := a.applyContextFields(doc.ContextFields); err != nil {
+		return err
+	}
+
+	return a.applyFlags(doc.Flags, envIDs)
+}
+
+func (a *applier) applyProject(dp Project) error {
+	p, err := a.client.Project.Get(a.ctx, a.projectID)
+	if err != nil {
+		return err
+	}
+
+	var fields []FieldChange
+	update := p.Update()
+	if dp.Name != "" && dp.Name != p.Name {
+		// Checked here rather than left to the unique index so that a dry
+		// run reports it too, and other constraint errors are not taken for
+		// it.
+		taken, err := a.client.Project.Query().
+			Where(project.Name(dp.Name), project.IDNEQ(p.ID)).
+			Exist(a.ctx)
+		if err != nil {
+			return err
+		}
+		if taken {
+			return &ValidationError{Fields: map[string]string{"project.name": "A project with this name already exists"}}
+		}
+		fields = append(fields, FieldChange{Field: "name", From: p.Name, To: dp.Name})
+		update.SetName(dp.Name)
+	}
+	if dp.Description != p.Description {
+		fields = append(fields, FieldChange{Field: "description", From: p.Description, To: dp.Description})
+		if dp.Description == "" {
+			update.ClearDescription()
+		} else {
+			update.SetDescription(dp.Description)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	a.record(Change{Action: ActionUpdate, Kind: KindProject, Name: p.Name, Fields: fields})
+	if a.opts.DryRun {
+		return nil
+	}
+	return update.Exec(a.ctx)
+}
+
+// applyEnvironments reconciles environments and returns the ID of every
+// environment declared in the document. Environments created during a dry run
+// have no ID and are absent from the map.
+func (a *applier) applyEnvironments(envs []Environment) (map[string]int, error) {
+	current, err := a.client.Environment.Query().
+		Where(environment.ProjectID(a.projectID), environment.DeletedAtIsNil()).
+		All(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*ent.Environment, len(current))
+	for _, e := range current {
+		byName[e.Name] = e
+	}
+
+	// Names in the trash stay reserved until purged.
+	trashed, err := a.client.Environment.Query().
+		Where(environment.ProjectID(a.projectID), environment.DeletedAtNotNil()).
+		Select(environment.FieldName).
+		Strings(a.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, de := range envs {
+		if _, ok := byName[de.Name]; !ok && slices.Contains(trashed, de.Name) {
+			return nil, &ValidationError{Fields: map[string]string{
+				"environments": fmt.Sprintf("Environment %q is in the trash; restore or purge it first", de.Name),
+			}}
+		}
+	}
+
+	ids := make(map[string]int, len(envs))
+	declared := make(map[string]bool, len(envs))
+	for _, de := range envs {
+		declared[de.Name] = true
+
+		e, ok := byName[de.Name]
+		if !ok {
+			a.record(Change{Action: ActionCreate, Kind: KindEnvironment, Name: de.Name})
+			if a.opts.DryRun {
+				continue
+			}
+			created, err := a.client.Environment.Create().
+				SetName(de.Name).
+				SetType(environment.Type(de.Type)).
+				SetSortOrder(de.SortOrder).
+				SetProjectID(a.projectID).
+				Save(a.ctx)
+			if err != nil {
+				return nil, err
+			}
+			ids[de.Name] = created.ID
+			continue
+		}
+
+		ids[de.Name] = e.ID
+		var fields []FieldChange
+		if string(e.Type) != de.Type {
+			fields = append(fields, FieldChange{Field: "type", From: string(e.Type), To: de.Type})
+		}
+		if e.SortOrder != de.SortOrder {
+			fields = append(fields, FieldChange{Field: "sort_order", From: e.SortOrder, To: de.SortOrder})
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		a.record(Change{Action: ActionUpdate, Kind: KindEnvironment, Name: de.Name, Fields: fields})
+		if a.opts.DryRun {
+			continue
+		}
+		err := e.Update().
+			SetType(environment.Type(de.Type)).
+			SetSortOrder(de.SortOrder).
+			Exec(a.ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !a.opts.Prune {
+		return ids, nil
+	}
+
+	for _, e := range current {
+		if declared[e.Name] {
+			continue
+		}
+		a.record(Change{Action: ActionDelete, Kind: KindEnvironment, Name: e.Name})
+		if a.opts.DryRun {
+			continue
+		}
+		// Pruned environments go to the trash with their flag configs.
+		if err := e.Update().SetDeletedAt(time.Now()).Exec(a.ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return ids, nil
+}
+
+// applyExpectedMatches reconciles the declared expected-match relationships
+// once every environment exists. Relationships of environments created by this
+// apply are part of their create change.
+func (a *applier) applyExpectedMatches(envs []Environment, ids map[string]int) error {
+	created := map[string]bool{}
+	for _, c := range a.diff.Changes {
+		if c.Kind == KindEnvironment && c.Action == ActionCreate {
+			created[c.Name] = true
+		}
+	}
+
+	current, err := a.client.Environment.Query().
+		Where(environment.ProjectID(a.projectID), environment.DeletedAtIsNil()).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*ent.Environment, len(current))
+	names := make(map[int]string, len(current))
+	for _, e := range current {
+		byName[e.Name] = e
+		names[e.ID] = e.Name
+	}
+
+	for _, de := range envs {
+		e, ok := byName[de.Name]
+		if !ok {
+			continue
+		}
+		have := ""
+		if e.ExpectedMatchID != nil {
+			have = names[*e.ExpectedMatchID]
+		}
+		if have == de.ExpectedMatch {
+			continue
+		}
+
+		if !created[de.Name] {
+			a.record(Change{Action: ActionUpdate, Kind: KindEnvironment, Name: de.Name, Fields: []FieldChange{
+				{Field: "expected_match", From: have, To: de.ExpectedMatch},
+			}})
+		}
+		if a.opts.DryRun {
+			continue
+		}
+		update := e.Update()
+		if de.ExpectedMatch == "" {
+			update.ClearExpectedMatchID()
+		} else {
+			update.SetExpectedMatchID(ids[de.ExpectedMatch])
+		}
+		if err := update.Exec(a.ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) applyContextFields(fields []ContextField) error {
+	current, err := a.client.ContextField.Query().
+		Where(contextfield.ProjectID(a.projectID)).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+
+	byName := make(map[string]*ent.ContextField, len(current))
+	for _, f := range current {
+		byName[f.Name] = f
+	}
+
+	declared := make(map[string]bool, len(fields))
+	for _, df := range fields {
+		declared[df.Name] = true
+
+		f, ok := byName[df.Name]
+		if !ok {
+			a.record(Change{Action: ActionCreate, Kind: KindContextField, Name: df.Name})
+			if a.opts.DryRun {
+				continue
+			}
+			err := a.client.ContextField.Create().
+				SetName(df.Name).
+				SetNillableDescription(nilIfEmpty(df.Description)).
+				SetType(contextfield.Type(df.Type)).
+				SetLegalValues(df.LegalValues).
+				SetProjectID(a.projectID).
+				Exec(a.ctx)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		var changes []FieldChange
+		if f.Description != df.Description {
+			changes = append(changes, FieldChange{Field: "description", From: f.Description, To: df.Description})
+		}
+		if string(f.Type) != df.Type {
+			changes = append(changes, FieldChange{Field: "type", From: string(f.Type), To: df.Type})
+		}
+		if !slices.Equal(f.LegalValues, df.LegalValues) {
+			changes = append(changes, FieldChange{Field: "legal_values", From: f.LegalValues, To: df.LegalValues})
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		a.record(Change{Action: ActionUpdate, Kind: KindContextField, Name: df.Name, Fields: changes})
+		if a.opts.DryRun {
+			continue
+		}
+		err := f.Update().
+			SetDescription(df.Description).
+			SetType(contextfield.Type(df.Type)).
+			SetLegalValues(df.LegalValues).
+			Exec(a.ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !a.opts.Prune {
+		return nil
+	}
+
+	for _, f := range current {
+		if declared[f.Name] {
+			continue
+		}
+		a.record(Change{Action: ActionDelete, Kind: KindContextField, Name: f.Name})
+		if a.opts.DryRun {
+			continue
+		}
+		if err := a.client.ContextField.DeleteOne(f).Exec(a.ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) applyFlags(flags []Flag, envIDs map[string]int) error {
+	current, err := loadFlags(a.ctx, a.client, a.projectID)
+	if err != nil {
+		return err
+	}
+
+	envs, err := a.client.Environment.Query().
+		Where(environment.ProjectID(a.projectID), environment.DeletedAtIsNil()).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+	envNames := make(map[int]string, len(envs))
+	for _, e := range envs {
+		envNames[e.ID] = e.Name
+	}
+
+	byName := make(map[string]*ent.Flag, len(current))
+	for _, f := range current {
+		byName[f.Name] = f
+	}
+
+	trashed, err := a.client.Flag.Query().
+		Where(entflag.ProjectID(a.projectID), entflag.DeletedAtNotNil()).
+		Select(entflag.FieldName).
+		Strings(a.ctx)
+	if err != nil {
+		return err
+	}
+	for _, df := range flags {
+		if _, ok := byName[df.Name]; !ok && slices.Contains(trashed, df.Name) {
+			return &ValidationError{Fields: map[string]string{
+				"flags": fmt.Sprintf("Flag %q is in the trash; restore or purge it first", df.Name),
+			}}
+		}
+	}
+
+	declared := make(map[string]bool, len(flags))
+	for _, df := range flags {
+		declared[df.Name] = true
+
+		f, ok := byName[df.Name]
+		if !ok {
+			a.record(Change{Action: ActionCreate, Kind: KindFlag, Name: df.Name})
+			if !a.opts.DryRun {
+				f, err = a.client.Flag.Create().
+					SetName(df.Name).
+					SetNillableDescription(nilIfEmpty(df.Description)).
+					SetFlagType(entflag.FlagType(df.FlagType)).
+					SetNillableOwner(nilIfEmpty(df.Owner)).
+					SetLinks(LinksToEnt(df.Links)).
+					SetMetadata(df.Metadata).
+					SetProjectID(a.projectID).
+					Save(a.ctx)
+				if err != nil {
+					return err
+				}
+				if err := SetTags(a.ctx, a.client, a.projectID, f.ID, NormalizeTags(df.Tags)); err != nil {
+					return err
+				}
+				byName[df.Name] = f
+			}
+		} else if err := a.updateFlag(f, df); err != nil {
+			return err
+		}
+
+		if err := a.applyFlagEnvironments(f, df, envIDs, envNames); err != nil {
+			return err
+		}
+	}
+
+	if err := a.applyPrerequisites(flags, byName, envIDs); err != nil {
+		return err
+	}
+
+	if !a.opts.Prune {
+		return nil
+	}
+
+	// Move every pruned flag to the trash first, so that pruned flags
+	// depending on each other can go together.
+	var pruned []*ent.Flag
+	for _, f := range current {
+		if declared[f.Name] {
+			continue
+		}
+		a.record(Change{Action: ActionDelete, Kind: KindFlag, Name: f.Name})
+		if a.opts.DryRun {
+			continue
+		}
+		if err := f.Update().SetDeletedAt(time.Now()).Exec(a.ctx); err != nil {
+			return err
+		}
+		pruned = append(pruned, f)
+	}
+	for _, f := range pruned {
+		if err := a.checkNoDependents(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNoDependents rejects deleting a flag that other flags still require.
+func (a *applier) checkNoDependents(f *ent.Flag) error {
+	dependents, err := Dependents(a.ctx, a.client, f.ID)
+	if err != nil || len(dependents) == 0 {
+		return err
+	}
+	return &ValidationError{Fields: map[string]string{
+		"flags": fmt.Sprintf("Flag %q is a prerequisite of %q in %s", f.Name, dependents[0].Flag, dependents[0].Environment),
+	}}
+}
+
+func (a *applier) updateFlag(f *ent.Flag, df Flag) error {
+	var fields []FieldChange
+	if f.Description != df.Description {
+		fields = append(fields, FieldChange{Field: "description", From: f.Description, To: df.Description})
+	}
+	if string(f.FlagType) != df.FlagType {
+		fields = append(fields, FieldChange{Field: "flag_type", From: string(f.FlagType), To: df.FlagType})
+	}
+	if f.Owner != df.Owner {
+		fields = append(fields, FieldChange{Field: "owner", From: f.Owner, To: df.Owner})
+	}
+	tags, wantTags := TagNames(f.Edges.Tags), NormalizeTags(df.Tags)
+	if !slices.Equal(tags, wantTags) {
+		fields = append(fields, FieldChange{Field: "tags", From: tags, To: wantTags})
+	}
+	links := LinksFromEnt(f.Links)
+	if !slices.Equal(links, df.Links) {
+		fields = append(fields, FieldChange{Field: "links", From: links, To: df.Links})
+	}
+	if !maps.Equal(f.Metadata, df.Metadata) {
+		fields = append(fields, FieldChange{Field: "metadata", From: f.Metadata, To: df.Metadata})
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+
+	a.record(Change{Action: ActionUpdate, Kind: KindFlag, Name: f.Name, Fields: fields})
+	if a.opts.DryRun {
+		return nil
+	}
+
+	update := f.Update().
+		SetFlagType(entflag.FlagType(df.FlagType)).
+		SetLinks(LinksToEnt(df.Links)).
+		SetMetadata(df.Metadata)
+	if df.Description == "" {
+		update.ClearDescription()
+	} else {
+		update.SetDescription(df.Description)
+	}
+	if df.Owner == "" {
+		update.ClearOwner()
+	} else {
+		update.SetOwner(df.Owner)
+	}
+	if err := update.Exec(a.ctx); err != nil {
+		return err
+	}
+	if slices.Equal(tags, wantTags) {
+		return nil
+	}
+	return SetTags(a.ctx, a.client, a.projectID, f.ID, wantTags)
+}
+
+// applyFlagEnvironments reconciles the per-environment configs of one flag.
+// f is nil when the flag is being created during a dry run.
+func (a *applier) applyFlagEnvironments(f *ent.Flag, df Flag, envIDs map[string]int, envNames map[int]string) error {
+	current := map[string]*ent.FlagEnvironment{}
+	if f != nil {
+		for _, fe := range f.Edges.FlagEnvironments {
+			current[envNames[fe.EnvironmentID]] = fe
+		}
+	}
+
+	declared := make(map[string]bool, len(df.Environments))
+	for _, dfe := range df.Environments {
+		declared[dfe.Environment] = true
+		want := normalizeStrategies(dfe.Strategies)
+
+		fe, ok := current[dfe.Environment]
+		if !ok {
+			a.record(Change{Action: ActionCreate, Kind: KindFlagEnvironment, Name: df.Name, Environment: dfe.Environment})
+			if a.opts.DryRun {
+				continue
+			}
+			fe, err := a.client.FlagEnvironment.Create().
+				SetFlagID(f.ID).
+				SetEnvironmentID(envIDs[dfe.Environment]).
+				SetEnabled(dfe.Enabled).
+				Save(a.ctx)
+			if err != nil {
+				return err
+			}
+			if err := a.createStrategies(fe.ID, want); err != nil {
+				return err
+			}
+			change := a.touch(fe.ID)
+			change.Enabled = &dfe.Enabled
+			change.StrategiesReplaced = true
+			continue
+		}
+
+		have := normalizeStrategies(strategiesFromEnt(fe.Edges.Strategies))
+		var fields []FieldChange
+		if fe.Enabled != dfe.Enabled {
+			fields = append(fields, FieldChange{Field: "enabled", From: fe.Enabled, To: dfe.Enabled})
+		}
+		strategiesChanged := !sameStrategies(have, want)
+		if strategiesChanged {
+			fields = append(fields, FieldChange{Field: "strategies", From: have, To: want})
+		}
+		if len(fields) == 0 {
+			continue
+		}
+
+		a.record(Change{Action: ActionUpdate, Kind: KindFlagEnvironment, Name: df.Name, Environment: dfe.Environment, Fields: fields})
+		if a.opts.DryRun {
+			continue
+		}
+		change := a.touch(fe.ID)
+		if fe.Enabled != dfe.Enabled {
+			if err := fe.Update().SetEnabled(dfe.Enabled).Exec(a.ctx); err != nil {
+				return err
+			}
+			change.Enabled = &dfe.Enabled
+		}
+		if strategiesChanged {
+			change.StrategiesReplaced = true
+			if err := a.deleteStrategies(fe.ID); err != nil {
+				return err
+			}
+			if err := a.createStrategies(fe.ID, want); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !a.opts.Prune || f == nil {
+		return nil
+	}
+
+	// Only prune configs whose environment is kept; configs of pruned
+	// environments are reported as part of the environment deletion.
+	names := make([]string, 0, len(current))
+	for name := range current {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if declared[name] {
+			continue
+		}
+		if _, kept := envIDs[name]; !kept {
+			continue
+		}
+		a.record(Change{Action: ActionDelete, Kind: KindFlagEnvironment, Name: df.Name, Environment: name})
+		if a.opts.DryRun {
+			continue
+		}
+		if err := a.deleteFlagEnvironments([]int{current[name].ID}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a *applier) createStrategies(feID int, strategies []Strategy) error {
+	for i, s := range strategies {
+		created, err := a.client.Strategy.Create().
+			SetName(s.Name).
+			SetParameters(s.Parameters).
+			SetSortOrder(i).
+			SetFlagEnvironmentID(feID).
+			Save(a.ctx)
+		if err != nil {
+			return err
+		}
+		for _, c := range s.Constraints {
+			err := a.client.Constraint.Create().
+				SetContextName(c.ContextName).
+				SetOperator(entconstraint.Operator(c.Operator)).
+				SetValues(c.Values).
+				SetInverted(c.Inverted).
+				SetCaseInsensitive(c.CaseInsensitive).
+				SetStrategyID(created.ID).
+				Exec(a.ctx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *applier) deleteStrategies(feID int) error {
+	ids, err := a.client.Strategy.Query().
+		Where(strategy.FlagEnvironmentID(feID)).
+		IDs(a.ctx)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	if _, err := a.client.Constraint.Delete().Where(entconstraint.StrategyIDIn(ids...)).Exec(a.ctx); err != nil {
+		return err
+	}
+	_, err = a.client.Strategy.Delete().Where(strategy.IDIn(ids...)).Exec(a.ctx)
+	return err
+}
+
+// deleteFlagEnvironments deletes flag environment configs with their
+// strategies, constraints, prerequisites and versions (SQLite has no FK
+// cascade).
+func (a *applier) deleteFlagEnvironments(ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	for _, id := range ids {
+		if err := a.deleteStrategies(id); err != nil {
+			return err
+		}
+	}
+	if _, err := a.client.Prerequisite.Delete().Where(prerequisite.FlagEnvironmentIDIn(ids...)).Exec(a.ctx); err != nil {
+		return err
+	}
+	if _, err := a.client.FlagEnvironmentVersion.Delete().Where(flagenvironmentversion.FlagEnvironmentIDIn(ids...)).Exec(a.ctx); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		delete(a.touched, id)
+	}
+	_, err := a.client.FlagEnvironment.Delete().Where(flagenvironment.IDIn(ids...)).Exec(a.ctx)
+	return err
+}
+
+// normalizeStrategies makes empty collections consistent so that a document
+// written with omitted lists compares equal to the stored state.
+func normalizeStrategies(in []Strategy) []Strategy {
+	out := make([]Strategy, 0, len(in))
+	for _, s := range in {
+		ns := Strategy{Name: s.Name, Parameters: s.Parameters, Constraints: make([]Constraint, 0, len(s.Constraints))}
+		if len(ns.Parameters) == 0 {
+			ns.Parameters = nil
+		}
+		for _, c := range s.Constraints {
+			if c.Values == nil {
+				c.Values = []string{}
+			}
+			ns.Constraints = append(ns.Constraints, c)
+		}
+		out = append(out, ns)
+	}
+	return out
+}
+
+func sameStrategies(a, b []Strategy) bool {
+	ja, errA := json.Marshal(a)
+	jb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
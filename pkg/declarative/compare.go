@@ -0,0 +1,210 @@
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+)
+
+type (
+	// Difference is one setting whose value is not the same in every compared
+	// environment. Path addresses the setting, e.g. "enabled",
+	// "strategies[0].parameters.rollout", "strategies[1].constraints[0]" or
+	// "prerequisites.<flag>".
+	// Values holds the value per environment name; nil means absent.
+	Difference struct {
+		Path   string         `json:"path"`
+		Values map[string]any `json:"values"`
+	}
+
+	// FlagComparison lists the differences of one flag across environments.
+	FlagComparison struct {
+		Name        string       `json:"name"`
+		Differences []Difference `json:"differences"`
+	}
+
+	// Comparison reports every flag whose configuration differs between the
+	// compared environments. A flag not configured in an environment compares
+	// as disabled with no strategies, as clients see it.
+	Comparison struct {
+		Environments []string         `json:"environments"`
+		Flags        []FlagComparison `json:"flags"`
+	}
+
+	// Drift compares an environment with the one it is declared to match.
+	Drift struct {
+		Environment   string           `json:"environment"`
+		ExpectedMatch string           `json:"expected_match"`
+		Flags         []FlagComparison `json:"flags"`
+	}
+)
+
+// Compare compares the flag configuration of two or more environments of a
+// project, given by name.
+func Compare(ctx context.Context, orm *ent.Client, projectID int, envNames []string) (*Comparison, error) {
+	fields := map[string]string{}
+	if len(envNames) < 2 {
+		fields["environments"] = "At least two environments are required"
+	}
+
+	envs, err := orm.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(envs))
+	for _, e := range envs {
+		ids[e.Name] = e.ID
+	}
+
+	seen := make(map[string]bool, len(envNames))
+	for _, name := range envNames {
+		if _, ok := ids[name]; !ok {
+			fields["environments"] = fmt.Sprintf("Unknown environment %q", name)
+		} else if seen[name] {
+			fields["environments"] = fmt.Sprintf("Duplicate environment %q", name)
+		}
+		seen[name] = true
+	}
+	if len(fields) > 0 {
+		return nil, &ValidationError{Fields: fields}
+	}
+
+	flags, err := loadFlags(ctx, orm, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return compareFlags(flags, envNames, ids), nil
+}
+
+// DriftReport compares every environment of a project that declares an
+// expected match with that environment. Environments without drift are
+// included with no flags.
+func DriftReport(ctx context.Context, orm *ent.Client, projectID int) ([]Drift, error) {
+	envs, err := orm.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Order(environment.BySortOrder(), environment.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(envs))
+	names := make(map[int]string, len(envs))
+	for _, e := range envs {
+		ids[e.Name] = e.ID
+		names[e.ID] = e.Name
+	}
+
+	drifts := []Drift{}
+	var flags []*ent.Flag
+	for _, e := range envs {
+		if e.ExpectedMatchID == nil {
+			continue
+		}
+		match, ok := names[*e.ExpectedMatchID]
+		if !ok {
+			continue
+		}
+		if flags == nil {
+			if flags, err = loadFlags(ctx, orm, projectID); err != nil {
+				return nil, err
+			}
+		}
+		c := compareFlags(flags, []string{match, e.Name}, ids)
+		drifts = append(drifts, Drift{Environment: e.Name, ExpectedMatch: match, Flags: c.Flags})
+	}
+	return drifts, nil
+}
+
+func compareFlags(flags []*ent.Flag, envNames []string, ids map[string]int) *Comparison {
+	c := &Comparison{Environments: envNames, Flags: []FlagComparison{}}
+
+	for _, f := range flags {
+		byEnv := make(map[int]*ent.FlagEnvironment, len(f.Edges.FlagEnvironments))
+		for _, fe := range f.Edges.FlagEnvironments {
+			byEnv[fe.EnvironmentID] = fe
+		}
+
+		// Flatten each environment's configuration to path -> JSON value.
+		flat := make(map[string]map[string]string, len(envNames))
+		paths := map[string]bool{}
+		for _, name := range envNames {
+			state := FlagEnvironment{Strategies: []Strategy{}}
+			if fe, ok := byEnv[ids[name]]; ok {
+				state.Enabled = fe.Enabled
+				state.Strategies = strategiesFromEnt(fe.Edges.Strategies)
+				state.Prerequisites = prerequisitesFromEnt(fe.Edges.Prerequisites)
+			}
+			flat[name] = flatten(state)
+			for p := range flat[name] {
+				paths[p] = true
+			}
+		}
+
+		sorted := make([]string, 0, len(paths))
+		for p := range paths {
+			sorted = append(sorted, p)
+		}
+		sort.Strings(sorted)
+
+		var diffs []Difference
+		for _, p := range sorted {
+			first, same := flat[envNames[0]][p], true
+			for _, name := range envNames[1:] {
+				if flat[name][p] != first {
+					same = false
+					break
+				}
+			}
+			if same {
+				continue
+			}
+			d := Difference{Path: p, Values: make(map[string]any, len(envNames))}
+			for _, name := range envNames {
+				var v any
+				if raw, ok := flat[name][p]; ok {
+					_ = json.Unmarshal([]byte(raw), &v)
+				}
+				d.Values[name] = v
+			}
+			diffs = append(diffs, d)
+		}
+
+		if len(diffs) > 0 {
+			c.Flags = append(c.Flags, FlagComparison{Name: f.Name, Differences: diffs})
+		}
+	}
+
+	return c
+}
+
+// flatten maps a flag environment's settings to JSON-encoded values by path.
+func flatten(fe FlagEnvironment) map[string]string {
+	out := map[string]string{}
+	put := func(path string, v any) {
+		b, _ := json.Marshal(v)
+		out[path] = string(b)
+	}
+
+	put("enabled", fe.Enabled)
+	for i, s := range normalizeStrategies(fe.Strategies) {
+		sp := fmt.Sprintf("strategies[%d]", i)
+		put(sp+".name", s.Name)
+		for k, v := range s.Parameters {
+			put(sp+".parameters."+k, v)
+		}
+		for j, con := range s.Constraints {
+			put(fmt.Sprintf("%s.constraints[%d]", sp, j), con)
+		}
+	}
+	for _, p := range fe.Prerequisites {
+		put("prerequisites."+p.Flag, map[string]any{"enabled": p.Required(), "variant": p.Variant})
+	}
+	return out
+}
@@ -0,0 +1,300 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+)
+
+// Types of context fields.
+const (
+	ContextString = "string"
+	ContextNumber = "number"
+	ContextDate   = "date"
+	ContextSemver = "semver"
+	ContextIP     = "ip"
+)
+
+// ContextFieldTypes lists the types a context field may have.
+var ContextFieldTypes = []string{ContextString, ContextNumber, ContextDate, ContextSemver, ContextIP}
+
+// ContextField declares a field of the evaluation context that constraints
+// may test. Fields registered on a project carry the ID of their stored row;
+// the built-in ones are filled by the SDKs themselves.
+type ContextField struct {
+	ID          int      `json:"id,omitempty" yaml:"-"`
+	Name        string   `json:"name" yaml:"name"`
+	Description string   `json:"description,omitempty" yaml:"description,omitempty"`
+	Type        string   `json:"type" yaml:"type"`
+	LegalValues []string `json:"legal_values,omitempty" yaml:"legal_values,omitempty"`
+	Builtin     bool     `json:"builtin,omitempty" yaml:"-"`
+}
+
+var builtinContextFields = []ContextField{
+	{Name: "userId", Type: ContextString, Description: "Identifier of the user being evaluated", Builtin: true},
+	{Name: "sessionId", Type: ContextString, Description: "Identifier of the user's session", Builtin: true},
+	{Name: "remoteAddress", Type: ContextIP, Description: "IP address the request comes from", Builtin: true},
+}
+
+// operatorsByType lists the operators a constraint may apply to a field of
+// each type.
+var operatorsByType = map[string][]string{
+	ContextString: {"IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH", "REGEX", "ANY_OF", "ALL_OF"},
+	ContextNumber: {"IN", "NOT_IN", "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE"},
+	ContextDate:   {"IN", "NOT_IN", "DATE_AFTER", "DATE_BEFORE"},
+	ContextSemver: {"IN", "NOT_IN", "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT"},
+	ContextIP:     {"IN", "NOT_IN", "STR_STARTS_WITH", "IN_CIDR"},
+}
+
+// BuiltinContextFields returns the context fields every SDK fills.
+func BuiltinContextFields() []ContextField {
+	return slices.Clone(builtinContextFields)
+}
+
+// OperatorsByType returns, for each context field type, the operators a
+// constraint on a field of that type may use.
+func OperatorsByType() map[string][]string {
+	out := make(map[string][]string, len(operatorsByType))
+	for t, ops := range operatorsByType {
+		out[t] = slices.Clone(ops)
+	}
+	return out
+}
+
+// ProjectContextFields returns the built-in context fields followed by the
+// fields registered on a project, by name.
+func ProjectContextFields(ctx context.Context, client *ent.Client, projectID int) ([]ContextField, error) {
+	registered, err := client.ContextField.Query().
+		Where(contextfield.ProjectID(projectID)).
+		Order(ent.Asc(contextfield.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fields := BuiltinContextFields()
+	for _, f := range registered {
+		fields = append(fields, ContextFieldFromEnt(f))
+	}
+	return fields, nil
+}
+
+// ContextFieldFromEnt converts a stored context field.
+func ContextFieldFromEnt(f *ent.ContextField) ContextField {
+	return ContextField{
+		ID:          f.ID,
+		Name:        f.Name,
+		Description: f.Description,
+		Type:        string(f.Type),
+		LegalValues: f.LegalValues,
+	}
+}
+
+// ContextFieldUsage returns the names of the flags of a project, including
+// those in the trash, with a constraint on a context field.
+func ContextFieldUsage(ctx context.Context, client *ent.Client, projectID int, name string) ([]string, error) {
+	return client.Flag.Query().
+		Where(
+			entflag.ProjectID(projectID),
+			entflag.HasFlagEnvironmentsWith(flagenvironment.HasStrategiesWith(
+				strategy.HasConstraintsWith(entconstraint.ContextName(name)),
+			)),
+		).
+		Order(ent.Asc(entflag.FieldName)).
+		Select(entflag.FieldName).
+		Strings(ctx)
+}
+
+// ValidateContextField checks a context field to register and returns errors
+// keyed by field path (e.g. "legal_values").
+func ValidateContextField(f ContextField) map[string]string {
+	fields := map[string]string{}
+
+	switch {
+	case f.Name == "":
+		fields["name"] = "Name is required"
+	case !contextNamePattern.MatchString(f.Name):
+		fields["name"] = "Name must start with a letter and contain only letters, digits, _, . and -"
+	case slices.ContainsFunc(builtinContextFields, func(b ContextField) bool { return b.Name == f.Name }):
+		fields["name"] = fmt.Sprintf("%q is a built-in context field", f.Name)
+	}
+
+	if !slices.Contains(ContextFieldTypes, f.Type) {
+		fields["type"] = "Type must be one of: " + strings.Join(ContextFieldTypes, ", ")
+		return fields
+	}
+
+	seen := make(map[string]bool, len(f.LegalValues))
+	for _, v := range f.LegalValues {
+		if msg := checkContextValue(f.Type, v); msg != "" {
+			fields["legal_values"] = msg
+			break
+		}
+		if seen[v] {
+			fields["legal_values"] = fmt.Sprintf("Duplicate value %q", v)
+			break
+		}
+		seen[v] = true
+	}
+
+	return fields
+}
+
+var contextNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_.-]*$`)
+
+var semverPattern = regexp.MustCompile(`^v?\d+\.\d+\.\d+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// checkContextValue returns why v is not a value of the given type, or "".
+func checkContextValue(typ, v string) string {
+	switch typ {
+	case ContextNumber:
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return fmt.Sprintf("%q is not a number", v)
+		}
+	case ContextDate:
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			if _, err := time.Parse(time.DateOnly, v); err != nil {
+				return fmt.Sprintf("%q is not a date (YYYY-MM-DD or RFC 3339)", v)
+			}
+		}
+	case ContextSemver:
+		if !semverPattern.MatchString(v) {
+			return fmt.Sprintf("%q is not a semantic version", v)
+		}
+	case ContextIP:
+		if net.ParseIP(v) == nil {
+			return fmt.Sprintf("%q is not an IP address", v)
+		}
+	}
+	return ""
+}
+
+// Registry is what the flags of a project may refer to: the strategies the
+// SDKs evaluate and the context fields constraints test.
+type Registry struct {
+	Strategies    []StrategyDefinition
+	ContextFields []ContextField
+}
+
+// BuiltinRegistry returns the registry of a project without custom
+// strategies or registered context fields.
+func BuiltinRegistry() *Registry {
+	return &Registry{Strategies: BuiltinStrategies(), ContextFields: BuiltinContextFields()}
+}
+
+// ProjectRegistry returns the registry of a project.
+func ProjectRegistry(ctx context.Context, client *ent.Client, projectID int) (*Registry, error) {
+	strategies, err := ProjectStrategies(ctx, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := ProjectContextFields(ctx, client, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return &Registry{Strategies: strategies, ContextFields: fields}, nil
+}
+
+// LookupContextField finds a context field by name.
+func (r *Registry) LookupContextField(name string) (ContextField, bool) {
+	i := slices.IndexFunc(r.ContextFields, func(f ContextField) bool { return f.Name == name })
+	if i < 0 {
+		return ContextField{}, false
+	}
+	return r.ContextFields[i], true
+}
+
+// strict reports whether the project registered context fields. Until it
+// does, constraints may test any field, as they could before the registry
+// existed.
+func (r *Registry) strict() bool {
+	return slices.ContainsFunc(r.ContextFields, func(f ContextField) bool { return !f.Builtin })
+}
+
+// withContextFields returns a copy of the registry where the given fields
+// replace the registered ones of the same name.
+func (r *Registry) withContextFields(fields []ContextField) *Registry {
+	out := &Registry{Strategies: r.Strategies, ContextFields: slices.Clone(r.ContextFields)}
+	for _, f := range fields {
+		i := slices.IndexFunc(out.ContextFields, func(c ContextField) bool { return c.Name == f.Name })
+		if i < 0 {
+			out.ContextFields = append(out.ContextFields, f)
+		} else if !out.ContextFields[i].Builtin {
+			out.ContextFields[i] = f
+		}
+	}
+	return out
+}
+
+// validateConstraint checks a constraint against the context fields; errors
+// are keyed below path, e.g. path+"operator".
+func (r *Registry) validateConstraint(c Constraint, path string, fields map[string]string) {
+	if c.ContextName == "" {
+		fields[path+"context_name"] = "Context name is required"
+	}
+	if entconstraint.OperatorValidator(entconstraint.Operator(c.Operator)) != nil {
+		fields[path+"operator"] = fmt.Sprintf("Unknown operator %q", c.Operator)
+		return
+	}
+	if msg := checkOperands(c.Operator, c.Values); msg != "" {
+		fields[path+"values"] = msg
+		return
+	}
+	if c.ContextName == "" {
+		return
+	}
+
+	field, ok := r.LookupContextField(c.ContextName)
+	if !ok {
+		if !r.strict() {
+			return
+		}
+		msg := fmt.Sprintf("Unknown context field %q", c.ContextName)
+		if i := slices.IndexFunc(r.ContextFields, func(f ContextField) bool { return strings.EqualFold(f.Name, c.ContextName) }); i >= 0 {
+			msg += fmt.Sprintf("; did you mean %q?", r.ContextFields[i].Name)
+		}
+		fields[path+"context_name"] = msg
+		return
+	}
+
+	if !slices.Contains(operatorsByType[field.Type], c.Operator) {
+		fields[path+"operator"] = fmt.Sprintf("Operator %s does not apply to %s, a %s field; use one of: %s",
+			c.Operator, field.Name, field.Type, strings.Join(operatorsByType[field.Type], ", "))
+		return
+	}
+
+	// Values of prefix operators on IP fields are partial addresses, and
+	// those of CIDR ranges were checked above.
+	if c.Operator != "STR_STARTS_WITH" && c.Operator != "IN_CIDR" {
+		for _, v := range c.Values {
+			if msg := checkContextValue(field.Type, v); msg != "" {
+				fields[path+"values"] = msg
+				return
+			}
+		}
+	}
+
+	if len(field.LegalValues) > 0 && slices.Contains([]string{"IN", "NOT_IN", "ANY_OF", "ALL_OF"}, c.Operator) {
+		for _, v := range c.Values {
+			legal := slices.ContainsFunc(field.LegalValues, func(l string) bool {
+				return l == v || c.CaseInsensitive && strings.EqualFold(l, v)
+			})
+			if !legal {
+				fields[path+"values"] = fmt.Sprintf("%q is not a legal value of %s; must be one of: %s",
+					v, field.Name, strings.Join(field.LegalValues, ", "))
+				return
+			}
+		}
+	}
+}
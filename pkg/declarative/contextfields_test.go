@@ -0,0 +1,115 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateContextField(t *testing.T) {
+	tests := []struct {
+		name  string
+		field ContextField
+		want  map[string]string
+	}{
+		{
+			name:  "valid",
+			field: ContextField{Name: "app.version", Type: ContextSemver, LegalValues: []string{"1.0.0", "v2.1.0-beta.1"}},
+			want:  map[string]string{},
+		},
+		{
+			name:  "built-in name",
+			field: ContextField{Name: "sessionId", Type: ContextString},
+			want:  map[string]string{"name": `"sessionId" is a built-in context field`},
+		},
+		{
+			name:  "unknown type",
+			field: ContextField{Name: "plan", Type: "bool"},
+			want:  map[string]string{"type": "Type must be one of: string, number, date, semver, ip"},
+		},
+		{
+			name:  "values of the wrong type",
+			field: ContextField{Name: "signup", Type: ContextDate, LegalValues: []string{"2025-01-31", "yesterday"}},
+			want:  map[string]string{"legal_values": `"yesterday" is not a date (YYYY-MM-DD or RFC 3339)`},
+		},
+		{
+			name:  "duplicate values",
+			field: ContextField{Name: "seats", Type: ContextNumber, LegalValues: []string{"1", "5", "1"}},
+			want:  map[string]string{"legal_values": `Duplicate value "1"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ValidateContextField(tt.field))
+		})
+	}
+}
+
+func TestRegistry_Constraints(t *testing.T) {
+	reg := BuiltinRegistry().withContextFields([]ContextField{
+		{Name: "seats", Type: ContextNumber},
+		{Name: "signup", Type: ContextDate},
+		{Name: "plan", Type: ContextString, LegalValues: []string{"free", "pro"}},
+	})
+
+	tests := []struct {
+		name       string
+		constraint Constraint
+		want       map[string]string
+	}{
+		{
+			name:       "number",
+			constraint: Constraint{ContextName: "seats", Operator: "NUM_GTE", Values: []string{"10"}},
+			want:       map[string]string{},
+		},
+		{
+			name:       "not a number",
+			constraint: Constraint{ContextName: "seats", Operator: "NUM_GTE", Values: []string{"ten"}},
+			want:       map[string]string{"c.values": `"ten" is not a number`},
+		},
+		{
+			name:       "date operator on a number",
+			constraint: Constraint{ContextName: "seats", Operator: "DATE_AFTER", Values: []string{"2025-01-01"}},
+			want:       map[string]string{"c.operator": "Operator DATE_AFTER does not apply to seats, a number field; use one of: IN, NOT_IN, NUM_EQ, NUM_GT, NUM_GTE, NUM_LT, NUM_LTE"},
+		},
+		{
+			name:       "date",
+			constraint: Constraint{ContextName: "signup", Operator: "DATE_BEFORE", Values: []string{"2025-01-31T00:00:00Z"}},
+			want:       map[string]string{},
+		},
+		{
+			name:       "prefix of an ip",
+			constraint: Constraint{ContextName: "remoteAddress", Operator: "STR_STARTS_WITH", Values: []string{"10.0."}},
+			want:       map[string]string{},
+		},
+		{
+			name:       "legal values ignore case when asked",
+			constraint: Constraint{ContextName: "plan", Operator: "IN", Values: []string{"PRO"}, CaseInsensitive: true},
+			want:       map[string]string{},
+		},
+		{
+			name:       "legal values only bind IN and NOT_IN",
+			constraint: Constraint{ContextName: "plan", Operator: "STR_STARTS_WITH", Values: []string{"ent"}},
+			want:       map[string]string{},
+		},
+		{
+			name:       "unregistered field",
+			constraint: Constraint{ContextName: "tenant", Operator: "IN", Values: []string{"acme"}},
+			want:       map[string]string{"c.context_name": `Unknown context field "tenant"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fields := map[string]string{}
+			reg.validateConstraint(tt.constraint, "c.", fields)
+			assert.Equal(t, tt.want, fields)
+		})
+	}
+
+	// Without registered fields, any field may be tested.
+	fields := map[string]string{}
+	BuiltinRegistry().validateConstraint(Constraint{ContextName: "tenant", Operator: "NUM_GT", Values: []string{"1"}}, "c.", fields)
+	assert.Empty(t, fields)
+}
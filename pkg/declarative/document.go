@@ -0,0 +1,340 @@
+package declarative
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+)
+
+// Version is the current document format version. Bump it whenever the
+// format grows: documents with a newer version are rejected so that older
+// binaries never half-apply a newer format, while older versions are still
+// accepted, since their documents simply lack the newer sections.
+//
+//   - 1: environments, flags and strategies with constraints.
+//   - 2: prerequisites, tags, owner, links and metadata, strategy definitions
+//     and context fields.
+const Version = 2
+
+// Supported document encodings.
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+type (
+	// Document describes the desired state of a single project: its
+	// environments, registered context fields, flags, and per-environment
+	// flag configuration.
+	Document struct {
+		Version       int            `json:"version" yaml:"version"`
+		Project       Project        `json:"project" yaml:"project"`
+		Environments  []Environment  `json:"environments" yaml:"environments"`
+		ContextFields []ContextField `json:"context_fields,omitempty" yaml:"context_fields,omitempty"`
+		Flags         []Flag         `json:"flags" yaml:"flags"`
+	}
+
+	// Project holds project-level metadata.
+	Project struct {
+		Name        string `json:"name" yaml:"name"`
+		Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	}
+
+	// Environment is identified by name within the project.
+	Environment struct {
+		Name      string `json:"name" yaml:"name"`
+		Type      string `json:"type" yaml:"type"`
+		SortOrder int    `json:"sort_order" yaml:"sort_order"`
+		// ExpectedMatch names an environment whose flag configuration this
+		// one should match; differences are reported as drift.
+		ExpectedMatch string `json:"expected_match,omitempty" yaml:"expected_match,omitempty"`
+	}
+
+	// Flag is identified by name within the project.
+	Flag struct {
+		Name         string            `json:"name" yaml:"name"`
+		Description  string            `json:"description,omitempty" yaml:"description,omitempty"`
+		FlagType     string            `json:"flag_type" yaml:"flag_type"`
+		Owner        string            `json:"owner,omitempty" yaml:"owner,omitempty"`
+		Tags         []string          `json:"tags,omitempty" yaml:"tags,omitempty"`
+		Links        []Link            `json:"links,omitempty" yaml:"links,omitempty"`
+		Metadata     map[string]string `json:"metadata,omitempty" yaml:"metadata,omitempty"`
+		Environments []FlagEnvironment `json:"environments,omitempty" yaml:"environments,omitempty"`
+	}
+
+	// Link points from a flag to a ticket, pull request, doc or any other
+	// related resource.
+	Link struct {
+		Title string `json:"title,omitempty" yaml:"title,omitempty"`
+		URL   string `json:"url" yaml:"url"`
+	}
+
+	// FlagEnvironment is the configuration of a flag in one environment. It
+	// mirrors the body accepted by the PATCH flag/env admin endpoint; the
+	// strategy order is the list order.
+	FlagEnvironment struct {
+		Environment   string         `json:"environment" yaml:"environment"`
+		Enabled       bool           `json:"enabled" yaml:"enabled"`
+		Strategies    []Strategy     `json:"strategies" yaml:"strategies"`
+		Prerequisites []Prerequisite `json:"prerequisites,omitempty" yaml:"prerequisites,omitempty"`
+	}
+
+	// Prerequisite requires another flag of the project to be in a given
+	// state in the same environment before this flag is evaluated.
+	Prerequisite struct {
+		Flag string `json:"flag" yaml:"flag"`
+		// Enabled is the required state of the parent flag; nil means true.
+		Enabled *bool `json:"enabled,omitempty" yaml:"enabled,omitempty"`
+		// Variant optionally requires the parent flag to resolve to this
+		// variant, for SDKs that support variants.
+		Variant string `json:"variant,omitempty" yaml:"variant,omitempty"`
+	}
+
+	// Strategy is an evaluation strategy with its constraints.
+	Strategy struct {
+		Name        string         `json:"name" yaml:"name"`
+		Parameters  map[string]any `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+		Constraints []Constraint   `json:"constraints" yaml:"constraints"`
+	}
+
+	// Constraint restricts a strategy to contexts matching the operator.
+	Constraint struct {
+		ContextName     string   `json:"context_name" yaml:"context_name"`
+		Operator        string   `json:"operator" yaml:"operator"`
+		Values          []string `json:"values" yaml:"values"`
+		Inverted        bool     `json:"inverted" yaml:"inverted"`
+		CaseInsensitive bool     `json:"case_insensitive" yaml:"case_insensitive"`
+	}
+)
+
+// Decode parses a document in the given format. Unknown fields are rejected so
+// that typos in hand-edited files surface instead of being silently ignored.
+func Decode(data []byte, format string) (*Document, error) {
+	var doc Document
+
+	switch format {
+	case FormatJSON:
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&doc); err != nil {
+			if v := peekVersion(data, format); v > Version {
+				return nil, fmt.Errorf("declarative: %s", newerVersionError(v))
+			}
+			return nil, fmt.Errorf("declarative: invalid JSON document: %w", err)
+		}
+	case FormatYAML:
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&doc); err != nil {
+			if v := peekVersion(data, format); v > Version {
+				return nil, fmt.Errorf("declarative: %s", newerVersionError(v))
+			}
+			return nil, fmt.Errorf("declarative: invalid YAML document: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("declarative: unsupported format %q", format)
+	}
+
+	// Strategy parameters are stored as JSON, so round-trip them through JSON
+	// to get the same value types (float64 numbers, []any lists) as the
+	// database. Otherwise YAML ints would always compare as changed.
+	for i := range doc.Flags {
+		for j := range doc.Flags[i].Environments {
+			for k, s := range doc.Flags[i].Environments[j].Strategies {
+				params, err := normalizeParameters(s.Parameters)
+				if err != nil {
+					return nil, fmt.Errorf("declarative: invalid parameters for strategy %q: %w", s.Name, err)
+				}
+				doc.Flags[i].Environments[j].Strategies[k].Parameters = params
+			}
+		}
+	}
+
+	return &doc, nil
+}
+
+// peekVersion reads the version of a document that does not decode, so that
+// one from a newer release, whose new sections are unknown fields here, is
+// reported as such. It returns 0 when the version cannot be read.
+func peekVersion(data []byte, format string) int {
+	var v struct {
+		Version int `json:"version" yaml:"version"`
+	}
+	if format == FormatJSON {
+		json.Unmarshal(data, &v)
+	} else {
+		yaml.Unmarshal(data, &v)
+	}
+	return v.Version
+}
+
+func newerVersionError(v int) string {
+	return fmt.Sprintf("Version %d is newer than this release supports (%d); upgrade Bandeira to import it", v, Version)
+}
+
+// Encode serializes a document in the given format.
+func Encode(doc *Document, format string) ([]byte, error) {
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(doc, "", "  ")
+	case FormatYAML:
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return nil, err
+		}
+		if err := enc.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("declarative: unsupported format %q", format)
+	}
+}
+
+// FormatFromContentType maps a request Content-Type to a document format,
+// defaulting to JSON.
+func FormatFromContentType(contentType string) string {
+	if strings.Contains(contentType, "yaml") {
+		return FormatYAML
+	}
+	return FormatJSON
+}
+
+// Validate checks the document for structural errors and returns them keyed by
+// field path (e.g. "flags[2].environments[0].environment"). An empty map means
+// the document is valid. Strategies and context fields are checked against the
+// built-in ones and those the document declares; use ValidateFor to allow the
+// registry of a project.
+func (d *Document) Validate() map[string]string {
+	return d.ValidateFor(BuiltinRegistry())
+}
+
+// ValidateFor is Validate with the registry the document may refer to. The
+// context fields the document declares take precedence over the registry's.
+func (d *Document) ValidateFor(reg *Registry) map[string]string {
+	fields := map[string]string{}
+
+	switch {
+	case d.Version > Version:
+		fields["version"] = newerVersionError(d.Version)
+	case d.Version < 1:
+		fields["version"] = fmt.Sprintf("Version must be between 1 and %d", Version)
+	}
+
+	envNames := make(map[string]bool, len(d.Environments))
+	for i, e := range d.Environments {
+		path := fmt.Sprintf("environments[%d]", i)
+		if e.Name == "" {
+			fields[path+".name"] = "Name is required"
+		} else if envNames[e.Name] {
+			fields[path+".name"] = fmt.Sprintf("Duplicate environment %q", e.Name)
+		}
+		envNames[e.Name] = true
+		if environment.TypeValidator(environment.Type(e.Type)) != nil {
+			fields[path+".type"] = "Type must be one of: development, staging, production"
+		}
+	}
+	for i, e := range d.Environments {
+		switch {
+		case e.ExpectedMatch == "":
+		case e.ExpectedMatch == e.Name:
+			fields[fmt.Sprintf("environments[%d].expected_match", i)] = "An environment cannot match itself"
+		case !envNames[e.ExpectedMatch]:
+			fields[fmt.Sprintf("environments[%d].expected_match", i)] = fmt.Sprintf("Environment %q is not declared in the document", e.ExpectedMatch)
+		}
+	}
+
+	cfNames := make(map[string]bool, len(d.ContextFields))
+	for i, cf := range d.ContextFields {
+		path := fmt.Sprintf("context_fields[%d].", i)
+		for k, v := range ValidateContextField(cf) {
+			fields[path+k] = v
+		}
+		if cfNames[cf.Name] {
+			fields[path+"name"] = fmt.Sprintf("Duplicate context field %q", cf.Name)
+		}
+		cfNames[cf.Name] = true
+	}
+	reg = reg.withContextFields(d.ContextFields)
+
+	flagNames := make(map[string]bool, len(d.Flags))
+	for i, f := range d.Flags {
+		path := fmt.Sprintf("flags[%d]", i)
+		if f.Name == "" {
+			fields[path+".name"] = "Name is required"
+		} else if flagNames[f.Name] {
+			fields[path+".name"] = fmt.Sprintf("Duplicate flag %q", f.Name)
+		}
+		flagNames[f.Name] = true
+		if entflag.FlagTypeValidator(entflag.FlagType(f.FlagType)) != nil {
+			fields[path+".flag_type"] = "Flag type must be one of: release, experiment, operational, kill_switch"
+		}
+		for k, v := range ValidateFlagMetadata(NormalizeTags(f.Tags), f.Links, f.Metadata, path+".") {
+			fields[k] = v
+		}
+
+		seen := make(map[string]bool, len(f.Environments))
+		for j, fe := range f.Environments {
+			fePath := fmt.Sprintf("%s.environments[%d]", path, j)
+			switch {
+			case !envNames[fe.Environment]:
+				fields[fePath+".environment"] = fmt.Sprintf("Environment %q is not declared in the document", fe.Environment)
+			case seen[fe.Environment]:
+				fields[fePath+".environment"] = fmt.Sprintf("Duplicate environment %q", fe.Environment)
+			}
+			seen[fe.Environment] = true
+
+			validatePrerequisites(f.Name, fe.Prerequisites, fePath+".", fields)
+
+			for k, s := range fe.Strategies {
+				sPath := fmt.Sprintf("%s.strategies[%d].", fePath, k)
+				for key, msg := range reg.ValidateStrategy(s, sPath) {
+					fields[key] = msg
+				}
+			}
+		}
+	}
+
+	// Cycles among the declared prerequisites, per environment.
+	for _, e := range d.Environments {
+		graph := map[string][]string{}
+		for _, f := range d.Flags {
+			for _, fe := range f.Environments {
+				if fe.Environment != e.Name {
+					continue
+				}
+				for _, p := range fe.Prerequisites {
+					graph[f.Name] = append(graph[f.Name], p.Flag)
+				}
+			}
+		}
+		if cycle := findCycle(graph); cycle != nil {
+			fields["prerequisites"] = cycleError(e.Name, cycle)
+		}
+	}
+
+	return fields
+}
+
+func normalizeParameters(params map[string]any) (map[string]any, error) {
+	if params == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,132 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecode_YAMLNormalizesParameters(t *testing.T) {
+	doc, err := Decode([]byte(`version: 1
+project:
+  name: demo
+environments:
+  - name: dev
+    type: development
+flags:
+  - name: checkout
+    flag_type: release
+    environments:
+      - environment: dev
+        enabled: true
+        strategies:
+          - name: gradualRollout
+            parameters:
+              rollout: 25
+            constraints: []
+`), FormatYAML)
+	require.NoError(t, err)
+
+	params := doc.Flags[0].Environments[0].Strategies[0].Parameters
+	assert.Equal(t, float64(25), params["rollout"])
+	assert.Empty(t, doc.Validate())
+}
+
+func TestDecode_UnknownField(t *testing.T) {
+	_, err := Decode([]byte(`{"version":1,"projcet":{}}`), FormatJSON)
+	assert.Error(t, err)
+
+	_, err = Decode([]byte("version: 1\nprojcet: {}\n"), FormatYAML)
+	assert.Error(t, err)
+}
+
+func TestDecode_NewerVersion(t *testing.T) {
+	// A newer document is reported as such, even when it has sections this
+	// release does not know.
+	_, err := Decode([]byte(`{"version":99,"project":{},"rollouts":[]}`), FormatJSON)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Version 99 is newer")
+
+	doc, err := Decode([]byte("version: 99\nproject:\n  name: demo\n"), FormatYAML)
+	require.NoError(t, err)
+	assert.Contains(t, doc.Validate()["version"], "Version 99 is newer")
+
+	// Older versions are still accepted.
+	doc.Version = 1
+	assert.NotContains(t, doc.Validate(), "version")
+	doc.Version = 0
+	assert.Contains(t, doc.Validate(), "version")
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	doc := &Document{
+		Version:      Version,
+		Project:      Project{Name: "demo"},
+		Environments: []Environment{{Name: "dev", Type: "development"}},
+		Flags: []Flag{{
+			Name:     "checkout",
+			FlagType: "release",
+			Environments: []FlagEnvironment{{
+				Environment: "dev",
+				Enabled:     true,
+				Strategies:  []Strategy{{Name: "default", Constraints: []Constraint{}}},
+			}},
+		}},
+	}
+
+	for _, format := range []string{FormatJSON, FormatYAML} {
+		data, err := Encode(doc, format)
+		require.NoError(t, err)
+		decoded, err := Decode(data, format)
+		require.NoError(t, err)
+		assert.Equal(t, doc, decoded, format)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	doc := &Document{
+		Version: Version + 1,
+		Environments: []Environment{
+			{Name: "dev", Type: "development"},
+			{Name: "dev", Type: "bogus"},
+			{Name: "staging", Type: "staging", ExpectedMatch: "staging"},
+			{Name: "qa", Type: "staging", ExpectedMatch: "prod"},
+		},
+		Flags: []Flag{{
+			Name:     "checkout",
+			FlagType: "release",
+			Environments: []FlagEnvironment{{
+				Environment:   "prod",
+				Prerequisites: []Prerequisite{{Flag: "checkout"}},
+				Strategies: []Strategy{{
+					Name:        "default",
+					Constraints: []Constraint{{ContextName: "region", Operator: "NOPE"}},
+				}},
+			}},
+		}},
+	}
+
+	fields := doc.Validate()
+	assert.Contains(t, fields, "version")
+	assert.Contains(t, fields, "environments[1].name")
+	assert.Contains(t, fields, "environments[1].type")
+	assert.Contains(t, fields, "environments[2].expected_match")
+	assert.Contains(t, fields, "environments[3].expected_match")
+	assert.Contains(t, fields, "flags[0].environments[0].environment")
+	assert.Contains(t, fields, "flags[0].environments[0].strategies[0].constraints[0].operator")
+	assert.Contains(t, fields, "flags[0].environments[0].prerequisites[0].flag")
+}
+
+func TestFindCycle(t *testing.T) {
+	assert.Nil(t, findCycle(map[string][]string{
+		"c": {"b"},
+		"b": {"a"},
+		"d": {"a", "b"},
+	}))
+	assert.Equal(t, []string{"a", "b", "c", "a"}, findCycle(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"a"},
+	}))
+}
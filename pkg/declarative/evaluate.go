@@ -0,0 +1,475 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand/v2"
+	"net"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+)
+
+type (
+	// EvalContext is the context a client evaluates flags for, in the shape
+	// the SDKs receive it. Any other field is read from Properties.
+	EvalContext struct {
+		UserID        string            `json:"userId,omitempty"`
+		SessionID     string            `json:"sessionId,omitempty"`
+		RemoteAddress string            `json:"remoteAddress,omitempty"`
+		Properties    map[string]string `json:"properties,omitempty"`
+	}
+
+	// ConstraintTrace is the outcome of one constraint. Value is the context
+	// value it was checked against.
+	ConstraintTrace struct {
+		Constraint
+		Value  string `json:"value"`
+		Passed bool   `json:"passed"`
+	}
+
+	// StrategyTrace is the outcome of one strategy. Bucket is the computed
+	// rollout bucket (0-99) of a gradualRollout strategy, when the
+	// stickiness value is set. Custom strategies are left to the SDKs and
+	// never match here.
+	StrategyTrace struct {
+		Name        string            `json:"name"`
+		Parameters  map[string]any    `json:"parameters,omitempty"`
+		Matched     bool              `json:"matched"`
+		Reason      string            `json:"reason"`
+		Bucket      *int              `json:"bucket,omitempty"`
+		Custom      bool              `json:"custom,omitempty"`
+		Constraints []ConstraintTrace `json:"constraints"`
+	}
+
+	// PrerequisiteTrace is the outcome of one prerequisite: the state the
+	// parent flag evaluated to against the required one.
+	PrerequisiteTrace struct {
+		Prerequisite
+		Actual bool   `json:"actual"`
+		Passed bool   `json:"passed"`
+		Reason string `json:"reason,omitempty"`
+	}
+
+	// FlagEvaluation explains the result of one flag in one environment.
+	// Strategies are all traced even after the first match; Strategy is the
+	// index of the one that turned the flag on.
+	FlagEvaluation struct {
+		Flag          string              `json:"flag"`
+		Enabled       bool                `json:"enabled"`
+		Reason        string              `json:"reason"`
+		Configured    bool                `json:"configured"`
+		Toggle        bool                `json:"toggle"`
+		Strategy      *int                `json:"strategy,omitempty"`
+		Prerequisites []PrerequisiteTrace `json:"prerequisites,omitempty"`
+		Strategies    []StrategyTrace     `json:"strategies"`
+	}
+
+	// EnvironmentEvaluation holds the results of every flag in one
+	// environment.
+	EnvironmentEvaluation struct {
+		Environment string           `json:"environment"`
+		Flags       []FlagEvaluation `json:"flags"`
+	}
+)
+
+// Evaluate evaluates every flag of a project for a context in the given
+// environments, by name, following the evaluation logic of the SDKs. No names
+// means every environment. Unknown names are reported as a ValidationError.
+func Evaluate(ctx context.Context, orm *ent.Client, projectID int, envNames []string, ec EvalContext) ([]EnvironmentEvaluation, error) {
+	envs, err := orm.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Order(environment.BySortOrder(), environment.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]int, len(envs))
+	for _, e := range envs {
+		ids[e.Name] = e.ID
+	}
+
+	if len(envNames) == 0 {
+		for _, e := range envs {
+			envNames = append(envNames, e.Name)
+		}
+	}
+	seen := make(map[string]bool, len(envNames))
+	for _, name := range envNames {
+		if _, ok := ids[name]; !ok {
+			return nil, &ValidationError{Fields: map[string]string{"environments": fmt.Sprintf("Unknown environment %q", name)}}
+		}
+		if seen[name] {
+			return nil, &ValidationError{Fields: map[string]string{"environments": fmt.Sprintf("Duplicate environment %q", name)}}
+		}
+		seen[name] = true
+	}
+
+	flags, err := loadFlags(ctx, orm, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]EnvironmentEvaluation, 0, len(envNames))
+	for _, name := range envNames {
+		out = append(out, EvaluateEnvironment(flags, ids[name], name, ec))
+	}
+	return out, nil
+}
+
+// EvaluateEnvironment evaluates loaded flags (see loadFlags) in one
+// environment.
+func EvaluateEnvironment(flags []*ent.Flag, envID int, envName string, ec EvalContext) EnvironmentEvaluation {
+	e := &evaluator{
+		ctx:     ec,
+		configs: make(map[string]*ent.FlagEnvironment, len(flags)),
+		results: make(map[string]*FlagEvaluation, len(flags)),
+	}
+	for _, f := range flags {
+		e.configs[f.Name] = nil
+		for _, fe := range f.Edges.FlagEnvironments {
+			if fe.EnvironmentID == envID {
+				e.configs[f.Name] = fe
+			}
+		}
+	}
+
+	ev := EnvironmentEvaluation{Environment: envName, Flags: make([]FlagEvaluation, 0, len(flags))}
+	for _, f := range flags {
+		ev.Flags = append(ev.Flags, *e.flag(f.Name))
+	}
+	return ev
+}
+
+type evaluator struct {
+	ctx     EvalContext
+	configs map[string]*ent.FlagEnvironment
+	results map[string]*FlagEvaluation
+}
+
+// flag evaluates a flag once, prerequisites first. A flag reached again while
+// being evaluated is a cycle, which writes reject; it evaluates as off.
+func (e *evaluator) flag(name string) *FlagEvaluation {
+	if r, ok := e.results[name]; ok {
+		return r
+	}
+	r := &FlagEvaluation{Flag: name, Strategies: []StrategyTrace{}, Reason: "Prerequisite cycle"}
+	e.results[name] = r
+
+	fe, ok := e.configs[name]
+	if !ok || fe == nil {
+		r.Reason = "Not configured in this environment"
+		return r
+	}
+	r.Configured = true
+	r.Toggle = fe.Enabled
+
+	prereqsMet := true
+	for _, p := range prerequisitesFromEnt(fe.Edges.Prerequisites) {
+		pt := PrerequisiteTrace{Prerequisite: p}
+		if _, ok := e.configs[p.Flag]; ok {
+			pt.Actual = e.flag(p.Flag).Enabled
+		} else {
+			pt.Reason = "Unknown flag"
+		}
+		pt.Passed = pt.Actual == p.Required()
+		if pt.Passed && p.Variant != "" {
+			pt.Reason = fmt.Sprintf("Variant %q is checked by the SDK", p.Variant)
+		}
+		prereqsMet = prereqsMet && pt.Passed
+		r.Prerequisites = append(r.Prerequisites, pt)
+	}
+
+	for _, s := range strategiesFromEnt(fe.Edges.Strategies) {
+		r.Strategies = append(r.Strategies, e.strategy(s))
+	}
+	for i, s := range r.Strategies {
+		if s.Matched {
+			r.Strategy = &i
+			break
+		}
+	}
+
+	switch {
+	case !prereqsMet:
+		r.Reason = "A prerequisite is not met"
+	case !fe.Enabled:
+		r.Reason = "Disabled in this environment"
+	case len(r.Strategies) == 0:
+		r.Enabled = true
+		r.Reason = "Enabled with no strategies"
+	case r.Strategy != nil:
+		r.Enabled = true
+		r.Reason = fmt.Sprintf("Strategy %d (%s) matched", *r.Strategy+1, r.Strategies[*r.Strategy].Name)
+	case slices.ContainsFunc(r.Strategies, func(s StrategyTrace) bool { return s.Custom }):
+		r.Reason = "No built-in strategy matched; custom strategies are evaluated by the SDK"
+	default:
+		r.Reason = "No strategy matched"
+	}
+	return r
+}
+
+// strategy evaluates the constraints of a strategy (ANDed), then the strategy
+// itself. The strategy is traced even when a constraint fails, so the bucket
+// is always reported.
+func (e *evaluator) strategy(s Strategy) StrategyTrace {
+	t := StrategyTrace{Name: s.Name, Parameters: s.Parameters, Constraints: make([]ConstraintTrace, 0, len(s.Constraints))}
+
+	passed := true
+	for _, c := range s.Constraints {
+		ct := ConstraintTrace{Constraint: c, Value: e.value(c.ContextName)}
+		ct.Passed = evalOperator(c.Operator, ct.Value, c.Values, c.CaseInsensitive) != c.Inverted
+		passed = passed && ct.Passed
+		t.Constraints = append(t.Constraints, ct)
+	}
+
+	var matched bool
+	switch s.Name {
+	case "default":
+		matched, t.Reason = true, "On for everyone"
+	case "userWithId":
+		matched, t.Reason = e.userWithID(s.Parameters)
+	case "gradualRollout":
+		matched, t.Reason, t.Bucket = e.gradualRollout(s.Parameters)
+	case "remoteAddress":
+		matched, t.Reason = e.remoteAddress(s.Parameters)
+	default:
+		t.Custom = true
+		t.Reason = fmt.Sprintf("Custom strategy %q is evaluated by the SDK", s.Name)
+	}
+
+	t.Matched = passed && matched
+	if !passed {
+		t.Reason = "A constraint failed; " + t.Reason
+	}
+	return t
+}
+
+// value reads a context field the way the SDKs do.
+func (e *evaluator) value(name string) string {
+	switch name {
+	case "userId":
+		return e.ctx.UserID
+	case "sessionId":
+		return e.ctx.SessionID
+	case "remoteAddress":
+		return e.ctx.RemoteAddress
+	}
+	return e.ctx.Properties[name]
+}
+
+func (e *evaluator) userWithID(params map[string]any) (bool, string) {
+	raw, _ := params["userIds"].(string)
+	if slices.Contains(SplitList(raw), e.ctx.UserID) {
+		return true, fmt.Sprintf("User %q is listed", e.ctx.UserID)
+	}
+	return false, fmt.Sprintf("User %q is not listed", e.ctx.UserID)
+}
+
+func (e *evaluator) gradualRollout(params map[string]any) (bool, string, *int) {
+	var rollout float64
+	switch v := params["rollout"].(type) {
+	case float64:
+		rollout = v
+	case int:
+		rollout = float64(v)
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		if err != nil {
+			return false, "Rollout is not a number", nil
+		}
+		rollout = f
+	default:
+		return false, "Rollout is not set", nil
+	}
+
+	// As in the SDKs, the ends hold whatever the stickiness, even for a
+	// context without a value for it.
+	if rollout >= 100 {
+		return true, "Rollout is 100%; always on", nil
+	}
+	if rollout <= 0 {
+		return false, "Rollout is 0%; always off", nil
+	}
+
+	stickiness, _ := params["stickiness"].(string)
+	groupID, _ := params["groupId"].(string)
+	var value string
+	switch stickiness {
+	case "", "default":
+		stickiness = "userId"
+		value = e.ctx.UserID
+		if value == "" {
+			stickiness, value = "sessionId", e.ctx.SessionID
+		}
+	case "random":
+		bucket := rand.IntN(100)
+		return float64(bucket) < rollout, fmt.Sprintf("Random bucket %d of %s%%; varies per evaluation", bucket, formatFloat(rollout)), &bucket
+	default:
+		value = e.value(stickiness)
+	}
+	if value == "" {
+		return false, fmt.Sprintf("Stickiness field %s is empty", stickiness), nil
+	}
+
+	bucket := Bucket(value + groupID)
+	if float64(bucket) < rollout {
+		return true, fmt.Sprintf("Bucket %d is within %s%%", bucket, formatFloat(rollout)), &bucket
+	}
+	return false, fmt.Sprintf("Bucket %d is outside %s%%", bucket, formatFloat(rollout)), &bucket
+}
+
+func (e *evaluator) remoteAddress(params map[string]any) (bool, string) {
+	raw, _ := params["ips"].(string)
+	addr := e.ctx.RemoteAddress
+	ip := net.ParseIP(addr)
+	for _, rule := range SplitList(raw) {
+		if rule == addr {
+			return true, fmt.Sprintf("%q matches %q", addr, rule)
+		}
+		if strings.HasSuffix(rule, ".") && strings.HasPrefix(addr, rule) {
+			return true, fmt.Sprintf("%q matches prefix %q", addr, rule)
+		}
+		if _, n, err := net.ParseCIDR(rule); err == nil && ip != nil && n.Contains(ip) {
+			return true, fmt.Sprintf("%q is in %q", addr, rule)
+		}
+	}
+	return false, fmt.Sprintf("%q is not listed", addr)
+}
+
+// Bucket returns the rollout bucket (0-99) of a stickiness value followed by
+// the group ID: the FNV-1a 32-bit hash modulo 100, as the SDKs compute it.
+func Bucket(s string) int {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+	return int(h.Sum32() % 100)
+}
+
+// evalOperator reports whether a context value satisfies an operator for any
+// of the values (every value for ALL_OF), before inversion.
+func evalOperator(op, value string, values []string, caseInsensitive bool) bool {
+	fold := func(s string) string {
+		if caseInsensitive {
+			return strings.ToLower(s)
+		}
+		return s
+	}
+	cv := fold(value)
+	some := func(match func(v string) bool) bool {
+		return slices.ContainsFunc(values, match)
+	}
+
+	switch op {
+	case "IN":
+		return some(func(v string) bool { return cv == fold(v) })
+	case "NOT_IN":
+		return !some(func(v string) bool { return cv == fold(v) })
+	case "STR_CONTAINS":
+		return some(func(v string) bool { return strings.Contains(cv, fold(v)) })
+	case "STR_STARTS_WITH":
+		return some(func(v string) bool { return strings.HasPrefix(cv, fold(v)) })
+	case "STR_ENDS_WITH":
+		return some(func(v string) bool { return strings.HasSuffix(cv, fold(v)) })
+
+	case "NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE":
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return false
+		}
+		return some(func(v string) bool {
+			t, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return false
+			}
+			switch op {
+			case "NUM_EQ":
+				return n == t
+			case "NUM_GT":
+				return n > t
+			case "NUM_GTE":
+				return n >= t
+			case "NUM_LT":
+				return n < t
+			}
+			return n <= t
+		})
+
+	case "DATE_AFTER", "DATE_BEFORE":
+		d, ok := parseDate(value)
+		if !ok {
+			return false
+		}
+		return some(func(v string) bool {
+			t, ok := parseDate(v)
+			if !ok {
+				return false
+			}
+			if op == "DATE_AFTER" {
+				return d.After(t)
+			}
+			return d.Before(t)
+		})
+
+	case "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT":
+		return some(func(v string) bool {
+			cmp, ok := CompareSemver(value, v)
+			switch {
+			case !ok:
+				return false
+			case op == "SEMVER_EQ":
+				return cmp == 0
+			case op == "SEMVER_GT":
+				return cmp > 0
+			}
+			return cmp < 0
+		})
+
+	case "REGEX":
+		return some(func(v string) bool {
+			if caseInsensitive {
+				v = "(?i)" + v
+			}
+			re, err := regexp.Compile(v)
+			return err == nil && re.MatchString(value)
+		})
+
+	case "IN_CIDR":
+		ip := net.ParseIP(strings.TrimSpace(value))
+		return ip != nil && some(func(v string) bool {
+			_, n, err := net.ParseCIDR(v)
+			return err == nil && n.Contains(ip)
+		})
+
+	// List-valued context holds its items comma-separated.
+	case "ANY_OF", "ALL_OF":
+		items := SplitList(cv)
+		in := func(v string) bool { return slices.Contains(items, fold(v)) }
+		if op == "ANY_OF" {
+			return some(in)
+		}
+		for _, v := range values {
+			if !in(v) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, true
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, true
+	}
+	return time.Time{}, false
+}
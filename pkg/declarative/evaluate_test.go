@@ -0,0 +1,122 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+)
+
+func TestBucket_MatchesSDKs(t *testing.T) {
+	// Values computed with the dashboard's normalizedHash.
+	assert.Equal(t, 0, Bucket("user-1"))
+	assert.Equal(t, 57, Bucket("user-2"))
+	assert.Equal(t, 66, Bucket("alice"+"exp"))
+}
+
+func TestEvalOperator(t *testing.T) {
+	tests := []struct {
+		op     string
+		value  string
+		values []string
+		ci     bool
+		want   bool
+	}{
+		{"IN", "pro", []string{"free", "pro"}, false, true},
+		{"IN", "PRO", []string{"pro"}, true, true},
+		{"NOT_IN", "pro", []string{"free"}, false, true},
+		{"STR_ENDS_WITH", "a@example.com", []string{"@example.com"}, false, true},
+		{"NUM_GTE", "18", []string{"18"}, false, true},
+		{"NUM_LT", "abc", []string{"18"}, false, false},
+		{"DATE_AFTER", "2026-05-01", []string{"2026-01-01T00:00:00Z"}, false, true},
+		{"SEMVER_GT", "2.10.0", []string{"2.9.0"}, false, true},
+		{"SEMVER_LT", "1.0.0-rc.1", []string{"1.0.0"}, false, true},
+		{"REGEX", "Beta-42", []string{`^beta-\d+$`}, true, true},
+		{"IN_CIDR", "10.1.2.3", []string{"10.0.0.0/8"}, false, true},
+		{"IN_CIDR", "192.168.0.1", []string{"10.0.0.0/8"}, false, false},
+		{"ANY_OF", "a,b", []string{"b", "c"}, false, true},
+		{"ALL_OF", "a,b", []string{"b", "c"}, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op+" "+tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, evalOperator(tt.op, tt.value, tt.values, tt.ci))
+		})
+	}
+}
+
+func TestEvaluateEnvironment(t *testing.T) {
+	strat := func(name string, params map[string]any, cons ...*ent.Constraint) *ent.Strategy {
+		return &ent.Strategy{Name: name, Parameters: params, Edges: ent.StrategyEdges{Constraints: cons}}
+	}
+	config := func(enabled bool, prereqs []*ent.Prerequisite, strategies ...*ent.Strategy) []*ent.FlagEnvironment {
+		return []*ent.FlagEnvironment{{EnvironmentID: 1, Enabled: enabled, Edges: ent.FlagEnvironmentEdges{Strategies: strategies, Prerequisites: prereqs}}}
+	}
+	flag := func(name string, envs []*ent.FlagEnvironment) *ent.Flag {
+		return &ent.Flag{Name: name, Edges: ent.FlagEdges{FlagEnvironments: envs}}
+	}
+	parent := flag("base", config(true, nil))
+
+	flags := []*ent.Flag{
+		parent,
+		flag("off", config(false, nil)),
+		flag("unconfigured", nil),
+		flag("rollout", config(true, nil,
+			strat("gradualRollout", map[string]any{"rollout": float64(50), "groupId": "exp"},
+				&ent.Constraint{ContextName: "plan", Operator: entconstraint.OperatorIN, Values: []string{"pro"}}),
+			strat("userWithId", map[string]any{"userIds": "bob\nalice"}),
+		)),
+		flag("gated", config(true, []*ent.Prerequisite{
+			{Enabled: false, Edges: ent.PrerequisiteEdges{ParentFlag: parent}},
+		})),
+		flag("custom", config(true, nil, strat("byTenant", nil))),
+		flag("full", config(true, nil, strat("gradualRollout", map[string]any{"rollout": float64(100), "stickiness": "tenantId"}))),
+		flag("none", config(true, nil, strat("gradualRollout", map[string]any{"rollout": "0"}))),
+	}
+
+	ev := EvaluateEnvironment(flags, 1, "production", EvalContext{UserID: "alice", Properties: map[string]string{"plan": "free"}})
+	require.Len(t, ev.Flags, 8)
+	byName := map[string]FlagEvaluation{}
+	for _, f := range ev.Flags {
+		byName[f.Flag] = f
+	}
+
+	assert.True(t, byName["base"].Enabled)
+	assert.Equal(t, "Enabled with no strategies", byName["base"].Reason)
+
+	assert.False(t, byName["off"].Enabled)
+	assert.Equal(t, "Disabled in this environment", byName["off"].Reason)
+
+	assert.False(t, byName["unconfigured"].Configured)
+
+	r := byName["rollout"]
+	assert.True(t, r.Enabled)
+	require.NotNil(t, r.Strategy)
+	assert.Equal(t, 1, *r.Strategy)
+	require.Len(t, r.Strategies, 2)
+	assert.False(t, r.Strategies[0].Matched)
+	require.NotNil(t, r.Strategies[0].Bucket)
+	assert.Equal(t, 66, *r.Strategies[0].Bucket, "the bucket is reported even when a constraint fails")
+	assert.Equal(t, "free", r.Strategies[0].Constraints[0].Value)
+	assert.False(t, r.Strategies[0].Constraints[0].Passed)
+	assert.True(t, r.Strategies[1].Matched)
+
+	g := byName["gated"]
+	assert.False(t, g.Enabled)
+	assert.Equal(t, "A prerequisite is not met", g.Reason)
+	require.Len(t, g.Prerequisites, 1)
+	assert.True(t, g.Prerequisites[0].Actual)
+	assert.False(t, g.Prerequisites[0].Passed)
+
+	c := byName["custom"]
+	assert.False(t, c.Enabled)
+	assert.True(t, c.Strategies[0].Custom)
+	assert.Contains(t, c.Reason, "custom strategies are evaluated by the SDK")
+
+	// A full rollout does not need the stickiness field, which alice lacks.
+	assert.True(t, byName["full"].Enabled)
+	assert.Nil(t, byName["full"].Strategies[0].Bucket)
+	assert.False(t, byName["none"].Enabled)
+}
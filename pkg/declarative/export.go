@@ -0,0 +1,167 @@
+package declarative
+
+import (
+	"context"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+)
+
+// Export builds a document describing the current state of a project.
+func Export(ctx context.Context, orm *ent.Client, projectID int) (*Document, error) {
+	p, err := orm.Project.Query().
+		Where(project.ID(projectID)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	envs, err := orm.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Order(environment.BySortOrder(), environment.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contextFields, err := orm.ContextField.Query().
+		Where(contextfield.ProjectID(projectID)).
+		Order(contextfield.ByName()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := loadFlags(ctx, orm, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		Version: Version,
+		Project: Project{
+			Name:        p.Name,
+			Description: p.Description,
+		},
+		Environments: make([]Environment, 0, len(envs)),
+		Flags:        make([]Flag, 0, len(flags)),
+	}
+
+	envNames := make(map[int]string, len(envs))
+	for _, e := range envs {
+		envNames[e.ID] = e.Name
+	}
+	for _, e := range envs {
+		de := Environment{
+			Name:      e.Name,
+			Type:      string(e.Type),
+			SortOrder: e.SortOrder,
+		}
+		if e.ExpectedMatchID != nil {
+			de.ExpectedMatch = envNames[*e.ExpectedMatchID]
+		}
+		doc.Environments = append(doc.Environments, de)
+	}
+
+	for _, f := range contextFields {
+		cf := ContextFieldFromEnt(f)
+		cf.ID = 0
+		doc.ContextFields = append(doc.ContextFields, cf)
+	}
+
+	for _, f := range flags {
+		df := Flag{
+			Name:        f.Name,
+			Description: f.Description,
+			FlagType:    string(f.FlagType),
+			Owner:       f.Owner,
+			Links:       LinksFromEnt(f.Links),
+			Metadata:    f.Metadata,
+		}
+		if len(f.Edges.Tags) > 0 {
+			df.Tags = TagNames(f.Edges.Tags)
+		}
+
+		// Emit environments in the project's environment order so that
+		// exports are stable and diff cleanly under version control.
+		byEnv := make(map[int]*ent.FlagEnvironment, len(f.Edges.FlagEnvironments))
+		for _, fe := range f.Edges.FlagEnvironments {
+			byEnv[fe.EnvironmentID] = fe
+		}
+		for _, e := range envs {
+			fe, ok := byEnv[e.ID]
+			if !ok {
+				continue
+			}
+			df.Environments = append(df.Environments, FlagEnvironment{
+				Environment:   envNames[fe.EnvironmentID],
+				Enabled:       fe.Enabled,
+				Strategies:    strategiesFromEnt(fe.Edges.Strategies),
+				Prerequisites: prerequisitesFromEnt(fe.Edges.Prerequisites),
+			})
+		}
+
+		doc.Flags = append(doc.Flags, df)
+	}
+
+	return doc, nil
+}
+
+// loadFlags loads all flags of a project with their tags and full environment
+// tree, including prerequisites with their parent flag.
+func loadFlags(ctx context.Context, orm *ent.Client, projectID int) ([]*ent.Flag, error) {
+	return orm.Flag.Query().
+		Where(entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		Order(entflag.ByName()).
+		WithTags().
+		WithFlagEnvironments(func(q *ent.FlagEnvironmentQuery) {
+			q.Where(flagenvironment.HasEnvironmentWith(environment.DeletedAtIsNil()))
+			q.WithStrategies(func(sq *ent.StrategyQuery) {
+				sq.Order(strategy.BySortOrder(), strategy.ByID())
+				sq.WithConstraints(func(cq *ent.ConstraintQuery) {
+					cq.Order(entconstraint.ByID())
+				})
+			})
+			q.WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+				pq.WithParentFlag()
+			})
+		}).
+		All(ctx)
+}
+
+// strategiesFromEnt converts loaded strategies (with constraints) to their
+// document form.
+func strategiesFromEnt(strategies []*ent.Strategy) []Strategy {
+	out := make([]Strategy, 0, len(strategies))
+	for _, s := range strategies {
+		ds := Strategy{
+			Name:        s.Name,
+			Parameters:  s.Parameters,
+			Constraints: make([]Constraint, 0, len(s.Edges.Constraints)),
+		}
+		if len(ds.Parameters) == 0 {
+			ds.Parameters = nil
+		}
+		for _, c := range s.Edges.Constraints {
+			values := c.Values
+			if values == nil {
+				values = []string{}
+			}
+			ds.Constraints = append(ds.Constraints, Constraint{
+				ContextName:     c.ContextName,
+				Operator:        string(c.Operator),
+				Values:          values,
+				Inverted:        c.Inverted,
+				CaseInsensitive: c.CaseInsensitive,
+			})
+		}
+		out = append(out, ds)
+	}
+	return out
+}
@@ -0,0 +1,129 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	"github.com/felipekafuri/bandeira/ent/project"
+)
+
+// Freeze describes a frozen project or environment. While it lasts, only
+// admins may change it.
+type Freeze struct {
+	FrozenAt time.Time `json:"frozen_at"`
+	FrozenBy string    `json:"frozen_by,omitempty"`
+	Reason   string    `json:"reason"`
+}
+
+// ProjectFreeze returns the freeze of a project, or nil.
+func ProjectFreeze(p *ent.Project) *Freeze {
+	if p.FrozenAt == nil {
+		return nil
+	}
+	return &Freeze{FrozenAt: *p.FrozenAt, FrozenBy: p.FrozenBy, Reason: p.FreezeReason}
+}
+
+// EnvironmentFreeze returns the freeze of an environment, or nil.
+func EnvironmentFreeze(e *ent.Environment) *Freeze {
+	if e.FrozenAt == nil {
+		return nil
+	}
+	return &Freeze{FrozenAt: *e.FrozenAt, FrozenBy: e.FrozenBy, Reason: e.FreezeReason}
+}
+
+// FrozenError is returned for a change to a frozen project or environment.
+type FrozenError struct {
+	// Environment names the frozen environment; it is empty when the whole
+	// project is frozen.
+	Environment string
+	Freeze
+}
+
+func (e *FrozenError) Error() string {
+	if e.Environment == "" {
+		return "declarative: project is frozen: " + e.Reason
+	}
+	return fmt.Sprintf("declarative: environment %q is frozen: %s", e.Environment, e.Reason)
+}
+
+// CheckFrozen returns a FrozenError when the project, or one of the
+// environments a change affects, is frozen.
+func CheckFrozen(ctx context.Context, client *ent.Client, projectID int, envIDs ...int) error {
+	p, err := client.Project.Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if f := ProjectFreeze(p); f != nil {
+		return &FrozenError{Freeze: *f}
+	}
+	if len(envIDs) == 0 {
+		return nil
+	}
+
+	frozen, err := client.Environment.Query().
+		Where(
+			environment.IDIn(envIDs...),
+			environment.ProjectID(projectID),
+			environment.FrozenAtNotNil(),
+		).
+		Order(environment.BySortOrder(), environment.ByID()).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return &FrozenError{Environment: frozen.Name, Freeze: *EnvironmentFreeze(frozen)}
+}
+
+// FreezeProject freezes a project. Freezing it again replaces the reason.
+func FreezeProject(ctx context.Context, client *ent.Client, projectID int, by, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return &ValidationError{Fields: map[string]string{"reason": "Reason is required"}}
+	}
+	return client.Project.Update().
+		Where(project.ID(projectID)).
+		SetFrozenAt(time.Now()).
+		SetFrozenBy(by).
+		SetFreezeReason(reason).
+		Exec(ctx)
+}
+
+// UnfreezeProject lifts the freeze of a project.
+func UnfreezeProject(ctx context.Context, client *ent.Client, projectID int) error {
+	return client.Project.Update().
+		Where(project.ID(projectID)).
+		ClearFrozenAt().
+		ClearFrozenBy().
+		ClearFreezeReason().
+		Exec(ctx)
+}
+
+// FreezeEnvironment freezes an environment. Freezing it again replaces the
+// reason.
+func FreezeEnvironment(ctx context.Context, client *ent.Client, envID int, by, reason string) error {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return &ValidationError{Fields: map[string]string{"reason": "Reason is required"}}
+	}
+	return client.Environment.UpdateOneID(envID).
+		SetFrozenAt(time.Now()).
+		SetFrozenBy(by).
+		SetFreezeReason(reason).
+		Exec(ctx)
+}
+
+// UnfreezeEnvironment lifts the freeze of an environment.
+func UnfreezeEnvironment(ctx context.Context, client *ent.Client, envID int) error {
+	return client.Environment.UpdateOneID(envID).
+		ClearFrozenAt().
+		ClearFrozenBy().
+		ClearFreezeReason().
+		Exec(ctx)
+}
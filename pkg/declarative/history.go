@@ -0,0 +1,335 @@
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+)
+
+// FlagVersion is a recorded snapshot of a flag's configuration in one
+// environment.
+type FlagVersion struct {
+	Version   int             `json:"version"`
+	Actor     string          `json:"actor,omitempty"`
+	Reason    string          `json:"reason,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+	Config    FlagEnvironment `json:"config"`
+}
+
+// RecordVersion snapshots the current configuration of a flag environment as
+// its next version and returns it. Nothing is recorded, and nil is returned,
+// when the configuration equals the latest version.
+func RecordVersion(ctx context.Context, orm *ent.Client, feID int, actor string) (*FlagVersion, error) {
+	return RecordVersionWithReason(ctx, orm, feID, actor, "")
+}
+
+// RecordVersionWithReason is RecordVersion with the reason for the change.
+func RecordVersionWithReason(ctx context.Context, orm *ent.Client, feID int, actor, reason string) (*FlagVersion, error) {
+	fe, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.ID(feID)).
+		WithStrategies(func(sq *ent.StrategyQuery) {
+			sq.Order(strategy.BySortOrder(), strategy.ByID())
+			sq.WithConstraints(func(cq *ent.ConstraintQuery) {
+				cq.Order(entconstraint.ByID())
+			})
+		}).
+		WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+			pq.WithParentFlag()
+		}).
+		WithEnvironment().
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The environment name is left out of the snapshot and filled in when
+	// reading, so that renaming an environment keeps its history intact.
+	config := FlagEnvironment{
+		Enabled:       fe.Enabled,
+		Strategies:    normalizeStrategies(strategiesFromEnt(fe.Edges.Strategies)),
+		Prerequisites: prerequisitesFromEnt(fe.Edges.Prerequisites),
+	}
+	snapshot, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	next := 1
+	latest, err := orm.FlagEnvironmentVersion.Query().
+		Where(flagenvironmentversion.FlagEnvironmentID(feID)).
+		Order(ent.Desc(flagenvironmentversion.FieldVersion)).
+		First(ctx)
+	switch {
+	case err == nil:
+		if bytes.Equal(latest.Snapshot, snapshot) {
+			return nil, nil
+		}
+		next = latest.Version + 1
+	case !ent.IsNotFound(err):
+		return nil, err
+	}
+
+	v, err := orm.FlagEnvironmentVersion.Create().
+		SetFlagEnvironmentID(feID).
+		SetVersion(next).
+		SetSnapshot(snapshot).
+		SetNillableActor(nilIfEmpty(actor)).
+		SetNillableReason(nilIfEmpty(reason)).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return versionFromEnt(v, fe.Edges.Environment.Name)
+}
+
+// Versions lists the recorded versions of a flag in one environment, newest
+// first. A flag not configured in the environment has no versions.
+func Versions(ctx context.Context, orm *ent.Client, projectID, flagID, envID int) ([]FlagVersion, error) {
+	fe, err := findFlagEnvironment(ctx, orm, projectID, flagID, envID)
+	if ent.IsNotFound(err) {
+		return []FlagVersion{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := fe.QueryVersions().
+		Order(ent.Desc(flagenvironmentversion.FieldVersion)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]FlagVersion, 0, len(versions))
+	for _, v := range versions {
+		fv, err := versionFromEnt(v, fe.Edges.Environment.Name)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, *fv)
+	}
+	return out, nil
+}
+
+// DiffVersions lists the settings that differ between two versions of a flag
+// in one environment, by path as in Compare. A missing version is reported as
+// a not-found error.
+func DiffVersions(ctx context.Context, orm *ent.Client, projectID, flagID, envID, from, to int) ([]FieldChange, error) {
+	fe, err := findFlagEnvironment(ctx, orm, projectID, flagID, envID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := loadVersion(ctx, orm, fe, from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := loadVersion(ctx, orm, fe, to)
+	if err != nil {
+		return nil, err
+	}
+	return diffConfigs(a.Config, b.Config), nil
+}
+
+// RestoreVersion reverts a flag in one environment to a recorded version
+// inside a single transaction, and records the result as a new version.
+// Prerequisites on flags that no longer exist are reported as a
+// ValidationError. A non-zero revision must match the current one of the
+// flag environment, or a ConflictError is returned.
+func RestoreVersion(ctx context.Context, orm *ent.Client, projectID, flagID, envID, version, revision int, actor string) (*Diff, error) {
+	fe, err := findFlagEnvironment(ctx, orm, projectID, flagID, envID)
+	if err != nil {
+		return nil, err
+	}
+	v, err := loadVersion(ctx, orm, fe, version)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{
+		ctx:       ctx,
+		client:    tx.Client(),
+		projectID: projectID,
+		opts:      Options{Actor: actor},
+		diff:      &Diff{Changes: []Change{}},
+	}
+	a.expect(fe.ID, revision)
+	if err := a.restore(fe, v.Config); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a.diff, nil
+}
+
+func (a *applier) restore(fe *ent.FlagEnvironment, config FlagEnvironment) error {
+	flags, err := loadFlags(a.ctx, a.client, a.projectID)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*ent.Flag, len(flags))
+	var f *ent.Flag
+	for _, pf := range flags {
+		byName[pf.Name] = pf
+		if pf.ID == fe.FlagID {
+			f = pf
+		}
+	}
+	if f == nil {
+		return &ent.NotFoundError{}
+	}
+
+	env := fe.Edges.Environment
+	envIDs := map[string]int{env.Name: env.ID}
+	envNames := map[int]string{env.ID: env.Name}
+	df := Flag{Name: f.Name, Environments: []FlagEnvironment{config}}
+	if err := a.applyFlagEnvironments(f, df, envIDs, envNames); err != nil {
+		return err
+	}
+	if err := a.applyPrerequisites([]Flag{df}, byName, envIDs); err != nil {
+		return err
+	}
+	return a.recordVersions()
+}
+
+// touch marks a flag environment as changed by this run, so that a version is
+// recorded for it. The caller notes on the result what it changed.
+func (a *applier) touch(feID int) *FlagEnvironmentChange {
+	if a.touched == nil {
+		a.touched = map[int]*FlagEnvironmentChange{}
+	}
+	if a.touched[feID] == nil {
+		a.touched[feID] = &FlagEnvironmentChange{ID: feID}
+	}
+	return a.touched[feID]
+}
+
+// recordVersions bumps the revisions (see bumpRevisions), then records a
+// version of every flag environment changed by this run, in ID order, and
+// lists them in the diff.
+func (a *applier) recordVersions() error {
+	if err := a.bumpRevisions(); err != nil {
+		return err
+	}
+	ids := make([]int, 0, len(a.touched))
+	for id := range a.touched {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if _, err := RecordVersion(a.ctx, a.client, id, a.opts.Actor); err != nil {
+			return err
+		}
+	}
+
+	fes, err := a.client.FlagEnvironment.Query().
+		Where(flagenvironment.IDIn(ids...)).
+		WithFlag().
+		WithEnvironment().
+		Order(flagenvironment.ByID()).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+	for _, fe := range fes {
+		change := *a.touched[fe.ID]
+		change.FlagID = fe.FlagID
+		change.Flag = fe.Edges.Flag.Name
+		change.EnvironmentID = fe.EnvironmentID
+		change.Environment = fe.Edges.Environment.Name
+		change.Revision = fe.Revision
+		a.diff.FlagEnvironments = append(a.diff.FlagEnvironments, change)
+	}
+	return nil
+}
+
+// findFlagEnvironment loads the config of a project's flag in one
+// environment, with its environment.
+func findFlagEnvironment(ctx context.Context, orm *ent.Client, projectID, flagID, envID int) (*ent.FlagEnvironment, error) {
+	return orm.FlagEnvironment.Query().
+		Where(
+			flagenvironment.FlagID(flagID),
+			flagenvironment.EnvironmentID(envID),
+			flagenvironment.HasFlagWith(entflag.ProjectID(projectID), entflag.DeletedAtIsNil()),
+			flagenvironment.HasEnvironmentWith(environment.DeletedAtIsNil()),
+		).
+		WithEnvironment().
+		Only(ctx)
+}
+
+func loadVersion(ctx context.Context, orm *ent.Client, fe *ent.FlagEnvironment, version int) (*FlagVersion, error) {
+	v, err := fe.QueryVersions().
+		Where(flagenvironmentversion.Version(version)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return versionFromEnt(v, fe.Edges.Environment.Name)
+}
+
+func versionFromEnt(v *ent.FlagEnvironmentVersion, envName string) (*FlagVersion, error) {
+	var config FlagEnvironment
+	if err := json.Unmarshal(v.Snapshot, &config); err != nil {
+		return nil, err
+	}
+	config.Environment = envName
+	return &FlagVersion{
+		Version:   v.Version,
+		Actor:     v.Actor,
+		Reason:    v.Reason,
+		CreatedAt: v.CreatedAt,
+		Config:    config,
+	}, nil
+}
+
+// diffConfigs lists the settings that differ between two configurations of a
+// flag environment. A setting absent on one side is nil there.
+func diffConfigs(from, to FlagEnvironment) []FieldChange {
+	a, b := flatten(from), flatten(to)
+	paths := make([]string, 0, len(a)+len(b))
+	for p := range a {
+		paths = append(paths, p)
+	}
+	for p := range b {
+		if _, ok := a[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	decode := func(raw string, ok bool) any {
+		var v any
+		if ok {
+			_ = json.Unmarshal([]byte(raw), &v)
+		}
+		return v
+	}
+
+	out := []FieldChange{}
+	for _, p := range paths {
+		ra, okA := a[p]
+		rb, okB := b[p]
+		if okA && okB && ra == rb {
+			continue
+		}
+		out = append(out, FieldChange{Field: p, From: decode(ra, okA), To: decode(rb, okB)})
+	}
+	return out
+}
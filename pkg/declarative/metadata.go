@@ -0,0 +1,145 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/tag"
+)
+
+// Limits on flag metadata, so that it stays small enough to ship around in
+// list responses.
+const (
+	MaxTags         = 20
+	MaxLinks        = 20
+	MaxMetadataKeys = 50
+)
+
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:/-]{0,49}$`)
+
+// NormalizeTags lowercases and trims tag names, dropping empty entries and
+// duplicates. The result is sorted.
+func NormalizeTags(names []string) []string {
+	out := make([]string, 0, len(names))
+	for _, n := range names {
+		n = strings.ToLower(strings.TrimSpace(n))
+		if n != "" && !slices.Contains(out, n) {
+			out = append(out, n)
+		}
+	}
+	slices.Sort(out)
+	return out
+}
+
+// ValidateFlagMetadata checks normalized tags, links and metadata and returns
+// errors keyed by field path below prefix (e.g. "links[0].url"). An empty map
+// means they are valid.
+func ValidateFlagMetadata(tags []string, links []Link, metadata map[string]string, prefix string) map[string]string {
+	fields := map[string]string{}
+
+	if len(tags) > MaxTags {
+		fields[prefix+"tags"] = fmt.Sprintf("At most %d tags are allowed", MaxTags)
+	}
+	for _, t := range tags {
+		if !tagPattern.MatchString(t) {
+			fields[prefix+"tags"] = fmt.Sprintf("Invalid tag %q: use up to 50 lowercase letters, digits and - _ . : /", t)
+			break
+		}
+	}
+
+	if len(links) > MaxLinks {
+		fields[prefix+"links"] = fmt.Sprintf("At most %d links are allowed", MaxLinks)
+	}
+	for i, l := range links {
+		u, err := url.Parse(l.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			fields[fmt.Sprintf("%slinks[%d].url", prefix, i)] = "URL must be an absolute http(s) URL"
+		}
+	}
+
+	if len(metadata) > MaxMetadataKeys {
+		fields[prefix+"metadata"] = fmt.Sprintf("At most %d metadata keys are allowed", MaxMetadataKeys)
+	}
+	for k := range metadata {
+		if strings.TrimSpace(k) == "" {
+			fields[prefix+"metadata"] = "Metadata keys cannot be empty"
+			break
+		}
+	}
+
+	return fields
+}
+
+// SetTags replaces the tags of a flag with the given normalized names,
+// creating missing tags in the project and removing the ones no flag uses
+// anymore.
+func SetTags(ctx context.Context, client *ent.Client, projectID, flagID int, names []string) error {
+	existing, err := client.Tag.Query().
+		Where(tag.ProjectID(projectID), tag.NameIn(names...)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]int, 0, len(names))
+	for _, name := range names {
+		i := slices.IndexFunc(existing, func(t *ent.Tag) bool { return t.Name == name })
+		if i >= 0 {
+			ids = append(ids, existing[i].ID)
+			continue
+		}
+		t, err := client.Tag.Create().SetName(name).SetProjectID(projectID).Save(ctx)
+		if err != nil {
+			return err
+		}
+		ids = append(ids, t.ID)
+	}
+
+	if err := client.Flag.UpdateOneID(flagID).ClearTags().AddTagIDs(ids...).Exec(ctx); err != nil {
+		return err
+	}
+	_, err = client.Tag.Delete().
+		Where(tag.ProjectID(projectID), tag.Not(tag.HasFlags())).
+		Exec(ctx)
+	return err
+}
+
+// TagNames returns the names of loaded tags, sorted.
+func TagNames(tags []*ent.Tag) []string {
+	out := make([]string, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, t.Name)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// LinksToEnt converts document links to their stored form.
+func LinksToEnt(links []Link) []schema.Link {
+	if len(links) == 0 {
+		return nil
+	}
+	out := make([]schema.Link, 0, len(links))
+	for _, l := range links {
+		out = append(out, schema.Link{Title: l.Title, URL: l.URL})
+	}
+	return out
+}
+
+// LinksFromEnt converts stored links to their document form.
+func LinksFromEnt(links []schema.Link) []Link {
+	if len(links) == 0 {
+		return nil
+	}
+	out := make([]Link, 0, len(links))
+	for _, l := range links {
+		out = append(out, Link{Title: l.Title, URL: l.URL})
+	}
+	return out
+}
@@ -0,0 +1,127 @@
+package declarative
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// checkOperands returns why the values of a constraint cannot be used with
+// its operator, or "". Operators whose operands have a fixed format are
+// checked whatever field they test.
+func checkOperands(operator string, values []string) string {
+	switch operator {
+	case "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT", "REGEX", "IN_CIDR", "ANY_OF", "ALL_OF":
+		if len(values) == 0 {
+			return fmt.Sprintf("%s needs at least one value", operator)
+		}
+	}
+
+	for _, v := range values {
+		switch operator {
+		case "SEMVER_EQ", "SEMVER_GT", "SEMVER_LT":
+			if !semverPattern.MatchString(v) {
+				return fmt.Sprintf("%q is not a semantic version", v)
+			}
+		case "REGEX":
+			if _, err := regexp.Compile(v); err != nil {
+				return fmt.Sprintf("%q is not a valid regular expression: %s", v, strings.TrimPrefix(err.Error(), "error parsing regexp: "))
+			}
+		case "IN_CIDR":
+			if _, _, err := net.ParseCIDR(v); err != nil {
+				return fmt.Sprintf("%q is not a CIDR range, e.g. 10.0.0.0/8", v)
+			}
+		}
+	}
+	return ""
+}
+
+// CompareSemver compares two semantic versions, ignoring build metadata and
+// an optional "v" prefix, and returns -1, 0 or 1. ok is false when either is
+// not a semantic version.
+func CompareSemver(a, b string) (cmp int, ok bool) {
+	va, okA := parseSemver(a)
+	vb, okB := parseSemver(b)
+	if !okA || !okB {
+		return 0, false
+	}
+	for i := range 3 {
+		if va.core[i] != vb.core[i] {
+			if va.core[i] < vb.core[i] {
+				return -1, true
+			}
+			return 1, true
+		}
+	}
+	return comparePrerelease(va.pre, vb.pre), true
+}
+
+type semver struct {
+	core [3]uint64
+	pre  []string
+}
+
+func parseSemver(s string) (semver, bool) {
+	if !semverPattern.MatchString(s) {
+		return semver{}, false
+	}
+	s = strings.TrimPrefix(s, "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	var v semver
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		v.pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+	for i, part := range strings.SplitN(s, ".", 3) {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return semver{}, false
+		}
+		v.core[i] = n
+	}
+	return v, true
+}
+
+// comparePrerelease orders pre-release identifiers as semver 2.0.0 does: a
+// version without them is greater, numeric identifiers sort numerically and
+// before alphanumeric ones.
+func comparePrerelease(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, errA := strconv.ParseUint(a[i], 10, 64)
+		nb, errB := strconv.ParseUint(b[i], 10, 64)
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				if na < nb {
+					return -1
+				}
+				return 1
+			}
+		case errA == nil:
+			return -1
+		case errB == nil:
+			return 1
+		case a[i] != b[i]:
+			return strings.Compare(a[i], b[i])
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareSemver(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.2.3", "1.2.3", 0},
+		{"v1.2.3", "1.2.3+build.5", 0},
+		{"1.2.3", "1.10.0", -1},
+		{"2.0.0", "1.99.99", 1},
+		{"1.0.0-alpha", "1.0.0", -1},
+		{"1.0.0-alpha.1", "1.0.0-alpha", 1},
+		{"1.0.0-alpha.2", "1.0.0-alpha.10", -1},
+		{"1.0.0-2", "1.0.0-beta", -1},
+		{"1.0.0-rc.1", "1.0.0-beta.11", 1},
+	}
+	for _, tt := range tests {
+		got, ok := CompareSemver(tt.a, tt.b)
+		assert.True(t, ok, "%s vs %s", tt.a, tt.b)
+		assert.Equal(t, tt.want, got, "%s vs %s", tt.a, tt.b)
+	}
+
+	_, ok := CompareSemver("1.2", "1.2.0")
+	assert.False(t, ok)
+}
+
+func TestCheckOperands(t *testing.T) {
+	tests := []struct {
+		operator string
+		values   []string
+		want     string
+	}{
+		{"SEMVER_GT", []string{"2.4.0", "v3.0.0-rc.1"}, ""},
+		{"SEMVER_LT", []string{"2.4"}, `"2.4" is not a semantic version`},
+		{"REGEX", []string{`^[a-z]+@acme\.com$`}, ""},
+		{"REGEX", []string{`[a-z`}, `"[a-z" is not a valid regular expression: missing closing ]: ` + "`[a-z`"},
+		{"IN_CIDR", []string{"10.0.0.0/8", "2001:db8::/32"}, ""},
+		{"IN_CIDR", []string{"10.0.0.1"}, `"10.0.0.1" is not a CIDR range, e.g. 10.0.0.0/8`},
+		{"ALL_OF", nil, "ALL_OF needs at least one value"},
+		{"IN", nil, ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, checkOperands(tt.operator, tt.values), tt.operator)
+	}
+}
@@ -0,0 +1,427 @@
+package declarative
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+)
+
+// Dependent is a flag that requires another flag in one environment.
+type Dependent struct {
+	FlagID      int    `json:"flag_id"`
+	Flag        string `json:"flag"`
+	Environment string `json:"environment"`
+	Enabled     bool   `json:"enabled"`
+	Variant     string `json:"variant,omitempty"`
+}
+
+// Dependents lists the flags that name flagID as a prerequisite, ordered by
+// flag and environment name. Flags and environments in the trash are left out.
+func Dependents(ctx context.Context, orm *ent.Client, flagID int) ([]Dependent, error) {
+	prereqs, err := orm.Prerequisite.Query().
+		Where(
+			prerequisite.ParentFlagID(flagID),
+			prerequisite.HasFlagEnvironmentWith(
+				flagenvironment.HasFlagWith(entflag.DeletedAtIsNil()),
+				flagenvironment.HasEnvironmentWith(environment.DeletedAtIsNil()),
+			),
+		).
+		WithFlagEnvironment(func(q *ent.FlagEnvironmentQuery) {
+			q.WithFlag()
+			q.WithEnvironment()
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Dependent, 0, len(prereqs))
+	for _, p := range prereqs {
+		fe := p.Edges.FlagEnvironment
+		out = append(out, Dependent{
+			FlagID:      fe.FlagID,
+			Flag:        fe.Edges.Flag.Name,
+			Environment: fe.Edges.Environment.Name,
+			Enabled:     p.Enabled,
+			Variant:     p.Variant,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Flag != out[j].Flag {
+			return out[i].Flag < out[j].Flag
+		}
+		return out[i].Environment < out[j].Environment
+	})
+	return out, nil
+}
+
+// Required reports the state the parent flag must be in.
+func (p Prerequisite) Required() bool {
+	return p.Enabled == nil || *p.Enabled
+}
+
+// SetPrerequisites replaces the prerequisites of a flag in one environment
+// inside a single transaction and bumps the revision of the config, which is
+// created when missing. A non-zero revision must match the current one, or a
+// ConflictError is returned. Unknown parent flags and cycles are reported as
+// a ValidationError. No version is recorded; callers record one once they
+// are done changing the config.
+func SetPrerequisites(ctx context.Context, orm *ent.Client, projectID, flagID, envID, revision int, prereqs []Prerequisite) (*Diff, error) {
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{
+		ctx:       ctx,
+		client:    tx.Client(),
+		projectID: projectID,
+		diff:      &Diff{Changes: []Change{}},
+	}
+	feID, err := a.setPrerequisites(flagID, envID, prereqs)
+	if err == nil {
+		a.expect(feID, revision)
+		err = a.bumpRevisions()
+	}
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a.diff, nil
+}
+
+// ReplacePrerequisites is SetPrerequisites for callers running their own
+// transaction on client. The revision is left to the caller.
+func ReplacePrerequisites(ctx context.Context, client *ent.Client, projectID, flagID, envID int, prereqs []Prerequisite) error {
+	a := &applier{
+		ctx:       ctx,
+		client:    client,
+		projectID: projectID,
+		diff:      &Diff{Changes: []Change{}},
+	}
+	_, err := a.setPrerequisites(flagID, envID, prereqs)
+	return err
+}
+
+// setPrerequisites replaces the prerequisites of a flag environment and
+// returns its ID.
+func (a *applier) setPrerequisites(flagID, envID int, prereqs []Prerequisite) (int, error) {
+	f, err := a.client.Flag.Query().
+		Where(entflag.ID(flagID), entflag.ProjectID(a.projectID), entflag.DeletedAtIsNil()).
+		Only(a.ctx)
+	if err != nil {
+		return 0, err
+	}
+	env, err := a.client.Environment.Query().
+		Where(environment.ID(envID), environment.ProjectID(a.projectID), environment.DeletedAtIsNil()).
+		Only(a.ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fields := map[string]string{}
+	validatePrerequisites(f.Name, prereqs, "", fields)
+	if len(fields) > 0 {
+		return 0, &ValidationError{Fields: fields}
+	}
+
+	feID, err := a.client.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(f.ID), flagenvironment.EnvironmentID(env.ID)).
+		OnlyID(a.ctx)
+	if ent.IsNotFound(err) {
+		var fe *ent.FlagEnvironment
+		fe, err = a.client.FlagEnvironment.Create().
+			SetFlagID(f.ID).
+			SetEnvironmentID(env.ID).
+			Save(a.ctx)
+		if err == nil {
+			feID = fe.ID
+		}
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	flags, err := a.client.Flag.Query().
+		Where(entflag.ProjectID(a.projectID), entflag.DeletedAtIsNil()).
+		All(a.ctx)
+	if err != nil {
+		return 0, err
+	}
+	byName := make(map[string]*ent.Flag, len(flags))
+	for _, pf := range flags {
+		byName[pf.Name] = pf
+	}
+
+	df := Flag{Name: f.Name, Environments: []FlagEnvironment{{Environment: env.Name, Prerequisites: prereqs}}}
+	return feID, a.applyPrerequisites([]Flag{df}, byName, map[string]int{env.Name: env.ID})
+}
+
+// validatePrerequisites checks the prerequisites of one flag environment and
+// adds errors under prefix (e.g. "flags[0].environments[1].").
+func validatePrerequisites(flagName string, prereqs []Prerequisite, prefix string, fields map[string]string) {
+	seen := make(map[string]bool, len(prereqs))
+	for i, p := range prereqs {
+		path := fmt.Sprintf("%sprerequisites[%d].flag", prefix, i)
+		switch {
+		case p.Flag == "":
+			fields[path] = "Flag is required"
+		case p.Flag == flagName:
+			fields[path] = "A flag cannot depend on itself"
+		case seen[p.Flag]:
+			fields[path] = fmt.Sprintf("Duplicate prerequisite %q", p.Flag)
+		}
+		seen[p.Flag] = true
+	}
+}
+
+// applyPrerequisites reconciles the prerequisites of the given flag
+// environments. It runs once every flag exists, so that a flag can depend on
+// one declared after it. byName holds the project's flags; flags created
+// during a dry run are absent from it.
+func (a *applier) applyPrerequisites(flags []Flag, byName map[string]*ent.Flag, envIDs map[string]int) error {
+	declared := make(map[string]bool, len(flags))
+	for _, df := range flags {
+		declared[df.Name] = true
+	}
+
+	planned := map[string]map[string][]string{}
+	createdFE := map[string]bool{}
+	for _, c := range a.diff.Changes {
+		if c.Kind == KindFlagEnvironment && c.Action == ActionCreate {
+			createdFE[c.Name+"\x00"+c.Environment] = true
+		}
+	}
+
+	for _, df := range flags {
+		for _, dfe := range df.Environments {
+			want := normalizePrerequisites(dfe.Prerequisites)
+			if planned[dfe.Environment] == nil {
+				planned[dfe.Environment] = map[string][]string{}
+			}
+			parents := make([]string, 0, len(want))
+			for _, p := range want {
+				parents = append(parents, p.Flag)
+			}
+			planned[dfe.Environment][df.Name] = parents
+			for _, p := range want {
+				if byName[p.Flag] == nil && !declared[p.Flag] {
+					return &ValidationError{Fields: map[string]string{
+						"prerequisites": fmt.Sprintf("Flag %q depends on unknown flag %q", df.Name, p.Flag),
+					}}
+				}
+			}
+
+			var fe *ent.FlagEnvironment
+			if f, envID := byName[df.Name], envIDs[dfe.Environment]; f != nil && envID != 0 {
+				var err error
+				fe, err = a.client.FlagEnvironment.Query().
+					Where(flagenvironment.FlagID(f.ID), flagenvironment.EnvironmentID(envID)).
+					WithPrerequisites(func(q *ent.PrerequisiteQuery) { q.WithParentFlag() }).
+					Only(a.ctx)
+				if err != nil && !ent.IsNotFound(err) {
+					return err
+				}
+			}
+
+			var have []Prerequisite
+			if fe != nil {
+				have = prerequisitesFromEnt(fe.Edges.Prerequisites)
+			}
+			if samePrerequisites(have, want) {
+				continue
+			}
+
+			if !createdFE[df.Name+"\x00"+dfe.Environment] {
+				a.recordFields(KindFlagEnvironment, df.Name, dfe.Environment, FieldChange{Field: "prerequisites", From: have, To: want})
+			}
+			if a.opts.DryRun || fe == nil {
+				continue
+			}
+			a.touch(fe.ID).PrerequisitesReplaced = true
+
+			if _, err := a.client.Prerequisite.Delete().Where(prerequisite.FlagEnvironmentID(fe.ID)).Exec(a.ctx); err != nil {
+				return err
+			}
+			for _, p := range want {
+				err := a.client.Prerequisite.Create().
+					SetFlagEnvironmentID(fe.ID).
+					SetParentFlagID(byName[p.Flag].ID).
+					SetEnabled(p.Required()).
+					SetNillableVariant(nilIfEmpty(p.Variant)).
+					Exec(a.ctx)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return a.checkPrerequisiteCycles(planned)
+}
+
+// recordFields adds field changes to the update change of a resource already
+// recorded by this run, or records a new update change.
+func (a *applier) recordFields(kind, name, env string, fields ...FieldChange) {
+	for i := len(a.diff.Changes) - 1; i >= 0; i-- {
+		c := &a.diff.Changes[i]
+		if c.Action == ActionUpdate && c.Kind == kind && c.Name == name && c.Environment == env {
+			c.Fields = append(c.Fields, fields...)
+			return
+		}
+	}
+	a.record(Change{Action: ActionUpdate, Kind: kind, Name: name, Environment: env, Fields: fields})
+}
+
+// checkPrerequisiteCycles rejects the prerequisites of the project if they
+// form a cycle in any environment. planned maps environment and flag names to
+// the parent flag names being applied, and takes the place of what is stored
+// for those flags, so that a dry run, which writes nothing, is checked too.
+func (a *applier) checkPrerequisiteCycles(planned map[string]map[string][]string) error {
+	prereqs, err := a.client.Prerequisite.Query().
+		Where(prerequisite.HasParentFlagWith(entflag.ProjectID(a.projectID))).
+		WithParentFlag().
+		WithFlagEnvironment(func(q *ent.FlagEnvironmentQuery) {
+			q.WithFlag()
+			q.WithEnvironment()
+		}).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+
+	graphs := map[string]map[string][]string{}
+	for _, p := range prereqs {
+		fe := p.Edges.FlagEnvironment
+		env := fe.Edges.Environment.Name
+		if graphs[env] == nil {
+			graphs[env] = map[string][]string{}
+		}
+		child := fe.Edges.Flag.Name
+		if _, ok := planned[env][child]; ok {
+			continue
+		}
+		graphs[env][child] = append(graphs[env][child], p.Edges.ParentFlag.Name)
+	}
+	for env, children := range planned {
+		if graphs[env] == nil {
+			graphs[env] = map[string][]string{}
+		}
+		for child, parents := range children {
+			graphs[env][child] = parents
+		}
+	}
+
+	envs := make([]string, 0, len(graphs))
+	for env := range graphs {
+		envs = append(envs, env)
+	}
+	sort.Strings(envs)
+	for _, env := range envs {
+		if cycle := findCycle(graphs[env]); cycle != nil {
+			return &ValidationError{Fields: map[string]string{"prerequisites": cycleError(env, cycle)}}
+		}
+	}
+	return nil
+}
+
+// findCycle returns a dependency cycle in the graph of flag name to parent
+// flag names, starting and ending with the same flag, or nil.
+func findCycle(graph map[string][]string) []string {
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := map[string]int{}
+	var stack []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case done:
+			return nil
+		case visiting:
+			for i, n := range stack {
+				if n == name {
+					return append(append([]string{}, stack[i:]...), name)
+				}
+			}
+		}
+		state[name] = visiting
+		stack = append(stack, name)
+		for _, parent := range graph[name] {
+			if cycle := visit(parent); cycle != nil {
+				return cycle
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = done
+		return nil
+	}
+
+	names := make([]string, 0, len(graph))
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if cycle := visit(name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+func cycleError(env string, cycle []string) string {
+	return fmt.Sprintf("Prerequisites form a cycle in %s: %s", env, strings.Join(cycle, " -> "))
+}
+
+// prerequisitesFromEnt converts loaded prerequisites (with their parent flag)
+// to their normalized document form.
+func prerequisitesFromEnt(prereqs []*ent.Prerequisite) []Prerequisite {
+	out := make([]Prerequisite, 0, len(prereqs))
+	for _, p := range prereqs {
+		enabled := p.Enabled
+		out = append(out, Prerequisite{Flag: p.Edges.ParentFlag.Name, Enabled: &enabled, Variant: p.Variant})
+	}
+	return normalizePrerequisites(out)
+}
+
+// normalizePrerequisites sorts prerequisites by flag name and drops the
+// default required state, so that equal sets compare and export equally.
+// An empty set is nil.
+func normalizePrerequisites(in []Prerequisite) []Prerequisite {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]Prerequisite, 0, len(in))
+	for _, p := range in {
+		if p.Required() {
+			p.Enabled = nil
+		} else {
+			disabled := false
+			p.Enabled = &disabled
+		}
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Flag < out[j].Flag })
+	return out
+}
+
+func samePrerequisites(a, b []Prerequisite) bool {
+	ja, errA := json.Marshal(normalizePrerequisites(a))
+	jb, errB := json.Marshal(normalizePrerequisites(b))
+	return errA == nil && errB == nil && string(ja) == string(jb)
+}
@@ -0,0 +1,189 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+)
+
+// PromoteOptions selects what Promote copies and where.
+type PromoteOptions struct {
+	// Source and Target are environment names within the project.
+	Source string `json:"source"`
+	Target string `json:"target"`
+
+	// Flags limits the promotion to these flag names. Empty promotes every
+	// flag of the project.
+	Flags []string `json:"flags,omitempty"`
+
+	// Enabled copies the enabled state.
+	Enabled bool `json:"enabled"`
+
+	// Strategies replaces the target's strategies, constraints and
+	// prerequisites with a copy of the source's.
+	Strategies bool `json:"strategies"`
+
+	// DryRun computes the diff without persisting anything.
+	DryRun bool `json:"dry_run"`
+
+	// Actor is recorded on the target's new flag environment versions.
+	Actor string `json:"-"`
+}
+
+// Validate checks the options without touching the database.
+func (o PromoteOptions) Validate() map[string]string {
+	errs := map[string]string{}
+	if o.Source == "" {
+		errs["source"] = "Source environment is required"
+	}
+	if o.Target == "" {
+		errs["target"] = "Target environment is required"
+	} else if o.Target == o.Source {
+		errs["target"] = "Target environment must differ from the source"
+	}
+	if !o.Enabled && !o.Strategies {
+		errs["enabled"] = "Select at least one of enabled and strategies"
+	}
+	return errs
+}
+
+// Promote copies the configuration of flags from the source environment to the
+// target environment inside a single transaction, and returns the changes
+// made to the target. A flag with no configuration in the source is promoted
+// as disabled with no strategies. With DryRun set, the transaction is rolled
+// back and the returned diff describes what would have changed.
+func Promote(ctx context.Context, orm *ent.Client, projectID int, opts PromoteOptions) (*Diff, error) {
+	if fields := opts.Validate(); len(fields) > 0 {
+		return nil, &ValidationError{Fields: fields}
+	}
+
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a := &applier{
+		ctx:       ctx,
+		client:    tx.Client(),
+		projectID: projectID,
+		opts:      Options{DryRun: opts.DryRun, Actor: opts.Actor},
+		diff:      &Diff{Changes: []Change{}},
+	}
+	if err := a.promote(opts); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !opts.DryRun {
+		if err := a.recordVersions(); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	if opts.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, err
+		}
+		return a.diff, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a.diff, nil
+}
+
+func (a *applier) promote(opts PromoteOptions) error {
+	envs, err := a.client.Environment.Query().
+		Where(environment.ProjectID(a.projectID), environment.DeletedAtIsNil()).
+		All(a.ctx)
+	if err != nil {
+		return err
+	}
+	envIDs := make(map[string]int, len(envs))
+	envNames := make(map[int]string, len(envs))
+	for _, e := range envs {
+		envIDs[e.Name] = e.ID
+		envNames[e.ID] = e.Name
+	}
+
+	fields := map[string]string{}
+	if _, ok := envIDs[opts.Source]; !ok {
+		fields["source"] = fmt.Sprintf("Unknown environment %q", opts.Source)
+	}
+	if _, ok := envIDs[opts.Target]; !ok {
+		fields["target"] = fmt.Sprintf("Unknown environment %q", opts.Target)
+	}
+
+	flags, err := loadFlags(a.ctx, a.client, a.projectID)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]*ent.Flag, len(flags))
+	for _, f := range flags {
+		byName[f.Name] = f
+	}
+	if len(opts.Flags) > 0 {
+		selected := make([]*ent.Flag, 0, len(opts.Flags))
+		for i, name := range opts.Flags {
+			f, ok := byName[name]
+			if !ok {
+				fields[fmt.Sprintf("flags[%d]", i)] = fmt.Sprintf("Unknown flag %q", name)
+				continue
+			}
+			selected = append(selected, f)
+		}
+		flags = selected
+	}
+	if len(fields) > 0 {
+		return &ValidationError{Fields: fields}
+	}
+
+	var promoted []Flag
+	for _, f := range flags {
+		var source, target *ent.FlagEnvironment
+		for _, fe := range f.Edges.FlagEnvironments {
+			switch envNames[fe.EnvironmentID] {
+			case opts.Source:
+				source = fe
+			case opts.Target:
+				target = fe
+			}
+		}
+
+		// Start from the target's current state and overwrite what is promoted.
+		want := FlagEnvironment{Environment: opts.Target, Strategies: []Strategy{}}
+		if target != nil {
+			want.Enabled = target.Enabled
+			want.Strategies = strategiesFromEnt(target.Edges.Strategies)
+			want.Prerequisites = prerequisitesFromEnt(target.Edges.Prerequisites)
+		}
+		if opts.Enabled {
+			want.Enabled = source != nil && source.Enabled
+		}
+		if opts.Strategies {
+			want.Strategies = []Strategy{}
+			want.Prerequisites = nil
+			if source != nil {
+				want.Strategies = strategiesFromEnt(source.Edges.Strategies)
+				want.Prerequisites = prerequisitesFromEnt(source.Edges.Prerequisites)
+			}
+		}
+
+		// Nothing to create for an unconfigured flag promoted from an
+		// unconfigured (or disabled, empty) source.
+		if target == nil && !want.Enabled && len(want.Strategies) == 0 && len(want.Prerequisites) == 0 {
+			continue
+		}
+
+		df := Flag{Name: f.Name, Environments: []FlagEnvironment{want}}
+		if err := a.applyFlagEnvironments(f, df, envIDs, envNames); err != nil {
+			return err
+		}
+		promoted = append(promoted, df)
+	}
+
+	return a.applyPrerequisites(promoted, byName, envIDs)
+}
@@ -0,0 +1,107 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+)
+
+// ConflictError is returned when a flag environment changed since the
+// revision a writer read. Revision is the current one.
+type ConflictError struct {
+	FlagEnvironmentID int
+	Revision          int
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("declarative: flag environment %d is at revision %d", e.FlagEnvironmentID, e.Revision)
+}
+
+// BumpRevision increments the revision of a flag environment and returns the
+// new one. When expected is not zero the increment only happens while the
+// stored revision still equals it; otherwise a ConflictError is returned.
+// Call it on the transaction making the change, so that the check and the
+// change commit together.
+func BumpRevision(ctx context.Context, client *ent.Client, feID, expected int) (int, error) {
+	update := client.FlagEnvironment.Update().
+		Where(flagenvironment.ID(feID)).
+		AddRevision(1)
+	if expected != 0 {
+		update.Where(flagenvironment.Revision(expected))
+	}
+	n, err := update.Save(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	fe, err := client.FlagEnvironment.Get(ctx, feID)
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		return 0, &ConflictError{FlagEnvironmentID: feID, Revision: fe.Revision}
+	}
+	return fe.Revision, nil
+}
+
+// ETag formats a revision as an HTTP entity tag.
+func ETag(revision int) string {
+	return strconv.Quote(strconv.Itoa(revision))
+}
+
+// ParseIfMatch reads the revision of an If-Match header: a strong or weak
+// entity tag as written by ETag. An empty header or "*" is zero, which skips
+// the check. ok is false for anything else.
+func ParseIfMatch(header string) (revision int, ok bool) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, true
+	}
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		return 0, false
+	}
+	revision, err = strconv.Atoi(tag)
+	if err != nil || revision < 1 {
+		return 0, false
+	}
+	return revision, true
+}
+
+// expect makes the run fail with a ConflictError unless the flag environment
+// is still at revision. Zero skips the check.
+func (a *applier) expect(feID, revision int) {
+	if revision == 0 {
+		return
+	}
+	if a.expected == nil {
+		a.expected = map[int]int{}
+	}
+	a.expected[feID] = revision
+}
+
+// bumpRevisions bumps the revision of every flag environment changed by this
+// run or expected at a revision, in ID order.
+func (a *applier) bumpRevisions() error {
+	ids := make([]int, 0, len(a.touched)+len(a.expected))
+	for id := range a.touched {
+		ids = append(ids, id)
+	}
+	for id := range a.expected {
+		if _, ok := a.touched[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		if _, err := BumpRevision(a.ctx, a.client, id, a.expected[id]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
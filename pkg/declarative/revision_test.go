@@ -0,0 +1,30 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		header string
+		want   int
+		ok     bool
+	}{
+		{"", 0, true},
+		{"*", 0, true},
+		{ETag(7), 7, true},
+		{`W/"7"`, 7, true},
+		{"7", 0, false},
+		{`"0"`, 0, false},
+		{`"abc"`, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			got, ok := ParseIfMatch(tt.header)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
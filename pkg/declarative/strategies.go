@@ -0,0 +1,401 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+)
+
+// Types of strategy parameters.
+const (
+	// ParamNumber is a JSON number, optionally bounded by Min and Max.
+	ParamNumber = "number"
+
+	// ParamString is free text.
+	ParamString = "string"
+
+	// ParamEnum is one of Values.
+	ParamEnum = "enum"
+
+	// ParamList is a string holding one value per line or comma, as the SDKs
+	// read it.
+	ParamList = "list"
+)
+
+type (
+	// StrategyParam declares a parameter of a strategy.
+	StrategyParam struct {
+		Name        string   `json:"name"`
+		Label       string   `json:"label"`
+		Type        string   `json:"type"`
+		Required    bool     `json:"required"`
+		Min         *float64 `json:"min,omitempty"`
+		Max         *float64 `json:"max,omitempty"`
+		Values      []string `json:"values,omitempty"`
+		Default     any      `json:"default,omitempty"`
+		Description string   `json:"description,omitempty"`
+
+		// item validates each value of a list parameter.
+		item func(string) bool
+	}
+
+	// StrategyDefinition declares a strategy the SDKs know how to evaluate
+	// and the parameters it takes. Custom strategies of a project carry the
+	// ID of their stored definition.
+	StrategyDefinition struct {
+		ID          int             `json:"id,omitempty"`
+		Name        string          `json:"name"`
+		Label       string          `json:"label"`
+		Description string          `json:"description,omitempty"`
+		Builtin     bool            `json:"builtin"`
+		Parameters  []StrategyParam `json:"parameters"`
+	}
+)
+
+func bound(v float64) *float64 {
+	return &v
+}
+
+// builtinStrategies are the strategies evaluated by every SDK.
+var builtinStrategies = []StrategyDefinition{
+	{
+		Name:        "default",
+		Builtin:     true,
+		Label:       "default (always on)",
+		Description: "On for everyone who passes the constraints.",
+		Parameters:  []StrategyParam{},
+	},
+	{
+		Name:        "gradualRollout",
+		Builtin:     true,
+		Label:       "gradual_rollout",
+		Description: "On for a stable percentage of users, bucketed by a hash of the stickiness field.",
+		Parameters: []StrategyParam{
+			{Name: "rollout", Label: "Rollout percentage", Type: ParamNumber, Required: true, Min: bound(0), Max: bound(100), Default: 0},
+			{Name: "stickiness", Label: "Stickiness", Type: ParamEnum, Values: []string{"default", "userId", "sessionId", "random"}, Default: "default"},
+			{Name: "groupId", Label: "Group ID", Type: ParamString, Description: "e.g. experiment-1; buckets users differently per group"},
+		},
+	},
+	{
+		Name:        "userWithId",
+		Builtin:     true,
+		Label:       "user_targeting",
+		Description: "On for the listed user IDs.",
+		Parameters: []StrategyParam{
+			{Name: "userIds", Label: "User IDs", Type: ParamList, Required: true, Description: "One user ID per line"},
+		},
+	},
+	{
+		Name:        "remoteAddress",
+		Builtin:     true,
+		Label:       "ip_filtering",
+		Description: "On for requests from the listed IP addresses.",
+		Parameters: []StrategyParam{
+			{Name: "ips", Label: "IP addresses", Type: ParamList, Required: true, Description: "One IP, CIDR range or prefix ending in . per line", item: validIPRule},
+		},
+	},
+}
+
+// BuiltinStrategies returns the strategies every SDK can evaluate.
+func BuiltinStrategies() []StrategyDefinition {
+	return slices.Clone(builtinStrategies)
+}
+
+// ProjectStrategies returns the built-in strategies followed by the custom
+// strategies of a project, by name.
+func ProjectStrategies(ctx context.Context, client *ent.Client, projectID int) ([]StrategyDefinition, error) {
+	custom, err := client.StrategyDefinition.Query().
+		Where(strategydefinition.ProjectID(projectID)).
+		Order(ent.Asc(strategydefinition.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defs := BuiltinStrategies()
+	for _, d := range custom {
+		defs = append(defs, CustomStrategy(d))
+	}
+	return defs, nil
+}
+
+// CustomStrategy converts a stored strategy definition.
+func CustomStrategy(d *ent.StrategyDefinition) StrategyDefinition {
+	params := make([]StrategyParam, 0, len(d.Parameters))
+	for _, p := range d.Parameters {
+		label := p.Label
+		if label == "" {
+			label = p.Name
+		}
+		params = append(params, StrategyParam{
+			Name:        p.Name,
+			Label:       label,
+			Type:        p.Type,
+			Required:    p.Required,
+			Min:         p.Min,
+			Max:         p.Max,
+			Values:      p.Values,
+			Default:     p.Default,
+			Description: p.Description,
+		})
+	}
+	return StrategyDefinition{
+		ID:          d.ID,
+		Name:        d.Name,
+		Label:       d.Name,
+		Description: d.Description,
+		Parameters:  params,
+	}
+}
+
+// StrategyParamsToEnt converts parameters to their stored form.
+func StrategyParamsToEnt(params []StrategyParam) []schema.StrategyParameter {
+	out := make([]schema.StrategyParameter, 0, len(params))
+	for _, p := range params {
+		label := p.Label
+		if label == p.Name {
+			label = ""
+		}
+		out = append(out, schema.StrategyParameter{
+			Name:        p.Name,
+			Label:       label,
+			Type:        p.Type,
+			Required:    p.Required,
+			Min:         p.Min,
+			Max:         p.Max,
+			Values:      p.Values,
+			Default:     p.Default,
+			Description: p.Description,
+		})
+	}
+	return out
+}
+
+// StrategyUsage returns the names of the flags of a project, including those
+// in the trash, that use a strategy in any environment.
+func StrategyUsage(ctx context.Context, client *ent.Client, projectID int, name string) ([]string, error) {
+	return client.Flag.Query().
+		Where(
+			entflag.ProjectID(projectID),
+			entflag.HasFlagEnvironmentsWith(flagenvironment.HasStrategiesWith(strategy.Name(name))),
+		).
+		Order(ent.Asc(entflag.FieldName)).
+		Select(entflag.FieldName).
+		Strings(ctx)
+}
+
+var namePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+
+// ValidateDefinition checks a custom strategy definition and returns errors
+// keyed by field path (e.g. "parameters[0].type").
+func ValidateDefinition(def StrategyDefinition) map[string]string {
+	fields := map[string]string{}
+
+	switch {
+	case def.Name == "":
+		fields["name"] = "Name is required"
+	case !namePattern.MatchString(def.Name):
+		fields["name"] = "Name must start with a letter and contain only letters, digits and _"
+	case slices.ContainsFunc(builtinStrategies, func(b StrategyDefinition) bool { return b.Name == def.Name }):
+		fields["name"] = fmt.Sprintf("%q is a built-in strategy", def.Name)
+	}
+
+	types := []string{ParamNumber, ParamString, ParamEnum, ParamList}
+	seen := make(map[string]bool, len(def.Parameters))
+	for i, p := range def.Parameters {
+		path := fmt.Sprintf("parameters[%d].", i)
+		switch {
+		case p.Name == "":
+			fields[path+"name"] = "Name is required"
+		case !namePattern.MatchString(p.Name):
+			fields[path+"name"] = "Name must start with a letter and contain only letters, digits and _"
+		case seen[p.Name]:
+			fields[path+"name"] = fmt.Sprintf("Duplicate parameter %q", p.Name)
+		}
+		seen[p.Name] = true
+
+		if !slices.Contains(types, p.Type) {
+			fields[path+"type"] = "Type must be one of: " + strings.Join(types, ", ")
+			continue
+		}
+		if p.Type == ParamEnum && len(p.Values) == 0 {
+			fields[path+"values"] = "An enum needs at least one value"
+		}
+		if p.Type != ParamNumber && (p.Min != nil || p.Max != nil) {
+			fields[path+"min"] = "Only number parameters have bounds"
+		}
+		if p.Min != nil && p.Max != nil && *p.Min > *p.Max {
+			fields[path+"min"] = "Min must not be greater than max"
+		}
+		if p.Default != nil && p.Default != "" {
+			if p.Label == "" {
+				p.Label = p.Name
+			}
+			if msg := p.check(p.Default); msg != "" {
+				fields[path+"default"] = "Default: " + msg
+			}
+		}
+	}
+
+	return fields
+}
+
+// LookupStrategy finds a strategy by name.
+func LookupStrategy(defs []StrategyDefinition, name string) (StrategyDefinition, bool) {
+	i := slices.IndexFunc(defs, func(d StrategyDefinition) bool { return d.Name == name })
+	if i < 0 {
+		return StrategyDefinition{}, false
+	}
+	return defs[i], true
+}
+
+// ValidateStrategy checks a strategy, its parameters and its constraints
+// against the registry and returns errors keyed by field path below prefix
+// (e.g. "parameters.rollout" or "constraints[0].operator").
+func (r *Registry) ValidateStrategy(s Strategy, prefix string) map[string]string {
+	fields := map[string]string{}
+
+	if s.Name == "" {
+		fields[prefix+"name"] = "Name is required"
+	} else if def, ok := LookupStrategy(r.Strategies, s.Name); !ok {
+		names := make([]string, 0, len(r.Strategies))
+		for _, d := range r.Strategies {
+			names = append(names, d.Name)
+		}
+		fields[prefix+"name"] = fmt.Sprintf("Unknown strategy %q; must be one of: %s", s.Name, strings.Join(names, ", "))
+	} else {
+		validateParameters(def, s.Parameters, prefix+"parameters", fields)
+	}
+
+	for i, c := range s.Constraints {
+		r.validateConstraint(c, fmt.Sprintf("%sconstraints[%d].", prefix, i), fields)
+	}
+
+	return fields
+}
+
+func validateParameters(def StrategyDefinition, params map[string]any, path string, fields map[string]string) {
+	unknown := make([]string, 0)
+	for name := range params {
+		if !slices.ContainsFunc(def.Parameters, func(p StrategyParam) bool { return p.Name == name }) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		fields[path] = fmt.Sprintf("Unknown parameters for %s: %s", def.Name, strings.Join(unknown, ", "))
+	}
+
+	for _, p := range def.Parameters {
+		key := path + "." + p.Name
+		v, ok := params[p.Name]
+		if !ok || v == nil || v == "" {
+			if p.Required {
+				fields[key] = p.Label + " is required"
+			}
+			continue
+		}
+		if msg := p.check(v); msg != "" {
+			fields[key] = msg
+		}
+	}
+}
+
+// check returns why a value is invalid for the parameter, or "".
+func (p StrategyParam) check(v any) string {
+	switch p.Type {
+	case ParamNumber:
+		var n float64
+		switch v := v.(type) {
+		case float64:
+			n = v
+		case int:
+			n = float64(v)
+		case string:
+			// Older clients sent numbers as strings; the SDKs accept both.
+			f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+			if err != nil {
+				return p.Label + " must be a number"
+			}
+			n = f
+		default:
+			return p.Label + " must be a number"
+		}
+		switch {
+		case p.Min != nil && p.Max != nil && (n < *p.Min || n > *p.Max):
+			return fmt.Sprintf("%s must be between %s and %s", p.Label, formatFloat(*p.Min), formatFloat(*p.Max))
+		case p.Min != nil && n < *p.Min:
+			return fmt.Sprintf("%s must be at least %s", p.Label, formatFloat(*p.Min))
+		case p.Max != nil && n > *p.Max:
+			return fmt.Sprintf("%s must be at most %s", p.Label, formatFloat(*p.Max))
+		}
+
+	case ParamEnum:
+		s, ok := v.(string)
+		if !ok || !slices.Contains(p.Values, s) {
+			return fmt.Sprintf("%s must be one of: %s", p.Label, strings.Join(p.Values, ", "))
+		}
+
+	case ParamString:
+		if _, ok := v.(string); !ok {
+			return p.Label + " must be a string"
+		}
+
+	case ParamList:
+		s, ok := v.(string)
+		if !ok {
+			return p.Label + " must be a string with one value per line"
+		}
+		items := SplitList(s)
+		if len(items) == 0 && p.Required {
+			return p.Label + " is required"
+		}
+		if p.item != nil {
+			for _, item := range items {
+				if !p.item(item) {
+					return fmt.Sprintf("%s: %q is not valid", p.Label, item)
+				}
+			}
+		}
+	}
+	return ""
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// SplitList splits a list parameter on newlines and commas, dropping blanks.
+func SplitList(s string) []string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '\n' || r == '\r' || r == ',' })
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// validIPRule accepts the forms the SDKs match remote addresses against: an
+// exact address, a CIDR range or a prefix ending in a dot.
+func validIPRule(s string) bool {
+	if net.ParseIP(s) != nil {
+		return true
+	}
+	if _, _, err := net.ParseCIDR(s); err == nil {
+		return true
+	}
+	return strings.HasSuffix(s, ".") && strings.Trim(s, "0123456789.") == ""
+}
@@ -0,0 +1,114 @@
+package declarative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateStrategy(t *testing.T) {
+	reg := BuiltinRegistry()
+
+	tests := []struct {
+		name     string
+		strategy Strategy
+		want     map[string]string
+	}{
+		{
+			name:     "default",
+			strategy: Strategy{Name: "default"},
+			want:     map[string]string{},
+		},
+		{
+			name:     "rollout as string",
+			strategy: Strategy{Name: "gradualRollout", Parameters: map[string]any{"rollout": "50", "stickiness": "userId"}},
+			want:     map[string]string{},
+		},
+		{
+			name:     "unknown strategy",
+			strategy: Strategy{Name: "flexibleRollout"},
+			want:     map[string]string{"s.name": `Unknown strategy "flexibleRollout"; must be one of: default, gradualRollout, userWithId, remoteAddress`},
+		},
+		{
+			name:     "rollout out of range",
+			strategy: Strategy{Name: "gradualRollout", Parameters: map[string]any{"rollout": float64(150)}},
+			want:     map[string]string{"s.parameters.rollout": "Rollout percentage must be between 0 and 100"},
+		},
+		{
+			name:     "missing and unknown parameters",
+			strategy: Strategy{Name: "gradualRollout", Parameters: map[string]any{"percentage": 10, "stickiness": "cookie"}},
+			want: map[string]string{
+				"s.parameters":            "Unknown parameters for gradualRollout: percentage",
+				"s.parameters.rollout":    "Rollout percentage is required",
+				"s.parameters.stickiness": "Stickiness must be one of: default, userId, sessionId, random",
+			},
+		},
+		{
+			name:     "blank list",
+			strategy: Strategy{Name: "userWithId", Parameters: map[string]any{"userIds": "\n , \n"}},
+			want:     map[string]string{"s.parameters.userIds": "User IDs is required"},
+		},
+		{
+			name:     "ip rules",
+			strategy: Strategy{Name: "remoteAddress", Parameters: map[string]any{"ips": "10.0.0.1\n192.168.0.0/16, 172.16.\nlocalhost"}},
+			want:     map[string]string{"s.parameters.ips": `IP addresses: "localhost" is not valid`},
+		},
+		{
+			name: "constraints",
+			strategy: Strategy{Name: "default", Constraints: []Constraint{
+				{ContextName: "region", Operator: "IN"},
+				{Operator: "LIKE"},
+			}},
+			want: map[string]string{
+				"s.constraints[1].context_name": "Context name is required",
+				"s.constraints[1].operator":     `Unknown operator "LIKE"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, reg.ValidateStrategy(tt.strategy, "s."))
+		})
+	}
+}
+
+func TestSplitList(t *testing.T) {
+	assert.Equal(t, []string{"a", "b", "c"}, SplitList("a\r\nb, c,,\n"))
+	assert.Empty(t, SplitList(" \n "))
+}
+
+func TestValidateDefinition(t *testing.T) {
+	valid := StrategyDefinition{
+		Name: "tenantPlan",
+		Parameters: []StrategyParam{
+			{Name: "plans", Type: ParamList, Required: true},
+			{Name: "tier", Type: ParamEnum, Values: []string{"free", "pro"}, Default: "free"},
+			{Name: "minSeats", Type: ParamNumber, Min: bound(1), Max: bound(1000)},
+		},
+	}
+	assert.Empty(t, ValidateDefinition(valid))
+
+	assert.Equal(t, map[string]string{
+		"name": `"gradualRollout" is a built-in strategy`,
+	}, ValidateDefinition(StrategyDefinition{Name: "gradualRollout"}))
+
+	assert.Equal(t, map[string]string{
+		"name":                  "Name must start with a letter and contain only letters, digits and _",
+		"parameters[1].name":    `Duplicate parameter "plans"`,
+		"parameters[2].type":    "Type must be one of: number, string, enum, list",
+		"parameters[3].values":  "An enum needs at least one value",
+		"parameters[4].min":     "Min must not be greater than max",
+		"parameters[5].default": "Default: seats must be between 1 and 10",
+	}, ValidateDefinition(StrategyDefinition{
+		Name: "tenant plan",
+		Parameters: []StrategyParam{
+			{Name: "plans", Type: ParamList},
+			{Name: "plans", Type: ParamString},
+			{Name: "date", Type: "date"},
+			{Name: "tier", Type: ParamEnum},
+			{Name: "range", Type: ParamNumber, Min: bound(10), Max: bound(1)},
+			{Name: "seats", Type: ParamNumber, Min: bound(1), Max: bound(10), Default: float64(20)},
+		},
+	}))
+}
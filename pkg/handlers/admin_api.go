@@ -3,30 +3,41 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
-	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	entflag "github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/ent/project"
-	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	"github.com/felipekafuri/bandeira/pkg/context"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/log"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/pager"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 	"github.com/felipekafuri/bandeira/pkg/token"
 )
 
 type AdminAPI struct {
-	ORM *ent.Client
-	Hub *services.Hub
+	ORM      *ent.Client
+	Hub      *services.Hub
+	Trash    *services.TrashService
+	Flags    *services.FlagService
+	Rollouts *services.RolloutService
+	Metrics  *services.Metrics
 }
 
 func init() {
@@ -36,13 +47,17 @@ func init() {
 func (h *AdminAPI) Init(c *services.Container) error {
 	h.ORM = c.ORM
 	h.Hub = c.Hub
+	h.Trash = c.Trash
+	h.Flags = c.Flags
+	h.Rollouts = c.Rollouts
+	h.Metrics = c.Metrics
 	return nil
 }
 
 func (h *AdminAPI) Routes(_ *echo.Group) {}
 
 func (h *AdminAPI) APIRoutes(api *echo.Group) {
-	admin := api.Group("/admin", middleware.RequireTokenAuth(h.ORM, "admin"))
+	admin := api.Group("/admin", middleware.RequireTokenAuth(h.ORM, h.Metrics, "admin"))
 
 	// Projects
 	admin.GET("/projects", h.ListProjects).Name = routenames.AdminProjectList
@@ -50,6 +65,12 @@ func (h *AdminAPI) APIRoutes(api *echo.Group) {
 	admin.GET("/projects/:id", h.GetProject).Name = routenames.AdminProjectGet
 	admin.PUT("/projects/:id", h.UpdateProject).Name = routenames.AdminProjectUpdate
 	admin.DELETE("/projects/:id", h.DeleteProject).Name = routenames.AdminProjectDelete
+	admin.GET("/projects/:id/export", h.ExportProject).Name = routenames.AdminProjectExport
+	admin.POST("/projects/:id/import", h.ImportProject).Name = routenames.AdminProjectImport
+	admin.POST("/projects/:id/promote", h.PromoteProject).Name = routenames.AdminProjectPromote
+	admin.GET("/projects/:id/compare", h.CompareProject).Name = routenames.AdminProjectCompare
+	admin.GET("/projects/:id/drift", h.ProjectDrift).Name = routenames.AdminProjectDrift
+	admin.POST("/projects/:id/evaluate", h.EvaluateProject).Name = routenames.AdminProjectEvaluate
 
 	// Environments (nested under project)
 	admin.GET("/projects/:id/environments", h.ListEnvironments).Name = routenames.AdminEnvironmentList
@@ -57,18 +78,56 @@ func (h *AdminAPI) APIRoutes(api *echo.Group) {
 	admin.PUT("/projects/:id/environments/:envId", h.UpdateEnvironment).Name = routenames.AdminEnvironmentUpdate
 	admin.DELETE("/projects/:id/environments/:envId", h.DeleteEnvironment).Name = routenames.AdminEnvironmentDelete
 
+	// Freeze and kill switch
+	admin.PUT("/projects/:id/freeze", h.FreezeProject).Name = routenames.AdminProjectFreeze
+	admin.DELETE("/projects/:id/freeze", h.UnfreezeProject).Name = routenames.AdminProjectUnfreeze
+	admin.PUT("/projects/:id/environments/:envId/freeze", h.FreezeEnvironment).Name = routenames.AdminEnvironmentFreeze
+	admin.DELETE("/projects/:id/environments/:envId/freeze", h.UnfreezeEnvironment).Name = routenames.AdminEnvironmentUnfreeze
+	admin.POST("/projects/:id/environments/:envId/kill-switch", h.KillSwitch).Name = routenames.AdminEnvironmentKillSwitch
+
 	// Flags (nested under project)
 	admin.GET("/projects/:id/flags", h.ListFlags).Name = routenames.AdminFlagList
+	admin.GET("/projects/:id/tags", h.ListTags).Name = routenames.AdminTagList
 	admin.POST("/projects/:id/flags", h.CreateFlag).Name = routenames.AdminFlagCreate
 	admin.GET("/projects/:id/flags/:flagId", h.GetFlag).Name = routenames.AdminFlagGet
 	admin.PUT("/projects/:id/flags/:flagId", h.UpdateFlag).Name = routenames.AdminFlagUpdate
 	admin.DELETE("/projects/:id/flags/:flagId", h.DeleteFlag).Name = routenames.AdminFlagDelete
+	admin.POST("/projects/:id/flags/bulk", h.BulkFlags).Name = routenames.AdminFlagBulk
 	admin.PATCH("/projects/:id/flags/:flagId/environments/:envId", h.PatchFlagEnv).Name = routenames.AdminFlagEnvPatch
+	admin.GET("/projects/:id/flags/:flagId/environments/:envId/versions", h.ListVersions).Name = routenames.AdminVersionList
+	admin.GET("/projects/:id/flags/:flagId/environments/:envId/versions/diff", h.DiffVersions).Name = routenames.AdminVersionDiff
+	admin.POST("/projects/:id/flags/:flagId/environments/:envId/versions/:version/restore", h.RestoreVersion).Name = routenames.AdminVersionRestore
+
+	// Rollout plans
+	admin.GET("/projects/:id/flags/:flagId/rollouts", h.ListRollouts).Name = routenames.AdminRolloutList
+	admin.POST("/projects/:id/flags/:flagId/rollouts", h.CreateRollout).Name = routenames.AdminRolloutCreate
+	admin.GET("/projects/:id/flags/:flagId/rollouts/:planId", h.GetRollout).Name = routenames.AdminRolloutGet
+	admin.POST("/projects/:id/flags/:flagId/rollouts/:planId/pause", h.PauseRollout).Name = routenames.AdminRolloutPause
+	admin.POST("/projects/:id/flags/:flagId/rollouts/:planId/resume", h.ResumeRollout).Name = routenames.AdminRolloutResume
+	admin.POST("/projects/:id/flags/:flagId/rollouts/:planId/abort", h.AbortRollout).Name = routenames.AdminRolloutAbort
+
+	// Trash
+	admin.GET("/projects/:id/trash", h.ListTrash).Name = routenames.AdminTrashList
+	admin.POST("/projects/:id/flags/:flagId/restore", h.RestoreFlag).Name = routenames.AdminFlagRestore
+	admin.POST("/projects/:id/environments/:envId/restore", h.RestoreEnvironment).Name = routenames.AdminEnvironmentRestore
 
 	// Tokens
 	admin.GET("/api-tokens", h.ListTokens).Name = routenames.AdminTokenList
 	admin.POST("/api-tokens", h.CreateToken).Name = routenames.AdminTokenCreate
 	admin.DELETE("/api-tokens/:id", h.DeleteToken).Name = routenames.AdminTokenDelete
+
+	// Strategies
+	admin.GET("/strategies", h.ListStrategies).Name = routenames.AdminStrategyList
+	admin.GET("/projects/:id/strategy-definitions", h.ListStrategyDefinitions).Name = routenames.AdminStrategyDefinitionList
+	admin.POST("/projects/:id/strategy-definitions", h.CreateStrategyDefinition).Name = routenames.AdminStrategyDefinitionCreate
+	admin.PUT("/projects/:id/strategy-definitions/:definitionId", h.UpdateStrategyDefinition).Name = routenames.AdminStrategyDefinitionUpdate
+	admin.DELETE("/projects/:id/strategy-definitions/:definitionId", h.DeleteStrategyDefinition).Name = routenames.AdminStrategyDefinitionDelete
+
+	// Context fields
+	admin.GET("/projects/:id/context-fields", h.ListContextFields).Name = routenames.AdminContextFieldList
+	admin.POST("/projects/:id/context-fields", h.CreateContextField).Name = routenames.AdminContextFieldCreate
+	admin.PUT("/projects/:id/context-fields/:fieldId", h.UpdateContextField).Name = routenames.AdminContextFieldUpdate
+	admin.DELETE("/projects/:id/context-fields/:fieldId", h.DeleteContextField).Name = routenames.AdminContextFieldDelete
 }
 
 // ---------------------------------------------------------------------------
@@ -79,6 +138,11 @@ func adminTokenFromContext(ctx echo.Context) *ent.ApiToken {
 	return ctx.Get(context.APITokenKey).(*ent.ApiToken)
 }
 
+// adminActor names the admin token of the request for version history.
+func adminActor(ctx echo.Context) string {
+	return "token:" + adminTokenFromContext(ctx).Name
+}
+
 var errAccessDenied = errors.New("access denied")
 
 func (h *AdminAPI) requireProjectAccess(ctx echo.Context) (int, error) {
@@ -95,6 +159,20 @@ func (h *AdminAPI) requireProjectAccess(ctx echo.Context) (int, error) {
 	return id, nil
 }
 
+var errInvalidListParams = errors.New("invalid list parameters")
+
+// listParams parses the limit, cursor, sort and q parameters of a list
+// request. Like requireProjectAccess, it writes the 422 response itself.
+func listParams(ctx echo.Context, sorts []string, defaultSort string) (pager.Params, error) {
+	page, err := pager.Parse(ctx.QueryParams(), sorts, defaultSort)
+	var pe *pager.ParamError
+	if errors.As(err, &pe) {
+		jsonValidationError(ctx, map[string]string{pe.Param: pe.Message})
+		return page, errInvalidListParams
+	}
+	return page, nil
+}
+
 func jsonError(ctx echo.Context, code int, msg string) error {
 	return ctx.JSON(code, map[string]any{"error": msg})
 }
@@ -114,22 +192,47 @@ func timeRFC3339(t time.Time) string {
 // Projects
 // ---------------------------------------------------------------------------
 
+// ListProjects lists the token's project. It takes the same list parameters
+// as the other list endpoints so that clients can treat them alike.
 func (h *AdminAPI) ListProjects(ctx echo.Context) error {
 	tok := adminTokenFromContext(ctx)
 	reqCtx := ctx.Request().Context()
 
-	p, err := h.ORM.Project.Query().
+	page, err := listParams(ctx, []string{"name", "created_at"}, "name")
+	if err != nil {
+		return nil
+	}
+
+	query := h.ORM.Project.Query().
 		Where(project.ID(tok.ProjectID)).
-		WithFlags().
-		WithEnvironments().
-		Only(reqCtx)
+		Where(predicate.Project(page.Search(project.FieldName, project.FieldDescription)))
+	total, err := query.Clone().Count(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to load project")
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"projects": []map[string]any{projectDTO(p)},
-	})
+	projects, err := query.
+		Where(predicate.Project(page.After())).
+		Order(project.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		WithFlags(func(q *ent.FlagQuery) {
+			q.Where(entflag.DeletedAtIsNil())
+		}).
+		WithEnvironments(func(q *ent.EnvironmentQuery) {
+			q.Where(environment.DeletedAtIsNil())
+		}).
+		All(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load project")
+	}
+
+	projects, next := pager.Trim(page, projects, func(p *ent.Project) int { return p.ID })
+	items := make([]map[string]any, 0, len(projects))
+	for _, p := range projects {
+		items = append(items, projectDTO(p))
+	}
+
+	return ctx.JSON(http.StatusOK, pager.NewPage(items, next, total))
 }
 
 func (h *AdminAPI) CreateProject(ctx echo.Context) error {
@@ -145,8 +248,12 @@ func (h *AdminAPI) GetProject(ctx echo.Context) error {
 	reqCtx := ctx.Request().Context()
 	p, err := h.ORM.Project.Query().
 		Where(project.ID(projectID)).
-		WithFlags().
-		WithEnvironments().
+		WithFlags(func(q *ent.FlagQuery) {
+			q.Where(entflag.DeletedAtIsNil())
+		}).
+		WithEnvironments(func(q *ent.EnvironmentQuery) {
+			q.Where(environment.DeletedAtIsNil())
+		}).
 		Only(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusNotFound, "Project not found")
@@ -205,29 +312,12 @@ func (h *AdminAPI) DeleteProject(ctx echo.Context) error {
 		return nil
 	}
 
-	reqCtx := ctx.Request().Context()
-
-	// Cascade-delete children (SQLite has no FK cascade).
-	// Delete strategies+constraints for flags in this project.
-	flagIDs, _ := h.ORM.Flag.Query().Where(entflag.ProjectID(projectID)).IDs(reqCtx)
-	if len(flagIDs) > 0 {
-		feIDs, _ := h.ORM.FlagEnvironment.Query().Where(flagenvironment.FlagIDIn(flagIDs...)).IDs(reqCtx)
-		if len(feIDs) > 0 {
-			stratIDs, _ := h.ORM.Strategy.Query().Where(strategy.FlagEnvironmentIDIn(feIDs...)).IDs(reqCtx)
-			if len(stratIDs) > 0 {
-				h.ORM.Constraint.Delete().Where(entconstraint.StrategyIDIn(stratIDs...)).Exec(reqCtx)
-				h.ORM.Strategy.Delete().Where(strategy.IDIn(stratIDs...)).Exec(reqCtx)
-			}
-			h.ORM.FlagEnvironment.Delete().Where(flagenvironment.IDIn(feIDs...)).Exec(reqCtx)
-		}
-		h.ORM.Flag.Delete().Where(entflag.ProjectID(projectID)).Exec(reqCtx)
-	}
-	h.ORM.Environment.Delete().Where(environment.ProjectID(projectID)).Exec(reqCtx)
-	h.ORM.ApiToken.Delete().Where(apitoken.ProjectID(projectID)).Exec(reqCtx)
-
-	if err := h.ORM.Project.DeleteOneID(projectID).Exec(reqCtx); err != nil {
+	// The project goes to the trash with everything in it, and its tokens,
+	// including this one, stop working until it is restored.
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashProject, projectID); err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to delete project")
 	}
+	h.Hub.NotifyProject(projectID)
 
 	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
 }
@@ -239,6 +329,7 @@ func projectDTO(p *ent.Project) map[string]any {
 		"description":       p.Description,
 		"flag_count":        len(p.Edges.Flags),
 		"environment_count": len(p.Edges.Environments),
+		"freeze":            freezeDTO(declarative.ProjectFreeze(p)),
 		"created_at":        timeRFC3339(p.CreatedAt),
 		"updated_at":        timeRFC3339(p.UpdatedAt),
 	}
@@ -254,20 +345,38 @@ func (h *AdminAPI) ListEnvironments(ctx echo.Context) error {
 		return nil
 	}
 
-	envs, err := h.ORM.Environment.Query().
-		Where(environment.ProjectID(projectID)).
-		Order(environment.BySortOrder()).
-		All(ctx.Request().Context())
+	page, err := listParams(ctx, []string{"sort_order", "name", "created_at"}, "sort_order")
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// Environments have no description; search goes by name only.
+	query := h.ORM.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Where(predicate.Environment(page.Search(environment.FieldName)))
+	total, err := query.Clone().Count(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to load environments")
 	}
 
+	envs, err := query.
+		Where(predicate.Environment(page.After())).
+		Order(environment.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		All(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load environments")
+	}
+
+	envs, next := pager.Trim(page, envs, func(e *ent.Environment) int { return e.ID })
 	items := make([]map[string]any, 0, len(envs))
 	for _, e := range envs {
 		items = append(items, envDTO(e))
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"environments": items})
+	return ctx.JSON(http.StatusOK, pager.NewPage(items, next, total))
 }
 
 func (h *AdminAPI) CreateEnvironment(ctx echo.Context) error {
@@ -277,9 +386,10 @@ func (h *AdminAPI) CreateEnvironment(ctx echo.Context) error {
 	}
 
 	var body struct {
-		Name      string `json:"name"`
-		Type      string `json:"type"`
-		SortOrder *int   `json:"sort_order"`
+		Name            string `json:"name"`
+		Type            string `json:"type"`
+		SortOrder       *int   `json:"sort_order"`
+		ExpectedMatchID *int   `json:"expected_match_id"`
 	}
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
 		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
@@ -293,6 +403,9 @@ func (h *AdminAPI) CreateEnvironment(ctx echo.Context) error {
 	if !validTypes[body.Type] {
 		fields["type"] = "Type must be one of: development, staging, production"
 	}
+	if body.ExpectedMatchID != nil && !expectedMatchInProject(ctx.Request().Context(), h.ORM, projectID, 0, *body.ExpectedMatchID) {
+		fields["expected_match_id"] = "Must be another environment of this project"
+	}
 	if len(fields) > 0 {
 		return jsonValidationError(ctx, fields)
 	}
@@ -304,8 +417,12 @@ func (h *AdminAPI) CreateEnvironment(ctx echo.Context) error {
 	if body.SortOrder != nil {
 		create.SetSortOrder(*body.SortOrder)
 	}
+	create.SetNillableExpectedMatchID(body.ExpectedMatchID)
 
 	e, err := create.Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "Environment name is taken by another environment or one in the trash")
+	}
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to create environment")
 	}
@@ -328,7 +445,7 @@ func (h *AdminAPI) UpdateEnvironment(ctx echo.Context) error {
 
 	// Verify env belongs to this project.
 	e, err := h.ORM.Environment.Query().
-		Where(environment.ID(envID), environment.ProjectID(projectID)).
+		Where(environment.ID(envID), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
 		Only(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusNotFound, "Environment not found")
@@ -338,6 +455,8 @@ func (h *AdminAPI) UpdateEnvironment(ctx echo.Context) error {
 		Name      *string `json:"name"`
 		Type      *string `json:"type"`
 		SortOrder *int    `json:"sort_order"`
+		// ExpectedMatchID 0 clears the relationship.
+		ExpectedMatchID *int `json:"expected_match_id"`
 	}
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
 		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
@@ -353,6 +472,10 @@ func (h *AdminAPI) UpdateEnvironment(ctx echo.Context) error {
 			fields["type"] = "Type must be one of: development, staging, production"
 		}
 	}
+	if body.ExpectedMatchID != nil && *body.ExpectedMatchID != 0 &&
+		!expectedMatchInProject(reqCtx, h.ORM, projectID, e.ID, *body.ExpectedMatchID) {
+		fields["expected_match_id"] = "Must be another environment of this project"
+	}
 	if len(fields) > 0 {
 		return jsonValidationError(ctx, fields)
 	}
@@ -367,8 +490,18 @@ func (h *AdminAPI) UpdateEnvironment(ctx echo.Context) error {
 	if body.SortOrder != nil {
 		update.SetSortOrder(*body.SortOrder)
 	}
+	if body.ExpectedMatchID != nil {
+		if *body.ExpectedMatchID == 0 {
+			update.ClearExpectedMatchID()
+		} else {
+			update.SetExpectedMatchID(*body.ExpectedMatchID)
+		}
+	}
 
 	updated, err := update.Save(reqCtx)
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "Environment name is taken by another environment or one in the trash")
+	}
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to update environment")
 	}
@@ -388,29 +521,193 @@ func (h *AdminAPI) DeleteEnvironment(ctx echo.Context) error {
 	}
 
 	// Verify env belongs to this project.
-	exists, err := h.ORM.Environment.Query().
-		Where(environment.ID(envID), environment.ProjectID(projectID)).
-		Exist(ctx.Request().Context())
-	if err != nil || !exists {
+	e, err := h.ORM.Environment.Query().
+		Where(environment.ID(envID), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
+	if err != nil {
 		return jsonError(ctx, http.StatusNotFound, "Environment not found")
 	}
 
-	if err := h.ORM.Environment.DeleteOneID(envID).Exec(ctx.Request().Context()); err != nil {
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashEnvironment, envID); err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to delete environment")
 	}
+	h.Hub.Notify(projectID, e.Name)
 
 	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
 }
 
 func envDTO(e *ent.Environment) map[string]any {
 	return map[string]any{
-		"id":         e.ID,
-		"name":       e.Name,
-		"type":       string(e.Type),
-		"sort_order": e.SortOrder,
-		"project_id": e.ProjectID,
-		"created_at": timeRFC3339(e.CreatedAt),
-		"updated_at": timeRFC3339(e.UpdatedAt),
+		"id":                e.ID,
+		"name":              e.Name,
+		"type":              string(e.Type),
+		"sort_order":        e.SortOrder,
+		"project_id":        e.ProjectID,
+		"expected_match_id": e.ExpectedMatchID,
+		"freeze":            freezeDTO(declarative.EnvironmentFreeze(e)),
+		"created_at":        timeRFC3339(e.CreatedAt),
+		"updated_at":        timeRFC3339(e.UpdatedAt),
+	}
+}
+
+// ---------------------------------------------------------------------------
+// Freeze and kill switch
+// ---------------------------------------------------------------------------
+
+// FreezeProject freezes the project: until it is lifted, the dashboard
+// rejects changes by users other than admins.
+func (h *AdminAPI) FreezeProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	reqCtx := ctx.Request().Context()
+	if err := declarative.FreezeProject(reqCtx, h.ORM, projectID, adminActor(ctx), body.Reason); err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to freeze project")
+	}
+	log.Ctx(ctx).Info("project frozen", "project_id", projectID, "actor", adminActor(ctx), "reason", body.Reason)
+
+	p, err := h.ORM.Project.Get(reqCtx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load project")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"freeze": freezeDTO(declarative.ProjectFreeze(p))})
+}
+
+func (h *AdminAPI) UnfreezeProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	if err := declarative.UnfreezeProject(ctx.Request().Context(), h.ORM, projectID); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to lift the freeze")
+	}
+	log.Ctx(ctx).Info("project unfrozen", "project_id", projectID, "actor", adminActor(ctx))
+
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
+}
+
+// FreezeEnvironment freezes one environment; see FreezeProject.
+func (h *AdminAPI) FreezeEnvironment(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	e, err := h.findEnvironment(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	reqCtx := ctx.Request().Context()
+	if err := declarative.FreezeEnvironment(reqCtx, h.ORM, e.ID, adminActor(ctx), body.Reason); err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to freeze environment")
+	}
+	log.Ctx(ctx).Info("environment frozen", "project_id", projectID, "environment", e.Name, "actor", adminActor(ctx), "reason", body.Reason)
+
+	e, err = h.ORM.Environment.Get(reqCtx, e.ID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load environment")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"freeze": freezeDTO(declarative.EnvironmentFreeze(e))})
+}
+
+func (h *AdminAPI) UnfreezeEnvironment(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	e, err := h.findEnvironment(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	}
+
+	if err := declarative.UnfreezeEnvironment(ctx.Request().Context(), h.ORM, e.ID); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to lift the freeze")
+	}
+	log.Ctx(ctx).Info("environment unfrozen", "project_id", projectID, "environment", e.Name, "actor", adminActor(ctx))
+
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
+}
+
+// KillSwitch turns off every kill_switch flag in the environment at once.
+func (h *AdminAPI) KillSwitch(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	e, err := h.findEnvironment(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	}
+
+	var body struct {
+		Reason string `json:"reason"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	flags, err := h.Flags.KillSwitch(ctx.Request().Context(), projectID, e.ID, adminActor(ctx), body.Reason)
+	if err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to pull the kill switch")
+	}
+	log.Ctx(ctx).Warn("kill switch pulled", "project_id", projectID, "environment", e.Name, "actor", adminActor(ctx), "reason", body.Reason, "flags", flags)
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"environment": e.Name,
+		"flags":       flags,
+	})
+}
+
+func (h *AdminAPI) findEnvironment(ctx echo.Context, projectID int) (*ent.Environment, error) {
+	id, err := strconv.Atoi(ctx.Param("envId"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.Environment.Query().
+		Where(environment.ID(id), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
+}
+
+// freezeDTO converts a freeze; nil when not frozen.
+func freezeDTO(f *declarative.Freeze) map[string]any {
+	if f == nil {
+		return nil
+	}
+	return map[string]any{
+		"frozen_at": timeRFC3339(f.FrozenAt),
+		"frozen_by": f.FrozenBy,
+		"reason":    f.Reason,
 	}
 }
 
@@ -424,19 +721,46 @@ func (h *AdminAPI) ListFlags(ctx echo.Context) error {
 		return nil
 	}
 
-	flags, err := h.ORM.Flag.Query().
-		Where(entflag.ProjectID(projectID)).
-		All(ctx.Request().Context())
+	filter := parseFlagFilter(ctx)
+	if filter.Type != "" && entflag.FlagTypeValidator(entflag.FlagType(filter.Type)) != nil {
+		return jsonValidationError(ctx, map[string]string{
+			"type": "Flag type must be one of: release, experiment, operational, kill_switch",
+		})
+	}
+
+	page, err := listParams(ctx, flagSorts, "name")
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	query := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		Where(filter.predicates()...).
+		Where(predicate.Flag(page.Search(entflag.FieldName, entflag.FieldDescription)))
+	total, err := query.Clone().Count(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to load flags")
 	}
 
+	flags, err := query.
+		Where(predicate.Flag(page.After())).
+		Order(entflag.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		WithTags().
+		All(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load flags")
+	}
+
+	flags, next := pager.Trim(page, flags, func(f *ent.Flag) int { return f.ID })
 	items := make([]map[string]any, 0, len(flags))
 	for _, f := range flags {
 		items = append(items, flagSimpleDTO(f))
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"flags": items})
+	return ctx.JSON(http.StatusOK, pager.NewPage(items, next, total))
 }
 
 func (h *AdminAPI) CreateFlag(ctx echo.Context) error {
@@ -446,15 +770,20 @@ func (h *AdminAPI) CreateFlag(ctx echo.Context) error {
 	}
 
 	var body struct {
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		FlagType    string `json:"flag_type"`
+		Name        string             `json:"name"`
+		Description string             `json:"description"`
+		FlagType    string             `json:"flag_type"`
+		Owner       string             `json:"owner"`
+		Tags        []string           `json:"tags"`
+		Links       []declarative.Link `json:"links"`
+		Metadata    map[string]string  `json:"metadata"`
 	}
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
 		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
 	}
 
-	fields := map[string]string{}
+	tags := declarative.NormalizeTags(body.Tags)
+	fields := declarative.ValidateFlagMetadata(tags, body.Links, body.Metadata, "")
 	if body.Name == "" {
 		fields["name"] = "Name is required"
 	}
@@ -466,19 +795,38 @@ func (h *AdminAPI) CreateFlag(ctx echo.Context) error {
 		return jsonValidationError(ctx, fields)
 	}
 
-	f, err := h.ORM.Flag.Create().
+	reqCtx := ctx.Request().Context()
+
+	tx, err := h.ORM.Tx(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to create flag")
+	}
+	f, err := tx.Flag.Create().
 		SetName(body.Name).
 		SetNillableDescription(nilIfEmpty(body.Description)).
 		SetFlagType(entflag.FlagType(body.FlagType)).
+		SetNillableOwner(nilIfEmpty(strings.TrimSpace(body.Owner))).
+		SetLinks(declarative.LinksToEnt(body.Links)).
+		SetMetadata(body.Metadata).
 		SetProjectID(projectID).
-		Save(ctx.Request().Context())
+		Save(reqCtx)
+	if err == nil {
+		err = declarative.SetTags(reqCtx, tx.Client(), projectID, f.ID, tags)
+	}
 	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return jsonError(ctx, http.StatusConflict, "Flag name is taken by another flag or one in the trash")
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to create flag")
+	}
+	if err := tx.Commit(); err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to create flag")
 	}
 
 	h.Hub.NotifyProject(projectID)
 
-	return ctx.JSON(http.StatusCreated, flagSimpleDTO(f))
+	return h.flagResponse(ctx, http.StatusCreated, f.ID)
 }
 
 func (h *AdminAPI) GetFlag(ctx echo.Context) error {
@@ -495,11 +843,16 @@ func (h *AdminAPI) GetFlag(ctx echo.Context) error {
 	reqCtx := ctx.Request().Context()
 
 	f, err := h.ORM.Flag.Query().
-		Where(entflag.ID(flagID), entflag.ProjectID(projectID)).
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		WithTags().
 		WithFlagEnvironments(func(q *ent.FlagEnvironmentQuery) {
+			q.Where(flagenvironment.HasEnvironmentWith(environment.DeletedAtIsNil()))
 			q.WithStrategies(func(sq *ent.StrategyQuery) {
 				sq.WithConstraints()
 			})
+			q.WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+				pq.WithParentFlag()
+			})
 			q.WithEnvironment()
 		}).
 		Only(reqCtx)
@@ -507,30 +860,13 @@ func (h *AdminAPI) GetFlag(ctx echo.Context) error {
 		return jsonError(ctx, http.StatusNotFound, "Flag not found")
 	}
 
+	dependents, err := declarative.Dependents(reqCtx, h.ORM, f.ID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load dependents")
+	}
+
 	envConfigs := make([]map[string]any, 0, len(f.Edges.FlagEnvironments))
 	for _, fe := range f.Edges.FlagEnvironments {
-		strategies := make([]map[string]any, 0, len(fe.Edges.Strategies))
-		for _, s := range fe.Edges.Strategies {
-			constraints := make([]map[string]any, 0, len(s.Edges.Constraints))
-			for _, c := range s.Edges.Constraints {
-				constraints = append(constraints, map[string]any{
-					"id":               c.ID,
-					"context_name":     c.ContextName,
-					"operator":         string(c.Operator),
-					"values":           c.Values,
-					"inverted":         c.Inverted,
-					"case_insensitive": c.CaseInsensitive,
-				})
-			}
-			strategies = append(strategies, map[string]any{
-				"id":          s.ID,
-				"name":        s.Name,
-				"parameters":  s.Parameters,
-				"sort_order":  s.SortOrder,
-				"constraints": constraints,
-			})
-		}
-
 		envName := ""
 		if fe.Edges.Environment != nil {
 			envName = fe.Edges.Environment.Name
@@ -540,20 +876,16 @@ func (h *AdminAPI) GetFlag(ctx echo.Context) error {
 			"environment_id":   fe.EnvironmentID,
 			"environment_name": envName,
 			"enabled":          fe.Enabled,
-			"strategies":       strategies,
+			"revision":         fe.Revision,
+			"strategies":       strategyDTOs(fe.Edges.Strategies),
+			"prerequisites":    prerequisiteDTOs(fe.Edges.Prerequisites),
 		})
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"id":           f.ID,
-		"name":         f.Name,
-		"description":  f.Description,
-		"flag_type":    string(f.FlagType),
-		"project_id":   f.ProjectID,
-		"created_at":   timeRFC3339(f.CreatedAt),
-		"updated_at":   timeRFC3339(f.UpdatedAt),
-		"environments": envConfigs,
-	})
+	dto := flagSimpleDTO(f)
+	dto["environments"] = envConfigs
+	dto["dependents"] = dependents
+	return ctx.JSON(http.StatusOK, dto)
 }
 
 func (h *AdminAPI) UpdateFlag(ctx echo.Context) error {
@@ -571,22 +903,42 @@ func (h *AdminAPI) UpdateFlag(ctx echo.Context) error {
 
 	// Verify flag belongs to project.
 	f, err := h.ORM.Flag.Query().
-		Where(entflag.ID(flagID), entflag.ProjectID(projectID)).
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
 		Only(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusNotFound, "Flag not found")
 	}
 
 	var body struct {
-		Name        *string `json:"name"`
-		Description *string `json:"description"`
-		FlagType    *string `json:"flag_type"`
+		Name        *string             `json:"name"`
+		Description *string             `json:"description"`
+		FlagType    *string             `json:"flag_type"`
+		Owner       *string             `json:"owner"`
+		Tags        *[]string           `json:"tags"`
+		Links       *[]declarative.Link `json:"links"`
+		Metadata    *map[string]string  `json:"metadata"`
 	}
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
 		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
 	}
 
-	fields := map[string]string{}
+	// Omitted fields keep their current value.
+	var (
+		tags     []string
+		links    []declarative.Link
+		metadata map[string]string
+	)
+	if body.Tags != nil {
+		tags = declarative.NormalizeTags(*body.Tags)
+	}
+	if body.Links != nil {
+		links = *body.Links
+	}
+	if body.Metadata != nil {
+		metadata = *body.Metadata
+	}
+
+	fields := declarative.ValidateFlagMetadata(tags, links, metadata, "")
 	if body.Name != nil && *body.Name == "" {
 		fields["name"] = "Name is required"
 	}
@@ -600,7 +952,11 @@ func (h *AdminAPI) UpdateFlag(ctx echo.Context) error {
 		return jsonValidationError(ctx, fields)
 	}
 
-	update := f.Update()
+	tx, err := h.ORM.Tx(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to update flag")
+	}
+	update := tx.Flag.UpdateOneID(f.ID)
 	if body.Name != nil {
 		update.SetName(*body.Name)
 	}
@@ -610,15 +966,38 @@ func (h *AdminAPI) UpdateFlag(ctx echo.Context) error {
 	if body.FlagType != nil {
 		update.SetFlagType(entflag.FlagType(*body.FlagType))
 	}
+	if body.Owner != nil {
+		if owner := strings.TrimSpace(*body.Owner); owner == "" {
+			update.ClearOwner()
+		} else {
+			update.SetOwner(owner)
+		}
+	}
+	if body.Links != nil {
+		update.SetLinks(declarative.LinksToEnt(links))
+	}
+	if body.Metadata != nil {
+		update.SetMetadata(metadata)
+	}
 
-	updated, err := update.Save(reqCtx)
+	err = update.Exec(reqCtx)
+	if err == nil && body.Tags != nil {
+		err = declarative.SetTags(reqCtx, tx.Client(), projectID, f.ID, tags)
+	}
 	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return jsonError(ctx, http.StatusConflict, "Flag name is taken by another flag or one in the trash")
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to update flag")
+	}
+	if err := tx.Commit(); err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to update flag")
 	}
 
 	h.Hub.NotifyProject(projectID)
 
-	return ctx.JSON(http.StatusOK, flagSimpleDTO(updated))
+	return h.flagResponse(ctx, http.StatusOK, f.ID)
 }
 
 func (h *AdminAPI) DeleteFlag(ctx echo.Context) error {
@@ -634,13 +1013,24 @@ func (h *AdminAPI) DeleteFlag(ctx echo.Context) error {
 
 	// Verify flag belongs to project.
 	exists, err := h.ORM.Flag.Query().
-		Where(entflag.ID(flagID), entflag.ProjectID(projectID)).
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
 		Exist(ctx.Request().Context())
 	if err != nil || !exists {
 		return jsonError(ctx, http.StatusNotFound, "Flag not found")
 	}
 
-	if err := h.ORM.Flag.DeleteOneID(flagID).Exec(ctx.Request().Context()); err != nil {
+	dependents, err := declarative.Dependents(ctx.Request().Context(), h.ORM, flagID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load dependents")
+	}
+	if len(dependents) > 0 {
+		return ctx.JSON(http.StatusConflict, map[string]any{
+			"error":      "Flag is a prerequisite of other flags",
+			"dependents": dependents,
+		})
+	}
+
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashFlag, flagID); err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to delete flag")
 	}
 
@@ -649,181 +1039,1063 @@ func (h *AdminAPI) DeleteFlag(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
 }
 
+// BulkFlags toggles, configures, deletes or tags many flags at once. By
+// default all operations commit together; see BulkRequest.
+func (h *AdminAPI) BulkFlags(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var req BulkRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	results, failed, fields, err := bulkFlags(ctx, h.ORM, h.Flags, h.Trash, h.Hub, projectID, req, adminActor(ctx))
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to apply operations")
+	}
+	if len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+	return bulkResponse(ctx, req, results, failed)
+}
+
+// flagResponse loads a flag with its tags and writes it as the response.
+func (h *AdminAPI) flagResponse(ctx echo.Context, code, flagID int) error {
+	f, err := h.ORM.Flag.Query().
+		Where(entflag.ID(flagID)).
+		WithTags().
+		Only(ctx.Request().Context())
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load flag")
+	}
+	return ctx.JSON(code, flagSimpleDTO(f))
+}
+
+// flagSimpleDTO expects the flag's tags to be loaded.
 func flagSimpleDTO(f *ent.Flag) map[string]any {
+	links := declarative.LinksFromEnt(f.Links)
+	if links == nil {
+		links = []declarative.Link{}
+	}
+	metadata := f.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
 	return map[string]any{
 		"id":          f.ID,
 		"name":        f.Name,
 		"description": f.Description,
 		"flag_type":   string(f.FlagType),
 		"project_id":  f.ProjectID,
+		"owner":       f.Owner,
+		"tags":        declarative.TagNames(f.Edges.Tags),
+		"links":       links,
+		"metadata":    metadata,
 		"created_at":  timeRFC3339(f.CreatedAt),
 		"updated_at":  timeRFC3339(f.UpdatedAt),
 	}
 }
 
-// ---------------------------------------------------------------------------
-// PATCH flag/env
-// ---------------------------------------------------------------------------
-
-func (h *AdminAPI) PatchFlagEnv(ctx echo.Context) error {
+// ListTags lists the tags of a project with the number of flags using each.
+func (h *AdminAPI) ListTags(ctx echo.Context) error {
 	projectID, err := h.requireProjectAccess(ctx)
 	if err != nil {
 		return nil
 	}
 
-	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	tags, err := h.ORM.Tag.Query().
+		Where(tag.ProjectID(projectID)).
+		Order(tag.ByName()).
+		WithFlags(func(q *ent.FlagQuery) {
+			q.Where(entflag.DeletedAtIsNil())
+		}).
+		All(ctx.Request().Context())
 	if err != nil {
-		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load tags")
 	}
 
-	envID, err := strconv.Atoi(ctx.Param("envId"))
-	if err != nil {
-		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	items := make([]map[string]any, 0, len(tags))
+	for _, t := range tags {
+		items = append(items, map[string]any{
+			"name":       t.Name,
+			"flag_count": len(t.Edges.Flags),
+		})
 	}
 
-	reqCtx := ctx.Request().Context()
+	return ctx.JSON(http.StatusOK, map[string]any{"tags": items})
+}
+
+// ---------------------------------------------------------------------------
+// Strategies
+// ---------------------------------------------------------------------------
+
+// ListStrategies lists the built-in strategies, with the parameters each
+// takes. Strategy writes are validated against them and the custom strategies
+// of the project.
+func (h *AdminAPI) ListStrategies(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]any{"strategies": declarative.BuiltinStrategies()})
+}
+
+// ListStrategyDefinitions lists the strategies the flags of a project can
+// use: the built-in ones followed by the project's custom strategies.
+func (h *AdminAPI) ListStrategyDefinitions(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	defs, err := declarative.ProjectStrategies(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to list strategies")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"strategies": defs})
+}
+
+func (h *AdminAPI) CreateStrategyDefinition(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var body struct {
+		Name        string                      `json:"name"`
+		Description string                      `json:"description"`
+		Parameters  []declarative.StrategyParam `json:"parameters"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	def := declarative.StrategyDefinition{Name: body.Name, Description: body.Description, Parameters: body.Parameters}
+	if fields := declarative.ValidateDefinition(def); len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+
+	d, err := h.ORM.StrategyDefinition.Create().
+		SetName(def.Name).
+		SetNillableDescription(nilIfEmpty(def.Description)).
+		SetParameters(declarative.StrategyParamsToEnt(def.Parameters)).
+		SetProjectID(projectID).
+		Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "A strategy with this name already exists")
+	}
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to create strategy")
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	return ctx.JSON(http.StatusCreated, declarative.CustomStrategy(d))
+}
+
+// UpdateStrategyDefinition changes a custom strategy. Omitted fields are left
+// unchanged; a strategy cannot be renamed while flags use it.
+func (h *AdminAPI) UpdateStrategyDefinition(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	d, err := h.findStrategyDefinition(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Strategy not found")
+	}
+
+	var body struct {
+		Name        *string                      `json:"name"`
+		Description *string                      `json:"description"`
+		Parameters  *[]declarative.StrategyParam `json:"parameters"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	def := declarative.CustomStrategy(d)
+	if body.Name != nil {
+		def.Name = *body.Name
+	}
+	if body.Description != nil {
+		def.Description = *body.Description
+	}
+	if body.Parameters != nil {
+		def.Parameters = *body.Parameters
+	}
+	if fields := declarative.ValidateDefinition(def); len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+
+	if def.Name != d.Name {
+		flags, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+		if err != nil {
+			return jsonError(ctx, http.StatusInternalServerError, "Failed to check strategy usage")
+		}
+		if len(flags) > 0 {
+			return ctx.JSON(http.StatusConflict, map[string]any{
+				"error": "Strategy is used by flags and cannot be renamed",
+				"flags": flags,
+			})
+		}
+	}
+
+	d, err = d.Update().
+		SetName(def.Name).
+		SetNillableDescription(nilIfEmpty(def.Description)).
+		SetParameters(declarative.StrategyParamsToEnt(def.Parameters)).
+		Save(reqCtx)
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "A strategy with this name already exists")
+	}
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to update strategy")
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	return ctx.JSON(http.StatusOK, declarative.CustomStrategy(d))
+}
+
+// DeleteStrategyDefinition deletes a custom strategy that no flag uses,
+// including flags in the trash.
+func (h *AdminAPI) DeleteStrategyDefinition(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	d, err := h.findStrategyDefinition(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Strategy not found")
+	}
+
+	flags, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to check strategy usage")
+	}
+	if len(flags) > 0 {
+		return ctx.JSON(http.StatusConflict, map[string]any{
+			"error": "Strategy is used by flags",
+			"flags": flags,
+		})
+	}
+
+	if err := h.ORM.StrategyDefinition.DeleteOne(d).Exec(reqCtx); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to delete strategy")
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
+}
+
+func (h *AdminAPI) findStrategyDefinition(ctx echo.Context, projectID int) (*ent.StrategyDefinition, error) {
+	id, err := strconv.Atoi(ctx.Param("definitionId"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.StrategyDefinition.Query().
+		Where(strategydefinition.ID(id), strategydefinition.ProjectID(projectID)).
+		Only(ctx.Request().Context())
+}
+
+// ---------------------------------------------------------------------------
+// Context fields
+// ---------------------------------------------------------------------------
+
+// ListContextFields lists the context fields constraints of a project can
+// test: the built-in ones followed by the registered ones, with the operators
+// each field type allows.
+func (h *AdminAPI) ListContextFields(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	fields, err := declarative.ProjectContextFields(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to list context fields")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"context_fields": fields,
+		"operators":      declarative.OperatorsByType(),
+	})
+}
+
+func (h *AdminAPI) CreateContextField(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var body struct {
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Type        string   `json:"type"`
+		LegalValues []string `json:"legal_values"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+	if body.Type == "" {
+		body.Type = declarative.ContextString
+	}
+
+	cf := declarative.ContextField{Name: body.Name, Description: body.Description, Type: body.Type, LegalValues: body.LegalValues}
+	if fields := declarative.ValidateContextField(cf); len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+
+	f, err := h.ORM.ContextField.Create().
+		SetName(cf.Name).
+		SetNillableDescription(nilIfEmpty(cf.Description)).
+		SetType(contextfield.Type(cf.Type)).
+		SetLegalValues(cf.LegalValues).
+		SetProjectID(projectID).
+		Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "A context field with this name already exists")
+	}
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to create context field")
+	}
+
+	return ctx.JSON(http.StatusCreated, declarative.ContextFieldFromEnt(f))
+}
+
+// UpdateContextField changes a registered context field. Omitted fields are
+// left unchanged; a field cannot be renamed or change type while flags test it.
+func (h *AdminAPI) UpdateContextField(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	f, err := h.findContextField(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Context field not found")
+	}
+
+	var body struct {
+		Name        *string   `json:"name"`
+		Description *string   `json:"description"`
+		Type        *string   `json:"type"`
+		LegalValues *[]string `json:"legal_values"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	cf := declarative.ContextFieldFromEnt(f)
+	if body.Name != nil {
+		cf.Name = *body.Name
+	}
+	if body.Description != nil {
+		cf.Description = *body.Description
+	}
+	if body.Type != nil {
+		cf.Type = *body.Type
+	}
+	if body.LegalValues != nil {
+		cf.LegalValues = *body.LegalValues
+	}
+	if fields := declarative.ValidateContextField(cf); len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+
+	if cf.Name != f.Name || cf.Type != string(f.Type) {
+		flags, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, f.Name)
+		if err != nil {
+			return jsonError(ctx, http.StatusInternalServerError, "Failed to check context field usage")
+		}
+		if len(flags) > 0 {
+			return ctx.JSON(http.StatusConflict, map[string]any{
+				"error": "Context field is used by flags; its name and type cannot change",
+				"flags": flags,
+			})
+		}
+	}
+
+	f, err = f.Update().
+		SetName(cf.Name).
+		SetDescription(cf.Description).
+		SetType(contextfield.Type(cf.Type)).
+		SetLegalValues(cf.LegalValues).
+		Save(reqCtx)
+	if ent.IsConstraintError(err) {
+		return jsonError(ctx, http.StatusConflict, "A context field with this name already exists")
+	}
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to update context field")
+	}
+
+	return ctx.JSON(http.StatusOK, declarative.ContextFieldFromEnt(f))
+}
+
+// DeleteContextField deletes a registered context field that no constraint
+// tests, including those of flags in the trash.
+func (h *AdminAPI) DeleteContextField(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	f, err := h.findContextField(ctx, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Context field not found")
+	}
+
+	flags, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, f.Name)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to check context field usage")
+	}
+	if len(flags) > 0 {
+		return ctx.JSON(http.StatusConflict, map[string]any{
+			"error": "Context field is used by flags",
+			"flags": flags,
+		})
+	}
+
+	if err := h.ORM.ContextField.DeleteOne(f).Exec(reqCtx); err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to delete context field")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
+}
+
+func (h *AdminAPI) findContextField(ctx echo.Context, projectID int) (*ent.ContextField, error) {
+	id, err := strconv.Atoi(ctx.Param("fieldId"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.ContextField.Query().
+		Where(contextfield.ID(id), contextfield.ProjectID(projectID)).
+		Only(ctx.Request().Context())
+}
+
+// ---------------------------------------------------------------------------
+// PATCH flag/env
+// ---------------------------------------------------------------------------
+
+// PatchFlagEnv changes the config of a flag in one environment. All changes
+// commit together with the revision bump; an If-Match header holding a stale
+// ETag answers 409 with the current config.
+func (h *AdminAPI) PatchFlagEnv(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	envID, err := strconv.Atoi(ctx.Param("envId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	}
+
+	revision, ok := declarative.ParseIfMatch(ctx.Request().Header.Get("If-Match"))
+	if !ok {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid If-Match header")
+	}
+
+	var body struct {
+		Enabled       *bool                       `json:"enabled"`
+		Strategies    *json.RawMessage            `json:"strategies"`
+		Prerequisites *[]declarative.Prerequisite `json:"prerequisites"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	patch := services.FlagEnvPatch{
+		Enabled:       body.Enabled,
+		Prerequisites: body.Prerequisites,
+		Revision:      revision,
+		Actor:         adminActor(ctx),
+	}
+	if body.Strategies != nil {
+		var strategies []StrategyInput
+		if err := json.Unmarshal(*body.Strategies, &strategies); err != nil {
+			return jsonError(ctx, http.StatusBadRequest, "Invalid strategies format")
+		}
+		patch.Strategies = &strategies
+	}
+
+	reqCtx := ctx.Request().Context()
+	ref := services.FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: envID}
+	fe, err := h.Flags.Patch(reqCtx, ref, patch)
+	if err != nil {
+		return flagServiceError(ctx, h.ORM, err, "Failed to update flag environment")
+	}
+
+	dto, err := flagEnvDTO(reqCtx, h.ORM, fe.ID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to reload")
+	}
+	ctx.Response().Header().Set("ETag", declarative.ETag(fe.Revision))
+	return ctx.JSON(http.StatusOK, dto)
+}
+
+// ---------------------------------------------------------------------------
+// Export / import
+// ---------------------------------------------------------------------------
+
+func (h *AdminAPI) ExportProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	format := ctx.QueryParam("format")
+	if format == "" {
+		format = declarative.FormatJSON
+	}
+	if format != declarative.FormatJSON && format != declarative.FormatYAML {
+		return jsonError(ctx, http.StatusBadRequest, "Format must be json or yaml")
+	}
+
+	doc, err := declarative.Export(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to export project")
+	}
+
+	data, err := declarative.Encode(doc, format)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to encode document")
+	}
+
+	if format == declarative.FormatYAML {
+		return ctx.Blob(http.StatusOK, "application/yaml", data)
+	}
+	return ctx.JSONBlob(http.StatusOK, data)
+}
+
+func (h *AdminAPI) ImportProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	format := ctx.QueryParam("format")
+	if format == "" {
+		format = declarative.FormatFromContentType(ctx.Request().Header.Get(echo.HeaderContentType))
+	}
+
+	data, err := io.ReadAll(ctx.Request().Body)
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Failed to read body")
+	}
 
-	// Verify flag belongs to project.
-	exists, err := h.ORM.Flag.Query().
-		Where(entflag.ID(flagID), entflag.ProjectID(projectID)).
-		Exist(reqCtx)
-	if err != nil || !exists {
-		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	doc, err := declarative.Decode(data, format)
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, err.Error())
 	}
 
-	// Verify env belongs to project.
-	exists, err = h.ORM.Environment.Query().
-		Where(environment.ID(envID), environment.ProjectID(projectID)).
-		Exist(reqCtx)
-	if err != nil || !exists {
-		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	opts := declarative.Options{
+		DryRun: ctx.QueryParam("dry_run") == "true",
+		Prune:  ctx.QueryParam("prune") == "true",
+		Actor:  adminActor(ctx),
 	}
 
-	var body struct {
-		Enabled    *bool           `json:"enabled"`
-		Strategies *json.RawMessage `json:"strategies"`
+	diff, err := h.Flags.Apply(ctx.Request().Context(), projectID, doc, opts)
+	if err != nil {
+		var verr *declarative.ValidationError
+		switch {
+		case errors.As(err, &verr):
+			return jsonValidationError(ctx, verr.Fields)
+		case ent.IsConstraintError(err):
+			return jsonError(ctx, http.StatusConflict, "The document conflicts with existing data")
+		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to apply document")
 	}
-	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
-		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+
+	if !opts.DryRun && !diff.Empty() {
+		h.Hub.NotifyProject(projectID)
 	}
 
-	fe, err := getOrCreateFlagEnvironment(reqCtx, h.ORM, flagID, envID)
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"dry_run": opts.DryRun,
+		"applied": !opts.DryRun,
+		"summary": diff.Summary(),
+		"changes": diff.Changes,
+	})
+}
+
+// PromoteProject copies flag configuration from one environment to another.
+// The body selects the source and target environments, the flags (all when
+// omitted) and whether the enabled state and/or strategies are copied.
+func (h *AdminAPI) PromoteProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
 	if err != nil {
-		return jsonError(ctx, http.StatusInternalServerError, "Failed to get flag environment")
+		return nil
 	}
 
-	// Toggle enabled state.
-	if body.Enabled != nil {
-		fe, err = fe.Update().SetEnabled(*body.Enabled).Save(reqCtx)
-		if err != nil {
-			return jsonError(ctx, http.StatusInternalServerError, "Failed to update enabled state")
-		}
+	var opts declarative.PromoteOptions
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&opts); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+	if ctx.QueryParam("dry_run") == "true" {
+		opts.DryRun = true
 	}
+	opts.Actor = adminActor(ctx)
 
-	// Replace strategies if provided.
-	if body.Strategies != nil {
-		var strategyInputs []StrategyInput
-		if err := json.Unmarshal(*body.Strategies, &strategyInputs); err != nil {
-			return jsonError(ctx, http.StatusBadRequest, "Invalid strategies format")
+	return promote(ctx, h.Flags, projectID, opts)
+}
+
+// promote runs a promotion through the flag service, which notifies the
+// target's streams, and writes the resulting diff. Shared by the admin API and
+// the flag edit page.
+func promote(ctx echo.Context, flags *services.FlagService, projectID int, opts declarative.PromoteOptions) error {
+	diff, err := flags.Promote(ctx.Request().Context(), projectID, opts)
+	if err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
 		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to promote")
+	}
 
-		tx, err := h.ORM.Tx(reqCtx)
-		if err != nil {
-			return jsonError(ctx, http.StatusInternalServerError, "Failed to start transaction")
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"dry_run": opts.DryRun,
+		"applied": !opts.DryRun,
+		"summary": diff.Summary(),
+		"changes": diff.Changes,
+	})
+}
+
+// CompareProject compares the flag configuration of the environments named by
+// the repeated env query parameter.
+func (h *AdminAPI) CompareProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	c, err := declarative.Compare(ctx.Request().Context(), h.ORM, projectID, ctx.QueryParams()["env"])
+	if err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
 		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to compare environments")
+	}
+
+	return ctx.JSON(http.StatusOK, c)
+}
+
+// ProjectDrift reports the differences between each environment and the one
+// it is expected to match.
+func (h *AdminAPI) ProjectDrift(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	drift, err := declarative.DriftReport(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to compute drift")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"drift": drift})
+}
+
+// EvaluateRequest is the body of the evaluate endpoints: the environments to
+// evaluate in, by name (all when empty), and the SDK context.
+type EvaluateRequest struct {
+	Environments []string                `json:"environments"`
+	Context      declarative.EvalContext `json:"context"`
+}
+
+// EvaluateProject evaluates every flag of the project for a context and
+// explains each result.
+func (h *AdminAPI) EvaluateProject(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	var req EvaluateRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	return evaluate(ctx, h.ORM, projectID, req)
+}
 
-		// Delete old constraints then strategies.
-		oldStrategies, _ := tx.Strategy.Query().
-			Where(strategy.FlagEnvironmentID(fe.ID)).
-			IDs(reqCtx)
-		if len(oldStrategies) > 0 {
-			_, _ = tx.Constraint.Delete().
-				Where(entconstraint.StrategyIDIn(oldStrategies...)).
-				Exec(reqCtx)
-			_, _ = tx.Strategy.Delete().
-				Where(strategy.FlagEnvironmentID(fe.ID)).
-				Exec(reqCtx)
+// evaluate runs an evaluation and writes the traces. Shared by the admin API
+// and the playground page.
+func evaluate(ctx echo.Context, orm *ent.Client, projectID int, req EvaluateRequest) error {
+	results, err := declarative.Evaluate(ctx.Request().Context(), orm, projectID, req.Environments, req.Context)
+	if err != nil {
+		var verr *declarative.ValidationError
+		if errors.As(err, &verr) {
+			return jsonValidationError(ctx, verr.Fields)
 		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to evaluate flags")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"context": req.Context, "environments": results})
+}
+
+// ---------------------------------------------------------------------------
+// Version history
+// ---------------------------------------------------------------------------
+
+// flagEnvParams reads the flag and environment IDs of a flag/env route.
+func flagEnvParams(ctx echo.Context) (flagID, envID int, ok bool) {
+	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	if err != nil {
+		return 0, 0, false
+	}
+	envID, err = strconv.Atoi(ctx.Param("envId"))
+	if err != nil {
+		return 0, 0, false
+	}
+	return flagID, envID, true
+}
+
+// ListVersions lists the recorded configs of a flag in one environment,
+// newest first.
+func (h *AdminAPI) ListVersions(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, envID, ok := flagEnvParams(ctx)
+	if !ok {
+		return jsonError(ctx, http.StatusNotFound, "Flag or environment not found")
+	}
+	return listVersions(ctx, h.ORM, projectID, flagID, envID)
+}
+
+// DiffVersions lists the settings that differ between the versions given by
+// the from and to query parameters.
+func (h *AdminAPI) DiffVersions(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, envID, ok := flagEnvParams(ctx)
+	if !ok {
+		return jsonError(ctx, http.StatusNotFound, "Flag or environment not found")
+	}
+	return diffVersions(ctx, h.ORM, projectID, flagID, envID)
+}
 
-		// Recreate from input.
-		for i, si := range strategyInputs {
-			s, err := tx.Strategy.Create().
-				SetName(si.Name).
-				SetParameters(si.Parameters).
-				SetSortOrder(i).
-				SetFlagEnvironmentID(fe.ID).
-				Save(reqCtx)
-			if err != nil {
-				tx.Rollback()
-				return jsonError(ctx, http.StatusInternalServerError, "Failed to create strategy")
-			}
-			for _, ci := range si.Constraints {
-				_, err := tx.Constraint.Create().
-					SetContextName(ci.ContextName).
-					SetOperator(entconstraint.Operator(ci.Operator)).
-					SetValues(ci.Values).
-					SetInverted(ci.Inverted).
-					SetCaseInsensitive(ci.CaseInsensitive).
-					SetStrategyID(s.ID).
-					Save(reqCtx)
-				if err != nil {
-					tx.Rollback()
-					return jsonError(ctx, http.StatusInternalServerError, "Failed to create constraint")
-				}
-			}
+// RestoreVersion reverts a flag in one environment to a recorded version.
+func (h *AdminAPI) RestoreVersion(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, envID, ok := flagEnvParams(ctx)
+	if !ok {
+		return jsonError(ctx, http.StatusNotFound, "Flag or environment not found")
+	}
+	version, err := strconv.Atoi(ctx.Param("version"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Version not found")
+	}
+	revision, ok := declarative.ParseIfMatch(ctx.Request().Header.Get("If-Match"))
+	if !ok {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid If-Match header")
+	}
+	return restoreVersion(ctx, h.ORM, h.Flags, projectID, flagID, envID, version, revision, adminActor(ctx))
+}
+
+// listVersions writes the versions of a flag environment. Shared by the admin
+// API and the flag edit page.
+func listVersions(ctx echo.Context, orm *ent.Client, projectID, flagID, envID int) error {
+	versions, err := declarative.Versions(ctx.Request().Context(), orm, projectID, flagID, envID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load versions")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"versions": versions})
+}
+
+// diffVersions writes the changes between the from and to versions of a flag
+// environment. Shared by the admin API and the flag edit page.
+func diffVersions(ctx echo.Context, orm *ent.Client, projectID, flagID, envID int) error {
+	fields := map[string]string{}
+	from, err := strconv.Atoi(ctx.QueryParam("from"))
+	if err != nil {
+		fields["from"] = "must be a version number"
+	}
+	to, err := strconv.Atoi(ctx.QueryParam("to"))
+	if err != nil {
+		fields["to"] = "must be a version number"
+	}
+	if len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+
+	changes, err := declarative.DiffVersions(ctx.Request().Context(), orm, projectID, flagID, envID, from, to)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return jsonError(ctx, http.StatusNotFound, "Version not found")
 		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to diff versions")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"from":    from,
+		"to":      to,
+		"changes": changes,
+	})
+}
 
-		if err := tx.Commit(); err != nil {
-			return jsonError(ctx, http.StatusInternalServerError, "Failed to commit")
+// restoreVersion reverts a flag environment to a version through the flag
+// service, which notifies its streams. A non-zero revision must be the current
+// one. Shared by the admin API and the flag edit page.
+func restoreVersion(ctx echo.Context, orm *ent.Client, flags *services.FlagService, projectID, flagID, envID, version, revision int, actor string) error {
+	reqCtx := ctx.Request().Context()
+	ref := services.FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: envID}
+	diff, err := flags.RestoreVersion(reqCtx, ref, version, revision, actor)
+	if err != nil {
+		var verr *declarative.ValidationError
+		var conflict *declarative.ConflictError
+		switch {
+		case errors.As(err, &conflict):
+			return revisionConflict(ctx, orm, conflict.FlagEnvironmentID)
+		case errors.As(err, &verr):
+			return jsonValidationError(ctx, verr.Fields)
+		case ent.IsNotFound(err):
+			return jsonError(ctx, http.StatusNotFound, "Version not found")
 		}
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to restore version")
 	}
 
-	// Reload to return fresh state.
-	fe, err = h.ORM.FlagEnvironment.Query().
-		Where(flagenvironment.ID(fe.ID)).
-		WithStrategies(func(sq *ent.StrategyQuery) {
-			sq.WithConstraints()
-			sq.Order(ent.Asc(strategy.FieldSortOrder))
-		}).
+	fe, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(flagID), flagenvironment.EnvironmentID(envID)).
 		Only(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to reload")
 	}
+	ctx.Response().Header().Set("ETag", declarative.ETag(fe.Revision))
 
-	strategies := make([]map[string]any, 0, len(fe.Edges.Strategies))
-	for _, s := range fe.Edges.Strategies {
-		constraints := make([]map[string]any, 0, len(s.Edges.Constraints))
-		for _, c := range s.Edges.Constraints {
-			constraints = append(constraints, map[string]any{
-				"id":               c.ID,
-				"context_name":     c.ContextName,
-				"operator":         string(c.Operator),
-				"values":           c.Values,
-				"inverted":         c.Inverted,
-				"case_insensitive": c.CaseInsensitive,
-			})
-		}
-		strategies = append(strategies, map[string]any{
-			"id":          s.ID,
-			"name":        s.Name,
-			"parameters":  s.Parameters,
-			"sort_order":  s.SortOrder,
-			"constraints": constraints,
-		})
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"restored": version,
+		"revision": fe.Revision,
+		"summary":  diff.Summary(),
+		"changes":  diff.Changes,
+	})
+}
+
+// ---------------------------------------------------------------------------
+// Rollout plans
+// ---------------------------------------------------------------------------
+
+// ListRollouts lists the rollout plans of a flag, newest first.
+func (h *AdminAPI) ListRollouts(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
 	}
 
-	if env, err := h.ORM.Environment.Get(reqCtx, envID); err == nil {
-		h.Hub.Notify(projectID, env.Name)
+	plans, err := h.Rollouts.List(ctx.Request().Context(), projectID, flagID)
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "Failed to load rollout plans")
+	}
+	out := make([]map[string]any, 0, len(plans))
+	for _, p := range plans {
+		out = append(out, rolloutPlanDTO(p))
 	}
+	return ctx.JSON(http.StatusOK, map[string]any{"plans": out})
+}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"environment_id": fe.EnvironmentID,
-		"flag_id":        fe.FlagID,
-		"enabled":        fe.Enabled,
-		"strategies":     strategies,
+// CreateRollout starts a rollout plan for a gradualRollout strategy of the
+// flag and applies its first step.
+func (h *AdminAPI) CreateRollout(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	var body rolloutRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+
+	p, err := h.Rollouts.Create(ctx.Request().Context(), projectID, flagID, body.StrategyID, body.Steps, adminActor(ctx))
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "Failed to start rollout plan")
+	}
+	return ctx.JSON(http.StatusCreated, rolloutPlanDTO(p))
+}
+
+// GetRollout returns a rollout plan of the flag with its progress.
+func (h *AdminAPI) GetRollout(ctx echo.Context) error {
+	return h.rolloutAction(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Get(ctx.Request().Context(), projectID, flagID, planID)
+	})
+}
+
+// PauseRollout stops a running plan at its current step.
+func (h *AdminAPI) PauseRollout(ctx echo.Context) error {
+	return h.rolloutAction(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Pause(ctx.Request().Context(), projectID, flagID, planID, adminActor(ctx))
 	})
 }
 
+// ResumeRollout continues a paused plan.
+func (h *AdminAPI) ResumeRollout(ctx echo.Context) error {
+	return h.rolloutAction(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Resume(ctx.Request().Context(), projectID, flagID, planID)
+	})
+}
+
+// AbortRollout stops a plan for good, leaving the rollout where it is.
+func (h *AdminAPI) AbortRollout(ctx echo.Context) error {
+	return h.rolloutAction(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Abort(ctx.Request().Context(), projectID, flagID, planID, adminActor(ctx))
+	})
+}
+
+// rolloutAction runs fn on the plan in the path and writes the plan.
+func (h *AdminAPI) rolloutAction(ctx echo.Context, fn func(projectID, flagID, planID int) (*ent.RolloutPlan, error)) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+	flagID, err1 := strconv.Atoi(ctx.Param("flagId"))
+	planID, err2 := strconv.Atoi(ctx.Param("planId"))
+	if err1 != nil || err2 != nil {
+		return jsonError(ctx, http.StatusNotFound, "Rollout plan not found")
+	}
+
+	p, err := fn(projectID, flagID, planID)
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "Failed to update rollout plan")
+	}
+	return ctx.JSON(http.StatusOK, rolloutPlanDTO(p))
+}
+
+// ---------------------------------------------------------------------------
+// Trash
+// ---------------------------------------------------------------------------
+
+// ListTrash lists the flags and environments of the project in the trash,
+// most recently deleted first.
+func (h *AdminAPI) ListTrash(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	items, err := h.Trash.List(ctx.Request().Context(), projectID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load trash")
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"items": items})
+}
+
+// RestoreFlag takes a flag of the project out of the trash.
+func (h *AdminAPI) RestoreFlag(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	flagID, err := strconv.Atoi(ctx.Param("flagId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+	exists, err := h.ORM.Flag.Query().
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtNotNil()).
+		Exist(reqCtx)
+	if err != nil || !exists {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found in the trash")
+	}
+
+	if tokenFrozen(ctx, h.ORM, projectID, projectEnvironmentIDs(ctx, h.ORM, projectID)...) {
+		return nil
+	}
+
+	if err := h.Trash.Restore(reqCtx, services.TrashFlag, flagID); err != nil {
+		return restoreError(ctx, err)
+	}
+	h.Hub.NotifyProject(projectID)
+
+	return h.flagResponse(ctx, http.StatusOK, flagID)
+}
+
+// RestoreEnvironment takes an environment of the project out of the trash,
+// with its flag configs.
+func (h *AdminAPI) RestoreEnvironment(ctx echo.Context) error {
+	projectID, err := h.requireProjectAccess(ctx)
+	if err != nil {
+		return nil
+	}
+
+	envID, err := strconv.Atoi(ctx.Param("envId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+	exists, err := h.ORM.Environment.Query().
+		Where(environment.ID(envID), environment.ProjectID(projectID), environment.DeletedAtNotNil()).
+		Exist(reqCtx)
+	if err != nil || !exists {
+		return jsonError(ctx, http.StatusNotFound, "Environment not found in the trash")
+	}
+
+	if tokenFrozen(ctx, h.ORM, projectID, envID) {
+		return nil
+	}
+
+	if err := h.Trash.Restore(reqCtx, services.TrashEnvironment, envID); err != nil {
+		return restoreError(ctx, err)
+	}
+
+	e, err := h.ORM.Environment.Get(reqCtx, envID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load environment")
+	}
+	h.Hub.Notify(projectID, e.Name)
+
+	return ctx.JSON(http.StatusOK, envDTO(e))
+}
+
+// restoreError maps a failed restore to its response: 410 once the retention
+// window has passed and 409 when something it depends on is still in the
+// trash.
+func restoreError(ctx echo.Context, err error) error {
+	var conflict *services.RestoreConflictError
+	switch {
+	case errors.Is(err, services.ErrTrashExpired):
+		return jsonError(ctx, http.StatusGone, "Retention window has passed; the item is awaiting purge")
+	case errors.As(err, &conflict):
+		return jsonError(ctx, http.StatusConflict, "Cannot restore: "+conflict.Reason)
+	case ent.IsNotFound(err):
+		return jsonError(ctx, http.StatusNotFound, "Not found in the trash")
+	}
+	log.Ctx(ctx).Error("restore failed", "error", err)
+	return jsonError(ctx, http.StatusInternalServerError, "Failed to restore")
+}
+
 // ---------------------------------------------------------------------------
 // Tokens
 // ---------------------------------------------------------------------------
@@ -832,14 +2104,29 @@ func (h *AdminAPI) ListTokens(ctx echo.Context) error {
 	tok := adminTokenFromContext(ctx)
 	reqCtx := ctx.Request().Context()
 
-	tokens, err := h.ORM.ApiToken.Query().
+	page, err := listParams(ctx, tokenSorts, "created_at")
+	if err != nil {
+		return nil
+	}
+
+	query := h.ORM.ApiToken.Query().
 		Where(apitoken.ProjectID(tok.ProjectID)).
-		Order(apitoken.ByCreatedAt()).
+		Where(predicate.ApiToken(page.Search(apitoken.FieldName)))
+	total, err := query.Clone().Count(reqCtx)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load tokens")
+	}
+
+	tokens, err := query.
+		Where(predicate.ApiToken(page.After())).
+		Order(apitoken.OrderOption(page.Order())).
+		Limit(page.Fetch()).
 		All(reqCtx)
 	if err != nil {
 		return jsonError(ctx, http.StatusInternalServerError, "Failed to load tokens")
 	}
 
+	tokens, next := pager.Trim(page, tokens, func(t *ent.ApiToken) int { return t.ID })
 	items := make([]map[string]any, 0, len(tokens))
 	for _, t := range tokens {
 		items = append(items, map[string]any{
@@ -851,7 +2138,7 @@ func (h *AdminAPI) ListTokens(ctx echo.Context) error {
 		})
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"tokens": items})
+	return ctx.JSON(http.StatusOK, pager.NewPage(items, next, total))
 }
 
 func (h *AdminAPI) CreateToken(ctx echo.Context) error {
@@ -888,6 +2175,7 @@ func (h *AdminAPI) CreateToken(ctx echo.Context) error {
 			Where(
 				environment.Name(body.Environment),
 				environment.ProjectID(tok.ProjectID),
+				environment.DeletedAtIsNil(),
 			).Exist(reqCtx)
 		if err != nil || !exists {
 			return jsonValidationError(ctx, map[string]string{
@@ -9,7 +9,15 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/services"
 	"github.com/felipekafuri/bandeira/pkg/token"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -149,7 +157,7 @@ func TestAdminAPI_Projects_List(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	body := parseJSON(t, resp)
-	projects, ok := body["projects"].([]any)
+	projects, ok := body["items"].([]any)
 	require.True(t, ok)
 	assert.Len(t, projects, 1)
 
@@ -226,7 +234,7 @@ func TestAdminAPI_Environments_List(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	body := parseJSON(t, resp)
-	envs := body["environments"].([]any)
+	envs := body["items"].([]any)
 	assert.GreaterOrEqual(t, len(envs), 1)
 }
 
@@ -316,10 +324,75 @@ func TestAdminAPI_Flags_List(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	body := parseJSON(t, resp)
-	flags := body["flags"].([]any)
+	flags := body["items"].([]any)
 	assert.GreaterOrEqual(t, len(flags), 1)
 }
 
+func TestAdminAPI_Flags_Pagination(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	path := fmt.Sprintf("/api/admin/projects/%d/flags", fix.projectID)
+
+	// Flags created in quick succession may share created_at, which the ID
+	// tie-break keeps in creation order.
+	for _, name := range []string{"delta", "alpha", "echo", "charlie", "bravo"} {
+		c.ORM.Flag.Create().
+			SetName(name).
+			SetFlagType("release").
+			SetProjectID(fix.projectID).
+			SaveX(ctx)
+	}
+
+	walk := func(query string) (names []string, totals []float64) {
+		t.Helper()
+		next := ""
+		for {
+			q := path + query
+			if next != "" {
+				q += "&cursor=" + next
+			}
+			resp := adminRequest(t, "GET", q, nil, fix.rawToken)
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			body := parseJSON(t, resp)
+			for _, f := range body["items"].([]any) {
+				names = append(names, f.(map[string]any)["name"].(string))
+			}
+			totals = append(totals, body["total"].(float64))
+			if body["next_cursor"] == nil {
+				return names, totals
+			}
+			next = body["next_cursor"].(string)
+		}
+	}
+
+	names, totals := walk("?limit=2")
+	assert.Equal(t, []string{"alpha", "bravo", "charlie", "delta", "echo"}, names)
+	assert.Equal(t, []float64{5, 5, 5}, totals)
+
+	names, _ = walk("?limit=2&sort=-name")
+	assert.Equal(t, []string{"echo", "delta", "charlie", "bravo", "alpha"}, names)
+
+	names, _ = walk("?limit=1&sort=created_at")
+	assert.Equal(t, []string{"delta", "alpha", "echo", "charlie", "bravo"}, names)
+
+	names, totals = walk("?limit=1&q=HA")
+	assert.Equal(t, []string{"alpha", "charlie"}, names)
+	assert.Equal(t, []float64{2, 2}, totals)
+
+	// A cursor only works with the sort it was issued for.
+	resp := adminRequest(t, "GET", path+"?limit=1", nil, fix.rawToken)
+	cursor := parseJSON(t, resp)["next_cursor"].(string)
+	resp = adminRequest(t, "GET", path+"?sort=-name&cursor="+cursor, nil, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Contains(t, parseJSON(t, resp)["fields"], "cursor")
+
+	for _, q := range []string{"?limit=0", "?limit=1000", "?sort=secret", "?cursor=!!"} {
+		resp = adminRequest(t, "GET", path+q, nil, fix.rawToken)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, q)
+		resp.Body.Close()
+	}
+}
+
 func TestAdminAPI_Flags_Create(t *testing.T) {
 	fix := setupAdminFixture(t)
 	path := fmt.Sprintf("/api/admin/projects/%d/flags", fix.projectID)
@@ -584,6 +657,413 @@ func TestAdminAPI_PatchFlagEnv_WrongProject(t *testing.T) {
 	resp.Body.Close()
 }
 
+func TestAdminAPI_PatchFlagEnv_IfMatch(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	flag, err := c.ORM.Flag.Create().
+		SetName("guarded-flag").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(gocontext.Background())
+	require.NoError(t, err)
+
+	env, err := c.ORM.Environment.Create().
+		SetName("dev-guarded").
+		SetType("development").
+		SetProjectID(fix.projectID).
+		Save(gocontext.Background())
+	require.NoError(t, err)
+
+	path := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flag.ID, env.ID)
+	patch := func(ifMatch string, body map[string]any) *http.Response {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		req, err := http.NewRequest("PATCH", srv.URL+path, bytes.NewReader(b))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+fix.rawToken)
+		req.Header.Set("If-Match", ifMatch)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Without If-Match the change is unconditional.
+	resp := adminRequest(t, "PATCH", path, map[string]any{"enabled": true}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	etag := resp.Header.Get("ETag")
+	body := parseJSON(t, resp)
+	rev := int(body["revision"].(float64))
+	assert.Equal(t, fmt.Sprintf("%q", fmt.Sprint(rev)), etag)
+
+	// GET reports the same revision.
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/flags/%d", fix.projectID, flag.ID), nil, fix.rawToken)
+	detail := parseJSON(t, resp)
+	envs := detail["environments"].([]any)
+	require.Len(t, envs, 1)
+	assert.Equal(t, float64(rev), envs[0].(map[string]any)["revision"])
+
+	// A change based on the current revision goes through and bumps it.
+	resp = patch(etag, map[string]any{"enabled": false})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body = parseJSON(t, resp)
+	assert.Equal(t, float64(rev+1), body["revision"])
+	assert.Equal(t, false, body["enabled"])
+
+	// Replaying it with the old revision is a conflict and changes nothing.
+	resp = patch(etag, map[string]any{"strategies": []map[string]any{{"name": "default"}}})
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, fmt.Sprintf("%q", fmt.Sprint(rev+1)), resp.Header.Get("ETag"))
+	body = parseJSON(t, resp)
+	current := body["current"].(map[string]any)
+	assert.Equal(t, float64(rev+1), current["revision"])
+	assert.Equal(t, false, current["enabled"])
+	assert.Empty(t, current["strategies"])
+
+	// Weak tags are accepted; malformed ones are not.
+	resp = patch(fmt.Sprintf(`W/"%d"`, rev+1), map[string]any{"enabled": true})
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = patch("latest", map[string]any{"enabled": true})
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_BulkFlags(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	var flags []*ent.Flag
+	for _, name := range []string{"bulk-a", "bulk-b", "bulk-c"} {
+		f, err := c.ORM.Flag.Create().SetName(name).SetFlagType("release").SetProjectID(fix.projectID).Save(ctx)
+		require.NoError(t, err)
+		flags = append(flags, f)
+	}
+	var envs []*ent.Environment
+	for _, name := range []string{"bulk-dev", "bulk-prod"} {
+		e, err := c.ORM.Environment.Create().SetName(name).SetType("development").SetProjectID(fix.projectID).Save(ctx)
+		require.NoError(t, err)
+		envs = append(envs, e)
+	}
+
+	// bulk-c requires bulk-a in bulk-dev, so bulk-a cannot be deleted alone.
+	resp := adminRequest(t, "PATCH", fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flags[2].ID, envs[0].ID),
+		map[string]any{"prerequisites": []map[string]any{{"flag": "bulk-a"}}}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	path := fmt.Sprintf("/api/admin/projects/%d/flags/bulk", fix.projectID)
+	enabled := func(flagID, envID int) bool {
+		fe, err := c.ORM.FlagEnvironment.Query().
+			Where(flagenvironment.FlagID(flagID), flagenvironment.EnvironmentID(envID)).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			return false
+		}
+		require.NoError(t, err)
+		return fe.Enabled
+	}
+
+	t.Run("atomic", func(t *testing.T) {
+		resp := adminRequest(t, "POST", path, map[string]any{
+			"operations": []map[string]any{
+				{"op": "toggle", "flag_ids": []int{flags[0].ID, flags[1].ID}, "environment_ids": []int{envs[0].ID, envs[1].ID}, "enabled": true},
+				{"op": "set_strategies", "flag_ids": []int{flags[1].ID}, "environment_ids": []int{envs[1].ID}, "strategies": []map[string]any{
+					{"name": "gradualRollout", "parameters": map[string]any{"rollout": 10}},
+				}},
+				{"op": "tag", "flag_ids": []int{flags[0].ID, flags[1].ID}, "add_tags": []string{"Incident-42"}},
+			},
+		}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := parseJSON(t, resp)
+		assert.Equal(t, float64(7), body["succeeded"])
+		assert.Equal(t, float64(0), body["failed"])
+
+		for _, f := range flags[:2] {
+			for _, e := range envs {
+				assert.True(t, enabled(f.ID, e.ID), "%s in %s", f.Name, e.Name)
+			}
+			tags, err := c.ORM.Flag.QueryTags(f).All(ctx)
+			require.NoError(t, err)
+			require.Len(t, tags, 1)
+			assert.Equal(t, "incident-42", tags[0].Name)
+		}
+		n, err := c.ORM.Strategy.Query().
+			Where(strategy.HasFlagEnvironmentWith(flagenvironment.FlagID(flags[1].ID), flagenvironment.EnvironmentID(envs[1].ID))).
+			Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+
+		// Each change is recorded in the history.
+		versions, err := declarative.Versions(ctx, c.ORM, fix.projectID, flags[0].ID, envs[0].ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, versions)
+		assert.Equal(t, "token:admin-token", versions[0].Actor)
+	})
+
+	t.Run("failure rolls everything back", func(t *testing.T) {
+		resp := adminRequest(t, "POST", path, map[string]any{
+			"operations": []map[string]any{
+				{"op": "toggle", "flag_ids": []int{flags[1].ID}, "environment_ids": []int{envs[0].ID}, "enabled": false},
+				{"op": "delete", "flag_ids": []int{flags[0].ID}},
+			},
+		}, fix.rawToken)
+		require.Equal(t, http.StatusConflict, resp.StatusCode)
+		body := parseJSON(t, resp)
+		results := body["results"].([]any)
+		require.Len(t, results, 2)
+		last := results[1].(map[string]any)
+		assert.Equal(t, false, last["ok"])
+		assert.Contains(t, last["error"], "bulk-c")
+
+		assert.True(t, enabled(flags[1].ID, envs[0].ID), "the toggle was rolled back")
+		f, err := c.ORM.Flag.Get(ctx, flags[0].ID)
+		require.NoError(t, err)
+		assert.Nil(t, f.DeletedAt)
+	})
+
+	t.Run("continue on error", func(t *testing.T) {
+		resp := adminRequest(t, "POST", path, map[string]any{
+			"continue_on_error": true,
+			"operations": []map[string]any{
+				{"op": "toggle", "flag_ids": []int{flags[1].ID}, "environment_ids": []int{envs[0].ID}, "enabled": false},
+				{"op": "delete", "flag_ids": []int{flags[0].ID}},
+			},
+		}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := parseJSON(t, resp)
+		assert.Equal(t, float64(1), body["succeeded"])
+		assert.Equal(t, float64(1), body["failed"])
+		assert.False(t, enabled(flags[1].ID, envs[0].ID))
+	})
+
+	t.Run("deleting a flag with its dependents", func(t *testing.T) {
+		resp := adminRequest(t, "POST", path, map[string]any{
+			"operations": []map[string]any{
+				{"op": "delete", "flag_ids": []int{flags[2].ID, flags[0].ID}},
+			},
+		}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		n, err := c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID), entflag.DeletedAtIsNil()).Count(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		resp := adminRequest(t, "POST", path, map[string]any{
+			"operations": []map[string]any{
+				{"op": "rename", "flag_ids": []int{flags[1].ID}},
+				{"op": "toggle", "flag_ids": []int{flags[0].ID}, "environment_ids": []int{envs[0].ID}},
+				{"op": "set_strategies", "flag_ids": []int{flags[1].ID}, "environment_ids": []int{envs[0].ID}, "strategies": []map[string]any{{"name": "nope"}}},
+			},
+		}, fix.rawToken)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		fields := parseJSON(t, resp)["fields"].(map[string]any)
+		assert.Contains(t, fields, "operations[0].op")
+		assert.Contains(t, fields, "operations[1].enabled")
+		assert.Contains(t, fields, "operations[1].flag_ids[0]", "trashed flags are not found")
+		assert.Contains(t, fields, "operations[2].strategies[0].name")
+	})
+}
+
+// ---------------------------------------------------------------------------
+// Export / import
+// ---------------------------------------------------------------------------
+
+func importDocument(fix adminFixture, envName string) map[string]any {
+	return map[string]any{
+		"version": 1,
+		"project": map[string]any{"name": fmt.Sprintf("admin-test-import-%d", fix.projectID)},
+		"environments": []map[string]any{
+			{"name": envName, "type": "development", "sort_order": 0},
+		},
+		"flags": []map[string]any{
+			{
+				"name":      "imported-flag",
+				"flag_type": "release",
+				"environments": []map[string]any{
+					{
+						"environment": envName,
+						"enabled":     true,
+						"strategies": []map[string]any{
+							{
+								"name":       "gradualRollout",
+								"parameters": map[string]any{"rollout": 50},
+								"constraints": []map[string]any{
+									{"context_name": "region", "operator": "IN", "values": []string{"eu"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestAdminAPI_Import_DryRun(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	path := fmt.Sprintf("/api/admin/projects/%d/import?dry_run=true", fix.projectID)
+	resp := adminRequest(t, "POST", path, importDocument(fix, "dev-dry"), fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body := parseJSON(t, resp)
+	assert.Equal(t, true, body["dry_run"])
+	summary := body["summary"].(map[string]any)
+	// Project rename, environment, flag and flag environment.
+	assert.Equal(t, float64(3), summary["create"])
+	assert.Equal(t, float64(1), summary["update"])
+
+	count, err := c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID)).Count(gocontext.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestAdminAPI_Import_ApplyAndExport(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	doc := importDocument(fix, "dev-apply")
+	path := fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID)
+	resp := adminRequest(t, "POST", path, doc, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body := parseJSON(t, resp)
+	assert.Equal(t, true, body["applied"])
+
+	// Re-applying the same document is a no-op.
+	resp = adminRequest(t, "POST", path, doc, fix.rawToken)
+	body = parseJSON(t, resp)
+	assert.Empty(t, body["changes"])
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	exported := parseJSON(t, resp)
+	flags := exported["flags"].([]any)
+	require.Len(t, flags, 1)
+	fes := flags[0].(map[string]any)["environments"].([]any)
+	require.Len(t, fes, 1)
+	fe := fes[0].(map[string]any)
+	assert.Equal(t, "dev-apply", fe["environment"])
+	assert.Equal(t, true, fe["enabled"])
+	assert.Len(t, fe["strategies"], 1)
+}
+
+func TestAdminAPI_Import_Prune(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	_, err := c.ORM.Flag.Create().
+		SetName("stale-flag").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	path := fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID)
+	resp := adminRequest(t, "POST", path, importDocument(fix, "dev-prune"), fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	exists, err := c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID), entflag.Name("stale-flag")).Exist(ctx)
+	require.NoError(t, err)
+	assert.True(t, exists, "flags are kept without prune")
+
+	resp = adminRequest(t, "POST", path+"?prune=true", importDocument(fix, "dev-prune"), fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	body := parseJSON(t, resp)
+	assert.Equal(t, float64(1), body["summary"].(map[string]any)["delete"])
+
+	exists, err = c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID), entflag.Name("stale-flag"), entflag.DeletedAtIsNil()).Exist(ctx)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	trashed, err := c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID), entflag.Name("stale-flag"), entflag.DeletedAtNotNil()).Exist(ctx)
+	require.NoError(t, err)
+	assert.True(t, trashed, "pruned flags go to the trash")
+}
+
+func TestAdminAPI_Import_YAML(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	doc := fmt.Sprintf(`version: 1
+project:
+  name: admin-test-import-yaml-%d
+environments:
+  - name: dev-yaml
+    type: development
+    sort_order: 0
+flags:
+  - name: yaml-flag
+    flag_type: release
+    environments:
+      - environment: dev-yaml
+        enabled: true
+        strategies:
+          - name: default
+            constraints: []
+`, fix.projectID)
+
+	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/admin/projects/%d/import", srv.URL, fix.projectID), bytes.NewBufferString(doc))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/yaml")
+	req.Header.Set("Authorization", "Bearer "+fix.rawToken)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export?format=yaml", fix.projectID), nil, fix.rawToken)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Content-Type"), "application/yaml")
+	out, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(out), "name: yaml-flag")
+}
+
+func TestAdminAPI_Import_Validation(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	doc := importDocument(fix, "dev-invalid")
+	doc["flags"].([]map[string]any)[0]["environments"].([]map[string]any)[0]["environment"] = "missing"
+
+	resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID), doc, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	body := parseJSON(t, resp)
+	fields := body["fields"].(map[string]any)
+	assert.Contains(t, fields, "flags[0].environments[0].environment")
+}
+
+func TestAdminAPI_Import_ProjectNameTaken(t *testing.T) {
+	fix := setupAdminFixture(t)
+	other := setupAdminFixtureNamed(t, "other")
+	otherProject, err := c.ORM.Project.Get(gocontext.Background(), other.projectID)
+	require.NoError(t, err)
+
+	doc := importDocument(fix, "dev")
+	doc["project"] = map[string]any{"name": otherProject.Name}
+
+	for _, query := range []string{"?dry_run=true", ""} {
+		resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import%s", fix.projectID, query), doc, fix.rawToken)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, query)
+		body := parseJSON(t, resp)
+		fields := body["fields"].(map[string]any)
+		assert.Contains(t, fields, "project.name", query)
+	}
+}
+
+func TestAdminAPI_Import_WrongProject(t *testing.T) {
+	fix := setupAdminFixture(t)
+	other := setupAdminFixtureNamed(t, "other")
+
+	resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import", other.projectID), importDocument(fix, "dev"), fix.rawToken)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	resp.Body.Close()
+}
+
 // ---------------------------------------------------------------------------
 // Tokens
 // ---------------------------------------------------------------------------
@@ -595,7 +1075,7 @@ func TestAdminAPI_Tokens_List(t *testing.T) {
 	assert.Equal(t, http.StatusOK, resp.StatusCode)
 
 	body := parseJSON(t, resp)
-	tokens := body["tokens"].([]any)
+	tokens := body["items"].([]any)
 	assert.GreaterOrEqual(t, len(tokens), 1) // At least the admin token itself.
 }
 
@@ -681,3 +1161,1257 @@ func TestAdminAPI_CrossProjectIsolation(t *testing.T) {
 	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
 	resp.Body.Close()
 }
+
+// ---------------------------------------------------------------------------
+// Promote
+// ---------------------------------------------------------------------------
+
+// setupPromoteFixture imports a project with "staging-<id>" and "prod-<id>"
+// environments and one flag configured only in staging.
+func setupPromoteFixture(t *testing.T) (fix adminFixture, staging, prod string) {
+	t.Helper()
+	fix = setupAdminFixture(t)
+	staging = fmt.Sprintf("staging-%d", fix.projectID)
+	prod = fmt.Sprintf("prod-%d", fix.projectID)
+
+	doc := importDocument(fix, staging)
+	doc["environments"] = []map[string]any{
+		{"name": staging, "type": "staging", "sort_order": 0},
+		{"name": prod, "type": "production", "sort_order": 1},
+	}
+	resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID), doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	return fix, staging, prod
+}
+
+func TestAdminAPI_Promote(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d/promote", fix.projectID)
+	body := map[string]any{"source": staging, "target": prod, "enabled": true, "strategies": true}
+
+	// Preview.
+	resp := adminRequest(t, "POST", path+"?dry_run=true", body, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	result := parseJSON(t, resp)
+	assert.Equal(t, true, result["dry_run"])
+	changes := result["changes"].([]any)
+	require.Len(t, changes, 1)
+	change := changes[0].(map[string]any)
+	assert.Equal(t, "create", change["action"])
+	assert.Equal(t, prod, change["environment"])
+
+	// Apply.
+	resp = adminRequest(t, "POST", path, body, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, true, parseJSON(t, resp)["applied"])
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	exported := parseJSON(t, resp)
+	fes := exported["flags"].([]any)[0].(map[string]any)["environments"].([]any)
+	require.Len(t, fes, 2)
+	assert.Equal(t, fes[0].(map[string]any)["strategies"], fes[1].(map[string]any)["strategies"])
+	assert.Equal(t, true, fes[1].(map[string]any)["enabled"])
+
+	// Promoting again changes nothing.
+	resp = adminRequest(t, "POST", path, body, fix.rawToken)
+	assert.Empty(t, parseJSON(t, resp)["changes"])
+}
+
+func TestAdminAPI_Promote_AbortsRollout(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	ctx := gocontext.Background()
+	f := c.ORM.Flag.Query().Where(entflag.ProjectID(fix.projectID)).OnlyX(ctx)
+	env := c.ORM.Environment.Query().Where(environment.ProjectID(fix.projectID), environment.Name(prod)).OnlyX(ctx)
+
+	ref := services.FlagEnvRef{ProjectID: fix.projectID, FlagID: f.ID, EnvironmentID: env.ID}
+	st, _, err := c.Flags.CreateStrategy(ctx, ref, services.StrategyInput{
+		Name:       "gradualRollout",
+		Parameters: map[string]any{"rollout": 0, "stickiness": "userId"},
+	}, 0, "")
+	require.NoError(t, err)
+	plan, err := c.Rollouts.Create(ctx, fix.projectID, f.ID, st.ID, []services.RolloutStepInput{{Percentage: 10, Hold: "1h"}, {Percentage: 50}}, "")
+	require.NoError(t, err)
+
+	resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/promote", fix.projectID),
+		map[string]any{"source": staging, "target": prod, "strategies": true}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	plan, err = c.Rollouts.Get(ctx, fix.projectID, f.ID, plan.ID)
+	require.NoError(t, err)
+	assert.Equal(t, rolloutplan.StatusAborted, plan.Status)
+	assert.Equal(t, "the strategies were replaced by token:admin-token", plan.StatusReason)
+}
+
+func TestAdminAPI_Promote_EnabledOnly(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d/promote", fix.projectID)
+
+	resp := adminRequest(t, "POST", path, map[string]any{
+		"source": staging, "target": prod, "enabled": true, "flags": []string{"imported-flag"},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	exported := parseJSON(t, resp)
+	fes := exported["flags"].([]any)[0].(map[string]any)["environments"].([]any)
+	require.Len(t, fes, 2)
+	assert.Equal(t, true, fes[1].(map[string]any)["enabled"])
+	assert.Empty(t, fes[1].(map[string]any)["strategies"])
+}
+
+func TestAdminAPI_Promote_Validation(t *testing.T) {
+	fix, staging, _ := setupPromoteFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d/promote", fix.projectID)
+
+	resp := adminRequest(t, "POST", path, map[string]any{"source": staging, "target": staging, "enabled": true}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "POST", path, map[string]any{"source": staging, "target": "nope", "enabled": true, "flags": []string{"missing", "imported-flag", "gone"}}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Equal(t, `Unknown environment "nope"`, fields["target"])
+	assert.Equal(t, `Unknown flag "missing"`, fields["flags[0]"])
+	assert.Equal(t, `Unknown flag "gone"`, fields["flags[2]"])
+	assert.NotContains(t, fields, "flags[1]")
+}
+
+func TestAdminAPI_Compare(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d/compare?env=%s&env=%s", fix.projectID, staging, prod)
+
+	resp := adminRequest(t, "GET", path, nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	result := parseJSON(t, resp)
+	assert.Equal(t, []any{staging, prod}, result["environments"])
+	flags := result["flags"].([]any)
+	require.Len(t, flags, 1)
+	flag := flags[0].(map[string]any)
+	assert.Equal(t, "imported-flag", flag["name"])
+
+	paths := map[string]map[string]any{}
+	for _, d := range flag["differences"].([]any) {
+		diff := d.(map[string]any)
+		paths[diff["path"].(string)] = diff["values"].(map[string]any)
+	}
+	require.Contains(t, paths, "enabled")
+	assert.Equal(t, true, paths["enabled"][staging])
+	assert.Equal(t, false, paths["enabled"][prod])
+	require.Contains(t, paths, "strategies[0].parameters.rollout")
+	assert.Nil(t, paths["strategies[0].parameters.rollout"][prod])
+
+	// Fewer than two or unknown environments are rejected.
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/compare?env=%s", fix.projectID, staging), nil, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/compare?env=%s&env=nope", fix.projectID, staging), nil, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_Drift(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	base := fmt.Sprintf("/api/admin/projects/%d", fix.projectID)
+
+	resp := adminRequest(t, "GET", base+"/drift", nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, parseJSON(t, resp)["drift"])
+
+	resp = adminRequest(t, "GET", base+"/environments", nil, fix.rawToken)
+	ids := map[string]int{}
+	for _, e := range parseJSON(t, resp)["items"].([]any) {
+		env := e.(map[string]any)
+		ids[env["name"].(string)] = int(env["id"].(float64))
+	}
+
+	// An environment cannot match itself.
+	resp = adminRequest(t, "PUT", fmt.Sprintf("%s/environments/%d", base, ids[prod]),
+		map[string]any{"expected_match_id": ids[prod]}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "PUT", fmt.Sprintf("%s/environments/%d", base, ids[prod]),
+		map[string]any{"expected_match_id": ids[staging]}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(ids[staging]), parseJSON(t, resp)["expected_match_id"])
+
+	resp = adminRequest(t, "GET", base+"/drift", nil, fix.rawToken)
+	drift := parseJSON(t, resp)["drift"].([]any)
+	require.Len(t, drift, 1)
+	d := drift[0].(map[string]any)
+	assert.Equal(t, prod, d["environment"])
+	assert.Equal(t, staging, d["expected_match"])
+	assert.Len(t, d["flags"], 1)
+
+	// Promoting resolves the drift.
+	resp = adminRequest(t, "POST", base+"/promote", map[string]any{
+		"source": staging, "target": prod, "enabled": true, "strategies": true,
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", base+"/drift", nil, fix.rawToken)
+	drift = parseJSON(t, resp)["drift"].([]any)
+	require.Len(t, drift, 1)
+	assert.Empty(t, drift[0].(map[string]any)["flags"])
+
+	// 0 clears the relationship.
+	resp = adminRequest(t, "PUT", fmt.Sprintf("%s/environments/%d", base, ids[prod]),
+		map[string]any{"expected_match_id": 0}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Nil(t, parseJSON(t, resp)["expected_match_id"])
+}
+
+func TestAdminAPI_Evaluate(t *testing.T) {
+	fix, staging, prod := setupPromoteFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d/evaluate", fix.projectID)
+
+	resp := adminRequest(t, "POST", path, map[string]any{
+		"environments": []string{staging, prod},
+		"context":      map[string]any{"userId": "user-1", "properties": map[string]string{"region": "eu"}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	result := parseJSON(t, resp)
+	envs := result["environments"].([]any)
+	require.Len(t, envs, 2)
+
+	stagingFlags := envs[0].(map[string]any)["flags"].([]any)
+	require.Len(t, stagingFlags, 1)
+	flag := stagingFlags[0].(map[string]any)
+	assert.Equal(t, "imported-flag", flag["flag"])
+	assert.Equal(t, true, flag["enabled"])
+	strategy := flag["strategies"].([]any)[0].(map[string]any)
+	assert.Equal(t, true, strategy["matched"])
+	assert.Equal(t, float64(0), strategy["bucket"])
+	constraint := strategy["constraints"].([]any)[0].(map[string]any)
+	assert.Equal(t, "eu", constraint["value"])
+	assert.Equal(t, true, constraint["passed"])
+
+	prodFlag := envs[1].(map[string]any)["flags"].([]any)[0].(map[string]any)
+	assert.Equal(t, false, prodFlag["enabled"])
+	assert.Equal(t, false, prodFlag["configured"])
+
+	// A failing constraint turns the flag off but still reports the bucket.
+	resp = adminRequest(t, "POST", path, map[string]any{
+		"environments": []string{staging},
+		"context":      map[string]any{"userId": "user-1", "properties": map[string]string{"region": "us"}},
+	}, fix.rawToken)
+	flag = parseJSON(t, resp)["environments"].([]any)[0].(map[string]any)["flags"].([]any)[0].(map[string]any)
+	assert.Equal(t, false, flag["enabled"])
+	assert.Equal(t, "No strategy matched", flag["reason"])
+	strategy = flag["strategies"].([]any)[0].(map[string]any)
+	assert.Equal(t, float64(0), strategy["bucket"])
+	assert.Equal(t, false, strategy["constraints"].([]any)[0].(map[string]any)["passed"])
+
+	resp = adminRequest(t, "POST", path, map[string]any{"environments": []string{"nope"}}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_Prerequisites(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	envName := fmt.Sprintf("prereq-%d", fix.projectID)
+
+	env, err := c.ORM.Environment.Create().
+		SetName(envName).
+		SetType("production").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+	parent, err := c.ORM.Flag.Create().
+		SetName("new-checkout").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+	child, err := c.ORM.Flag.Create().
+		SetName("new-checkout-v2").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	base := fmt.Sprintf("/api/admin/projects/%d/flags", fix.projectID)
+	resp := adminRequest(t, "PATCH", fmt.Sprintf("%s/%d/environments/%d", base, child.ID, env.ID), map[string]any{
+		"enabled":       true,
+		"prerequisites": []map[string]any{{"flag": "new-checkout"}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	prereqs := parseJSON(t, resp)["prerequisites"].([]any)
+	require.Len(t, prereqs, 1)
+	assert.Equal(t, "new-checkout", prereqs[0].(map[string]any)["flag"])
+	assert.Equal(t, true, prereqs[0].(map[string]any)["enabled"])
+
+	// The client payload carries the prerequisite.
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, envName, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"prerequisites":[{"flag":"new-checkout","enabled":true}]`)
+
+	// The parent lists its dependents.
+	resp = adminRequest(t, "GET", fmt.Sprintf("%s/%d", base, parent.ID), nil, fix.rawToken)
+	dependents := parseJSON(t, resp)["dependents"].([]any)
+	require.Len(t, dependents, 1)
+	assert.Equal(t, "new-checkout-v2", dependents[0].(map[string]any)["flag"])
+
+	// A cycle is rejected.
+	resp = adminRequest(t, "PATCH", fmt.Sprintf("%s/%d/environments/%d", base, parent.ID, env.ID), map[string]any{
+		"prerequisites": []map[string]any{{"flag": "new-checkout-v2"}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Contains(t, parseJSON(t, resp)["fields"], "prerequisites")
+
+	// Unknown and self references are rejected.
+	resp = adminRequest(t, "PATCH", fmt.Sprintf("%s/%d/environments/%d", base, child.ID, env.ID), map[string]any{
+		"prerequisites": []map[string]any{{"flag": "missing"}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+	resp = adminRequest(t, "PATCH", fmt.Sprintf("%s/%d/environments/%d", base, child.ID, env.ID), map[string]any{
+		"prerequisites": []map[string]any{{"flag": "new-checkout-v2"}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+
+	// The parent cannot be deleted while depended on.
+	resp = adminRequest(t, "DELETE", fmt.Sprintf("%s/%d", base, parent.ID), nil, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	// Export round-trips the prerequisite.
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	exported := parseJSON(t, resp)
+	var found bool
+	for _, f := range exported["flags"].([]any) {
+		flag := f.(map[string]any)
+		if flag["name"] != "new-checkout-v2" {
+			continue
+		}
+		fe := flag["environments"].([]any)[0].(map[string]any)
+		assert.Equal(t, []any{map[string]any{"flag": "new-checkout"}}, fe["prerequisites"])
+		found = true
+	}
+	assert.True(t, found)
+
+	// Clearing the prerequisites unblocks the delete.
+	resp = adminRequest(t, "PATCH", fmt.Sprintf("%s/%d/environments/%d", base, child.ID, env.ID), map[string]any{
+		"prerequisites": []any{},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	resp = adminRequest(t, "DELETE", fmt.Sprintf("%s/%d", base, parent.ID), nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_Import_PrerequisiteCycle(t *testing.T) {
+	fix := setupAdminFixture(t)
+	envName := fmt.Sprintf("cycle-%d", fix.projectID)
+	doc := importDocument(fix, envName)
+	doc["flags"] = []map[string]any{
+		{"name": "a", "flag_type": "release", "environments": []map[string]any{
+			{"environment": envName, "enabled": true, "strategies": []any{}, "prerequisites": []map[string]any{{"flag": "b"}}},
+		}},
+		{"name": "b", "flag_type": "release", "environments": []map[string]any{
+			{"environment": envName, "enabled": true, "strategies": []any{}, "prerequisites": []map[string]any{{"flag": "a", "enabled": false}}},
+		}},
+	}
+
+	for _, query := range []string{"?dry_run=true", ""} {
+		resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import%s", fix.projectID, query), doc, fix.rawToken)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode, query)
+		fields := parseJSON(t, resp)["fields"].(map[string]any)
+		assert.Contains(t, fields["prerequisites"], "a -> b -> a", query)
+	}
+}
+
+func TestAdminAPI_Import_PrerequisitesAndPrune(t *testing.T) {
+	fix := setupAdminFixture(t)
+	envName := fmt.Sprintf("prune-%d", fix.projectID)
+	path := fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID)
+	doc := importDocument(fix, envName)
+	// "a" depends on "b", declared after it.
+	doc["flags"] = []map[string]any{
+		{"name": "a", "flag_type": "release", "environments": []map[string]any{
+			{"environment": envName, "enabled": true, "strategies": []any{}, "prerequisites": []map[string]any{{"flag": "b", "variant": "blue"}}},
+		}},
+		{"name": "b", "flag_type": "release"},
+	}
+
+	resp := adminRequest(t, "POST", path+"?dry_run=true", doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	resp = adminRequest(t, "POST", path, doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// Re-applying is a no-op.
+	resp = adminRequest(t, "POST", path, doc, fix.rawToken)
+	assert.Empty(t, parseJSON(t, resp)["changes"])
+
+	// Pruning both flags at once succeeds.
+	doc["flags"] = []map[string]any{}
+	resp = adminRequest(t, "POST", path+"?prune=true", doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(2), parseJSON(t, resp)["summary"].(map[string]any)["delete"])
+}
+
+// ---------------------------------------------------------------------------
+// Version history
+// ---------------------------------------------------------------------------
+
+func TestAdminAPI_Versions(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	envName := fmt.Sprintf("history-%d", fix.projectID)
+
+	env, err := c.ORM.Environment.Create().
+		SetName(envName).
+		SetType("production").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+	f, err := c.ORM.Flag.Create().
+		SetName("history-flag").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	patch := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, f.ID, env.ID)
+	versions := patch + "/versions"
+
+	// v1: enabled with a 25% rollout.
+	resp := adminRequest(t, "PATCH", patch, map[string]any{
+		"enabled": true,
+		"strategies": []map[string]any{{
+			"name":       "gradualRollout",
+			"parameters": map[string]any{"rollout": 25},
+			"constraints": []map[string]any{
+				{"context_name": "country", "operator": "IN", "values": []string{"BR"}},
+			},
+		}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// v2: strategies replaced with a 100% rollout.
+	resp = adminRequest(t, "PATCH", patch, map[string]any{
+		"strategies": []map[string]any{{"name": "gradualRollout", "parameters": map[string]any{"rollout": 100}}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// An unchanged PATCH records nothing.
+	resp = adminRequest(t, "PATCH", patch, map[string]any{"enabled": true}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", versions, nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	list := parseJSON(t, resp)["versions"].([]any)
+	require.Len(t, list, 2)
+	latest := list[0].(map[string]any)
+	assert.Equal(t, float64(2), latest["version"])
+	assert.Equal(t, "token:admin-token", latest["actor"])
+	assert.Equal(t, envName, latest["config"].(map[string]any)["environment"])
+
+	resp = adminRequest(t, "GET", versions+"/diff?from=1&to=2", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	changes := parseJSON(t, resp)["changes"].([]any)
+	require.Len(t, changes, 2)
+	assert.Equal(t, map[string]any{"field": "strategies[0].constraints[0]", "from": map[string]any{
+		"context_name": "country", "operator": "IN", "values": []any{"BR"}, "inverted": false, "case_insensitive": false,
+	}, "to": nil}, changes[0])
+	assert.Equal(t, map[string]any{"field": "strategies[0].parameters.rollout", "from": float64(25), "to": float64(100)}, changes[1])
+
+	resp = adminRequest(t, "GET", versions+"/diff?from=1&to=9", nil, fix.rawToken)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+
+	// Restoring v1 brings back the constraint and records v3.
+	resp = adminRequest(t, "POST", versions+"/1/restore", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, float64(1), parseJSON(t, resp)["summary"].(map[string]any)["update"])
+
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, envName, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"parameters":{"rollout":25}`)
+	assert.Contains(t, string(payload), `"context_name":"country"`)
+
+	resp = adminRequest(t, "GET", versions+"/diff?from=1&to=3", nil, fix.rawToken)
+	assert.Empty(t, parseJSON(t, resp)["changes"])
+
+	resp = adminRequest(t, "POST", versions+"/7/restore", nil, fix.rawToken)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+}
+
+// ---------------------------------------------------------------------------
+// Trash
+// ---------------------------------------------------------------------------
+
+func TestAdminAPI_Trash(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	env, err := c.ORM.Environment.Create().
+		SetName("dev-trash").
+		SetType("development").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+	f, err := c.ORM.Flag.Create().
+		SetName("trashed-flag").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	base := fmt.Sprintf("/api/admin/projects/%d", fix.projectID)
+	resp := adminRequest(t, "PATCH", fmt.Sprintf("%s/flags/%d/environments/%d", base, f.ID, env.ID), map[string]any{"enabled": true}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "DELETE", fmt.Sprintf("%s/flags/%d", base, f.ID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// Deleted flags leave the payload and the flag list.
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, env.Name, nil)
+	require.NoError(t, err)
+	assert.NotContains(t, string(payload), "trashed-flag")
+
+	resp = adminRequest(t, "GET", base+"/flags", nil, fix.rawToken)
+	assert.Empty(t, parseJSON(t, resp)["items"])
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("%s/flags/%d", base, f.ID), nil, fix.rawToken)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+
+	// The name stays reserved.
+	resp = adminRequest(t, "POST", base+"/flags", map[string]any{"name": "trashed-flag", "flag_type": "release"}, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "DELETE", fmt.Sprintf("%s/environments/%d", base, env.ID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", base+"/trash", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	items := parseJSON(t, resp)["items"].([]any)
+	require.Len(t, items, 2)
+	assert.Equal(t, "environment", items[0].(map[string]any)["kind"])
+	assert.Equal(t, "trashed-flag", items[1].(map[string]any)["name"])
+	assert.NotEmpty(t, items[1].(map[string]any)["purge_at"])
+
+	// Restoring brings both back with their config.
+	resp = adminRequest(t, "POST", fmt.Sprintf("%s/environments/%d/restore", base, env.ID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	resp = adminRequest(t, "POST", fmt.Sprintf("%s/flags/%d/restore", base, f.ID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "trashed-flag", parseJSON(t, resp)["name"])
+
+	payload, err = buildFlagPayload(ctx, c.ORM, fix.projectID, env.Name, nil)
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"name":"trashed-flag","enabled":true`)
+
+	resp = adminRequest(t, "POST", fmt.Sprintf("%s/flags/%d/restore", base, f.ID), nil, fix.rawToken)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_Trash_ProjectTokens(t *testing.T) {
+	fix := setupAdminFixture(t)
+	path := fmt.Sprintf("/api/admin/projects/%d", fix.projectID)
+
+	resp := adminRequest(t, "DELETE", path, nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// Tokens of a project in the trash stop working until it is restored.
+	resp = adminRequest(t, "GET", path, nil, fix.rawToken)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp.Body.Close()
+
+	require.NoError(t, c.Trash.Restore(gocontext.Background(), services.TrashProject, fix.projectID))
+
+	resp = adminRequest(t, "GET", path, nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+// ---------------------------------------------------------------------------
+// Tags, owners and metadata
+// ---------------------------------------------------------------------------
+
+func TestAdminAPI_Flags_TagsAndFilters(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	flags := fmt.Sprintf("/api/admin/projects/%d/flags", fix.projectID)
+
+	env, err := c.ORM.Environment.Create().
+		SetName("prod").
+		SetType("production").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	resp := adminRequest(t, "POST", flags, map[string]any{
+		"name":      "checkout",
+		"flag_type": "release",
+		"owner":     "team-payments",
+		"tags":      []string{"Mobile", " web ", "mobile"},
+		"links":     []map[string]string{{"title": "spec", "url": "https://example.com/spec"}},
+		"metadata":  map[string]string{"jira": "PAY-12"},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	body := parseJSON(t, resp)
+	checkoutID := int(body["id"].(float64))
+	assert.Equal(t, []any{"mobile", "web"}, body["tags"])
+	assert.Equal(t, "team-payments", body["owner"])
+	assert.Equal(t, []any{map[string]any{"title": "spec", "url": "https://example.com/spec"}}, body["links"])
+	assert.Equal(t, map[string]any{"jira": "PAY-12"}, body["metadata"])
+
+	resp = adminRequest(t, "POST", flags, map[string]any{
+		"name":      "banner",
+		"flag_type": "experiment",
+		"tags":      []string{"web"},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	resp.Body.Close()
+
+	_, err = c.ORM.FlagEnvironment.Create().
+		SetFlagID(checkoutID).
+		SetEnvironmentID(env.ID).
+		SetEnabled(true).
+		Save(ctx)
+	require.NoError(t, err)
+
+	// Invalid tags and links are rejected field by field.
+	resp = adminRequest(t, "POST", flags, map[string]any{
+		"name":      "bad",
+		"flag_type": "release",
+		"tags":      []string{"no spaces"},
+		"links":     []map[string]string{{"url": "javascript:alert(1)"}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Contains(t, fields, "tags")
+	assert.Contains(t, fields, "links[0].url")
+
+	names := func(query string) []string {
+		t.Helper()
+		resp := adminRequest(t, "GET", flags+query, nil, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var out []string
+		for _, f := range parseJSON(t, resp)["items"].([]any) {
+			out = append(out, f.(map[string]any)["name"].(string))
+		}
+		return out
+	}
+	assert.ElementsMatch(t, []string{"checkout", "banner"}, names("?tag=web"))
+	assert.Equal(t, []string{"checkout"}, names("?tag=mobile"))
+	assert.Equal(t, []string{"checkout"}, names("?owner=team-payments"))
+	assert.Equal(t, []string{"banner"}, names("?type=experiment"))
+	assert.Equal(t, []string{"checkout"}, names("?enabled_in=prod"))
+	assert.Empty(t, names("?tag=web&type=experiment&enabled_in=prod"))
+
+	resp = adminRequest(t, "GET", flags+"?type=bogus", nil, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	resp.Body.Close()
+
+	// Client payloads can be narrowed down to tagged flags.
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, env.Name, []string{"mobile"})
+	require.NoError(t, err)
+	assert.Contains(t, string(payload), `"name":"checkout"`)
+	assert.NotContains(t, string(payload), `"name":"banner"`)
+
+	// Replacing the tags drops the ones no flag uses anymore.
+	resp = adminRequest(t, "PUT", fmt.Sprintf("%s/%d", flags, checkoutID), map[string]any{
+		"tags": []string{"web"},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body = parseJSON(t, resp)
+	assert.Equal(t, []any{"web"}, body["tags"])
+	assert.Equal(t, "team-payments", body["owner"])
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/tags", fix.projectID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []any{map[string]any{"name": "web", "flag_count": float64(2)}}, parseJSON(t, resp)["tags"])
+
+	// Exports carry the metadata along.
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	exported := parseJSON(t, resp)["flags"].([]any)
+	for _, f := range exported {
+		if f.(map[string]any)["name"] == "checkout" {
+			assert.Equal(t, []any{"web"}, f.(map[string]any)["tags"])
+			assert.Equal(t, map[string]any{"jira": "PAY-12"}, f.(map[string]any)["metadata"])
+		}
+	}
+}
+
+func TestBuildFlagPayload_TagsKeepPrerequisites(t *testing.T) {
+	fix := setupAdminFixture(t)
+	envName := fmt.Sprintf("tags-%d", fix.projectID)
+	doc := importDocument(fix, envName)
+	prereq := func(name, parent string, tags ...string) map[string]any {
+		fe := map[string]any{"environment": envName, "enabled": true, "strategies": []any{}}
+		if parent != "" {
+			fe["prerequisites"] = []map[string]any{{"flag": parent}}
+		}
+		return map[string]any{"name": name, "flag_type": "release", "tags": tags, "environments": []map[string]any{fe}}
+	}
+	doc["flags"] = []map[string]any{
+		prereq("checkout-v2", "checkout", "mobile"),
+		prereq("checkout", "payments"),
+		prereq("payments", ""),
+		prereq("banner", ""),
+	}
+	resp := adminRequest(t, "POST", fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID), doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// The untagged parents come along, so that SDKs can evaluate the tagged
+	// flag.
+	payload, err := buildFlagPayload(gocontext.Background(), c.ORM, fix.projectID, envName, []string{"mobile"})
+	require.NoError(t, err)
+	var body struct {
+		Flags []struct {
+			Name string `json:"name"`
+		} `json:"flags"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &body))
+	var names []string
+	for _, f := range body.Flags {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, []string{"checkout-v2", "checkout", "payments"}, names)
+}
+
+// ---------------------------------------------------------------------------
+// Strategies
+// ---------------------------------------------------------------------------
+
+func TestAdminAPI_Strategies_List(t *testing.T) {
+	fix := setupAdminFixture(t)
+
+	resp := adminRequest(t, "GET", "/api/admin/strategies", nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body := parseJSON(t, resp)
+	strategies := body["strategies"].([]any)
+	names := make([]string, 0, len(strategies))
+	for _, s := range strategies {
+		names = append(names, s.(map[string]any)["name"].(string))
+	}
+	assert.Equal(t, []string{"default", "gradualRollout", "userWithId", "remoteAddress"}, names)
+
+	rollout := strategies[1].(map[string]any)["parameters"].([]any)[0].(map[string]any)
+	assert.Equal(t, "rollout", rollout["name"])
+	assert.Equal(t, "number", rollout["type"])
+	assert.Equal(t, float64(100), rollout["max"])
+}
+
+func TestAdminAPI_PatchFlagEnv_InvalidStrategies(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	flag, err := c.ORM.Flag.Create().
+		SetName("invalid-strat-flag").
+		SetFlagType("release").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	env, err := c.ORM.Environment.Create().
+		SetName("dev-invalid-strat").
+		SetType("development").
+		SetProjectID(fix.projectID).
+		Save(ctx)
+	require.NoError(t, err)
+
+	path := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flag.ID, env.ID)
+	resp := adminRequest(t, "PATCH", path, map[string]any{
+		"enabled": true,
+		"strategies": []map[string]any{
+			{"name": "default"},
+			{"name": "gradualRollout", "parameters": map[string]any{"rollout": 120}},
+			{"name": "canary"},
+			{"name": "default", "constraints": []map[string]any{
+				{"context_name": "region", "operator": "LIKE", "values": []string{"us"}},
+			}},
+		},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Contains(t, fields, "strategies[1].parameters.rollout")
+	assert.Contains(t, fields, "strategies[2].name")
+	assert.Contains(t, fields, "strategies[3].constraints[0].operator")
+	assert.Len(t, fields, 3)
+
+	// Nothing was applied.
+	fe, err := c.ORM.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(flag.ID), flagenvironment.EnvironmentID(env.ID)).
+		Only(ctx)
+	if err == nil {
+		assert.False(t, fe.Enabled)
+	}
+}
+
+func TestAdminAPI_StrategyDefinitions(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	base := fmt.Sprintf("/api/admin/projects/%d/strategy-definitions", fix.projectID)
+
+	resp := adminRequest(t, "POST", base, map[string]any{
+		"name":       "userWithId",
+		"parameters": []map[string]any{{"name": "plans", "type": "date"}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Contains(t, fields, "name")
+	assert.Contains(t, fields, "parameters[0].type")
+
+	resp = adminRequest(t, "POST", base, map[string]any{
+		"name":        "tenantPlan",
+		"description": "On for tenants on the listed plans",
+		"parameters":  []map[string]any{{"name": "plans", "label": "Plans", "type": "list", "required": true}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	created := parseJSON(t, resp)
+	assert.Equal(t, false, created["builtin"])
+	defPath := fmt.Sprintf("%s/%d", base, int(created["id"].(float64)))
+
+	resp = adminRequest(t, "POST", base, map[string]any{"name": "tenantPlan"}, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", base, nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	strategies := parseJSON(t, resp)["strategies"].([]any)
+	assert.Len(t, strategies, 5)
+	assert.Equal(t, "tenantPlan", strategies[4].(map[string]any)["name"])
+
+	// Flags can use the strategy, with its parameters validated.
+	flag, err := c.ORM.Flag.Create().SetName("plan-flag").SetFlagType("release").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	env, err := c.ORM.Environment.Create().SetName("dev-plans").SetType("development").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	patchPath := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flag.ID, env.ID)
+
+	resp = adminRequest(t, "PATCH", patchPath, map[string]any{
+		"strategies": []map[string]any{{"name": "tenantPlan", "parameters": map[string]any{}}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields = parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Equal(t, "Plans is required", fields["strategies[0].parameters.plans"])
+
+	resp = adminRequest(t, "PATCH", patchPath, map[string]any{
+		"strategies": []map[string]any{{"name": "tenantPlan", "parameters": map[string]any{"plans": "pro\nenterprise"}}},
+	}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// The client payload describes the custom strategies.
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, env.Name, nil)
+	require.NoError(t, err)
+	var body struct {
+		StrategyDefinitions []map[string]any `json:"strategy_definitions"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &body))
+	require.Len(t, body.StrategyDefinitions, 1)
+	assert.Equal(t, "tenantPlan", body.StrategyDefinitions[0]["name"])
+
+	// While in use, the strategy can be neither renamed nor deleted.
+	resp = adminRequest(t, "PUT", defPath, map[string]any{"name": "plan"}, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, []any{"plan-flag"}, parseJSON(t, resp)["flags"])
+
+	resp = adminRequest(t, "DELETE", defPath, nil, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, []any{"plan-flag"}, parseJSON(t, resp)["flags"])
+
+	resp = adminRequest(t, "PUT", defPath, map[string]any{"description": "Plans"}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "Plans", parseJSON(t, resp)["description"])
+
+	resp = adminRequest(t, "PATCH", patchPath, map[string]any{"strategies": []map[string]any{}}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "DELETE", defPath, nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_ContextFields(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+	base := fmt.Sprintf("/api/admin/projects/%d/context-fields", fix.projectID)
+
+	flag, err := c.ORM.Flag.Create().SetName("region-flag").SetFlagType("release").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	env, err := c.ORM.Environment.Create().SetName("dev-context").SetType("development").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	patchPath := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flag.ID, env.ID)
+	constrained := func(c map[string]any) map[string]any {
+		return map[string]any{"strategies": []map[string]any{{"name": "default", "constraints": []map[string]any{c}}}}
+	}
+
+	// Until a field is registered, constraints may test any field.
+	resp := adminRequest(t, "PATCH", patchPath, constrained(map[string]any{
+		"context_name": "tier", "operator": "IN", "values": []string{"gold"},
+	}), fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "POST", base, map[string]any{"name": "userId", "type": "bool"}, fix.rawToken)
+	assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Contains(t, fields, "name")
+	assert.Contains(t, fields, "type")
+
+	resp = adminRequest(t, "POST", base, map[string]any{
+		"name": "region", "description": "Account region", "legal_values": []string{"eu", "us"},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	created := parseJSON(t, resp)
+	assert.Equal(t, "string", created["type"])
+	fieldPath := fmt.Sprintf("%s/%d", base, int(created["id"].(float64)))
+
+	resp = adminRequest(t, "POST", base, map[string]any{"name": "region"}, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "GET", base, nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	list := parseJSON(t, resp)
+	contextFields := list["context_fields"].([]any)
+	assert.Len(t, contextFields, 4)
+	assert.Equal(t, "region", contextFields[3].(map[string]any)["name"])
+	assert.Contains(t, list["operators"].(map[string]any)["number"], "NUM_GT")
+
+	// Now the registry is enforced.
+	for _, tt := range []struct {
+		constraint map[string]any
+		key, msg   string
+	}{
+		{map[string]any{"context_name": "Region", "operator": "IN", "values": []string{"eu"}}, "context_name", `Unknown context field "Region"; did you mean "region"?`},
+		{map[string]any{"context_name": "region", "operator": "NUM_GT", "values": []string{"1"}}, "operator", "Operator NUM_GT does not apply to region, a string field; use one of: IN, NOT_IN, STR_CONTAINS, STR_STARTS_WITH, STR_ENDS_WITH, REGEX, ANY_OF, ALL_OF"},
+		{map[string]any{"context_name": "region", "operator": "IN", "values": []string{"apac"}}, "values", `"apac" is not a legal value of region; must be one of: eu, us`},
+		{map[string]any{"context_name": "remoteAddress", "operator": "IN", "values": []string{"10.0.0"}}, "values", `"10.0.0" is not an IP address`},
+	} {
+		resp = adminRequest(t, "PATCH", patchPath, constrained(tt.constraint), fix.rawToken)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		fields = parseJSON(t, resp)["fields"].(map[string]any)
+		assert.Equal(t, tt.msg, fields["strategies[0].constraints[0]."+tt.key])
+	}
+
+	resp = adminRequest(t, "PATCH", patchPath, constrained(map[string]any{
+		"context_name": "region", "operator": "NOT_IN", "values": []string{"US"}, "case_insensitive": true,
+	}), fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// Exports carry the registry.
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/export", fix.projectID), nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	exported := parseJSON(t, resp)
+	assert.Equal(t, []any{map[string]any{
+		"name": "region", "description": "Account region", "type": "string", "legal_values": []any{"eu", "us"},
+	}}, exported["context_fields"])
+
+	// While in use, the field can be neither renamed, retyped nor deleted.
+	resp = adminRequest(t, "PUT", fieldPath, map[string]any{"name": "area"}, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	assert.Equal(t, []any{"region-flag"}, parseJSON(t, resp)["flags"])
+
+	resp = adminRequest(t, "DELETE", fieldPath, nil, fix.rawToken)
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "PUT", fieldPath, map[string]any{"legal_values": []string{"eu", "us", "apac"}}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []any{"eu", "us", "apac"}, parseJSON(t, resp)["legal_values"])
+
+	resp = adminRequest(t, "PATCH", patchPath, map[string]any{"strategies": []map[string]any{}}, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "DELETE", fieldPath, nil, fix.rawToken)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestAdminAPI_Import_ContextFields(t *testing.T) {
+	fix := setupAdminFixture(t)
+	envName := fmt.Sprintf("context-%d", fix.projectID)
+	path := fmt.Sprintf("/api/admin/projects/%d/import", fix.projectID)
+
+	// The document's fields apply to its own constraints.
+	doc := importDocument(fix, envName)
+	doc["context_fields"] = []map[string]any{{"name": "region", "type": "number"}}
+	resp := adminRequest(t, "POST", path, doc, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Contains(t, fields, "flags[0].environments[0].strategies[0].constraints[0].values")
+
+	doc["context_fields"] = []map[string]any{{"name": "region", "type": "string", "legal_values": []string{"eu", "us"}}}
+	resp = adminRequest(t, "POST", path, doc, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	changes := parseJSON(t, resp)["changes"].([]any)
+	assert.Contains(t, changes, map[string]any{"action": "create", "kind": "context_field", "name": "region"})
+
+	resp = adminRequest(t, "POST", path, doc, fix.rawToken)
+	assert.Empty(t, parseJSON(t, resp)["changes"])
+
+	// Pruning a field still tested by a constraint is rejected.
+	doc["context_fields"] = []map[string]any{{"name": "tier", "type": "string"}}
+	resp = adminRequest(t, "POST", path+"?prune=true", doc, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields = parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Equal(t, `Unknown context field "region"`, fields["flags[0].environments[0].strategies[0].constraints[0].context_name"])
+}
+
+func TestAdminAPI_PatchFlagEnv_ExtendedOperators(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	flag, err := c.ORM.Flag.Create().SetName("operators-flag").SetFlagType("release").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	env, err := c.ORM.Environment.Create().SetName("dev-operators").SetType("development").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	path := fmt.Sprintf("/api/admin/projects/%d/flags/%d/environments/%d", fix.projectID, flag.ID, env.ID)
+
+	resp := adminRequest(t, "PATCH", path, map[string]any{
+		"strategies": []map[string]any{{"name": "default", "constraints": []map[string]any{
+			{"context_name": "appVersion", "operator": "SEMVER_GT", "values": []string{"2.4"}},
+			{"context_name": "email", "operator": "REGEX", "values": []string{"[a-z"}},
+			{"context_name": "remoteAddress", "operator": "IN_CIDR", "values": []string{"10.0.0.0/33"}},
+			{"context_name": "groups", "operator": "ANY_OF", "values": []string{}},
+		}}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	fields := parseJSON(t, resp)["fields"].(map[string]any)
+	assert.Equal(t, `"2.4" is not a semantic version`, fields["strategies[0].constraints[0].values"])
+	assert.Contains(t, fields, "strategies[0].constraints[1].values")
+	assert.Contains(t, fields, "strategies[0].constraints[2].values")
+	assert.Equal(t, "ANY_OF needs at least one value", fields["strategies[0].constraints[3].values"])
+
+	resp = adminRequest(t, "PATCH", path, map[string]any{
+		"enabled": true,
+		"strategies": []map[string]any{{"name": "default", "constraints": []map[string]any{
+			{"context_name": "appVersion", "operator": "SEMVER_GT", "values": []string{"2.4.0"}},
+			{"context_name": "email", "operator": "REGEX", "values": []string{`@acme\.com$`}},
+			{"context_name": "remoteAddress", "operator": "IN_CIDR", "values": []string{"10.0.0.0/8"}},
+			{"context_name": "groups", "operator": "ALL_OF", "values": []string{"beta", "staff"}},
+		}}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// SDKs receive the operators as stored.
+	payload, err := buildFlagPayload(ctx, c.ORM, fix.projectID, env.Name, nil)
+	require.NoError(t, err)
+	var body struct {
+		Flags []struct {
+			Strategies []struct {
+				Constraints []struct {
+					Operator string `json:"operator"`
+				} `json:"constraints"`
+			} `json:"strategies"`
+		} `json:"flags"`
+	}
+	require.NoError(t, json.Unmarshal(payload, &body))
+	require.Len(t, body.Flags, 1)
+	var operators []string
+	for _, c := range body.Flags[0].Strategies[0].Constraints {
+		operators = append(operators, c.Operator)
+	}
+	assert.Equal(t, []string{"SEMVER_GT", "REGEX", "IN_CIDR", "ALL_OF"}, operators)
+}
+
+func TestAdminAPI_FreezeAndKillSwitch(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	prod, err := c.ORM.Environment.Create().SetName("ks-prod").SetType("production").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	dev, err := c.ORM.Environment.Create().SetName("ks-dev").SetType("development").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+
+	flagEnv := func(name, flagType string, enabled bool) *ent.FlagEnvironment {
+		f, err := c.ORM.Flag.Create().SetName(name).SetFlagType(entflag.FlagType(flagType)).SetProjectID(fix.projectID).Save(ctx)
+		require.NoError(t, err)
+		fe, err := c.ORM.FlagEnvironment.Create().SetFlagID(f.ID).SetEnvironmentID(prod.ID).SetEnabled(enabled).Save(ctx)
+		require.NoError(t, err)
+		return fe
+	}
+	payments := flagEnv("ks-payments", "kill_switch", true)
+	search := flagEnv("ks-search", "kill_switch", true)
+	release := flagEnv("ks-release", "release", true)
+
+	envPath := fmt.Sprintf("/api/admin/projects/%d/environments/%d", fix.projectID, prod.ID)
+
+	t.Run("freeze project", func(t *testing.T) {
+		path := fmt.Sprintf("/api/admin/projects/%d/freeze", fix.projectID)
+
+		resp := adminRequest(t, "PUT", path, map[string]any{"reason": " "}, fix.rawToken)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		resp.Body.Close()
+
+		resp = adminRequest(t, "PUT", path, map[string]any{"reason": "Incident 42"}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		freeze := parseJSON(t, resp)["freeze"].(map[string]any)
+		assert.Equal(t, "Incident 42", freeze["reason"])
+		assert.Equal(t, "token:admin-token", freeze["frozen_by"])
+
+		var ferr *declarative.FrozenError
+		require.ErrorAs(t, declarative.CheckFrozen(ctx, c.ORM, fix.projectID), &ferr)
+		assert.Empty(t, ferr.Environment)
+
+		resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d", fix.projectID), nil, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.NotNil(t, parseJSON(t, resp)["freeze"])
+
+		resp = adminRequest(t, "DELETE", path, nil, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+		assert.NoError(t, declarative.CheckFrozen(ctx, c.ORM, fix.projectID))
+	})
+
+	t.Run("freeze environment", func(t *testing.T) {
+		resp := adminRequest(t, "PUT", envPath+"/freeze", map[string]any{"reason": "Release freeze"}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		resp.Body.Close()
+
+		assert.NoError(t, declarative.CheckFrozen(ctx, c.ORM, fix.projectID, dev.ID))
+		var ferr *declarative.FrozenError
+		require.ErrorAs(t, declarative.CheckFrozen(ctx, c.ORM, fix.projectID, dev.ID, prod.ID), &ferr)
+		assert.Equal(t, "ks-prod", ferr.Environment)
+		assert.Equal(t, "Release freeze", ferr.Reason)
+	})
+
+	t.Run("kill switch", func(t *testing.T) {
+		resp := adminRequest(t, "POST", envPath+"/kill-switch", map[string]any{}, fix.rawToken)
+		require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		resp.Body.Close()
+
+		// Works while the environment is frozen.
+		resp = adminRequest(t, "POST", envPath+"/kill-switch", map[string]any{"reason": "Payments down"}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		body := parseJSON(t, resp)
+		assert.Equal(t, "ks-prod", body["environment"])
+		assert.Equal(t, []any{"ks-payments", "ks-search"}, body["flags"])
+
+		for _, fe := range []*ent.FlagEnvironment{payments, search} {
+			fe, err := c.ORM.FlagEnvironment.Get(ctx, fe.ID)
+			require.NoError(t, err)
+			assert.False(t, fe.Enabled)
+			assert.Equal(t, 2, fe.Revision)
+		}
+		fe, err := c.ORM.FlagEnvironment.Get(ctx, release.ID)
+		require.NoError(t, err)
+		assert.True(t, fe.Enabled, "release flags are left alone")
+
+		versions, err := declarative.Versions(ctx, c.ORM, fix.projectID, payments.FlagID, prod.ID)
+		require.NoError(t, err)
+		require.NotEmpty(t, versions)
+		assert.Equal(t, "Payments down", versions[0].Reason)
+		assert.Equal(t, "token:admin-token", versions[0].Actor)
+
+		// Nothing left to turn off.
+		resp = adminRequest(t, "POST", envPath+"/kill-switch", map[string]any{"reason": "Payments down"}, fix.rawToken)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Empty(t, parseJSON(t, resp)["flags"])
+	})
+}
+
+func TestAdminAPI_RolloutPlans(t *testing.T) {
+	fix := setupAdminFixture(t)
+	ctx := gocontext.Background()
+
+	env, err := c.ORM.Environment.Create().SetName("ro-prod").SetType("production").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	f, err := c.ORM.Flag.Create().SetName("ro-checkout").SetFlagType("release").SetProjectID(fix.projectID).Save(ctx)
+	require.NoError(t, err)
+	fe, err := c.ORM.FlagEnvironment.Create().SetFlagID(f.ID).SetEnvironmentID(env.ID).SetEnabled(true).Save(ctx)
+	require.NoError(t, err)
+	st, err := c.ORM.Strategy.Create().SetName("gradualRollout").
+		SetParameters(map[string]interface{}{"rollout": 0, "stickiness": "userId"}).
+		SetFlagEnvironmentID(fe.ID).Save(ctx)
+	require.NoError(t, err)
+
+	path := fmt.Sprintf("/api/admin/projects/%d/flags/%d/rollouts", fix.projectID, f.ID)
+
+	resp := adminRequest(t, "POST", path, map[string]any{
+		"strategy_id": st.ID,
+		"steps":       []map[string]any{{"percentage": 5, "hold": "1d"}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	assert.Contains(t, parseJSON(t, resp)["fields"], "steps[0].hold")
+
+	resp = adminRequest(t, "POST", path, map[string]any{
+		"strategy_id": st.ID,
+		"steps": []map[string]any{
+			{"percentage": 5, "hold": "24h"},
+			{"percentage": 25, "hold": "48h"},
+			{"percentage": 100},
+		},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	plan := parseJSON(t, resp)
+	assert.Equal(t, "running", plan["status"])
+	assert.Equal(t, float64(5), plan["percentage"])
+	assert.Equal(t, float64(env.ID), plan["environment_id"])
+	assert.Equal(t, "token:admin-token", plan["created_by"])
+	assert.NotNil(t, plan["next_step_at"])
+	assert.Equal(t, "24h", plan["steps"].([]any)[0].(map[string]any)["hold"])
+	assert.Equal(t, float64(5), c.ORM.Strategy.GetX(ctx, st.ID).Parameters["rollout"])
+	assert.Equal(t, 2, c.ORM.FlagEnvironment.GetX(ctx, fe.ID).Revision)
+
+	planPath := fmt.Sprintf("%s/%v", path, plan["id"])
+
+	resp = adminRequest(t, "POST", path, map[string]any{
+		"strategy_id": st.ID,
+		"steps":       []map[string]any{{"percentage": 50}},
+	}, fix.rawToken)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "POST", planPath+"/pause", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	plan = parseJSON(t, resp)
+	assert.Equal(t, "paused", plan["status"])
+	assert.Nil(t, plan["next_step_at"])
+
+	resp = adminRequest(t, "POST", planPath+"/pause", nil, fix.rawToken)
+	require.Equal(t, http.StatusConflict, resp.StatusCode)
+	resp.Body.Close()
+
+	resp = adminRequest(t, "POST", planPath+"/resume", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "running", parseJSON(t, resp)["status"])
+
+	resp = adminRequest(t, "POST", planPath+"/abort", nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	plan = parseJSON(t, resp)
+	assert.Equal(t, "aborted", plan["status"])
+	assert.Equal(t, "aborted by token:admin-token", plan["status_reason"])
+
+	resp = adminRequest(t, "GET", path, nil, fix.rawToken)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, parseJSON(t, resp)["plans"], 1)
+
+	resp = adminRequest(t, "GET", fmt.Sprintf("/api/admin/projects/%d/flags/%d/rollouts/999999", fix.projectID, f.ID), nil, fix.rawToken)
+	require.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp.Body.Close()
+}
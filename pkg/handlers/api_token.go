@@ -10,9 +10,11 @@ import (
 	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
 	"github.com/felipekafuri/bandeira/ent/environment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/pkg/form"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
 	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/pager"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 	"github.com/felipekafuri/bandeira/pkg/token"
@@ -32,6 +34,9 @@ type ApiTokenForm struct {
 	Environment string `form:"environment" json:"environment"`
 }
 
+// tokenSorts are the columns token lists can be sorted on.
+var tokenSorts = []string{"name", "created_at"}
+
 func init() {
 	Register(new(ApiTokenHandler))
 }
@@ -60,18 +65,35 @@ func (h *ApiTokenHandler) Index(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), projectID)
+	page, err := pageParams(ctx, tokenSorts, "created_at")
+	if err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	tokens, err := h.ORM.ApiToken.Query().
+	query := h.ORM.ApiToken.Query().
 		Where(apitoken.ProjectID(projectID)).
-		Order(apitoken.ByCreatedAt()).
-		All(ctx.Request().Context())
+		Where(predicate.ApiToken(page.Search(apitoken.FieldName)))
+	total, err := query.Clone().Count(reqCtx)
+	if err != nil {
+		return fail(err, "failed to count API tokens", h.Inertia, ctx)
+	}
+
+	tokens, err := query.
+		Where(predicate.ApiToken(page.After())).
+		Order(apitoken.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		All(reqCtx)
 	if err != nil {
 		return fail(err, "failed to load API tokens", h.Inertia, ctx)
 	}
+	tokens, next := pager.Trim(page, tokens, func(t *ent.ApiToken) int { return t.ID })
 
 	tokenList := make([]map[string]any, 0, len(tokens))
 	for _, t := range tokens {
@@ -94,7 +116,8 @@ func (h *ApiTokenHandler) Index(ctx echo.Context) error {
 				"id":   p.ID,
 				"name": p.Name,
 			},
-			"tokens": tokenList,
+			"tokens": pager.NewPage(tokenList, next, total),
+			"list":   listState(page),
 		},
 	)
 }
@@ -105,13 +128,13 @@ func (h *ApiTokenHandler) Create(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), projectID)
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
 	envs, err := h.ORM.Environment.Query().
-		Where(environment.ProjectID(projectID)).
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
 		All(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to load environments", h.Inertia, ctx)
@@ -166,6 +189,7 @@ func (h *ApiTokenHandler) Store(ctx echo.Context) error {
 			Where(
 				environment.Name(f.Environment),
 				environment.ProjectID(projectID),
+				environment.DeletedAtIsNil(),
 			).
 			Exist(ctx.Request().Context())
 		if err != nil || !exists {
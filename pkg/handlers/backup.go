@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/pkg/log"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// BackupHandler lists backups and takes one on demand. A backup copies every
+// project, so it is left to dashboard admins rather than the admin API,
+// whose tokens belong to a single project.
+type BackupHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+	Backup  *services.BackupService
+}
+
+func init() {
+	Register(new(BackupHandler))
+}
+
+func (h *BackupHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	h.Backup = c.Backup
+	return nil
+}
+
+func (h *BackupHandler) Routes(g *echo.Group) {
+	backups := g.Group("/backups", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin"))
+	backups.GET("", h.Index).Name = routenames.BackupIndex
+	backups.POST("", h.Store).Name = routenames.BackupStore
+}
+
+func (h *BackupHandler) Index(ctx echo.Context) error {
+	backups, err := h.Backup.List()
+	if err != nil {
+		return fail(err, "failed to list backups", h.Inertia, ctx)
+	}
+
+	type backupItem struct {
+		Name      string `json:"name"`
+		Size      int64  `json:"size"`
+		CreatedAt string `json:"createdAt"`
+	}
+
+	items := make([]backupItem, 0, len(backups))
+	for _, b := range backups {
+		items = append(items, backupItem{
+			Name:      b.Name,
+			Size:      b.Size,
+			CreatedAt: b.CreatedAt.Format("Jan 2, 2006 15:04"),
+		})
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Backups",
+		inertia.Props{
+			"backups": items,
+		},
+	)
+}
+
+// Store takes a backup through the same pipeline as scheduled ones: the
+// backup directory, the S3 upload and retention. Errors are logged; the
+// page only says what failed.
+func (h *BackupHandler) Store(ctx echo.Context) error {
+	info, err := h.Backup.Run(ctx.Request().Context())
+	switch {
+	case err != nil && info == nil:
+		log.Ctx(ctx).Error("backup failed", "error", err)
+		msg.Danger(ctx, "Backup failed; see the server logs.")
+	case err != nil:
+		log.Ctx(ctx).Error("backup incomplete", "backup", info.Name, "error", err)
+		msg.Warning(ctx, "Backup "+info.Name+" was written, but uploading it or applying retention failed; see the server logs.")
+	default:
+		log.Ctx(ctx).Info("backup taken", "backup", info.Name, "actor", userActor(ctx))
+		msg.Success(ctx, "Backup "+info.Name+" taken.")
+	}
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/ent/user"
+)
+
+func TestBackups_Store(t *testing.T) {
+	before, err := c.Backup.List()
+	require.NoError(t, err)
+
+	editor := dashboardClient(t, user.RoleEditor)
+	resp, err := dashboardPost(t, editor, "/backups", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+	after, err := c.Backup.List()
+	require.NoError(t, err)
+	assert.Len(t, after, len(before))
+
+	admin := dashboardClient(t, user.RoleAdmin)
+	resp, err = dashboardPost(t, admin, "/backups", nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Less(t, resp.StatusCode, 400)
+
+	after, err = c.Backup.List()
+	require.NoError(t, err)
+	assert.Len(t, after, len(before)+1)
+}
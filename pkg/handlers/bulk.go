@@ -0,0 +1,426 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// Operations of a bulk request.
+const (
+	BulkToggle        = "toggle"
+	BulkSetStrategies = "set_strategies"
+	BulkDelete        = "delete"
+	BulkTag           = "tag"
+)
+
+// maxBulkItems bounds the flag × environment pairs of one bulk request.
+const maxBulkItems = 1000
+
+// BulkRequest is the body of the bulk flag endpoints of the admin API and the
+// project page.
+type BulkRequest struct {
+	// ContinueOnError commits every item on its own and reports the failed
+	// ones. By default the whole request is one transaction and the first
+	// failure rolls everything back.
+	ContinueOnError bool            `json:"continue_on_error"`
+	Operations      []BulkOperation `json:"operations"`
+}
+
+// BulkOperation applies one change to several flags. Toggle and
+// set_strategies apply to every pair of FlagIDs and EnvironmentIDs; delete
+// and tag to each flag.
+type BulkOperation struct {
+	Op             string          `json:"op"`
+	FlagIDs        []int           `json:"flag_ids"`
+	EnvironmentIDs []int           `json:"environment_ids"`
+	Enabled        *bool           `json:"enabled"`
+	Strategies     []StrategyInput `json:"strategies"`
+	AddTags        []string        `json:"add_tags"`
+	RemoveTags     []string        `json:"remove_tags"`
+}
+
+// BulkResult is the outcome of one item of a bulk request.
+type BulkResult struct {
+	Operation     int    `json:"operation"`
+	Op            string `json:"op"`
+	FlagID        int    `json:"flag_id"`
+	Flag          string `json:"flag"`
+	EnvironmentID int    `json:"environment_id,omitempty"`
+	Environment   string `json:"environment,omitempty"`
+	OK            bool   `json:"ok"`
+	Error         string `json:"error,omitempty"`
+}
+
+// bulkItem is an operation on one flag, or one flag in one environment.
+type bulkItem struct {
+	index int
+	op    *BulkOperation
+	flag  *ent.Flag
+	env   *ent.Environment
+}
+
+func (it bulkItem) result() BulkResult {
+	r := BulkResult{Operation: it.index, Op: it.op.Op, FlagID: it.flag.ID, Flag: it.flag.Name}
+	if it.env != nil {
+		r.EnvironmentID = it.env.ID
+		r.Environment = it.env.Name
+	}
+	return r
+}
+
+// bulkRun carries a validated bulk request through its execution.
+type bulkRun struct {
+	ctx       echo.Context
+	orm       *ent.Client
+	flags     *services.FlagService
+	trash     *services.TrashService
+	projectID int
+	actor     string
+
+	items []bulkItem
+
+	// committed collects the changes of committed items.
+	committed bulkChanges
+}
+
+// bulkChanges is what items changed: the events of config changes, and
+// whether flags were deleted or retagged, which concerns the whole project.
+type bulkChanges struct {
+	events  []services.FlagEvent
+	project bool
+}
+
+// merge adds the changes of other.
+func (c *bulkChanges) merge(other bulkChanges) {
+	c.events = append(c.events, other.events...)
+	c.project = c.project || other.project
+}
+
+// bulkFlags validates a bulk request and runs it, then emits the events of
+// the committed config changes. Invalid requests return the errors by field
+// and change nothing. failed reports whether an item failed;
+// without ContinueOnError nothing was then committed.
+func bulkFlags(ctx echo.Context, orm *ent.Client, flags *services.FlagService, trash *services.TrashService, hub *services.Hub, projectID int, req BulkRequest, actor string) (results []BulkResult, failed bool, fields map[string]string, err error) {
+	run := &bulkRun{
+		ctx:       ctx,
+		orm:       orm,
+		flags:     flags,
+		trash:     trash,
+		projectID: projectID,
+		actor:     actor,
+	}
+
+	fields, err = run.prepare(req)
+	if err != nil || len(fields) > 0 {
+		return nil, false, fields, err
+	}
+
+	if req.ContinueOnError {
+		results, failed = run.eachItem()
+	} else {
+		results, failed, err = run.allItems()
+		if err != nil {
+			return nil, false, nil, err
+		}
+	}
+
+	flags.Emit(ctx.Request().Context(), run.committed.events)
+	if run.committed.project {
+		hub.NotifyProject(projectID)
+	}
+	return results, failed, nil, nil
+}
+
+// prepare validates the request and expands it into items.
+func (r *bulkRun) prepare(req BulkRequest) (map[string]string, error) {
+	reqCtx := r.ctx.Request().Context()
+	fields := map[string]string{}
+
+	if len(req.Operations) == 0 {
+		fields["operations"] = "At least one operation is required"
+		return fields, nil
+	}
+
+	flags, err := r.orm.Flag.Query().
+		Where(entflag.ProjectID(r.projectID), entflag.DeletedAtIsNil()).
+		All(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	flagsByID := make(map[int]*ent.Flag, len(flags))
+	for _, f := range flags {
+		flagsByID[f.ID] = f
+	}
+
+	envs, err := r.orm.Environment.Query().
+		Where(environment.ProjectID(r.projectID), environment.DeletedAtIsNil()).
+		All(reqCtx)
+	if err != nil {
+		return nil, err
+	}
+	envsByID := make(map[int]*ent.Environment, len(envs))
+	for _, e := range envs {
+		envsByID[e.ID] = e
+	}
+
+	var reg *declarative.Registry
+	for i := range req.Operations {
+		op := &req.Operations[i]
+		prefix := fmt.Sprintf("operations[%d].", i)
+
+		perEnv := op.Op == BulkToggle || op.Op == BulkSetStrategies
+		switch op.Op {
+		case BulkToggle:
+			if op.Enabled == nil {
+				fields[prefix+"enabled"] = "Enabled is required"
+			}
+		case BulkSetStrategies:
+			if reg == nil {
+				if reg, err = declarative.ProjectRegistry(reqCtx, r.orm, r.projectID); err != nil {
+					return nil, err
+				}
+			}
+			for j, si := range op.Strategies {
+				for k, v := range si.Validate(reg, fmt.Sprintf("%sstrategies[%d].", prefix, j)) {
+					fields[k] = v
+				}
+			}
+		case BulkDelete:
+		case BulkTag:
+			op.AddTags = declarative.NormalizeTags(op.AddTags)
+			op.RemoveTags = declarative.NormalizeTags(op.RemoveTags)
+			if len(op.AddTags) == 0 && len(op.RemoveTags) == 0 {
+				fields[prefix+"add_tags"] = "Add or remove at least one tag"
+			}
+			for k, v := range declarative.ValidateFlagMetadata(op.AddTags, nil, nil, prefix+"add_") {
+				fields[k] = v
+			}
+		default:
+			fields[prefix+"op"] = "Op must be one of: toggle, set_strategies, delete, tag"
+			continue
+		}
+
+		if len(op.FlagIDs) == 0 {
+			fields[prefix+"flag_ids"] = "At least one flag is required"
+		}
+		for j, id := range op.FlagIDs {
+			if flagsByID[id] == nil {
+				fields[fmt.Sprintf("%sflag_ids[%d]", prefix, j)] = "Flag not found"
+			}
+		}
+		if perEnv && len(op.EnvironmentIDs) == 0 {
+			fields[prefix+"environment_ids"] = "At least one environment is required"
+		}
+		for j, id := range op.EnvironmentIDs {
+			if envsByID[id] == nil {
+				fields[fmt.Sprintf("%senvironment_ids[%d]", prefix, j)] = "Environment not found"
+			}
+		}
+		if len(fields) > 0 {
+			continue
+		}
+
+		for _, flagID := range op.FlagIDs {
+			if !perEnv {
+				r.items = append(r.items, bulkItem{index: i, op: op, flag: flagsByID[flagID]})
+				continue
+			}
+			for _, envID := range op.EnvironmentIDs {
+				r.items = append(r.items, bulkItem{index: i, op: op, flag: flagsByID[flagID], env: envsByID[envID]})
+			}
+		}
+	}
+
+	if len(fields) == 0 && len(r.items) > maxBulkItems {
+		fields["operations"] = fmt.Sprintf("At most %d flag and environment pairs are allowed", maxBulkItems)
+	}
+	return fields, nil
+}
+
+// allItems runs every item in one transaction, stopping at the first failure.
+func (r *bulkRun) allItems() ([]BulkResult, bool, error) {
+	reqCtx := r.ctx.Request().Context()
+	tx, err := r.orm.Tx(reqCtx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var pending bulkChanges
+	results := make([]BulkResult, 0, len(r.items))
+	for _, it := range r.items {
+		res := it.result()
+		if err := r.apply(tx.Client(), it, &pending); err != nil {
+			tx.Rollback()
+			res.Error = bulkError(err)
+			return append(results, res), true, nil
+		}
+		res.OK = true
+		results = append(results, res)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	r.committed.merge(pending)
+	return results, false, nil
+}
+
+// eachItem runs every item in its own transaction.
+func (r *bulkRun) eachItem() ([]BulkResult, bool) {
+	reqCtx := r.ctx.Request().Context()
+	results := make([]BulkResult, 0, len(r.items))
+	failed := false
+	for _, it := range r.items {
+		res := it.result()
+		var pending bulkChanges
+
+		tx, err := r.orm.Tx(reqCtx)
+		if err == nil {
+			if err = r.apply(tx.Client(), it, &pending); err != nil {
+				tx.Rollback()
+			} else {
+				err = tx.Commit()
+			}
+		}
+		if err != nil {
+			res.Error = bulkError(err)
+			failed = true
+		} else {
+			res.OK = true
+			r.committed.merge(pending)
+		}
+		results = append(results, res)
+	}
+	return results, failed
+}
+
+// apply runs one item on client, noting what it changed in pending.
+func (r *bulkRun) apply(client *ent.Client, it bulkItem, pending *bulkChanges) error {
+	reqCtx := r.ctx.Request().Context()
+
+	switch it.op.Op {
+	case BulkToggle, BulkSetStrategies:
+		p := services.FlagEnvPatch{Enabled: it.op.Enabled, Actor: r.actor}
+		if it.op.Op == BulkSetStrategies {
+			p = services.FlagEnvPatch{Strategies: &it.op.Strategies, Actor: r.actor}
+		}
+		events, err := r.flags.PatchMany(reqCtx, client, []services.FlagEnvUpdate{{Flag: it.flag, Environment: it.env, Patch: p}})
+		if err != nil {
+			return err
+		}
+		pending.events = append(pending.events, events...)
+
+	case BulkDelete:
+		if err := r.trash.TrashIn(reqCtx, client, services.TrashFlag, it.flag.ID); err != nil {
+			return err
+		}
+		// Flags trashed earlier in the same transaction no longer count as
+		// dependents.
+		dependents, err := declarative.Dependents(reqCtx, client, it.flag.ID)
+		if err != nil {
+			return err
+		}
+		if len(dependents) > 0 {
+			return &bulkItemError{fmt.Sprintf("Flag is a prerequisite of %q in %s", dependents[0].Flag, dependents[0].Environment)}
+		}
+		pending.project = true
+
+	case BulkTag:
+		f, err := client.Flag.Query().Where(entflag.ID(it.flag.ID)).WithTags().Only(reqCtx)
+		if err != nil {
+			return err
+		}
+		tags := make([]string, 0, len(f.Edges.Tags)+len(it.op.AddTags))
+		for _, t := range f.Edges.Tags {
+			if !slices.Contains(it.op.RemoveTags, t.Name) {
+				tags = append(tags, t.Name)
+			}
+		}
+		for _, t := range it.op.AddTags {
+			if !slices.Contains(tags, t) {
+				tags = append(tags, t)
+			}
+		}
+		if len(tags) > declarative.MaxTags {
+			return &bulkItemError{fmt.Sprintf("At most %d tags are allowed", declarative.MaxTags)}
+		}
+		if err := declarative.SetTags(reqCtx, client, r.projectID, f.ID, tags); err != nil {
+			return err
+		}
+		pending.project = true
+	}
+	return nil
+}
+
+// bulkItemError is the failure of an item the caller can fix.
+type bulkItemError struct {
+	msg string
+}
+
+func (e *bulkItemError) Error() string { return e.msg }
+
+// bulkError describes the failure of an item; internal errors are not
+// exposed.
+func bulkError(err error) string {
+	var ie *bulkItemError
+	var verr *declarative.ValidationError
+	switch {
+	case errors.As(err, &ie):
+		return ie.msg
+	case errors.As(err, &verr):
+		for _, msg := range verr.Fields {
+			return msg
+		}
+	case ent.IsNotFound(err):
+		return "Flag not found"
+	}
+	return "Failed to apply the change"
+}
+
+// bulkResponse answers a bulk request that ran. Without ContinueOnError a
+// failed item is 409, as nothing was committed.
+func bulkResponse(ctx echo.Context, req BulkRequest, results []BulkResult, failed bool) error {
+	if failed && !req.ContinueOnError {
+		return ctx.JSON(http.StatusConflict, map[string]any{
+			"error":   "An operation failed; nothing was changed",
+			"results": results,
+		})
+	}
+
+	succeeded := 0
+	for _, r := range results {
+		if r.OK {
+			succeeded++
+		}
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"results":   results,
+		"succeeded": succeeded,
+		"failed":    len(results) - succeeded,
+	})
+}
+
+// bulkEnvironmentIDs returns the environments a bulk request changes, for the
+// freeze check of the dashboard. Deleting a flag changes all of them.
+func bulkEnvironmentIDs(ctx echo.Context, orm *ent.Client, projectID int, req BulkRequest) []int {
+	var ids []int
+	for _, op := range req.Operations {
+		switch op.Op {
+		case BulkDelete:
+			return projectEnvironmentIDs(ctx, orm, projectID)
+		case BulkToggle, BulkSetStrategies:
+			ids = append(ids, op.EnvironmentIDs...)
+		}
+	}
+	return ids
+}
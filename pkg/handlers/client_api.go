@@ -4,24 +4,39 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand/v2"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 
+	"github.com/felipekafuri/bandeira/config"
 	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/environment"
 	entflag "github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
 	appctx "github.com/felipekafuri/bandeira/pkg/context"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 )
 
 type ClientAPI struct {
-	ORM *ent.Client
-	Hub *services.Hub
+	ORM          *ent.Client
+	Hub          *services.Hub
+	Metrics      *services.Metrics
+	StreamConfig config.StreamConfig
 }
 
 func init() {
@@ -31,25 +46,29 @@ func init() {
 func (h *ClientAPI) Init(c *services.Container) error {
 	h.ORM = c.ORM
 	h.Hub = c.Hub
+	h.Metrics = c.Metrics
+	h.StreamConfig = c.Config.Stream
 	return nil
 }
 
 func (h *ClientAPI) Routes(_ *echo.Group) {}
 
 func (h *ClientAPI) APIRoutes(api *echo.Group) {
-	v1 := api.Group("/v1", middleware.RequireTokenAuth(h.ORM, "client"))
+	v1 := api.Group("/v1", middleware.RequireTokenAuth(h.ORM, h.Metrics, "client"))
 	v1.GET("/flags", h.GetFlags).Name = routenames.APIGetFlags
 }
 
 func (h *ClientAPI) StreamAPIRoutes(g *echo.Group) {
-	g.GET("", h.Stream, middleware.RequireTokenAuth(h.ORM, "client")).Name = routenames.APIStreamFlags
+	g.GET("", h.Stream, middleware.RequireTokenAuth(h.ORM, h.Metrics, "client")).Name = routenames.APIStreamFlags
 }
 
 func (h *ClientAPI) GetFlags(ctx echo.Context) error {
 	tok := ctx.Get(appctx.APITokenKey).(*ent.ApiToken)
 	reqCtx := ctx.Request().Context()
 
-	payload, err := buildFlagPayload(reqCtx, h.ORM, tok.ProjectID, tok.Environment)
+	start := time.Now()
+	payload, err := buildFlagPayload(reqCtx, h.ORM, tok.ProjectID, tok.Environment, queryTags(ctx))
+	h.Metrics.ObserveFlagPayload("poll", time.Since(start))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to load flags")
 	}
@@ -62,6 +81,13 @@ func (h *ClientAPI) Stream(ctx echo.Context) error {
 	tok := ctx.Get(appctx.APITokenKey).(*ent.ApiToken)
 	reqCtx := ctx.Request().Context()
 
+	// Refuse new streams while shutting down so clients go elsewhere.
+	if !h.Hub.Accepting() {
+		retryAfter := int(math.Ceil(h.reconnectDelay().Seconds()))
+		ctx.Response().Header().Set("Retry-After", strconv.Itoa(retryAfter))
+		return echo.NewHTTPError(http.StatusServiceUnavailable, "Server is shutting down")
+	}
+
 	// Set SSE headers.
 	res := ctx.Response()
 	res.Header().Set("Content-Type", "text/event-stream")
@@ -70,8 +96,10 @@ func (h *ClientAPI) Stream(ctx echo.Context) error {
 	res.WriteHeader(http.StatusOK)
 	res.Flush()
 
+	tags := queryTags(ctx)
+
 	// Send initial flag state.
-	if err := writeSSEFlags(res, h.ORM, tok.ProjectID, tok.Environment); err != nil {
+	if err := h.writeSSEFlags(res, tok.ProjectID, tok.Environment, tags); err != nil {
 		return nil
 	}
 
@@ -81,15 +109,23 @@ func (h *ClientAPI) Stream(ctx echo.Context) error {
 	ticker := time.NewTicker(30 * time.Second)
 	defer ticker.Stop()
 
+	draining := h.Hub.Draining()
 	for {
 		select {
 		case <-reqCtx.Done():
 			return nil
+		case <-draining:
+			// Only once; the stream stays up until the client leaves or the
+			// hub is closed.
+			draining = nil
+			if err := writeSSEReconnect(res, h.reconnectDelay()); err != nil {
+				return nil
+			}
 		case _, ok := <-notify:
 			if !ok {
 				return nil
 			}
-			if err := writeSSEFlags(res, h.ORM, tok.ProjectID, tok.Environment); err != nil {
+			if err := h.writeSSEFlags(res, tok.ProjectID, tok.Environment, tags); err != nil {
 				return nil
 			}
 		case <-ticker.C:
@@ -101,9 +137,32 @@ func (h *ClientAPI) Stream(ctx echo.Context) error {
 	}
 }
 
+// reconnectDelay returns the configured reconnect delay plus a random share of
+// the jitter, so that clients don't all reconnect at the same moment.
+func (h *ClientAPI) reconnectDelay() time.Duration {
+	d := h.StreamConfig.ReconnectDelay
+	if h.StreamConfig.ReconnectJitter > 0 {
+		d += rand.N(h.StreamConfig.ReconnectJitter)
+	}
+	return d
+}
+
+// writeSSEReconnect asks the client to reconnect after delay, both with the
+// standard SSE retry field and a reconnect event for SDKs to act on.
+func writeSSEReconnect(res *echo.Response, delay time.Duration) error {
+	ms := delay.Milliseconds()
+	if _, err := fmt.Fprintf(res, "retry: %d\nevent: reconnect\ndata: {\"retry_ms\":%d}\n\n", ms, ms); err != nil {
+		return err
+	}
+	res.Flush()
+	return nil
+}
+
 // writeSSEFlags queries the current flag state and writes it as an SSE event.
-func writeSSEFlags(res *echo.Response, orm *ent.Client, projectID int, envName string) error {
-	payload, err := buildFlagPayload(context.Background(), orm, projectID, envName)
+func (h *ClientAPI) writeSSEFlags(res *echo.Response, projectID int, envName string, tags []string) error {
+	start := time.Now()
+	payload, err := buildFlagPayload(context.Background(), h.ORM, projectID, envName, tags)
+	h.Metrics.ObserveFlagPayload("stream", time.Since(start))
 	if err != nil {
 		return err
 	}
@@ -116,29 +175,89 @@ func writeSSEFlags(res *echo.Response, orm *ent.Client, projectID int, envName s
 }
 
 // buildFlagPayload queries all flags for a project+environment and returns
-// the JSON-encoded response. Shared by GetFlags and Stream.
-func buildFlagPayload(ctx context.Context, orm *ent.Client, projectID int, envName string) ([]byte, error) {
+// the JSON-encoded response. Shared by GetFlags and Stream. With tags set,
+// only flags carrying any of them are included.
+func buildFlagPayload(ctx context.Context, orm *ent.Client, projectID int, envName string, tags []string) (_ []byte, err error) {
+	ctx, span := otel.Tracer(services.TracerName).Start(ctx, "buildFlagPayload",
+		trace.WithAttributes(
+			attribute.Int("bandeira.project_id", projectID),
+			attribute.String("bandeira.environment", envName),
+			attribute.StringSlice("bandeira.tags", tags),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
 	env, err := orm.Environment.Query().
 		Where(
 			environment.Name(envName),
 			environment.ProjectID(projectID),
+			environment.DeletedAtIsNil(),
+			environment.HasProjectWith(project.DeletedAtIsNil()),
 		).
 		Only(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	flags, err := orm.Flag.Query().
-		Where(entflag.ProjectID(projectID)).
-		WithFlagEnvironments(func(q *ent.FlagEnvironmentQuery) {
-			q.Where(flagenvironment.EnvironmentID(env.ID))
-			q.WithStrategies(func(sq *ent.StrategyQuery) {
-				sq.WithConstraints()
-			})
-		}).
-		All(ctx)
-	if err != nil {
-		return nil, err
+	// load queries flags with their config in the environment.
+	load := func(ps ...predicate.Flag) ([]*ent.Flag, error) {
+		return orm.Flag.Query().
+			Where(entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+			Where(ps...).
+			WithFlagEnvironments(func(q *ent.FlagEnvironmentQuery) {
+				q.Where(flagenvironment.EnvironmentID(env.ID))
+				q.WithStrategies(func(sq *ent.StrategyQuery) {
+					sq.WithConstraints()
+				})
+				q.WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+					pq.WithParentFlag()
+				})
+			}).
+			All(ctx)
+	}
+
+	var flags []*ent.Flag
+	if len(tags) == 0 {
+		flags, err = load()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// The parents of the tagged flags are included whatever their tags,
+		// down to the last one: without them SDKs could not check the
+		// prerequisites and would turn the tagged flags off.
+		seen := map[int]bool{}
+		next, err := load(entflag.HasTagsWith(tag.NameIn(tags...)))
+		for len(next) > 0 && err == nil {
+			var parents []int
+			for _, f := range next {
+				seen[f.ID] = true
+			}
+			for _, f := range next {
+				for _, fe := range f.Edges.FlagEnvironments {
+					for _, p := range fe.Edges.Prerequisites {
+						if !seen[p.ParentFlagID] {
+							seen[p.ParentFlagID] = true
+							parents = append(parents, p.ParentFlagID)
+						}
+					}
+				}
+			}
+			flags = append(flags, next...)
+			next = nil
+			if len(parents) > 0 {
+				next, err = load(entflag.IDIn(parents...))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	type constraintDTO struct {
@@ -155,10 +274,20 @@ func buildFlagPayload(ctx context.Context, orm *ent.Client, projectID int, envNa
 		Constraints []constraintDTO `json:"constraints"`
 	}
 
+	// A flag with prerequisites evaluates as disabled unless every parent
+	// flag evaluates to the required state (and variant, when set), so
+	// clients can short-circuit before evaluating its strategies.
+	type prerequisiteDTO struct {
+		Flag    string `json:"flag"`
+		Enabled bool   `json:"enabled"`
+		Variant string `json:"variant,omitempty"`
+	}
+
 	type flagDTO struct {
-		Name       string        `json:"name"`
-		Enabled    bool          `json:"enabled"`
-		Strategies []strategyDTO `json:"strategies"`
+		Name          string            `json:"name"`
+		Enabled       bool              `json:"enabled"`
+		Strategies    []strategyDTO     `json:"strategies"`
+		Prerequisites []prerequisiteDTO `json:"prerequisites,omitempty"`
 	}
 
 	result := make([]flagDTO, 0, len(flags))
@@ -190,10 +319,32 @@ func buildFlagPayload(ctx context.Context, orm *ent.Client, projectID int, envNa
 				}
 				dto.Strategies = append(dto.Strategies, sd)
 			}
+
+			for _, p := range fe.Edges.Prerequisites {
+				dto.Prerequisites = append(dto.Prerequisites, prerequisiteDTO{
+					Flag:    p.Edges.ParentFlag.Name,
+					Enabled: p.Enabled,
+					Variant: p.Variant,
+				})
+			}
 		}
 
 		result = append(result, dto)
 	}
 
-	return json.Marshal(map[string]any{"flags": result})
+	// Custom strategies are sent so that SDKs can check they implement every
+	// strategy the flags use; the built-in ones are known to all of them.
+	custom, err := orm.StrategyDefinition.Query().
+		Where(strategydefinition.ProjectID(projectID)).
+		Order(ent.Asc(strategydefinition.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	definitions := make([]declarative.StrategyDefinition, 0, len(custom))
+	for _, d := range custom {
+		definitions = append(definitions, declarative.CustomStrategy(d))
+	}
+
+	return json.Marshal(map[string]any{"flags": result, "strategy_definitions": definitions})
 }
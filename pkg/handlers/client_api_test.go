@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/config"
+)
+
+func TestClientAPI_ReconnectDelay(t *testing.T) {
+	h := &ClientAPI{StreamConfig: config.StreamConfig{
+		ReconnectDelay:  time.Second,
+		ReconnectJitter: 5 * time.Second,
+	}}
+
+	seen := make(map[time.Duration]bool)
+	for range 50 {
+		d := h.reconnectDelay()
+		assert.GreaterOrEqual(t, d, time.Second)
+		assert.Less(t, d, 6*time.Second)
+		seen[d] = true
+	}
+	assert.Greater(t, len(seen), 1, "delays should be jittered")
+
+	h.StreamConfig.ReconnectJitter = 0
+	assert.Equal(t, time.Second, h.reconnectDelay())
+}
+
+func TestWriteSSEReconnect(t *testing.T) {
+	rec := httptest.NewRecorder()
+	res := echo.NewResponse(rec, echo.New())
+	require.NoError(t, writeSSEReconnect(res, 2500*time.Millisecond))
+	assert.Equal(t, "retry: 2500\nevent: reconnect\ndata: {\"retry_ms\":2500}\n\n", rec.Body.String())
+}
@@ -0,0 +1,334 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/form"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// ContextFieldHandler manages the context fields registered on a project.
+type ContextFieldHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+}
+
+type ContextFieldForm struct {
+	form.Submission
+
+	Name        string `form:"name" json:"name" validate:"required"`
+	Description string `form:"description" json:"description"`
+	Type        string `form:"type" json:"type" validate:"required"`
+	// LegalValues holds one value per line or comma.
+	LegalValues string `form:"legal_values" json:"legal_values"`
+}
+
+// field validates the form and returns the context field it describes.
+func (f *ContextFieldForm) field() (declarative.ContextField, bool) {
+	cf := declarative.ContextField{
+		Name:        strings.TrimSpace(f.Name),
+		Description: f.Description,
+		Type:        f.Type,
+		LegalValues: declarative.SplitList(f.LegalValues),
+	}
+	errs := declarative.ValidateContextField(cf)
+	keys := map[string]string{"name": "Name", "type": "Type", "legal_values": "LegalValues"}
+	for key, message := range errs {
+		f.SetFieldError(keys[key], message)
+	}
+	return cf, len(errs) == 0
+}
+
+func init() {
+	Register(new(ContextFieldHandler))
+}
+
+func (h *ContextFieldHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	return nil
+}
+
+func (h *ContextFieldHandler) Routes(g *echo.Group) {
+	fields := g.Group("/projects/:projectId/context-fields", middleware.RequireAuth())
+	fields.GET("", h.Index).Name = routenames.ContextFieldIndex
+
+	mut := g.Group("/projects/:projectId/context-fields", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
+	mut.GET("/create", h.Create).Name = routenames.ContextFieldCreate
+	mut.POST("", h.Store).Name = routenames.ContextFieldStore
+	mut.GET("/:id/edit", h.Edit).Name = routenames.ContextFieldEdit
+	mut.PUT("/:id", h.Update).Name = routenames.ContextFieldUpdate
+	mut.DELETE("/:id", h.Delete).Name = routenames.ContextFieldDelete
+}
+
+func (h *ContextFieldHandler) Index(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	fields, err := declarative.ProjectContextFields(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return fail(err, "failed to load context fields", h.Inertia, ctx)
+	}
+
+	// usedBy lists the flags testing each registered field, which then cannot
+	// be deleted.
+	usedBy := map[string][]string{}
+	for _, f := range fields {
+		if f.Builtin {
+			continue
+		}
+		flags, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, f.Name)
+		if err != nil {
+			return fail(err, "failed to load context field usage", h.Inertia, ctx)
+		}
+		usedBy[f.Name] = flags
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/ContextFields/Index",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"contextFields": fields,
+			"operators":     declarative.OperatorsByType(),
+			"usedBy":        usedBy,
+		},
+	)
+}
+
+func (h *ContextFieldHandler) Create(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/ContextFields/Form",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"types": declarative.ContextFieldTypes,
+		},
+	)
+}
+
+func (h *ContextFieldHandler) Store(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
+
+	var f ContextFieldForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	cf, ok := f.field()
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	_, err = h.ORM.ContextField.Create().
+		SetName(cf.Name).
+		SetNillableDescription(nilIfEmpty(cf.Description)).
+		SetType(contextfield.Type(cf.Type)).
+		SetLegalValues(cf.LegalValues).
+		SetProjectID(projectID).
+		Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		f.SetFieldError("Name", "A context field with this name already exists.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+	if err != nil {
+		return fail(err, "failed to create context field", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Context field created successfully.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/context-fields", projectID))
+}
+
+func (h *ContextFieldHandler) Edit(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	cf, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Context field not found")
+	}
+
+	usedBy, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, cf.Name)
+	if err != nil {
+		return fail(err, "failed to load context field usage", h.Inertia, ctx)
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/ContextFields/Form",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"types":        declarative.ContextFieldTypes,
+			"contextField": declarative.ContextFieldFromEnt(cf),
+			"usedBy":       usedBy,
+		},
+	)
+}
+
+func (h *ContextFieldHandler) Update(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	cf, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Context field not found")
+	}
+
+	var f ContextFieldForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	field, ok := f.field()
+	if ok && (field.Name != cf.Name || field.Type != string(cf.Type)) {
+		flags, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, cf.Name)
+		if err != nil {
+			return fail(err, "failed to check context field usage", h.Inertia, ctx)
+		}
+		if len(flags) > 0 {
+			f.SetFieldError("Name", fmt.Sprintf("Used by %s; the name and type cannot change.", strings.Join(flags, ", ")))
+			ok = false
+		}
+	}
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	_, err = cf.Update().
+		SetName(field.Name).
+		SetDescription(field.Description).
+		SetType(contextfield.Type(field.Type)).
+		SetLegalValues(field.LegalValues).
+		Save(reqCtx)
+	if ent.IsConstraintError(err) {
+		f.SetFieldError("Name", "A context field with this name already exists.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+	if err != nil {
+		return fail(err, "failed to update context field", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Context field updated successfully.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/context-fields", projectID))
+}
+
+func (h *ContextFieldHandler) Delete(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	cf, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Context field not found")
+	}
+
+	flags, err := declarative.ContextFieldUsage(reqCtx, h.ORM, projectID, cf.Name)
+	if err != nil {
+		return fail(err, "failed to check context field usage", h.Inertia, ctx)
+	}
+	if len(flags) > 0 {
+		msg.Danger(ctx, fmt.Sprintf("Context field %s is used by %s and cannot be deleted.", cf.Name, strings.Join(flags, ", ")))
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	if err := h.ORM.ContextField.DeleteOne(cf).Exec(reqCtx); err != nil {
+		return fail(err, "failed to delete context field", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Context field deleted.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/context-fields", projectID))
+}
+
+func (h *ContextFieldHandler) find(ctx echo.Context, projectID int) (*ent.ContextField, error) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.ContextField.Query().
+		Where(contextfield.ID(id), contextfield.ProjectID(projectID)).
+		Only(ctx.Request().Context())
+}
@@ -5,6 +5,8 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/project"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
@@ -34,14 +36,25 @@ func (h *Dashboard) Routes(g *echo.Group) {
 func (h *Dashboard) Index(ctx echo.Context) error {
 	reqCtx := ctx.Request().Context()
 
-	projectCount, _ := h.ORM.Project.Query().Count(reqCtx)
-	flagCount, _ := h.ORM.Flag.Query().Count(reqCtx)
-	environmentCount, _ := h.ORM.Environment.Query().Count(reqCtx)
+	projectCount, _ := h.ORM.Project.Query().
+		Where(project.DeletedAtIsNil()).
+		Count(reqCtx)
+	flagCount, _ := h.ORM.Flag.Query().
+		Where(entflag.DeletedAtIsNil(), entflag.HasProjectWith(project.DeletedAtIsNil())).
+		Count(reqCtx)
+	environmentCount, _ := h.ORM.Environment.Query().
+		Where(environment.DeletedAtIsNil(), environment.HasProjectWith(project.DeletedAtIsNil())).
+		Count(reqCtx)
 
 	recentProjects, _ := h.ORM.Project.
 		Query().
-		WithFlags().
-		WithEnvironments().
+		Where(project.DeletedAtIsNil()).
+		WithFlags(func(q *ent.FlagQuery) {
+			q.Where(entflag.DeletedAtIsNil())
+		}).
+		WithEnvironments(func(q *ent.EnvironmentQuery) {
+			q.Where(environment.DeletedAtIsNil())
+		}).
 		Order(project.ByCreatedAt(sql.OrderDesc())).
 		Limit(5).
 		All(reqCtx)
@@ -23,6 +23,7 @@ func (h *Docs) Init(c *services.Container) error {
 
 func (h *Docs) Routes(g *echo.Group) {
 	g.GET("/docs", h.Index).Name = routenames.Docs
+	g.GET("/docs/api", h.API).Name = routenames.DocsAPI
 }
 
 func (h *Docs) Index(ctx echo.Context) error {
@@ -33,3 +34,15 @@ func (h *Docs) Index(ctx echo.Context) error {
 		inertia.Props{},
 	)
 }
+
+// API renders the explorer of the OpenAPI document.
+func (h *Docs) API(ctx echo.Context) error {
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"ApiExplorer",
+		inertia.Props{
+			"specUrl": ctx.Echo().Reverse(routenames.OpenAPISpec),
+		},
+	)
+}
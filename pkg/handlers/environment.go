@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -20,6 +21,8 @@ import (
 type Environment struct {
 	Inertia *inertia.Inertia
 	ORM     *ent.Client
+	Hub     *services.Hub
+	Trash   *services.TrashService
 }
 
 type EnvironmentForm struct {
@@ -28,6 +31,10 @@ type EnvironmentForm struct {
 	Name      string `form:"name" json:"name" validate:"required"`
 	Type      string `form:"type" json:"type" validate:"required,oneof=development staging production"`
 	SortOrder string `form:"sort_order" json:"sort_order"`
+
+	// ExpectedMatchID is the environment this one should mirror; empty or
+	// "none" clears it.
+	ExpectedMatchID string `form:"expected_match_id" json:"expected_match_id"`
 }
 
 func init() {
@@ -37,6 +44,8 @@ func init() {
 func (h *Environment) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.ORM = c.ORM
+	h.Hub = c.Hub
+	h.Trash = c.Trash
 	return nil
 }
 
@@ -56,7 +65,7 @@ func (h *Environment) Create(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), projectID)
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
@@ -79,6 +88,9 @@ func (h *Environment) Store(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
 
 	var f EnvironmentForm
 	if err := form.Submit(ctx, &f); err != nil {
@@ -87,6 +99,20 @@ func (h *Environment) Store(ctx echo.Context) error {
 		return nil
 	}
 
+	// Names of environments in the trash stay reserved until they are purged.
+	exists, err := h.ORM.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.Name(f.Name)).
+		Exist(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to check environment name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "An environment with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
 	sortOrder := 0
 	if f.SortOrder != "" {
 		sortOrder, _ = strconv.Atoi(f.SortOrder)
@@ -118,16 +144,35 @@ func (h *Environment) Edit(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), projectID)
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	env, err := h.ORM.Environment.Get(ctx.Request().Context(), id)
+	env, err := h.ORM.Environment.Query().
+		Where(environment.ID(id), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
 	}
 
+	others, err := h.ORM.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.IDNEQ(id), environment.DeletedAtIsNil()).
+		Order(ent.Asc(environment.FieldSortOrder)).
+		All(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to load environments", h.Inertia, ctx)
+	}
+	envItems := make([]map[string]any, len(others))
+	for i, o := range others {
+		envItems[i] = map[string]any{"id": o.ID, "name": o.Name}
+	}
+
+	expectedMatchID := 0
+	if env.ExpectedMatchID != nil {
+		expectedMatchID = *env.ExpectedMatchID
+	}
+
 	return h.Inertia.Render(
 		ctx.Response().Writer,
 		ctx.Request(),
@@ -142,7 +187,10 @@ func (h *Environment) Edit(ctx echo.Context) error {
 				"name":      env.Name,
 				"type":      string(env.Type),
 				"sortOrder": env.SortOrder,
+				// 0 when the environment is not expected to match another.
+				"expectedMatchId": expectedMatchID,
 			},
+			"environments": envItems,
 		},
 	)
 }
@@ -157,6 +205,9 @@ func (h *Environment) Update(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, id) {
+		return nil
+	}
 
 	var f EnvironmentForm
 	if err := form.Submit(ctx, &f); err != nil {
@@ -165,17 +216,43 @@ func (h *Environment) Update(ctx echo.Context) error {
 		return nil
 	}
 
+	exists, err := h.ORM.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.Name(f.Name), environment.IDNEQ(id)).
+		Exist(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to check environment name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "An environment with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
 	sortOrder := 0
 	if f.SortOrder != "" {
 		sortOrder, _ = strconv.Atoi(f.SortOrder)
 	}
 
-	_, err = h.ORM.Environment.
+	update := h.ORM.Environment.
 		UpdateOneID(id).
 		SetName(f.Name).
 		SetType(environment.Type(f.Type)).
-		SetSortOrder(sortOrder).
-		Save(ctx.Request().Context())
+		SetSortOrder(sortOrder)
+	if f.ExpectedMatchID == "" || f.ExpectedMatchID == "none" {
+		update.ClearExpectedMatchID()
+	} else {
+		matchID, err := strconv.Atoi(f.ExpectedMatchID)
+		if err != nil || !expectedMatchInProject(ctx.Request().Context(), h.ORM, projectID, id, matchID) {
+			f.SetFieldError("ExpectedMatchID", "Must be another environment of this project.")
+			form.ShareErrors(ctx, &f)
+			h.Inertia.Back(ctx.Response(), ctx.Request())
+			return nil
+		}
+		update.SetExpectedMatchID(matchID)
+	}
+
+	_, err = update.Save(ctx.Request().Context())
 	if err != nil {
 		return fail(err, "failed to update environment", h.Inertia, ctx)
 	}
@@ -194,11 +271,34 @@ func (h *Environment) Delete(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, id) {
+		return nil
+	}
 
-	if err := h.ORM.Environment.DeleteOneID(id).Exec(ctx.Request().Context()); err != nil {
+	env, err := h.ORM.Environment.Query().
+		Where(environment.ID(id), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
+	}
+
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashEnvironment, env.ID); err != nil {
 		return fail(err, "failed to delete environment", h.Inertia, ctx)
 	}
+	h.Hub.Notify(projectID, env.Name)
 
-	msg.Success(ctx, "Environment deleted successfully.")
+	msg.Success(ctx, "Environment moved to the trash.")
 	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d", projectID))
 }
+
+// expectedMatchInProject reports whether matchID is an environment of the
+// project other than envID, so it can be declared as envID's expected match.
+func expectedMatchInProject(ctx context.Context, orm *ent.Client, projectID, envID, matchID int) bool {
+	if matchID == envID {
+		return false
+	}
+	exists, err := orm.Environment.Query().
+		Where(environment.ID(matchID), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Exist(ctx)
+	return err == nil && exists
+}
@@ -1,19 +1,23 @@
 package handlers
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/felipekafuri/bandeira/ent"
-	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/environment"
 	entflag "github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/tag"
+	appctx "github.com/felipekafuri/bandeira/pkg/context"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
 	"github.com/felipekafuri/bandeira/pkg/form"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
 	"github.com/felipekafuri/bandeira/pkg/msg"
@@ -26,6 +30,8 @@ type FlagHandler struct {
 	Inertia *inertia.Inertia
 	ORM     *ent.Client
 	Hub     *services.Hub
+	Trash   *services.TrashService
+	Flags   *services.FlagService
 }
 
 type FlagForm struct {
@@ -34,23 +40,38 @@ type FlagForm struct {
 	Name        string `form:"name" json:"name" validate:"required"`
 	Description string `form:"description" json:"description"`
 	FlagType    string `form:"flag_type" json:"flag_type" validate:"required,oneof=release experiment operational kill_switch"`
+	Owner       string `form:"owner" json:"owner"`
+	// Tags is a comma-separated list of tag names.
+	Tags     string             `form:"tags" json:"tags"`
+	Links    []declarative.Link `json:"links"`
+	Metadata map[string]string  `json:"metadata"`
 }
 
-type StrategyInput struct {
-	EnvironmentID int                    `json:"environment_id"`
-	Name          string                 `json:"name"`
-	Parameters    map[string]interface{} `json:"parameters"`
-	SortOrder     int                    `json:"sort_order"`
-	Constraints   []ConstraintInput      `json:"constraints"`
+// validateMetadata normalizes the submitted tags and sets field errors for
+// invalid tags, links or metadata. It returns the tag names and whether the
+// form is still valid.
+func (f *FlagForm) validateMetadata() ([]string, bool) {
+	tags := declarative.NormalizeTags(strings.Split(f.Tags, ","))
+	errs := declarative.ValidateFlagMetadata(tags, f.Links, f.Metadata, "")
+	for key, message := range errs {
+		switch {
+		case strings.HasPrefix(key, "tags"):
+			f.SetFieldError("Tags", message)
+		case strings.HasPrefix(key, "links"):
+			f.SetFieldError("Links", message)
+		default:
+			f.SetFieldError("Metadata", message)
+		}
+	}
+	return tags, len(errs) == 0
 }
 
-type ConstraintInput struct {
-	ContextName     string   `json:"context_name"`
-	Operator        string   `json:"operator"`
-	Values          []string `json:"values"`
-	Inverted        bool     `json:"inverted"`
-	CaseInsensitive bool     `json:"case_insensitive"`
-}
+// StrategyInput and ConstraintInput are the strategies accepted by the
+// FlagService.
+type (
+	StrategyInput   = services.StrategyInput
+	ConstraintInput = services.ConstraintInput
+)
 
 func init() {
 	Register(new(FlagHandler))
@@ -60,6 +81,8 @@ func (h *FlagHandler) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.ORM = c.ORM
 	h.Hub = c.Hub
+	h.Trash = c.Trash
+	h.Flags = c.Flags
 	return nil
 }
 
@@ -68,6 +91,9 @@ func (h *FlagHandler) Routes(g *echo.Group) {
 	flags := g.Group("/projects/:projectId/flags", middleware.RequireAuth())
 	flags.GET("/:id/edit", h.Edit).Name = routenames.FlagEdit
 	flags.GET("/:id/strategies", h.ListStrategies).Name = routenames.StrategyList
+	flags.GET("/:id/prerequisites", h.ListPrerequisites).Name = routenames.PrerequisiteList
+	flags.GET("/:id/versions", h.ListVersions).Name = routenames.VersionList
+	flags.GET("/:id/versions/diff", h.DiffVersions).Name = routenames.VersionDiff
 
 	// Mutation routes require admin or editor role
 	mut := g.Group("/projects/:projectId/flags", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
@@ -75,10 +101,14 @@ func (h *FlagHandler) Routes(g *echo.Group) {
 	mut.POST("", h.Store).Name = routenames.FlagStore
 	mut.PUT("/:id", h.Update).Name = routenames.FlagUpdate
 	mut.DELETE("/:id", h.Delete).Name = routenames.FlagDelete
+	mut.POST("/bulk", h.Bulk).Name = routenames.FlagBulk
 	mut.POST("/:id/toggle", h.Toggle).Name = routenames.FlagToggle
+	mut.POST("/:id/promote", h.Promote).Name = routenames.FlagPromote
 	mut.POST("/:id/strategies", h.StoreStrategy).Name = routenames.StrategyStore
 	mut.PUT("/:id/strategies/:strategyId", h.UpdateStrategy).Name = routenames.StrategyUpdate
 	mut.DELETE("/:id/strategies/:strategyId", h.DeleteStrategy).Name = routenames.StrategyDelete
+	mut.PUT("/:id/prerequisites", h.UpdatePrerequisites).Name = routenames.PrerequisiteUpdate
+	mut.POST("/:id/versions/:version/restore", h.RestoreVersion).Name = routenames.VersionRestore
 }
 
 func (h *FlagHandler) Create(ctx echo.Context) error {
@@ -87,7 +117,7 @@ func (h *FlagHandler) Create(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), projectID)
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
@@ -110,6 +140,9 @@ func (h *FlagHandler) Store(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
 
 	var f FlagForm
 	if err := form.Submit(ctx, &f); err != nil {
@@ -118,14 +151,51 @@ func (h *FlagHandler) Store(ctx echo.Context) error {
 		return nil
 	}
 
-	_, err = h.ORM.Flag.
+	tags, ok := f.validateMetadata()
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	// Names of flags in the trash stay reserved until they are purged.
+	exists, err := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(projectID), entflag.Name(f.Name)).
+		Exist(reqCtx)
+	if err != nil {
+		return fail(err, "failed to check flag name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "A flag with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	tx, err := h.ORM.Tx(reqCtx)
+	if err != nil {
+		return fail(err, "failed to start transaction", h.Inertia, ctx)
+	}
+	created, err := tx.Flag.
 		Create().
 		SetName(f.Name).
 		SetNillableDescription(nilIfEmpty(f.Description)).
 		SetFlagType(entflag.FlagType(f.FlagType)).
+		SetNillableOwner(nilIfEmpty(strings.TrimSpace(f.Owner))).
+		SetLinks(declarative.LinksToEnt(f.Links)).
+		SetMetadata(f.Metadata).
 		SetProjectID(projectID).
-		Save(ctx.Request().Context())
+		Save(reqCtx)
+	if err == nil {
+		err = declarative.SetTags(reqCtx, tx.Client(), projectID, created.ID, tags)
+	}
 	if err != nil {
+		tx.Rollback()
+		return fail(err, "failed to create flag", h.Inertia, ctx)
+	}
+	if err := tx.Commit(); err != nil {
 		return fail(err, "failed to create flag", h.Inertia, ctx)
 	}
 
@@ -148,24 +218,30 @@ func (h *FlagHandler) Edit(ctx echo.Context) error {
 
 	reqCtx := ctx.Request().Context()
 
-	p, err := h.ORM.Project.Get(reqCtx, projectID)
+	p, err := findProject(reqCtx, h.ORM, projectID)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	f, err := h.ORM.Flag.Get(reqCtx, id)
+	f, err := h.ORM.Flag.Query().
+		Where(entflag.ID(id), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		WithTags().
+		Only(reqCtx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
 
 	type envItem struct {
-		ID        int    `json:"id"`
-		Name      string `json:"name"`
-		Type      string `json:"type"`
-		SortOrder int    `json:"sortOrder"`
+		ID        int            `json:"id"`
+		Name      string         `json:"name"`
+		Type      string         `json:"type"`
+		SortOrder int            `json:"sortOrder"`
+		Freeze    map[string]any `json:"freeze"`
 	}
 
-	environments, _ := p.QueryEnvironments().All(reqCtx)
+	environments, _ := p.QueryEnvironments().
+		Where(environment.DeletedAtIsNil()).
+		All(reqCtx)
 
 	envs := make([]envItem, 0, len(environments))
 	for _, e := range environments {
@@ -174,12 +250,14 @@ func (h *FlagHandler) Edit(ctx echo.Context) error {
 			Name:      e.Name,
 			Type:      string(e.Type),
 			SortOrder: e.SortOrder,
+			Freeze:    freezeProps(declarative.EnvironmentFreeze(e)),
 		})
 	}
 
 	type toggleState struct {
 		EnvironmentID int  `json:"environmentId"`
 		Enabled       bool `json:"enabled"`
+		Revision      int  `json:"revision"`
 	}
 
 	fes, _ := h.ORM.FlagEnvironment.
@@ -192,26 +270,51 @@ func (h *FlagHandler) Edit(ctx echo.Context) error {
 		toggles = append(toggles, toggleState{
 			EnvironmentID: fe.EnvironmentID,
 			Enabled:       fe.Enabled,
+			Revision:      fe.Revision,
 		})
 	}
 
+	dependents, _ := declarative.Dependents(reqCtx, h.ORM, id)
+	strategies, _ := declarative.ProjectStrategies(reqCtx, h.ORM, projectID)
+	contextFields, _ := declarative.ProjectContextFields(reqCtx, h.ORM, projectID)
+
+	// Candidate prerequisites: every other flag of the project.
+	others, _ := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(projectID), entflag.IDNEQ(id), entflag.DeletedAtIsNil()).
+		Order(entflag.ByName()).
+		All(reqCtx)
+	flagNames := make([]string, 0, len(others))
+	for _, o := range others {
+		flagNames = append(flagNames, o.Name)
+	}
+
 	return h.Inertia.Render(
 		ctx.Response().Writer,
 		ctx.Request(),
 		"Projects/Flags/Edit",
 		inertia.Props{
 			"project": map[string]any{
-				"id":   p.ID,
-				"name": p.Name,
+				"id":     p.ID,
+				"name":   p.Name,
+				"freeze": freezeProps(declarative.ProjectFreeze(p)),
 			},
 			"flag": map[string]any{
 				"id":          f.ID,
 				"name":        f.Name,
 				"description": f.Description,
 				"flagType":    string(f.FlagType),
+				"owner":       f.Owner,
+				"tags":        declarative.TagNames(f.Edges.Tags),
+				"links":       declarative.LinksFromEnt(f.Links),
+				"metadata":    f.Metadata,
 			},
-			"environments": envs,
-			"toggles":      toggles,
+			"environments":  envs,
+			"toggles":       toggles,
+			"dependents":    dependents,
+			"projectFlags":  flagNames,
+			"strategies":    strategies,
+			"contextFields": contextFields,
+			"operators":     declarative.OperatorsByType(),
 		},
 	)
 }
@@ -226,6 +329,9 @@ func (h *FlagHandler) Update(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID) {
+		return nil
+	}
 
 	var f FlagForm
 	if err := form.Submit(ctx, &f); err != nil {
@@ -234,16 +340,59 @@ func (h *FlagHandler) Update(ctx echo.Context) error {
 		return nil
 	}
 
-	_, err = h.ORM.Flag.
+	tags, ok := f.validateMetadata()
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	exists, err := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(projectID), entflag.Name(f.Name), entflag.IDNEQ(id)).
+		Exist(reqCtx)
+	if err != nil {
+		return fail(err, "failed to check flag name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "A flag with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	tx, err := h.ORM.Tx(reqCtx)
+	if err != nil {
+		return fail(err, "failed to start transaction", h.Inertia, ctx)
+	}
+	update := tx.Flag.
 		UpdateOneID(id).
 		SetName(f.Name).
 		SetNillableDescription(nilIfEmpty(f.Description)).
 		SetFlagType(entflag.FlagType(f.FlagType)).
-		Save(ctx.Request().Context())
+		SetLinks(declarative.LinksToEnt(f.Links)).
+		SetMetadata(f.Metadata)
+	if owner := strings.TrimSpace(f.Owner); owner == "" {
+		update.ClearOwner()
+	} else {
+		update.SetOwner(owner)
+	}
+	err = update.Exec(reqCtx)
+	if err == nil {
+		err = declarative.SetTags(reqCtx, tx.Client(), projectID, id, tags)
+	}
 	if err != nil {
+		tx.Rollback()
+		return fail(err, "failed to update flag", h.Inertia, ctx)
+	}
+	if err := tx.Commit(); err != nil {
 		return fail(err, "failed to update flag", h.Inertia, ctx)
 	}
 
+	// Tags select flags in client payloads, so they may have changed.
+	h.Hub.NotifyProject(projectID)
+
 	msg.Success(ctx, "Flag updated successfully.")
 	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d", projectID))
 }
@@ -258,18 +407,59 @@ func (h *FlagHandler) Delete(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, projectEnvironmentIDs(ctx, h.ORM, projectID)...) {
+		return nil
+	}
 
-	if err := h.ORM.Flag.DeleteOneID(id).Exec(ctx.Request().Context()); err != nil {
+	dependents, err := declarative.Dependents(ctx.Request().Context(), h.ORM, id)
+	if err != nil {
+		return fail(err, "failed to load dependents", h.Inertia, ctx)
+	}
+	if len(dependents) > 0 {
+		msg.Danger(ctx, fmt.Sprintf("Flag is a prerequisite of %q in %s; remove the dependency first.",
+			dependents[0].Flag, dependents[0].Environment))
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashFlag, id); err != nil {
 		return fail(err, "failed to delete flag", h.Inertia, ctx)
 	}
 
 	h.Hub.NotifyProject(projectID)
 
-	msg.Success(ctx, "Flag deleted successfully.")
+	msg.Success(ctx, "Flag moved to the trash.")
 	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d", projectID))
 }
 
-// Toggle creates or updates a FlagEnvironment record to toggle a flag's enabled state.
+// Bulk applies the multi-select actions of the project matrix; it takes the
+// same body as the bulk endpoint of the admin API.
+func (h *FlagHandler) Bulk(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	var req BulkRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid request body")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, bulkEnvironmentIDs(ctx, h.ORM, projectID, req)...) {
+		return nil
+	}
+
+	results, failed, fields, err := bulkFlags(ctx, h.ORM, h.Flags, h.Trash, h.Hub, projectID, req, userActor(ctx))
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to apply changes")
+	}
+	if len(fields) > 0 {
+		return jsonValidationError(ctx, fields)
+	}
+	return bulkResponse(ctx, req, results, failed)
+}
+
+// Toggle turns a flag on or off in one environment. A non-zero revision must
+// be the current one of the config.
 func (h *FlagHandler) Toggle(ctx echo.Context) error {
 	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
@@ -284,51 +474,62 @@ func (h *FlagHandler) Toggle(ctx echo.Context) error {
 	var body struct {
 		EnvironmentID int  `json:"environmentId"`
 		Enabled       bool `json:"enabled"`
+		Revision      int  `json:"revision"`
 	}
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Invalid request body")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, body.EnvironmentID) {
+		return nil
+	}
 
-	reqCtx := ctx.Request().Context()
+	ref := services.FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: body.EnvironmentID}
+	fe, err := h.Flags.SetEnabled(ctx.Request().Context(), ref, body.Enabled, body.Revision, userActor(ctx))
+	if err != nil {
+		return flagServiceError(ctx, h.ORM, err, "failed to toggle flag")
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]any{"enabled": fe.Enabled, "revision": fe.Revision})
+}
 
-	fe, err := getOrCreateFlagEnvironment(reqCtx, h.ORM, flagID, body.EnvironmentID)
+// Promote copies this flag's enabled state and/or strategies from one
+// environment to another. With dry_run set it only returns the diff.
+func (h *FlagHandler) Promote(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to toggle flag"})
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	_, err = fe.Update().SetEnabled(body.Enabled).Save(reqCtx)
+	flagID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to toggle flag"})
+		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
 
-	if env, err := h.ORM.Environment.Get(reqCtx, body.EnvironmentID); err == nil {
-		h.Hub.Notify(projectID, env.Name)
+	f, err := h.ORM.Flag.Query().
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]any{"error": "flag not found"})
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"enabled": body.Enabled})
-}
+	var opts declarative.PromoteOptions
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&opts); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "Invalid request body"})
+	}
+	opts.Flags = []string{f.Name}
+	opts.Actor = userActor(ctx)
 
-// getOrCreateFlagEnvironment finds or creates a FlagEnvironment record for the given flag+environment pair.
-func getOrCreateFlagEnvironment(ctx context.Context, orm *ent.Client, flagID, envID int) (*ent.FlagEnvironment, error) {
-	fe, err := orm.FlagEnvironment.
-		Query().
-		Where(
-			flagenvironment.FlagID(flagID),
-			flagenvironment.EnvironmentID(envID),
-		).
-		Only(ctx)
-	if err != nil {
-		if ent.IsNotFound(err) {
-			return orm.FlagEnvironment.
-				Create().
-				SetFlagID(flagID).
-				SetEnvironmentID(envID).
-				SetEnabled(false).
-				Save(ctx)
+	// A dry run changes nothing, so a freeze does not stop it.
+	if !opts.DryRun {
+		target, _ := h.ORM.Environment.Query().
+			Where(environment.ProjectID(projectID), environment.Name(opts.Target), environment.DeletedAtIsNil()).
+			IDs(ctx.Request().Context())
+		if frozen(ctx, h.ORM, h.Inertia, projectID, target...) {
+			return nil
 		}
-		return nil, err
 	}
-	return fe, nil
+
+	return promote(ctx, h.Flags, projectID, opts)
 }
 
 // ListStrategies returns all strategies (with constraints) for a flag+environment pair.
@@ -355,7 +556,7 @@ func (h *FlagHandler) ListStrategies(ctx echo.Context) error {
 		Only(reqCtx)
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return ctx.JSON(http.StatusOK, map[string]any{"strategies": []any{}})
+			return ctx.JSON(http.StatusOK, map[string]any{"strategies": []any{}, "revision": 0})
 		}
 		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to query"})
 	}
@@ -392,235 +593,315 @@ func (h *FlagHandler) ListStrategies(ctx echo.Context) error {
 		})
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"strategies": result})
+	return ctx.JSON(http.StatusOK, map[string]any{"strategies": result, "revision": fe.Revision})
 }
 
-// StoreStrategy creates a new strategy with constraints for a flag+environment pair.
-func (h *FlagHandler) StoreStrategy(ctx echo.Context) error {
+// ListPrerequisites returns the prerequisites of a flag+environment pair.
+func (h *FlagHandler) ListPrerequisites(ctx echo.Context) error {
 	flagID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
 
-	var input StrategyInput
-	if err := json.NewDecoder(ctx.Request().Body).Decode(&input); err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "Invalid request body"})
+	envID, err := strconv.Atoi(ctx.QueryParam("env"))
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "env query param required"})
+	}
+
+	fe, err := h.ORM.FlagEnvironment.
+		Query().
+		Where(
+			flagenvironment.FlagID(flagID),
+			flagenvironment.EnvironmentID(envID),
+		).
+		WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+			pq.WithParentFlag()
+		}).
+		Only(ctx.Request().Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return ctx.JSON(http.StatusOK, map[string]any{"prerequisites": []any{}, "revision": 0})
+		}
+		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to query"})
 	}
 
-	if input.Name == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "name is required"})
+	return ctx.JSON(http.StatusOK, map[string]any{
+		"prerequisites": prerequisiteDTOs(fe.Edges.Prerequisites),
+		"revision":      fe.Revision,
+	})
+}
+
+// UpdatePrerequisites replaces the prerequisites of a flag+environment pair.
+func (h *FlagHandler) UpdatePrerequisites(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Project not found")
 	}
 
-	reqCtx := ctx.Request().Context()
+	flagID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
 
-	fe, err := getOrCreateFlagEnvironment(reqCtx, h.ORM, flagID, input.EnvironmentID)
+	var body struct {
+		EnvironmentID int                        `json:"environment_id"`
+		Prerequisites []declarative.Prerequisite `json:"prerequisites"`
+		Revision      int                        `json:"revision"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, body.EnvironmentID) {
+		return nil
+	}
+
+	ref := services.FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: body.EnvironmentID}
+	fe, err := h.Flags.SetPrerequisites(ctx.Request().Context(), ref, body.Prerequisites, body.Revision, userActor(ctx))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to get/create flag environment"})
+		return flagServiceError(ctx, h.ORM, err, "Failed to update prerequisites")
 	}
 
-	tx, err := h.ORM.Tx(reqCtx)
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true, "revision": fe.Revision})
+}
+
+// strategyRequest is the body of the strategy endpoints of the edit page:
+// a strategy and the revision of the config it was read at.
+type strategyRequest struct {
+	StrategyInput
+	Revision int `json:"revision"`
+}
+
+// StoreStrategy creates a new strategy with constraints for a flag+environment pair.
+func (h *FlagHandler) StoreStrategy(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to start transaction"})
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	s, err := tx.Strategy.
-		Create().
-		SetName(input.Name).
-		SetParameters(input.Parameters).
-		SetSortOrder(input.SortOrder).
-		SetFlagEnvironmentID(fe.ID).
-		Save(reqCtx)
+	flagID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
-		tx.Rollback()
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to create strategy"})
-	}
-
-	constraints := make([]map[string]any, 0, len(input.Constraints))
-	for _, ci := range input.Constraints {
-		c, err := tx.Constraint.
-			Create().
-			SetContextName(ci.ContextName).
-			SetOperator(entconstraint.Operator(ci.Operator)).
-			SetValues(ci.Values).
-			SetInverted(ci.Inverted).
-			SetCaseInsensitive(ci.CaseInsensitive).
-			SetStrategyID(s.ID).
-			Save(reqCtx)
-		if err != nil {
-			tx.Rollback()
-			return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to create constraint"})
-		}
-		constraints = append(constraints, map[string]any{
-			"id":               c.ID,
-			"context_name":     c.ContextName,
-			"operator":         string(c.Operator),
-			"values":           c.Values,
-			"inverted":         c.Inverted,
-			"case_insensitive": c.CaseInsensitive,
-		})
+		return echo.NewHTTPError(http.StatusNotFound, "Flag not found")
 	}
 
-	if err := tx.Commit(); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to commit"})
+	var input strategyRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&input); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid request body")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, input.EnvironmentID) {
+		return nil
 	}
 
-	if env, err := h.ORM.Environment.Get(reqCtx, input.EnvironmentID); err == nil {
-		projectID, _ := strconv.Atoi(ctx.Param("projectId"))
-		h.Hub.Notify(projectID, env.Name)
+	ref := services.FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: input.EnvironmentID}
+	s, revision, err := h.Flags.CreateStrategy(ctx.Request().Context(), ref, input.StrategyInput, input.Revision, userActor(ctx))
+	if err != nil {
+		return flagServiceError(ctx, h.ORM, err, "failed to create strategy")
 	}
 
-	return ctx.JSON(http.StatusCreated, map[string]any{
-		"id":          s.ID,
-		"name":        s.Name,
-		"parameters":  s.Parameters,
-		"sort_order":  s.SortOrder,
-		"constraints": constraints,
-	})
+	dto := strategyDTOs([]*ent.Strategy{s})[0]
+	dto["revision"] = revision
+	return ctx.JSON(http.StatusCreated, dto)
 }
 
 // UpdateStrategy updates a strategy and replaces its constraints.
 func (h *FlagHandler) UpdateStrategy(ctx echo.Context) error {
-	strategyID, err := strconv.Atoi(ctx.Param("strategyId"))
-	if err != nil {
+	projectID, flagID, strategyID, ok := strategyParams(ctx)
+	if !ok {
 		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
 	}
 
-	var input StrategyInput
+	var input strategyRequest
 	if err := json.NewDecoder(ctx.Request().Body).Decode(&input); err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "Invalid request body"})
+		return jsonError(ctx, http.StatusBadRequest, "Invalid request body")
 	}
 
-	if input.Name == "" {
-		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "name is required"})
+	reqCtx := ctx.Request().Context()
+	env, err := h.Flags.StrategyEnvironment(reqCtx, projectID, flagID, strategyID)
+	if err != nil {
+		return flagServiceError(ctx, h.ORM, err, "failed to update strategy")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, env.ID) {
+		return nil
 	}
 
-	reqCtx := ctx.Request().Context()
+	s, revision, err := h.Flags.UpdateStrategy(reqCtx, projectID, flagID, strategyID, input.StrategyInput, input.Revision, userActor(ctx))
+	if err != nil {
+		return flagServiceError(ctx, h.ORM, err, "failed to update strategy")
+	}
 
-	// Capture env info for notification before the transaction.
-	envName := resolveEnvNameFromStrategy(reqCtx, h.ORM, strategyID)
+	dto := strategyDTOs([]*ent.Strategy{s})[0]
+	dto["revision"] = revision
+	return ctx.JSON(http.StatusOK, dto)
+}
 
-	tx, err := h.ORM.Tx(reqCtx)
+// DeleteStrategy deletes a strategy and its constraints. A non-zero revision
+// query parameter must be the current one of the config.
+func (h *FlagHandler) DeleteStrategy(ctx echo.Context) error {
+	projectID, flagID, strategyID, ok := strategyParams(ctx)
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
+	}
+	expected, _ := strconv.Atoi(ctx.QueryParam("revision"))
+
+	reqCtx := ctx.Request().Context()
+	env, err := h.Flags.StrategyEnvironment(reqCtx, projectID, flagID, strategyID)
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to start transaction"})
+		return flagServiceError(ctx, h.ORM, err, "failed to delete strategy")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, env.ID) {
+		return nil
 	}
 
-	s, err := tx.Strategy.
-		UpdateOneID(strategyID).
-		SetName(input.Name).
-		SetParameters(input.Parameters).
-		SetSortOrder(input.SortOrder).
-		Save(reqCtx)
+	revision, err := h.Flags.DeleteStrategy(reqCtx, projectID, flagID, strategyID, expected, userActor(ctx))
 	if err != nil {
-		tx.Rollback()
-		if ent.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, map[string]any{"error": "strategy not found"})
-		}
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to update strategy"})
+		return flagServiceError(ctx, h.ORM, err, "failed to delete strategy")
 	}
 
-	// Delete all existing constraints for this strategy.
-	_, err = tx.Constraint.Delete().
-		Where(entconstraint.StrategyID(strategyID)).
-		Exec(reqCtx)
+	return ctx.JSON(http.StatusOK, map[string]any{"ok": true, "revision": revision})
+}
+
+// strategyParams parses the project, flag and strategy IDs of a strategy
+// route.
+func strategyParams(ctx echo.Context) (projectID, flagID, strategyID int, ok bool) {
+	var err1, err2, err3 error
+	projectID, err1 = strconv.Atoi(ctx.Param("projectId"))
+	flagID, err2 = strconv.Atoi(ctx.Param("id"))
+	strategyID, err3 = strconv.Atoi(ctx.Param("strategyId"))
+	return projectID, flagID, strategyID, err1 == nil && err2 == nil && err3 == nil
+}
+
+// ListVersions returns the recorded configs of a flag+environment pair, newest
+// first.
+func (h *FlagHandler) ListVersions(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
-		tx.Rollback()
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to delete old constraints"})
-	}
-
-	// Recreate constraints from input.
-	constraints := make([]map[string]any, 0, len(input.Constraints))
-	for _, ci := range input.Constraints {
-		c, err := tx.Constraint.
-			Create().
-			SetContextName(ci.ContextName).
-			SetOperator(entconstraint.Operator(ci.Operator)).
-			SetValues(ci.Values).
-			SetInverted(ci.Inverted).
-			SetCaseInsensitive(ci.CaseInsensitive).
-			SetStrategyID(s.ID).
-			Save(reqCtx)
-		if err != nil {
-			tx.Rollback()
-			return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to create constraint"})
-		}
-		constraints = append(constraints, map[string]any{
-			"id":               c.ID,
-			"context_name":     c.ContextName,
-			"operator":         string(c.Operator),
-			"values":           c.Values,
-			"inverted":         c.Inverted,
-			"case_insensitive": c.CaseInsensitive,
-		})
+		return jsonError(ctx, http.StatusNotFound, "Project not found")
 	}
 
-	if err := tx.Commit(); err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to commit"})
+	flagID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
 	}
 
-	if envName != "" {
-		projectID, _ := strconv.Atoi(ctx.Param("projectId"))
-		h.Hub.Notify(projectID, envName)
+	envID, err := strconv.Atoi(ctx.QueryParam("env"))
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "env query param required")
 	}
 
-	return ctx.JSON(http.StatusOK, map[string]any{
-		"id":          s.ID,
-		"name":        s.Name,
-		"parameters":  s.Parameters,
-		"sort_order":  s.SortOrder,
-		"constraints": constraints,
-	})
+	return listVersions(ctx, h.ORM, projectID, flagID, envID)
 }
 
-// DeleteStrategy deletes a strategy and its constraints.
-func (h *FlagHandler) DeleteStrategy(ctx echo.Context) error {
-	strategyID, err := strconv.Atoi(ctx.Param("strategyId"))
+// DiffVersions returns the changes between two versions of a
+// flag+environment pair.
+func (h *FlagHandler) DiffVersions(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
-		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
+		return jsonError(ctx, http.StatusNotFound, "Project not found")
 	}
 
-	reqCtx := ctx.Request().Context()
+	flagID, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	envID, err := strconv.Atoi(ctx.QueryParam("env"))
+	if err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "env query param required")
+	}
 
-	// Capture env info for notification before deletion.
-	envName := resolveEnvNameFromStrategy(reqCtx, h.ORM, strategyID)
+	return diffVersions(ctx, h.ORM, projectID, flagID, envID)
+}
 
-	// Delete constraints first (SQLite has no FK cascade).
-	_, err = h.ORM.Constraint.Delete().
-		Where(entconstraint.StrategyID(strategyID)).
-		Exec(reqCtx)
+// RestoreVersion reverts a flag+environment pair to a recorded version.
+func (h *FlagHandler) RestoreVersion(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to delete constraints"})
+		return jsonError(ctx, http.StatusNotFound, "Project not found")
 	}
 
-	err = h.ORM.Strategy.DeleteOneID(strategyID).Exec(reqCtx)
+	flagID, err := strconv.Atoi(ctx.Param("id"))
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return ctx.JSON(http.StatusNotFound, map[string]any{"error": "strategy not found"})
-		}
-		return ctx.JSON(http.StatusInternalServerError, map[string]any{"error": "failed to delete strategy"})
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	version, err := strconv.Atoi(ctx.Param("version"))
+	if err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Version not found")
+	}
+
+	var body struct {
+		EnvironmentID int `json:"environment_id"`
+		Revision      int `json:"revision"`
+	}
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&body); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid JSON")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, body.EnvironmentID) {
+		return nil
+	}
+
+	return restoreVersion(ctx, h.ORM, h.Flags, projectID, flagID, body.EnvironmentID, version, body.Revision, userActor(ctx))
+}
 
-	if envName != "" {
-		projectID, _ := strconv.Atoi(ctx.Param("projectId"))
-		h.Hub.Notify(projectID, envName)
+// userActor names the signed-in user for version history.
+func userActor(ctx echo.Context) string {
+	if u, ok := ctx.Get(appctx.AuthKey).(*ent.User); ok {
+		return u.Email
 	}
+	return ""
+}
 
-	return ctx.JSON(http.StatusOK, map[string]any{"ok": true})
+// flagSorts are the columns flag lists can be sorted on.
+var flagSorts = []string{"name", "created_at", "updated_at"}
+
+// flagFilter narrows down the flags of a project. Zero fields match every
+// flag.
+type flagFilter struct {
+	// Tags matches flags with any of the tags.
+	Tags  []string `json:"tag"`
+	Owner string   `json:"owner"`
+	Type  string   `json:"type"`
+	// EnabledIn matches flags enabled in the environment of that name.
+	EnabledIn string `json:"enabled_in"`
 }
 
-// resolveEnvNameFromStrategy looks up a strategy's FlagEnvironment → Environment
-// to get the environment name. Returns "" if lookup fails (best-effort for notifications).
-func resolveEnvNameFromStrategy(ctx context.Context, orm *ent.Client, strategyID int) string {
-	s, err := orm.Strategy.Get(ctx, strategyID)
-	if err != nil {
-		return ""
+// parseFlagFilter reads a flagFilter from the tag, owner, type and
+// enabled_in query parameters.
+func parseFlagFilter(ctx echo.Context) flagFilter {
+	q := ctx.QueryParams()
+	return flagFilter{
+		Tags:      queryTags(ctx),
+		Owner:     strings.TrimSpace(q.Get("owner")),
+		Type:      q.Get("type"),
+		EnabledIn: q.Get("enabled_in"),
 	}
-	fe, err := orm.FlagEnvironment.Get(ctx, s.FlagEnvironmentID)
-	if err != nil {
-		return ""
+}
+
+// queryTags reads the tag query parameter, which may be repeated or hold a
+// comma-separated list.
+func queryTags(ctx echo.Context) []string {
+	var names []string
+	for _, v := range ctx.QueryParams()["tag"] {
+		names = append(names, strings.Split(v, ",")...)
 	}
-	env, err := orm.Environment.Get(ctx, fe.EnvironmentID)
-	if err != nil {
-		return ""
+	return declarative.NormalizeTags(names)
+}
+
+func (f flagFilter) predicates() []predicate.Flag {
+	var ps []predicate.Flag
+	if len(f.Tags) > 0 {
+		ps = append(ps, entflag.HasTagsWith(tag.NameIn(f.Tags...)))
+	}
+	if f.Owner != "" {
+		ps = append(ps, entflag.Owner(f.Owner))
+	}
+	if f.Type != "" {
+		ps = append(ps, entflag.FlagTypeEQ(entflag.FlagType(f.Type)))
+	}
+	if f.EnabledIn != "" {
+		ps = append(ps, entflag.HasFlagEnvironmentsWith(
+			flagenvironment.Enabled(true),
+			flagenvironment.HasEnvironmentWith(environment.Name(f.EnabledIn), environment.DeletedAtIsNil()),
+		))
 	}
-	return env.Name
+	return ps
 }
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/log"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// Helpers shared by the admin API and the flag edit page to return the config
+// of a flag in one environment. Changes go through services.FlagService.
+
+// flagEnvDTO loads the config of a flag environment as the admin API returns
+// it.
+func flagEnvDTO(ctx context.Context, orm *ent.Client, feID int) (map[string]any, error) {
+	fe, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.ID(feID)).
+		WithStrategies(func(sq *ent.StrategyQuery) {
+			sq.WithConstraints()
+			sq.Order(ent.Asc(strategy.FieldSortOrder))
+		}).
+		WithPrerequisites(func(pq *ent.PrerequisiteQuery) {
+			pq.WithParentFlag()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"environment_id": fe.EnvironmentID,
+		"flag_id":        fe.FlagID,
+		"enabled":        fe.Enabled,
+		"revision":       fe.Revision,
+		"strategies":     strategyDTOs(fe.Edges.Strategies),
+		"prerequisites":  prerequisiteDTOs(fe.Edges.Prerequisites),
+	}, nil
+}
+
+// strategyDTOs converts loaded strategies (with their constraints).
+func strategyDTOs(strategies []*ent.Strategy) []map[string]any {
+	out := make([]map[string]any, 0, len(strategies))
+	for _, s := range strategies {
+		constraints := make([]map[string]any, 0, len(s.Edges.Constraints))
+		for _, c := range s.Edges.Constraints {
+			constraints = append(constraints, map[string]any{
+				"id":               c.ID,
+				"context_name":     c.ContextName,
+				"operator":         string(c.Operator),
+				"values":           c.Values,
+				"inverted":         c.Inverted,
+				"case_insensitive": c.CaseInsensitive,
+			})
+		}
+		out = append(out, map[string]any{
+			"id":          s.ID,
+			"name":        s.Name,
+			"parameters":  s.Parameters,
+			"sort_order":  s.SortOrder,
+			"constraints": constraints,
+		})
+	}
+	return out
+}
+
+// revisionConflict answers a write based on a stale revision with 409, the
+// current config of the flag environment and its ETag. Shared by the admin
+// API and the flag edit page.
+func revisionConflict(ctx echo.Context, orm *ent.Client, feID int) error {
+	dto, err := flagEnvDTO(ctx.Request().Context(), orm, feID)
+	if err != nil {
+		return jsonError(ctx, http.StatusInternalServerError, "Failed to load flag environment")
+	}
+	ctx.Response().Header().Set("ETag", declarative.ETag(dto["revision"].(int)))
+	return ctx.JSON(http.StatusConflict, map[string]any{
+		"error":   "Flag changed since you read it",
+		"current": dto,
+	})
+}
+
+// prerequisiteDTOs converts loaded prerequisites (with their parent flag).
+func prerequisiteDTOs(prereqs []*ent.Prerequisite) []map[string]any {
+	out := make([]map[string]any, 0, len(prereqs))
+	for _, p := range prereqs {
+		out = append(out, map[string]any{
+			"flag_id": p.ParentFlagID,
+			"flag":    p.Edges.ParentFlag.Name,
+			"enabled": p.Enabled,
+			"variant": p.Variant,
+		})
+	}
+	return out
+}
+
+// flagServiceError answers a failed FlagService call: 404 for a flag,
+// environment or strategy outside the project, 409 for a stale revision and
+// 422 for invalid input.
+func flagServiceError(ctx echo.Context, orm *ent.Client, err error, msg string) error {
+	var verr *declarative.ValidationError
+	var conflict *declarative.ConflictError
+	switch {
+	case errors.As(err, &conflict):
+		return revisionConflict(ctx, orm, conflict.FlagEnvironmentID)
+	case errors.As(err, &verr):
+		return jsonValidationError(ctx, verr.Fields)
+	case errors.Is(err, services.ErrFlagNotFound):
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	case errors.Is(err, services.ErrEnvironmentNotFound):
+		return jsonError(ctx, http.StatusNotFound, "Environment not found")
+	case errors.Is(err, services.ErrStrategyNotFound):
+		return jsonError(ctx, http.StatusNotFound, "Strategy not found")
+	}
+	log.Ctx(ctx).Error(msg, "error", err)
+	return jsonError(ctx, http.StatusInternalServerError, msg)
+}
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/ent/user"
+)
+
+func TestFlagToggle_Revision(t *testing.T) {
+	ctx := context.Background()
+	p := c.ORM.Project.Create().SetName("matrix-revision").SaveX(ctx)
+	env := c.ORM.Environment.Create().SetName("production").SetType("production").SetProjectID(p.ID).SaveX(ctx)
+	f := c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(p.ID).SaveX(ctx)
+	fe := c.ORM.FlagEnvironment.Create().SetFlagID(f.ID).SetEnvironmentID(env.ID).SetRevision(3).SaveX(ctx)
+
+	editor := dashboardClient(t, user.RoleEditor)
+	path := fmt.Sprintf("/projects/%d/flags/%d/toggle", p.ID, f.ID)
+
+	// A toggle from a page loaded before another change gets the current
+	// state back instead of overwriting it.
+	resp, err := dashboardJSON(t, editor, path, map[string]any{"environmentId": env.ID, "enabled": true, "revision": 2})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusConflict, resp.StatusCode)
+	var conflict struct {
+		Current struct {
+			Enabled  bool `json:"enabled"`
+			Revision int  `json:"revision"`
+		} `json:"current"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&conflict))
+	assert.Equal(t, 3, conflict.Current.Revision)
+	assert.False(t, c.ORM.FlagEnvironment.GetX(ctx, fe.ID).Enabled)
+
+	resp, err = dashboardJSON(t, editor, path, map[string]any{"environmentId": env.ID, "enabled": true, "revision": 3})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	var toggled struct {
+		Enabled  bool `json:"enabled"`
+		Revision int  `json:"revision"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&toggled))
+	assert.True(t, toggled.Enabled)
+	assert.Equal(t, 4, toggled.Revision)
+}
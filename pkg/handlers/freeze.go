@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	"github.com/felipekafuri/bandeira/ent/user"
+	appctx "github.com/felipekafuri/bandeira/pkg/context"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/form"
+	"github.com/felipekafuri/bandeira/pkg/log"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// FreezeHandler serves the incident controls of the project page: freezing a
+// project or environment, and pulling the kill switches of an environment.
+type FreezeHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+	Flags   *services.FlagService
+}
+
+type FreezeForm struct {
+	form.Submission
+
+	Reason string `form:"reason" json:"reason" validate:"required"`
+}
+
+func init() {
+	Register(new(FreezeHandler))
+}
+
+func (h *FreezeHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	h.Flags = c.Flags
+	return nil
+}
+
+func (h *FreezeHandler) Routes(g *echo.Group) {
+	// Only admins freeze and lift freezes, since they are the ones a freeze
+	// does not stop.
+	admin := g.Group("/projects/:projectId", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin"))
+	admin.POST("/freeze", h.FreezeProject).Name = routenames.ProjectFreeze
+	admin.DELETE("/freeze", h.UnfreezeProject).Name = routenames.ProjectUnfreeze
+	admin.POST("/environments/:id/freeze", h.FreezeEnvironment).Name = routenames.EnvironmentFreeze
+	admin.DELETE("/environments/:id/freeze", h.UnfreezeEnvironment).Name = routenames.EnvironmentUnfreeze
+
+	// The panic button is for whoever is on call, and works during a freeze.
+	mut := g.Group("/projects/:projectId", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
+	mut.POST("/environments/:id/kill-switch", h.KillSwitch).Name = routenames.EnvironmentKillSwitch
+}
+
+func (h *FreezeHandler) FreezeProject(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+	if _, err := findProject(ctx.Request().Context(), h.ORM, projectID); err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	var f FreezeForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	if err := declarative.FreezeProject(ctx.Request().Context(), h.ORM, projectID, userActor(ctx), f.Reason); err != nil {
+		return fail(err, "failed to freeze project", h.Inertia, ctx)
+	}
+	log.Ctx(ctx).Info("project frozen", "project_id", projectID, "actor", userActor(ctx), "reason", f.Reason)
+
+	msg.Warning(ctx, "Project frozen. Only admins can change it until the freeze is lifted.")
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+func (h *FreezeHandler) UnfreezeProject(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	if err := declarative.UnfreezeProject(ctx.Request().Context(), h.ORM, projectID); err != nil {
+		return fail(err, "failed to lift the freeze", h.Inertia, ctx)
+	}
+	log.Ctx(ctx).Info("project unfrozen", "project_id", projectID, "actor", userActor(ctx))
+
+	msg.Success(ctx, "Freeze lifted.")
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+func (h *FreezeHandler) FreezeEnvironment(ctx echo.Context) error {
+	e, err := h.environment(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
+	}
+
+	var f FreezeForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	if err := declarative.FreezeEnvironment(ctx.Request().Context(), h.ORM, e.ID, userActor(ctx), f.Reason); err != nil {
+		return fail(err, "failed to freeze environment", h.Inertia, ctx)
+	}
+	log.Ctx(ctx).Info("environment frozen", "project_id", e.ProjectID, "environment", e.Name, "actor", userActor(ctx), "reason", f.Reason)
+
+	msg.Warning(ctx, fmt.Sprintf("%s frozen. Only admins can change it until the freeze is lifted.", e.Name))
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+func (h *FreezeHandler) UnfreezeEnvironment(ctx echo.Context) error {
+	e, err := h.environment(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
+	}
+
+	if err := declarative.UnfreezeEnvironment(ctx.Request().Context(), h.ORM, e.ID); err != nil {
+		return fail(err, "failed to lift the freeze", h.Inertia, ctx)
+	}
+	log.Ctx(ctx).Info("environment unfrozen", "project_id", e.ProjectID, "environment", e.Name, "actor", userActor(ctx))
+
+	msg.Success(ctx, fmt.Sprintf("Freeze of %s lifted.", e.Name))
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+// KillSwitch turns off every kill_switch flag in the environment.
+func (h *FreezeHandler) KillSwitch(ctx echo.Context) error {
+	e, err := h.environment(ctx)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Environment not found")
+	}
+
+	var f FreezeForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	flags, err := h.Flags.KillSwitch(ctx.Request().Context(), e.ProjectID, e.ID, userActor(ctx), f.Reason)
+	if err != nil {
+		return fail(err, "failed to pull the kill switch", h.Inertia, ctx)
+	}
+	log.Ctx(ctx).Warn("kill switch pulled", "project_id", e.ProjectID, "environment", e.Name, "actor", userActor(ctx), "reason", f.Reason, "flags", flags)
+
+	if len(flags) == 0 {
+		msg.Info(ctx, fmt.Sprintf("No kill switch was on in %s.", e.Name))
+	} else {
+		msg.Warning(ctx, fmt.Sprintf("Turned off %d kill switch(es) in %s: %s.", len(flags), e.Name, strings.Join(flags, ", ")))
+	}
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+// environment loads the environment in the path, which must belong to the
+// project in the path.
+func (h *FreezeHandler) environment(ctx echo.Context) (*ent.Environment, error) {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return nil, err
+	}
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.Environment.Query().
+		Where(environment.ID(id), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx.Request().Context())
+}
+
+// frozen reports whether a freeze of the project, or of one of the
+// environments, stops the signed-in user from making a change. When it does,
+// the request has been answered: Inertia visits go back with a flash message,
+// the JSON endpoints get 423. Admins are never stopped.
+func frozen(ctx echo.Context, orm *ent.Client, i InertiaBacker, projectID int, envIDs ...int) bool {
+	if u, ok := ctx.Get(appctx.AuthKey).(*ent.User); ok && u.Role == user.RoleAdmin {
+		return false
+	}
+
+	err := declarative.CheckFrozen(ctx.Request().Context(), orm, projectID, envIDs...)
+	var ferr *declarative.FrozenError
+	switch {
+	case err == nil, ent.IsNotFound(err):
+		// A missing project is left to the handler to report.
+		return false
+	case !errors.As(err, &ferr):
+		if inertia.IsInertiaRequest(ctx.Request()) {
+			fail(err, "failed to check freeze", i, ctx)
+		} else {
+			jsonError(ctx, http.StatusInternalServerError, "Failed to check freeze")
+		}
+		return true
+	}
+
+	text := "The project is frozen: " + ferr.Reason
+	if ferr.Environment != "" {
+		text = fmt.Sprintf("%s is frozen: %s", ferr.Environment, ferr.Reason)
+	}
+	if inertia.IsInertiaRequest(ctx.Request()) {
+		msg.Danger(ctx, text)
+		i.Back(ctx.Response(), ctx.Request())
+		return true
+	}
+	ctx.JSON(http.StatusLocked, map[string]any{
+		"error":       text,
+		"environment": ferr.Environment,
+		"freeze":      freezeDTO(&ferr.Freeze),
+	})
+	return true
+}
+
+// tokenFrozen is frozen for admin API requests. Admin tokens are not stopped
+// by a freeze, like admin users; any other token type reaching a change is
+// answered with 423 Locked.
+func tokenFrozen(ctx echo.Context, orm *ent.Client, projectID int, envIDs ...int) bool {
+	if adminTokenFromContext(ctx).TokenType == apitoken.TokenTypeAdmin {
+		return false
+	}
+	return frozen(ctx, orm, nil, projectID, envIDs...)
+}
+
+// projectEnvironmentIDs returns the IDs of all environments of a project, for
+// changes that touch every environment.
+func projectEnvironmentIDs(ctx echo.Context, orm *ent.Client, projectID int) []int {
+	ids, _ := orm.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		IDs(ctx.Request().Context())
+	return ids
+}
+
+// freezeProps converts a freeze for the dashboard pages; nil when not frozen.
+func freezeProps(f *declarative.Freeze) map[string]any {
+	if f == nil {
+		return nil
+	}
+	return map[string]any{
+		"frozenAt": f.FrozenAt.Format("Jan 2, 2006 15:04"),
+		"frozenBy": f.FrozenBy,
+		"reason":   f.Reason,
+	}
+}
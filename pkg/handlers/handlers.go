@@ -32,6 +32,12 @@ type StreamAPIHandler interface {
 	StreamAPIRoutes(g *echo.Group)
 }
 
+// RootHandler is implemented by handlers that register routes directly on the
+// router, outside every middleware group (e.g. health probes).
+type RootHandler interface {
+	RootRoutes(e *echo.Echo)
+}
+
 // InertiaBacker abstracts the Back method from gonertia.Inertia
 // to allow injection and mocking in handlers and tests.
 type InertiaBacker interface {
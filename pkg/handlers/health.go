@@ -0,0 +1,64 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// readyTimeout bounds the readiness checks so a stuck database fails the probe
+// instead of hanging it.
+const readyTimeout = 2 * time.Second
+
+// Health serves the liveness, readiness and build-info probes. They are
+// registered on the root router, outside the session, CSRF and Inertia
+// middleware, so orchestrators can call them without a session.
+type Health struct {
+	Container *services.Container
+}
+
+func init() {
+	Register(new(Health))
+}
+
+func (h *Health) Init(c *services.Container) error {
+	h.Container = c
+	return nil
+}
+
+func (h *Health) Routes(g *echo.Group) {}
+
+func (h *Health) RootRoutes(e *echo.Echo) {
+	e.GET("/healthz", h.Live).Name = routenames.Healthz
+	e.GET("/readyz", h.Ready).Name = routenames.Readyz
+	e.GET("/version", h.Version).Name = routenames.Version
+}
+
+// Live reports that the process is up and serving requests.
+func (h *Health) Live(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// Ready reports whether the instance should receive traffic. It returns 503
+// once shutdown has started or when a dependency is unavailable.
+func (h *Health) Ready(ctx echo.Context) error {
+	reqCtx, cancel := context.WithTimeout(ctx.Request().Context(), readyTimeout)
+	defer cancel()
+
+	ready, checks := h.Container.Ready(reqCtx)
+	status, code := "ok", http.StatusOK
+	if !ready {
+		status, code = "unavailable", http.StatusServiceUnavailable
+	}
+	return ctx.JSON(code, map[string]any{"status": status, "checks": checks})
+}
+
+// Version returns the build information and enabled features.
+func (h *Health) Version(ctx echo.Context) error {
+	return ctx.JSON(http.StatusOK, h.Container.BuildInfo())
+}
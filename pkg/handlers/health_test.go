@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+func getJSON(t *testing.T, path string, v any) int {
+	t.Helper()
+	resp, err := http.Get(srv.URL + path)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Empty(t, resp.Header.Get("Set-Cookie"), "probes must not go through the session middleware")
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(v))
+	return resp.StatusCode
+}
+
+func TestHealth_Live(t *testing.T) {
+	var body map[string]string
+	assert.Equal(t, http.StatusOK, getJSON(t, "/healthz", &body))
+	assert.Equal(t, "ok", body["status"])
+}
+
+func TestHealth_Ready(t *testing.T) {
+	var body struct {
+		Status string                 `json:"status"`
+		Checks []services.HealthCheck `json:"checks"`
+	}
+	assert.Equal(t, http.StatusOK, getJSON(t, "/readyz", &body))
+	assert.Equal(t, "ok", body.Status)
+	require.Len(t, body.Checks, 4)
+	for _, check := range body.Checks {
+		assert.True(t, check.OK, check.Name)
+	}
+}
+
+func TestHealth_Version(t *testing.T) {
+	var body services.BuildInfo
+	assert.Equal(t, http.StatusOK, getJSON(t, "/version", &body))
+	assert.Equal(t, services.Version, body.Version)
+	assert.Equal(t, services.SchemaVersion, body.SchemaVersion)
+	assert.Contains(t, body.Features, "metrics")
+}
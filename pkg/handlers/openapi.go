@@ -0,0 +1,1397 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/pkg/openapi"
+	"github.com/felipekafuri/bandeira/pkg/pager"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// OpenAPI serves the OpenAPI document of the admin and client APIs.
+type OpenAPI struct {
+	spec []byte
+}
+
+func init() {
+	Register(new(OpenAPI))
+}
+
+func (h *OpenAPI) Init(_ *services.Container) error {
+	spec, err := json.Marshal(apiSpec())
+	if err != nil {
+		return err
+	}
+	h.spec = spec
+	return nil
+}
+
+func (h *OpenAPI) Routes(_ *echo.Group) {}
+
+func (h *OpenAPI) APIRoutes(api *echo.Group) {
+	api.GET("/openapi.json", h.Spec).Name = routenames.OpenAPISpec
+}
+
+// Spec serves the document. It needs no token so that tools can fetch it.
+func (h *OpenAPI) Spec(ctx echo.Context) error {
+	return ctx.JSONBlob(http.StatusOK, h.spec)
+}
+
+// apiSpec describes every route registered on the /api groups, using the route
+// names as operation IDs. TestOpenAPI_MatchesRoutes fails when a route is
+// added without being described here.
+func apiSpec() *openapi.Document {
+	doc := openapi.New(openapi.Info{
+		Title:   "Bandeira API",
+		Version: "1",
+		Description: "The client API serves flag state to SDKs and requires a client token. " +
+			"The admin API manages a project and requires an admin token. Both tokens are " +
+			"created in the dashboard and scoped to one project.",
+	})
+	doc.Tags = []openapi.Tag{
+		{Name: "client", Description: "Flag state for SDKs"},
+		{Name: "projects"},
+		{Name: "environments"},
+		{Name: "flags"},
+		{Name: "strategies", Description: "Built-in and custom strategies"},
+		{Name: "context fields", Description: "Context fields constraints may test"},
+		{Name: "versions", Description: "Version history of a flag in one environment"},
+		{Name: "rollouts", Description: "Scheduled ramp-ups of gradual rollouts"},
+		{Name: "declarative", Description: "Export, import, promote and compare"},
+		{Name: "trash"},
+		{Name: "tokens"},
+		{Name: "meta"},
+	}
+
+	specComponents(doc)
+	specClientAPI(doc)
+	specAdminAPI(doc)
+
+	doc.Add(http.MethodGet, "/api/openapi.json", &openapi.Operation{
+		OperationID: routenames.OpenAPISpec,
+		Summary:     "This OpenAPI document",
+		Tags:        []string{"meta"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("OpenAPI 3.1 document", openapi.Any("")),
+		},
+	})
+
+	return doc
+}
+
+// ---------------------------------------------------------------------------
+// Helpers
+// ---------------------------------------------------------------------------
+
+var pathParam = regexp.MustCompile(`:([A-Za-z]+)`)
+
+func componentParam(name string) *openapi.Parameter {
+	return &openapi.Parameter{Ref: "#/components/parameters/" + name}
+}
+
+func componentResponse(name string) *openapi.Response {
+	return &openapi.Response{Ref: "#/components/responses/" + name}
+}
+
+func jsonResponse(description string, s *openapi.Schema) *openapi.Response {
+	return &openapi.Response{Description: description, Content: openapi.JSON(s, nil)}
+}
+
+func jsonBody(s *openapi.Schema, example any) *openapi.RequestBody {
+	return &openapi.RequestBody{Required: true, Content: openapi.JSON(s, example)}
+}
+
+func queryParam(name, description string, s *openapi.Schema) *openapi.Parameter {
+	return &openapi.Parameter{Name: name, In: "query", Description: description, Schema: s}
+}
+
+// listQueryParams are the parameters of a paginated list sorted on one of the
+// columns.
+func listQueryParams(sorts []string, defaultSort, search string) []*openapi.Parameter {
+	values := make([]string, 0, 2*len(sorts))
+	for _, s := range sorts {
+		values = append(values, s, "-"+s)
+	}
+	sort := openapi.Enum("Column to sort on; prefix with - for descending order", values...)
+	sort.Default = defaultSort
+	return []*openapi.Parameter{
+		componentParam("Limit"),
+		componentParam("Cursor"),
+		queryParam("sort", "", sort),
+		queryParam("q", "Case-insensitive search in "+search, openapi.String("")),
+	}
+}
+
+// pageSchema is the envelope of a paginated list.
+func pageSchema(items *openapi.Schema) *openapi.Schema {
+	return openapi.Object(map[string]*openapi.Schema{
+		"items":       openapi.Array(items),
+		"next_cursor": openapi.Nullable(openapi.String("Cursor of the next page; null on the last page")),
+		"total":       openapi.Integer("Number of matching items across all pages"),
+	}, "items", "next_cursor", "total")
+}
+
+// addAdmin adds an admin API operation. Path parameters are filled in from
+// the route, and the responses every admin route may give are added.
+func addAdmin(doc *openapi.Document, method, path string, op *openapi.Operation) {
+	op.Security = []map[string][]string{{"adminToken": {}}}
+
+	params := make([]*openapi.Parameter, 0, len(op.Parameters)+3)
+	for _, m := range pathParam.FindAllStringSubmatch(path, -1) {
+		switch {
+		case m[1] == "id" && path == "/api/admin/api-tokens/:id":
+			params = append(params, componentParam("TokenID"))
+		case m[1] == "id":
+			params = append(params, componentParam("ProjectID"))
+		case m[1] == "flagId":
+			params = append(params, componentParam("FlagID"))
+		case m[1] == "envId":
+			params = append(params, componentParam("EnvironmentID"))
+		case m[1] == "version":
+			params = append(params, componentParam("Version"))
+		case m[1] == "definitionId":
+			params = append(params, componentParam("StrategyDefinitionID"))
+		case m[1] == "fieldId":
+			params = append(params, componentParam("ContextFieldID"))
+		case m[1] == "planId":
+			params = append(params, componentParam("RolloutPlanID"))
+		}
+	}
+	op.Parameters = append(params, op.Parameters...)
+
+	op.Responses["401"] = componentResponse("Unauthorized")
+	if len(params) > 0 {
+		op.Responses["403"] = componentResponse("Forbidden")
+		if _, ok := op.Responses["404"]; !ok {
+			op.Responses["404"] = componentResponse("NotFound")
+		}
+	}
+	doc.Add(method, path, op)
+}
+
+// ---------------------------------------------------------------------------
+// Components
+// ---------------------------------------------------------------------------
+
+func specComponents(doc *openapi.Document) {
+	doc.Components.SecuritySchemes = map[string]openapi.SecurityScheme{
+		"adminToken": {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "Admin token of a project, sent as `Authorization: Bearer <token>`",
+		},
+		"clientToken": {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "Client token of a project environment, sent as `Authorization: Bearer <token>`",
+		},
+	}
+
+	doc.Components.Parameters = map[string]*openapi.Parameter{
+		"ProjectID":            {Name: "id", In: "path", Required: true, Description: "Project ID; must be the token's project", Schema: openapi.Integer("")},
+		"FlagID":               {Name: "flagId", In: "path", Required: true, Description: "Flag ID", Schema: openapi.Integer("")},
+		"EnvironmentID":        {Name: "envId", In: "path", Required: true, Description: "Environment ID", Schema: openapi.Integer("")},
+		"Version":              {Name: "version", In: "path", Required: true, Description: "Version number", Schema: openapi.Integer("")},
+		"TokenID":              {Name: "id", In: "path", Required: true, Description: "API token ID", Schema: openapi.Integer("")},
+		"StrategyDefinitionID": {Name: "definitionId", In: "path", Required: true, Description: "Custom strategy ID", Schema: openapi.Integer("")},
+		"ContextFieldID":       {Name: "fieldId", In: "path", Required: true, Description: "Registered context field ID", Schema: openapi.Integer("")},
+		"RolloutPlanID":        {Name: "planId", In: "path", Required: true, Description: "Rollout plan ID", Schema: openapi.Integer("")},
+		"Limit": {Name: "limit", In: "query", Description: "Page size", Schema: func() *openapi.Schema {
+			s := openapi.Integer("").Range(1, pager.MaxLimit)
+			s.Default = pager.DefaultLimit
+			return s
+		}()},
+		"Cursor":   {Name: "cursor", In: "query", Description: "next_cursor of the previous page, issued for the same sort", Schema: openapi.String("")},
+		"DryRun":   {Name: "dry_run", In: "query", Description: "Report the changes without applying them", Schema: openapi.Boolean("")},
+		"TagQuery": {Name: "tag", In: "query", Description: "Only flags carrying any of these tags; repeat or comma-separate", Schema: openapi.Array(openapi.String(""))},
+		"IfMatch":  {Name: "If-Match", In: "header", Description: "ETag of the configuration the change is based on, as returned by the last read or write; the change is rejected with 409 if the configuration changed since", Schema: openapi.String("")},
+	}
+
+	doc.Components.Responses = map[string]*openapi.Response{
+		"Unauthorized": {Description: "Missing or invalid token"},
+		"Forbidden":    jsonResponse("The token cannot access this project", openapi.Ref("Error")),
+		"NotFound":     jsonResponse("Not found", openapi.Ref("Error")),
+		"BadRequest":   jsonResponse("Malformed body", openapi.Ref("Error")),
+		"ValidationFailed": {
+			Description: "Invalid input",
+			Content: openapi.JSON(openapi.Ref("ValidationError"), map[string]any{
+				"error":  "Validation failed",
+				"fields": map[string]string{"name": "Name is required"},
+			}),
+		},
+		"Conflict": jsonResponse("Conflicts with the current state", openapi.Ref("Error")),
+		"RevisionConflict": jsonResponse("The configuration changed since the If-Match revision", openapi.Object(map[string]*openapi.Schema{
+			"error":   openapi.String(""),
+			"current": openapi.Ref("FlagEnvironment"),
+		}, "error", "current")),
+		"OK": jsonResponse("Done", openapi.Ref("OK")),
+	}
+
+	str := openapi.String
+	integer := openapi.Integer
+	boolean := openapi.Boolean
+	timestamp := openapi.DateTime
+	contextFieldType := openapi.Enum("Decides the operators constraints on the field may use", "string", "number", "date", "semver", "ip")
+	strategyName := str("Strategy name; one of those listed by GET /api/admin/strategies")
+	operator := openapi.Enum("", "IN", "NOT_IN", "STR_CONTAINS", "STR_STARTS_WITH", "STR_ENDS_WITH",
+		"NUM_EQ", "NUM_GT", "NUM_GTE", "NUM_LT", "NUM_LTE", "DATE_AFTER", "DATE_BEFORE",
+		"SEMVER_EQ", "SEMVER_GT", "SEMVER_LT", "REGEX", "IN_CIDR", "ANY_OF", "ALL_OF")
+
+	doc.Components.Schemas = map[string]*openapi.Schema{
+		"Error": openapi.Object(map[string]*openapi.Schema{
+			"error": str("Human-readable message"),
+		}, "error"),
+		"ValidationError": openapi.Object(map[string]*openapi.Schema{
+			"error": openapi.Enum("", "Validation failed"),
+			"fields": {
+				Type:                 "object",
+				Description:          `Message per invalid field, keyed by field path, e.g. "name", "links[0].url" or "flags[1].environments[0].strategies[0].name"`,
+				AdditionalProperties: str(""),
+			},
+		}, "error", "fields"),
+		"OK": openapi.Object(map[string]*openapi.Schema{
+			"ok": boolean(""),
+		}, "ok"),
+
+		// Client API
+		"ClientPayload": openapi.Object(map[string]*openapi.Schema{
+			"flags":                openapi.Array(openapi.Ref("ClientFlag")),
+			"strategy_definitions": {Type: "array", Description: "Custom strategies of the project; SDKs implement the built-in ones", Items: openapi.Ref("StrategyDefinition")},
+		}, "flags", "strategy_definitions"),
+		"ClientFlag": openapi.Object(map[string]*openapi.Schema{
+			"name":       str(""),
+			"enabled":    boolean(""),
+			"strategies": openapi.Array(openapi.Ref("StrategyInput")),
+			"prerequisites": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"flag":    str(""),
+				"enabled": boolean(""),
+				"variant": str(""),
+			}, "flag", "enabled")),
+		}, "name", "enabled", "strategies"),
+
+		// Projects
+		"Project": openapi.Object(map[string]*openapi.Schema{
+			"id":                integer(""),
+			"name":              str(""),
+			"description":       str(""),
+			"flag_count":        integer(""),
+			"environment_count": integer(""),
+			"freeze":            openapi.Nullable(openapi.Ref("Freeze")),
+			"created_at":        timestamp(""),
+			"updated_at":        timestamp(""),
+		}, "id", "name", "description", "created_at", "updated_at"),
+		"ProjectInput": openapi.Object(map[string]*openapi.Schema{
+			"name":        str(""),
+			"description": str(""),
+		}),
+
+		// Environments
+		"Environment": openapi.Object(map[string]*openapi.Schema{
+			"id":                integer(""),
+			"name":              str(""),
+			"type":              openapi.Enum("", "development", "staging", "production"),
+			"sort_order":        integer(""),
+			"project_id":        integer(""),
+			"expected_match_id": openapi.Nullable(integer("Environment this one is expected to match")),
+			"freeze":            openapi.Nullable(openapi.Ref("Freeze")),
+			"created_at":        timestamp(""),
+			"updated_at":        timestamp(""),
+		}, "id", "name", "type", "sort_order", "project_id", "expected_match_id", "created_at", "updated_at"),
+		"EnvironmentInput": openapi.Object(map[string]*openapi.Schema{
+			"name":              str(""),
+			"type":              openapi.Enum("", "development", "staging", "production"),
+			"sort_order":        integer(""),
+			"expected_match_id": integer("Another environment of the project; 0 clears it on update"),
+		}),
+
+		// Freeze and kill switch
+		"Freeze": openapi.Object(map[string]*openapi.Schema{
+			"frozen_at": timestamp(""),
+			"frozen_by": str(`Who froze it, e.g. a user's email or "token:<name>"`),
+			"reason":    str(""),
+		}, "frozen_at", "reason"),
+		"ReasonInput": openapi.Object(map[string]*openapi.Schema{
+			"reason": str("Why, for the audit trail; required"),
+		}, "reason"),
+
+		// Flags
+		"Link": openapi.Object(map[string]*openapi.Schema{
+			"title": str(""),
+			"url":   {Type: "string", Format: "uri", Description: "Absolute http(s) URL"},
+		}, "url"),
+		"Flag": openapi.Object(map[string]*openapi.Schema{
+			"id":          integer(""),
+			"name":        str(""),
+			"description": str(""),
+			"flag_type":   openapi.Ref("FlagType"),
+			"project_id":  integer(""),
+			"owner":       str(""),
+			"tags":        openapi.Array(str("")),
+			"links":       openapi.Array(openapi.Ref("Link")),
+			"metadata":    openapi.Map(str("")),
+			"created_at":  timestamp(""),
+			"updated_at":  timestamp(""),
+		}, "id", "name", "description", "flag_type", "project_id", "owner", "tags", "links", "metadata", "created_at", "updated_at"),
+		"FlagType": openapi.Enum("", "release", "experiment", "operational", "kill_switch"),
+		"FlagInput": openapi.Object(map[string]*openapi.Schema{
+			"name":        str(""),
+			"description": str(""),
+			"flag_type":   openapi.Ref("FlagType"),
+			"owner":       str(""),
+			"tags":        openapi.Array(str("Lowercase letters, digits and - _ . : /; at most 20 tags")),
+			"links":       openapi.Array(openapi.Ref("Link")),
+			"metadata":    openapi.Map(str("")),
+		}),
+		"FlagDetail": {
+			Type:        "object",
+			Description: "A flag with its configuration in every environment",
+			Properties: map[string]*openapi.Schema{
+				"environments": openapi.Array(openapi.Ref("FlagEnvironment")),
+				"dependents":   openapi.Array(openapi.Ref("Dependent")),
+			},
+		},
+		"FlagEnvironment": openapi.Object(map[string]*openapi.Schema{
+			"environment_id":   integer(""),
+			"environment_name": str(""),
+			"flag_id":          integer(""),
+			"enabled":          boolean(""),
+			"revision":         integer("Bumped by every change to the configuration; also sent as the ETag header"),
+			"strategies":       openapi.Array(openapi.Ref("Strategy")),
+			"prerequisites":    openapi.Array(openapi.Ref("Prerequisite")),
+		}, "environment_id", "enabled", "revision", "strategies", "prerequisites"),
+		"FlagEnvironmentPatch": openapi.Object(map[string]*openapi.Schema{
+			"enabled":       boolean(""),
+			"strategies":    {Type: "array", Description: "Replaces all strategies when present", Items: openapi.Ref("StrategyInput")},
+			"prerequisites": {Type: "array", Description: "Replaces all prerequisites when present", Items: openapi.Ref("PrerequisiteInput")},
+		}),
+		"BulkRequest": openapi.Object(map[string]*openapi.Schema{
+			"continue_on_error": boolean("Commit each item on its own and report failures; by default one failure rolls back everything"),
+			"operations":        openapi.Array(openapi.Ref("BulkOperation")),
+		}, "operations"),
+		"BulkOperation": openapi.Object(map[string]*openapi.Schema{
+			"op":              openapi.Enum("toggle and set_strategies apply to every flag in every environment; delete and tag to every flag", "toggle", "set_strategies", "delete", "tag"),
+			"flag_ids":        openapi.Array(integer("")),
+			"environment_ids": {Type: "array", Description: "Required by toggle and set_strategies", Items: integer("")},
+			"enabled":         boolean("Required by toggle"),
+			"strategies":      {Type: "array", Description: "Replaces all strategies (set_strategies)", Items: openapi.Ref("StrategyInput")},
+			"add_tags":        {Type: "array", Description: "Tags to add (tag)", Items: str("")},
+			"remove_tags":     {Type: "array", Description: "Tags to remove (tag)", Items: str("")},
+		}, "op", "flag_ids"),
+		"BulkResult": openapi.Object(map[string]*openapi.Schema{
+			"operation":      integer("Index of the operation"),
+			"op":             str(""),
+			"flag_id":        integer(""),
+			"flag":           str(""),
+			"environment_id": integer(""),
+			"environment":    str(""),
+			"ok":             boolean(""),
+			"error":          str(""),
+		}, "operation", "op", "flag_id", "flag", "ok"),
+		"Strategy": openapi.Object(map[string]*openapi.Schema{
+			"id":          integer(""),
+			"name":        strategyName,
+			"parameters":  openapi.Any("Strategy parameters, e.g. rollout and stickiness"),
+			"sort_order":  integer(""),
+			"constraints": openapi.Array(openapi.Ref("Constraint")),
+		}, "id", "name", "parameters", "sort_order", "constraints"),
+		"StrategyInput": openapi.Object(map[string]*openapi.Schema{
+			"name":        strategyName,
+			"parameters":  openapi.Any("Strategy parameters, e.g. rollout and stickiness"),
+			"constraints": openapi.Array(openapi.Ref("ConstraintInput")),
+		}, "name"),
+		"StrategyDefinition": openapi.Object(map[string]*openapi.Schema{
+			"id":          integer("Set on custom strategies"),
+			"name":        str(""),
+			"label":       str(""),
+			"description": str(""),
+			"builtin":     boolean(""),
+			"parameters":  openapi.Array(openapi.Ref("StrategyParameter")),
+		}, "name", "label", "builtin", "parameters"),
+		"StrategyDefinitionInput": openapi.Object(map[string]*openapi.Schema{
+			"name":        str("Letters, digits and _, starting with a letter; not a built-in name"),
+			"description": str(""),
+			"parameters":  openapi.Array(openapi.Ref("StrategyParameter")),
+		}),
+		"ContextField": openapi.Object(map[string]*openapi.Schema{
+			"id":           integer("Set on registered fields"),
+			"name":         str(""),
+			"description":  str(""),
+			"type":         contextFieldType,
+			"legal_values": openapi.Array(str("")),
+			"builtin":      boolean("Set on the fields every SDK fills"),
+		}, "name", "type"),
+		"ContextFieldInput": openapi.Object(map[string]*openapi.Schema{
+			"name":         str("Letters, digits, _, . and -, starting with a letter; not a built-in name"),
+			"description":  str(""),
+			"type":         contextFieldType,
+			"legal_values": {Type: "array", Description: "When set, the only values IN and NOT_IN constraints may list", Items: str("")},
+		}),
+		"StrategyParameter": openapi.Object(map[string]*openapi.Schema{
+			"name":        str(""),
+			"label":       str(""),
+			"type":        openapi.Enum("list holds one value per line or comma", "number", "string", "enum", "list"),
+			"required":    boolean(""),
+			"min":         {Type: "number", Description: "Lower bound of a number"},
+			"max":         {Type: "number", Description: "Upper bound of a number"},
+			"values":      openapi.Array(str("Allowed values of an enum")),
+			"default":     {Description: "Value used when the parameter is omitted"},
+			"description": str(""),
+		}, "name", "label", "type", "required"),
+		"Constraint": openapi.Object(map[string]*openapi.Schema{
+			"id":               integer(""),
+			"context_name":     str(""),
+			"operator":         operator,
+			"values":           openapi.Array(str("")),
+			"inverted":         boolean(""),
+			"case_insensitive": boolean(""),
+		}, "id", "context_name", "operator", "values", "inverted", "case_insensitive"),
+		"ConstraintInput": openapi.Object(map[string]*openapi.Schema{
+			"context_name":     str("Context field, e.g. userId or region"),
+			"operator":         operator,
+			"values":           openapi.Array(str("")),
+			"inverted":         boolean(""),
+			"case_insensitive": boolean(""),
+		}, "context_name", "operator", "values"),
+		"Prerequisite": openapi.Object(map[string]*openapi.Schema{
+			"flag_id": integer(""),
+			"flag":    str(""),
+			"enabled": boolean("Required state of the parent flag"),
+			"variant": str(""),
+		}, "flag_id", "flag", "enabled"),
+		"PrerequisiteInput": openapi.Object(map[string]*openapi.Schema{
+			"flag":    str("Name of a flag of the project"),
+			"enabled": {Type: "boolean", Description: "Required state of the parent flag", Default: true},
+			"variant": str(""),
+		}, "flag"),
+		"Dependent": openapi.Object(map[string]*openapi.Schema{
+			"flag_id":     integer(""),
+			"flag":        str(""),
+			"environment": str(""),
+			"enabled":     boolean(""),
+			"variant":     str(""),
+		}, "flag_id", "flag", "environment", "enabled"),
+		"Tag": openapi.Object(map[string]*openapi.Schema{
+			"name":       str(""),
+			"flag_count": integer(""),
+		}, "name", "flag_count"),
+
+		// Declarative configuration
+		"Document": openapi.Object(map[string]*openapi.Schema{
+			"version": integer("Document format version"),
+			"project": openapi.Ref("ProjectInput"),
+			"environments": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"name":           str(""),
+				"type":           openapi.Enum("", "development", "staging", "production"),
+				"sort_order":     integer(""),
+				"expected_match": str("Name of the environment this one is expected to match"),
+			}, "name", "type")),
+			"context_fields": openapi.Array(openapi.Ref("ContextFieldInput")),
+			"flags": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"name":         str(""),
+				"description":  str(""),
+				"flag_type":    openapi.Ref("FlagType"),
+				"owner":        str(""),
+				"tags":         openapi.Array(str("")),
+				"links":        openapi.Array(openapi.Ref("Link")),
+				"metadata":     openapi.Map(str("")),
+				"environments": openapi.Array(openapi.Ref("DocumentFlagEnvironment")),
+			}, "name", "flag_type")),
+		}, "version", "project", "environments", "flags"),
+		"DocumentFlagEnvironment": openapi.Object(map[string]*openapi.Schema{
+			"environment":   str(""),
+			"enabled":       boolean(""),
+			"strategies":    openapi.Array(openapi.Ref("StrategyInput")),
+			"prerequisites": openapi.Array(openapi.Ref("PrerequisiteInput")),
+		}, "environment", "enabled", "strategies"),
+		"FieldChange": openapi.Object(map[string]*openapi.Schema{
+			"field": str(""),
+			"from":  {Description: "Previous value, of any type"},
+			"to":    {Description: "New value, of any type"},
+		}, "field", "from", "to"),
+		"Change": openapi.Object(map[string]*openapi.Schema{
+			"action":      openapi.Enum("", "create", "update", "delete"),
+			"kind":        openapi.Enum("", "project", "environment", "flag", "flag_environment"),
+			"name":        str(""),
+			"environment": str(""),
+			"fields":      openapi.Array(openapi.Ref("FieldChange")),
+		}, "action", "kind", "name"),
+		"ChangeResult": openapi.Object(map[string]*openapi.Schema{
+			"dry_run": boolean(""),
+			"applied": boolean(""),
+			"summary": openapi.Map(integer("Number of changes per action")),
+			"changes": openapi.Array(openapi.Ref("Change")),
+		}, "dry_run", "applied", "summary", "changes"),
+		"PromoteInput": openapi.Object(map[string]*openapi.Schema{
+			"source":     str("Source environment name"),
+			"target":     str("Target environment name"),
+			"flags":      openapi.Array(str("Flag names; all flags when omitted")),
+			"enabled":    boolean("Copy the enabled state"),
+			"strategies": boolean("Copy strategies, constraints and prerequisites"),
+			"dry_run":    boolean(""),
+		}, "source", "target"),
+		"FlagComparison": openapi.Object(map[string]*openapi.Schema{
+			"name": str(""),
+			"differences": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"path":   str(`Setting that differs, e.g. "enabled" or "strategies[0].parameters.rollout"`),
+				"values": openapi.Any("Value per environment name; null when absent"),
+			}, "path", "values")),
+		}, "name", "differences"),
+		"Comparison": openapi.Object(map[string]*openapi.Schema{
+			"environments": openapi.Array(str("")),
+			"flags":        openapi.Array(openapi.Ref("FlagComparison")),
+		}, "environments", "flags"),
+		"Drift": openapi.Object(map[string]*openapi.Schema{
+			"environment":    str(""),
+			"expected_match": str(""),
+			"flags":          openapi.Array(openapi.Ref("FlagComparison")),
+		}, "environment", "expected_match", "flags"),
+
+		// Evaluation
+		"EvalContext": openapi.Object(map[string]*openapi.Schema{
+			"userId":        str(""),
+			"sessionId":     str(""),
+			"remoteAddress": str(""),
+			"properties":    openapi.Map(str("Any other context field")),
+		}),
+		"EvaluateInput": openapi.Object(map[string]*openapi.Schema{
+			"environments": openapi.Array(str("Environment names; all environments when omitted")),
+			"context":      openapi.Ref("EvalContext"),
+		}, "context"),
+		"FlagEvaluation": openapi.Object(map[string]*openapi.Schema{
+			"flag":       str(""),
+			"enabled":    boolean("Result the SDKs compute for the context"),
+			"reason":     str(""),
+			"configured": boolean("Whether the flag is configured in the environment"),
+			"toggle":     boolean("Configured enabled state"),
+			"strategy":   integer("Index of the first matching strategy"),
+			"prerequisites": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"flag":    str(""),
+				"enabled": boolean("Required state of the parent flag"),
+				"variant": str(""),
+				"actual":  boolean("State the parent flag evaluated to"),
+				"passed":  boolean(""),
+				"reason":  str(""),
+			}, "flag", "actual", "passed")),
+			"strategies": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"name":       str(""),
+				"parameters": openapi.Any(""),
+				"matched":    boolean(""),
+				"reason":     str(""),
+				"bucket":     integer("Rollout bucket (0-99) of a gradualRollout strategy"),
+				"custom":     boolean("Custom strategies are evaluated by the SDKs and never match here"),
+				"constraints": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+					"context_name":     str(""),
+					"operator":         operator,
+					"values":           openapi.Array(str("")),
+					"inverted":         boolean(""),
+					"case_insensitive": boolean(""),
+					"value":            str("Context value the constraint was checked against"),
+					"passed":           boolean(""),
+				}, "context_name", "operator", "values", "value", "passed")),
+			}, "name", "matched", "reason", "constraints")),
+		}, "flag", "enabled", "reason", "configured", "toggle", "strategies"),
+		"Evaluation": openapi.Object(map[string]*openapi.Schema{
+			"context": openapi.Ref("EvalContext"),
+			"environments": openapi.Array(openapi.Object(map[string]*openapi.Schema{
+				"environment": str(""),
+				"flags":       openapi.Array(openapi.Ref("FlagEvaluation")),
+			}, "environment", "flags")),
+		}, "context", "environments"),
+
+		// Version history
+		"FlagVersion": openapi.Object(map[string]*openapi.Schema{
+			"version":    integer(""),
+			"actor":      str(`Who made the change, e.g. a user's email or "token:<name>"`),
+			"reason":     str("Why, when recorded by an incident action such as the kill switch"),
+			"created_at": timestamp(""),
+			"config":     openapi.Ref("DocumentFlagEnvironment"),
+		}, "version", "created_at", "config"),
+
+		// Rollout plans
+		"RolloutStep": openapi.Object(map[string]*openapi.Schema{
+			"percentage": integer("Rollout percentage to set, 0 to 100, higher than the step before"),
+			"hold":       str(`How long to hold the step before the next one, e.g. "24h"; ignored on the last step`),
+		}, "percentage"),
+		"RolloutPlan": openapi.Object(map[string]*openapi.Schema{
+			"id":              integer(""),
+			"flag_id":         integer(""),
+			"environment_id":  integer(""),
+			"strategy_id":     integer("The gradualRollout strategy the plan ramps up"),
+			"status":          openapi.Enum("", "running", "paused", "completed", "aborted"),
+			"status_reason":   str("Why the plan was paused or aborted"),
+			"steps":           openapi.Array(openapi.Ref("RolloutStep")),
+			"current_step":    integer("Index of the step applied last"),
+			"percentage":      integer("Rollout percentage of the current step"),
+			"step_started_at": timestamp("When the current step was applied"),
+			"paused_at":       openapi.Nullable(timestamp("")),
+			"next_step_at":    openapi.Nullable(timestamp("When the next step is due; null unless running")),
+			"created_by":      str(`Who started the plan, e.g. a user's email or "token:<name>"`),
+			"created_at":      timestamp(""),
+			"updated_at":      timestamp(""),
+		}, "id", "flag_id", "environment_id", "strategy_id", "status", "steps", "current_step", "percentage", "step_started_at", "created_at", "updated_at"),
+		"RolloutPlanInput": openapi.Object(map[string]*openapi.Schema{
+			"strategy_id": integer("A gradualRollout strategy of the flag"),
+			"steps":       openapi.Array(openapi.Ref("RolloutStep")),
+		}, "strategy_id", "steps"),
+
+		// Trash, tokens and backups
+		"TrashItem": openapi.Object(map[string]*openapi.Schema{
+			"kind":         openapi.Enum("", "project", "flag", "environment"),
+			"id":           integer(""),
+			"name":         str(""),
+			"project_id":   integer(""),
+			"project_name": str(""),
+			"deleted_at":   timestamp(""),
+			"purge_at":     timestamp("When the item is purged for good"),
+		}, "kind", "id", "name", "project_id", "project_name", "deleted_at"),
+		"Token": openapi.Object(map[string]*openapi.Schema{
+			"id":          integer(""),
+			"name":        str(""),
+			"token_type":  openapi.Enum("", "client", "admin"),
+			"environment": str("Environment of a client token; empty for admin tokens"),
+			"created_at":  timestamp(""),
+		}, "id", "name", "token_type", "environment", "created_at"),
+	}
+
+	// FlagDetail extends Flag; 3.1 allows $ref next to other keywords, but
+	// copying the properties keeps generators that predate it working.
+	detail := doc.Components.Schemas["FlagDetail"]
+	for name, s := range doc.Components.Schemas["Flag"].Properties {
+		detail.Properties[name] = s
+	}
+	detail.Required = append(append([]string{}, doc.Components.Schemas["Flag"].Required...), "environments", "dependents")
+}
+
+// ---------------------------------------------------------------------------
+// Client API
+// ---------------------------------------------------------------------------
+
+func specClientAPI(doc *openapi.Document) {
+	security := []map[string][]string{{"clientToken": {}}}
+
+	doc.Add(http.MethodGet, "/api/v1/flags", &openapi.Operation{
+		OperationID: routenames.APIGetFlags,
+		Summary:     "Get the flags of the token's environment",
+		Description: "Returns every flag of the token's project with its state, strategies and constraints in the token's environment.",
+		Tags:        []string{"client"},
+		Security:    security,
+		Parameters:  []*openapi.Parameter{componentParam("TagQuery")},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Flag state", openapi.Ref("ClientPayload")),
+			"401": componentResponse("Unauthorized"),
+		},
+	})
+
+	doc.Add(http.MethodGet, "/api/v1/stream", &openapi.Operation{
+		OperationID: routenames.APIStreamFlags,
+		Summary:     "Stream the flags of the token's environment",
+		Description: "Server-sent events. A `flags` event carrying the same payload as GET /api/v1/flags is sent on connect " +
+			"and after every change. A `reconnect` event with `retry_ms` asks the client to reconnect elsewhere when the server shuts down.",
+		Tags:       []string{"client"},
+		Security:   security,
+		Parameters: []*openapi.Parameter{componentParam("TagQuery")},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "Event stream",
+				Content: map[string]openapi.MediaType{
+					"text/event-stream": {Schema: openapi.String("`event: flags` with a ClientPayload as data")},
+				},
+			},
+			"401": componentResponse("Unauthorized"),
+			"503": {Description: "The server is shutting down; retry after the Retry-After delay"},
+		},
+	})
+}
+
+// ---------------------------------------------------------------------------
+// Admin API
+// ---------------------------------------------------------------------------
+
+func specAdminAPI(doc *openapi.Document) {
+	ok := componentResponse("OK")
+	invalid := componentResponse("ValidationFailed")
+	badRequest := componentResponse("BadRequest")
+	conflict := componentResponse("Conflict")
+
+	// Projects
+	addAdmin(doc, http.MethodGet, "/api/admin/projects", &openapi.Operation{
+		OperationID: routenames.AdminProjectList,
+		Summary:     "List projects",
+		Description: "Lists the token's project; admin tokens are scoped to one project.",
+		Tags:        []string{"projects"},
+		Parameters:  listQueryParams([]string{"name", "created_at"}, "name", "name and description"),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("A page of projects", pageSchema(openapi.Ref("Project"))),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects", &openapi.Operation{
+		OperationID: routenames.AdminProjectCreate,
+		Summary:     "Create a project",
+		Description: "Always refused: admin tokens are project-scoped. Create projects in the dashboard.",
+		Tags:        []string{"projects"},
+		Responses: map[string]*openapi.Response{
+			"403": jsonResponse("Refused", openapi.Ref("Error")),
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id", &openapi.Operation{
+		OperationID: routenames.AdminProjectGet,
+		Summary:     "Get a project",
+		Tags:        []string{"projects"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The project with its flag and environment counts", openapi.Ref("Project")),
+		},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id", &openapi.Operation{
+		OperationID: routenames.AdminProjectUpdate,
+		Summary:     "Update a project",
+		Description: "Omitted fields are left unchanged.",
+		Tags:        []string{"projects"},
+		RequestBody: jsonBody(openapi.Ref("ProjectInput"), map[string]any{"name": "my-project", "description": "Updated description"}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The updated project", openapi.Ref("Project")),
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id", &openapi.Operation{
+		OperationID: routenames.AdminProjectDelete,
+		Summary:     "Move a project to the trash",
+		Description: "Its tokens, including the one making the request, stop working until it is restored.",
+		Tags:        []string{"projects"},
+		Responses:   map[string]*openapi.Response{"200": ok},
+	})
+
+	// Declarative configuration
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/export", &openapi.Operation{
+		OperationID: routenames.AdminProjectExport,
+		Summary:     "Export a project",
+		Tags:        []string{"declarative"},
+		Parameters: []*openapi.Parameter{
+			queryParam("format", "", &openapi.Schema{Type: "string", Enum: []any{"json", "yaml"}, Default: "json"}),
+		},
+		Responses: map[string]*openapi.Response{
+			"200": {
+				Description: "The project as a declarative document",
+				Content: map[string]openapi.MediaType{
+					"application/json": {Schema: openapi.Ref("Document")},
+					"application/yaml": {Schema: openapi.Ref("Document")},
+				},
+			},
+			"400": badRequest,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/import", &openapi.Operation{
+		OperationID: routenames.AdminProjectImport,
+		Summary:     "Import a document into a project",
+		Description: "Reconciles the project with the document in one transaction. The format follows the format " +
+			"parameter or the Content-Type header.",
+		Tags: []string{"declarative"},
+		Parameters: []*openapi.Parameter{
+			queryParam("format", "", openapi.Enum("", "json", "yaml")),
+			componentParam("DryRun"),
+			queryParam("prune", "Delete flags and environments missing from the document", openapi.Boolean("")),
+		},
+		RequestBody: &openapi.RequestBody{
+			Required: true,
+			Content: map[string]openapi.MediaType{
+				"application/json": {Schema: openapi.Ref("Document")},
+				"application/yaml": {Schema: openapi.Ref("Document")},
+			},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The changes made, or that would be made on a dry run", openapi.Ref("ChangeResult")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/promote", &openapi.Operation{
+		OperationID: routenames.AdminProjectPromote,
+		Summary:     "Promote flag configuration between environments",
+		Tags:        []string{"declarative"},
+		Parameters:  []*openapi.Parameter{componentParam("DryRun")},
+		RequestBody: jsonBody(openapi.Ref("PromoteInput"), map[string]any{
+			"source": "staging", "target": "production", "flags": []string{"new-checkout"}, "enabled": true, "strategies": true,
+		}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The changes made to the target", openapi.Ref("ChangeResult")),
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/compare", &openapi.Operation{
+		OperationID: routenames.AdminProjectCompare,
+		Summary:     "Compare environments",
+		Tags:        []string{"declarative"},
+		Parameters: []*openapi.Parameter{
+			{Name: "env", In: "query", Required: true, Description: "Environment names; repeat for at least two", Schema: openapi.Array(openapi.String(""))},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The flags that differ", openapi.Ref("Comparison")),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/drift", &openapi.Operation{
+		OperationID: routenames.AdminProjectDrift,
+		Summary:     "Report drift from expected matches",
+		Tags:        []string{"declarative"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Drift per environment with an expected match", openapi.Object(map[string]*openapi.Schema{
+				"drift": openapi.Array(openapi.Ref("Drift")),
+			}, "drift")),
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/evaluate", &openapi.Operation{
+		OperationID: routenames.AdminProjectEvaluate,
+		Summary:     "Evaluate flags for a context",
+		Description: "Evaluates every flag as the SDKs would and traces which prerequisites, strategies and constraints matched, with the computed rollout bucket.",
+		Tags:        []string{"declarative"},
+		RequestBody: jsonBody(openapi.Ref("EvaluateInput"), map[string]any{
+			"environments": []string{"production"},
+			"context":      map[string]any{"userId": "user-42", "properties": map[string]string{"plan": "pro"}},
+		}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Results per environment and flag", openapi.Ref("Evaluation")),
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+
+	// Environments
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/environments", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentList,
+		Summary:     "List environments",
+		Tags:        []string{"environments"},
+		Parameters:  listQueryParams([]string{"sort_order", "name", "created_at"}, "sort_order", "name"),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("A page of environments", pageSchema(openapi.Ref("Environment"))),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/environments", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentCreate,
+		Summary:     "Create an environment",
+		Tags:        []string{"environments"},
+		RequestBody: jsonBody(openapi.Ref("EnvironmentInput"), map[string]any{"name": "staging", "type": "staging", "sort_order": 1}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The new environment", openapi.Ref("Environment")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/environments/:envId", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentUpdate,
+		Summary:     "Update an environment",
+		Description: "Omitted fields are left unchanged.",
+		Tags:        []string{"environments"},
+		RequestBody: jsonBody(openapi.Ref("EnvironmentInput"), nil),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The updated environment", openapi.Ref("Environment")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/environments/:envId", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentDelete,
+		Summary:     "Move an environment to the trash",
+		Tags:        []string{"environments"},
+		Responses:   map[string]*openapi.Response{"200": ok},
+	})
+
+	// Freeze and kill switch
+	frozen := jsonResponse("The freeze", openapi.Object(map[string]*openapi.Schema{
+		"freeze": openapi.Ref("Freeze"),
+	}, "freeze"))
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/freeze", &openapi.Operation{
+		OperationID: routenames.AdminProjectFreeze,
+		Summary:     "Freeze a project",
+		Description: "Until the freeze is lifted, users other than admins cannot change the project in the dashboard. Admin API tokens are not stopped. Freezing again replaces the reason.",
+		Tags:        []string{"projects"},
+		RequestBody: jsonBody(openapi.Ref("ReasonInput"), map[string]any{"reason": "Incident 42: checkout errors"}),
+		Responses: map[string]*openapi.Response{
+			"200": frozen,
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/freeze", &openapi.Operation{
+		OperationID: routenames.AdminProjectUnfreeze,
+		Summary:     "Lift the freeze of a project",
+		Tags:        []string{"projects"},
+		Responses:   map[string]*openapi.Response{"200": ok},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/environments/:envId/freeze", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentFreeze,
+		Summary:     "Freeze an environment",
+		Description: "Like freezing the project, for one environment.",
+		Tags:        []string{"environments"},
+		RequestBody: jsonBody(openapi.Ref("ReasonInput"), map[string]any{"reason": "Release freeze for the holidays"}),
+		Responses: map[string]*openapi.Response{
+			"200": frozen,
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/environments/:envId/freeze", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentUnfreeze,
+		Summary:     "Lift the freeze of an environment",
+		Tags:        []string{"environments"},
+		Responses:   map[string]*openapi.Response{"200": ok},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/environments/:envId/kill-switch", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentKillSwitch,
+		Summary:     "Turn off every kill switch",
+		Description: "Turns off every flag of type kill_switch that is on in the environment, in one transaction, and records a version of each with the reason. Works during a freeze.",
+		Tags:        []string{"environments"},
+		RequestBody: jsonBody(openapi.Ref("ReasonInput"), map[string]any{"reason": "Incident 42: payment provider down"}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The flags turned off", openapi.Object(map[string]*openapi.Schema{
+				"environment": openapi.String(""),
+				"flags":       openapi.Array(openapi.String("")),
+			}, "environment", "flags")),
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+
+	// Flags
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags", &openapi.Operation{
+		OperationID: routenames.AdminFlagList,
+		Summary:     "List flags",
+		Tags:        []string{"flags"},
+		Parameters: append(listQueryParams(flagSorts, "name", "name and description"),
+			componentParam("TagQuery"),
+			queryParam("owner", "Only flags of this owner", openapi.String("")),
+			queryParam("type", "Only flags of this type", openapi.Ref("FlagType")),
+			queryParam("enabled_in", "Only flags enabled in the environment of this name", openapi.String("")),
+		),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("A page of flags", pageSchema(openapi.Ref("Flag"))),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/tags", &openapi.Operation{
+		OperationID: routenames.AdminTagList,
+		Summary:     "List tags",
+		Tags:        []string{"flags"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The tags of the project", openapi.Object(map[string]*openapi.Schema{
+				"tags": openapi.Array(openapi.Ref("Tag")),
+			}, "tags")),
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags", &openapi.Operation{
+		OperationID: routenames.AdminFlagCreate,
+		Summary:     "Create a flag",
+		Tags:        []string{"flags"},
+		RequestBody: jsonBody(openapi.Ref("FlagInput"), map[string]any{
+			"name": "new-checkout", "flag_type": "release", "owner": "payments", "tags": []string{"checkout"},
+		}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The new flag", openapi.Ref("Flag")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags/:flagId", &openapi.Operation{
+		OperationID: routenames.AdminFlagGet,
+		Summary:     "Get a flag",
+		Tags:        []string{"flags"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The flag with its configuration per environment", openapi.Ref("FlagDetail")),
+		},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/flags/:flagId", &openapi.Operation{
+		OperationID: routenames.AdminFlagUpdate,
+		Summary:     "Update a flag",
+		Description: "Omitted fields are left unchanged; tags, links and metadata are replaced when present.",
+		Tags:        []string{"flags"},
+		RequestBody: jsonBody(openapi.Ref("FlagInput"), nil),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The updated flag", openapi.Ref("Flag")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/flags/:flagId", &openapi.Operation{
+		OperationID: routenames.AdminFlagDelete,
+		Summary:     "Move a flag to the trash",
+		Tags:        []string{"flags"},
+		Responses: map[string]*openapi.Response{
+			"200": ok,
+			"409": jsonResponse("The flag is a prerequisite of other flags", openapi.Object(map[string]*openapi.Schema{
+				"error":      openapi.String(""),
+				"dependents": openapi.Array(openapi.Ref("Dependent")),
+			}, "error", "dependents")),
+		},
+	})
+	addAdmin(doc, http.MethodPatch, "/api/admin/projects/:id/flags/:flagId/environments/:envId", &openapi.Operation{
+		OperationID: routenames.AdminFlagEnvPatch,
+		Summary:     "Configure a flag in an environment",
+		Description: "Toggles the flag and replaces its strategies or prerequisites. Omitted fields are left unchanged. Send If-Match to reject the change when someone else changed the configuration since it was read.",
+		Tags:        []string{"flags"},
+		Parameters:  []*openapi.Parameter{componentParam("IfMatch")},
+		RequestBody: jsonBody(openapi.Ref("FlagEnvironmentPatch"), map[string]any{
+			"enabled": true,
+			"strategies": []map[string]any{{
+				"name":       "gradualRollout",
+				"parameters": map[string]any{"rollout": 50, "stickiness": "userId"},
+				"constraints": []map[string]any{{
+					"context_name": "region", "operator": "IN", "values": []string{"us-east", "us-west"},
+				}},
+			}},
+		}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The flag's configuration in the environment", openapi.Ref("FlagEnvironment")),
+			"400": badRequest,
+			"409": componentResponse("RevisionConflict"),
+			"422": invalid,
+		},
+	})
+
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/bulk", &openapi.Operation{
+		OperationID: routenames.AdminFlagBulk,
+		Summary:     "Change many flags at once",
+		Description: "Toggles, replaces the strategies of, deletes or tags many flags across environments. Connected SDKs are notified once per affected environment.",
+		Tags:        []string{"flags"},
+		RequestBody: jsonBody(openapi.Ref("BulkRequest"), map[string]any{
+			"operations": []map[string]any{
+				{"op": "toggle", "flag_ids": []int{3, 4}, "environment_ids": []int{2}, "enabled": false},
+				{"op": "tag", "flag_ids": []int{3, 4}, "add_tags": []string{"incident-42"}},
+			},
+		}),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The result of every item", openapi.Object(map[string]*openapi.Schema{
+				"results":   openapi.Array(openapi.Ref("BulkResult")),
+				"succeeded": openapi.Integer(""),
+				"failed":    openapi.Integer(""),
+			}, "results", "succeeded", "failed")),
+			"400": badRequest,
+			"409": jsonResponse("An item failed and nothing was changed", openapi.Object(map[string]*openapi.Schema{
+				"error":   openapi.String(""),
+				"results": openapi.Array(openapi.Ref("BulkResult")),
+			}, "error", "results")),
+			"422": invalid,
+		},
+	})
+
+	// Strategies
+	strategyList := openapi.Object(map[string]*openapi.Schema{
+		"strategies": openapi.Array(openapi.Ref("StrategyDefinition")),
+	}, "strategies")
+	inUse := jsonResponse("Flags use the strategy", openapi.Object(map[string]*openapi.Schema{
+		"error": openapi.String(""),
+		"flags": openapi.Array(openapi.String("")),
+	}, "error", "flags"))
+	addAdmin(doc, http.MethodGet, "/api/admin/strategies", &openapi.Operation{
+		OperationID: routenames.AdminStrategyList,
+		Summary:     "List built-in strategies",
+		Description: "Built-in strategies and their parameters.",
+		Tags:        []string{"strategies"},
+		Responses:   map[string]*openapi.Response{"200": jsonResponse("Built-in strategies", strategyList)},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/strategy-definitions", &openapi.Operation{
+		OperationID: routenames.AdminStrategyDefinitionList,
+		Summary:     "List the strategies of a project",
+		Description: "The built-in strategies followed by the project's custom ones. Strategy writes are validated against this list.",
+		Tags:        []string{"strategies"},
+		Responses:   map[string]*openapi.Response{"200": jsonResponse("Available strategies", strategyList)},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/strategy-definitions", &openapi.Operation{
+		OperationID: routenames.AdminStrategyDefinitionCreate,
+		Summary:     "Create a custom strategy",
+		Tags:        []string{"strategies"},
+		RequestBody: jsonBody(openapi.Ref("StrategyDefinitionInput"), map[string]any{
+			"name":        "tenantPlan",
+			"description": "On for tenants on the listed plans",
+			"parameters": []map[string]any{
+				{"name": "plans", "label": "Plans", "type": "list", "required": true},
+			},
+		}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The new strategy", openapi.Ref("StrategyDefinition")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/strategy-definitions/:definitionId", &openapi.Operation{
+		OperationID: routenames.AdminStrategyDefinitionUpdate,
+		Summary:     "Update a custom strategy",
+		Description: "Omitted fields are left unchanged. A strategy cannot be renamed while flags use it.",
+		Tags:        []string{"strategies"},
+		RequestBody: jsonBody(openapi.Ref("StrategyDefinitionInput"), nil),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The updated strategy", openapi.Ref("StrategyDefinition")),
+			"400": badRequest,
+			"409": inUse,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/strategy-definitions/:definitionId", &openapi.Operation{
+		OperationID: routenames.AdminStrategyDefinitionDelete,
+		Summary:     "Delete a custom strategy",
+		Description: "Refused while any flag of the project, including flags in the trash, uses the strategy.",
+		Tags:        []string{"strategies"},
+		Responses: map[string]*openapi.Response{
+			"200": ok,
+			"409": inUse,
+		},
+	})
+
+	// Context fields
+	fieldInUse := jsonResponse("Flags test the context field", openapi.Object(map[string]*openapi.Schema{
+		"error": openapi.String(""),
+		"flags": openapi.Array(openapi.String("")),
+	}, "error", "flags"))
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/context-fields", &openapi.Operation{
+		OperationID: routenames.AdminContextFieldList,
+		Summary:     "List the context fields of a project",
+		Description: "The built-in fields followed by the registered ones, with the operators allowed for each field type. Once a project registers a field, constraints may only test listed fields.",
+		Tags:        []string{"context fields"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Context fields", openapi.Object(map[string]*openapi.Schema{
+				"context_fields": openapi.Array(openapi.Ref("ContextField")),
+				"operators":      openapi.Map(openapi.Array(openapi.String(""))),
+			}, "context_fields", "operators")),
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/context-fields", &openapi.Operation{
+		OperationID: routenames.AdminContextFieldCreate,
+		Summary:     "Register a context field",
+		Tags:        []string{"context fields"},
+		RequestBody: jsonBody(openapi.Ref("ContextFieldInput"), map[string]any{
+			"name":         "region",
+			"description":  "Region the user's account lives in",
+			"type":         "string",
+			"legal_values": []string{"eu", "us", "apac"},
+		}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The new context field", openapi.Ref("ContextField")),
+			"400": badRequest,
+			"409": conflict,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPut, "/api/admin/projects/:id/context-fields/:fieldId", &openapi.Operation{
+		OperationID: routenames.AdminContextFieldUpdate,
+		Summary:     "Update a context field",
+		Description: "Omitted fields are left unchanged. A field cannot be renamed or change type while constraints test it.",
+		Tags:        []string{"context fields"},
+		RequestBody: jsonBody(openapi.Ref("ContextFieldInput"), nil),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The updated context field", openapi.Ref("ContextField")),
+			"400": badRequest,
+			"409": fieldInUse,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/projects/:id/context-fields/:fieldId", &openapi.Operation{
+		OperationID: routenames.AdminContextFieldDelete,
+		Summary:     "Delete a context field",
+		Description: "Refused while any constraint of the project, including those of flags in the trash, tests the field.",
+		Tags:        []string{"context fields"},
+		Responses: map[string]*openapi.Response{
+			"200": ok,
+			"409": fieldInUse,
+		},
+	})
+
+	// Version history
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags/:flagId/environments/:envId/versions", &openapi.Operation{
+		OperationID: routenames.AdminVersionList,
+		Summary:     "List versions",
+		Tags:        []string{"versions"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Versions, newest first", openapi.Object(map[string]*openapi.Schema{
+				"versions": openapi.Array(openapi.Ref("FlagVersion")),
+			}, "versions")),
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags/:flagId/environments/:envId/versions/diff", &openapi.Operation{
+		OperationID: routenames.AdminVersionDiff,
+		Summary:     "Diff two versions",
+		Tags:        []string{"versions"},
+		Parameters: []*openapi.Parameter{
+			{Name: "from", In: "query", Required: true, Schema: openapi.Integer("")},
+			{Name: "to", In: "query", Required: true, Schema: openapi.Integer("")},
+		},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The settings that differ", openapi.Object(map[string]*openapi.Schema{
+				"from":    openapi.Integer(""),
+				"to":      openapi.Integer(""),
+				"changes": openapi.Array(openapi.Ref("FieldChange")),
+			}, "from", "to", "changes")),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/environments/:envId/versions/:version/restore", &openapi.Operation{
+		OperationID: routenames.AdminVersionRestore,
+		Summary:     "Restore a version",
+		Description: "Reverts the flag in the environment to the version, recording a new version. Honors If-Match like the PATCH of the configuration.",
+		Tags:        []string{"versions"},
+		Parameters:  []*openapi.Parameter{componentParam("IfMatch")},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The changes made", openapi.Object(map[string]*openapi.Schema{
+				"restored": openapi.Integer("The restored version"),
+				"revision": openapi.Integer("The new revision of the configuration"),
+				"summary":  openapi.Map(openapi.Integer("")),
+				"changes":  openapi.Array(openapi.Ref("Change")),
+			}, "restored", "revision", "summary", "changes")),
+			"400": badRequest,
+			"409": componentResponse("RevisionConflict"),
+			"422": invalid,
+		},
+	})
+
+	// Rollout plans
+	plan := jsonResponse("The plan", openapi.Ref("RolloutPlan"))
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags/:flagId/rollouts", &openapi.Operation{
+		OperationID: routenames.AdminRolloutList,
+		Summary:     "List rollout plans",
+		Description: "Plans of the flag in every environment, newest first.",
+		Tags:        []string{"rollouts"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Plans, newest first", openapi.Object(map[string]*openapi.Schema{
+				"plans": openapi.Array(openapi.Ref("RolloutPlan")),
+			}, "plans")),
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/rollouts", &openapi.Operation{
+		OperationID: routenames.AdminRolloutCreate,
+		Summary:     "Start a rollout plan",
+		Description: "Sets the rollout of a gradualRollout strategy to the percentage of the first step right away, then to each next one once the hold of the step before has passed. Each step bumps the revision and records a version by `rollout:<plan id>`. A plan waits while its project or environment is frozen, pauses when its rollout is changed by hand, and aborts when its strategy is deleted.",
+		Tags:        []string{"rollouts"},
+		RequestBody: jsonBody(openapi.Ref("RolloutPlanInput"), map[string]any{
+			"strategy_id": 7,
+			"steps": []map[string]any{
+				{"percentage": 1, "hold": "24h"},
+				{"percentage": 5, "hold": "48h"},
+				{"percentage": 25, "hold": "72h"},
+				{"percentage": 100},
+			},
+		}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The plan, at its first step", openapi.Ref("RolloutPlan")),
+			"400": badRequest,
+			"409": jsonResponse("The strategy already has a plan in progress", openapi.Ref("Error")),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/flags/:flagId/rollouts/:planId", &openapi.Operation{
+		OperationID: routenames.AdminRolloutGet,
+		Summary:     "Get a rollout plan",
+		Tags:        []string{"rollouts"},
+		Responses:   map[string]*openapi.Response{"200": plan},
+	})
+	wrongStatus := jsonResponse("The status of the plan does not allow it", openapi.Ref("Error"))
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/rollouts/:planId/pause", &openapi.Operation{
+		OperationID: routenames.AdminRolloutPause,
+		Summary:     "Pause a rollout plan",
+		Description: "Holds a running plan at its current step.",
+		Tags:        []string{"rollouts"},
+		Responses:   map[string]*openapi.Response{"200": plan, "409": wrongStatus},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/rollouts/:planId/resume", &openapi.Operation{
+		OperationID: routenames.AdminRolloutResume,
+		Summary:     "Resume a rollout plan",
+		Description: "Continues a paused plan. The time spent paused does not count towards the hold of the current step.",
+		Tags:        []string{"rollouts"},
+		Responses:   map[string]*openapi.Response{"200": plan, "409": wrongStatus},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/rollouts/:planId/abort", &openapi.Operation{
+		OperationID: routenames.AdminRolloutAbort,
+		Summary:     "Abort a rollout plan",
+		Description: "Stops a running or paused plan for good. The rollout stays at the percentage of the current step.",
+		Tags:        []string{"rollouts"},
+		Responses:   map[string]*openapi.Response{"200": plan, "409": wrongStatus},
+	})
+
+	// Trash
+	gone := jsonResponse("The retention window has passed", openapi.Ref("Error"))
+	addAdmin(doc, http.MethodGet, "/api/admin/projects/:id/trash", &openapi.Operation{
+		OperationID: routenames.AdminTrashList,
+		Summary:     "List the trash",
+		Description: "Flags and environments of the project in the trash, most recently deleted first.",
+		Tags:        []string{"trash"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("Trashed items", openapi.Object(map[string]*openapi.Schema{
+				"items": openapi.Array(openapi.Ref("TrashItem")),
+			}, "items")),
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/flags/:flagId/restore", &openapi.Operation{
+		OperationID: routenames.AdminFlagRestore,
+		Summary:     "Restore a flag from the trash",
+		Tags:        []string{"trash"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The restored flag", openapi.Ref("Flag")),
+			"409": conflict,
+			"410": gone,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/projects/:id/environments/:envId/restore", &openapi.Operation{
+		OperationID: routenames.AdminEnvironmentRestore,
+		Summary:     "Restore an environment from the trash",
+		Tags:        []string{"trash"},
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("The restored environment", openapi.Ref("Environment")),
+			"409": conflict,
+			"410": gone,
+		},
+	})
+
+	// Tokens
+	addAdmin(doc, http.MethodGet, "/api/admin/api-tokens", &openapi.Operation{
+		OperationID: routenames.AdminTokenList,
+		Summary:     "List API tokens",
+		Tags:        []string{"tokens"},
+		Parameters:  listQueryParams(tokenSorts, "created_at", "name"),
+		Responses: map[string]*openapi.Response{
+			"200": jsonResponse("A page of tokens of the project", pageSchema(openapi.Ref("Token"))),
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodPost, "/api/admin/api-tokens", &openapi.Operation{
+		OperationID: routenames.AdminTokenCreate,
+		Summary:     "Create an API token",
+		Tags:        []string{"tokens"},
+		RequestBody: jsonBody(openapi.Object(map[string]*openapi.Schema{
+			"name":        openapi.String(""),
+			"token_type":  openapi.Enum("", "client", "admin"),
+			"environment": openapi.String("Environment name; required for client tokens"),
+		}, "name", "token_type"), map[string]any{"name": "web-app", "token_type": "client", "environment": "production"}),
+		Responses: map[string]*openapi.Response{
+			"201": jsonResponse("The new token; raw_token is only shown once", &openapi.Schema{
+				Type: "object",
+				Properties: map[string]*openapi.Schema{
+					"id":          openapi.Integer(""),
+					"name":        openapi.String(""),
+					"token_type":  openapi.Enum("", "client", "admin"),
+					"environment": openapi.String(""),
+					"raw_token":   openapi.String("The secret to send as the bearer token"),
+					"created_at":  openapi.DateTime(""),
+				},
+				Required: []string{"id", "name", "token_type", "environment", "raw_token", "created_at"},
+			}),
+			"400": badRequest,
+			"422": invalid,
+		},
+	})
+	addAdmin(doc, http.MethodDelete, "/api/admin/api-tokens/:id", &openapi.Operation{
+		OperationID: routenames.AdminTokenDelete,
+		Summary:     "Delete an API token",
+		Tags:        []string{"tokens"},
+		Responses:   map[string]*openapi.Response{"200": ok},
+	})
+}
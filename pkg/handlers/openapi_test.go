@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/pkg/openapi"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+)
+
+func TestOpenAPI_MatchesRoutes(t *testing.T) {
+	doc := apiSpec()
+	methods := []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+	routed := map[string]bool{}
+	for _, r := range c.Web.Routes() {
+		if !strings.HasPrefix(r.Path, "/api/") || !slices.Contains(methods, r.Method) {
+			continue
+		}
+		routed[r.Method+" "+openapi.Path(r.Path)] = true
+
+		op := doc.Operation(r.Method, r.Path)
+		if assert.NotNil(t, op, "%s %s is not documented", r.Method, r.Path) {
+			assert.Equal(t, r.Name, op.OperationID, "%s %s", r.Method, r.Path)
+		}
+	}
+	require.NotEmpty(t, routed)
+
+	for path, item := range doc.Paths {
+		for method := range item {
+			key := strings.ToUpper(method) + " " + path
+			assert.True(t, routed[key], "%s is documented but not routed", key)
+		}
+	}
+}
+
+func TestOpenAPI_Spec(t *testing.T) {
+	resp, err := http.Get(srv.URL + c.Web.Reverse(routenames.OpenAPISpec))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var doc map[string]any
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&doc))
+	assert.Equal(t, openapi.Version, doc["openapi"])
+
+	// Every reference resolves.
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if ref, ok := v["$ref"].(string); ok {
+				target := any(doc)
+				for _, part := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+					m, _ := target.(map[string]any)
+					target = m[part]
+				}
+				assert.NotNil(t, target, "unresolved $ref %s", ref)
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+}
+
+func TestOpenAPI_ErrorShapes(t *testing.T) {
+	schemas := apiSpec().Components.Schemas
+
+	respond := func(write func(echo.Context) error) map[string]any {
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		require.NoError(t, write(ctx))
+		var body map[string]any
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		return body
+	}
+	keys := func(m map[string]any) []string {
+		out := make([]string, 0, len(m))
+		for k := range m {
+			out = append(out, k)
+		}
+		return out
+	}
+
+	body := respond(func(ctx echo.Context) error {
+		return jsonValidationError(ctx, map[string]string{"name": "Name is required"})
+	})
+	assert.ElementsMatch(t, schemas["ValidationError"].Required, keys(body))
+	assert.Equal(t, schemas["ValidationError"].Properties["error"].Enum, []any{body["error"]})
+
+	body = respond(func(ctx echo.Context) error {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	})
+	assert.ElementsMatch(t, schemas["Error"].Required, keys(body))
+}
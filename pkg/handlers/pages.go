@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/labstack/echo/v4"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 	inertia "github.com/romsar/gonertia/v2"
@@ -49,7 +50,9 @@ func (h *Pages) Strategies(ctx echo.Context) error {
 		ctx.Response().Writer,
 		ctx.Request(),
 		"Strategies",
-		inertia.Props{},
+		inertia.Props{
+			"strategies": declarative.BuiltinStrategies(),
+		},
 	)
 	if err != nil {
 		handleServerErr(ctx.Response().Writer, err)
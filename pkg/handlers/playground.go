@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// PlaygroundHandler evaluates the flags of a project for a context entered on
+// the dashboard and explains each result.
+type PlaygroundHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+}
+
+func init() {
+	Register(new(PlaygroundHandler))
+}
+
+func (h *PlaygroundHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	return nil
+}
+
+func (h *PlaygroundHandler) Routes(g *echo.Group) {
+	playground := g.Group("/projects/:projectId/playground", middleware.RequireAuth())
+	playground.GET("", h.Index).Name = routenames.PlaygroundIndex
+	playground.POST("", h.Evaluate).Name = routenames.PlaygroundEvaluate
+}
+
+func (h *PlaygroundHandler) Index(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	envs, err := h.ORM.Environment.Query().
+		Where(environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Order(environment.BySortOrder(), environment.ByName()).
+		All(reqCtx)
+	if err != nil {
+		return fail(err, "failed to load environments", h.Inertia, ctx)
+	}
+	envList := make([]map[string]any, 0, len(envs))
+	for _, e := range envs {
+		envList = append(envList, map[string]any{
+			"id":   e.ID,
+			"name": e.Name,
+			"type": e.Type,
+		})
+	}
+
+	fields, err := declarative.ProjectContextFields(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return fail(err, "failed to load context fields", h.Inertia, ctx)
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/Playground",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"environments":  envList,
+			"contextFields": fields,
+		},
+	)
+}
+
+// Evaluate returns the evaluation traces as JSON, in the shape of the admin
+// API's evaluate endpoint.
+func (h *PlaygroundHandler) Evaluate(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	if _, err := findProject(ctx.Request().Context(), h.ORM, projectID); err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]any{"error": "Project not found"})
+	}
+
+	var req EvaluateRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]any{"error": "Invalid request body"})
+	}
+
+	return evaluate(ctx, h.ORM, projectID, req)
+}
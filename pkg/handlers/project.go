@@ -1,19 +1,26 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/labstack/echo/v4"
 
 	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
 	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
 	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/tag"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
 	"github.com/felipekafuri/bandeira/pkg/form"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
 	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/pager"
 	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 	inertia "github.com/romsar/gonertia/v2"
@@ -22,6 +29,7 @@ import (
 type Project struct {
 	Inertia *inertia.Inertia
 	ORM     *ent.Client
+	Trash   *services.TrashService
 }
 
 type ProjectForm struct {
@@ -38,6 +46,7 @@ func init() {
 func (h *Project) Init(c *services.Container) error {
 	h.Inertia = c.Inertia
 	h.ORM = c.ORM
+	h.Trash = c.Trash
 	return nil
 }
 
@@ -45,6 +54,7 @@ func (h *Project) Routes(g *echo.Group) {
 	projects := g.Group("/projects", middleware.RequireAuth())
 	projects.GET("", h.Index).Name = routenames.ProjectIndex
 	projects.GET("/:id", h.Show).Name = routenames.ProjectShow
+	projects.GET("/:id/compare", h.Compare).Name = routenames.ProjectCompare
 
 	// Mutation routes require admin or editor role
 	mut := g.Group("/projects", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
@@ -56,15 +66,37 @@ func (h *Project) Routes(g *echo.Group) {
 }
 
 func (h *Project) Index(ctx echo.Context) error {
-	projects, err := h.ORM.Project.
+	page, err := pageParams(ctx, []string{"name", "created_at"}, "-created_at")
+	if err != nil {
+		return err
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	query := h.ORM.Project.
 		Query().
-		WithFlags().
-		WithEnvironments().
-		Order(project.ByCreatedAt(sql.OrderDesc())).
-		All(ctx.Request().Context())
+		Where(project.DeletedAtIsNil()).
+		Where(predicate.Project(page.Search(project.FieldName, project.FieldDescription)))
+	total, err := query.Clone().Count(reqCtx)
+	if err != nil {
+		return fail(err, "failed to count projects", h.Inertia, ctx)
+	}
+
+	projects, err := query.
+		Where(predicate.Project(page.After())).
+		Order(project.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		WithFlags(func(q *ent.FlagQuery) {
+			q.Where(entflag.DeletedAtIsNil())
+		}).
+		WithEnvironments(func(q *ent.EnvironmentQuery) {
+			q.Where(environment.DeletedAtIsNil())
+		}).
+		All(reqCtx)
 	if err != nil {
 		return fail(err, "failed to query projects", h.Inertia, ctx)
 	}
+	projects, next := pager.Trim(page, projects, func(p *ent.Project) int { return p.ID })
 
 	type projectItem struct {
 		ID               int    `json:"id"`
@@ -92,7 +124,8 @@ func (h *Project) Index(ctx echo.Context) error {
 		ctx.Request(),
 		"Projects/Index",
 		inertia.Props{
-			"projects": items,
+			"projects": pager.NewPage(items, next, total),
+			"list":     listState(page),
 		},
 	)
 }
@@ -114,6 +147,18 @@ func (h *Project) Store(ctx echo.Context) error {
 		return nil
 	}
 
+	// Names of projects in the trash stay reserved until they are purged.
+	exists, err := h.ORM.Project.Query().Where(project.Name(f.Name)).Exist(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to check project name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "A project with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
 	p, err := h.ORM.Project.
 		Create().
 		SetName(f.Name).
@@ -134,21 +179,46 @@ func (h *Project) Show(ctx echo.Context) error {
 	}
 
 	reqCtx := ctx.Request().Context()
+	filter := parseFlagFilter(ctx)
+	page, err := pageParams(ctx, flagSorts, "name")
+	if err != nil {
+		return err
+	}
 
 	p, err := h.ORM.Project.
 		Query().
-		Where(project.ID(id)).
-		WithFlags().
-		WithEnvironments().
+		Where(project.ID(id), project.DeletedAtIsNil()).
+		WithEnvironments(func(q *ent.EnvironmentQuery) {
+			q.Where(environment.DeletedAtIsNil())
+		}).
 		Only(reqCtx)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	// Query all FlagEnvironment records for this project's flags so we can
+	flagQuery := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(p.ID), entflag.DeletedAtIsNil()).
+		Where(filter.predicates()...).
+		Where(predicate.Flag(page.Search(entflag.FieldName, entflag.FieldDescription)))
+	total, err := flagQuery.Clone().Count(reqCtx)
+	if err != nil {
+		return fail(err, "failed to count flags", h.Inertia, ctx)
+	}
+	pageFlags, err := flagQuery.
+		Where(predicate.Flag(page.After())).
+		Order(entflag.OrderOption(page.Order())).
+		Limit(page.Fetch()).
+		WithTags().
+		All(reqCtx)
+	if err != nil {
+		return fail(err, "failed to load flags", h.Inertia, ctx)
+	}
+	pageFlags, next := pager.Trim(page, pageFlags, func(f *ent.Flag) int { return f.ID })
+
+	// Query the FlagEnvironment records of the flags on this page so we can
 	// build the matrix of enabled states.
-	flagIDs := make([]int, 0, len(p.Edges.Flags))
-	for _, f := range p.Edges.Flags {
+	flagIDs := make([]int, 0, len(pageFlags))
+	for _, f := range pageFlags {
 		flagIDs = append(flagIDs, f.ID)
 	}
 
@@ -156,6 +226,7 @@ func (h *Project) Show(ctx echo.Context) error {
 		FlagID        int  `json:"flagId"`
 		EnvironmentID int  `json:"environmentId"`
 		Enabled       bool `json:"enabled"`
+		Revision      int  `json:"revision"`
 	}
 
 	var toggles []toggleState
@@ -170,37 +241,63 @@ func (h *Project) Show(ctx echo.Context) error {
 					FlagID:        fe.FlagID,
 					EnvironmentID: fe.EnvironmentID,
 					Enabled:       fe.Enabled,
+					Revision:      fe.Revision,
 				})
 			}
 		}
 	}
 
 	type flagItem struct {
-		ID          int    `json:"id"`
-		Name        string `json:"name"`
-		Description string `json:"description"`
-		FlagType    string `json:"flagType"`
-		CreatedAt   string `json:"createdAt"`
+		ID          int      `json:"id"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		FlagType    string   `json:"flagType"`
+		Owner       string   `json:"owner"`
+		Tags        []string `json:"tags"`
+		CreatedAt   string   `json:"createdAt"`
 	}
 
 	type envItem struct {
-		ID        int    `json:"id"`
-		Name      string `json:"name"`
-		Type      string `json:"type"`
-		SortOrder int    `json:"sortOrder"`
+		ID        int            `json:"id"`
+		Name      string         `json:"name"`
+		Type      string         `json:"type"`
+		SortOrder int            `json:"sortOrder"`
+		Freeze    map[string]any `json:"freeze"`
 	}
 
-	flags := make([]flagItem, 0, len(p.Edges.Flags))
-	for _, f := range p.Edges.Flags {
+	flags := make([]flagItem, 0, len(pageFlags))
+	for _, f := range pageFlags {
 		flags = append(flags, flagItem{
 			ID:          f.ID,
 			Name:        f.Name,
 			Description: f.Description,
 			FlagType:    string(f.FlagType),
+			Owner:       f.Owner,
+			Tags:        declarative.TagNames(f.Edges.Tags),
 			CreatedAt:   f.CreatedAt.Format("Jan 2, 2006"),
 		})
 	}
 
+	// Options for the filter bar come from the whole project, not just the
+	// flags that match the current filter.
+	tags, err := h.ORM.Tag.Query().
+		Where(tag.ProjectID(p.ID)).
+		Order(tag.ByName()).
+		Select(tag.FieldName).
+		Strings(reqCtx)
+	if err != nil {
+		return fail(err, "failed to load tags", h.Inertia, ctx)
+	}
+	owners, err := h.ORM.Flag.Query().
+		Where(entflag.ProjectID(p.ID), entflag.DeletedAtIsNil(), entflag.OwnerNEQ("")).
+		Unique(true).
+		Order(entflag.ByOwner()).
+		Select(entflag.FieldOwner).
+		Strings(reqCtx)
+	if err != nil {
+		return fail(err, "failed to load owners", h.Inertia, ctx)
+	}
+
 	envs := make([]envItem, 0, len(p.Edges.Environments))
 	for _, e := range p.Edges.Environments {
 		envs = append(envs, envItem{
@@ -208,6 +305,7 @@ func (h *Project) Show(ctx echo.Context) error {
 			Name:      e.Name,
 			Type:      string(e.Type),
 			SortOrder: e.SortOrder,
+			Freeze:    freezeProps(declarative.EnvironmentFreeze(e)),
 		})
 	}
 
@@ -221,10 +319,85 @@ func (h *Project) Show(ctx echo.Context) error {
 				"name":         p.Name,
 				"description":  p.Description,
 				"createdAt":    p.CreatedAt.Format("Jan 2, 2006"),
-				"flags":        flags,
+				"flags":        pager.NewPage(flags, next, total),
 				"environments": envs,
 				"toggles":      toggles,
+				"freeze":       freezeProps(declarative.ProjectFreeze(p)),
+			},
+			"filter": filter,
+			"list":   listState(page),
+			"tags":   tags,
+			"owners": owners,
+		},
+	)
+}
+
+// Compare shows the differences between the environments selected with the
+// env query parameter (the first two by default) and the drift of every
+// environment from the one it is expected to match.
+func (h *Project) Compare(ctx echo.Context) error {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, id)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	envs, err := h.ORM.Environment.Query().
+		Where(environment.ProjectID(id), environment.DeletedAtIsNil()).
+		Order(environment.BySortOrder(), environment.ByName()).
+		All(reqCtx)
+	if err != nil {
+		return fail(err, "failed to load environments", h.Inertia, ctx)
+	}
+
+	names := make([]string, len(envs))
+	for i, e := range envs {
+		names[i] = e.Name
+	}
+
+	selected := ctx.QueryParams()["env"]
+	if len(selected) == 0 && len(names) >= 2 {
+		selected = names[:2]
+	}
+	if selected == nil {
+		selected = []string{}
+	}
+
+	var compareError string
+	comparison, err := declarative.Compare(reqCtx, h.ORM, id, selected)
+	if err != nil {
+		var verr *declarative.ValidationError
+		if !errors.As(err, &verr) {
+			return fail(err, "failed to compare environments", h.Inertia, ctx)
+		}
+		compareError = verr.Fields["environments"]
+	}
+
+	drift, err := declarative.DriftReport(reqCtx, h.ORM, id)
+	if err != nil {
+		return fail(err, "failed to compute drift", h.Inertia, ctx)
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/Compare",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
 			},
+			"environments": names,
+			"selected":     selected,
+			"comparison":   comparison,
+			"compareError": compareError,
+			"drift":        drift,
 		},
 	)
 }
@@ -235,7 +408,7 @@ func (h *Project) Edit(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
 
-	p, err := h.ORM.Project.Get(ctx.Request().Context(), id)
+	p, err := findProject(ctx.Request().Context(), h.ORM, id)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
@@ -259,6 +432,9 @@ func (h *Project) Update(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, id) {
+		return nil
+	}
 
 	var f ProjectForm
 	if err := form.Submit(ctx, &f); err != nil {
@@ -267,6 +443,19 @@ func (h *Project) Update(ctx echo.Context) error {
 		return nil
 	}
 
+	exists, err := h.ORM.Project.Query().
+		Where(project.Name(f.Name), project.IDNEQ(id)).
+		Exist(ctx.Request().Context())
+	if err != nil {
+		return fail(err, "failed to check project name", h.Inertia, ctx)
+	}
+	if exists {
+		f.SetFieldError("Name", "A project with this name already exists or is in the trash.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
 	_, err = h.ORM.Project.
 		UpdateOneID(id).
 		SetName(f.Name).
@@ -286,15 +475,45 @@ func (h *Project) Delete(ctx echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
 	}
+	if frozen(ctx, h.ORM, h.Inertia, id, projectEnvironmentIDs(ctx, h.ORM, id)...) {
+		return nil
+	}
 
-	if err := h.ORM.Project.DeleteOneID(id).Exec(ctx.Request().Context()); err != nil {
+	if err := h.Trash.Trash(ctx.Request().Context(), services.TrashProject, id); err != nil {
 		return fail(err, "failed to delete project", h.Inertia, ctx)
 	}
 
-	msg.Success(ctx, "Project deleted successfully.")
+	msg.Success(ctx, "Project moved to the trash.")
 	return ctx.Redirect(http.StatusSeeOther, "/projects")
 }
 
+// pageParams parses the limit, cursor, sort and q parameters of a dashboard
+// list page. Invalid parameters fail the request with 400.
+func pageParams(ctx echo.Context, sorts []string, defaultSort string) (pager.Params, error) {
+	page, err := pager.Parse(ctx.QueryParams(), sorts, defaultSort)
+	var pe *pager.ParamError
+	if errors.As(err, &pe) {
+		return page, echo.NewHTTPError(http.StatusBadRequest, pe.Message)
+	}
+	return page, err
+}
+
+// listState is shared with list pages so that their search and sort controls
+// reflect the current request.
+func listState(page pager.Params) map[string]any {
+	return map[string]any{
+		"sort": page.Sort,
+		"q":    page.Query,
+	}
+}
+
+// findProject loads a project that is not in the trash.
+func findProject(ctx context.Context, orm *ent.Client, id int) (*ent.Project, error) {
+	return orm.Project.Query().
+		Where(project.ID(id), project.DeletedAtIsNil()).
+		Only(ctx)
+}
+
 func nilIfEmpty(s string) *string {
 	if s == "" {
 		return nil
@@ -0,0 +1,230 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// RolloutHandler serves the rollout plans of the flag edit page.
+type RolloutHandler struct {
+	Inertia  *inertia.Inertia
+	ORM      *ent.Client
+	Flags    *services.FlagService
+	Rollouts *services.RolloutService
+}
+
+// rolloutRequest starts a plan for a gradualRollout strategy.
+type rolloutRequest struct {
+	StrategyID int                         `json:"strategy_id"`
+	Steps      []services.RolloutStepInput `json:"steps"`
+}
+
+func init() {
+	Register(new(RolloutHandler))
+}
+
+func (h *RolloutHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	h.Flags = c.Flags
+	h.Rollouts = c.Rollouts
+	return nil
+}
+
+func (h *RolloutHandler) Routes(g *echo.Group) {
+	flags := g.Group("/projects/:projectId/flags", middleware.RequireAuth())
+	flags.GET("/:id/rollouts", h.List).Name = routenames.RolloutList
+
+	mut := g.Group("/projects/:projectId/flags", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
+	mut.POST("/:id/rollouts", h.Store).Name = routenames.RolloutStore
+	mut.POST("/:id/rollouts/:planId/pause", h.Pause).Name = routenames.RolloutPause
+	mut.POST("/:id/rollouts/:planId/resume", h.Resume).Name = routenames.RolloutResume
+	mut.POST("/:id/rollouts/:planId/abort", h.Abort).Name = routenames.RolloutAbort
+}
+
+// List returns the plans of a flag, newest first; the env query parameter
+// narrows them to one environment.
+func (h *RolloutHandler) List(ctx echo.Context) error {
+	projectID, flagID, ok := rolloutParams(ctx)
+	if !ok {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+	envID, _ := strconv.Atoi(ctx.QueryParam("env"))
+
+	plans, err := h.Rollouts.List(ctx.Request().Context(), projectID, flagID)
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "failed to load rollout plans")
+	}
+	out := make([]map[string]any, 0, len(plans))
+	for _, p := range plans {
+		if envID == 0 || p.Edges.FlagEnvironment.EnvironmentID == envID {
+			out = append(out, rolloutPlanDTO(p))
+		}
+	}
+	return ctx.JSON(http.StatusOK, map[string]any{"plans": out})
+}
+
+// Store starts a plan. Starting one applies its first step, so a freeze
+// stops it like any other change.
+func (h *RolloutHandler) Store(ctx echo.Context) error {
+	projectID, flagID, ok := rolloutParams(ctx)
+	if !ok {
+		return jsonError(ctx, http.StatusNotFound, "Flag not found")
+	}
+
+	var input rolloutRequest
+	if err := json.NewDecoder(ctx.Request().Body).Decode(&input); err != nil {
+		return jsonError(ctx, http.StatusBadRequest, "Invalid request body")
+	}
+
+	reqCtx := ctx.Request().Context()
+	env, err := h.Flags.StrategyEnvironment(reqCtx, projectID, flagID, input.StrategyID)
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "failed to start rollout plan")
+	}
+	if frozen(ctx, h.ORM, h.Inertia, projectID, env.ID) {
+		return nil
+	}
+
+	p, err := h.Rollouts.Create(reqCtx, projectID, flagID, input.StrategyID, input.Steps, userActor(ctx))
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "failed to start rollout plan")
+	}
+	return ctx.JSON(http.StatusCreated, rolloutPlanDTO(p))
+}
+
+// Pause stops a plan at its current step. It works during a freeze.
+func (h *RolloutHandler) Pause(ctx echo.Context) error {
+	return h.action(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Pause(ctx.Request().Context(), projectID, flagID, planID, userActor(ctx))
+	})
+}
+
+// Resume continues a paused plan. A freeze stops it, since the plan would go
+// on to change the rollout.
+func (h *RolloutHandler) Resume(ctx echo.Context) error {
+	return h.action(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		reqCtx := ctx.Request().Context()
+		p, err := h.Rollouts.Get(reqCtx, projectID, flagID, planID)
+		if err != nil {
+			return nil, err
+		}
+		if frozen(ctx, h.ORM, h.Inertia, projectID, p.Edges.FlagEnvironment.EnvironmentID) {
+			return nil, nil
+		}
+		return h.Rollouts.Resume(reqCtx, projectID, flagID, planID)
+	})
+}
+
+// Abort stops a plan for good. It works during a freeze.
+func (h *RolloutHandler) Abort(ctx echo.Context) error {
+	return h.action(ctx, func(projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+		return h.Rollouts.Abort(ctx.Request().Context(), projectID, flagID, planID, userActor(ctx))
+	})
+}
+
+// action runs a change to the plan in the path and writes the plan. A nil
+// plan without an error means fn has answered the request.
+func (h *RolloutHandler) action(ctx echo.Context, fn func(projectID, flagID, planID int) (*ent.RolloutPlan, error)) error {
+	projectID, flagID, ok := rolloutParams(ctx)
+	planID, err := strconv.Atoi(ctx.Param("planId"))
+	if !ok || err != nil {
+		return jsonError(ctx, http.StatusNotFound, "Rollout plan not found")
+	}
+
+	p, err := fn(projectID, flagID, planID)
+	if err != nil {
+		return rolloutError(ctx, h.ORM, err, "failed to update rollout plan")
+	}
+	if p == nil {
+		return nil
+	}
+	return ctx.JSON(http.StatusOK, rolloutPlanDTO(p))
+}
+
+// rolloutParams parses the project and flag IDs of a dashboard rollout
+// route.
+func rolloutParams(ctx echo.Context) (projectID, flagID int, ok bool) {
+	projectID, err1 := strconv.Atoi(ctx.Param("projectId"))
+	flagID, err2 := strconv.Atoi(ctx.Param("id"))
+	return projectID, flagID, err1 == nil && err2 == nil
+}
+
+// rolloutError writes the response for an error of the RolloutService.
+// Shared by the admin API and the flag edit page.
+func rolloutError(ctx echo.Context, orm *ent.Client, err error, msg string) error {
+	var serr *services.RolloutStateError
+	switch {
+	case errors.Is(err, services.ErrRolloutPlanNotFound):
+		return jsonError(ctx, http.StatusNotFound, "Rollout plan not found")
+	case errors.Is(err, services.ErrRolloutInProgress):
+		return jsonError(ctx, http.StatusConflict, "The strategy already has a rollout plan in progress")
+	case errors.As(err, &serr):
+		return jsonError(ctx, http.StatusConflict, "Rollout plan is "+string(serr.Status))
+	}
+	return flagServiceError(ctx, orm, err, msg)
+}
+
+// rolloutPlanDTO converts a plan, with its flag environment loaded, for the
+// admin API and the flag edit page.
+func rolloutPlanDTO(p *ent.RolloutPlan) map[string]any {
+	steps := make([]map[string]any, 0, len(p.Steps))
+	for _, s := range p.Steps {
+		steps = append(steps, map[string]any{
+			"percentage": s.Percentage,
+			"hold":       formatHold(time.Duration(s.HoldSeconds) * time.Second),
+		})
+	}
+
+	dto := map[string]any{
+		"id":              p.ID,
+		"strategy_id":     p.StrategyID,
+		"status":          string(p.Status),
+		"status_reason":   p.StatusReason,
+		"steps":           steps,
+		"current_step":    p.CurrentStep,
+		"percentage":      p.Steps[p.CurrentStep].Percentage,
+		"step_started_at": timeRFC3339(p.StepStartedAt),
+		"paused_at":       nil,
+		"next_step_at":    nil,
+		"created_by":      p.CreatedBy,
+		"created_at":      timeRFC3339(p.CreatedAt),
+		"updated_at":      timeRFC3339(p.UpdatedAt),
+	}
+	if fe := p.Edges.FlagEnvironment; fe != nil {
+		dto["flag_id"] = fe.FlagID
+		dto["environment_id"] = fe.EnvironmentID
+	}
+	if p.PausedAt != nil {
+		dto["paused_at"] = timeRFC3339(*p.PausedAt)
+	}
+	if at := services.RolloutNextAt(p); at != nil {
+		dto["next_step_at"] = timeRFC3339(*at)
+	}
+	return dto
+}
+
+// formatHold writes a hold without the zero units time.Duration adds, e.g.
+// "24h" rather than "24h0m0s".
+func formatHold(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = strings.TrimSuffix(s, "0s")
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+	return s
+}
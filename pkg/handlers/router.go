@@ -11,11 +11,25 @@ import (
 	"github.com/felipekafuri/bandeira/config"
 	"github.com/felipekafuri/bandeira/pkg/context"
 	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
 	"github.com/felipekafuri/bandeira/pkg/services"
 )
 
 // BuildRouter builds the router.
 func BuildRouter(c *services.Container) error {
+	// Request tracing and metrics for every route, including static files.
+	c.Web.Use(
+		middleware.Tracing(c.Tracing.Provider, c.Web),
+		middleware.Metrics(c.Metrics, c.Web),
+	)
+
+	// Prometheus scrape endpoint, outside the session and API groups.
+	if c.Config.Metrics.Enabled {
+		c.Web.GET("/metrics", echo.WrapHandler(c.Metrics.Handler()),
+			middleware.RequireBearerToken(c.Config.Metrics.Token),
+		).Name = routenames.Metrics
+	}
+
 	// Static files with proper cache control.
 	c.Web.Group("", middleware.CacheControl(c.Config.Cache.Expiration.StaticFile)).
 		Static(config.StaticPrefix, config.StaticDir)
@@ -107,6 +121,10 @@ func BuildRouter(c *services.Container) error {
 
 		h.Routes(g)
 
+		if rootH, ok := h.(RootHandler); ok {
+			rootH.RootRoutes(c.Web)
+		}
+
 		if apiH, ok := h.(APIHandler); ok {
 			apiH.APIRoutes(api)
 		}
@@ -1,20 +1,25 @@
 package handlers
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/cookiejar"
 	"net/http/httptest"
 	"net/url"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent/user"
 	"github.com/felipekafuri/bandeira/pkg/services"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
 var (
@@ -136,3 +141,68 @@ func (h *httpResponse) toDoc() *goquery.Document {
 	assert.NoError(h.t, err)
 	return doc
 }
+
+// dashboardClient logs in as a new user with the given role and returns a
+// client carrying the session and XSRF cookies. Redirects are not followed.
+func dashboardClient(t *testing.T, role user.Role) *http.Client {
+	t.Helper()
+	email := string(role) + "-" + strings.ToLower(strings.ReplaceAll(t.Name(), "/", "-")) + "@example.com"
+	hash, err := bcrypt.GenerateFromPassword([]byte("secret-password"), bcrypt.MinCost)
+	require.NoError(t, err)
+	c.ORM.User.Create().
+		SetEmail(email).
+		SetPassword(string(hash)).
+		SetName(string(role)).
+		SetRole(role).
+		SaveX(context.Background())
+
+	jar, err := cookiejar.New(nil)
+	require.NoError(t, err)
+	client := &http.Client{
+		Jar: jar,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	resp, err := client.Get(srv.URL + "/user/login")
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	form := url.Values{"email": {email}, "password": {"secret-password"}}
+	resp, err = dashboardPost(t, client, "/user/login", form)
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusSeeOther, resp.StatusCode)
+	require.Equal(t, "/dashboard", resp.Header.Get("Location"))
+	return client
+}
+
+// dashboardPost submits a form with the XSRF token from the client's cookies.
+func dashboardPost(t *testing.T, client *http.Client, path string, form url.Values) (*http.Response, error) {
+	t.Helper()
+	return dashboardSend(t, client, path, "application/x-www-form-urlencoded", form.Encode())
+}
+
+// dashboardJSON posts v as JSON, like the fetch calls of the dashboard pages.
+func dashboardJSON(t *testing.T, client *http.Client, path string, v any) (*http.Response, error) {
+	t.Helper()
+	body, err := json.Marshal(v)
+	require.NoError(t, err)
+	return dashboardSend(t, client, path, "application/json", string(body))
+}
+
+func dashboardSend(t *testing.T, client *http.Client, path, contentType, body string) (*http.Response, error) {
+	t.Helper()
+	u, err := url.Parse(srv.URL + path)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", contentType)
+	for _, ck := range client.Jar.Cookies(u) {
+		if ck.Name == "XSRF-TOKEN" {
+			req.Header.Set("X-XSRF-TOKEN", ck.Value)
+		}
+	}
+	return client.Do(req)
+}
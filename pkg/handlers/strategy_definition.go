@@ -0,0 +1,329 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+	"github.com/felipekafuri/bandeira/pkg/form"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+// StrategyDefinitionHandler manages the custom strategies of a project.
+type StrategyDefinitionHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+	Hub     *services.Hub
+}
+
+type StrategyDefinitionForm struct {
+	form.Submission
+
+	Name        string                      `form:"name" json:"name" validate:"required"`
+	Description string                      `form:"description" json:"description"`
+	Parameters  []declarative.StrategyParam `json:"parameters"`
+}
+
+// definition validates the form and returns the strategy it describes; errors
+// on parameters are set under their path, e.g. "parameters[0].type".
+func (f *StrategyDefinitionForm) definition() (declarative.StrategyDefinition, bool) {
+	def := declarative.StrategyDefinition{
+		Name:        strings.TrimSpace(f.Name),
+		Description: f.Description,
+		Parameters:  f.Parameters,
+	}
+	errs := declarative.ValidateDefinition(def)
+	for key, message := range errs {
+		if key == "name" {
+			key = "Name"
+		}
+		f.SetFieldError(key, message)
+	}
+	return def, len(errs) == 0
+}
+
+func init() {
+	Register(new(StrategyDefinitionHandler))
+}
+
+func (h *StrategyDefinitionHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	h.Hub = c.Hub
+	return nil
+}
+
+func (h *StrategyDefinitionHandler) Routes(g *echo.Group) {
+	defs := g.Group("/projects/:projectId/strategy-definitions", middleware.RequireAuth())
+	defs.GET("", h.Index).Name = routenames.StrategyDefinitionIndex
+
+	// Custom strategies need SDK support, so only admins manage them.
+	mut := g.Group("/projects/:projectId/strategy-definitions", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin"))
+	mut.GET("/create", h.Create).Name = routenames.StrategyDefinitionCreate
+	mut.POST("", h.Store).Name = routenames.StrategyDefinitionStore
+	mut.GET("/:id/edit", h.Edit).Name = routenames.StrategyDefinitionEdit
+	mut.PUT("/:id", h.Update).Name = routenames.StrategyDefinitionUpdate
+	mut.DELETE("/:id", h.Delete).Name = routenames.StrategyDefinitionDelete
+}
+
+func (h *StrategyDefinitionHandler) Index(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	defs, err := declarative.ProjectStrategies(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return fail(err, "failed to load strategies", h.Inertia, ctx)
+	}
+
+	// usedBy lists the flags using each custom strategy, which then cannot be
+	// deleted.
+	usedBy := map[string][]string{}
+	for _, d := range defs {
+		if d.Builtin {
+			continue
+		}
+		flags, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+		if err != nil {
+			return fail(err, "failed to load strategy usage", h.Inertia, ctx)
+		}
+		usedBy[d.Name] = flags
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/StrategyDefinitions/Index",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"strategies": defs,
+			"usedBy":     usedBy,
+		},
+	)
+}
+
+func (h *StrategyDefinitionHandler) Create(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	p, err := findProject(ctx.Request().Context(), h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/StrategyDefinitions/Form",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+		},
+	)
+}
+
+func (h *StrategyDefinitionHandler) Store(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	var f StrategyDefinitionForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	def, ok := f.definition()
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	_, err = h.ORM.StrategyDefinition.Create().
+		SetName(def.Name).
+		SetNillableDescription(nilIfEmpty(def.Description)).
+		SetParameters(declarative.StrategyParamsToEnt(def.Parameters)).
+		SetProjectID(projectID).
+		Save(ctx.Request().Context())
+	if ent.IsConstraintError(err) {
+		f.SetFieldError("Name", "A strategy with this name already exists.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+	if err != nil {
+		return fail(err, "failed to create strategy", h.Inertia, ctx)
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	msg.Success(ctx, "Strategy created successfully.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/strategy-definitions", projectID))
+}
+
+func (h *StrategyDefinitionHandler) Edit(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	p, err := findProject(reqCtx, h.ORM, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	d, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
+	}
+
+	usedBy, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+	if err != nil {
+		return fail(err, "failed to load strategy usage", h.Inertia, ctx)
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Projects/StrategyDefinitions/Form",
+		inertia.Props{
+			"project": map[string]any{
+				"id":   p.ID,
+				"name": p.Name,
+			},
+			"strategy": declarative.CustomStrategy(d),
+			"usedBy":   usedBy,
+		},
+	)
+}
+
+func (h *StrategyDefinitionHandler) Update(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	d, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
+	}
+
+	var f StrategyDefinitionForm
+	if err := form.Submit(ctx, &f); err != nil {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	def, ok := f.definition()
+	if ok && def.Name != d.Name {
+		flags, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+		if err != nil {
+			return fail(err, "failed to check strategy usage", h.Inertia, ctx)
+		}
+		if len(flags) > 0 {
+			f.SetFieldError("Name", fmt.Sprintf("Used by %s; the strategy cannot be renamed.", strings.Join(flags, ", ")))
+			ok = false
+		}
+	}
+	if !ok {
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	_, err = d.Update().
+		SetName(def.Name).
+		SetNillableDescription(nilIfEmpty(def.Description)).
+		SetParameters(declarative.StrategyParamsToEnt(def.Parameters)).
+		Save(reqCtx)
+	if ent.IsConstraintError(err) {
+		f.SetFieldError("Name", "A strategy with this name already exists.")
+		form.ShareErrors(ctx, &f)
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+	if err != nil {
+		return fail(err, "failed to update strategy", h.Inertia, ctx)
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	msg.Success(ctx, "Strategy updated successfully.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/strategy-definitions", projectID))
+}
+
+func (h *StrategyDefinitionHandler) Delete(ctx echo.Context) error {
+	projectID, err := strconv.Atoi(ctx.Param("projectId"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Project not found")
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	d, err := h.find(ctx, projectID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Strategy not found")
+	}
+
+	flags, err := declarative.StrategyUsage(reqCtx, h.ORM, projectID, d.Name)
+	if err != nil {
+		return fail(err, "failed to check strategy usage", h.Inertia, ctx)
+	}
+	if len(flags) > 0 {
+		msg.Danger(ctx, fmt.Sprintf("Strategy %s is used by %s and cannot be deleted.", d.Name, strings.Join(flags, ", ")))
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	}
+
+	if err := h.ORM.StrategyDefinition.DeleteOne(d).Exec(reqCtx); err != nil {
+		return fail(err, "failed to delete strategy", h.Inertia, ctx)
+	}
+
+	h.Hub.NotifyProject(projectID)
+
+	msg.Success(ctx, "Strategy deleted.")
+	return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d/strategy-definitions", projectID))
+}
+
+func (h *StrategyDefinitionHandler) find(ctx echo.Context, projectID int) (*ent.StrategyDefinition, error) {
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return nil, err
+	}
+	return h.ORM.StrategyDefinition.Query().
+		Where(strategydefinition.ID(id), strategydefinition.ProjectID(projectID)).
+		Only(ctx.Request().Context())
+}
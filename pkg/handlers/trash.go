@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/pkg/middleware"
+	"github.com/felipekafuri/bandeira/pkg/msg"
+	"github.com/felipekafuri/bandeira/pkg/routenames"
+	"github.com/felipekafuri/bandeira/pkg/services"
+	inertia "github.com/romsar/gonertia/v2"
+)
+
+type TrashHandler struct {
+	Inertia *inertia.Inertia
+	ORM     *ent.Client
+	Hub     *services.Hub
+	Trash   *services.TrashService
+}
+
+func init() {
+	Register(new(TrashHandler))
+}
+
+func (h *TrashHandler) Init(c *services.Container) error {
+	h.Inertia = c.Inertia
+	h.ORM = c.ORM
+	h.Hub = c.Hub
+	h.Trash = c.Trash
+	return nil
+}
+
+func (h *TrashHandler) Routes(g *echo.Group) {
+	g.GET("/trash", h.Index, middleware.RequireAuth()).Name = routenames.TrashIndex
+
+	// Restoring is open to editors; purging cannot be undone and is left to
+	// admins.
+	mut := g.Group("/trash", middleware.RequireAuth(), middleware.RequireRole(h.ORM, "admin", "editor"))
+	mut.POST("/:kind/:id/restore", h.Restore).Name = routenames.TrashRestore
+	mut.DELETE("/:kind/:id", h.Purge, middleware.RequireRole(h.ORM, "admin")).Name = routenames.TrashPurge
+}
+
+func (h *TrashHandler) Index(ctx echo.Context) error {
+	items, err := h.Trash.List(ctx.Request().Context(), 0)
+	if err != nil {
+		return fail(err, "failed to load trash", h.Inertia, ctx)
+	}
+
+	type trashItem struct {
+		Kind        string `json:"kind"`
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		ProjectID   int    `json:"projectId"`
+		ProjectName string `json:"projectName"`
+		DeletedAt   string `json:"deletedAt"`
+		// PurgeAt is empty when items are kept until purged by hand.
+		PurgeAt string `json:"purgeAt"`
+	}
+
+	list := make([]trashItem, 0, len(items))
+	for _, it := range items {
+		purgeAt := ""
+		if it.PurgeAt != nil {
+			purgeAt = it.PurgeAt.Format("Jan 2, 2006 15:04")
+		}
+		list = append(list, trashItem{
+			Kind:        it.Kind,
+			ID:          it.ID,
+			Name:        it.Name,
+			ProjectID:   it.ProjectID,
+			ProjectName: it.ProjectName,
+			DeletedAt:   it.DeletedAt.Format("Jan 2, 2006 15:04"),
+			PurgeAt:     purgeAt,
+		})
+	}
+
+	return h.Inertia.Render(
+		ctx.Response().Writer,
+		ctx.Request(),
+		"Trash",
+		inertia.Props{
+			"items": list,
+		},
+	)
+}
+
+func (h *TrashHandler) Restore(ctx echo.Context) error {
+	kind := ctx.Param("kind")
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Not found")
+	}
+
+	// Restoring brings back flag configs, so a freeze stops it like an edit.
+	if projectID, envIDs, ok := restoreScope(ctx, h.ORM, kind, id); ok && frozen(ctx, h.ORM, h.Inertia, projectID, envIDs...) {
+		return nil
+	}
+
+	err = h.Trash.Restore(ctx.Request().Context(), kind, id)
+	var conflict *services.RestoreConflictError
+	switch {
+	case errors.Is(err, services.ErrTrashExpired):
+		msg.Danger(ctx, "The retention window has passed; the item is awaiting purge.")
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	case errors.As(err, &conflict):
+		msg.Danger(ctx, fmt.Sprintf("Cannot restore: %s.", conflict.Reason))
+		h.Inertia.Back(ctx.Response(), ctx.Request())
+		return nil
+	case ent.IsNotFound(err), errors.Is(err, services.ErrTrashKind):
+		return echo.NewHTTPError(http.StatusNotFound, "Not found")
+	case err != nil:
+		return fail(err, "failed to restore", h.Inertia, ctx)
+	}
+
+	switch kind {
+	case services.TrashProject:
+		msg.Success(ctx, "Project restored.")
+		return ctx.Redirect(http.StatusSeeOther, fmt.Sprintf("/projects/%d", id))
+	case services.TrashFlag:
+		if f, err := h.ORM.Flag.Get(ctx.Request().Context(), id); err == nil {
+			h.Hub.NotifyProject(f.ProjectID)
+		}
+		msg.Success(ctx, "Flag restored.")
+	case services.TrashEnvironment:
+		if e, err := h.ORM.Environment.Get(ctx.Request().Context(), id); err == nil {
+			h.Hub.Notify(e.ProjectID, e.Name)
+		}
+		msg.Success(ctx, "Environment restored.")
+	}
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
+
+// restoreScope returns the project and environments that restoring an item
+// changes. Items that cannot be loaded are left to the trash service to
+// report.
+func restoreScope(ctx echo.Context, orm *ent.Client, kind string, id int) (int, []int, bool) {
+	reqCtx := ctx.Request().Context()
+	switch kind {
+	case services.TrashProject:
+		return id, projectEnvironmentIDs(ctx, orm, id), true
+	case services.TrashFlag:
+		f, err := orm.Flag.Get(reqCtx, id)
+		if err != nil {
+			return 0, nil, false
+		}
+		return f.ProjectID, projectEnvironmentIDs(ctx, orm, f.ProjectID), true
+	case services.TrashEnvironment:
+		e, err := orm.Environment.Get(reqCtx, id)
+		if err != nil {
+			return 0, nil, false
+		}
+		return e.ProjectID, []int{e.ID}, true
+	}
+	return 0, nil, false
+}
+
+// Purge permanently deletes an item in the trash with everything below it.
+func (h *TrashHandler) Purge(ctx echo.Context) error {
+	kind := ctx.Param("kind")
+	id, err := strconv.Atoi(ctx.Param("id"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Not found")
+	}
+
+	err = h.Trash.PurgeItem(ctx.Request().Context(), kind, id)
+	switch {
+	case ent.IsNotFound(err), errors.Is(err, services.ErrTrashKind):
+		return echo.NewHTTPError(http.StatusNotFound, "Not found")
+	case err != nil:
+		return fail(err, "failed to purge", h.Inertia, ctx)
+	}
+
+	msg.Success(ctx, "Purged permanently.")
+	h.Inertia.Back(ctx.Response(), ctx.Request())
+	return nil
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/ent/user"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+func TestTrash_RestoreFrozen(t *testing.T) {
+	ctx := context.Background()
+	p := c.ORM.Project.Create().SetName("trash-frozen").SaveX(ctx)
+	env := c.ORM.Environment.Create().SetName("production").SetType("production").SetProjectID(p.ID).SaveX(ctx)
+	f := c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(p.ID).SetDeletedAt(time.Now()).SaveX(ctx)
+	require.NoError(t, declarative.FreezeEnvironment(ctx, c.ORM, env.ID, "admin", "release week"))
+
+	path := fmt.Sprintf("/trash/flag/%d/restore", f.ID)
+
+	editor := dashboardClient(t, user.RoleEditor)
+	resp, err := dashboardPost(t, editor, path, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusLocked, resp.StatusCode)
+	assert.NotNil(t, c.ORM.Flag.GetX(ctx, f.ID).DeletedAt)
+
+	admin := dashboardClient(t, user.RoleAdmin)
+	resp, err = dashboardPost(t, admin, path, nil)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Less(t, resp.StatusCode, 400)
+	assert.Nil(t, c.ORM.Flag.GetX(ctx, f.ID).DeletedAt)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	"github.com/felipekafuri/bandeira/pkg/log"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SetLogger initializes a logger for the current request and stores it in the context.
@@ -21,6 +22,11 @@ func SetLogger() echo.MiddlewareFunc {
 			rID := ctx.Response().Header().Get(echo.HeaderXRequestID)
 			logger := log.Ctx(ctx).With("request_id", rID)
 
+			// Include the trace ID to correlate logs with traces
+			if sc := trace.SpanContextFromContext(ctx.Request().Context()); sc.IsValid() {
+				logger = logger.With("trace_id", sc.TraceID().String())
+			}
+
 			// TODO include other fields you may want in all logs for this request
 			log.Set(ctx, logger)
 			return next(ctx)
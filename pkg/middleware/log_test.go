@@ -10,6 +10,7 @@ import (
 	"github.com/felipekafuri/bandeira/pkg/log"
 	"github.com/felipekafuri/bandeira/pkg/tests"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -107,3 +108,20 @@ func TestLogRequest(t *testing.T) {
 	exec()
 	assert.Equal(t, "ERROR", h.level)
 }
+
+func TestLogTraceID(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/")
+
+	h := new(mockLogHandler)
+	log.Set(ctx, slog.New(h))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	sc := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+	ctx.SetRequest(ctx.Request().WithContext(trace.ContextWithSpanContext(ctx.Request().Context(), sc)))
+
+	require.NoError(t, tests.ExecuteMiddleware(ctx, SetLogger()))
+
+	log.Ctx(ctx).Info("test")
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", h.GetAttr("trace_id"))
+}
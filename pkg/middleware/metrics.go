@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// Metrics records the count and latency of every request, labelled with the
+// matched route's name (see routenames) so that path parameters don't explode
+// cardinality. Routes without a name, such as static files, are recorded as
+// "unnamed" and requests matching no route as "unmatched".
+func Metrics(m *services.Metrics, e *echo.Echo) echo.MiddlewareFunc {
+	routes := newRouteNamer(e)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) (err error) {
+			start := time.Now()
+			if err = next(ctx); err != nil {
+				ctx.Error(err)
+			}
+
+			req := ctx.Request()
+			m.ObserveHTTPRequest(routes.name(req.Method, ctx.Path()), req.Method, ctx.Response().Status, time.Since(start))
+			return nil
+		}
+	}
+}
+
+// RequireBearerToken rejects requests without the given bearer token. An empty
+// token allows all requests.
+func RequireBearerToken(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if token == "" {
+				return next(ctx)
+			}
+			got := ctx.Request().Header.Get("Authorization")
+			if subtle.ConstantTimeCompare([]byte(got), []byte("Bearer "+token)) != 1 {
+				return echo.NewHTTPError(http.StatusUnauthorized)
+			}
+			return next(ctx)
+		}
+	}
+}
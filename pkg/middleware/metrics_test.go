@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/pkg/services"
+	"github.com/felipekafuri/bandeira/pkg/tests"
+)
+
+func TestMetrics(t *testing.T) {
+	m := services.NewMetrics(c.Database, services.NewHub())
+	e := echo.New()
+	e.Use(Metrics(m, e))
+	e.GET("/things/:id", func(ctx echo.Context) error {
+		return ctx.NoContent(http.StatusNoContent)
+	}).Name = "things.show"
+
+	for _, path := range []string{"/things/1", "/things/2", "/missing"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(body), `bandeira_http_requests_total{method="GET",route="things.show",status="204"} 2`)
+	assert.Contains(t, string(body), `bandeira_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+}
+
+func TestRequireBearerToken(t *testing.T) {
+	ctx, _ := tests.NewContext(c.Web, "/metrics")
+	err := tests.ExecuteMiddleware(ctx, RequireBearerToken("secret"))
+	tests.AssertHTTPErrorCode(t, err, http.StatusUnauthorized)
+
+	ctx.Request().Header.Set("Authorization", "Bearer secret")
+	assert.NoError(t, tests.ExecuteMiddleware(ctx, RequireBearerToken("secret")))
+
+	ctx, _ = tests.NewContext(c.Web, "/metrics")
+	assert.NoError(t, tests.ExecuteMiddleware(ctx, RequireBearerToken("")))
+}
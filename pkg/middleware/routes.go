@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/labstack/echo/v4"
+)
+
+// routeNamer resolves a request's matched route to its name (see routenames).
+// Routes without a name, such as static files, resolve to "unnamed" and
+// requests matching no route to "unmatched".
+type routeNamer struct {
+	e     *echo.Echo
+	once  sync.Once
+	names map[string]string
+}
+
+func newRouteNamer(e *echo.Echo) *routeNamer {
+	return &routeNamer{e: e}
+}
+
+// name returns the route name for the method and matched path (ctx.Path()).
+// Routes are registered after middleware, so names are resolved lazily.
+func (n *routeNamer) name(method, path string) string {
+	n.once.Do(func() {
+		n.names = make(map[string]string)
+		for _, r := range n.e.Routes() {
+			// Echo defaults the name to the handler's function name,
+			// which includes the package path.
+			name := r.Name
+			if name == "" || strings.Contains(name, "/") {
+				name = "unnamed"
+			}
+			n.names[r.Method+" "+r.Path] = name
+		}
+	})
+	if name, ok := n.names[method+" "+path]; ok {
+		return name
+	}
+	return "unmatched"
+}
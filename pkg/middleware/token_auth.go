@@ -8,26 +8,35 @@ import (
 
 	"github.com/felipekafuri/bandeira/ent"
 	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/project"
 	"github.com/felipekafuri/bandeira/pkg/context"
+	"github.com/felipekafuri/bandeira/pkg/services"
 	"github.com/felipekafuri/bandeira/pkg/token"
 )
 
 // RequireTokenAuth validates a Bearer token from the Authorization header.
 // It hashes the incoming token with SHA-256, looks it up in the database,
 // and stores the resolved *ent.ApiToken in the echo context under APITokenKey.
-func RequireTokenAuth(orm *ent.Client, tokenType string) echo.MiddlewareFunc {
+// Rejections are counted in metrics.
+func RequireTokenAuth(orm *ent.Client, metrics *services.Metrics, tokenType string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
 			auth := ctx.Request().Header.Get("Authorization")
 			if auth == "" || !strings.HasPrefix(auth, "Bearer ") {
+				metrics.ObserveTokenAuthFailure(tokenType, "missing")
 				return echo.NewHTTPError(http.StatusUnauthorized, "Missing or invalid Authorization header")
 			}
 
 			raw := strings.TrimPrefix(auth, "Bearer ")
 			hashed := token.Hash(raw)
 
+			// Tokens of a project in the trash stop working until it is
+			// restored.
 			query := orm.ApiToken.Query().
-				Where(apitoken.Secret(hashed)).
+				Where(
+					apitoken.Secret(hashed),
+					apitoken.HasProjectWith(project.DeletedAtIsNil()),
+				).
 				WithProject()
 
 			if tokenType != "" {
@@ -36,6 +45,7 @@ func RequireTokenAuth(orm *ent.Client, tokenType string) echo.MiddlewareFunc {
 
 			tok, err := query.Only(ctx.Request().Context())
 			if err != nil {
+				metrics.ObserveTokenAuthFailure(tokenType, "invalid")
 				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid API token")
 			}
 
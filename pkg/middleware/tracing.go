@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/felipekafuri/bandeira/pkg/services"
+)
+
+// Tracing starts a server span for every request, continuing the trace from
+// an incoming W3C traceparent header. Spans are named after the matched
+// route's name (see routenames), and the span context is stored in the
+// request context so that ORM queries and logs are correlated with it.
+func Tracing(tp trace.TracerProvider, e *echo.Echo) echo.MiddlewareFunc {
+	tracer := tp.Tracer(services.TracerName)
+	routes := newRouteNamer(e)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) (err error) {
+			req := ctx.Request()
+			parent := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+
+			spanCtx, span := tracer.Start(parent, "HTTP "+req.Method,
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPRequestMethodKey.String(req.Method),
+					semconv.URLPath(req.URL.Path),
+				),
+			)
+			defer span.End()
+			ctx.SetRequest(req.WithContext(spanCtx))
+
+			if err = next(ctx); err != nil {
+				ctx.Error(err)
+			}
+
+			// The route is only known once the router has matched.
+			span.SetName(routes.name(req.Method, ctx.Path()))
+			status := ctx.Response().Status
+			span.SetAttributes(
+				semconv.HTTPRoute(ctx.Path()),
+				semconv.HTTPResponseStatusCode(status),
+			)
+			if status >= http.StatusInternalServerError {
+				span.SetStatus(codes.Error, fmt.Sprintf("%d %s", status, http.StatusText(status)))
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTracing(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+	e := echo.New()
+	e.Use(Tracing(tp, e))
+
+	var handlerSpan trace.SpanContext
+	e.GET("/things/:id", func(ctx echo.Context) error {
+		handlerSpan = trace.SpanContextFromContext(ctx.Request().Context())
+		return ctx.NoContent(http.StatusNoContent)
+	}).Name = "things.show"
+	e.GET("/boom", func(ctx echo.Context) error {
+		return echo.NewHTTPError(http.StatusInternalServerError)
+	}).Name = "boom"
+
+	// The incoming W3C trace context is continued.
+	req := httptest.NewRequest(http.MethodGet, "/things/1", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	e.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := rec.Ended()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "things.show", span.Name())
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext().TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", span.Parent().SpanID().String())
+	assert.Equal(t, span.SpanContext(), handlerSpan)
+
+	attrs := make(map[string]any)
+	for _, a := range span.Attributes() {
+		attrs[string(a.Key)] = a.Value.AsInterface()
+	}
+	assert.Equal(t, "/things/:id", attrs["http.route"])
+	assert.Equal(t, int64(http.StatusNoContent), attrs["http.response.status_code"])
+
+	// Server errors mark the span as failed.
+	e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/boom", nil))
+	spans = rec.Ended()
+	require.Len(t, spans, 2)
+	assert.Equal(t, "boom", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+	assert.False(t, spans[1].Parent().IsValid())
+}
@@ -0,0 +1,222 @@
+// Package openapi models the parts of an OpenAPI 3.1 document that the
+// Bandeira APIs need, with helpers to build schemas tersely.
+package openapi
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Version is the OpenAPI version of the documents built by this package.
+const Version = "3.1.0"
+
+type (
+	// Document is the root of an OpenAPI document.
+	Document struct {
+		OpenAPI    string              `json:"openapi"`
+		Info       Info                `json:"info"`
+		Tags       []Tag               `json:"tags,omitempty"`
+		Paths      map[string]PathItem `json:"paths"`
+		Components Components          `json:"components"`
+	}
+
+	// Info describes the API.
+	Info struct {
+		Title       string `json:"title"`
+		Version     string `json:"version"`
+		Description string `json:"description,omitempty"`
+	}
+
+	// Tag groups operations.
+	Tag struct {
+		Name        string `json:"name"`
+		Description string `json:"description,omitempty"`
+	}
+
+	// PathItem maps lowercase HTTP methods to the operations of a path.
+	PathItem map[string]*Operation
+
+	// Operation is a single API operation.
+	Operation struct {
+		OperationID string                `json:"operationId"`
+		Summary     string                `json:"summary"`
+		Description string                `json:"description,omitempty"`
+		Tags        []string              `json:"tags,omitempty"`
+		Parameters  []*Parameter          `json:"parameters,omitempty"`
+		RequestBody *RequestBody          `json:"requestBody,omitempty"`
+		Responses   map[string]*Response  `json:"responses"`
+		Security    []map[string][]string `json:"security,omitempty"`
+	}
+
+	// Parameter is a path, query or header parameter, or a reference to one.
+	Parameter struct {
+		Ref         string  `json:"$ref,omitempty"`
+		Name        string  `json:"name,omitempty"`
+		In          string  `json:"in,omitempty"`
+		Description string  `json:"description,omitempty"`
+		Required    bool    `json:"required,omitempty"`
+		Schema      *Schema `json:"schema,omitempty"`
+	}
+
+	// RequestBody is the body of an operation.
+	RequestBody struct {
+		Description string               `json:"description,omitempty"`
+		Required    bool                 `json:"required,omitempty"`
+		Content     map[string]MediaType `json:"content"`
+	}
+
+	// Response is a response of an operation, or a reference to one.
+	Response struct {
+		Ref         string               `json:"$ref,omitempty"`
+		Description string               `json:"description,omitempty"`
+		Content     map[string]MediaType `json:"content,omitempty"`
+	}
+
+	// MediaType is the schema of a body in one content type.
+	MediaType struct {
+		Schema  *Schema `json:"schema"`
+		Example any     `json:"example,omitempty"`
+	}
+
+	// Components holds the reusable parts of the document.
+	Components struct {
+		Schemas         map[string]*Schema        `json:"schemas,omitempty"`
+		Responses       map[string]*Response      `json:"responses,omitempty"`
+		Parameters      map[string]*Parameter     `json:"parameters,omitempty"`
+		SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+	}
+
+	// SecurityScheme describes how requests authenticate.
+	SecurityScheme struct {
+		Type        string `json:"type"`
+		Scheme      string `json:"scheme,omitempty"`
+		Description string `json:"description,omitempty"`
+	}
+
+	// Schema is a JSON Schema (2020-12) as used by OpenAPI 3.1. Type holds a
+	// string, or a list of strings for nullable values.
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 any                `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Description          string             `json:"description,omitempty"`
+		Enum                 []any              `json:"enum,omitempty"`
+		Default              any                `json:"default,omitempty"`
+		Minimum              *float64           `json:"minimum,omitempty"`
+		Maximum              *float64           `json:"maximum,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		Required             []string           `json:"required,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+	}
+)
+
+// New returns an empty document.
+func New(info Info) *Document {
+	return &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas:         map[string]*Schema{},
+			Responses:       map[string]*Response{},
+			Parameters:      map[string]*Parameter{},
+			SecuritySchemes: map[string]SecurityScheme{},
+		},
+	}
+}
+
+// Add adds an operation for an Echo route path such as /projects/:id.
+func (d *Document) Add(method, echoPath string, op *Operation) {
+	path := Path(echoPath)
+	if d.Paths[path] == nil {
+		d.Paths[path] = PathItem{}
+	}
+	d.Paths[path][strings.ToLower(method)] = op
+}
+
+// Operation returns the operation for a method and Echo route path, or nil.
+func (d *Document) Operation(method, echoPath string) *Operation {
+	return d.Paths[Path(echoPath)][strings.ToLower(method)]
+}
+
+var echoParam = regexp.MustCompile(`:([A-Za-z0-9_]+)`)
+
+// Path converts an Echo route path to an OpenAPI path template.
+func Path(echoPath string) string {
+	return echoParam.ReplaceAllString(echoPath, "{$1}")
+}
+
+// Ref refers to a schema of the document's components.
+func Ref(name string) *Schema {
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// String is a string schema.
+func String(description string) *Schema {
+	return &Schema{Type: "string", Description: description}
+}
+
+// Integer is an integer schema.
+func Integer(description string) *Schema {
+	return &Schema{Type: "integer", Description: description}
+}
+
+// Boolean is a boolean schema.
+func Boolean(description string) *Schema {
+	return &Schema{Type: "boolean", Description: description}
+}
+
+// DateTime is an RFC 3339 timestamp.
+func DateTime(description string) *Schema {
+	return &Schema{Type: "string", Format: "date-time", Description: description}
+}
+
+// Enum is a string schema restricted to values.
+func Enum(description string, values ...string) *Schema {
+	s := String(description)
+	for _, v := range values {
+		s.Enum = append(s.Enum, v)
+	}
+	return s
+}
+
+// Array is an array of items.
+func Array(items *Schema) *Schema {
+	return &Schema{Type: "array", Items: items}
+}
+
+// Map is an object with arbitrary keys and values of one schema.
+func Map(values *Schema) *Schema {
+	return &Schema{Type: "object", AdditionalProperties: values}
+}
+
+// Any is an object with no constraints on its properties.
+func Any(description string) *Schema {
+	return &Schema{Type: "object", Description: description}
+}
+
+// Object is an object with the given properties, of which the required ones
+// are listed.
+func Object(properties map[string]*Schema, required ...string) *Schema {
+	return &Schema{Type: "object", Properties: properties, Required: required}
+}
+
+// Nullable allows null in addition to the schema's type.
+func Nullable(s *Schema) *Schema {
+	if t, ok := s.Type.(string); ok {
+		s.Type = []string{t, "null"}
+	}
+	return s
+}
+
+// Range bounds a numeric schema.
+func (s *Schema) Range(min, max float64) *Schema {
+	s.Minimum, s.Maximum = &min, &max
+	return s
+}
+
+// JSON is a JSON body or response content with an optional example.
+func JSON(s *Schema, example any) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: s, Example: example}}
+}
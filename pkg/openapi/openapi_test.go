@@ -0,0 +1,37 @@
+package openapi
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPath(t *testing.T) {
+	assert.Equal(t, "/api/admin/projects", Path("/api/admin/projects"))
+	assert.Equal(t, "/projects/{id}/flags/{flagId}/environments/{envId}", Path("/projects/:id/flags/:flagId/environments/:envId"))
+}
+
+func TestDocument(t *testing.T) {
+	doc := New(Info{Title: "Test", Version: "1"})
+	doc.Add("GET", "/items/:id", &Operation{OperationID: "items.get"})
+
+	assert.Equal(t, "items.get", doc.Operation("GET", "/items/:id").OperationID)
+	assert.Nil(t, doc.Operation("DELETE", "/items/:id"))
+
+	b, err := json.Marshal(doc)
+	require.NoError(t, err)
+	assert.Contains(t, string(b), `"/items/{id}":{"get":{"operationId":"items.get"`)
+	assert.Contains(t, string(b), `"openapi":"3.1.0"`)
+}
+
+func TestSchema(t *testing.T) {
+	b, err := json.Marshal(Nullable(Integer("")).Range(0, 100))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":["integer","null"],"minimum":0,"maximum":100}`, string(b))
+
+	b, err = json.Marshal(Enum("", "a", "b"))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type":"string","enum":["a","b"]}`, string(b))
+}
@@ -0,0 +1,202 @@
+// Package pager implements cursor pagination, sorting and search for list
+// endpoints backed by Ent queries.
+package pager
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// DefaultLimit is the page size when the request does not set one.
+	DefaultLimit = 50
+
+	// MaxLimit caps the page size a request may ask for.
+	MaxLimit = 200
+)
+
+type (
+	// Params are the list parameters of a request: limit, cursor, sort and q.
+	Params struct {
+		// Limit is the page size.
+		Limit int
+
+		// Sort is a sortable column, prefixed with "-" for descending order.
+		Sort string
+
+		// Query is a case-insensitive substring to search for.
+		Query string
+
+		// after is the ID of the last row of the previous page, or 0.
+		after int
+	}
+
+	// Page is the envelope of a list response.
+	Page[T any] struct {
+		Items []T `json:"items"`
+		// NextCursor is nil on the last page.
+		NextCursor *string `json:"next_cursor"`
+		// Total counts the matching rows across all pages.
+		Total int `json:"total"`
+	}
+
+	// ParamError reports an invalid list parameter.
+	ParamError struct {
+		Param   string
+		Message string
+	}
+
+	// cursor is the decoded form of the opaque cursor parameter. The sort is
+	// kept so that a cursor cannot be replayed against another order.
+	cursor struct {
+		ID   int    `json:"id"`
+		Sort string `json:"sort"`
+	}
+)
+
+func (e *ParamError) Error() string {
+	return fmt.Sprintf("pager: invalid %s: %s", e.Param, e.Message)
+}
+
+// Parse reads the limit, cursor, sort and q query parameters. sorts lists the
+// columns that may be sorted on; defaultSort applies when sort is omitted.
+func Parse(values url.Values, sorts []string, defaultSort string) (Params, error) {
+	p := Params{
+		Limit: DefaultLimit,
+		Sort:  defaultSort,
+		Query: strings.TrimSpace(values.Get("q")),
+	}
+
+	if v := values.Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > MaxLimit {
+			return p, &ParamError{Param: "limit", Message: fmt.Sprintf("Limit must be between 1 and %d", MaxLimit)}
+		}
+		p.Limit = n
+	}
+
+	if v := values.Get("sort"); v != "" {
+		if !slices.Contains(sorts, strings.TrimPrefix(v, "-")) {
+			return p, &ParamError{Param: "sort", Message: "Sort must be one of: " + strings.Join(sorts, ", ") + " (prefix with - to reverse)"}
+		}
+		p.Sort = v
+	}
+
+	if v := values.Get("cursor"); v != "" {
+		c, err := decodeCursor(v)
+		if err != nil {
+			return p, &ParamError{Param: "cursor", Message: "Cursor is invalid"}
+		}
+		if c.Sort != p.Sort {
+			return p, &ParamError{Param: "cursor", Message: "Cursor was issued for another sort order"}
+		}
+		p.after = c.ID
+	}
+
+	return p, nil
+}
+
+// column returns the sort column and whether the order is descending.
+func (p Params) column() (string, bool) {
+	return strings.TrimPrefix(p.Sort, "-"), strings.HasPrefix(p.Sort, "-")
+}
+
+// Search matches rows where any of the columns contains the query. It
+// matches everything when the query is empty.
+func (p Params) Search(columns ...string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if p.Query == "" {
+			return
+		}
+		preds := make([]*sql.Predicate, 0, len(columns))
+		for _, c := range columns {
+			preds = append(preds, sql.ContainsFold(s.C(c), p.Query))
+		}
+		s.Where(sql.Or(preds...))
+	}
+}
+
+// Order sorts by the sort column, breaking ties by ID in the same direction.
+func (p Params) Order() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		col, desc := p.column()
+		if desc {
+			s.OrderBy(sql.Desc(s.C(col)), sql.Desc(s.C("id")))
+		} else {
+			s.OrderBy(sql.Asc(s.C(col)), sql.Asc(s.C("id")))
+		}
+	}
+}
+
+// After skips the rows up to and including the cursor row. The sort value of
+// the cursor row is looked up by ID, so cursors stay valid whatever type the
+// sort column has.
+func (p Params) After() func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		if p.after == 0 {
+			return
+		}
+		col, desc := p.column()
+		op := ">"
+		if desc {
+			op = "<"
+		}
+		value := fmt.Sprintf("(SELECT %s FROM %s WHERE id = ?)", col, s.TableName())
+		s.Where(sql.ExprP(
+			fmt.Sprintf("(%[1]s %[2]s %[3]s OR (%[1]s = %[3]s AND %[4]s %[2]s ?))", s.C(col), op, value, s.C("id")),
+			p.after, p.after, p.after,
+		))
+	}
+}
+
+// Fetch is the number of rows to load: one more than the limit, to find out
+// whether there is a next page.
+func (p Params) Fetch() int {
+	return p.Limit + 1
+}
+
+// Trim cuts rows loaded with Fetch down to the page and returns the cursor of
+// the next page, or nil on the last page.
+func Trim[T any](p Params, rows []T, id func(T) int) ([]T, *string) {
+	if len(rows) <= p.Limit {
+		return rows, nil
+	}
+	rows = rows[:p.Limit]
+	next := encodeCursor(cursor{ID: id(rows[len(rows)-1]), Sort: p.Sort})
+	return rows, &next
+}
+
+// NewPage builds the envelope of a list response.
+func NewPage[T any](items []T, next *string, total int) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, NextCursor: next, Total: total}
+}
+
+func encodeCursor(c cursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(s string) (cursor, error) {
+	var c cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, err
+	}
+	if err := json.Unmarshal(b, &c); err != nil {
+		return c, err
+	}
+	if c.ID <= 0 {
+		return c, fmt.Errorf("pager: cursor without id")
+	}
+	return c, nil
+}
@@ -0,0 +1,53 @@
+package pager
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	sorts := []string{"name", "created_at"}
+
+	p, err := Parse(url.Values{}, sorts, "name")
+	require.NoError(t, err)
+	assert.Equal(t, Params{Limit: DefaultLimit, Sort: "name"}, p)
+
+	p, err = Parse(url.Values{"limit": {"10"}, "sort": {"-created_at"}, "q": {"  beta "}}, sorts, "name")
+	require.NoError(t, err)
+	assert.Equal(t, Params{Limit: 10, Sort: "-created_at", Query: "beta"}, p)
+
+	var pe *ParamError
+	for param, value := range map[string]string{
+		"limit":  "201",
+		"sort":   "--name",
+		"cursor": "not-a-cursor",
+	} {
+		_, err := Parse(url.Values{param: {value}}, sorts, "name")
+		require.ErrorAs(t, err, &pe, param)
+		assert.Equal(t, param, pe.Param)
+	}
+}
+
+func TestTrim(t *testing.T) {
+	p := Params{Limit: 2, Sort: "-name"}
+	id := func(n int) int { return n }
+
+	rows, next := Trim(p, []int{7, 5}, id)
+	assert.Equal(t, []int{7, 5}, rows)
+	assert.Nil(t, next)
+
+	rows, next = Trim(p, []int{7, 5, 3}, id)
+	assert.Equal(t, []int{7, 5}, rows)
+	require.NotNil(t, next)
+
+	// The cursor resumes after the last row, for the same sort only.
+	p, err := Parse(url.Values{"sort": {"-name"}, "cursor": {*next}}, []string{"name"}, "name")
+	require.NoError(t, err)
+	assert.Equal(t, 5, p.after)
+
+	_, err = Parse(url.Values{"cursor": {*next}}, []string{"name"}, "name")
+	assert.Error(t, err)
+}
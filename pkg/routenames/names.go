@@ -8,14 +8,19 @@ const (
 	UserLogout = "user.logout"
 	Dashboard  = "dashboard"
 	Docs       = "docs"
+	Metrics    = "metrics"
+	Healthz    = "healthz"
+	Readyz     = "readyz"
+	Version    = "version"
 
-	ProjectIndex  = "projects.index"
-	ProjectCreate = "projects.create"
-	ProjectStore  = "projects.store"
-	ProjectShow   = "projects.show"
-	ProjectEdit   = "projects.edit"
-	ProjectUpdate = "projects.update"
-	ProjectDelete = "projects.delete"
+	ProjectIndex   = "projects.index"
+	ProjectCreate  = "projects.create"
+	ProjectStore   = "projects.store"
+	ProjectShow    = "projects.show"
+	ProjectEdit    = "projects.edit"
+	ProjectUpdate  = "projects.update"
+	ProjectDelete  = "projects.delete"
+	ProjectCompare = "projects.compare"
 
 	EnvironmentCreate = "environments.create"
 	EnvironmentStore  = "environments.store"
@@ -23,18 +28,30 @@ const (
 	EnvironmentUpdate = "environments.update"
 	EnvironmentDelete = "environments.delete"
 
-	FlagCreate = "flags.create"
-	FlagStore  = "flags.store"
-	FlagEdit   = "flags.edit"
-	FlagUpdate = "flags.update"
-	FlagDelete = "flags.delete"
-	FlagToggle = "flags.toggle"
+	FlagCreate  = "flags.create"
+	FlagStore   = "flags.store"
+	FlagEdit    = "flags.edit"
+	FlagUpdate  = "flags.update"
+	FlagDelete  = "flags.delete"
+	FlagToggle  = "flags.toggle"
+	FlagPromote = "flags.promote"
 
 	StrategyList   = "flags.strategies"
 	StrategyStore  = "flags.strategies.store"
 	StrategyUpdate = "flags.strategies.update"
 	StrategyDelete = "flags.strategies.delete"
 
+	PrerequisiteList   = "flags.prerequisites"
+	PrerequisiteUpdate = "flags.prerequisites.update"
+
+	VersionList    = "flags.versions"
+	VersionDiff    = "flags.versions.diff"
+	VersionRestore = "flags.versions.restore"
+
+	TrashIndex   = "trash.index"
+	TrashRestore = "trash.restore"
+	TrashPurge   = "trash.purge"
+
 	APIGetFlags    = "api.flags"
 	APIStreamFlags = "api.flags.stream"
 
@@ -49,6 +66,11 @@ const (
 	AdminProjectGet        = "api.admin.projects.get"
 	AdminProjectUpdate     = "api.admin.projects.update"
 	AdminProjectDelete     = "api.admin.projects.delete"
+	AdminProjectExport     = "api.admin.projects.export"
+	AdminProjectImport     = "api.admin.projects.import"
+	AdminProjectPromote    = "api.admin.projects.promote"
+	AdminProjectCompare    = "api.admin.projects.compare"
+	AdminProjectDrift      = "api.admin.projects.drift"
 	AdminEnvironmentList   = "api.admin.environments"
 	AdminEnvironmentCreate = "api.admin.environments.create"
 	AdminEnvironmentUpdate = "api.admin.environments.update"
@@ -59,7 +81,69 @@ const (
 	AdminFlagUpdate        = "api.admin.flags.update"
 	AdminFlagDelete        = "api.admin.flags.delete"
 	AdminFlagEnvPatch      = "api.admin.flags.env.patch"
+	AdminVersionList       = "api.admin.flags.versions"
+	AdminVersionDiff       = "api.admin.flags.versions.diff"
+	AdminVersionRestore    = "api.admin.flags.versions.restore"
 	AdminTokenList         = "api.admin.tokens"
 	AdminTokenCreate       = "api.admin.tokens.create"
 	AdminTokenDelete       = "api.admin.tokens.delete"
+
+	AdminTrashList          = "api.admin.trash"
+	AdminFlagRestore        = "api.admin.flags.restore"
+	AdminEnvironmentRestore = "api.admin.environments.restore"
+
+	AdminTagList = "api.admin.tags"
+
+	OpenAPISpec = "api.openapi"
+	DocsAPI     = "docs.api"
+
+	AdminStrategyList             = "api.admin.strategies"
+	AdminStrategyDefinitionList   = "api.admin.strategy_definitions"
+	AdminStrategyDefinitionCreate = "api.admin.strategy_definitions.create"
+	AdminStrategyDefinitionUpdate = "api.admin.strategy_definitions.update"
+	AdminStrategyDefinitionDelete = "api.admin.strategy_definitions.delete"
+	StrategyDefinitionIndex       = "strategy_definitions.index"
+	StrategyDefinitionCreate      = "strategy_definitions.create"
+	StrategyDefinitionStore       = "strategy_definitions.store"
+	StrategyDefinitionEdit        = "strategy_definitions.edit"
+	StrategyDefinitionUpdate      = "strategy_definitions.update"
+	StrategyDefinitionDelete      = "strategy_definitions.delete"
+	AdminContextFieldList         = "api.admin.context_fields"
+	AdminContextFieldCreate       = "api.admin.context_fields.create"
+	AdminContextFieldUpdate       = "api.admin.context_fields.update"
+	AdminContextFieldDelete       = "api.admin.context_fields.delete"
+	ContextFieldIndex             = "context_fields.index"
+	ContextFieldCreate            = "context_fields.create"
+	ContextFieldStore             = "context_fields.store"
+	ContextFieldEdit              = "context_fields.edit"
+	ContextFieldUpdate            = "context_fields.update"
+	ContextFieldDelete            = "context_fields.delete"
+	AdminProjectEvaluate          = "api.admin.projects.evaluate"
+	PlaygroundIndex               = "playground.index"
+	PlaygroundEvaluate            = "playground.evaluate"
+	AdminFlagBulk                 = "api.admin.flags.bulk"
+	FlagBulk                      = "flags.bulk"
+	AdminProjectFreeze            = "api.admin.projects.freeze"
+	AdminProjectUnfreeze          = "api.admin.projects.unfreeze"
+	AdminEnvironmentFreeze        = "api.admin.environments.freeze"
+	AdminEnvironmentUnfreeze      = "api.admin.environments.unfreeze"
+	AdminEnvironmentKillSwitch    = "api.admin.environments.kill_switch"
+	ProjectFreeze                 = "projects.freeze"
+	ProjectUnfreeze               = "projects.unfreeze"
+	EnvironmentFreeze             = "environments.freeze"
+	EnvironmentUnfreeze           = "environments.unfreeze"
+	EnvironmentKillSwitch         = "environments.kill_switch"
+	AdminRolloutList              = "api.admin.flags.rollouts"
+	AdminRolloutCreate            = "api.admin.flags.rollouts.create"
+	AdminRolloutGet               = "api.admin.flags.rollouts.get"
+	AdminRolloutPause             = "api.admin.flags.rollouts.pause"
+	AdminRolloutResume            = "api.admin.flags.rollouts.resume"
+	AdminRolloutAbort             = "api.admin.flags.rollouts.abort"
+	RolloutList                   = "flags.rollouts"
+	RolloutStore                  = "flags.rollouts.store"
+	RolloutPause                  = "flags.rollouts.pause"
+	RolloutResume                 = "flags.rollouts.resume"
+	RolloutAbort                  = "flags.rollouts.abort"
+	BackupIndex                   = "backups.index"
+	BackupStore                   = "backups.store"
 )
@@ -0,0 +1,450 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+
+	"github.com/felipekafuri/bandeira/config"
+)
+
+// SchemaVersion is stored in the SQLite user_version pragma after migration.
+// Bump it whenever the Ent schema changes so that restores can reject backups
+// taken by a newer release.
+const SchemaVersion = 12
+
+const (
+	backupPrefix     = "bandeira-"
+	backupSuffix     = ".db"
+	backupTimeLayout = "20060102-150405.000"
+)
+
+// requiredTables must exist in a database for it to be restorable.
+var requiredTables = []string{"projects", "environments", "flags", "flag_environments", "strategies", "constraints", "api_tokens", "users"}
+
+// BackupInfo describes a stored backup.
+type BackupInfo struct {
+	Name      string    `json:"name"`
+	Size      int64     `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+	Uploaded  bool      `json:"uploaded"`
+}
+
+// BackupService takes online backups of the SQLite database,
+// optionally uploads them to S3-compatible storage, and applies retention.
+type BackupService struct {
+	db  *sql.DB
+	cfg config.BackupConfig
+	s3  *S3Client
+
+	// mu serializes backups so that scheduled and on-demand runs never overlap.
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+	now  func() time.Time
+}
+
+// NewBackupService creates a backup service for the given database.
+func NewBackupService(db *sql.DB, cfg config.BackupConfig) (*BackupService, error) {
+	b := &BackupService{db: db, cfg: cfg, now: time.Now}
+	if cfg.S3.Enabled {
+		s3, err := NewS3Client(cfg.S3)
+		if err != nil {
+			return nil, err
+		}
+		b.s3 = s3
+	}
+	return b, nil
+}
+
+// Start runs a backup every configured interval until Stop is called. It does
+// nothing unless scheduled backups are enabled.
+func (b *BackupService) Start() {
+	if !b.cfg.Enabled || b.cfg.Interval <= 0 || b.stop != nil {
+		return
+	}
+	b.stop = make(chan struct{})
+	b.done = make(chan struct{})
+
+	go func() {
+		defer close(b.done)
+		ticker := time.NewTicker(b.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.stop:
+				return
+			case <-ticker.C:
+				info, err := b.Run(context.Background())
+				if err != nil {
+					slog.Error("scheduled backup failed", "error", err)
+					continue
+				}
+				slog.Info("scheduled backup completed", "name", info.Name, "size", info.Size)
+			}
+		}
+	}()
+}
+
+// Stop stops the schedule and waits for a running backup to finish.
+func (b *BackupService) Stop() {
+	if b.stop == nil {
+		return
+	}
+	close(b.stop)
+	<-b.done
+	b.stop = nil
+}
+
+// Run takes a backup into the backup directory, uploads it when S3 is enabled,
+// and then applies the retention rules.
+func (b *BackupService) Run(ctx context.Context) (*BackupInfo, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := os.MkdirAll(b.cfg.Directory, 0755); err != nil {
+		return nil, err
+	}
+
+	createdAt := b.now().UTC()
+	name := backupPrefix + createdAt.Format(backupTimeLayout) + backupSuffix
+	dst := filepath.Join(b.cfg.Directory, name)
+
+	if err := BackupDatabase(ctx, b.db, dst); err != nil {
+		return nil, err
+	}
+
+	stat, err := os.Stat(dst)
+	if err != nil {
+		return nil, err
+	}
+	info := &BackupInfo{Name: name, Size: stat.Size(), CreatedAt: createdAt}
+
+	if b.s3 != nil {
+		if err := b.upload(ctx, dst, name, stat.Size()); err != nil {
+			return info, fmt.Errorf("backup %s was written locally but the upload failed: %w", name, err)
+		}
+		info.Uploaded = true
+	}
+
+	if err := b.prune(ctx); err != nil {
+		return info, fmt.Errorf("backup %s succeeded but retention failed: %w", name, err)
+	}
+
+	return info, nil
+}
+
+// List returns the local backups, newest first.
+func (b *BackupService) List() ([]BackupInfo, error) {
+	entries, err := os.ReadDir(b.cfg.Directory)
+	if errors.Is(err, os.ErrNotExist) {
+		return []BackupInfo{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]BackupInfo, 0, len(entries))
+	for _, e := range entries {
+		createdAt, ok := parseBackupName(e.Name())
+		if !ok || e.IsDir() {
+			continue
+		}
+		fi, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		backups = append(backups, BackupInfo{Name: e.Name(), Size: fi.Size(), CreatedAt: createdAt})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].Name > backups[j].Name
+	})
+	return backups, nil
+}
+
+func (b *BackupService) upload(ctx context.Context, src, name string, size int64) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return b.s3.Put(ctx, path.Join(b.cfg.S3.Prefix, name), f, size)
+}
+
+// prune deletes local and remote backups that fall outside the retention rules.
+func (b *BackupService) prune(ctx context.Context) error {
+	local, err := b.List()
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(local))
+	for _, l := range local {
+		names = append(names, l.Name)
+	}
+	for _, name := range b.expired(names) {
+		if err := os.Remove(filepath.Join(b.cfg.Directory, name)); err != nil {
+			return err
+		}
+	}
+
+	if b.s3 == nil {
+		return nil
+	}
+
+	objects, err := b.s3.List(ctx, b.cfg.S3.Prefix)
+	if err != nil {
+		return err
+	}
+	keys := make(map[string]string, len(objects))
+	names = names[:0]
+	for _, o := range objects {
+		name := path.Base(o.Key)
+		if _, ok := parseBackupName(name); !ok {
+			continue
+		}
+		keys[name] = o.Key
+		names = append(names, name)
+	}
+	for _, name := range b.expired(names) {
+		if err := b.s3.Delete(ctx, keys[name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expired returns the backup names to delete: everything beyond the newest
+// Keep backups, and everything older than MaxAge. The newest backup is never
+// expired.
+func (b *BackupService) expired(names []string) []string {
+	sorted := append([]string(nil), names...)
+	sort.Sort(sort.Reverse(sort.StringSlice(sorted)))
+
+	var out []string
+	cutoff := b.now().Add(-b.cfg.Retention.MaxAge)
+	for i, name := range sorted {
+		if i == 0 {
+			continue
+		}
+		if b.cfg.Retention.Keep > 0 && i >= b.cfg.Retention.Keep {
+			out = append(out, name)
+			continue
+		}
+		createdAt, _ := parseBackupName(name)
+		if b.cfg.Retention.MaxAge > 0 && createdAt.Before(cutoff) {
+			out = append(out, name)
+		}
+	}
+	return out
+}
+
+func parseBackupName(name string) (time.Time, bool) {
+	if !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupSuffix) {
+		return time.Time{}, false
+	}
+	ts := strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupSuffix)
+	t, err := time.Parse(backupTimeLayout, ts)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// BackupDatabase writes a consistent copy of the live database to dst, which
+// must not exist yet, using the SQLite online backup API. Writers are only
+// blocked while pages are copied, and in WAL mode readers never are.
+func BackupDatabase(ctx context.Context, db *sql.DB, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return fmt.Errorf("backup: %s already exists", dst)
+	}
+
+	destDB, err := sql.Open("sqlite3", dst)
+	if err != nil {
+		return err
+	}
+	defer destDB.Close()
+
+	destConn, err := destDB.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("backup: open %s: %w", dst, err)
+	}
+	defer destConn.Close()
+
+	srcConn, err := db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer srcConn.Close()
+
+	err = destConn.Raw(func(destRaw any) error {
+		return srcConn.Raw(func(srcRaw any) error {
+			dest, ok := destRaw.(*sqlite3.SQLiteConn)
+			src, ok2 := srcRaw.(*sqlite3.SQLiteConn)
+			if !ok || !ok2 {
+				return errors.New("backup: database is not SQLite")
+			}
+
+			bk, err := dest.Backup("main", src, "main")
+			if err != nil {
+				return err
+			}
+			for {
+				done, err := bk.Step(-1)
+				if err != nil {
+					bk.Finish()
+					return err
+				}
+				if done {
+					break
+				}
+			}
+			return bk.Finish()
+		})
+	})
+	if err != nil {
+		os.Remove(dst)
+		return fmt.Errorf("backup: %w", err)
+	}
+	return nil
+}
+
+// ValidateBackup checks that the file at path is an intact Bandeira database
+// that this release can open, and returns its schema version. Backups from
+// older releases are accepted since auto-migration upgrades them on startup.
+func ValidateBackup(path string) (int, error) {
+	if _, err := os.Stat(path); err != nil {
+		return 0, err
+	}
+
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+
+	var integrity string
+	if err := db.QueryRow("PRAGMA integrity_check").Scan(&integrity); err != nil {
+		return 0, fmt.Errorf("restore: %s is not a SQLite database: %w", path, err)
+	}
+	if integrity != "ok" {
+		return 0, fmt.Errorf("restore: integrity check failed: %s", integrity)
+	}
+
+	var version int
+	if err := db.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return 0, err
+	}
+	if version > SchemaVersion {
+		return version, fmt.Errorf("restore: backup schema version %d is newer than this release (%d)", version, SchemaVersion)
+	}
+
+	for _, table := range requiredTables {
+		var n int
+		err := db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = ?", table).Scan(&n)
+		if err != nil {
+			return version, err
+		}
+		if n == 0 {
+			return version, fmt.Errorf("restore: backup is missing table %q", table)
+		}
+	}
+
+	return version, nil
+}
+
+// RestoreBackup validates the backup at src and swaps it in as the database
+// file of the given connection string. The current database is kept next to
+// it with a ".pre-restore-<timestamp>" suffix. The server must be stopped.
+func RestoreBackup(connection, src string) (string, error) {
+	if _, err := ValidateBackup(src); err != nil {
+		return "", err
+	}
+
+	dst := DatabasePath(connection)
+	if dst == "" {
+		return "", fmt.Errorf("restore: %q is not a file database", connection)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return "", err
+	}
+
+	// Copy to a temporary file in the same directory first so the final
+	// rename is atomic.
+	tmp := dst + ".restore"
+	if err := copyFile(src, tmp); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+
+	previous := ""
+	if _, err := os.Stat(dst); err == nil {
+		previous = fmt.Sprintf("%s.pre-restore-%s", dst, time.Now().UTC().Format("20060102-150405"))
+		if err := os.Rename(dst, previous); err != nil {
+			os.Remove(tmp)
+			return "", err
+		}
+	}
+	// The WAL may hold commits that were never checkpointed, so it moves
+	// with the old database rather than being deleted; opening the kept copy
+	// replays it. Without a previous database the files are stale.
+	for _, suffix := range []string{"-wal", "-shm"} {
+		if previous == "" {
+			os.Remove(dst + suffix)
+			continue
+		}
+		if err := os.Rename(dst+suffix, previous+suffix); err != nil && !os.IsNotExist(err) {
+			os.Remove(tmp)
+			return previous, err
+		}
+	}
+
+	if err := os.Rename(tmp, dst); err != nil {
+		return previous, err
+	}
+	return previous, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// DatabasePath extracts the file path from a SQLite connection string, or
+// returns "" for in-memory databases.
+func DatabasePath(connection string) string {
+	p, _, _ := strings.Cut(connection, "?")
+	p = strings.TrimPrefix(p, "file:")
+	if p == "" || p == ":memory:" || strings.Contains(connection, "vfs=memdb") || strings.Contains(connection, "mode=memory") {
+		return ""
+	}
+	return p
+}
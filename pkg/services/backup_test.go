@@ -0,0 +1,237 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/config"
+)
+
+// fakeS3 is a local stand-in for an S3-compatible bucket.
+type fakeS3 struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/"+f.bucket+"/")
+	switch r.Method {
+	case http.MethodPut:
+		data, _ := io.ReadAll(r.Body)
+		f.objects[key] = data
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodGet:
+		prefix := r.URL.Query().Get("prefix")
+		keys := make([]string, 0, len(f.objects))
+		for k := range f.objects {
+			if strings.HasPrefix(k, prefix) {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		io.WriteString(w, "<ListBucketResult>")
+		for _, k := range keys {
+			io.WriteString(w, "<Contents><Key>"+k+"</Key><Size>1</Size><LastModified>2026-01-01T00:00:00.000Z</LastModified></Contents>")
+		}
+		io.WriteString(w, "<IsTruncated>false</IsTruncated></ListBucketResult>")
+	}
+}
+
+func (f *fakeS3) keys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	keys := make([]string, 0, len(f.objects))
+	for k := range f.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// newTestBackupService returns a service with a fake clock and a function
+// that advances it by an hour.
+func newTestBackupService(t *testing.T, cfg config.BackupConfig) (*BackupService, func()) {
+	t.Helper()
+	cfg.Directory = t.TempDir()
+	b, err := NewBackupService(c.Database, cfg)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b.now = func() time.Time { return now }
+	return b, func() { now = now.Add(time.Hour) }
+}
+
+func TestBackupService_RunAndRetention(t *testing.T) {
+	s3 := &fakeS3{bucket: "backups", objects: map[string][]byte{}}
+	srv := httptest.NewServer(s3)
+	defer srv.Close()
+
+	var cfg config.BackupConfig
+	cfg.Retention.Keep = 2
+	cfg.S3 = config.S3Config{
+		Enabled:   true,
+		Endpoint:  srv.URL,
+		Bucket:    "backups",
+		Prefix:    "bandeira/",
+		AccessKey: "key",
+		SecretKey: "secret",
+	}
+	b, tick := newTestBackupService(t, cfg)
+
+	var last *BackupInfo
+	for i := 0; i < 3; i++ {
+		tick()
+		info, err := b.Run(context.Background())
+		require.NoError(t, err)
+		assert.True(t, info.Uploaded)
+		last = info
+	}
+
+	backups, err := b.List()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	assert.Equal(t, last.Name, backups[0].Name)
+
+	keys := s3.keys()
+	require.Len(t, keys, 2)
+	assert.Equal(t, "bandeira/"+last.Name, keys[1])
+
+	version, err := ValidateBackup(filepath.Join(b.cfg.Directory, last.Name))
+	require.NoError(t, err)
+	assert.Equal(t, SchemaVersion, version)
+}
+
+func TestBackupService_MaxAge(t *testing.T) {
+	var cfg config.BackupConfig
+	cfg.Retention.MaxAge = 90 * time.Minute
+	b, tick := newTestBackupService(t, cfg)
+
+	for i := 0; i < 3; i++ {
+		tick()
+		_, err := b.Run(context.Background())
+		require.NoError(t, err)
+	}
+
+	// Backups are an hour apart, so only the newest two are within 90 minutes
+	// of the last retention pass.
+	backups, err := b.List()
+	require.NoError(t, err)
+	assert.Len(t, backups, 2)
+}
+
+func TestValidateBackup(t *testing.T) {
+	dir := t.TempDir()
+
+	junk := filepath.Join(dir, "junk.db")
+	require.NoError(t, os.WriteFile(junk, []byte("not a database"), 0644))
+	_, err := ValidateBackup(junk)
+	assert.Error(t, err)
+
+	empty := filepath.Join(dir, "empty.db")
+	db, err := sql.Open("sqlite3", empty)
+	require.NoError(t, err)
+	_, err = db.Exec("CREATE TABLE other (id INTEGER)")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	_, err = ValidateBackup(empty)
+	assert.ErrorContains(t, err, "missing table")
+
+	newer := filepath.Join(dir, "newer.db")
+	require.NoError(t, BackupDatabase(context.Background(), c.Database, newer))
+	db, err = sql.Open("sqlite3", newer)
+	require.NoError(t, err)
+	_, err = db.Exec("PRAGMA user_version = 999")
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+	_, err = ValidateBackup(newer)
+	assert.ErrorContains(t, err, "newer than this release")
+}
+
+func TestRestoreBackup(t *testing.T) {
+	dir := t.TempDir()
+	backup := filepath.Join(dir, "backup.db")
+	require.NoError(t, BackupDatabase(context.Background(), c.Database, backup))
+
+	target := filepath.Join(dir, "data", "main.db")
+	require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+	require.NoError(t, os.WriteFile(target, []byte("old"), 0644))
+	require.NoError(t, os.WriteFile(target+"-wal", []byte("stale"), 0644))
+
+	previous, err := RestoreBackup(target+"?_journal=WAL&_fk=true", backup)
+	require.NoError(t, err)
+
+	old, err := os.ReadFile(previous)
+	require.NoError(t, err)
+	assert.Equal(t, "old", string(old))
+	assert.NoFileExists(t, target+"-wal")
+	assert.FileExists(t, previous+"-wal")
+
+	_, err = ValidateBackup(target)
+	assert.NoError(t, err)
+}
+
+func TestRestoreBackup_KeepsUncheckpointedWAL(t *testing.T) {
+	dir := t.TempDir()
+	backup := filepath.Join(dir, "backup.db")
+	require.NoError(t, BackupDatabase(context.Background(), c.Database, backup))
+
+	// Write to a WAL database without checkpointing, then copy its files
+	// while the connection is still open, as a crashed server leaves them.
+	live := filepath.Join(dir, "live.db")
+	db, err := sql.Open("sqlite3", live+"?_journal=WAL")
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	for _, stmt := range []string{
+		"PRAGMA wal_autocheckpoint = 0",
+		"CREATE TABLE notes (body TEXT)",
+		"INSERT INTO notes VALUES ('unsaved')",
+	} {
+		_, err = db.Exec(stmt)
+		require.NoError(t, err)
+	}
+	target := filepath.Join(dir, "data", "main.db")
+	require.NoError(t, os.MkdirAll(filepath.Dir(target), 0755))
+	require.NoError(t, copyFile(live, target))
+	require.NoError(t, copyFile(live+"-wal", target+"-wal"))
+	require.NoError(t, db.Close())
+
+	previous, err := RestoreBackup(target, backup)
+	require.NoError(t, err)
+
+	db, err = sql.Open("sqlite3", previous)
+	require.NoError(t, err)
+	defer db.Close()
+	var body string
+	require.NoError(t, db.QueryRow("SELECT body FROM notes").Scan(&body))
+	assert.Equal(t, "unsaved", body)
+}
+
+func TestDatabasePath(t *testing.T) {
+	assert.Equal(t, "dbs/main.db", DatabasePath("dbs/main.db?_journal=WAL"))
+	assert.Equal(t, "/data/main.db", DatabasePath("file:/data/main.db?_fk=true"))
+	assert.Equal(t, "", DatabasePath("file:/123?vfs=memdb&_fk=true"))
+}
@@ -33,6 +33,9 @@ type (
 	CacheClient struct {
 		// store holds the Cache storage
 		store CacheStore
+
+		// metrics records hits and misses, if set
+		metrics *Metrics
 	}
 
 	// CacheSetOp handles chaining a set operation
@@ -182,7 +185,14 @@ func (c *CacheGetOp) Fetch(ctx context.Context) (any, error) {
 		return nil, errors.New("no cache key specified")
 	}
 
-	return c.client.store.get(ctx, c)
+	data, err := c.client.store.get(ctx, c)
+	switch {
+	case err == nil:
+		c.client.metrics.ObserveCache(true)
+	case errors.Is(err, ErrCacheMiss):
+		c.client.metrics.ObserveCache(false)
+	}
+	return data, err
 }
 
 // Key sets the cache key
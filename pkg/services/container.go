@@ -11,6 +11,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
+	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/labstack/echo/v4"
@@ -20,6 +22,7 @@ import (
 	inertia "github.com/romsar/gonertia/v2"
 	"golang.org/x/crypto/bcrypt"
 
+	"entgo.io/ent/dialect"
 	entsql "entgo.io/ent/dialect/sql"
 )
 
@@ -47,8 +50,29 @@ type Container struct {
 	// Hub manages SSE subscribers for real-time flag change notifications.
 	Hub *Hub
 
+	// Backup takes scheduled and on-demand database backups.
+	Backup *BackupService
+
+	// Trash soft-deletes items and purges them after the retention window.
+	Trash *TrashService
+
+	// Flags changes the config of flags in their environments.
+	Flags *FlagService
+
+	// Rollouts runs rollout plans that ramp up gradual rollouts in steps.
+	Rollouts *RolloutService
+
+	// Metrics holds the Prometheus collectors.
+	Metrics *Metrics
+
+	// Tracing holds the OpenTelemetry tracer provider.
+	Tracing *Tracing
+
 	// Inertia for React
 	Inertia *inertia.Inertia
+
+	// shuttingDown is set at the start of Shutdown to fail readiness checks.
+	shuttingDown atomic.Bool
 }
 
 // NewContainer creates and initializes a new Container.
@@ -59,25 +83,75 @@ func NewContainer() *Container {
 	c.initWeb()
 	c.initCache()
 	c.initDatabase()
+	c.initTracing()
 	c.initORM()
 	c.initHub()
+	c.initMetrics()
+	c.initBackup()
+	c.initTrash()
+	c.initFlags()
+	c.initRollouts()
 	c.seedAdminUser()
 	c.initInertia()
+	c.Backup.Start()
+	c.Trash.Start()
+	c.Rollouts.Start()
+	return c
+}
+
+// NewHeadlessContainer creates a Container without the web framework and
+// Inertia, for command-line tools that only need configuration and the database.
+// The schema is migrated just like in NewContainer.
+func NewHeadlessContainer() *Container {
+	c := new(Container)
+	c.initConfig()
+	c.initValidator()
+	c.initCache()
+	c.initDatabase()
+	c.initORM()
+	c.initHub()
+	c.initBackup()
+	c.initTrash()
+	c.initFlags()
+	c.initRollouts()
 	return c
 }
 
 // Shutdown gracefully shuts the Container down and disconnects all connections.
 func (c *Container) Shutdown() error {
-	// Shutdown the web server.
-	webCtx, webCancel := context.WithTimeout(context.Background(), c.Config.HTTP.ShutdownTimeout)
-	defer webCancel()
-	if err := c.Web.Shutdown(webCtx); err != nil {
-		return err
+	// Fail readiness checks first and give load balancers time to notice,
+	// while requests and SSE streams are still being served.
+	c.shuttingDown.Store(true)
+	if c.Web != nil && c.Config.HTTP.DrainDelay > 0 {
+		time.Sleep(c.Config.HTTP.DrainDelay)
 	}
 
-	// Shutdown the hub (close all SSE subscriber channels).
+	// Ask SSE clients to reconnect elsewhere and give them until the drain
+	// timeout to leave. Closing the rest keeps the web server shutdown from
+	// waiting on long-lived streams.
+	drainCtx, drainCancel := context.WithTimeout(context.Background(), c.Config.Stream.DrainTimeout)
+	defer drainCancel()
+	c.Hub.Drain(drainCtx)
 	c.Hub.Close()
 
+	// Shutdown the web server.
+	if c.Web != nil {
+		webCtx, webCancel := context.WithTimeout(context.Background(), c.Config.HTTP.ShutdownTimeout)
+		defer webCancel()
+		if err := c.Web.Shutdown(webCtx); err != nil {
+			return err
+		}
+	}
+
+	// Stop scheduled backups, waiting for one in progress.
+	c.Backup.Stop()
+
+	// Stop purging the trash.
+	c.Trash.Stop()
+
+	// Stop advancing rollout plans, waiting for a step in progress.
+	c.Rollouts.Stop()
+
 	// Shutdown the ORM (also closes the underlying database connection).
 	if err := c.ORM.Close(); err != nil {
 		return err
@@ -86,6 +160,15 @@ func (c *Container) Shutdown() error {
 	// Shutdown the cache.
 	c.Cache.Close()
 
+	// Flush pending spans.
+	if c.Tracing != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), c.Config.HTTP.ShutdownTimeout)
+		defer cancel()
+		if err := c.Tracing.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -104,6 +187,11 @@ func (c *Container) initConfig() {
 	default:
 		slog.SetLogLoggerLevel(slog.LevelDebug)
 	}
+
+	// There is no load balancer to drain in tests.
+	if cfg.App.Environment == config.EnvTest {
+		c.Config.HTTP.DrainDelay = 0
+	}
 }
 
 // initValidator initializes the validator.
@@ -148,12 +236,22 @@ func (c *Container) initDatabase() {
 
 // initORM initializes the Ent ORM client and runs auto-migration.
 func (c *Container) initORM() {
-	drv := entsql.OpenDB(c.Config.Database.Driver, c.Database)
+	var drv dialect.Driver = entsql.OpenDB(c.Config.Database.Driver, c.Database)
+	if c.Tracing != nil && c.Config.Tracing.Enabled {
+		drv = newTracingDriver(drv, c.Tracing.Provider)
+	}
 	c.ORM = ent.NewClient(ent.Driver(drv))
 
 	if err := c.ORM.Schema.Create(context.Background()); err != nil {
 		panic(fmt.Sprintf("failed to create schema resources: %v", err))
 	}
+
+	// Record the schema version so backups can be validated on restore.
+	if c.Config.Database.Driver == "sqlite3" {
+		if _, err := c.Database.Exec(fmt.Sprintf("PRAGMA user_version = %d", SchemaVersion)); err != nil {
+			panic(fmt.Sprintf("failed to set schema version: %v", err))
+		}
+	}
 }
 
 // seedAdminUser creates the initial admin user if no users exist and
@@ -274,6 +372,59 @@ func (c *Container) getInertia() *inertia.Inertia {
 	return i
 }
 
+// initBackup initializes the backup service. The schedule is started by
+// NewContainer only when backups are enabled.
+func (c *Container) initBackup() {
+	// Keep test backups out of the source tree.
+	if c.Config.App.Environment == config.EnvTest {
+		dir, err := os.MkdirTemp("", "bandeira-backups-")
+		if err != nil {
+			panic(err)
+		}
+		c.Config.Backup.Directory = dir
+	}
+
+	b, err := NewBackupService(c.Database, c.Config.Backup)
+	if err != nil {
+		panic(fmt.Sprintf("failed to initialize backups: %v", err))
+	}
+	c.Backup = b
+}
+
+// initTrash initializes the trash service. Scheduled purging is started by
+// NewContainer.
+func (c *Container) initTrash() {
+	c.Trash = NewTrashService(c.ORM, c.Config.Trash)
+}
+
+// initFlags initializes the flag service.
+func (c *Container) initFlags() {
+	c.Flags = NewFlagService(c.ORM, c.Hub)
+}
+
+// initRollouts initializes the rollout service. The worker is started by
+// NewContainer.
+func (c *Container) initRollouts() {
+	c.Rollouts = NewRolloutService(c.ORM, c.Flags, c.Config.Rollout)
+}
+
+// initMetrics initializes the Prometheus collectors and attaches them to the
+// hub and cache.
+func (c *Container) initMetrics() {
+	c.Metrics = NewMetrics(c.Database, c.Hub)
+	c.Hub.metrics = c.Metrics
+	c.Cache.metrics = c.Metrics
+}
+
+// initTracing initializes OpenTelemetry tracing.
+func (c *Container) initTracing() {
+	t, err := NewTracing(c.Config.Tracing, c.Config.App.Name)
+	if err != nil {
+		panic(fmt.Sprintf("failed to initialize tracing: %v", err))
+	}
+	c.Tracing = t
+}
+
 // initHub initializes the SSE event hub.
 func (c *Container) initHub() {
 	c.Hub = NewHub()
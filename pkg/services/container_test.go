@@ -14,3 +14,12 @@ func TestNewContainer(t *testing.T) {
 	assert.NotNil(t, c.Database)
 	assert.NotNil(t, c.ORM)
 }
+
+func TestNewHeadlessContainer(t *testing.T) {
+	hc := NewHeadlessContainer()
+	assert.Nil(t, hc.Web)
+	assert.Nil(t, hc.Inertia)
+	assert.NotNil(t, hc.ORM)
+	assert.NotNil(t, hc.Hub)
+	assert.NoError(t, hc.Shutdown())
+}
@@ -0,0 +1,731 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/felipekafuri/bandeira/ent"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+var (
+	// ErrFlagNotFound is returned for a flag that does not exist, is in the
+	// trash or belongs to another project.
+	ErrFlagNotFound = errors.New("flags: flag not found")
+
+	// ErrEnvironmentNotFound is returned for an environment that does not
+	// exist, is in the trash or belongs to another project.
+	ErrEnvironmentNotFound = errors.New("flags: environment not found")
+
+	// ErrStrategyNotFound is returned for a strategy that does not exist or
+	// belongs to another flag.
+	ErrStrategyNotFound = errors.New("flags: strategy not found")
+)
+
+// StrategyInput is a strategy as submitted by the dashboard and the admin
+// API.
+type StrategyInput struct {
+	EnvironmentID int                    `json:"environment_id"`
+	Name          string                 `json:"name"`
+	Parameters    map[string]interface{} `json:"parameters"`
+	SortOrder     int                    `json:"sort_order"`
+	Constraints   []ConstraintInput      `json:"constraints"`
+}
+
+type ConstraintInput struct {
+	ContextName     string   `json:"context_name"`
+	Operator        string   `json:"operator"`
+	Values          []string `json:"values"`
+	Inverted        bool     `json:"inverted"`
+	CaseInsensitive bool     `json:"case_insensitive"`
+}
+
+// Validate checks the strategy against the registry and returns errors keyed
+// by field path below prefix.
+func (si StrategyInput) Validate(reg *declarative.Registry, prefix string) map[string]string {
+	s := declarative.Strategy{
+		Name:        si.Name,
+		Parameters:  si.Parameters,
+		Constraints: make([]declarative.Constraint, 0, len(si.Constraints)),
+	}
+	for _, ci := range si.Constraints {
+		s.Constraints = append(s.Constraints, declarative.Constraint{
+			ContextName:     ci.ContextName,
+			Operator:        ci.Operator,
+			Values:          ci.Values,
+			Inverted:        ci.Inverted,
+			CaseInsensitive: ci.CaseInsensitive,
+		})
+	}
+	return reg.ValidateStrategy(s, prefix)
+}
+
+// FlagEnvRef names the config of a flag in one environment of a project.
+type FlagEnvRef struct {
+	ProjectID     int
+	FlagID        int
+	EnvironmentID int
+}
+
+// FlagEnvPatch is a change to the config of a flag in one environment. Nil
+// fields are left alone.
+type FlagEnvPatch struct {
+	Enabled       *bool
+	Strategies    *[]StrategyInput
+	Prerequisites *[]declarative.Prerequisite
+
+	// Revision, when not zero, must be the current revision of the config.
+	Revision int
+
+	// Actor and Reason are recorded on the new version.
+	Actor  string
+	Reason string
+}
+
+// FlagEnvUpdate is one change of a batch applied with PatchMany.
+type FlagEnvUpdate struct {
+	Flag        *ent.Flag
+	Environment *ent.Environment
+	Patch       FlagEnvPatch
+}
+
+// FlagEvent is a change made through the FlagService. Events are delivered
+// after the change commits.
+type FlagEvent interface {
+	Meta() EventMeta
+}
+
+// EventMeta says which config an event is about, who changed it and the
+// revision it is at now.
+type EventMeta struct {
+	ProjectID     int
+	FlagID        int
+	Flag          string
+	EnvironmentID int
+	Environment   string
+	Revision      int
+	Actor         string
+	At            time.Time
+}
+
+func (m EventMeta) Meta() EventMeta { return m }
+
+// FlagEnvChanged is emitted when the enabled state, strategies or
+// prerequisites of a config are set with Patch, or changed by an import, a
+// promotion or a version restore.
+type FlagEnvChanged struct {
+	EventMeta
+	// Enabled is the new state when it was set.
+	Enabled               *bool
+	StrategiesReplaced    bool
+	PrerequisitesReplaced bool
+}
+
+type StrategyCreated struct {
+	EventMeta
+	Strategy *ent.Strategy
+}
+
+type StrategyUpdated struct {
+	EventMeta
+	Strategy *ent.Strategy
+}
+
+type StrategyDeleted struct {
+	EventMeta
+	StrategyID int
+}
+
+// FlagService changes the config of flags in their environments. It checks
+// that everything belongs to the project, validates strategies, applies the
+// change, the revision bump and a new version in one transaction, notifies
+// connected SDKs and emits a FlagEvent. The dashboard and the admin
+// API both go through it.
+type FlagService struct {
+	orm *ent.Client
+	hub *Hub
+
+	mu          sync.RWMutex
+	subscribers map[int]func(context.Context, FlagEvent)
+	nextID      int
+}
+
+// NewFlagService creates a flag service.
+func NewFlagService(orm *ent.Client, hub *Hub) *FlagService {
+	return &FlagService{orm: orm, hub: hub, subscribers: map[int]func(context.Context, FlagEvent){}}
+}
+
+// Subscribe calls fn with every event, in order, on the goroutine that made
+// the change, so fn should return quickly. It returns a function that
+// unsubscribes.
+func (s *FlagService) Subscribe(fn func(context.Context, FlagEvent)) func() {
+	s.mu.Lock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = fn
+	s.mu.Unlock()
+
+	return func() {
+		s.mu.Lock()
+		delete(s.subscribers, id)
+		s.mu.Unlock()
+	}
+}
+
+// Patch applies a change to the config of a flag in one environment and
+// returns the config at its new revision.
+func (s *FlagService) Patch(ctx context.Context, ref FlagEnvRef, p FlagEnvPatch) (*ent.FlagEnvironment, error) {
+	f, env, err := s.target(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate strategies before anything else so that an invalid strategy
+	// rejects the whole change.
+	if p.Strategies != nil {
+		reg, err := declarative.ProjectRegistry(ctx, s.orm, ref.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		fields := map[string]string{}
+		for i, si := range *p.Strategies {
+			for k, v := range si.Validate(reg, fmt.Sprintf("strategies[%d].", i)) {
+				fields[k] = v
+			}
+		}
+		if len(fields) > 0 {
+			return nil, &declarative.ValidationError{Fields: fields}
+		}
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.PatchMany(ctx, tx.Client(), []FlagEnvUpdate{{Flag: f, Environment: env, Patch: p}})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.Emit(ctx, events)
+
+	return s.orm.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(f.ID), flagenvironment.EnvironmentID(env.ID)).
+		Only(ctx)
+}
+
+// PatchMany applies changes to several configs on the client of a
+// transaction owned by the caller, so that they commit along with its other
+// changes, and returns one event per change. The caller checks that the
+// flags and environments belong to the project, validates strategies, and
+// hands the events to Emit once the transaction has committed.
+func (s *FlagService) PatchMany(ctx context.Context, client *ent.Client, updates []FlagEnvUpdate) ([]FlagEvent, error) {
+	events := make([]FlagEvent, 0, len(updates))
+	for _, u := range updates {
+		fe, err := GetOrCreateFlagEnvironment(ctx, client, u.Flag.ID, u.Environment.ID)
+		if err != nil {
+			return nil, err
+		}
+		rev, err := patchIn(ctx, client, u.Flag.ProjectID, fe, u.Patch)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, FlagEnvChanged{
+			EventMeta:             s.meta(u.Flag, u.Environment, rev, u.Patch.Actor),
+			Enabled:               u.Patch.Enabled,
+			StrategiesReplaced:    u.Patch.Strategies != nil,
+			PrerequisitesReplaced: u.Patch.Prerequisites != nil,
+		})
+	}
+	return events, nil
+}
+
+// Emit follows up the events of committed changes returned by PatchMany.
+func (s *FlagService) Emit(ctx context.Context, events []FlagEvent) {
+	for _, ev := range events {
+		s.changed(ctx, ev)
+	}
+}
+
+// SetEnabled turns a flag on or off in one environment.
+func (s *FlagService) SetEnabled(ctx context.Context, ref FlagEnvRef, enabled bool, revision int, actor string) (*ent.FlagEnvironment, error) {
+	return s.Patch(ctx, ref, FlagEnvPatch{Enabled: &enabled, Revision: revision, Actor: actor})
+}
+
+// SetPrerequisites replaces the prerequisites of a flag in one environment.
+func (s *FlagService) SetPrerequisites(ctx context.Context, ref FlagEnvRef, prereqs []declarative.Prerequisite, revision int, actor string) (*ent.FlagEnvironment, error) {
+	return s.Patch(ctx, ref, FlagEnvPatch{Prerequisites: &prereqs, Revision: revision, Actor: actor})
+}
+
+// CreateStrategy adds a strategy to the config of a flag in one environment.
+// It returns the strategy, with its constraints, and the new revision.
+func (s *FlagService) CreateStrategy(ctx context.Context, ref FlagEnvRef, in StrategyInput, revision int, actor string) (*ent.Strategy, int, error) {
+	f, env, err := s.target(ctx, ref)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.validate(ctx, ref.ProjectID, in); err != nil {
+		return nil, 0, err
+	}
+
+	fe, err := GetOrCreateFlagEnvironment(ctx, s.orm, f.ID, env.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var st *ent.Strategy
+	rev, err := withRevision(ctx, s.orm, fe.ID, revision, actor, func(client *ent.Client) error {
+		st, err = createStrategy(ctx, client, fe.ID, in)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.changed(ctx, StrategyCreated{EventMeta: s.meta(f, env, rev, actor), Strategy: st})
+	return st, rev, nil
+}
+
+// UpdateStrategy replaces the settings and constraints of a strategy of the
+// flag. It returns the strategy and the new revision of its config.
+func (s *FlagService) UpdateStrategy(ctx context.Context, projectID, flagID, strategyID int, in StrategyInput, revision int, actor string) (*ent.Strategy, int, error) {
+	f, env, fe, err := s.strategyTarget(ctx, projectID, flagID, strategyID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := s.validate(ctx, projectID, in); err != nil {
+		return nil, 0, err
+	}
+
+	var st *ent.Strategy
+	rev, err := withRevision(ctx, s.orm, fe.ID, revision, actor, func(client *ent.Client) error {
+		if _, err := client.Constraint.Delete().Where(entconstraint.StrategyID(strategyID)).Exec(ctx); err != nil {
+			return err
+		}
+		st, err = client.Strategy.
+			UpdateOneID(strategyID).
+			SetName(in.Name).
+			SetParameters(in.Parameters).
+			SetSortOrder(in.SortOrder).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		st.Edges.Constraints, err = createConstraints(ctx, client, st.ID, in.Constraints)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	s.changed(ctx, StrategyUpdated{EventMeta: s.meta(f, env, rev, actor), Strategy: st})
+	return st, rev, nil
+}
+
+// DeleteStrategy deletes a strategy of the flag with its constraints and
+// returns the new revision of its config.
+func (s *FlagService) DeleteStrategy(ctx context.Context, projectID, flagID, strategyID, revision int, actor string) (int, error) {
+	f, env, fe, err := s.strategyTarget(ctx, projectID, flagID, strategyID)
+	if err != nil {
+		return 0, err
+	}
+
+	rev, err := withRevision(ctx, s.orm, fe.ID, revision, actor, func(client *ent.Client) error {
+		// Delete constraints first (SQLite has no FK cascade).
+		if _, err := client.Constraint.Delete().Where(entconstraint.StrategyID(strategyID)).Exec(ctx); err != nil {
+			return err
+		}
+		return client.Strategy.DeleteOneID(strategyID).Exec(ctx)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	s.changed(ctx, StrategyDeleted{EventMeta: s.meta(f, env, rev, actor), StrategyID: strategyID})
+	return rev, nil
+}
+
+// KillSwitch turns off every kill_switch flag of the project that is enabled
+// in the environment, all in one transaction, recording a version of each
+// with the reason. Off is the safe state of a kill switch. It returns the
+// names of the flags turned off, sorted. A freeze does not stop it.
+func (s *FlagService) KillSwitch(ctx context.Context, projectID, envID int, actor, reason string) ([]string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, &declarative.ValidationError{Fields: map[string]string{"reason": "Reason is required"}}
+	}
+
+	env, err := s.orm.Environment.Query().
+		Where(environment.ID(envID), environment.ProjectID(projectID), environment.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrEnvironmentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.orm.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fes, err := tx.FlagEnvironment.Query().
+		Where(
+			flagenvironment.EnvironmentID(envID),
+			flagenvironment.Enabled(true),
+			flagenvironment.HasFlagWith(
+				entflag.ProjectID(projectID),
+				entflag.DeletedAtIsNil(),
+				entflag.FlagTypeEQ(entflag.FlagTypeKillSwitch),
+			),
+		).
+		WithFlag().
+		Order(flagenvironment.ByID()).
+		All(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	off := false
+	updates := make([]FlagEnvUpdate, 0, len(fes))
+	names := make([]string, 0, len(fes))
+	for _, fe := range fes {
+		updates = append(updates, FlagEnvUpdate{
+			Flag:        fe.Edges.Flag,
+			Environment: env,
+			Patch:       FlagEnvPatch{Enabled: &off, Actor: actor, Reason: reason},
+		})
+		names = append(names, fe.Edges.Flag.Name)
+	}
+	events, err := s.PatchMany(ctx, tx.Client(), updates)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	s.Emit(ctx, events)
+
+	sort.Strings(names)
+	return names, nil
+}
+
+// Apply reconciles a project with a document (see declarative.Apply) and
+// emits a FlagEnvChanged for every config it changed. The caller notifies
+// SDKs of project-wide changes such as new flags or environments.
+func (s *FlagService) Apply(ctx context.Context, projectID int, doc *declarative.Document, opts declarative.Options) (*declarative.Diff, error) {
+	diff, err := declarative.Apply(ctx, s.orm, projectID, doc, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.emitDiff(ctx, projectID, diff, opts.Actor)
+	return diff, nil
+}
+
+// Promote copies flag configs between environments (see declarative.Promote)
+// and emits a FlagEnvChanged for every config of the target it changed.
+func (s *FlagService) Promote(ctx context.Context, projectID int, opts declarative.PromoteOptions) (*declarative.Diff, error) {
+	diff, err := declarative.Promote(ctx, s.orm, projectID, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.emitDiff(ctx, projectID, diff, opts.Actor)
+	return diff, nil
+}
+
+// RestoreVersion reverts a config to a recorded version (see
+// declarative.RestoreVersion) and emits a FlagEnvChanged for it.
+func (s *FlagService) RestoreVersion(ctx context.Context, ref FlagEnvRef, version, revision int, actor string) (*declarative.Diff, error) {
+	diff, err := declarative.RestoreVersion(ctx, s.orm, ref.ProjectID, ref.FlagID, ref.EnvironmentID, version, revision, actor)
+	if err != nil {
+		return nil, err
+	}
+	s.emitDiff(ctx, ref.ProjectID, diff, actor)
+	return diff, nil
+}
+
+// emitDiff emits the configs changed by a committed declarative run.
+func (s *FlagService) emitDiff(ctx context.Context, projectID int, diff *declarative.Diff, actor string) {
+	events := make([]FlagEvent, 0, len(diff.FlagEnvironments))
+	for _, c := range diff.FlagEnvironments {
+		events = append(events, FlagEnvChanged{
+			EventMeta: EventMeta{
+				ProjectID:     projectID,
+				FlagID:        c.FlagID,
+				Flag:          c.Flag,
+				EnvironmentID: c.EnvironmentID,
+				Environment:   c.Environment,
+				Revision:      c.Revision,
+				Actor:         actor,
+				At:            time.Now(),
+			},
+			Enabled:               c.Enabled,
+			StrategiesReplaced:    c.StrategiesReplaced,
+			PrerequisitesReplaced: c.PrerequisitesReplaced,
+		})
+	}
+	s.Emit(ctx, events)
+}
+
+// StrategyEnvironment returns the environment of a strategy of the flag.
+func (s *FlagService) StrategyEnvironment(ctx context.Context, projectID, flagID, strategyID int) (*ent.Environment, error) {
+	_, env, _, err := s.strategyTarget(ctx, projectID, flagID, strategyID)
+	return env, err
+}
+
+// target loads the flag and environment of ref, which must both belong to
+// its project.
+func (s *FlagService) target(ctx context.Context, ref FlagEnvRef) (*ent.Flag, *ent.Environment, error) {
+	f, err := s.orm.Flag.Query().
+		Where(entflag.ID(ref.FlagID), entflag.ProjectID(ref.ProjectID), entflag.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrFlagNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env, err := s.orm.Environment.Query().
+		Where(environment.ID(ref.EnvironmentID), environment.ProjectID(ref.ProjectID), environment.DeletedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, ErrEnvironmentNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, env, nil
+}
+
+// strategyTarget loads a strategy's flag, environment and config, checking
+// that it is a strategy of the flag.
+func (s *FlagService) strategyTarget(ctx context.Context, projectID, flagID, strategyID int) (*ent.Flag, *ent.Environment, *ent.FlagEnvironment, error) {
+	st, err := s.orm.Strategy.Query().
+		Where(strategy.ID(strategyID)).
+		WithFlagEnvironment().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil, nil, ErrStrategyNotFound
+	}
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	fe := st.Edges.FlagEnvironment
+	if fe == nil || fe.FlagID != flagID {
+		return nil, nil, nil, ErrStrategyNotFound
+	}
+
+	f, env, err := s.target(ctx, FlagEnvRef{ProjectID: projectID, FlagID: flagID, EnvironmentID: fe.EnvironmentID})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return f, env, fe, nil
+}
+
+func (s *FlagService) validate(ctx context.Context, projectID int, in StrategyInput) error {
+	reg, err := declarative.ProjectRegistry(ctx, s.orm, projectID)
+	if err != nil {
+		return err
+	}
+	if fields := in.Validate(reg, ""); len(fields) > 0 {
+		return &declarative.ValidationError{Fields: fields}
+	}
+	return nil
+}
+
+func (s *FlagService) meta(f *ent.Flag, env *ent.Environment, revision int, actor string) EventMeta {
+	return EventMeta{
+		ProjectID:     f.ProjectID,
+		FlagID:        f.ID,
+		Flag:          f.Name,
+		EnvironmentID: env.ID,
+		Environment:   env.Name,
+		Revision:      revision,
+		Actor:         actor,
+		At:            time.Now(),
+	}
+}
+
+// changed follows up a committed change: it notifies SDKs and emits the
+// event.
+func (s *FlagService) changed(ctx context.Context, ev FlagEvent) {
+	m := ev.Meta()
+	s.hub.Notify(m.ProjectID, m.Environment)
+
+	s.mu.RLock()
+	subs := make([]func(context.Context, FlagEvent), 0, len(s.subscribers))
+	for _, fn := range s.subscribers {
+		subs = append(subs, fn)
+	}
+	s.mu.RUnlock()
+	for _, fn := range subs {
+		fn(ctx, ev)
+	}
+}
+
+// GetOrCreateFlagEnvironment finds or creates the config of a flag in an
+// environment.
+func GetOrCreateFlagEnvironment(ctx context.Context, client *ent.Client, flagID, envID int) (*ent.FlagEnvironment, error) {
+	fe, err := client.FlagEnvironment.
+		Query().
+		Where(
+			flagenvironment.FlagID(flagID),
+			flagenvironment.EnvironmentID(envID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return client.FlagEnvironment.
+				Create().
+				SetFlagID(flagID).
+				SetEnvironmentID(envID).
+				SetEnabled(false).
+				Save(ctx)
+		}
+		return nil, err
+	}
+	return fe, nil
+}
+
+// patchIn applies a patch on the client of a transaction and records the
+// new version, and returns the new revision. The revision is bumped first,
+// so a stale write changes nothing. Prerequisites are replaced next so that
+// an invalid set (unknown flag, cycle) rejects the whole patch.
+func patchIn(ctx context.Context, client *ent.Client, projectID int, fe *ent.FlagEnvironment, p FlagEnvPatch) (int, error) {
+	revision, err := declarative.BumpRevision(ctx, client, fe.ID, p.Revision)
+	if err != nil {
+		return 0, err
+	}
+
+	if p.Prerequisites != nil {
+		if err := declarative.ReplacePrerequisites(ctx, client, projectID, fe.FlagID, fe.EnvironmentID, *p.Prerequisites); err != nil {
+			return 0, err
+		}
+	}
+
+	if p.Enabled != nil {
+		if err := client.FlagEnvironment.UpdateOneID(fe.ID).SetEnabled(*p.Enabled).Exec(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	if p.Strategies != nil {
+		if err := replaceStrategies(ctx, client, fe.ID, *p.Strategies); err != nil {
+			return 0, err
+		}
+	}
+
+	if _, err := declarative.RecordVersionWithReason(ctx, client, fe.ID, p.Actor, p.Reason); err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// replaceStrategies deletes the strategies of a flag environment, with their
+// constraints, and creates the given ones in their order.
+func replaceStrategies(ctx context.Context, client *ent.Client, feID int, inputs []StrategyInput) error {
+	// Delete old constraints then strategies.
+	oldStrategies, err := client.Strategy.Query().
+		Where(strategy.FlagEnvironmentID(feID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(oldStrategies) > 0 {
+		if _, err := client.Constraint.Delete().Where(entconstraint.StrategyIDIn(oldStrategies...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := client.Strategy.Delete().Where(strategy.FlagEnvironmentID(feID)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Recreate from input.
+	for i, si := range inputs {
+		si.SortOrder = i
+		if _, err := createStrategy(ctx, client, feID, si); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withRevision runs fn in a transaction that also bumps the revision of the
+// flag environment, checked against expected unless it is zero, and records
+// the new version by actor. It returns the new revision.
+func withRevision(ctx context.Context, orm *ent.Client, feID, expected int, actor string, fn func(*ent.Client) error) (int, error) {
+	tx, err := orm.Tx(ctx)
+	if err != nil {
+		return 0, err
+	}
+	revision, err := declarative.BumpRevision(ctx, tx.Client(), feID, expected)
+	if err == nil {
+		err = fn(tx.Client())
+	}
+	if err == nil {
+		_, err = declarative.RecordVersion(ctx, tx.Client(), feID, actor)
+	}
+	if err != nil {
+		tx.Rollback()
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+// createStrategy creates a strategy with its constraints, which are loaded
+// into its edges.
+func createStrategy(ctx context.Context, client *ent.Client, feID int, si StrategyInput) (*ent.Strategy, error) {
+	s, err := client.Strategy.Create().
+		SetName(si.Name).
+		SetParameters(si.Parameters).
+		SetSortOrder(si.SortOrder).
+		SetFlagEnvironmentID(feID).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.Edges.Constraints, err = createConstraints(ctx, client, s.ID, si.Constraints)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// createConstraints creates the constraints of a strategy.
+func createConstraints(ctx context.Context, client *ent.Client, strategyID int, inputs []ConstraintInput) ([]*ent.Constraint, error) {
+	out := make([]*ent.Constraint, 0, len(inputs))
+	for _, ci := range inputs {
+		c, err := client.Constraint.Create().
+			SetContextName(ci.ContextName).
+			SetOperator(entconstraint.Operator(ci.Operator)).
+			SetValues(ci.Values).
+			SetInverted(ci.Inverted).
+			SetCaseInsensitive(ci.CaseInsensitive).
+			SetStrategyID(strategyID).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, nil
+}
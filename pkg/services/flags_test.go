@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+func TestFlagService(t *testing.T) {
+	bg := context.Background()
+	flags := NewFlagService(c.ORM, c.Hub)
+
+	p := c.ORM.Project.Create().SetName("flags-test").SaveX(bg)
+	other := c.ORM.Project.Create().SetName("flags-test-other").SaveX(bg)
+	env := c.ORM.Environment.Create().SetName("prod").SetType("production").SetProjectID(p.ID).SaveX(bg)
+	otherEnv := c.ORM.Environment.Create().SetName("prod").SetType("production").SetProjectID(other.ID).SaveX(bg)
+	f := c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(p.ID).SaveX(bg)
+
+	var events []FlagEvent
+	unsubscribe := flags.Subscribe(func(_ context.Context, ev FlagEvent) {
+		events = append(events, ev)
+	})
+	defer unsubscribe()
+
+	ref := FlagEnvRef{ProjectID: p.ID, FlagID: f.ID, EnvironmentID: env.ID}
+
+	t.Run("ownership", func(t *testing.T) {
+		_, err := flags.SetEnabled(bg, FlagEnvRef{ProjectID: other.ID, FlagID: f.ID, EnvironmentID: otherEnv.ID}, true, 0, "")
+		assert.ErrorIs(t, err, ErrFlagNotFound)
+		_, err = flags.SetEnabled(bg, FlagEnvRef{ProjectID: p.ID, FlagID: f.ID, EnvironmentID: otherEnv.ID}, true, 0, "")
+		assert.ErrorIs(t, err, ErrEnvironmentNotFound)
+		assert.Empty(t, events)
+	})
+
+	t.Run("toggle", func(t *testing.T) {
+		fe, err := flags.SetEnabled(bg, ref, true, 0, "ana@example.com")
+		require.NoError(t, err)
+		assert.True(t, fe.Enabled)
+		assert.Equal(t, 2, fe.Revision)
+
+		require.Len(t, events, 1)
+		ev, ok := events[0].(FlagEnvChanged)
+		require.True(t, ok)
+		assert.Equal(t, "checkout", ev.Flag)
+		assert.Equal(t, "prod", ev.Environment)
+		assert.Equal(t, "ana@example.com", ev.Actor)
+		assert.Equal(t, 2, ev.Revision)
+		assert.True(t, *ev.Enabled)
+
+		versions, err := declarative.Versions(bg, c.ORM, p.ID, f.ID, env.ID)
+		require.NoError(t, err)
+		require.Len(t, versions, 1)
+
+		var conflict *declarative.ConflictError
+		_, err = flags.SetEnabled(bg, ref, false, 1, "")
+		assert.ErrorAs(t, err, &conflict)
+	})
+
+	t.Run("strategies", func(t *testing.T) {
+		events = nil
+
+		_, _, err := flags.CreateStrategy(bg, ref, StrategyInput{Name: "no-such-strategy"}, 0, "")
+		var verr *declarative.ValidationError
+		assert.ErrorAs(t, err, &verr)
+
+		s, rev, err := flags.CreateStrategy(bg, ref, StrategyInput{
+			Name:       "gradualRollout",
+			Parameters: map[string]any{"rollout": 10},
+		}, 2, "")
+		require.NoError(t, err)
+		assert.Equal(t, 3, rev)
+
+		// Another flag's strategy is out of reach.
+		f2 := c.ORM.Flag.Create().SetName("search").SetFlagType("release").SetProjectID(p.ID).SaveX(bg)
+		_, err = flags.DeleteStrategy(bg, p.ID, f2.ID, s.ID, 0, "")
+		assert.ErrorIs(t, err, ErrStrategyNotFound)
+
+		s, rev, err = flags.UpdateStrategy(bg, p.ID, f.ID, s.ID, StrategyInput{
+			Name:       "gradualRollout",
+			Parameters: map[string]any{"rollout": 50},
+		}, 0, "")
+		require.NoError(t, err)
+		assert.Equal(t, 4, rev)
+		assert.EqualValues(t, 50, s.Parameters["rollout"])
+
+		rev, err = flags.DeleteStrategy(bg, p.ID, f.ID, s.ID, rev, "")
+		require.NoError(t, err)
+		assert.Equal(t, 5, rev)
+
+		require.Len(t, events, 3)
+		assert.IsType(t, StrategyCreated{}, events[0])
+		assert.IsType(t, StrategyUpdated{}, events[1])
+		assert.Equal(t, s.ID, events[2].(StrategyDeleted).StrategyID)
+	})
+
+	t.Run("kill switch", func(t *testing.T) {
+		events = nil
+		ks := c.ORM.Flag.Create().SetName("payments-off").SetFlagType("kill_switch").SetProjectID(p.ID).SaveX(bg)
+		ksRef := FlagEnvRef{ProjectID: p.ID, FlagID: ks.ID, EnvironmentID: env.ID}
+		_, err := flags.SetEnabled(bg, ksRef, true, 0, "")
+		require.NoError(t, err)
+		events = nil
+
+		names, err := flags.KillSwitch(bg, p.ID, env.ID, "ana@example.com", "Payments down")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"payments-off"}, names)
+
+		// One event per config turned off, as for any other change.
+		require.Len(t, events, 1)
+		ev, ok := events[0].(FlagEnvChanged)
+		require.True(t, ok)
+		assert.Equal(t, "payments-off", ev.Flag)
+		assert.Equal(t, "ana@example.com", ev.Actor)
+		assert.False(t, *ev.Enabled)
+
+		versions, err := declarative.Versions(bg, c.ORM, p.ID, ks.ID, env.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Payments down", versions[0].Reason)
+	})
+}
@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+)
+
+// Version is the release version, set at build time with
+// -ldflags "-X github.com/felipekafuri/bandeira/pkg/services.Version=v1.2.3".
+var Version = "dev"
+
+// HealthCheck is the result of a single readiness check.
+type HealthCheck struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+// ShuttingDown reports whether Shutdown has been called.
+func (c *Container) ShuttingDown() bool {
+	return c.shuttingDown.Load()
+}
+
+// Ready runs the readiness checks: the container is not shutting down, the
+// database answers, the schema migration has been applied and the hub accepts
+// SSE subscribers. It reports whether all checks passed.
+func (c *Container) Ready(ctx context.Context) (bool, []HealthCheck) {
+	checks := []HealthCheck{
+		healthCheck("shutdown", func() error {
+			if c.ShuttingDown() {
+				return fmt.Errorf("shutting down")
+			}
+			return nil
+		}),
+		healthCheck("database", func() error {
+			return c.Database.PingContext(ctx)
+		}),
+		healthCheck("schema", func() error {
+			if c.Config.Database.Driver != "sqlite3" {
+				return nil
+			}
+			var version int
+			if err := c.Database.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+				return err
+			}
+			if version != SchemaVersion {
+				return fmt.Errorf("schema version %d, expected %d", version, SchemaVersion)
+			}
+			return nil
+		}),
+		healthCheck("hub", func() error {
+			if !c.Hub.Accepting() {
+				return fmt.Errorf("not accepting subscribers")
+			}
+			return nil
+		}),
+	}
+
+	ready := true
+	for _, check := range checks {
+		ready = ready && check.OK
+	}
+	return ready, checks
+}
+
+func healthCheck(name string, fn func() error) HealthCheck {
+	if err := fn(); err != nil {
+		return HealthCheck{Name: name, Error: err.Error()}
+	}
+	return HealthCheck{Name: name, OK: true}
+}
+
+// BuildInfo describes the running binary.
+type BuildInfo struct {
+	Version       string          `json:"version"`
+	Revision      string          `json:"revision,omitempty"`
+	Time          string          `json:"time,omitempty"`
+	Modified      bool            `json:"modified,omitempty"`
+	GoVersion     string          `json:"go_version"`
+	SchemaVersion int             `json:"schema_version"`
+	Features      map[string]bool `json:"features"`
+}
+
+// BuildInfo returns the version, VCS information embedded by the Go toolchain,
+// the schema version and which optional features are enabled.
+func (c *Container) BuildInfo() BuildInfo {
+	info := BuildInfo{
+		Version:       Version,
+		SchemaVersion: SchemaVersion,
+		Features: map[string]bool{
+			"tls":       c.Config.HTTP.TLS.Enabled,
+			"metrics":   c.Config.Metrics.Enabled,
+			"tracing":   c.Config.Tracing.Enabled,
+			"backup":    c.Config.Backup.Enabled,
+			"backup_s3": c.Config.Backup.S3.Enabled,
+		},
+	}
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		info.GoVersion = bi.GoVersion
+		for _, s := range bi.Settings {
+			switch s.Key {
+			case "vcs.revision":
+				info.Revision = s.Value
+			case "vcs.time":
+				info.Time = s.Value
+			case "vcs.modified":
+				info.Modified = s.Value == "true"
+			}
+		}
+	}
+	return info
+}
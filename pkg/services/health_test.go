@@ -0,0 +1,29 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainer_Ready(t *testing.T) {
+	ready, checks := c.Ready(context.Background())
+	assert.True(t, ready)
+	assert.Len(t, checks, 4)
+
+	hc := NewHeadlessContainer()
+	ready, _ = hc.Ready(context.Background())
+	assert.True(t, ready)
+	assert.False(t, hc.ShuttingDown())
+
+	assert.NoError(t, hc.Shutdown())
+	assert.True(t, hc.ShuttingDown())
+	ready, checks = hc.Ready(context.Background())
+	assert.False(t, ready)
+	for _, check := range checks {
+		if check.Name == "shutdown" || check.Name == "hub" {
+			assert.False(t, check.OK, check.Name)
+		}
+	}
+}
@@ -1,8 +1,10 @@
 package services
 
 import (
+	"context"
 	"fmt"
 	"sync"
+	"time"
 )
 
 // Hub manages SSE subscribers for real-time flag change notifications.
@@ -10,12 +12,16 @@ import (
 type Hub struct {
 	mu          sync.RWMutex
 	subscribers map[string]map[chan struct{}]struct{}
+	draining    chan struct{}
+	closed      bool
+	metrics     *Metrics
 }
 
 // NewHub creates a new Hub.
 func NewHub() *Hub {
 	return &Hub{
 		subscribers: make(map[string]map[chan struct{}]struct{}),
+		draining:    make(chan struct{}),
 	}
 }
 
@@ -24,12 +30,18 @@ func hubKey(projectID int, envName string) string {
 }
 
 // Subscribe registers a listener for flag changes on the given project+environment.
-// Returns a signal channel and an unsubscribe function.
+// Returns a signal channel and an unsubscribe function. Once the hub is
+// draining or closed the returned channel is already closed.
 func (h *Hub) Subscribe(projectID int, envName string) (chan struct{}, func()) {
 	ch := make(chan struct{}, 1)
 	key := hubKey(projectID, envName)
 
 	h.mu.Lock()
+	if !h.accepting() {
+		h.mu.Unlock()
+		close(ch)
+		return ch, func() {}
+	}
 	if h.subscribers[key] == nil {
 		h.subscribers[key] = make(map[chan struct{}]struct{})
 	}
@@ -50,6 +62,7 @@ func (h *Hub) Subscribe(projectID int, envName string) (chan struct{}, func()) {
 
 // Notify sends a non-blocking signal to all subscribers for the given project+environment.
 func (h *Hub) Notify(projectID int, envName string) {
+	start := time.Now()
 	key := hubKey(projectID, envName)
 
 	h.mu.RLock()
@@ -62,11 +75,14 @@ func (h *Hub) Notify(projectID int, envName string) {
 		default:
 		}
 	}
+
+	h.metrics.ObserveNotify("environment", len(subs), time.Since(start))
 }
 
 // NotifyProject sends a non-blocking signal to ALL environment subscribers for a project.
 // Used when a flag is created or deleted (affects all environments).
 func (h *Hub) NotifyProject(projectID int) {
+	start := time.Now()
 	prefix := fmt.Sprintf("%d:", projectID)
 
 	h.mu.RLock()
@@ -86,6 +102,72 @@ func (h *Hub) NotifyProject(projectID int) {
 		default:
 		}
 	}
+
+	h.metrics.ObserveNotify("project", len(channels), time.Since(start))
+}
+
+// SubscriberCounts returns the number of subscribers per "projectID:envName" key.
+func (h *Hub) SubscriberCounts() map[string]int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	counts := make(map[string]int, len(h.subscribers))
+	for key, subs := range h.subscribers {
+		counts[key] = len(subs)
+	}
+	return counts
+}
+
+// Accepting reports whether the hub accepts new subscribers.
+func (h *Hub) Accepting() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.accepting()
+}
+
+func (h *Hub) accepting() bool {
+	select {
+	case <-h.draining:
+		return false
+	default:
+		return !h.closed
+	}
+}
+
+// Draining returns a channel that is closed when Drain starts. Subscribers
+// should then ask their clients to reconnect elsewhere.
+func (h *Hub) Draining() <-chan struct{} {
+	return h.draining
+}
+
+// Drain stops accepting subscribers, signals the current ones through
+// Draining, and waits until they have all unsubscribed or ctx is done.
+// It does not close the remaining subscribers; call Close for that.
+func (h *Hub) Drain(ctx context.Context) {
+	h.mu.Lock()
+	select {
+	case <-h.draining:
+	default:
+		close(h.draining)
+	}
+	h.mu.Unlock()
+
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		h.mu.RLock()
+		remaining := len(h.subscribers)
+		h.mu.RUnlock()
+		if remaining == 0 {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
 }
 
 // Close closes all subscriber channels. Call during shutdown.
@@ -93,6 +175,8 @@ func (h *Hub) Close() {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
+	h.closed = true
+
 	for key, subs := range h.subscribers {
 		for ch := range subs {
 			close(ch)
@@ -0,0 +1,68 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_SubscribeAfterClose(t *testing.T) {
+	hub := NewHub()
+	assert.True(t, hub.Accepting())
+	hub.Close()
+	assert.False(t, hub.Accepting())
+
+	ch, unsub := hub.Subscribe(1, "production")
+	defer unsub()
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestHub_Drain(t *testing.T) {
+	hub := NewHub()
+	_, unsub := hub.Subscribe(1, "production")
+
+	// Subscribers leave once they see the drain signal.
+	go func() {
+		<-hub.Draining()
+		unsub()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		hub.Drain(context.Background())
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("drain did not return after subscribers left")
+	}
+	assert.False(t, hub.Accepting())
+	assert.Empty(t, hub.SubscriberCounts())
+
+	// New subscribers are refused.
+	ch, _ := hub.Subscribe(1, "production")
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+func TestHub_DrainTimeout(t *testing.T) {
+	hub := NewHub()
+	ch, unsub := hub.Subscribe(1, "production")
+	defer unsub()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	hub.Drain(ctx)
+
+	// The subscriber stays until Close.
+	require.Equal(t, map[string]int{"1:production": 1}, hub.SubscriberCounts())
+	hub.Close()
+	_, open := <-ch
+	assert.False(t, open)
+}
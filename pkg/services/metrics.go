@@ -0,0 +1,177 @@
+package services
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const metricsNamespace = "bandeira"
+
+// Metrics holds the Prometheus collectors for the application. All recording
+// methods are safe to call on a nil *Metrics, so components can be used
+// without metrics (e.g. in tests).
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests      *prometheus.CounterVec
+	httpDuration      *prometheus.HistogramVec
+	notifications     *prometheus.CounterVec
+	notifySignals     *prometheus.CounterVec
+	notifyDuration    *prometheus.HistogramVec
+	payloadDuration   *prometheus.HistogramVec
+	cacheRequests     *prometheus.CounterVec
+	tokenAuthFailures *prometheus.CounterVec
+}
+
+// NewMetrics creates the collectors and registers them, along with Go runtime,
+// process, database pool and SSE subscriber collectors.
+func NewMetrics(db *sql.DB, hub *Hub) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by route name, method and status code.",
+		}, []string{"route", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by route name and method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"route", "method"}),
+		notifications: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "hub_notifications_total",
+			Help:      "Hub notifications by scope (environment or project).",
+		}, []string{"scope"}),
+		notifySignals: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "hub_notify_signals_total",
+			Help:      "Subscribers signalled by hub notifications (fan-out), by scope.",
+		}, []string{"scope"}),
+		notifyDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "hub_notify_duration_seconds",
+			Help:      "Time spent fanning out a hub notification, by scope.",
+			Buckets:   []float64{.00001, .0001, .001, .01, .1},
+		}, []string{"scope"}),
+		payloadDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "flag_payload_build_duration_seconds",
+			Help:      "Time spent building the client flag payload, by source (poll or stream).",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+		}, []string{"source"}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "cache_requests_total",
+			Help:      "Cache lookups by result (hit or miss).",
+		}, []string{"result"}),
+		tokenAuthFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "token_auth_failures_total",
+			Help:      "Rejected API token authentications by token type and reason.",
+		}, []string{"token_type", "reason"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, "main"),
+		&subscriberCollector{hub: hub},
+		m.httpRequests,
+		m.httpDuration,
+		m.notifications,
+		m.notifySignals,
+		m.notifyDuration,
+		m.payloadDuration,
+		m.cacheRequests,
+		m.tokenAuthFailures,
+	)
+
+	return m
+}
+
+// Handler returns an HTTP handler serving the metrics in Prometheus text format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveHTTPRequest records a served HTTP request.
+func (m *Metrics) ObserveHTTPRequest(route, method string, status int, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.httpRequests.WithLabelValues(route, method, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(route, method).Observe(d.Seconds())
+}
+
+// ObserveNotify records a hub notification that signalled n subscribers.
+func (m *Metrics) ObserveNotify(scope string, n int, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.notifications.WithLabelValues(scope).Inc()
+	m.notifySignals.WithLabelValues(scope).Add(float64(n))
+	m.notifyDuration.WithLabelValues(scope).Observe(d.Seconds())
+}
+
+// ObserveFlagPayload records the time spent building a client flag payload.
+func (m *Metrics) ObserveFlagPayload(source string, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.payloadDuration.WithLabelValues(source).Observe(d.Seconds())
+}
+
+// ObserveCache records a cache lookup.
+func (m *Metrics) ObserveCache(hit bool) {
+	if m == nil {
+		return
+	}
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	m.cacheRequests.WithLabelValues(result).Inc()
+}
+
+// ObserveTokenAuthFailure records a rejected API token.
+func (m *Metrics) ObserveTokenAuthFailure(tokenType, reason string) {
+	if m == nil {
+		return
+	}
+	if tokenType == "" {
+		tokenType = "any"
+	}
+	m.tokenAuthFailures.WithLabelValues(tokenType, reason).Inc()
+}
+
+// subscriberCollector reports the current SSE subscribers per project and
+// environment at scrape time.
+type subscriberCollector struct {
+	hub *Hub
+}
+
+var subscribersDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(metricsNamespace, "sse", "subscribers"),
+	"Active SSE subscribers by project and environment.",
+	[]string{"project", "environment"}, nil,
+)
+
+func (s *subscriberCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- subscribersDesc
+}
+
+func (s *subscriberCollector) Collect(ch chan<- prometheus.Metric) {
+	for key, n := range s.hub.SubscriberCounts() {
+		project, env, _ := strings.Cut(key, ":")
+		ch <- prometheus.MustNewConstMetric(subscribersDesc, prometheus.GaugeValue, float64(n), project, env)
+	}
+}
@@ -0,0 +1,58 @@
+package services
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+	return string(body)
+}
+
+func TestMetrics_HubAndCache(t *testing.T) {
+	hub := NewHub()
+	m := NewMetrics(c.Database, hub)
+	hub.metrics = m
+
+	_, unsub := hub.Subscribe(7, "production")
+	defer unsub()
+	_, unsub2 := hub.Subscribe(7, "production")
+	defer unsub2()
+	hub.Notify(7, "production")
+	hub.NotifyProject(7)
+
+	cache := NewCacheClient(c.Cache.store)
+	cache.metrics = m
+	require.NoError(t, cache.Set().Key("metrics-test").Data("x").Expiration(time.Minute).Save(context.Background()))
+	_, _ = cache.Get().Key("metrics-test").Fetch(context.Background())
+	_, _ = cache.Get().Key("metrics-test-missing").Fetch(context.Background())
+
+	body := scrape(t, m)
+	assert.Contains(t, body, `bandeira_sse_subscribers{environment="production",project="7"} 2`)
+	assert.Contains(t, body, `bandeira_hub_notifications_total{scope="environment"} 1`)
+	assert.Contains(t, body, `bandeira_hub_notify_signals_total{scope="project"} 2`)
+	assert.Contains(t, body, `bandeira_cache_requests_total{result="hit"} 1`)
+	assert.Contains(t, body, `bandeira_cache_requests_total{result="miss"} 1`)
+	assert.Contains(t, body, `go_sql_open_connections{db_name="main"}`)
+}
+
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	assert.NotPanics(t, func() {
+		m.ObserveCache(true)
+		m.ObserveNotify("project", 1, 0)
+		m.ObserveTokenAuthFailure("admin", "invalid")
+	})
+}
@@ -0,0 +1,551 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/predicate"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/schema"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+// rolloutStrategy is the strategy whose rollout parameter plans ramp up.
+const rolloutStrategy = "gradualRollout"
+
+// maxRolloutSteps caps the number of steps of a plan.
+const maxRolloutSteps = 20
+
+var (
+	// ErrRolloutPlanNotFound is returned for a plan that does not exist or
+	// belongs to another flag.
+	ErrRolloutPlanNotFound = errors.New("rollout: plan not found")
+
+	// ErrRolloutInProgress is returned when starting a plan for a strategy
+	// that already has one running or paused.
+	ErrRolloutInProgress = errors.New("rollout: the strategy already has a plan in progress")
+
+	// errStrategyGone aborts a plan whose strategy was deleted or replaced.
+	errStrategyGone = errors.New("rollout: strategy is gone")
+
+	// errPlanMoved skips a step when the plan was paused, aborted or
+	// stepped since it was read.
+	errPlanMoved = errors.New("rollout: plan changed since it was read")
+)
+
+// RolloutStateError is returned for a pause, resume or abort that the
+// status of the plan does not allow.
+type RolloutStateError struct {
+	Status rolloutplan.Status
+}
+
+func (e *RolloutStateError) Error() string {
+	return fmt.Sprintf("rollout: plan is %s", e.Status)
+}
+
+// RolloutStepInput is a step of a plan as submitted by the dashboard and the
+// admin API. Hold is a duration such as "24h"; it is ignored on the last
+// step.
+type RolloutStepInput struct {
+	Percentage int    `json:"percentage"`
+	Hold       string `json:"hold"`
+}
+
+// RolloutNextAt returns when the next step of a running plan is due, or nil
+// when the plan is not running or has no step left.
+func RolloutNextAt(p *ent.RolloutPlan) *time.Time {
+	if p.Status != rolloutplan.StatusRunning || p.CurrentStep+1 >= len(p.Steps) {
+		return nil
+	}
+	at := p.StepStartedAt.Add(time.Duration(p.Steps[p.CurrentStep].HoldSeconds) * time.Second)
+	return &at
+}
+
+// RolloutService runs rollout plans: it applies the first step when a plan
+// starts, then a background worker moves each running plan to its next step
+// once the hold of the current one has passed. A plan waits while its
+// project or environment is frozen, or its flag or environment is in the
+// trash. Steps go through the FlagService like any other change, so they
+// bump the revision, record a version and notify SDKs. A plan pauses when
+// its rollout is changed by hand and aborts when its strategy is deleted.
+type RolloutService struct {
+	orm   *ent.Client
+	flags *FlagService
+	cfg   config.RolloutConfig
+
+	stop chan struct{}
+	done chan struct{}
+	now  func() time.Time
+}
+
+// NewRolloutService creates a rollout service.
+func NewRolloutService(orm *ent.Client, flags *FlagService, cfg config.RolloutConfig) *RolloutService {
+	r := &RolloutService{orm: orm, flags: flags, cfg: cfg, now: time.Now}
+	flags.Subscribe(r.flagChanged)
+	return r
+}
+
+// Start advances running plans every configured interval until Stop is
+// called. It does nothing when the interval is zero.
+func (r *RolloutService) Start() {
+	if r.cfg.Interval <= 0 || r.stop != nil {
+		return
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-r.stop:
+				return
+			case <-ticker.C:
+				if _, err := r.Advance(context.Background()); err != nil {
+					slog.Error("rollout advance failed", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops the worker and waits for a running pass to finish.
+func (r *RolloutService) Stop() {
+	if r.stop == nil {
+		return
+	}
+	close(r.stop)
+	<-r.done
+	r.stop = nil
+}
+
+// Create starts a plan for a gradualRollout strategy of the flag and applies
+// its first step.
+func (r *RolloutService) Create(ctx context.Context, projectID, flagID, strategyID int, in []RolloutStepInput, actor string) (*ent.RolloutPlan, error) {
+	f, env, fe, err := r.flags.strategyTarget(ctx, projectID, flagID, strategyID)
+	if err != nil {
+		return nil, err
+	}
+	st, err := r.orm.Strategy.Get(ctx, strategyID)
+	if err != nil {
+		return nil, err
+	}
+	if st.Name != rolloutStrategy {
+		return nil, &declarative.ValidationError{Fields: map[string]string{
+			"strategy": "Only gradualRollout strategies can have a rollout plan",
+		}}
+	}
+	steps, fields := parseRolloutSteps(in)
+	if len(fields) > 0 {
+		return nil, &declarative.ValidationError{Fields: fields}
+	}
+
+	open, err := r.orm.RolloutPlan.Query().
+		Where(rolloutplan.StrategyID(strategyID), rolloutplan.StatusIn(rolloutplan.StatusRunning, rolloutplan.StatusPaused)).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if open {
+		return nil, ErrRolloutInProgress
+	}
+
+	var p *ent.RolloutPlan
+	rev, err := withRevision(ctx, r.orm, fe.ID, 0, actor, func(client *ent.Client) error {
+		p, err = client.RolloutPlan.Create().
+			SetFlagEnvironmentID(fe.ID).
+			SetStrategyID(strategyID).
+			SetSteps(steps).
+			SetStatus(statusAfter(steps, 0)).
+			SetStepStartedAt(r.now()).
+			SetCreatedBy(actor).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		st, err = setRollout(ctx, client, strategyID, steps[0].Percentage)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.flags.changed(ctx, StrategyUpdated{EventMeta: r.flags.meta(f, env, rev, actor), Strategy: st})
+	p.Edges.FlagEnvironment = fe
+	return p, nil
+}
+
+// List returns the plans of a flag, newest first, with their flag
+// environments loaded.
+func (r *RolloutService) List(ctx context.Context, projectID, flagID int) ([]*ent.RolloutPlan, error) {
+	exists, err := r.orm.Flag.Query().
+		Where(entflag.ID(flagID), entflag.ProjectID(projectID), entflag.DeletedAtIsNil()).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrFlagNotFound
+	}
+	return r.orm.RolloutPlan.Query().
+		Where(rolloutplan.HasFlagEnvironmentWith(flagenvironment.FlagID(flagID))).
+		WithFlagEnvironment().
+		Order(ent.Desc(rolloutplan.FieldID)).
+		All(ctx)
+}
+
+// Get returns a plan of the flag with its flag environment loaded.
+func (r *RolloutService) Get(ctx context.Context, projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+	p, err := r.orm.RolloutPlan.Query().
+		Where(
+			rolloutplan.ID(planID),
+			rolloutplan.HasFlagEnvironmentWith(
+				flagenvironment.FlagID(flagID),
+				flagenvironment.HasFlagWith(entflag.ProjectID(projectID), entflag.DeletedAtIsNil()),
+			),
+		).
+		WithFlagEnvironment().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrRolloutPlanNotFound
+	}
+	return p, err
+}
+
+// Pause stops a running plan at its current step.
+func (r *RolloutService) Pause(ctx context.Context, projectID, flagID, planID int, actor string) (*ent.RolloutPlan, error) {
+	p, err := r.Get(ctx, projectID, flagID, planID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != rolloutplan.StatusRunning {
+		return nil, &RolloutStateError{Status: p.Status}
+	}
+	return r.pause(ctx, p, "paused by "+actor)
+}
+
+// Resume continues a paused plan. The time spent paused does not count
+// towards the hold of the current step.
+func (r *RolloutService) Resume(ctx context.Context, projectID, flagID, planID int) (*ent.RolloutPlan, error) {
+	p, err := r.Get(ctx, projectID, flagID, planID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != rolloutplan.StatusPaused {
+		return nil, &RolloutStateError{Status: p.Status}
+	}
+
+	started := p.StepStartedAt
+	if p.PausedAt != nil {
+		started = started.Add(r.now().Sub(*p.PausedAt))
+	}
+	return saveKeepingEdges(ctx, p, p.Update().
+		SetStatus(rolloutplan.StatusRunning).
+		SetStepStartedAt(started).
+		ClearPausedAt().
+		ClearStatusReason())
+}
+
+// Abort stops a running or paused plan for good. The rollout stays where
+// the plan left it.
+func (r *RolloutService) Abort(ctx context.Context, projectID, flagID, planID int, actor string) (*ent.RolloutPlan, error) {
+	p, err := r.Get(ctx, projectID, flagID, planID)
+	if err != nil {
+		return nil, err
+	}
+	if p.Status != rolloutplan.StatusRunning && p.Status != rolloutplan.StatusPaused {
+		return nil, &RolloutStateError{Status: p.Status}
+	}
+	return r.abort(ctx, p, "aborted by "+actor)
+}
+
+// Advance applies the next step of every running plan whose hold has
+// passed, and returns the number of steps applied.
+func (r *RolloutService) Advance(ctx context.Context) (int, error) {
+	plans, err := r.orm.RolloutPlan.Query().
+		Where(rolloutplan.StatusEQ(rolloutplan.StatusRunning)).
+		WithFlagEnvironment(func(q *ent.FlagEnvironmentQuery) {
+			q.WithFlag().WithEnvironment()
+		}).
+		Order(ent.Asc(rolloutplan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, p := range plans {
+		next := RolloutNextAt(p)
+		if next == nil || r.now().Before(*next) {
+			continue
+		}
+		fe := p.Edges.FlagEnvironment
+		f, env := fe.Edges.Flag, fe.Edges.Environment
+		if f.DeletedAt != nil || env.DeletedAt != nil {
+			continue
+		}
+		err := declarative.CheckFrozen(ctx, r.orm, f.ProjectID, env.ID)
+		var frozen *declarative.FrozenError
+		if errors.As(err, &frozen) {
+			continue
+		}
+		if err == nil {
+			err = r.step(ctx, p, f, env)
+		}
+		switch {
+		case errors.Is(err, errStrategyGone):
+			_, err = r.abort(ctx, p, "the strategy was removed")
+		case errors.Is(err, errPlanMoved):
+			err = nil
+		case err == nil:
+			applied++
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "rollout step failed", "plan_id", p.ID, "error", err)
+		}
+	}
+	return applied, nil
+}
+
+// step applies the next step of a plan, together with the revision bump, in
+// one transaction. The plan must still be running at the step it was read
+// at, so that a pause or abort made meanwhile wins; errPlanMoved is
+// returned otherwise.
+func (r *RolloutService) step(ctx context.Context, p *ent.RolloutPlan, f *ent.Flag, env *ent.Environment) error {
+	n := p.CurrentStep + 1
+	actor := "rollout:" + strconv.Itoa(p.ID)
+	var st *ent.Strategy
+	rev, err := withRevision(ctx, r.orm, p.FlagEnvironmentID, 0, actor, func(client *ent.Client) error {
+		updated, err := client.RolloutPlan.Update().
+			Where(
+				rolloutplan.ID(p.ID),
+				rolloutplan.StatusEQ(rolloutplan.StatusRunning),
+				rolloutplan.CurrentStep(p.CurrentStep),
+			).
+			SetCurrentStep(n).
+			SetStatus(statusAfter(p.Steps, n)).
+			SetStepStartedAt(r.now()).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			return errPlanMoved
+		}
+		st, err = setRollout(ctx, client, p.StrategyID, p.Steps[n].Percentage)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	r.flags.changed(ctx, StrategyUpdated{EventMeta: r.flags.meta(f, env, rev, actor), Strategy: st})
+	slog.InfoContext(ctx, "rollout step applied",
+		"plan_id", p.ID, "flag", f.Name, "environment", env.Name,
+		"step", n+1, "percentage", p.Steps[n].Percentage)
+	return nil
+}
+
+// flagChanged keeps plans in line with changes made by hand: a plan pauses
+// when its rollout is set to something else, and aborts when its strategy
+// is deleted or replaced.
+func (r *RolloutService) flagChanged(ctx context.Context, ev FlagEvent) {
+	var err error
+	switch ev := ev.(type) {
+	case StrategyUpdated:
+		err = r.strategyUpdated(ctx, ev)
+	case StrategyDeleted:
+		err = r.abortOpen(ctx, []int{ev.StrategyID}, "the strategy was deleted by "+ev.Actor)
+	case FlagEnvChanged:
+		if ev.StrategiesReplaced {
+			err = r.abortReplaced(ctx, ev.FlagID, ev.EnvironmentID, ev.Actor)
+		}
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to update rollout plans", "flag_id", ev.Meta().FlagID, "error", err)
+	}
+}
+
+func (r *RolloutService) strategyUpdated(ctx context.Context, ev StrategyUpdated) error {
+	plans, err := r.openPlans(ctx, rolloutplan.StrategyID(ev.Strategy.ID))
+	if err != nil {
+		return err
+	}
+	for _, p := range plans {
+		if ev.Strategy.Name != rolloutStrategy {
+			_, err = r.abort(ctx, p, "the strategy was changed by "+ev.Actor)
+		} else if pct, ok := rolloutPercentage(ev.Strategy.Parameters); p.Status == rolloutplan.StatusRunning && (!ok || pct != float64(p.Steps[p.CurrentStep].Percentage)) {
+			// Steps of the plan set its own percentage; anything else is
+			// someone taking over by hand.
+			_, err = r.pause(ctx, p, "the rollout was changed by "+ev.Actor)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// abortReplaced aborts the open plans of a config whose strategies were
+// replaced, since the strategies they ramp up no longer exist.
+func (r *RolloutService) abortReplaced(ctx context.Context, flagID, envID int, actor string) error {
+	plans, err := r.openPlans(ctx, rolloutplan.HasFlagEnvironmentWith(
+		flagenvironment.FlagID(flagID),
+		flagenvironment.EnvironmentID(envID),
+	))
+	if err != nil {
+		return err
+	}
+	for _, p := range plans {
+		exists, err := r.orm.Strategy.Query().Where(strategy.ID(p.StrategyID)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			if _, err := r.abort(ctx, p, "the strategies were replaced by "+actor); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (r *RolloutService) abortOpen(ctx context.Context, strategyIDs []int, reason string) error {
+	plans, err := r.openPlans(ctx, rolloutplan.StrategyIDIn(strategyIDs...))
+	if err != nil {
+		return err
+	}
+	for _, p := range plans {
+		if _, err := r.abort(ctx, p, reason); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// openPlans returns the running and paused plans matching where.
+func (r *RolloutService) openPlans(ctx context.Context, where ...predicate.RolloutPlan) ([]*ent.RolloutPlan, error) {
+	return r.orm.RolloutPlan.Query().
+		Where(where...).
+		Where(rolloutplan.StatusIn(rolloutplan.StatusRunning, rolloutplan.StatusPaused)).
+		All(ctx)
+}
+
+func (r *RolloutService) pause(ctx context.Context, p *ent.RolloutPlan, reason string) (*ent.RolloutPlan, error) {
+	slog.InfoContext(ctx, "rollout plan paused", "plan_id", p.ID, "reason", reason)
+	return saveKeepingEdges(ctx, p, p.Update().
+		SetStatus(rolloutplan.StatusPaused).
+		SetPausedAt(r.now()).
+		SetStatusReason(reason))
+}
+
+func (r *RolloutService) abort(ctx context.Context, p *ent.RolloutPlan, reason string) (*ent.RolloutPlan, error) {
+	slog.InfoContext(ctx, "rollout plan aborted", "plan_id", p.ID, "reason", reason)
+	return saveKeepingEdges(ctx, p, p.Update().
+		SetStatus(rolloutplan.StatusAborted).
+		ClearPausedAt().
+		SetStatusReason(reason))
+}
+
+// saveKeepingEdges saves an update of a plan and keeps the edges loaded on
+// it.
+func saveKeepingEdges(ctx context.Context, p *ent.RolloutPlan, u *ent.RolloutPlanUpdateOne) (*ent.RolloutPlan, error) {
+	saved, err := u.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	saved.Edges = p.Edges
+	return saved, nil
+}
+
+// setRollout sets the rollout parameter of a gradualRollout strategy.
+func setRollout(ctx context.Context, client *ent.Client, strategyID, percentage int) (*ent.Strategy, error) {
+	st, err := client.Strategy.Get(ctx, strategyID)
+	if ent.IsNotFound(err) {
+		return nil, errStrategyGone
+	}
+	if err != nil {
+		return nil, err
+	}
+	if st.Name != rolloutStrategy {
+		return nil, errStrategyGone
+	}
+	params := maps.Clone(st.Parameters)
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	params["rollout"] = percentage
+	return st.Update().SetParameters(params).Save(ctx)
+}
+
+// parseRolloutSteps converts submitted steps, returning errors keyed by
+// field path. Percentages must go up from step to step.
+func parseRolloutSteps(in []RolloutStepInput) ([]schema.RolloutStep, map[string]string) {
+	fields := map[string]string{}
+	switch {
+	case len(in) == 0:
+		fields["steps"] = "Add at least one step"
+		return nil, fields
+	case len(in) > maxRolloutSteps:
+		fields["steps"] = fmt.Sprintf("A plan can have at most %d steps", maxRolloutSteps)
+		return nil, fields
+	}
+
+	steps := make([]schema.RolloutStep, 0, len(in))
+	for i, s := range in {
+		prefix := fmt.Sprintf("steps[%d].", i)
+		switch {
+		case s.Percentage < 0 || s.Percentage > 100:
+			fields[prefix+"percentage"] = "Must be between 0 and 100"
+		case i > 0 && s.Percentage <= in[i-1].Percentage:
+			fields[prefix+"percentage"] = "Must be higher than the step before"
+		}
+
+		var hold time.Duration
+		if h := strings.TrimSpace(s.Hold); h != "" {
+			var err error
+			hold, err = time.ParseDuration(h)
+			if err != nil || hold < 0 {
+				fields[prefix+"hold"] = `Must be a duration such as "30m" or "24h"`
+			}
+		}
+		steps = append(steps, schema.RolloutStep{Percentage: s.Percentage, HoldSeconds: int(hold / time.Second)})
+	}
+	return steps, fields
+}
+
+// statusAfter is the status of a plan once step n is applied.
+func statusAfter(steps []schema.RolloutStep, n int) rolloutplan.Status {
+	if n >= len(steps)-1 {
+		return rolloutplan.StatusCompleted
+	}
+	return rolloutplan.StatusRunning
+}
+
+// rolloutPercentage reads the rollout parameter, which may be stored as a
+// number or a string.
+func rolloutPercentage(params map[string]interface{}) (float64, bool) {
+	switch v := params["rollout"].(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return f, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,227 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/pkg/declarative"
+)
+
+func TestRolloutService(t *testing.T) {
+	bg := context.Background()
+	now := time.Now()
+	flags := NewFlagService(c.ORM, c.Hub)
+	rollouts := NewRolloutService(c.ORM, flags, config.RolloutConfig{})
+	rollouts.now = func() time.Time { return now }
+
+	p := c.ORM.Project.Create().SetName("rollout-test").SaveX(bg)
+	env := c.ORM.Environment.Create().SetName("prod").SetType("production").SetProjectID(p.ID).SaveX(bg)
+	f := c.ORM.Flag.Create().SetName("checkout").SetFlagType("release").SetProjectID(p.ID).SaveX(bg)
+	ref := FlagEnvRef{ProjectID: p.ID, FlagID: f.ID, EnvironmentID: env.ID}
+
+	st, _, err := flags.CreateStrategy(bg, ref, StrategyInput{
+		Name:       "gradualRollout",
+		Parameters: map[string]interface{}{"rollout": 0, "stickiness": "userId"},
+	}, 0, "")
+	require.NoError(t, err)
+
+	rollout := func() float64 {
+		pct, _ := rolloutPercentage(c.ORM.Strategy.GetX(bg, st.ID).Parameters)
+		return pct
+	}
+	steps := []RolloutStepInput{{Percentage: 1, Hold: "1h"}, {Percentage: 25, Hold: "24h"}, {Percentage: 100}}
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := rollouts.Create(bg, p.ID, f.ID, st.ID, []RolloutStepInput{{Percentage: 50, Hold: "1h"}, {Percentage: 10, Hold: "soon"}}, "")
+		var verr *declarative.ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Contains(t, verr.Fields, "steps[1].percentage")
+		assert.Contains(t, verr.Fields, "steps[1].hold")
+
+		other, _, err := flags.CreateStrategy(bg, ref, StrategyInput{Name: "default"}, 0, "")
+		require.NoError(t, err)
+		_, err = rollouts.Create(bg, p.ID, f.ID, other.ID, steps, "")
+		require.ErrorAs(t, err, &verr)
+		assert.Contains(t, verr.Fields, "strategy")
+	})
+
+	var plan int
+	t.Run("steps", func(t *testing.T) {
+		pl, err := rollouts.Create(bg, p.ID, f.ID, st.ID, steps, "ana@example.com")
+		require.NoError(t, err)
+		plan = pl.ID
+		assert.Equal(t, rolloutplan.StatusRunning, pl.Status)
+		assert.Equal(t, 1.0, rollout())
+
+		_, err = rollouts.Create(bg, p.ID, f.ID, st.ID, steps, "")
+		assert.ErrorIs(t, err, ErrRolloutInProgress)
+
+		// Nothing is due before the hold has passed.
+		n, err := rollouts.Advance(bg)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+
+		now = now.Add(time.Hour)
+		n, err = rollouts.Advance(bg)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 25.0, rollout())
+
+		versions, err := declarative.Versions(bg, c.ORM, p.ID, f.ID, env.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "rollout:"+strconv.Itoa(plan), versions[0].Actor)
+	})
+
+	t.Run("pause and resume", func(t *testing.T) {
+		_, err := rollouts.Pause(bg, p.ID, f.ID, plan, "ana@example.com")
+		require.NoError(t, err)
+		_, err = rollouts.Pause(bg, p.ID, f.ID, plan, "")
+		var serr *RolloutStateError
+		assert.ErrorAs(t, err, &serr)
+
+		// Time spent paused does not count towards the hold.
+		now = now.Add(48 * time.Hour)
+		_, err = rollouts.Resume(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		n, err := rollouts.Advance(bg)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+
+		// A freeze holds the plan at its step.
+		now = now.Add(24 * time.Hour)
+		require.NoError(t, declarative.FreezeEnvironment(bg, c.ORM, env.ID, "", "incident"))
+		n, err = rollouts.Advance(bg)
+		require.NoError(t, err)
+		assert.Zero(t, n)
+		require.NoError(t, declarative.UnfreezeEnvironment(bg, c.ORM, env.ID))
+
+		n, err = rollouts.Advance(bg)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, 100.0, rollout())
+		pl, err := rollouts.Get(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusCompleted, pl.Status)
+	})
+
+	t.Run("changes by hand", func(t *testing.T) {
+		pl, err := rollouts.Create(bg, p.ID, f.ID, st.ID, []RolloutStepInput{{Percentage: 10, Hold: "1h"}, {Percentage: 50}}, "")
+		require.NoError(t, err)
+
+		_, _, err = flags.UpdateStrategy(bg, p.ID, f.ID, st.ID, StrategyInput{
+			Name:       "gradualRollout",
+			Parameters: map[string]interface{}{"rollout": 5, "stickiness": "userId"},
+		}, 0, "bob@example.com")
+		require.NoError(t, err)
+		pl, err = rollouts.Get(bg, p.ID, f.ID, pl.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusPaused, pl.Status)
+		assert.Equal(t, "the rollout was changed by bob@example.com", pl.StatusReason)
+
+		_, err = flags.DeleteStrategy(bg, p.ID, f.ID, st.ID, 0, "bob@example.com")
+		require.NoError(t, err)
+		pl, err = rollouts.Get(bg, p.ID, f.ID, pl.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusAborted, pl.Status)
+
+		plans, err := rollouts.List(bg, p.ID, f.ID)
+		require.NoError(t, err)
+		assert.Len(t, plans, 2)
+	})
+
+	t.Run("pause while stepping", func(t *testing.T) {
+		st, _, err := flags.CreateStrategy(bg, ref, StrategyInput{
+			Name:       "gradualRollout",
+			Parameters: map[string]interface{}{"rollout": 0, "stickiness": "userId"},
+		}, 0, "")
+		require.NoError(t, err)
+		pl, err := rollouts.Create(bg, p.ID, f.ID, st.ID, []RolloutStepInput{{Percentage: 10, Hold: "1h"}, {Percentage: 50}}, "")
+		require.NoError(t, err)
+
+		// The worker read the plan before it was paused.
+		stale, err := rollouts.Get(bg, p.ID, f.ID, pl.ID)
+		require.NoError(t, err)
+		_, err = rollouts.Pause(bg, p.ID, f.ID, pl.ID, "ana@example.com")
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, rollouts.step(bg, stale, f, env), errPlanMoved)
+		pl, err = rollouts.Get(bg, p.ID, f.ID, pl.ID)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusPaused, pl.Status)
+		assert.Zero(t, pl.CurrentStep)
+		pct, _ := rolloutPercentage(c.ORM.Strategy.GetX(bg, st.ID).Parameters)
+		assert.Equal(t, 10.0, pct)
+	})
+
+	// rolloutPlan starts a plan on a new rollout strategy in prod.
+	rolloutPlan := func(t *testing.T) int {
+		st, _, err := flags.CreateStrategy(bg, ref, StrategyInput{
+			Name:       "gradualRollout",
+			Parameters: map[string]interface{}{"rollout": 0, "stickiness": "userId"},
+		}, 0, "")
+		require.NoError(t, err)
+		pl, err := rollouts.Create(bg, p.ID, f.ID, st.ID, []RolloutStepInput{{Percentage: 10, Hold: "1h"}, {Percentage: 50}}, "")
+		require.NoError(t, err)
+		return pl.ID
+	}
+
+	t.Run("promote", func(t *testing.T) {
+		c.ORM.Environment.Create().SetName("staging").SetType("development").SetProjectID(p.ID).SaveX(bg)
+		plan := rolloutPlan(t)
+
+		// Promoting only the enabled state keeps the strategies.
+		_, err := flags.Promote(bg, p.ID, declarative.PromoteOptions{Source: "staging", Target: "prod", Enabled: true, Actor: "bob@example.com"})
+		require.NoError(t, err)
+		pl, err := rollouts.Get(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusRunning, pl.Status)
+
+		_, err = flags.Promote(bg, p.ID, declarative.PromoteOptions{Source: "staging", Target: "prod", Strategies: true, Actor: "bob@example.com"})
+		require.NoError(t, err)
+		pl, err = rollouts.Get(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusAborted, pl.Status)
+		assert.Equal(t, "the strategies were replaced by bob@example.com", pl.StatusReason)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		plan := rolloutPlan(t)
+
+		doc, err := declarative.Export(bg, c.ORM, p.ID)
+		require.NoError(t, err)
+		for i := range doc.Flags[0].Environments {
+			if doc.Flags[0].Environments[i].Environment == "prod" {
+				doc.Flags[0].Environments[i].Strategies = []declarative.Strategy{{Name: "default"}}
+			}
+		}
+		_, err = flags.Apply(bg, p.ID, doc, declarative.Options{Actor: "token:ci"})
+		require.NoError(t, err)
+
+		pl, err := rollouts.Get(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusAborted, pl.Status)
+		assert.Equal(t, "the strategies were replaced by token:ci", pl.StatusReason)
+	})
+
+	t.Run("restore version", func(t *testing.T) {
+		versions, err := declarative.Versions(bg, c.ORM, p.ID, f.ID, env.ID)
+		require.NoError(t, err)
+		before := versions[0].Version
+		plan := rolloutPlan(t)
+
+		_, err = flags.RestoreVersion(bg, ref, before, 0, "ana@example.com")
+		require.NoError(t, err)
+
+		pl, err := rollouts.Get(bg, p.ID, f.ID, plan)
+		require.NoError(t, err)
+		assert.Equal(t, rolloutplan.StatusAborted, pl.Status)
+		assert.Equal(t, "the strategies were replaced by ana@example.com", pl.StatusReason)
+	})
+}
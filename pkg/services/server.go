@@ -0,0 +1,38 @@
+package services
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// StartServer serves the web framework using the HTTP configuration and blocks
+// until the server stops. It returns nil when the server was shut down
+// gracefully through Shutdown.
+func (c *Container) StartServer() error {
+	srv := http.Server{
+		Addr:        fmt.Sprintf("%s:%d", c.Config.HTTP.Hostname, c.Config.HTTP.Port),
+		Handler:     c.Web,
+		ReadTimeout: c.Config.HTTP.ReadTimeout,
+		// WriteTimeout is 0 (disabled) to support SSE streaming.
+		// Per-request timeouts are enforced by the Echo timeout middleware.
+		IdleTimeout: c.Config.HTTP.IdleTimeout,
+	}
+
+	if c.Config.HTTP.TLS.Enabled {
+		certs, err := tls.LoadX509KeyPair(c.Config.HTTP.TLS.Certificate, c.Config.HTTP.TLS.Key)
+		if err != nil {
+			return fmt.Errorf("cannot load TLS certificate: %w", err)
+		}
+
+		srv.TLSConfig = &tls.Config{
+			Certificates: []tls.Certificate{certs},
+		}
+	}
+
+	if err := c.Web.StartServer(&srv); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/felipekafuri/bandeira/config"
+)
+
+// TracerName is the instrumentation name used for Bandeira's own spans.
+const TracerName = "github.com/felipekafuri/bandeira"
+
+// Tracing holds the OpenTelemetry tracer provider. When tracing is disabled the
+// provider is a no-op, but incoming W3C trace context is still propagated so
+// that trace IDs show up in logs.
+type Tracing struct {
+	// Provider creates tracers for instrumented components.
+	Provider trace.TracerProvider
+
+	// sdk is set when tracing is enabled, to flush spans on shutdown.
+	sdk *sdktrace.TracerProvider
+}
+
+// NewTracing configures OpenTelemetry from the tracing configuration and
+// installs the provider and the W3C trace context propagator globally.
+func NewTracing(cfg config.TracingConfig, serviceName string) (*Tracing, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		t := &Tracing{Provider: noop.NewTracerProvider()}
+		otel.SetTracerProvider(t.Provider)
+		return t, nil
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), otlpOptions(cfg.Endpoint)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ServiceName != "" {
+		serviceName = cfg.ServiceName
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	// Honour the caller's sampling decision; sample new traces by ratio.
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	otel.SetTracerProvider(provider)
+
+	return &Tracing{Provider: provider, sdk: provider}, nil
+}
+
+// Shutdown flushes pending spans.
+func (t *Tracing) Shutdown(ctx context.Context) error {
+	if t.sdk == nil {
+		return nil
+	}
+	return t.sdk.Shutdown(ctx)
+}
+
+// otlpOptions maps an endpoint such as "http://collector:4318" to exporter
+// options. An empty endpoint leaves the exporter defaults, which also honour
+// the standard OTEL_EXPORTER_OTLP_* environment variables.
+func otlpOptions(endpoint string) []otlptracehttp.Option {
+	if endpoint == "" {
+		return nil
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return []otlptracehttp.Option{otlptracehttp.WithEndpoint(endpoint)}
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(u.Host)}
+	if u.Scheme == "http" {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+	if p := strings.TrimRight(u.Path, "/"); p != "" {
+		opts = append(opts, otlptracehttp.WithURLPath(p))
+	}
+	return opts
+}
+
+// tracingDriver wraps an Ent driver to record a client span per SQL statement.
+type tracingDriver struct {
+	dialect.Driver
+	tracer trace.Tracer
+}
+
+// newTracingDriver wraps drv so that every query runs inside a span.
+func newTracingDriver(drv dialect.Driver, tp trace.TracerProvider) dialect.Driver {
+	return &tracingDriver{Driver: drv, tracer: tp.Tracer(TracerName)}
+}
+
+func (d *tracingDriver) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, d.tracer, query, func(ctx context.Context) error {
+		return d.Driver.Exec(ctx, query, args, v)
+	})
+}
+
+func (d *tracingDriver) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, d.tracer, query, func(ctx context.Context) error {
+		return d.Driver.Query(ctx, query, args, v)
+	})
+}
+
+func (d *tracingDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, ctx: ctx, tracer: d.tracer}, nil
+}
+
+// BeginTx is used by ent.Client.BeginTx.
+func (d *tracingDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	tx, err := d.Driver.(interface {
+		BeginTx(context.Context, *sql.TxOptions) (dialect.Tx, error)
+	}).BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &tracingTx{Tx: tx, ctx: ctx, tracer: d.tracer}, nil
+}
+
+// tracingTx traces statements within a transaction and its commit or rollback.
+type tracingTx struct {
+	dialect.Tx
+	ctx    context.Context
+	tracer trace.Tracer
+}
+
+func (t *tracingTx) Exec(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, t.tracer, query, func(ctx context.Context) error {
+		return t.Tx.Exec(ctx, query, args, v)
+	})
+}
+
+func (t *tracingTx) Query(ctx context.Context, query string, args, v any) error {
+	return traceQuery(ctx, t.tracer, query, func(ctx context.Context) error {
+		return t.Tx.Query(ctx, query, args, v)
+	})
+}
+
+func (t *tracingTx) Commit() error {
+	return traceQuery(t.ctx, t.tracer, "COMMIT", func(context.Context) error {
+		return t.Tx.Commit()
+	})
+}
+
+func (t *tracingTx) Rollback() error {
+	return traceQuery(t.ctx, t.tracer, "ROLLBACK", func(context.Context) error {
+		return t.Tx.Rollback()
+	})
+}
+
+// traceQuery runs fn inside a client span named after the SQL operation.
+func traceQuery(ctx context.Context, tracer trace.Tracer, query string, fn func(context.Context) error) error {
+	op, _, _ := strings.Cut(strings.TrimSpace(query), " ")
+	ctx, span := tracer.Start(ctx, strings.ToUpper(op),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNameSQLite,
+			semconv.DBOperationName(strings.ToUpper(op)),
+			semconv.DBQueryText(query),
+		),
+	)
+	defer span.End()
+
+	err := fn(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
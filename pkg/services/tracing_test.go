@@ -0,0 +1,60 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent"
+)
+
+func TestTracingDriver(t *testing.T) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	orm := ent.NewClient(ent.Driver(newTracingDriver(entsql.OpenDB(c.Config.Database.Driver, c.Database), tp)))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+	_, err := orm.Project.Query().Count(ctx)
+	require.NoError(t, err)
+
+	tx, err := orm.Tx(ctx)
+	require.NoError(t, err)
+	_, err = tx.Project.Query().All(ctx)
+	require.NoError(t, err)
+	require.NoError(t, tx.Rollback())
+	parent.End()
+
+	var names []string
+	for _, s := range rec.Ended() {
+		if s.Name() == "parent" {
+			continue
+		}
+		names = append(names, s.Name())
+		assert.Equal(t, trace.SpanKindClient, s.SpanKind())
+		assert.Equal(t, parent.SpanContext().TraceID(), s.SpanContext().TraceID())
+	}
+	assert.Equal(t, []string{"SELECT", "SELECT", "ROLLBACK"}, names)
+}
+
+func TestNewTracing_Disabled(t *testing.T) {
+	tr, err := NewTracing(config.TracingConfig{}, "bandeira")
+	require.NoError(t, err)
+
+	_, span := tr.Provider.Tracer(TracerName).Start(context.Background(), "noop")
+	assert.False(t, span.SpanContext().IsValid())
+	assert.NoError(t, tr.Shutdown(context.Background()))
+}
+
+func TestOTLPOptions(t *testing.T) {
+	assert.Empty(t, otlpOptions(""))
+	assert.Len(t, otlpOptions("http://collector:4318"), 2)
+	assert.Len(t, otlpOptions("https://collector.example.com/otlp/v1/traces"), 2)
+	assert.Len(t, otlpOptions("collector:4318"), 1)
+}
@@ -0,0 +1,505 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/apitoken"
+	entconstraint "github.com/felipekafuri/bandeira/ent/constraint"
+	"github.com/felipekafuri/bandeira/ent/contextfield"
+	"github.com/felipekafuri/bandeira/ent/environment"
+	entflag "github.com/felipekafuri/bandeira/ent/flag"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+	"github.com/felipekafuri/bandeira/ent/flagenvironmentversion"
+	"github.com/felipekafuri/bandeira/ent/prerequisite"
+	"github.com/felipekafuri/bandeira/ent/project"
+	"github.com/felipekafuri/bandeira/ent/rolloutplan"
+	"github.com/felipekafuri/bandeira/ent/strategy"
+	"github.com/felipekafuri/bandeira/ent/strategydefinition"
+	"github.com/felipekafuri/bandeira/ent/tag"
+)
+
+// Kinds of items that can be trashed.
+const (
+	TrashProject     = "project"
+	TrashFlag        = "flag"
+	TrashEnvironment = "environment"
+)
+
+var (
+	// ErrTrashExpired is returned when restoring an item whose retention
+	// window has passed.
+	ErrTrashExpired = errors.New("trash: retention window has passed")
+
+	// ErrTrashKind is returned for an unknown kind of item.
+	ErrTrashKind = errors.New("trash: unknown kind")
+)
+
+// RestoreConflictError is returned when an item cannot be restored before
+// something it depends on, such as its project.
+type RestoreConflictError struct {
+	Reason string
+}
+
+func (e *RestoreConflictError) Error() string {
+	return "trash: " + e.Reason
+}
+
+// TrashItem describes a project, flag or environment in the trash.
+type TrashItem struct {
+	Kind        string    `json:"kind"`
+	ID          int       `json:"id"`
+	Name        string    `json:"name"`
+	ProjectID   int       `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	DeletedAt   time.Time `json:"deleted_at"`
+	// PurgeAt is when the item is purged; nil when kept until purged by hand.
+	PurgeAt *time.Time `json:"purge_at,omitempty"`
+}
+
+// TrashService soft-deletes projects, flags and environments, restores them
+// within the retention window, and purges them (with everything below them)
+// once it has passed.
+type TrashService struct {
+	orm *ent.Client
+	cfg config.TrashConfig
+
+	stop chan struct{}
+	done chan struct{}
+	now  func() time.Time
+}
+
+// NewTrashService creates a trash service.
+func NewTrashService(orm *ent.Client, cfg config.TrashConfig) *TrashService {
+	return &TrashService{orm: orm, cfg: cfg, now: time.Now}
+}
+
+// Start purges expired items every configured interval until Stop is called.
+// It does nothing when items are kept until purged by hand.
+func (t *TrashService) Start() {
+	if t.cfg.Retention <= 0 || t.cfg.PurgeInterval <= 0 || t.stop != nil {
+		return
+	}
+	t.stop = make(chan struct{})
+	t.done = make(chan struct{})
+
+	go func() {
+		defer close(t.done)
+		ticker := time.NewTicker(t.cfg.PurgeInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-t.stop:
+				return
+			case <-ticker.C:
+				n, err := t.Purge(context.Background())
+				if err != nil {
+					slog.Error("trash purge failed", "error", err)
+					continue
+				}
+				if n > 0 {
+					slog.Info("trash purged", "items", n)
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops the schedule and waits for a running purge to finish.
+func (t *TrashService) Stop() {
+	if t.stop == nil {
+		return
+	}
+	close(t.stop)
+	<-t.done
+	t.stop = nil
+}
+
+// purgeAt returns when an item deleted at deletedAt expires, or nil.
+func (t *TrashService) purgeAt(deletedAt time.Time) *time.Time {
+	if t.cfg.Retention <= 0 {
+		return nil
+	}
+	at := deletedAt.Add(t.cfg.Retention)
+	return &at
+}
+
+func (t *TrashService) expired(deletedAt *time.Time) bool {
+	at := t.purgeAt(*deletedAt)
+	return at != nil && !t.now().Before(*at)
+}
+
+// Trash moves an item to the trash. Trashing an item already in the trash
+// is reported as not found.
+func (t *TrashService) Trash(ctx context.Context, kind string, id int) error {
+	return t.TrashIn(ctx, t.orm, kind, id)
+}
+
+// TrashIn is Trash on another client, typically that of a transaction
+// trashing several items together.
+func (t *TrashService) TrashIn(ctx context.Context, client *ent.Client, kind string, id int) error {
+	now := t.now()
+	var n int
+	var err error
+	switch kind {
+	case TrashProject:
+		n, err = client.Project.Update().
+			Where(project.ID(id), project.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			Save(ctx)
+	case TrashFlag:
+		n, err = client.Flag.Update().
+			Where(entflag.ID(id), entflag.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			Save(ctx)
+	case TrashEnvironment:
+		n, err = client.Environment.Update().
+			Where(environment.ID(id), environment.DeletedAtIsNil()).
+			SetDeletedAt(now).
+			Save(ctx)
+	default:
+		return ErrTrashKind
+	}
+	if err == nil && n == 0 {
+		return &ent.NotFoundError{}
+	}
+	return err
+}
+
+// Restore takes an item out of the trash. Flags and environments of a
+// project in the trash, and flags requiring a flag in the trash, are
+// refused with a RestoreConflictError.
+func (t *TrashService) Restore(ctx context.Context, kind string, id int) error {
+	switch kind {
+	case TrashProject:
+		p, err := t.orm.Project.Query().
+			Where(project.ID(id), project.DeletedAtNotNil()).
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if t.expired(p.DeletedAt) {
+			return ErrTrashExpired
+		}
+		return p.Update().ClearDeletedAt().Exec(ctx)
+
+	case TrashFlag:
+		f, err := t.orm.Flag.Query().
+			Where(entflag.ID(id), entflag.DeletedAtNotNil()).
+			WithProject().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if t.expired(f.DeletedAt) {
+			return ErrTrashExpired
+		}
+		if f.Edges.Project.DeletedAt != nil {
+			return &RestoreConflictError{Reason: fmt.Sprintf("restore project %q first", f.Edges.Project.Name)}
+		}
+		parent, err := t.orm.Flag.Query().
+			Where(
+				entflag.DeletedAtNotNil(),
+				entflag.HasDependentsWith(prerequisite.HasFlagEnvironmentWith(flagenvironment.FlagID(id))),
+			).
+			First(ctx)
+		switch {
+		case err == nil:
+			return &RestoreConflictError{Reason: fmt.Sprintf("restore prerequisite %q first", parent.Name)}
+		case !ent.IsNotFound(err):
+			return err
+		}
+		return f.Update().ClearDeletedAt().Exec(ctx)
+
+	case TrashEnvironment:
+		e, err := t.orm.Environment.Query().
+			Where(environment.ID(id), environment.DeletedAtNotNil()).
+			WithProject().
+			Only(ctx)
+		if err != nil {
+			return err
+		}
+		if t.expired(e.DeletedAt) {
+			return ErrTrashExpired
+		}
+		if e.Edges.Project.DeletedAt != nil {
+			return &RestoreConflictError{Reason: fmt.Sprintf("restore project %q first", e.Edges.Project.Name)}
+		}
+		return e.Update().ClearDeletedAt().Exec(ctx)
+	}
+	return ErrTrashKind
+}
+
+// List returns the items in the trash, most recently deleted first. With a
+// project ID it lists that project's flags and environments; with 0 it lists
+// every project, flag and environment in the trash.
+func (t *TrashService) List(ctx context.Context, projectID int) ([]TrashItem, error) {
+	items := []TrashItem{}
+
+	if projectID == 0 {
+		projects, err := t.orm.Project.Query().
+			Where(project.DeletedAtNotNil()).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range projects {
+			items = append(items, TrashItem{
+				Kind:        TrashProject,
+				ID:          p.ID,
+				Name:        p.Name,
+				ProjectID:   p.ID,
+				ProjectName: p.Name,
+				DeletedAt:   *p.DeletedAt,
+				PurgeAt:     t.purgeAt(*p.DeletedAt),
+			})
+		}
+	}
+
+	flagQuery := t.orm.Flag.Query().Where(entflag.DeletedAtNotNil()).WithProject()
+	envQuery := t.orm.Environment.Query().Where(environment.DeletedAtNotNil()).WithProject()
+	if projectID != 0 {
+		flagQuery.Where(entflag.ProjectID(projectID))
+		envQuery.Where(environment.ProjectID(projectID))
+	}
+
+	flags, err := flagQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range flags {
+		items = append(items, TrashItem{
+			Kind:        TrashFlag,
+			ID:          f.ID,
+			Name:        f.Name,
+			ProjectID:   f.ProjectID,
+			ProjectName: f.Edges.Project.Name,
+			DeletedAt:   *f.DeletedAt,
+			PurgeAt:     t.purgeAt(*f.DeletedAt),
+		})
+	}
+
+	envs, err := envQuery.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range envs {
+		items = append(items, TrashItem{
+			Kind:        TrashEnvironment,
+			ID:          e.ID,
+			Name:        e.Name,
+			ProjectID:   e.ProjectID,
+			ProjectName: e.Edges.Project.Name,
+			DeletedAt:   *e.DeletedAt,
+			PurgeAt:     t.purgeAt(*e.DeletedAt),
+		})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].DeletedAt.After(items[j].DeletedAt)
+	})
+	return items, nil
+}
+
+// Purge permanently deletes every item whose retention window has passed and
+// returns how many were purged.
+func (t *TrashService) Purge(ctx context.Context) (int, error) {
+	if t.cfg.Retention <= 0 {
+		return 0, nil
+	}
+	cutoff := t.now().Add(-t.cfg.Retention)
+
+	// Projects go first, taking their trashed flags and environments along.
+	projectIDs, err := t.orm.Project.Query().
+		Where(project.DeletedAtLTE(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range projectIDs {
+		if err := t.PurgeItem(ctx, TrashProject, id); err != nil {
+			return 0, err
+		}
+	}
+
+	flagIDs, err := t.orm.Flag.Query().
+		Where(entflag.DeletedAtLTE(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range flagIDs {
+		if err := t.PurgeItem(ctx, TrashFlag, id); err != nil {
+			return 0, err
+		}
+	}
+
+	envIDs, err := t.orm.Environment.Query().
+		Where(environment.DeletedAtLTE(cutoff)).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+	for _, id := range envIDs {
+		if err := t.PurgeItem(ctx, TrashEnvironment, id); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(projectIDs) + len(flagIDs) + len(envIDs), nil
+}
+
+// PurgeItem permanently deletes an item in the trash with everything below
+// it, inside a single transaction. Items not in the trash are not found.
+func (t *TrashService) PurgeItem(ctx context.Context, kind string, id int) error {
+	tx, err := t.orm.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	orm := tx.Client()
+
+	switch kind {
+	case TrashProject:
+		err = purgeProject(ctx, orm, id)
+	case TrashFlag:
+		err = purgeFlag(ctx, orm, id)
+	case TrashEnvironment:
+		err = purgeEnvironment(ctx, orm, id)
+	default:
+		err = ErrTrashKind
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func purgeProject(ctx context.Context, orm *ent.Client, id int) error {
+	p, err := orm.Project.Query().
+		Where(project.ID(id), project.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	feIDs, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.HasFlagWith(entflag.ProjectID(p.ID))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deleteFlagEnvironments(ctx, orm, feIDs); err != nil {
+		return err
+	}
+	if _, err := orm.Flag.Delete().Where(entflag.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if err := orm.Environment.Update().Where(environment.ProjectID(p.ID)).ClearExpectedMatchID().Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.Environment.Delete().Where(environment.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.ApiToken.Delete().Where(apitoken.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.Tag.Delete().Where(tag.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.StrategyDefinition.Delete().Where(strategydefinition.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.ContextField.Delete().Where(contextfield.ProjectID(p.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	return orm.Project.DeleteOneID(p.ID).Exec(ctx)
+}
+
+func purgeFlag(ctx context.Context, orm *ent.Client, id int) error {
+	f, err := orm.Flag.Query().
+		Where(entflag.ID(id), entflag.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	feIDs, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.FlagID(f.ID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deleteFlagEnvironments(ctx, orm, feIDs); err != nil {
+		return err
+	}
+	// Only flags in the trash (or configs of trashed environments) can still
+	// require it.
+	if _, err := orm.Prerequisite.Delete().Where(prerequisite.ParentFlagID(f.ID)).Exec(ctx); err != nil {
+		return err
+	}
+	return orm.Flag.DeleteOneID(f.ID).Exec(ctx)
+}
+
+func purgeEnvironment(ctx context.Context, orm *ent.Client, id int) error {
+	e, err := orm.Environment.Query().
+		Where(environment.ID(id), environment.DeletedAtNotNil()).
+		Only(ctx)
+	if err != nil {
+		return err
+	}
+
+	feIDs, err := orm.FlagEnvironment.Query().
+		Where(flagenvironment.EnvironmentID(e.ID)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if err := deleteFlagEnvironments(ctx, orm, feIDs); err != nil {
+		return err
+	}
+	if err := orm.Environment.Update().Where(environment.ExpectedMatchID(e.ID)).ClearExpectedMatchID().Exec(ctx); err != nil {
+		return err
+	}
+	return orm.Environment.DeleteOneID(e.ID).Exec(ctx)
+}
+
+// deleteFlagEnvironments deletes flag environment configs with their
+// strategies, constraints, prerequisites, versions and rollout plans (SQLite
+// has no FK cascade).
+func deleteFlagEnvironments(ctx context.Context, orm *ent.Client, ids []int) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	strategyIDs, err := orm.Strategy.Query().
+		Where(strategy.FlagEnvironmentIDIn(ids...)).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+	if len(strategyIDs) > 0 {
+		if _, err := orm.Constraint.Delete().Where(entconstraint.StrategyIDIn(strategyIDs...)).Exec(ctx); err != nil {
+			return err
+		}
+		if _, err := orm.Strategy.Delete().Where(strategy.IDIn(strategyIDs...)).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if _, err := orm.Prerequisite.Delete().Where(prerequisite.FlagEnvironmentIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.FlagEnvironmentVersion.Delete().Where(flagenvironmentversion.FlagEnvironmentIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := orm.RolloutPlan.Delete().Where(rolloutplan.FlagEnvironmentIDIn(ids...)).Exec(ctx); err != nil {
+		return err
+	}
+	_, err = orm.FlagEnvironment.Delete().Where(flagenvironment.IDIn(ids...)).Exec(ctx)
+	return err
+}
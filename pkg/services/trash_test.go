@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/felipekafuri/bandeira/config"
+	"github.com/felipekafuri/bandeira/ent"
+	"github.com/felipekafuri/bandeira/ent/apitoken"
+	"github.com/felipekafuri/bandeira/ent/flagenvironment"
+)
+
+func TestTrashService_RestoreAndPurge(t *testing.T) {
+	bg := context.Background()
+	now := time.Now()
+	trash := NewTrashService(c.ORM, config.TrashConfig{Retention: 24 * time.Hour})
+	trash.now = func() time.Time { return now }
+
+	p := c.ORM.Project.Create().SetName("trash-test").SaveX(bg)
+	env := c.ORM.Environment.Create().SetName("dev").SetType("development").SetProjectID(p.ID).SaveX(bg)
+	parent := c.ORM.Flag.Create().SetName("parent").SetFlagType("release").SetProjectID(p.ID).SaveX(bg)
+	child := c.ORM.Flag.Create().SetName("child").SetFlagType("release").SetProjectID(p.ID).SaveX(bg)
+	fe := c.ORM.FlagEnvironment.Create().SetFlagID(child.ID).SetEnvironmentID(env.ID).SetEnabled(true).SaveX(bg)
+	s := c.ORM.Strategy.Create().SetName("default").SetFlagEnvironmentID(fe.ID).SaveX(bg)
+	c.ORM.Constraint.Create().SetContextName("userId").SetOperator("IN").SetValues([]string{"1"}).SetStrategyID(s.ID).SaveX(bg)
+	c.ORM.Prerequisite.Create().SetFlagEnvironmentID(fe.ID).SetParentFlagID(parent.ID).SaveX(bg)
+	c.ORM.FlagEnvironmentVersion.Create().SetFlagEnvironmentID(fe.ID).SetVersion(1).SetSnapshot(json.RawMessage(`{}`)).SaveX(bg)
+	c.ORM.ApiToken.Create().SetName("tok").SetSecret("trash-test").SetTokenType(apitoken.TokenTypeClient).SetProjectID(p.ID).SaveX(bg)
+
+	// A flag cannot come back before a prerequisite it still names.
+	require.NoError(t, trash.Trash(bg, TrashFlag, child.ID))
+	require.NoError(t, trash.Trash(bg, TrashFlag, parent.ID))
+	var conflict *RestoreConflictError
+	require.ErrorAs(t, trash.Restore(bg, TrashFlag, child.ID), &conflict)
+	assert.Equal(t, `restore prerequisite "parent" first`, conflict.Reason)
+	require.NoError(t, trash.Restore(bg, TrashFlag, parent.ID))
+	require.NoError(t, trash.Restore(bg, TrashFlag, child.ID))
+	assert.True(t, ent.IsNotFound(trash.Restore(bg, TrashFlag, child.ID)))
+
+	// Past the retention window a flag can no longer be restored, and the
+	// purge takes its configs along.
+	require.NoError(t, trash.Trash(bg, TrashFlag, child.ID))
+	items, err := trash.List(bg, p.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.WithinDuration(t, now.Add(24*time.Hour), *items[0].PurgeAt, time.Second)
+
+	now = now.Add(25 * time.Hour)
+	assert.ErrorIs(t, trash.Restore(bg, TrashFlag, child.ID), ErrTrashExpired)
+
+	n, err := trash.Purge(bg)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.False(t, c.ORM.FlagEnvironment.Query().Where(flagenvironment.FlagID(child.ID)).ExistX(bg))
+	_, err = c.ORM.Flag.Get(bg, child.ID)
+	assert.True(t, ent.IsNotFound(err))
+
+	// Purging a project removes everything in it.
+	require.NoError(t, trash.Trash(bg, TrashProject, p.ID))
+	items, err = trash.List(bg, 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, TrashProject, items[0].Kind)
+
+	require.NoError(t, trash.PurgeItem(bg, TrashProject, p.ID))
+	_, err = c.ORM.Project.Get(bg, p.ID)
+	assert.True(t, ent.IsNotFound(err))
+	assert.Zero(t, c.ORM.ApiToken.Query().Where(apitoken.ProjectID(p.ID)).CountX(bg))
+}
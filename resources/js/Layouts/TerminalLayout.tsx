@@ -7,7 +7,7 @@ import { Menu, X } from "lucide-react";
 
 interface Props {
   children: ReactNode;
-  activePage?: "dashboard" | "projects" | "users" | "strategies" | "docs";
+  activePage?: "dashboard" | "projects" | "trash" | "users" | "backups" | "strategies" | "docs";
 }
 
 export default function TerminalLayout({ children, activePage }: Props) {
@@ -22,8 +22,12 @@ export default function TerminalLayout({ children, activePage }: Props) {
   const navItems = [
     { key: "dashboard", href: "/dashboard", label: "dashboard" },
     { key: "projects", href: "/projects", label: "projects" },
+    { key: "trash", href: "/trash", label: "trash" },
     ...(auth?.user?.role === "admin"
-      ? [{ key: "users", href: "/users", label: "users" }]
+      ? [
+          { key: "users", href: "/users", label: "users" },
+          { key: "backups", href: "/backups", label: "backups" },
+        ]
       : []),
     { key: "strategies", href: "/strategies", label: "strategies" },
     { key: "docs", href: "/docs", label: "docs" },
@@ -0,0 +1,506 @@
+import { useEffect, useMemo, useState } from "react";
+import { Link } from "@inertiajs/react";
+
+import PublicLayout from "@/Layouts/PublicLayout";
+
+// The subset of OpenAPI 3.1 that the explorer reads.
+interface Schema {
+  $ref?: string;
+  type?: string | string[];
+  format?: string;
+  description?: string;
+  enum?: unknown[];
+  default?: unknown;
+  minimum?: number;
+  maximum?: number;
+  properties?: Record<string, Schema>;
+  required?: string[];
+  additionalProperties?: Schema;
+  items?: Schema;
+}
+
+interface Parameter {
+  $ref?: string;
+  name: string;
+  in: "path" | "query" | "header";
+  description?: string;
+  required?: boolean;
+  schema?: Schema;
+}
+
+interface MediaType {
+  schema: Schema;
+  example?: unknown;
+}
+
+interface Response {
+  $ref?: string;
+  description?: string;
+  content?: Record<string, MediaType>;
+}
+
+interface Operation {
+  operationId: string;
+  summary: string;
+  description?: string;
+  tags?: string[];
+  parameters?: Parameter[];
+  requestBody?: { content: Record<string, MediaType> };
+  responses: Record<string, Response>;
+  security?: Record<string, string[]>[];
+}
+
+interface Spec {
+  info: { title: string; version: string; description?: string };
+  tags?: { name: string; description?: string }[];
+  paths: Record<string, Record<string, Operation>>;
+  components: {
+    schemas?: Record<string, Schema>;
+    parameters?: Record<string, Parameter>;
+    responses?: Record<string, Response>;
+  };
+}
+
+interface Entry {
+  method: string;
+  path: string;
+  op: Operation;
+}
+
+interface Props {
+  specUrl: string;
+}
+
+const methodColors: Record<string, string> = {
+  GET: "text-green-600",
+  POST: "text-yellow-600",
+  PUT: "text-blue-600",
+  PATCH: "text-purple-600",
+  DELETE: "text-red-600",
+};
+
+const methodOrder = ["get", "post", "put", "patch", "delete"];
+
+const tokenKey = "bandeira.explorer.token";
+
+const inputClass =
+  "bg-background border border-border px-2 py-1 text-xs text-foreground focus:outline-none focus:border-primary";
+
+// resolve follows a local "#/components/..." reference.
+function resolve<T extends { $ref?: string }>(spec: Spec, v: T): T {
+  if (!v.$ref) return v;
+  let target: unknown = spec;
+  for (const part of v.$ref.replace(/^#\//, "").split("/")) {
+    target = (target as Record<string, unknown> | undefined)?.[part];
+  }
+  return (target as T | undefined) ?? v;
+}
+
+const refName = (ref: string) => ref.split("/").pop() ?? ref;
+
+const typeLabel = (s: Schema) =>
+  Array.isArray(s.type) ? s.type.join(" | ") : (s.type ?? "any");
+
+function SchemaView({
+  spec,
+  schema,
+  depth = 0,
+}: {
+  spec: Spec;
+  schema: Schema;
+  depth?: number;
+}) {
+  const s = resolve(spec, schema);
+  const named = schema.$ref ? refName(schema.$ref) : null;
+
+  // Deeply nested schemas are cut off; the names tell where they lead.
+  if (depth > 4) {
+    return <span className="text-muted-foreground">{named ?? typeLabel(s)}</span>;
+  }
+
+  if (s.items) {
+    return (
+      <span>
+        <span className="text-muted-foreground">array of </span>
+        <SchemaView spec={spec} schema={s.items} depth={depth + 1} />
+      </span>
+    );
+  }
+
+  if (s.properties) {
+    return (
+      <div>
+        {named && <span className="text-muted-foreground">{named}</span>}
+        <ul className="ml-4 border-l border-border pl-3">
+          {Object.entries(s.properties).map(([name, prop]) => (
+            <li key={name} className="py-0.5">
+              <code className="text-foreground">{name}</code>
+              {s.required?.includes(name) && (
+                <span className="text-red-600">*</span>
+              )}
+              <span className="text-muted-foreground">: </span>
+              <SchemaView spec={spec} schema={prop} depth={depth + 1} />
+              {resolve(spec, prop).description && (
+                <span className="text-muted-foreground">
+                  {" "}
+                  — {resolve(spec, prop).description}
+                </span>
+              )}
+            </li>
+          ))}
+        </ul>
+      </div>
+    );
+  }
+
+  if (s.additionalProperties) {
+    return (
+      <span>
+        <span className="text-muted-foreground">map of </span>
+        <SchemaView spec={spec} schema={s.additionalProperties} depth={depth + 1} />
+      </span>
+    );
+  }
+
+  return (
+    <span className="text-muted-foreground">
+      {named ? `${named} (${typeLabel(s)})` : typeLabel(s)}
+      {s.format && ` <${s.format}>`}
+      {s.enum && ` — one of ${s.enum.map((v) => JSON.stringify(v)).join(", ")}`}
+      {s.minimum !== undefined && s.maximum !== undefined && ` — ${s.minimum}..${s.maximum}`}
+    </span>
+  );
+}
+
+function OperationView({
+  spec,
+  entry,
+  token,
+}: {
+  spec: Spec;
+  entry: Entry;
+  token: string;
+}) {
+  const { method, path, op } = entry;
+  const params = (op.parameters ?? []).map((p) => resolve(spec, p));
+  const body = op.requestBody?.content["application/json"];
+
+  const [values, setValues] = useState<Record<string, string>>({});
+  const [bodyText, setBodyText] = useState(
+    body?.example ? JSON.stringify(body.example, null, 2) : body ? "{}" : "",
+  );
+  const [result, setResult] = useState<{ status: number; body: string } | null>(null);
+  const [sending, setSending] = useState(false);
+
+  const send = async () => {
+    let url = path;
+    const query = new URLSearchParams();
+    for (const p of params) {
+      const v = values[p.name];
+      if (!v) continue;
+      if (p.in === "path") url = url.replace(`{${p.name}}`, encodeURIComponent(v));
+      else if (p.in === "query") v.split(",").forEach((part) => query.append(p.name, part.trim()));
+    }
+    if (query.toString()) url += `?${query}`;
+
+    const headers: Record<string, string> = { Accept: "application/json" };
+    if (token) headers.Authorization = `Bearer ${token}`;
+    if (body) headers["Content-Type"] = "application/json";
+
+    setSending(true);
+    try {
+      const res = await fetch(url, {
+        method: method.toUpperCase(),
+        headers,
+        body: body ? bodyText : undefined,
+      });
+      const text = await res.text();
+      let pretty = text;
+      try {
+        pretty = JSON.stringify(JSON.parse(text), null, 2);
+      } catch {
+        // Not JSON, e.g. YAML exports; shown as is.
+      }
+      setResult({ status: res.status, body: pretty });
+    } catch (e) {
+      setResult({ status: 0, body: String(e) });
+    } finally {
+      setSending(false);
+    }
+  };
+
+  const isStream = op.responses["200"]?.content?.["text/event-stream"] !== undefined;
+
+  return (
+    <div className="px-4 pb-4 space-y-4 text-xs">
+      {op.description && <p className="text-muted-foreground">{op.description}</p>}
+      <p className="text-muted-foreground">
+        auth:{" "}
+        {op.security
+          ? op.security.map((s) => Object.keys(s).join(", ")).join(" or ")
+          : "none"}{" "}
+        · operationId: <code>{op.operationId}</code>
+      </p>
+
+      {params.length > 0 && (
+        <div>
+          <h4 className="font-semibold text-foreground mb-2">parameters</h4>
+          <div className="space-y-1.5">
+            {params.map((p) => (
+              <div key={`${p.in}:${p.name}`} className="flex flex-wrap items-center gap-2">
+                <code className="w-28 shrink-0 text-foreground">
+                  {p.name}
+                  {p.required && <span className="text-red-600">*</span>}
+                </code>
+                <span className="w-12 shrink-0 text-muted-foreground">{p.in}</span>
+                <input
+                  aria-label={p.name}
+                  className={`${inputClass} w-40`}
+                  placeholder={p.schema?.default !== undefined ? String(p.schema.default) : ""}
+                  value={values[p.name] ?? ""}
+                  onChange={(e) => setValues({ ...values, [p.name]: e.target.value })}
+                />
+                <span className="text-muted-foreground">
+                  {p.schema && <SchemaView spec={spec} schema={p.schema} />}
+                  {p.description && ` — ${p.description}`}
+                </span>
+              </div>
+            ))}
+          </div>
+        </div>
+      )}
+
+      {body && (
+        <div>
+          <h4 className="font-semibold text-foreground mb-2">request body</h4>
+          <div className="grid gap-3 md:grid-cols-2">
+            <div className="bg-muted p-3 overflow-x-auto">
+              <SchemaView spec={spec} schema={body.schema} />
+            </div>
+            <textarea
+              aria-label="Request body"
+              className={`${inputClass} font-mono min-h-40`}
+              value={bodyText}
+              onChange={(e) => setBodyText(e.target.value)}
+            />
+          </div>
+        </div>
+      )}
+
+      <div>
+        <h4 className="font-semibold text-foreground mb-2">responses</h4>
+        <ul className="space-y-2">
+          {Object.entries(op.responses).map(([code, r]) => {
+            const resolved = resolve(spec, r);
+            const content = resolved.content
+              ? Object.values(resolved.content)[0]
+              : undefined;
+            return (
+              <li key={code}>
+                <code className={code.startsWith("2") ? "text-green-600" : "text-red-600"}>
+                  {code}
+                </code>{" "}
+                <span className="text-muted-foreground">{resolved.description}</span>
+                {content && (
+                  <div className="bg-muted p-3 mt-1 overflow-x-auto">
+                    <SchemaView spec={spec} schema={content.schema} />
+                  </div>
+                )}
+              </li>
+            );
+          })}
+        </ul>
+      </div>
+
+      {isStream ? (
+        <p className="text-muted-foreground">
+          # event streams cannot be tried from here; use curl -N
+        </p>
+      ) : (
+        <div>
+          <button
+            type="button"
+            onClick={send}
+            disabled={sending}
+            className="text-xs border border-border px-3 py-1.5 text-foreground hover:bg-muted transition-colors disabled:opacity-50"
+          >
+            {sending ? "[sending...]" : "[send request]"}
+          </button>
+          {result && (
+            <div className="mt-3">
+              <p className="mb-1">
+                status:{" "}
+                <code className={result.status >= 200 && result.status < 300 ? "text-green-600" : "text-red-600"}>
+                  {result.status || "network error"}
+                </code>
+              </p>
+              <pre className="bg-muted p-3 overflow-x-auto max-h-96">
+                <code>{result.body}</code>
+              </pre>
+            </div>
+          )}
+        </div>
+      )}
+    </div>
+  );
+}
+
+export default function ApiExplorer({ specUrl }: Props) {
+  const [spec, setSpec] = useState<Spec | null>(null);
+  const [error, setError] = useState("");
+  const [filter, setFilter] = useState("");
+  const [open, setOpen] = useState<string | null>(null);
+  const [token, setToken] = useState(
+    () => (typeof window !== "undefined" && sessionStorage.getItem(tokenKey)) || "",
+  );
+
+  useEffect(() => {
+    fetch(specUrl)
+      .then((res) => {
+        if (!res.ok) throw new Error(`HTTP ${res.status}`);
+        return res.json();
+      })
+      .then(setSpec)
+      .catch((e) => setError(String(e)));
+  }, [specUrl]);
+
+  // The token stays in this tab only.
+  useEffect(() => {
+    sessionStorage.setItem(tokenKey, token);
+  }, [token]);
+
+  const groups = useMemo(() => {
+    if (!spec) return [];
+    const entries: Entry[] = [];
+    for (const [path, item] of Object.entries(spec.paths)) {
+      for (const [method, op] of Object.entries(item)) {
+        entries.push({ method, path, op });
+      }
+    }
+    entries.sort(
+      (a, b) =>
+        a.path.localeCompare(b.path) ||
+        methodOrder.indexOf(a.method) - methodOrder.indexOf(b.method),
+    );
+
+    const q = filter.trim().toLowerCase();
+    const matches = (e: Entry) =>
+      !q ||
+      e.path.toLowerCase().includes(q) ||
+      e.op.summary.toLowerCase().includes(q) ||
+      e.op.operationId.toLowerCase().includes(q);
+
+    return (spec.tags ?? []).map((tag) => ({
+      tag,
+      entries: entries.filter((e) => e.op.tags?.includes(tag.name) && matches(e)),
+    }));
+  }, [spec, filter]);
+
+  return (
+    <PublicLayout activePage="docs">
+      <div className="py-8 px-4 md:px-6">
+        <div className="mx-auto max-w-5xl">
+          <div className="mb-8">
+            <Link
+              href="/docs"
+              className="text-xs text-muted-foreground hover:text-foreground transition-colors"
+            >
+              {"<"} docs
+            </Link>
+            <h1 className="text-xl font-semibold tracking-tight text-foreground mt-2">
+              {">"} api_explorer
+            </h1>
+            <p className="text-muted-foreground mt-1 text-sm">
+              # generated from{" "}
+              <a href={specUrl} className="text-primary hover:underline">
+                {specUrl}
+              </a>{" "}
+              (OpenAPI 3.1)
+            </p>
+          </div>
+
+          {error && (
+            <p className="text-sm text-red-600">
+              {">"} failed to load the spec: {error}
+            </p>
+          )}
+          {!spec && !error && (
+            <p className="text-sm text-muted-foreground">{">"} loading...</p>
+          )}
+
+          {spec && (
+            <div className="space-y-6">
+              {spec.info.description && (
+                <p className="text-sm text-muted-foreground">{spec.info.description}</p>
+              )}
+
+              <div className="bg-card border border-border p-4 flex flex-wrap items-center gap-3">
+                <label className="text-xs text-muted-foreground" htmlFor="explorer-token">
+                  bearer token
+                </label>
+                <input
+                  id="explorer-token"
+                  type="password"
+                  autoComplete="off"
+                  className={`${inputClass} flex-1 min-w-48`}
+                  placeholder="admin or client token"
+                  value={token}
+                  onChange={(e) => setToken(e.target.value)}
+                />
+                <input
+                  type="search"
+                  aria-label="Filter endpoints"
+                  className={`${inputClass} w-56`}
+                  placeholder="filter endpoints..."
+                  value={filter}
+                  onChange={(e) => setFilter(e.target.value)}
+                />
+              </div>
+
+              {groups
+                .filter((g) => g.entries.length > 0)
+                .map(({ tag, entries }) => (
+                  <section key={tag.name} className="bg-card border border-border">
+                    <div className="px-4 py-3 border-b border-border">
+                      <h2 className="text-sm font-semibold text-foreground">
+                        // {tag.name}
+                      </h2>
+                      {tag.description && (
+                        <p className="text-xs text-muted-foreground">{tag.description}</p>
+                      )}
+                    </div>
+                    <ul>
+                      {entries.map((entry) => {
+                        const key = `${entry.method} ${entry.path}`;
+                        const method = entry.method.toUpperCase();
+                        return (
+                          <li key={key} className="border-b border-border last:border-b-0">
+                            <button
+                              type="button"
+                              onClick={() => setOpen(open === key ? null : key)}
+                              className="flex items-center gap-2 w-full px-4 py-2 text-left hover:bg-muted/50 transition-colors min-w-0"
+                            >
+                              <code
+                                className={`bg-muted px-1.5 py-0.5 text-xs font-semibold shrink-0 w-16 text-center ${methodColors[method] ?? ""}`}
+                              >
+                                {method}
+                              </code>
+                              <code className="text-xs sm:text-sm truncate min-w-0">{entry.path}</code>
+                              <span className="text-xs text-muted-foreground ml-auto shrink-0 hidden sm:inline">
+                                {entry.op.summary}
+                              </span>
+                            </button>
+                            {open === key && <OperationView spec={spec} entry={entry} token={token} />}
+                          </li>
+                        );
+                      })}
+                    </ul>
+                  </section>
+                ))}
+            </div>
+          )}
+        </div>
+      </div>
+    </PublicLayout>
+  );
+}
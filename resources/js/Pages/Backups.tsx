@@ -0,0 +1,78 @@
+import { usePage, router } from "@inertiajs/react";
+import { useState } from "react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+
+interface Backup {
+  name: string;
+  size: number;
+  createdAt: string;
+}
+
+interface Props {
+  backups: Backup[];
+}
+
+const formatSize = (bytes: number) => {
+  if (bytes < 1024) return `${bytes} B`;
+  if (bytes < 1024 * 1024) return `${(bytes / 1024).toFixed(1)} KB`;
+  return `${(bytes / 1024 / 1024).toFixed(1)} MB`;
+};
+
+export default function Backups() {
+  const { backups } = usePage<SharedProps & Props>().props;
+  const [processing, setProcessing] = useState(false);
+
+  const handleBackup = () => {
+    router.post("/backups", {}, {
+      onStart: () => setProcessing(true),
+      onFinish: () => setProcessing(false),
+    });
+  };
+
+  return (
+    <TerminalLayout activePage="backups">
+      <div className="max-w-5xl">
+        <div className="mb-8 flex items-start justify-between">
+          <div>
+            <h1 className="text-xl font-semibold text-foreground">
+              {">"} backups
+            </h1>
+            <p className="text-muted-foreground mt-1 text-sm">
+              # hot copies of the whole database — restore with `bandeira db restore`
+            </p>
+          </div>
+          <button
+            type="button"
+            disabled={processing}
+            onClick={handleBackup}
+            className="text-sm text-primary hover:opacity-80 transition-opacity disabled:opacity-50"
+          >
+            {processing ? "[backing_up...]" : "[backup_now]"}
+          </button>
+        </div>
+
+        <div className="bg-card border border-border">
+          {backups.length === 0 ? (
+            <div className="flex flex-col items-center justify-center py-16 px-6 text-center">
+              <p className="text-muted-foreground text-lg mb-2">
+                {">"} no backups yet
+              </p>
+            </div>
+          ) : (
+            <div className="divide-y divide-border">
+              {backups.map((b) => (
+                <div key={b.name} className="px-5 py-3 flex items-center justify-between">
+                  <span className="font-medium text-foreground text-sm">{b.name}</span>
+                  <span className="text-xs text-muted-foreground">
+                    {formatSize(b.size)} · {b.createdAt}
+                  </span>
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+      </div>
+    </TerminalLayout>
+  );
+}
@@ -390,6 +390,15 @@ Client.close(client)`}
   ]
 }`}
               </CodeBlock>
+              <p className="text-sm text-muted-foreground mt-4">
+                A flag with prerequisites also lists them, e.g.{" "}
+                <code className="bg-muted px-1.5 py-0.5 rounded text-foreground text-xs">
+                  {`"prerequisites": [{"flag": "new-checkout", "enabled": true}]`}
+                </code>
+                . The flag is off unless every parent flag evaluates to the
+                required state (and <code className="bg-muted px-1.5 py-0.5 rounded text-foreground text-xs">variant</code>,
+                when set), so SDKs check prerequisites before strategies.
+              </p>
             </section>
 
             {/* Real-Time Streaming (SSE) */}
@@ -441,6 +450,14 @@ data: {"flags":[{"name":"new-dashboard","enabled":true,"strategies":[]}]}
                   <code className="bg-background px-1 py-0.5 rounded text-foreground text-xs">:heartbeat</code> comment is
                   sent every 30 seconds to keep the connection alive.
                 </p>
+                <p>
+                  <strong className="text-foreground">Reconnect</strong> — When
+                  the server shuts down or redeploys, it sends{" "}
+                  <code className="bg-background px-1 py-0.5 rounded text-foreground text-xs">event: reconnect</code> with
+                  a jittered <code className="bg-background px-1 py-0.5 rounded text-foreground text-xs">retry:</code> delay
+                  (also in <code className="bg-background px-1 py-0.5 rounded text-foreground text-xs">data.retry_ms</code>).
+                  Reconnect after that delay. Remaining streams are closed after a short drain deadline.
+                </p>
               </div>
 
               <h3 className="text-sm font-semibold text-foreground mt-6 mb-2">
@@ -530,6 +547,18 @@ client.start()`}
                 programmatically. All endpoints require an admin token and are
                 scoped to the token's project.
               </p>
+              <p className="text-sm text-muted-foreground mb-4">
+                Request and response schemas are published as an OpenAPI 3.1
+                document at{" "}
+                <a href="/api/openapi.json" className="text-primary hover:underline">
+                  /api/openapi.json
+                </a>
+                , which you can browse and try out in the{" "}
+                <Link href="/docs/api" className="text-primary hover:underline font-medium">
+                  API explorer
+                </Link>
+                .
+              </p>
 
               {/* Projects */}
               <h3 className="text-sm font-semibold text-foreground mb-3 mt-6 border-b border-border pb-2">
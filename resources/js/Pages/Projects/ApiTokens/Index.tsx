@@ -3,6 +3,8 @@ import { useState } from "react";
 import { SharedProps } from "@/types/global";
 import TerminalLayout from "@/Layouts/TerminalLayout";
 import { Button } from "@/components/ui/button";
+import ListControls, { ListPager } from "@/components/ListControls";
+import { ListState, Page } from "@/types";
 import {
   Plus,
   Trash2,
@@ -24,11 +26,20 @@ interface TokenItem {
 
 interface Props {
   project: { id: number; name: string };
-  tokens: TokenItem[];
+  tokens: Page<TokenItem>;
+  list: ListState;
 }
 
+const tokenSorts = [
+  { value: "created_at", label: "oldest first" },
+  { value: "-created_at", label: "newest first" },
+  { value: "name", label: "name a-z" },
+  { value: "-name", label: "name z-a" },
+];
+
 export default function Index() {
-  const { project, tokens, auth } = usePage<SharedProps & Props>().props;
+  const { project, tokens, list, auth } = usePage<SharedProps & Props>().props;
+  const path = `/projects/${project.id}/api-tokens`;
   const canMutate = auth?.user?.role === "admin" || auth?.user?.role === "editor";
 
   const [visibleTokens, setVisibleTokens] = useState<Record<number, boolean>>({});
@@ -93,7 +104,14 @@ export default function Index() {
 
           {/* Token list */}
           <div className="bg-card border border-border">
-            {tokens.length === 0 ? (
+            <div className="flex items-center justify-end px-5 py-3 border-b border-border">
+              <ListControls path={path} list={list} sorts={tokenSorts} />
+            </div>
+            {tokens.items.length === 0 && list.q ? (
+              <p className="py-12 px-6 text-center text-sm text-muted-foreground">
+                no api tokens match "{list.q}".
+              </p>
+            ) : tokens.items.length === 0 ? (
               <div className="flex flex-col items-center justify-center py-12 px-6 text-center">
                 <p className="text-sm text-muted-foreground mb-4">
                   no api tokens yet.
@@ -108,7 +126,7 @@ export default function Index() {
               </div>
             ) : (
               <div className="divide-y divide-border">
-                {tokens.map((tok) => (
+                {tokens.items.map((tok) => (
                   <div key={tok.id} className="px-5 py-3 space-y-2">
                     <div className="flex items-center justify-between">
                       <div className="flex items-center gap-3">
@@ -181,6 +199,13 @@ export default function Index() {
                 ))}
               </div>
             )}
+            <ListPager
+              path={path}
+              list={list}
+              total={tokens.total}
+              shown={tokens.items.length}
+              nextCursor={tokens.next_cursor}
+            />
           </div>
       </div>
     </TerminalLayout>
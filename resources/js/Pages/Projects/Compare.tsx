@@ -0,0 +1,223 @@
+import { Link, router, usePage } from "@inertiajs/react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Checkbox } from "@/components/ui/checkbox";
+
+interface Difference {
+  path: string;
+  values: Record<string, any>;
+}
+
+interface FlagComparison {
+  name: string;
+  differences: Difference[];
+}
+
+interface Comparison {
+  environments: string[];
+  flags: FlagComparison[];
+}
+
+interface Drift {
+  environment: string;
+  expected_match: string;
+  flags: FlagComparison[];
+}
+
+interface Props {
+  project: { id: number; name: string };
+  environments: string[];
+  selected: string[];
+  comparison: Comparison | null;
+  compareError: string;
+  drift: Drift[];
+}
+
+function formatValue(value: any): string {
+  if (value === null || value === undefined) {
+    return "—";
+  }
+  return typeof value === "string" ? value : JSON.stringify(value);
+}
+
+function DifferenceTable({
+  envs,
+  flags,
+}: {
+  envs: string[];
+  flags: FlagComparison[];
+}) {
+  return (
+    <div className="overflow-x-auto">
+      <table className="w-full text-xs">
+        <thead>
+          <tr className="border-b border-border">
+            <th className="text-left font-medium text-muted-foreground px-5 py-2">
+              flag / setting
+            </th>
+            {envs.map((env) => (
+              <th
+                key={env}
+                className="text-left font-medium text-muted-foreground px-4 py-2"
+              >
+                {env}
+              </th>
+            ))}
+          </tr>
+        </thead>
+        <tbody>
+          {flags.map((flag) =>
+            flag.differences.map((diff, i) => (
+              <tr
+                key={`${flag.name}-${diff.path}`}
+                className="border-b border-border last:border-b-0"
+              >
+                <td className="px-5 py-2 align-top">
+                  {i === 0 && (
+                    <span className="font-medium text-foreground">
+                      {flag.name}
+                    </span>
+                  )}
+                  <div className="text-muted-foreground">{diff.path}</div>
+                </td>
+                {envs.map((env) => (
+                  <td key={env} className="px-4 py-2 align-top">
+                    <pre className="whitespace-pre-wrap break-all text-foreground">
+                      {formatValue(diff.values[env])}
+                    </pre>
+                  </td>
+                ))}
+              </tr>
+            ))
+          )}
+        </tbody>
+      </table>
+    </div>
+  );
+}
+
+export default function Compare() {
+  const { project, environments, selected, comparison, compareError, drift } =
+    usePage<SharedProps & Props>().props;
+
+  const toggleEnv = (name: string, checked: boolean) => {
+    const next = checked
+      ? environments.filter((e) => e === name || selected.includes(e))
+      : selected.filter((e) => e !== name);
+    router.get(
+      `/projects/${project.id}/compare`,
+      { env: next },
+      { preserveScroll: true, preserveState: true }
+    );
+  };
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-6xl">
+        <Link
+          href={`/projects/${project.id}`}
+          className="inline-flex items-center gap-1 text-sm text-muted-foreground hover:text-foreground transition-colors mb-6"
+        >
+          projects / {project.name} /
+        </Link>
+
+        <div className="mb-8">
+          <h1 className="text-xl font-semibold text-foreground">
+            {">"} compare_environments
+          </h1>
+          <p className="text-muted-foreground mt-1 text-sm">
+            # flag configuration differences across environments
+          </p>
+        </div>
+
+        {/* Drift report */}
+        <div className="bg-card border border-border mb-6">
+          <div className="px-5 py-4 border-b border-border">
+            <h2 className="text-sm font-semibold text-foreground">// drift</h2>
+            <p className="text-xs text-muted-foreground mt-1">
+              Environments compared with the one they are expected to match
+            </p>
+          </div>
+          {drift.length === 0 ? (
+            <p className="px-5 py-6 text-sm text-muted-foreground">
+              {">"} no environment declares an expected match
+            </p>
+          ) : (
+            <div className="divide-y divide-border">
+              {drift.map((d) => (
+                <div key={d.environment}>
+                  <div className="px-5 py-3 flex items-center gap-2 text-sm">
+                    <span className="font-medium text-foreground">
+                      {d.environment}
+                    </span>
+                    <span className="text-muted-foreground">
+                      expected to match {d.expected_match}
+                    </span>
+                    <span
+                      className={`text-[10px] px-1.5 py-0.5 border font-medium ${
+                        d.flags.length === 0
+                          ? "text-primary border-primary/30"
+                          : "text-destructive border-destructive/30"
+                      }`}
+                    >
+                      {d.flags.length === 0
+                        ? "[in sync]"
+                        : `[${d.flags.length} drifted]`}
+                    </span>
+                  </div>
+                  {d.flags.length > 0 && (
+                    <DifferenceTable
+                      envs={[d.expected_match, d.environment]}
+                      flags={d.flags}
+                    />
+                  )}
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+
+        {/* Ad-hoc comparison */}
+        <div className="bg-card border border-border">
+          <div className="px-5 py-4 border-b border-border">
+            <h2 className="text-sm font-semibold text-foreground">
+              // compare
+            </h2>
+            <div className="flex flex-wrap items-center gap-4 mt-3">
+              {environments.map((env) => (
+                <label
+                  key={env}
+                  className="flex items-center gap-2 text-xs text-muted-foreground"
+                >
+                  <Checkbox
+                    checked={selected.includes(env)}
+                    onCheckedChange={(checked) =>
+                      toggleEnv(env, checked === true)
+                    }
+                  />
+                  {env}
+                </label>
+              ))}
+            </div>
+          </div>
+          {compareError ? (
+            <p className="px-5 py-6 text-sm text-muted-foreground">
+              {">"} {compareError}
+            </p>
+          ) : comparison && comparison.flags.length === 0 ? (
+            <p className="px-5 py-6 text-sm text-muted-foreground">
+              {">"} no differences
+            </p>
+          ) : (
+            comparison && (
+              <DifferenceTable
+                envs={comparison.environments}
+                flags={comparison.flags}
+              />
+            )
+          )}
+        </div>
+      </div>
+    </TerminalLayout>
+  );
+}
@@ -0,0 +1,182 @@
+import { Link, useForm, usePage } from "@inertiajs/react";
+import { FormEventHandler } from "react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import InputError from "@/components/InputError";
+import type { ContextField } from "@/Pages/Projects/Flags/components/ConstraintRow";
+import { Loader2 } from "lucide-react";
+
+interface Props {
+  project: { id: number; name: string };
+  types: ContextField["type"][];
+  // contextField is set when editing.
+  contextField?: ContextField;
+  usedBy?: string[];
+}
+
+export default function Form() {
+  const { project, types, contextField, usedBy } = usePage<SharedProps & Props>().props;
+  const errors = usePage().props.errors as Record<string, string[]> | undefined;
+  const path = `/projects/${project.id}/context-fields`;
+  const inUse = (usedBy ?? []).length > 0;
+
+  const { data, setData, post, put, processing } = useForm({
+    name: contextField?.name ?? "",
+    description: contextField?.description ?? "",
+    type: contextField?.type ?? "string",
+    legal_values: (contextField?.legal_values ?? []).join("\n"),
+  });
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    if (contextField) {
+      put(`${path}/${contextField.id}`);
+    } else {
+      post(path);
+    }
+  };
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-2xl">
+          <div className="mb-6">
+            <Link
+              href="/projects"
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              projects
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={`/projects/${project.id}`}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              {project.name}
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={path}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              context_fields
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <span className="text-sm text-foreground">
+              {contextField ? contextField.name : "create"}
+            </span>
+          </div>
+
+          <div className="mb-8">
+            <h1 className="text-2xl font-semibold tracking-tight text-foreground">
+              {">"} {contextField ? "edit_context_field" : "create_context_field"}
+            </h1>
+            <p className="text-muted-foreground mt-1 text-sm">
+              # the type decides which operators constraints on the field may use.
+            </p>
+          </div>
+
+          <div className="bg-card border border-border p-6">
+            <form onSubmit={submit} className="space-y-5">
+              <div className="space-y-2">
+                <Label htmlFor="name">name</Label>
+                <Input
+                  id="name"
+                  name="name"
+                  placeholder="e.g. region"
+                  value={data.name}
+                  onChange={(e) => setData("name", e.target.value)}
+                  aria-invalid={!!errors?.Name}
+                  disabled={inUse}
+                  className="h-11"
+                />
+                {inUse && (
+                  <p className="text-xs text-muted-foreground">
+                    used by {usedBy!.join(", ")}; the name and type cannot change.
+                  </p>
+                )}
+                {errors?.Name?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
+              <div className="space-y-2">
+                <Label htmlFor="description">description</Label>
+                <Input
+                  id="description"
+                  name="description"
+                  placeholder="e.g. Region the user's account lives in"
+                  value={data.description}
+                  onChange={(e) => setData("description", e.target.value)}
+                  className="h-11"
+                />
+              </div>
+
+              <div className="space-y-2">
+                <Label>type</Label>
+                <Select
+                  value={data.type}
+                  onValueChange={(v) => setData("type", v as ContextField["type"])}
+                  disabled={inUse}
+                >
+                  <SelectTrigger className="h-11">
+                    <SelectValue />
+                  </SelectTrigger>
+                  <SelectContent>
+                    {types.map((t) => (
+                      <SelectItem key={t} value={t}>
+                        {t}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+                {errors?.Type?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
+              <div className="space-y-2">
+                <Label htmlFor="legal_values">legal values</Label>
+                <textarea
+                  id="legal_values"
+                  name="legal_values"
+                  placeholder="One per line; leave empty to allow any value"
+                  value={data.legal_values}
+                  onChange={(e) => setData("legal_values", e.target.value)}
+                  className="flex w-full border border-input bg-background px-3 py-2 text-sm placeholder:text-muted-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring focus-visible:ring-offset-2 min-h-[100px] resize-y"
+                />
+                {errors?.LegalValues?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
+              <div className="flex items-center gap-3 pt-2">
+                <button
+                  type="submit"
+                  disabled={processing}
+                  className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors disabled:opacity-50"
+                >
+                  {processing && <Loader2 className="w-4 h-4 animate-spin" />}
+                  {contextField ? "[save]" : "[create]"}
+                </button>
+                <Link
+                  href={path}
+                  className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+                >
+                  [cancel]
+                </Link>
+              </div>
+            </form>
+          </div>
+      </div>
+    </TerminalLayout>
+  );
+}
@@ -0,0 +1,164 @@
+import { Link, usePage, router } from "@inertiajs/react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Button } from "@/components/ui/button";
+import type { ContextField } from "@/Pages/Projects/Flags/components/ConstraintRow";
+import { Plus, Trash2, Pencil } from "lucide-react";
+
+interface Props {
+  project: { id: number; name: string };
+  contextFields: ContextField[];
+  operators: Record<string, string[]>;
+  usedBy: Record<string, string[]>;
+}
+
+export default function Index() {
+  const { project, contextFields, operators, usedBy, auth } = usePage<SharedProps & Props>().props;
+  const path = `/projects/${project.id}/context-fields`;
+  const canMutate = auth?.user?.role === "admin" || auth?.user?.role === "editor";
+
+  const builtin = contextFields.filter((f) => f.builtin);
+  const registered = contextFields.filter((f) => !f.builtin);
+
+  const handleDelete = (f: ContextField) => {
+    if (confirm(`Delete context field ${f.name}?`)) {
+      router.delete(`${path}/${f.id}`);
+    }
+  };
+
+  const details = (f: ContextField) => (
+    <>
+      {f.description && (
+        <p className="text-xs text-muted-foreground mt-0.5">{f.description}</p>
+      )}
+      <p className="text-xs text-muted-foreground mt-0.5 font-mono">
+        {(operators[f.type] ?? []).join(", ")}
+      </p>
+      {(f.legal_values ?? []).length > 0 && (
+        <p className="text-xs text-muted-foreground mt-0.5 font-mono">
+          values: {f.legal_values!.join(", ")}
+        </p>
+      )}
+    </>
+  );
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-5xl">
+          <div className="mb-6">
+            <Link
+              href="/projects"
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              projects
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={`/projects/${project.id}`}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              {project.name}
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <span className="text-sm text-foreground">context_fields</span>
+          </div>
+
+          <div className="flex items-start justify-between mb-8">
+            <div>
+              <h1 className="text-2xl font-semibold tracking-tight text-foreground">
+                {">"} context_fields
+              </h1>
+              <p className="text-muted-foreground mt-1 text-sm">
+                # fields constraints of {project.name} can test. once one is registered, constraints may only test listed fields.
+              </p>
+            </div>
+            {canMutate && (
+              <Link
+                href={`${path}/create`}
+                className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors"
+              >
+                <Plus className="w-4 h-4" />
+                [add field]
+              </Link>
+            )}
+          </div>
+
+          <div className="bg-card border border-border mb-6">
+            <div className="px-5 py-3 border-b border-border">
+              <h2 className="text-sm font-medium text-foreground">// registered</h2>
+            </div>
+            {registered.length === 0 ? (
+              <p className="py-12 px-6 text-center text-sm text-muted-foreground">
+                no context fields registered; constraints may test any field.
+              </p>
+            ) : (
+              <div className="divide-y divide-border">
+                {registered.map((f) => {
+                  const flags = usedBy[f.name] ?? [];
+                  return (
+                    <div key={f.name} className="px-5 py-3 flex items-start justify-between gap-4">
+                      <div className="min-w-0">
+                        <div className="flex items-center gap-2">
+                          <span className="font-medium text-foreground text-sm">{f.name}</span>
+                          <span className="text-xs text-muted-foreground bg-muted px-2 py-0.5">
+                            {f.type}
+                          </span>
+                          <span className="text-xs text-muted-foreground bg-muted px-2 py-0.5">
+                            {flags.length === 0
+                              ? "unused"
+                              : `used by ${flags.length} flag${flags.length !== 1 ? "s" : ""}`}
+                          </span>
+                        </div>
+                        {details(f)}
+                      </div>
+                      {canMutate && (
+                        <div className="flex items-center gap-1 shrink-0">
+                          <Link
+                            href={`${path}/${f.id}/edit`}
+                            className="inline-flex items-center gap-1 text-xs text-muted-foreground hover:text-foreground transition-colors px-2 py-1"
+                          >
+                            <Pencil className="w-3.5 h-3.5" />
+                            Edit
+                          </Link>
+                          <Button
+                            variant="ghost"
+                            size="sm"
+                            className="text-destructive hover:text-destructive"
+                            disabled={flags.length > 0}
+                            title={flags.length > 0 ? `Used by ${flags.join(", ")}` : "Delete context field"}
+                            onClick={() => handleDelete(f)}
+                          >
+                            <Trash2 className="w-3.5 h-3.5" />
+                            Delete
+                          </Button>
+                        </div>
+                      )}
+                    </div>
+                  );
+                })}
+              </div>
+            )}
+          </div>
+
+          <div className="bg-card border border-border">
+            <div className="px-5 py-3 border-b border-border">
+              <h2 className="text-sm font-medium text-foreground">// built-in</h2>
+            </div>
+            <div className="divide-y divide-border">
+              {builtin.map((f) => (
+                <div key={f.name} className="px-5 py-3">
+                  <div className="flex items-center gap-2">
+                    <span className="font-medium text-foreground text-sm">{f.name}</span>
+                    <span className="text-xs text-muted-foreground bg-muted px-2 py-0.5">
+                      {f.type}
+                    </span>
+                  </div>
+                  {details(f)}
+                </div>
+              ))}
+            </div>
+          </div>
+      </div>
+    </TerminalLayout>
+  );
+}
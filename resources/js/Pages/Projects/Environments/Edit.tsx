@@ -21,17 +21,22 @@ interface Props {
     name: string;
     type: string;
     sortOrder: number;
+    expectedMatchId: number;
   };
+  environments: { id: number; name: string }[];
 }
 
 export default function Edit() {
-  const { project, environment } = usePage<SharedProps & Props>().props;
+  const { project, environment, environments } = usePage<SharedProps & Props>().props;
   const errors = usePage().props.errors as Record<string, string[]> | undefined;
 
   const { data, setData, put, processing } = useForm({
     name: environment.name,
     type: environment.type,
     sort_order: String(environment.sortOrder),
+    expected_match_id: environment.expectedMatchId
+      ? String(environment.expectedMatchId)
+      : "none",
   });
 
   const submit: FormEventHandler = (e) => {
@@ -125,6 +130,37 @@ export default function Edit() {
                 />
               </div>
 
+              <div className="space-y-2">
+                <Label>
+                  expected to match{" "}
+                  <span className="text-muted-foreground font-normal">
+                    (optional)
+                  </span>
+                </Label>
+                <Select
+                  value={data.expected_match_id}
+                  onValueChange={(value) => setData("expected_match_id", value)}
+                >
+                  <SelectTrigger className="w-full h-11">
+                    <SelectValue placeholder="None" />
+                  </SelectTrigger>
+                  <SelectContent>
+                    <SelectItem value="none">None</SelectItem>
+                    {environments.map((env) => (
+                      <SelectItem key={env.id} value={String(env.id)}>
+                        {env.name}
+                      </SelectItem>
+                    ))}
+                  </SelectContent>
+                </Select>
+                <p className="text-xs text-muted-foreground">
+                  Differences from this environment are reported as drift.
+                </p>
+                {errors?.ExpectedMatchID?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
               <div className="flex items-center gap-3 pt-2">
                 <button
                   type="submit"
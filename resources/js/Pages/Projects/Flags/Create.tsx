@@ -26,6 +26,8 @@ export default function Create() {
     name: "",
     description: "",
     flag_type: "release",
+    owner: "",
+    tags: "",
   });
 
   const submit: FormEventHandler = (e) => {
@@ -119,6 +121,44 @@ export default function Create() {
                 ))}
               </div>
 
+              <div className="space-y-2">
+                <Label htmlFor="owner">
+                  owner{" "}
+                  <span className="text-muted-foreground font-normal">
+                    (optional)
+                  </span>
+                </Label>
+                <Input
+                  id="owner"
+                  name="owner"
+                  placeholder="e.g. team-checkout or jane@example.com"
+                  value={data.owner}
+                  onChange={(e) => setData("owner", e.target.value)}
+                  className="h-11"
+                />
+              </div>
+
+              <div className="space-y-2">
+                <Label htmlFor="tags">
+                  tags{" "}
+                  <span className="text-muted-foreground font-normal">
+                    (comma-separated)
+                  </span>
+                </Label>
+                <Input
+                  id="tags"
+                  name="tags"
+                  placeholder="e.g. mobile, checkout"
+                  value={data.tags}
+                  onChange={(e) => setData("tags", e.target.value)}
+                  aria-invalid={!!errors?.Tags}
+                  className="h-11"
+                />
+                {errors?.Tags?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
               <div className="flex items-center gap-3 pt-2">
                 <button
                   type="submit"
@@ -1,5 +1,5 @@
 import { Link, useForm, usePage } from "@inertiajs/react";
-import { FormEventHandler, useState, useMemo } from "react";
+import { FormEventHandler, useCallback, useState, useMemo } from "react";
 import { SharedProps } from "@/types/global";
 import TerminalLayout from "@/Layouts/TerminalLayout";
 import { Input } from "@/components/ui/input";
@@ -14,49 +14,120 @@ import {
 import InputError from "@/components/InputError";
 import { Loader2 } from "lucide-react";
 import StrategyList from "./components/StrategyList";
+import type { StrategyDefinition } from "./components/StrategyParams";
+import type { ContextField } from "./components/ConstraintRow";
+import PromotePanel from "./components/PromotePanel";
+import PrerequisiteList from "./components/PrerequisiteList";
+import HistoryPanel from "./components/HistoryPanel";
+import FreezeBanner, { Freeze } from "@/components/FreezeBanner";
 
 interface EnvItem {
   id: number;
   name: string;
   type: string;
   sortOrder: number;
+  freeze: Freeze | null;
 }
 
 interface ToggleState {
   environmentId: number;
   enabled: boolean;
+  revision: number;
+}
+
+interface Dependent {
+  flag_id: number;
+  flag: string;
+  environment: string;
+  enabled: boolean;
+  variant?: string;
+}
+
+interface FlagLink {
+  title?: string;
+  url: string;
 }
 
 interface Props {
-  project: { id: number; name: string };
+  project: { id: number; name: string; freeze: Freeze | null };
   flag: {
     id: number;
     name: string;
     description: string;
     flagType: string;
+    owner: string;
+    tags: string[];
+    links: FlagLink[] | null;
+    metadata: Record<string, string> | null;
   };
   environments: EnvItem[];
   toggles: ToggleState[];
+  dependents: Dependent[];
+  projectFlags: string[];
+  strategies: StrategyDefinition[];
+  contextFields: ContextField[];
+  operators: Record<string, string[]>;
 }
 
 export default function Edit() {
-  const { project, flag, environments, toggles, auth } = usePage<
-    SharedProps & Props
-  >().props;
+  const {
+    project,
+    flag,
+    environments,
+    toggles,
+    dependents,
+    projectFlags,
+    strategies,
+    contextFields,
+    operators,
+    auth,
+  } = usePage<SharedProps & Props>().props;
   const errors = usePage().props.errors as Record<string, string[]> | undefined;
   const canMutate = auth?.user?.role === "admin" || auth?.user?.role === "editor";
 
-  const { data, setData, put, processing } = useForm({
+  const { data, setData, put, processing, transform } = useForm({
     name: flag.name,
     description: flag.description || "",
     flag_type: flag.flagType,
+    owner: flag.owner || "",
+    tags: flag.tags.join(", "),
+    links: (flag.links ?? []).map((l) => ({ title: l.title ?? "", url: l.url })),
+    metadata: Object.entries(flag.metadata ?? {}).map(([key, value]) => ({
+      key,
+      value,
+    })),
   });
 
+  // Metadata is edited as rows but stored as an object; blank rows are dropped.
+  transform((d) => ({
+    ...d,
+    links: d.links.filter((l) => l.url.trim() !== ""),
+    metadata: Object.fromEntries(
+      d.metadata
+        .filter((m) => m.key.trim() !== "")
+        .map((m) => [m.key.trim(), m.value]),
+    ),
+  }));
+
   const submit: FormEventHandler = (e) => {
     e.preventDefault();
     put(`/projects/${project.id}/flags/${flag.id}`);
   };
 
+  const setLink = (i: number, field: "title" | "url", value: string) => {
+    setData(
+      "links",
+      data.links.map((l, j) => (j === i ? { ...l, [field]: value } : l)),
+    );
+  };
+
+  const setMeta = (i: number, field: "key" | "value", value: string) => {
+    setData(
+      "metadata",
+      data.metadata.map((m, j) => (j === i ? { ...m, [field]: value } : m)),
+    );
+  };
+
   const sortedEnvs = useMemo(
     () => [...(environments ?? [])].sort((a, b) => a.sortOrder - b.sortOrder),
     [environments]
@@ -66,6 +137,33 @@ export default function Edit() {
     sortedEnvs.length > 0 ? sortedEnvs[0].id : null
   );
 
+  // Bumped after a promotion or restore to reload the strategy list.
+  const [refreshKey, setRefreshKey] = useState(0);
+
+  // The revision of each environment's config as last read by this page. It
+  // is sent with every change, so that a change made by someone else in the
+  // meantime is reported instead of overwritten.
+  const [revisions, setRevisions] = useState<Record<number, number>>(() =>
+    Object.fromEntries((toggles ?? []).map((t) => [t.environmentId, t.revision])),
+  );
+  const [conflict, setConflict] = useState(false);
+
+  const setRevision = useCallback(
+    (revision: number) => {
+      if (selectedEnvId === null) return;
+      setRevisions((cur) => ({ ...cur, [selectedEnvId]: revision }));
+    },
+    [selectedEnvId],
+  );
+  const onConflict = useCallback(() => setConflict(true), []);
+
+  const reload = () => {
+    setConflict(false);
+    setRefreshKey((k) => k + 1);
+  };
+
+  const revision = selectedEnvId !== null ? revisions[selectedEnvId] ?? 0 : 0;
+
   const csrfToken = useMemo(() => {
     const cookie = document.cookie
       .split("; ")
@@ -92,6 +190,12 @@ export default function Edit() {
           </p>
         </div>
 
+        <FreezeBanner
+          projectId={project.id}
+          freeze={project.freeze}
+          environments={environments ?? []}
+        />
+
         {/* Flag metadata form */}
         <div className="bg-card border border-border p-6 mb-8">
           <form onSubmit={submit} className="space-y-5">
@@ -149,6 +253,138 @@ export default function Edit() {
               ))}
             </div>
 
+            <div className="space-y-2">
+              <Label htmlFor="owner">
+                owner{" "}
+                <span className="text-muted-foreground font-normal">
+                  (optional)
+                </span>
+              </Label>
+              <Input
+                id="owner"
+                name="owner"
+                placeholder="e.g. team-checkout or jane@example.com"
+                value={data.owner}
+                onChange={(e) => setData("owner", e.target.value)}
+                className="h-11"
+              />
+            </div>
+
+            <div className="space-y-2">
+              <Label htmlFor="tags">
+                tags{" "}
+                <span className="text-muted-foreground font-normal">
+                  (comma-separated)
+                </span>
+              </Label>
+              <Input
+                id="tags"
+                name="tags"
+                placeholder="e.g. mobile, checkout"
+                value={data.tags}
+                onChange={(e) => setData("tags", e.target.value)}
+                aria-invalid={!!errors?.Tags}
+                className="h-11"
+              />
+              {errors?.Tags?.map((msg, i) => (
+                <InputError key={i} message={msg} />
+              ))}
+            </div>
+
+            <div className="space-y-2">
+              <Label>
+                links{" "}
+                <span className="text-muted-foreground font-normal">
+                  (tickets, pull requests, docs)
+                </span>
+              </Label>
+              {data.links.map((link, i) => (
+                <div key={i} className="flex items-center gap-2">
+                  <Input
+                    aria-label="Link title"
+                    placeholder="title"
+                    value={link.title}
+                    onChange={(e) => setLink(i, "title", e.target.value)}
+                    className="h-9 w-40"
+                  />
+                  <Input
+                    aria-label="Link URL"
+                    placeholder="https://"
+                    value={link.url}
+                    onChange={(e) => setLink(i, "url", e.target.value)}
+                    className="h-9 flex-1"
+                  />
+                  <button
+                    type="button"
+                    className="text-xs text-destructive hover:text-destructive/80 transition-colors"
+                    onClick={() =>
+                      setData("links", data.links.filter((_, j) => j !== i))
+                    }
+                  >
+                    [x]
+                  </button>
+                </div>
+              ))}
+              <button
+                type="button"
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors"
+                onClick={() =>
+                  setData("links", [...data.links, { title: "", url: "" }])
+                }
+              >
+                [+ link]
+              </button>
+              {errors?.Links?.map((msg, i) => (
+                <InputError key={i} message={msg} />
+              ))}
+            </div>
+
+            <div className="space-y-2">
+              <Label>metadata</Label>
+              {data.metadata.map((m, i) => (
+                <div key={i} className="flex items-center gap-2">
+                  <Input
+                    aria-label="Metadata key"
+                    placeholder="key"
+                    value={m.key}
+                    onChange={(e) => setMeta(i, "key", e.target.value)}
+                    className="h-9 w-40"
+                  />
+                  <Input
+                    aria-label="Metadata value"
+                    placeholder="value"
+                    value={m.value}
+                    onChange={(e) => setMeta(i, "value", e.target.value)}
+                    className="h-9 flex-1"
+                  />
+                  <button
+                    type="button"
+                    className="text-xs text-destructive hover:text-destructive/80 transition-colors"
+                    onClick={() =>
+                      setData(
+                        "metadata",
+                        data.metadata.filter((_, j) => j !== i),
+                      )
+                    }
+                  >
+                    [x]
+                  </button>
+                </div>
+              ))}
+              <button
+                type="button"
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors"
+                onClick={() =>
+                  setData("metadata", [...data.metadata, { key: "", value: "" }])
+                }
+              >
+                [+ key]
+              </button>
+              {errors?.Metadata?.map((msg, i) => (
+                <InputError key={i} message={msg} />
+              ))}
+            </div>
+
             {canMutate && (
               <div className="flex items-center gap-3 pt-2">
                 <button
@@ -222,19 +458,141 @@ export default function Edit() {
                 ))}
               </div>
 
+              {conflict && (
+                <div className="flex items-center justify-between border border-amber-500/50 bg-amber-500/10 px-3 py-2 mb-5 text-xs">
+                  <span className="text-amber-400">
+                    ! this flag changed since you opened it; your last change
+                    was not saved
+                  </span>
+                  <button
+                    type="button"
+                    onClick={reload}
+                    className="text-foreground hover:underline"
+                  >
+                    [reload]
+                  </button>
+                </div>
+              )}
+
               {/* Strategy list for selected env */}
               {selectedEnvId && (
                 <StrategyList
-                  key={selectedEnvId}
+                  key={`${selectedEnvId}-${refreshKey}`}
                   projectId={project.id}
                   flagId={flag.id}
                   environmentId={selectedEnvId}
                   csrfToken={csrfToken}
+                  definitions={strategies ?? []}
+                  context={{ fields: contextFields ?? [], operators: operators ?? {} }}
+                  revision={revision}
+                  onRevision={setRevision}
+                  onConflict={onConflict}
                 />
               )}
+
+              {/* Prerequisites for selected env */}
+              {selectedEnvId && (
+                <div className="mt-6 pt-5 border-t border-border">
+                  <h3 className="text-xs font-semibold text-foreground mb-1">
+                    // prerequisites
+                  </h3>
+                  <p className="text-xs text-muted-foreground mb-3">
+                    This flag is only evaluated when every parent flag is in
+                    the required state
+                  </p>
+                  <PrerequisiteList
+                    key={`${selectedEnvId}-${refreshKey}`}
+                    projectId={project.id}
+                    flagId={flag.id}
+                    environmentId={selectedEnvId}
+                    csrfToken={csrfToken}
+                    flagNames={projectFlags ?? []}
+                    canMutate={canMutate}
+                    revision={revision}
+                    onRevision={setRevision}
+                    onConflict={onConflict}
+                  />
+                </div>
+              )}
+
+              {/* Version history for selected env */}
+              {selectedEnvId && (
+                <div className="mt-6 pt-5 border-t border-border">
+                  <h3 className="text-xs font-semibold text-foreground mb-1">
+                    // history
+                  </h3>
+                  <p className="text-xs text-muted-foreground mb-3">
+                    Every change is recorded; restore a version to roll back
+                  </p>
+                  <HistoryPanel
+                    key={`${selectedEnvId}-${refreshKey}`}
+                    projectId={project.id}
+                    flagId={flag.id}
+                    environmentId={selectedEnvId}
+                    csrfToken={csrfToken}
+                    canMutate={canMutate}
+                    revision={revision}
+                    onRestored={() => setRefreshKey((k) => k + 1)}
+                    onConflict={onConflict}
+                  />
+                </div>
+              )}
             </>
           )}
         </div>
+
+        {/* Flags depending on this one */}
+        {dependents && dependents.length > 0 && (
+          <div className="bg-card border border-border p-6 mt-8">
+            <div className="mb-4">
+              <h2 className="text-sm font-semibold text-foreground">
+                // dependents
+              </h2>
+              <p className="text-xs text-muted-foreground mt-1">
+                Flags that require this flag; it cannot be deleted while they
+                do
+              </p>
+            </div>
+            <div className="space-y-1 text-xs">
+              {dependents.map((d) => (
+                <div key={`${d.flag_id}-${d.environment}`}>
+                  <Link
+                    href={`/projects/${project.id}/flags/${d.flag_id}/edit`}
+                    className="text-foreground hover:underline"
+                  >
+                    {d.flag}
+                  </Link>{" "}
+                  <span className="text-muted-foreground">
+                    @ {d.environment} requires [{d.enabled ? "on" : "off"}]
+                    {d.variant && ` variant=${d.variant}`}
+                  </span>
+                </div>
+              ))}
+            </div>
+          </div>
+        )}
+
+        {/* Promote between environments */}
+        {canMutate && sortedEnvs.length > 1 && (
+          <div className="bg-card border border-border p-6 mt-8">
+            <div className="mb-5">
+              <h2 className="text-sm font-semibold text-foreground">
+                // promote
+              </h2>
+              <p className="text-xs text-muted-foreground mt-1">
+                Copy the enabled state and strategies of this flag from one
+                environment to another
+              </p>
+            </div>
+            <PromotePanel
+              projectId={project.id}
+              flagId={flag.id}
+              environments={sortedEnvs}
+              csrfToken={csrfToken}
+              onPromoted={() => setRefreshKey((k) => k + 1)}
+            />
+          </div>
+        )}
       </div>
     </TerminalLayout>
   );
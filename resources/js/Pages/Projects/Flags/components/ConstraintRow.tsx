@@ -9,6 +9,7 @@ import {
 import { Checkbox } from "@/components/ui/checkbox";
 import { Button } from "@/components/ui/button";
 import { X } from "lucide-react";
+import { useId } from "react";
 
 const OPERATORS = [
   "IN",
@@ -23,8 +24,50 @@ const OPERATORS = [
   "NUM_LTE",
   "DATE_AFTER",
   "DATE_BEFORE",
+  "SEMVER_EQ",
+  "SEMVER_GT",
+  "SEMVER_LT",
+  "REGEX",
+  "IN_CIDR",
+  "ANY_OF",
+  "ALL_OF",
 ] as const;
 
+export interface ContextField {
+  id?: number;
+  name: string;
+  description?: string;
+  type: "string" | "number" | "date" | "semver" | "ip";
+  legal_values?: string[];
+  builtin?: boolean;
+}
+
+// ContextRegistry is what constraints may test: the context fields of the
+// project and the operators allowed for each field type.
+export interface ContextRegistry {
+  fields: ContextField[];
+  operators: Record<string, string[]>;
+}
+
+const VALUE_PLACEHOLDERS: Record<ContextField["type"], string> = {
+  string: "Values (comma-separated)",
+  number: "Numbers (comma-separated)",
+  date: "Dates, e.g. 2025-01-31 or 2025-01-31T12:00:00Z",
+  semver: "Versions, e.g. 1.4.0",
+  ip: "IP addresses (comma-separated)",
+};
+
+// Operators with a fixed operand format hint it whatever the field type.
+const OPERATOR_PLACEHOLDERS: Record<string, string> = {
+  SEMVER_EQ: VALUE_PLACEHOLDERS.semver,
+  SEMVER_GT: VALUE_PLACEHOLDERS.semver,
+  SEMVER_LT: VALUE_PLACEHOLDERS.semver,
+  REGEX: "RE2 patterns, e.g. ^[a-z]+@acme\\.com$",
+  IN_CIDR: "CIDR ranges, e.g. 10.0.0.0/8",
+  ANY_OF: "Items, matched against the comma-separated context value",
+  ALL_OF: "Items, matched against the comma-separated context value",
+};
+
 export interface ConstraintData {
   context_name: string;
   operator: string;
@@ -35,11 +78,42 @@ export interface ConstraintData {
 
 interface Props {
   constraint: ConstraintData;
+  context?: ContextRegistry;
   onChange: (c: ConstraintData) => void;
   onRemove: () => void;
 }
 
-export default function ConstraintRow({ constraint, onChange, onRemove }: Props) {
+export default function ConstraintRow({
+  constraint,
+  context,
+  onChange,
+  onRemove,
+}: Props) {
+  const listId = useId();
+  const fields = context?.fields ?? [];
+  const field = fields.find((f) => f.name === constraint.context_name);
+  const operators: readonly string[] =
+    (field && context?.operators[field.type]) || OPERATORS;
+  // Once a project registers fields, only listed ones may be tested.
+  const strict = fields.some((f) => !f.builtin);
+  const unknown = strict && constraint.context_name !== "" && !field;
+
+  const setContextName = (name: string) => {
+    const next = fields.find((f) => f.name === name);
+    const allowed = next && context?.operators[next.type];
+    const operator =
+      allowed && !allowed.includes(constraint.operator) ? allowed[0] : constraint.operator;
+    onChange({ ...constraint, context_name: name, operator });
+  };
+
+  const toggleValue = (v: string) =>
+    onChange({
+      ...constraint,
+      values: constraint.values.includes(v)
+        ? constraint.values.filter((x) => x !== v)
+        : [...constraint.values, v],
+    });
+
   return (
     <div className="border border-border p-3 space-y-3 bg-muted/30">
       <div className="flex items-start gap-2">
@@ -47,11 +121,29 @@ export default function ConstraintRow({ constraint, onChange, onRemove }: Props)
           <Input
             placeholder="Context name (e.g. userId, companyId)"
             value={constraint.context_name}
-            onChange={(e) =>
-              onChange({ ...constraint, context_name: e.target.value })
-            }
+            onChange={(e) => setContextName(e.target.value)}
+            list={listId}
+            aria-invalid={unknown}
             className="h-9 text-sm"
           />
+          <datalist id={listId}>
+            {fields.map((f) => (
+              <option key={f.name} value={f.name}>
+                {f.description ? `${f.type} — ${f.description}` : f.type}
+              </option>
+            ))}
+          </datalist>
+          {field && (
+            <p className="text-xs text-muted-foreground">
+              {field.type}
+              {field.description && ` · ${field.description}`}
+            </p>
+          )}
+          {unknown && (
+            <p className="text-xs text-muted-foreground">
+              not a registered context field
+            </p>
+          )}
           <Select
             value={constraint.operator}
             onValueChange={(v) => onChange({ ...constraint, operator: v })}
@@ -60,7 +152,7 @@ export default function ConstraintRow({ constraint, onChange, onRemove }: Props)
               <SelectValue placeholder="Operator" />
             </SelectTrigger>
             <SelectContent>
-              {OPERATORS.map((op) => (
+              {operators.map((op) => (
                 <SelectItem key={op} value={op}>
                   {op.replace(/_/g, " ")}
                 </SelectItem>
@@ -68,7 +160,10 @@ export default function ConstraintRow({ constraint, onChange, onRemove }: Props)
             </SelectContent>
           </Select>
           <Input
-            placeholder="Values (comma-separated)"
+            placeholder={
+              OPERATOR_PLACEHOLDERS[constraint.operator] ??
+              VALUE_PLACEHOLDERS[field?.type ?? "string"]
+            }
             value={constraint.values.join(", ")}
             onChange={(e) =>
               onChange({
@@ -81,6 +176,24 @@ export default function ConstraintRow({ constraint, onChange, onRemove }: Props)
             }
             className="h-9 text-sm"
           />
+          {(field?.legal_values ?? []).length > 0 && (
+            <div className="flex flex-wrap gap-1">
+              {field!.legal_values!.map((v) => (
+                <button
+                  key={v}
+                  type="button"
+                  onClick={() => toggleValue(v)}
+                  className={`text-xs px-2 py-0.5 border transition-colors ${
+                    constraint.values.includes(v)
+                      ? "border-primary text-foreground bg-primary/10"
+                      : "border-border text-muted-foreground hover:text-foreground"
+                  }`}
+                >
+                  {v}
+                </button>
+              ))}
+            </div>
+          )}
         </div>
         <Button
           variant="ghost"
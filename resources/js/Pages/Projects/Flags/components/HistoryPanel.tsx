@@ -0,0 +1,248 @@
+import { useState, useEffect, useCallback } from "react";
+import { Loader2 } from "lucide-react";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+
+interface VersionData {
+  version: number;
+  actor?: string;
+  // reason is set on versions recorded by an incident action such as the
+  // kill switch.
+  reason?: string;
+  created_at: string;
+  config: {
+    enabled: boolean;
+    strategies: { name: string }[];
+    prerequisites?: { flag: string }[];
+  };
+}
+
+interface FieldChange {
+  field: string;
+  from: unknown;
+  to: unknown;
+}
+
+interface Props {
+  projectId: number;
+  flagId: number;
+  environmentId: number;
+  csrfToken: string;
+  canMutate: boolean;
+  revision: number;
+  onRestored: () => void;
+  onConflict: () => void;
+}
+
+const show = (v: unknown) => (v === null || v === undefined ? "—" : JSON.stringify(v));
+
+export default function HistoryPanel({
+  projectId,
+  flagId,
+  environmentId,
+  csrfToken,
+  canMutate,
+  revision,
+  onRestored,
+  onConflict,
+}: Props) {
+  const [versions, setVersions] = useState<VersionData[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [from, setFrom] = useState("");
+  const [to, setTo] = useState("");
+  const [changes, setChanges] = useState<FieldChange[] | null>(null);
+  const [restoring, setRestoring] = useState<number | null>(null);
+  const [error, setError] = useState<string | null>(null);
+
+  const basePath = `/projects/${projectId}/flags/${flagId}/versions`;
+
+  const fetchVersions = useCallback(async () => {
+    setLoading(true);
+    try {
+      const res = await fetch(`${basePath}?env=${environmentId}`, {
+        headers: { "X-XSRF-TOKEN": csrfToken },
+      });
+      if (res.ok) {
+        const data = await res.json();
+        const list: VersionData[] = data.versions ?? [];
+        setVersions(list);
+        // Default to the latest change.
+        if (list.length > 1) {
+          setFrom(String(list[1].version));
+          setTo(String(list[0].version));
+        }
+      }
+    } finally {
+      setLoading(false);
+    }
+  }, [basePath, environmentId, csrfToken]);
+
+  useEffect(() => {
+    fetchVersions();
+  }, [fetchVersions]);
+
+  const handleDiff = async () => {
+    setError(null);
+    const res = await fetch(
+      `${basePath}/diff?env=${environmentId}&from=${from}&to=${to}`,
+      { headers: { "X-XSRF-TOKEN": csrfToken } }
+    );
+    const data = await res.json();
+    if (!res.ok) {
+      setError(data.error || "Failed to diff versions");
+      return;
+    }
+    setChanges(data.changes ?? []);
+  };
+
+  const handleRestore = async (version: number) => {
+    if (!confirm(`Restore version ${version}? The current config is kept in history.`)) return;
+    setRestoring(version);
+    setError(null);
+    try {
+      const res = await fetch(`${basePath}/${version}/restore`, {
+        method: "POST",
+        headers: {
+          "Content-Type": "application/json",
+          "X-XSRF-TOKEN": csrfToken,
+        },
+        body: JSON.stringify({ environment_id: environmentId, revision }),
+      });
+      if (res.status === 409) {
+        onConflict();
+        return;
+      }
+      if (!res.ok) {
+        const data = await res.json();
+        const fields = data.fields ? Object.values(data.fields).join(", ") : "";
+        setError(fields || data.error || "Failed to restore version");
+        return;
+      }
+      setChanges(null);
+      await fetchVersions();
+      onRestored();
+    } finally {
+      setRestoring(null);
+    }
+  };
+
+  if (loading) {
+    return (
+      <div className="flex items-center justify-center py-6 text-muted-foreground text-sm">
+        <Loader2 className="w-4 h-4 animate-spin mr-2" />
+        loading history...
+      </div>
+    );
+  }
+
+  if (versions.length === 0) {
+    return (
+      <p className="text-xs text-muted-foreground">
+        {">"} no recorded versions — history starts with the next change
+      </p>
+    );
+  }
+
+  return (
+    <div className="space-y-3">
+      <div className="space-y-1">
+        {versions.map((v, i) => (
+          <div
+            key={v.version}
+            className="flex items-center justify-between border border-border px-3 py-2 text-xs"
+          >
+            <span className="text-foreground">
+              <span className="font-medium">v{v.version}</span>{" "}
+              <span className="text-muted-foreground">
+                [{v.config.enabled ? "on" : "off"}] {v.config.strategies.length}{" "}
+                strategies
+                {v.config.prerequisites?.length
+                  ? `, ${v.config.prerequisites.length} prerequisites`
+                  : ""}{" "}
+                · {new Date(v.created_at).toLocaleString()}
+                {v.actor && ` · ${v.actor}`}
+                {v.reason && ` · "${v.reason}"`}
+              </span>
+            </span>
+            {i === 0 ? (
+              <span className="text-muted-foreground">current</span>
+            ) : (
+              canMutate && (
+                <button
+                  type="button"
+                  disabled={restoring !== null}
+                  onClick={() => handleRestore(v.version)}
+                  className="text-muted-foreground hover:text-foreground transition-colors disabled:opacity-50"
+                >
+                  {restoring === v.version ? "restoring..." : "[restore]"}
+                </button>
+              )
+            )}
+          </div>
+        ))}
+      </div>
+
+      {versions.length > 1 && (
+        <div className="flex flex-wrap items-center gap-2 text-xs">
+          <Select value={from} onValueChange={setFrom}>
+            <SelectTrigger className="w-24 h-9">
+              <SelectValue placeholder="from" />
+            </SelectTrigger>
+            <SelectContent>
+              {versions.map((v) => (
+                <SelectItem key={v.version} value={String(v.version)}>
+                  v{v.version}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <span className="text-muted-foreground">→</span>
+          <Select value={to} onValueChange={setTo}>
+            <SelectTrigger className="w-24 h-9">
+              <SelectValue placeholder="to" />
+            </SelectTrigger>
+            <SelectContent>
+              {versions.map((v) => (
+                <SelectItem key={v.version} value={String(v.version)}>
+                  v{v.version}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <button
+            type="button"
+            disabled={!from || !to}
+            onClick={handleDiff}
+            className="text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5 disabled:opacity-50"
+          >
+            [diff]
+          </button>
+        </div>
+      )}
+
+      {changes && (
+        <div className="border border-border px-3 py-2 text-xs space-y-1">
+          {changes.length === 0 ? (
+            <p className="text-muted-foreground">{">"} no differences</p>
+          ) : (
+            changes.map((c) => (
+              <div key={c.field} className="font-mono">
+                <span className="text-foreground">{c.field}</span>{" "}
+                <span className="text-destructive">{show(c.from)}</span>
+                {" → "}
+                <span className="text-green-600">{show(c.to)}</span>
+              </div>
+            ))
+          )}
+        </div>
+      )}
+
+      {error && <p className="text-xs text-destructive">{error}</p>}
+    </div>
+  );
+}
@@ -0,0 +1,212 @@
+import { useState, useEffect, useCallback } from "react";
+import { Loader2 } from "lucide-react";
+import { Input } from "@/components/ui/input";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+
+export interface PrerequisiteData {
+  flag: string;
+  enabled: boolean;
+  variant: string;
+}
+
+interface Props {
+  projectId: number;
+  flagId: number;
+  environmentId: number;
+  csrfToken: string;
+  flagNames: string[];
+  canMutate: boolean;
+  revision: number;
+  onRevision: (revision: number) => void;
+  onConflict: () => void;
+}
+
+export default function PrerequisiteList({
+  projectId,
+  flagId,
+  environmentId,
+  csrfToken,
+  flagNames,
+  canMutate,
+  revision,
+  onRevision,
+  onConflict,
+}: Props) {
+  const [prerequisites, setPrerequisites] = useState<PrerequisiteData[]>([]);
+  const [loading, setLoading] = useState(true);
+  const [saving, setSaving] = useState(false);
+  const [error, setError] = useState<string | null>(null);
+  const [newFlag, setNewFlag] = useState("");
+  const [newState, setNewState] = useState("on");
+  const [newVariant, setNewVariant] = useState("");
+
+  const basePath = `/projects/${projectId}/flags/${flagId}/prerequisites`;
+
+  const fetchPrerequisites = useCallback(async () => {
+    setLoading(true);
+    try {
+      const res = await fetch(`${basePath}?env=${environmentId}`, {
+        headers: { "X-XSRF-TOKEN": csrfToken },
+      });
+      if (res.ok) {
+        const data = await res.json();
+        setPrerequisites(data.prerequisites ?? []);
+        onRevision(data.revision);
+      }
+    } finally {
+      setLoading(false);
+    }
+  }, [basePath, environmentId, csrfToken, onRevision]);
+
+  useEffect(() => {
+    fetchPrerequisites();
+  }, [fetchPrerequisites]);
+
+  // The whole set is replaced on every change.
+  const save = async (next: PrerequisiteData[]) => {
+    setSaving(true);
+    setError(null);
+    try {
+      const res = await fetch(basePath, {
+        method: "PUT",
+        headers: {
+          "Content-Type": "application/json",
+          "X-XSRF-TOKEN": csrfToken,
+        },
+        body: JSON.stringify({
+          environment_id: environmentId,
+          prerequisites: next,
+          revision,
+        }),
+      });
+      if (res.status === 409) {
+        onConflict();
+        return false;
+      }
+      if (!res.ok) {
+        const data = await res.json();
+        const fields = data.fields ? Object.values(data.fields).join(", ") : "";
+        setError(fields || data.error || "Failed to update prerequisites");
+        return false;
+      }
+      await fetchPrerequisites();
+      return true;
+    } finally {
+      setSaving(false);
+    }
+  };
+
+  const handleAdd = async () => {
+    if (!newFlag) return;
+    const added = await save([
+      ...prerequisites,
+      { flag: newFlag, enabled: newState === "on", variant: newVariant },
+    ]);
+    if (added) {
+      setNewFlag("");
+      setNewState("on");
+      setNewVariant("");
+    }
+  };
+
+  const handleRemove = (flag: string) =>
+    save(prerequisites.filter((p) => p.flag !== flag));
+
+  const available = flagNames.filter(
+    (name) => !prerequisites.some((p) => p.flag === name)
+  );
+
+  if (loading) {
+    return (
+      <div className="flex items-center justify-center py-6 text-muted-foreground text-sm">
+        <Loader2 className="w-4 h-4 animate-spin mr-2" />
+        loading prerequisites...
+      </div>
+    );
+  }
+
+  return (
+    <div className="space-y-3">
+      {prerequisites.length === 0 ? (
+        <p className="text-xs text-muted-foreground">
+          {">"} no prerequisites — flag is evaluated on its own
+        </p>
+      ) : (
+        <div className="space-y-2">
+          {prerequisites.map((p) => (
+            <div
+              key={p.flag}
+              className="flex items-center justify-between border border-border px-3 py-2 text-xs"
+            >
+              <span className="text-foreground">
+                requires <span className="font-medium">{p.flag}</span>{" "}
+                <span className="text-muted-foreground">
+                  [{p.enabled ? "on" : "off"}]
+                  {p.variant && ` variant=${p.variant}`}
+                </span>
+              </span>
+              {canMutate && (
+                <button
+                  type="button"
+                  disabled={saving}
+                  onClick={() => handleRemove(p.flag)}
+                  className="text-destructive hover:text-destructive/80 transition-colors disabled:opacity-50"
+                >
+                  [x]
+                </button>
+              )}
+            </div>
+          ))}
+        </div>
+      )}
+
+      {canMutate && available.length > 0 && (
+        <div className="flex flex-wrap items-center gap-2">
+          <Select value={newFlag} onValueChange={setNewFlag}>
+            <SelectTrigger className="w-48 h-9">
+              <SelectValue placeholder="Parent flag" />
+            </SelectTrigger>
+            <SelectContent>
+              {available.map((name) => (
+                <SelectItem key={name} value={name}>
+                  {name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+          <Select value={newState} onValueChange={setNewState}>
+            <SelectTrigger className="w-24 h-9">
+              <SelectValue />
+            </SelectTrigger>
+            <SelectContent>
+              <SelectItem value="on">on</SelectItem>
+              <SelectItem value="off">off</SelectItem>
+            </SelectContent>
+          </Select>
+          <Input
+            placeholder="variant (optional)"
+            value={newVariant}
+            onChange={(e) => setNewVariant(e.target.value)}
+            className="w-40 h-9"
+          />
+          <button
+            type="button"
+            disabled={saving || !newFlag}
+            onClick={handleAdd}
+            className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5 disabled:opacity-50"
+          >
+            [+ add_prerequisite]
+          </button>
+        </div>
+      )}
+
+      {error && <p className="text-xs text-destructive">{error}</p>}
+    </div>
+  );
+}
@@ -0,0 +1,222 @@
+import { useState } from "react";
+import { Loader2 } from "lucide-react";
+import { Checkbox } from "@/components/ui/checkbox";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+
+interface EnvItem {
+  id: number;
+  name: string;
+}
+
+interface FieldChange {
+  field: string;
+  from: any;
+  to: any;
+}
+
+interface Change {
+  action: string;
+  kind: string;
+  name: string;
+  environment?: string;
+  fields?: FieldChange[];
+}
+
+interface Props {
+  projectId: number;
+  flagId: number;
+  environments: EnvItem[];
+  csrfToken: string;
+  onPromoted: () => void;
+}
+
+export default function PromotePanel({
+  projectId,
+  flagId,
+  environments,
+  csrfToken,
+  onPromoted,
+}: Props) {
+  const [source, setSource] = useState(environments[0]?.name ?? "");
+  const [target, setTarget] = useState(environments[1]?.name ?? "");
+  const [copyEnabled, setCopyEnabled] = useState(true);
+  const [copyStrategies, setCopyStrategies] = useState(true);
+  const [preview, setPreview] = useState<Change[] | null>(null);
+  const [error, setError] = useState<string | null>(null);
+  const [loading, setLoading] = useState(false);
+
+  const run = async (dryRun: boolean) => {
+    setLoading(true);
+    setError(null);
+    try {
+      const res = await fetch(`/projects/${projectId}/flags/${flagId}/promote`, {
+        method: "POST",
+        headers: {
+          "Content-Type": "application/json",
+          "X-XSRF-TOKEN": csrfToken,
+        },
+        body: JSON.stringify({
+          source,
+          target,
+          enabled: copyEnabled,
+          strategies: copyStrategies,
+          dry_run: dryRun,
+        }),
+      });
+      const data = await res.json();
+      if (!res.ok) {
+        const fields = data.fields ? Object.values(data.fields).join(", ") : "";
+        setError(fields || data.error || "Failed to promote");
+        return;
+      }
+      if (dryRun) {
+        setPreview(data.changes ?? []);
+      } else {
+        setPreview(null);
+        onPromoted();
+      }
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  const reset = () => setPreview(null);
+
+  return (
+    <div className="space-y-4">
+      <div className="grid grid-cols-1 sm:grid-cols-2 gap-4">
+        <div className="space-y-2">
+          <Label>from</Label>
+          <Select
+            value={source}
+            onValueChange={(v) => {
+              setSource(v);
+              reset();
+            }}
+          >
+            <SelectTrigger className="w-full h-11">
+              <SelectValue placeholder="Source environment" />
+            </SelectTrigger>
+            <SelectContent>
+              {environments.map((env) => (
+                <SelectItem key={env.id} value={env.name}>
+                  {env.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+        <div className="space-y-2">
+          <Label>to</Label>
+          <Select
+            value={target}
+            onValueChange={(v) => {
+              setTarget(v);
+              reset();
+            }}
+          >
+            <SelectTrigger className="w-full h-11">
+              <SelectValue placeholder="Target environment" />
+            </SelectTrigger>
+            <SelectContent>
+              {environments.map((env) => (
+                <SelectItem key={env.id} value={env.name}>
+                  {env.name}
+                </SelectItem>
+              ))}
+            </SelectContent>
+          </Select>
+        </div>
+      </div>
+
+      <div className="flex items-center gap-4">
+        <label className="flex items-center gap-2 text-xs text-muted-foreground">
+          <Checkbox
+            checked={copyEnabled}
+            onCheckedChange={(checked) => {
+              setCopyEnabled(checked === true);
+              reset();
+            }}
+          />
+          enabled state
+        </label>
+        <label className="flex items-center gap-2 text-xs text-muted-foreground">
+          <Checkbox
+            checked={copyStrategies}
+            onCheckedChange={(checked) => {
+              setCopyStrategies(checked === true);
+              reset();
+            }}
+          />
+          strategies &amp; constraints
+        </label>
+      </div>
+
+      {error && <p className="text-xs text-destructive">{error}</p>}
+
+      {preview && (
+        <div className="bg-muted p-3 text-xs space-y-2">
+          {preview.length === 0 ? (
+            <p className="text-muted-foreground">
+              {">"} {target} already matches {source}
+            </p>
+          ) : (
+            preview.map((change, i) => (
+              <div key={i} className="space-y-1">
+                <p className="text-foreground">
+                  [{change.action}] {change.name} @ {change.environment}
+                </p>
+                {change.fields?.map((f) => (
+                  <div key={f.field} className="pl-3 text-muted-foreground">
+                    <p>{f.field}:</p>
+                    <pre className="whitespace-pre-wrap break-all text-destructive">
+                      - {JSON.stringify(f.from)}
+                    </pre>
+                    <pre className="whitespace-pre-wrap break-all text-primary">
+                      + {JSON.stringify(f.to)}
+                    </pre>
+                  </div>
+                ))}
+              </div>
+            ))
+          )}
+        </div>
+      )}
+
+      <div className="flex items-center gap-3">
+        <button
+          type="button"
+          disabled={loading || !source || !target}
+          onClick={() => run(true)}
+          className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5 disabled:opacity-50"
+        >
+          [preview]
+        </button>
+        {preview && preview.length > 0 && (
+          <button
+            type="button"
+            disabled={loading}
+            onClick={() => run(false)}
+            className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors disabled:opacity-50"
+          >
+            {loading ? (
+              <>
+                <Loader2 className="w-4 h-4 animate-spin" />
+                promoting...
+              </>
+            ) : (
+              `[promote to ${target}]`
+            )}
+          </button>
+        )}
+      </div>
+    </div>
+  );
+}
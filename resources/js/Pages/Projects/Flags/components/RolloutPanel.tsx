@@ -0,0 +1,213 @@
+import { useState } from "react";
+
+export interface RolloutPlanData {
+  id: number;
+  strategy_id: number;
+  status: "running" | "paused" | "completed" | "aborted";
+  status_reason: string;
+  steps: { percentage: number; hold: string }[];
+  current_step: number;
+  percentage: number;
+  next_step_at: string | null;
+  created_by: string;
+}
+
+interface StepInput {
+  percentage: string;
+  hold: string;
+}
+
+interface Props {
+  // plan is the latest plan of the strategy, if any.
+  plan: RolloutPlanData | undefined;
+  strategyId: number;
+  basePath: string;
+  csrfToken: string;
+  onChanged: () => void;
+}
+
+const defaultSteps: StepInput[] = [
+  { percentage: "1", hold: "24h" },
+  { percentage: "5", hold: "24h" },
+  { percentage: "25", hold: "48h" },
+  { percentage: "100", hold: "" },
+];
+
+// RolloutPanel shows the progress of the rollout plan of a gradualRollout
+// strategy, and schedules a new ramp-up when none is in progress.
+export default function RolloutPanel({ plan, strategyId, basePath, csrfToken, onChanged }: Props) {
+  const [editing, setEditing] = useState(false);
+  const [steps, setSteps] = useState<StepInput[]>(defaultSteps);
+  const [error, setError] = useState<string | null>(null);
+  const [busy, setBusy] = useState(false);
+
+  const post = async (url: string, body?: unknown) => {
+    setBusy(true);
+    setError(null);
+    try {
+      const res = await fetch(url, {
+        method: "POST",
+        headers: { "Content-Type": "application/json", "X-XSRF-TOKEN": csrfToken },
+        body: body === undefined ? undefined : JSON.stringify(body),
+      });
+      const data = await res.json().catch(() => null);
+      if (!res.ok) {
+        const fields = data?.fields ? Object.values(data.fields).join(", ") : "";
+        setError(fields || data?.error || "Request failed");
+        return false;
+      }
+      onChanged();
+      return true;
+    } finally {
+      setBusy(false);
+    }
+  };
+
+  const start = async () => {
+    const ok = await post(basePath, {
+      strategy_id: strategyId,
+      steps: steps.map((s) => ({ percentage: Number(s.percentage), hold: s.hold })),
+    });
+    if (ok) setEditing(false);
+  };
+
+  const setStep = (i: number, patch: Partial<StepInput>) =>
+    setSteps((prev) => prev.map((s, j) => (j === i ? { ...s, ...patch } : s)));
+
+  const open = plan && (plan.status === "running" || plan.status === "paused");
+
+  return (
+    <div className="mt-3 pt-3 border-t border-border text-xs">
+      {plan && (
+        <div className="space-y-1">
+          <div className="flex items-center gap-1.5 flex-wrap">
+            <span className="text-muted-foreground">ramp-up [{plan.status}]:</span>
+            {plan.steps.map((s, i) => (
+              <span
+                key={i}
+                className={
+                  i === plan.current_step
+                    ? "text-primary border border-primary/30 px-1"
+                    : i < plan.current_step
+                      ? "text-foreground"
+                      : "text-muted-foreground"
+                }
+              >
+                {s.percentage}%{i < plan.steps.length - 1 && s.hold && ` (${s.hold})`}
+                {i < plan.steps.length - 1 && " →"}
+              </span>
+            ))}
+          </div>
+          {plan.next_step_at && (
+            <p className="text-muted-foreground">
+              next step at {new Date(plan.next_step_at).toLocaleString()}
+            </p>
+          )}
+          {plan.status_reason && <p className="text-muted-foreground">{plan.status_reason}</p>}
+          {open && (
+            <div className="flex items-center gap-3">
+              {plan.status === "running" ? (
+                <button
+                  type="button"
+                  disabled={busy}
+                  onClick={() => post(`${basePath}/${plan.id}/pause`)}
+                  className="text-muted-foreground hover:text-foreground transition-colors"
+                >
+                  [pause]
+                </button>
+              ) : (
+                <button
+                  type="button"
+                  disabled={busy}
+                  onClick={() => post(`${basePath}/${plan.id}/resume`)}
+                  className="text-muted-foreground hover:text-foreground transition-colors"
+                >
+                  [resume]
+                </button>
+              )}
+              <button
+                type="button"
+                disabled={busy}
+                onClick={() => confirm("Abort this ramp-up? The rollout stays where it is.") && post(`${basePath}/${plan.id}/abort`)}
+                className="text-destructive hover:text-destructive/80 transition-colors"
+              >
+                [abort]
+              </button>
+            </div>
+          )}
+        </div>
+      )}
+
+      {!open && !editing && (
+        <button
+          type="button"
+          onClick={() => setEditing(true)}
+          className="text-muted-foreground hover:text-foreground transition-colors mt-1"
+        >
+          [schedule_ramp_up]
+        </button>
+      )}
+
+      {!open && editing && (
+        <div className="space-y-2 mt-1">
+          <p className="text-muted-foreground">
+            Each step sets the rollout, then holds it (e.g. 30m, 24h) before the next one.
+          </p>
+          {steps.map((s, i) => (
+            <div key={i} className="flex items-center gap-2">
+              <input
+                type="number"
+                min={0}
+                max={100}
+                value={s.percentage}
+                onChange={(e) => setStep(i, { percentage: e.target.value })}
+                className="w-16 bg-background border border-border px-2 py-1"
+              />
+              <span className="text-muted-foreground">% hold</span>
+              <input
+                value={s.hold}
+                disabled={i === steps.length - 1}
+                placeholder={i === steps.length - 1 ? "last step" : "24h"}
+                onChange={(e) => setStep(i, { hold: e.target.value })}
+                className="w-24 bg-background border border-border px-2 py-1 disabled:opacity-50"
+              />
+              <button
+                type="button"
+                onClick={() => setSteps((prev) => prev.filter((_, j) => j !== i))}
+                className="text-destructive hover:text-destructive/80"
+              >
+                [x]
+              </button>
+            </div>
+          ))}
+          <div className="flex items-center gap-3">
+            <button
+              type="button"
+              onClick={() => setSteps((prev) => [...prev, { percentage: "", hold: "" }])}
+              className="text-muted-foreground hover:text-foreground"
+            >
+              [+ step]
+            </button>
+            <button
+              type="button"
+              disabled={busy || steps.length === 0}
+              onClick={start}
+              className="text-primary hover:opacity-80"
+            >
+              [start]
+            </button>
+            <button
+              type="button"
+              onClick={() => setEditing(false)}
+              className="text-muted-foreground hover:text-foreground"
+            >
+              [cancel]
+            </button>
+          </div>
+        </div>
+      )}
+
+      {error && <p className="text-destructive mt-1">{error}</p>}
+    </div>
+  );
+}
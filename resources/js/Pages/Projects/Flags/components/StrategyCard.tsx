@@ -1,3 +1,6 @@
+import type { ReactNode } from "react";
+import type { StrategyDefinition } from "./StrategyParams";
+
 export interface StrategyData {
   id: number;
   name: string;
@@ -15,37 +18,45 @@ export interface ConstraintData {
   case_insensitive: boolean;
 }
 
-function paramSummary(name: string, params: Record<string, any> | null): string {
-  if (!params) return "";
-  if (name === "gradualRollout") {
-    return `${params.rollout ?? 0}% rollout, stickiness: ${params.stickiness ?? "default"}`;
-  }
-  if (name === "userWithId") {
-    const ids = (params.userIds ?? "").split("\n").filter(Boolean);
-    return `${ids.length} user${ids.length !== 1 ? "s" : ""}`;
+// paramSummary describes the set parameters of a strategy in one line.
+function paramSummary(
+  def: StrategyDefinition | undefined,
+  params: Record<string, any> | null
+): string {
+  if (!params || !def) return "";
+  const parts: string[] = [];
+  for (const p of def.parameters) {
+    const v = params[p.name];
+    if (v === undefined || v === null || v === "") continue;
+    if (p.type === "list") {
+      const n = String(v).split(/[\n,]/).filter((s) => s.trim()).length;
+      parts.push(`${n} ${p.label.toLowerCase()}`);
+    } else if (p.type === "number" && p.min === 0 && p.max === 100) {
+      parts.push(`${v}% ${p.label.toLowerCase().replace(/ percentage$/, "")}`);
+    } else {
+      parts.push(`${p.label.toLowerCase()}: ${v}`);
+    }
   }
-  if (name === "remoteAddress") {
-    const ips = (params.ips ?? "").split("\n").filter(Boolean);
-    return `${ips.length} IP rule${ips.length !== 1 ? "s" : ""}`;
-  }
-  return "";
+  return parts.join(", ");
 }
 
-const STRATEGY_LABELS: Record<string, string> = {
-  default: "default",
-  gradualRollout: "gradual_rollout",
-  userWithId: "user_targeting",
-  remoteAddress: "ip_filtering",
-};
-
 interface Props {
   strategy: StrategyData;
+  definition: StrategyDefinition | undefined;
   onEdit: () => void;
   onDelete: () => void;
+  // children are shown below the strategy, e.g. its rollout plan.
+  children?: ReactNode;
 }
 
-export default function StrategyCard({ strategy, onEdit, onDelete }: Props) {
-  const summary = paramSummary(strategy.name, strategy.parameters);
+export default function StrategyCard({
+  strategy,
+  definition,
+  onEdit,
+  onDelete,
+  children,
+}: Props) {
+  const summary = paramSummary(definition, strategy.parameters);
 
   return (
     <div className="border border-border p-4 bg-card">
@@ -53,7 +64,7 @@ export default function StrategyCard({ strategy, onEdit, onDelete }: Props) {
         <div className="flex-1 min-w-0">
           <div className="flex items-center gap-2">
             <span className="text-xs font-medium text-primary border border-primary/30 px-1.5 py-0.5">
-              [{STRATEGY_LABELS[strategy.name] ?? strategy.name}]
+              [{definition?.label.split(" ")[0] ?? strategy.name}]
             </span>
             <span className="text-xs text-muted-foreground">
               #{strategy.sort_order}
@@ -86,6 +97,7 @@ export default function StrategyCard({ strategy, onEdit, onDelete }: Props) {
           </button>
         </div>
       </div>
+      {children}
     </div>
   );
 }
@@ -1,14 +1,34 @@
 import { useState, useEffect, useCallback } from "react";
 import { Loader2 } from "lucide-react";
 import StrategyCard, { type StrategyData } from "./StrategyCard";
-import StrategySheet from "./StrategySheet";
-import type { ConstraintData } from "./ConstraintRow";
+import RolloutPanel, { type RolloutPlanData } from "./RolloutPanel";
+import StrategySheet, { StrategyValidationError } from "./StrategySheet";
+import type { ConstraintData, ContextRegistry } from "./ConstraintRow";
+import type { StrategyDefinition } from "./StrategyParams";
 
 interface Props {
   projectId: number;
   flagId: number;
   environmentId: number;
   csrfToken: string;
+  definitions: StrategyDefinition[];
+  context?: ContextRegistry;
+  revision: number;
+  onRevision: (revision: number) => void;
+  onConflict: () => void;
+}
+
+// saveError turns a failed save into an error the sheet can show; validation
+// failures keep their field errors.
+async function saveError(res: Response, fallback: string): Promise<Error> {
+  const body = await res.json().catch(() => null);
+  if (res.status === 409) {
+    return new Error("This flag changed since you opened it");
+  }
+  if (res.status === 422 && body?.fields) {
+    return new StrategyValidationError(body.fields);
+  }
+  return new Error(body?.error ?? fallback);
 }
 
 export default function StrategyList({
@@ -16,28 +36,45 @@ export default function StrategyList({
   flagId,
   environmentId,
   csrfToken,
+  definitions,
+  context,
+  revision,
+  onRevision,
+  onConflict,
 }: Props) {
   const [strategies, setStrategies] = useState<StrategyData[]>([]);
+  const [plans, setPlans] = useState<RolloutPlanData[]>([]);
   const [loading, setLoading] = useState(true);
   const [sheetOpen, setSheetOpen] = useState(false);
   const [editing, setEditing] = useState<StrategyData | null>(null);
 
   const basePath = `/projects/${projectId}/flags/${flagId}/strategies`;
+  const rolloutsPath = `/projects/${projectId}/flags/${flagId}/rollouts`;
 
   const fetchStrategies = useCallback(async () => {
     setLoading(true);
     try {
-      const res = await fetch(`${basePath}?env=${environmentId}`, {
-        headers: { "X-XSRF-TOKEN": csrfToken },
-      });
+      const [res, plansRes] = await Promise.all([
+        fetch(`${basePath}?env=${environmentId}`, {
+          headers: { "X-XSRF-TOKEN": csrfToken },
+        }),
+        fetch(`${rolloutsPath}?env=${environmentId}`, {
+          headers: { "X-XSRF-TOKEN": csrfToken },
+        }),
+      ]);
       if (res.ok) {
         const data = await res.json();
         setStrategies(data.strategies ?? []);
+        onRevision(data.revision);
+      }
+      if (plansRes.ok) {
+        const data = await plansRes.json();
+        setPlans(data.plans ?? []);
       }
     } finally {
       setLoading(false);
     }
-  }, [basePath, environmentId, csrfToken]);
+  }, [basePath, rolloutsPath, environmentId, csrfToken, onRevision]);
 
   useEffect(() => {
     fetchStrategies();
@@ -56,9 +93,10 @@ export default function StrategyList({
           "Content-Type": "application/json",
           "X-XSRF-TOKEN": csrfToken,
         },
-        body: JSON.stringify(data),
+        body: JSON.stringify({ ...data, revision }),
       });
-      if (!res.ok) throw new Error("Failed to update strategy");
+      if (res.status === 409) onConflict();
+      if (!res.ok) throw await saveError(res, "Failed to update strategy");
     } else {
       const res = await fetch(basePath, {
         method: "POST",
@@ -66,21 +104,28 @@ export default function StrategyList({
           "Content-Type": "application/json",
           "X-XSRF-TOKEN": csrfToken,
         },
-        body: JSON.stringify({ ...data, environment_id: environmentId }),
+        body: JSON.stringify({ ...data, environment_id: environmentId, revision }),
       });
-      if (!res.ok) throw new Error("Failed to create strategy");
+      if (res.status === 409) onConflict();
+      if (!res.ok) throw await saveError(res, "Failed to create strategy");
     }
     await fetchStrategies();
   };
 
   const handleDelete = async (id: number) => {
     if (!confirm("Delete this strategy?")) return;
-    const res = await fetch(`${basePath}/${id}`, {
+    const res = await fetch(`${basePath}/${id}?revision=${revision}`, {
       method: "DELETE",
       headers: { "X-XSRF-TOKEN": csrfToken },
     });
+    if (res.status === 409) {
+      onConflict();
+      return;
+    }
     if (res.ok) {
+      const data = await res.json();
       setStrategies((prev) => prev.filter((s) => s.id !== id));
+      onRevision(data.revision);
     }
   };
 
@@ -125,9 +170,21 @@ export default function StrategyList({
               <StrategyCard
                 key={s.id}
                 strategy={s}
+                definition={definitions.find((d) => d.name === s.name)}
                 onEdit={() => openEdit(s)}
                 onDelete={() => handleDelete(s.id)}
-              />
+              >
+                {s.name === "gradualRollout" && (
+                  <RolloutPanel
+                    // Plans are newest first, so this is the latest one.
+                    plan={plans.find((p) => p.strategy_id === s.id)}
+                    strategyId={s.id}
+                    basePath={rolloutsPath}
+                    csrfToken={csrfToken}
+                    onChanged={fetchStrategies}
+                  />
+                )}
+              </StrategyCard>
             ))}
           </div>
           <button
@@ -144,6 +201,8 @@ export default function StrategyList({
         open={sheetOpen}
         onOpenChange={setSheetOpen}
         strategy={editing}
+        definitions={definitions}
+        context={context}
         onSave={handleSave}
       />
     </div>
@@ -8,103 +8,137 @@ import {
   SelectValue,
 } from "@/components/ui/select";
 
+export interface StrategyParam {
+  name: string;
+  label: string;
+  type: "number" | "string" | "enum" | "list";
+  required: boolean;
+  min?: number;
+  max?: number;
+  values?: string[];
+  default?: any;
+  description?: string;
+}
+
+export interface StrategyDefinition {
+  name: string;
+  label: string;
+  description?: string;
+  parameters: StrategyParam[];
+}
+
+// defaultParameters returns the parameters a newly picked strategy starts with.
+export function defaultParameters(
+  def: StrategyDefinition | undefined
+): Record<string, any> {
+  const params: Record<string, any> = {};
+  for (const p of def?.parameters ?? []) {
+    if (p.default !== undefined) params[p.name] = p.default;
+  }
+  return params;
+}
+
 interface Props {
-  strategyName: string;
+  definition: StrategyDefinition | undefined;
   parameters: Record<string, any>;
+  errors?: Record<string, string>;
   onChange: (params: Record<string, any>) => void;
 }
 
 export default function StrategyParams({
-  strategyName,
+  definition,
   parameters,
+  errors,
   onChange,
 }: Props) {
-  if (strategyName === "gradualRollout") {
-    return (
-      <div className="space-y-3">
-        <div className="space-y-1.5">
-          <Label className="text-xs">Rollout percentage</Label>
-          <div className="flex items-center gap-3">
-            <Input
-              type="number"
-              min={0}
-              max={100}
-              value={parameters.rollout ?? 0}
-              onChange={(e) =>
-                onChange({
-                  ...parameters,
-                  rollout: Math.min(100, Math.max(0, Number(e.target.value))),
-                })
-              }
-              className="h-9 text-sm w-24"
-            />
-            <span className="text-sm text-muted-foreground">%</span>
+  if (!definition || definition.parameters.length === 0) {
+    return null;
+  }
+
+  const set = (name: string, value: any) =>
+    onChange({ ...parameters, [name]: value });
+
+  return (
+    <div className="space-y-3">
+      {definition.parameters.map((p) => {
+        const error = errors?.[`parameters.${p.name}`];
+        const label = p.required ? p.label : `${p.label} (optional)`;
+        return (
+          <div key={p.name} className="space-y-1.5">
+            <Label className="text-xs">{label}</Label>
+            <ParamInput param={p} value={parameters[p.name]} onChange={(v) => set(p.name, v)} />
+            {p.description && !error && (
+              <p className="text-xs text-muted-foreground">{p.description}</p>
+            )}
+            {error && <p className="text-xs text-destructive">{error}</p>}
           </div>
-        </div>
-        <div className="space-y-1.5">
-          <Label className="text-xs">Stickiness</Label>
-          <Select
-            value={parameters.stickiness ?? "default"}
-            onValueChange={(v) =>
-              onChange({ ...parameters, stickiness: v })
-            }
-          >
-            <SelectTrigger className="h-9 text-sm">
-              <SelectValue />
-            </SelectTrigger>
-            <SelectContent>
-              <SelectItem value="default">Default</SelectItem>
-              <SelectItem value="userId">User ID</SelectItem>
-              <SelectItem value="sessionId">Session ID</SelectItem>
-              <SelectItem value="random">Random</SelectItem>
-            </SelectContent>
-          </Select>
-        </div>
-        <div className="space-y-1.5">
-          <Label className="text-xs">Group ID (optional)</Label>
+        );
+      })}
+      {errors?.parameters && (
+        <p className="text-xs text-destructive">{errors.parameters}</p>
+      )}
+    </div>
+  );
+}
+
+function ParamInput({
+  param,
+  value,
+  onChange,
+}: {
+  param: StrategyParam;
+  value: any;
+  onChange: (value: any) => void;
+}) {
+  switch (param.type) {
+    case "number":
+      return (
+        <div className="flex items-center gap-3">
           <Input
-            placeholder="e.g. experiment-1"
-            value={parameters.groupId ?? ""}
+            type="number"
+            min={param.min}
+            max={param.max}
+            value={value ?? ""}
             onChange={(e) =>
-              onChange({ ...parameters, groupId: e.target.value })
+              onChange(e.target.value === "" ? undefined : Number(e.target.value))
             }
-            className="h-9 text-sm"
+            className="h-9 text-sm w-24"
           />
+          {param.min === 0 && param.max === 100 && (
+            <span className="text-sm text-muted-foreground">%</span>
+          )}
         </div>
-      </div>
-    );
-  }
-
-  if (strategyName === "userWithId") {
-    return (
-      <div className="space-y-1.5">
-        <Label className="text-xs">User IDs (one per line)</Label>
+      );
+    case "enum":
+      return (
+        <Select value={value ?? ""} onValueChange={onChange}>
+          <SelectTrigger className="h-9 text-sm">
+            <SelectValue />
+          </SelectTrigger>
+          <SelectContent>
+            {(param.values ?? []).map((v) => (
+              <SelectItem key={v} value={v}>
+                {v}
+              </SelectItem>
+            ))}
+          </SelectContent>
+        </Select>
+      );
+    case "list":
+      return (
         <textarea
           className="flex w-full border border-input bg-background px-3 py-2 text-sm placeholder:text-muted-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring focus-visible:ring-offset-2 min-h-[80px] resize-y"
-          placeholder={"user-1\nuser-2\nuser-3"}
-          value={parameters.userIds ?? ""}
-          onChange={(e) =>
-            onChange({ ...parameters, userIds: e.target.value })
-          }
+          value={value ?? ""}
+          onChange={(e) => onChange(e.target.value)}
         />
-      </div>
-    );
-  }
-
-  if (strategyName === "remoteAddress") {
-    return (
-      <div className="space-y-1.5">
-        <Label className="text-xs">IP addresses (one per line)</Label>
-        <textarea
-          className="flex w-full border border-input bg-background px-3 py-2 text-sm placeholder:text-muted-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring focus-visible:ring-offset-2 min-h-[80px] resize-y"
-          placeholder={"192.168.1.0/24\n10.0.0.1"}
-          value={parameters.ips ?? ""}
-          onChange={(e) => onChange({ ...parameters, ips: e.target.value })}
+      );
+    default:
+      return (
+        <Input
+          value={value ?? ""}
+          onChange={(e) => onChange(e.target.value)}
+          className="h-9 text-sm"
         />
-      </div>
-    );
+      );
   }
-
-  // "default" strategy — no parameters needed
-  return null;
 }
@@ -17,21 +17,30 @@ import {
 import { Label } from "@/components/ui/label";
 import { Input } from "@/components/ui/input";
 import { Loader2 } from "lucide-react";
-import StrategyParams from "./StrategyParams";
-import ConstraintRow, { type ConstraintData } from "./ConstraintRow";
+import StrategyParams, {
+  defaultParameters,
+  type StrategyDefinition,
+} from "./StrategyParams";
+import ConstraintRow, {
+  type ConstraintData,
+  type ContextRegistry,
+} from "./ConstraintRow";
 import type { StrategyData } from "./StrategyCard";
 
-const STRATEGY_TYPES = [
-  { value: "default", label: "default (always on)" },
-  { value: "gradualRollout", label: "gradual_rollout" },
-  { value: "userWithId", label: "user_targeting" },
-  { value: "remoteAddress", label: "ip_filtering" },
-];
+// StrategyValidationError carries the field errors of a rejected save, keyed
+// like the server's validation response (e.g. "parameters.rollout").
+export class StrategyValidationError extends Error {
+  constructor(public fields: Record<string, string>) {
+    super("Validation failed");
+  }
+}
 
 interface Props {
   open: boolean;
   onOpenChange: (open: boolean) => void;
   strategy: StrategyData | null;
+  definitions: StrategyDefinition[];
+  context?: ContextRegistry;
   onSave: (data: {
     name: string;
     parameters: Record<string, any>;
@@ -52,6 +61,8 @@ export default function StrategySheet({
   open,
   onOpenChange,
   strategy,
+  definitions,
+  context,
   onSave,
 }: Props) {
   const [name, setName] = useState("default");
@@ -59,9 +70,13 @@ export default function StrategySheet({
   const [sortOrder, setSortOrder] = useState(0);
   const [constraints, setConstraints] = useState<ConstraintData[]>([]);
   const [saving, setSaving] = useState(false);
+  const [errors, setErrors] = useState<Record<string, string>>({});
+
+  const definition = definitions.find((d) => d.name === name);
 
   useEffect(() => {
     if (open) {
+      setErrors({});
       if (strategy) {
         setName(strategy.name);
         setParameters(strategy.parameters ?? {});
@@ -77,18 +92,33 @@ export default function StrategySheet({
         );
       } else {
         setName("default");
-        setParameters({});
+        setParameters(
+          defaultParameters(definitions.find((d) => d.name === "default"))
+        );
         setSortOrder(0);
         setConstraints([]);
       }
     }
   }, [open, strategy]);
 
+  const changeType = (value: string) => {
+    setName(value);
+    setParameters(defaultParameters(definitions.find((d) => d.name === value)));
+    setErrors({});
+  };
+
   const handleSave = async () => {
     setSaving(true);
+    setErrors({});
     try {
       await onSave({ name, parameters, sort_order: sortOrder, constraints });
       onOpenChange(false);
+    } catch (err) {
+      if (err instanceof StrategyValidationError) {
+        setErrors(err.fields);
+      } else {
+        setErrors({ name: err instanceof Error ? err.message : "Failed to save strategy" });
+      }
     } finally {
       setSaving(false);
     }
@@ -117,18 +147,26 @@ export default function StrategySheet({
         <div className="space-y-5 px-4">
           <div className="space-y-1.5">
             <Label className="text-sm">strategy_type</Label>
-            <Select value={name} onValueChange={setName}>
+            <Select value={name} onValueChange={changeType}>
               <SelectTrigger className="h-10">
                 <SelectValue />
               </SelectTrigger>
               <SelectContent>
-                {STRATEGY_TYPES.map((t) => (
-                  <SelectItem key={t.value} value={t.value}>
-                    {t.label}
+                {definitions.map((d) => (
+                  <SelectItem key={d.name} value={d.name}>
+                    {d.label}
                   </SelectItem>
                 ))}
               </SelectContent>
             </Select>
+            {definition?.description && !errors.name && (
+              <p className="text-xs text-muted-foreground">
+                {definition.description}
+              </p>
+            )}
+            {errors.name && (
+              <p className="text-xs text-destructive">{errors.name}</p>
+            )}
           </div>
 
           <div className="space-y-1.5">
@@ -143,8 +181,9 @@ export default function StrategySheet({
           </div>
 
           <StrategyParams
-            strategyName={name}
+            definition={definition}
             parameters={parameters}
+            errors={errors}
             onChange={setParameters}
           />
 
@@ -166,14 +205,26 @@ export default function StrategySheet({
                 No constraints — strategy applies to all users.
               </p>
             )}
-            {constraints.map((c, i) => (
-              <ConstraintRow
-                key={i}
-                constraint={c}
-                onChange={(updated) => updateConstraint(i, updated)}
-                onRemove={() => removeConstraint(i)}
-              />
-            ))}
+            {constraints.map((c, i) => {
+              const constraintErrors = Object.entries(errors)
+                .filter(([k]) => k.startsWith(`constraints[${i}].`))
+                .map(([, v]) => v);
+              return (
+                <div key={i} className="space-y-1">
+                  <ConstraintRow
+                    constraint={c}
+                    context={context}
+                    onChange={(updated) => updateConstraint(i, updated)}
+                    onRemove={() => removeConstraint(i)}
+                  />
+                  {constraintErrors.map((msg) => (
+                    <p key={msg} className="text-xs text-destructive">
+                      {msg}
+                    </p>
+                  ))}
+                </div>
+              );
+            })}
           </div>
         </div>
 
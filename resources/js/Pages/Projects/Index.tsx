@@ -1,6 +1,8 @@
 import { Link, usePage } from "@inertiajs/react";
 import { SharedProps } from "@/types/global";
 import TerminalLayout from "@/Layouts/TerminalLayout";
+import ListControls, { ListPager } from "@/components/ListControls";
+import { ListState, Page } from "@/types";
 
 interface ProjectItem {
   id: number;
@@ -12,11 +14,19 @@ interface ProjectItem {
 }
 
 interface Props {
-  projects: ProjectItem[];
+  projects: Page<ProjectItem>;
+  list: ListState;
 }
 
+const projectSorts = [
+  { value: "-created_at", label: "newest first" },
+  { value: "created_at", label: "oldest first" },
+  { value: "name", label: "name a-z" },
+  { value: "-name", label: "name z-a" },
+];
+
 export default function Index() {
-  const { projects, auth } = usePage<SharedProps & Props>().props;
+  const { projects, list, auth } = usePage<SharedProps & Props>().props;
   const canMutate = auth?.user?.role === "admin" || auth?.user?.role === "editor";
 
   return (
@@ -42,7 +52,17 @@ export default function Index() {
           )}
         </div>
 
-        {projects.length === 0 ? (
+        <div className="flex items-center justify-end mb-4">
+          <ListControls path="/projects" list={list} sorts={projectSorts} />
+        </div>
+
+        {projects.items.length === 0 && list.q ? (
+          <div className="bg-card border border-border">
+            <p className="py-16 px-6 text-center text-muted-foreground text-sm">
+              {">"} no projects match "{list.q}"
+            </p>
+          </div>
+        ) : projects.items.length === 0 ? (
           <div className="bg-card border border-border">
             <div className="flex flex-col items-center justify-center py-16 px-6 text-center">
               <p className="text-muted-foreground text-lg mb-2">
@@ -62,7 +82,7 @@ export default function Index() {
           </div>
         ) : (
           <div className="grid grid-cols-1 sm:grid-cols-2 lg:grid-cols-3 gap-4">
-            {projects.map((project) => (
+            {projects.items.map((project) => (
               <Link
                 key={project.id}
                 href={`/projects/${project.id}`}
@@ -88,6 +108,14 @@ export default function Index() {
             ))}
           </div>
         )}
+
+        <ListPager
+          path="/projects"
+          list={list}
+          total={projects.total}
+          shown={projects.items.length}
+          nextCursor={projects.next_cursor}
+        />
       </div>
     </TerminalLayout>
   );
@@ -0,0 +1,329 @@
+import { useMemo, useState } from "react";
+import { Link, usePage } from "@inertiajs/react";
+import { Loader2, ChevronRight, ChevronDown } from "lucide-react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Label } from "@/components/ui/label";
+import { Input } from "@/components/ui/input";
+import type { ContextField } from "@/Pages/Projects/Flags/components/ConstraintRow";
+
+interface EnvItem {
+  id: number;
+  name: string;
+  type: string;
+}
+
+interface ConstraintTrace {
+  context_name: string;
+  operator: string;
+  values: string[];
+  inverted: boolean;
+  case_insensitive: boolean;
+  value: string;
+  passed: boolean;
+}
+
+interface StrategyTrace {
+  name: string;
+  parameters?: Record<string, any>;
+  matched: boolean;
+  reason: string;
+  bucket?: number;
+  custom?: boolean;
+  constraints: ConstraintTrace[];
+}
+
+interface PrerequisiteTrace {
+  flag: string;
+  enabled?: boolean;
+  variant?: string;
+  actual: boolean;
+  passed: boolean;
+  reason?: string;
+}
+
+interface FlagEvaluation {
+  flag: string;
+  enabled: boolean;
+  reason: string;
+  configured: boolean;
+  toggle: boolean;
+  strategy?: number;
+  prerequisites?: PrerequisiteTrace[];
+  strategies: StrategyTrace[];
+}
+
+interface EnvironmentEvaluation {
+  environment: string;
+  flags: FlagEvaluation[];
+}
+
+interface Props {
+  project: { id: number; name: string };
+  environments: EnvItem[];
+  contextFields: ContextField[];
+}
+
+// initialContext fills the editor with the built-in fields and one property
+// per registered context field.
+function initialContext(fields: ContextField[]): string {
+  const properties: Record<string, string> = {};
+  for (const f of fields) {
+    if (!f.builtin) properties[f.name] = f.legal_values?.[0] ?? "";
+  }
+  return JSON.stringify({ userId: "user-1", sessionId: "", remoteAddress: "", properties }, null, 2);
+}
+
+function Result({ on }: { on: boolean }) {
+  return (
+    <span className={`text-xs font-mono ${on ? "text-primary" : "text-muted-foreground"}`}>
+      [{on ? "on" : "off"}]
+    </span>
+  );
+}
+
+function Trace({ flag }: { flag: FlagEvaluation }) {
+  if (!flag.configured) {
+    return <p className="text-xs text-muted-foreground pl-6 pb-3"># {flag.reason.toLowerCase()}</p>;
+  }
+
+  return (
+    <div className="pl-6 pb-3 space-y-2 text-xs font-mono">
+      <p className="text-muted-foreground">
+        toggle: {flag.toggle ? "enabled" : "disabled"}
+      </p>
+
+      {(flag.prerequisites ?? []).map((p) => (
+        <div key={p.flag} className={p.passed ? "text-foreground" : "text-destructive"}>
+          {p.passed ? "✓" : "✗"} requires {p.flag} {p.enabled === false ? "off" : "on"}
+          {p.variant && ` (${p.variant})`} — is {p.actual ? "on" : "off"}
+          {p.reason && <span className="text-muted-foreground"> · {p.reason}</span>}
+        </div>
+      ))}
+
+      {flag.strategies.map((s, i) => (
+        <div key={i} className="border-l border-border pl-3 space-y-1">
+          <p className={s.matched ? "text-primary" : "text-foreground"}>
+            {i + 1}. {s.name}
+            {s.matched && i === flag.strategy && " ← matched"}
+            {s.bucket !== undefined && (
+              <span className="text-muted-foreground"> · bucket {s.bucket}</span>
+            )}
+          </p>
+          <p className={s.custom ? "text-amber-400" : "text-muted-foreground"}>{s.reason}</p>
+          {s.constraints.map((c, j) => (
+            <p key={j} className={c.passed ? "text-muted-foreground" : "text-destructive"}>
+              {c.passed ? "✓" : "✗"} {c.context_name}="{c.value}" {c.inverted ? "NOT " : ""}
+              {c.operator} [{c.values.join(", ")}]
+              {c.case_insensitive && " (ci)"}
+            </p>
+          ))}
+        </div>
+      ))}
+    </div>
+  );
+}
+
+export default function Playground() {
+  const { project, environments, contextFields } = usePage<SharedProps & Props>().props;
+
+  const [selected, setSelected] = useState<string[]>(environments.map((e) => e.name));
+  const [context, setContext] = useState(() => initialContext(contextFields ?? []));
+  const [filter, setFilter] = useState("");
+  const [results, setResults] = useState<EnvironmentEvaluation[] | null>(null);
+  const [expanded, setExpanded] = useState<Record<string, boolean>>({});
+  const [error, setError] = useState<string | null>(null);
+  const [loading, setLoading] = useState(false);
+
+  const csrfToken = useMemo(() => {
+    const cookie = document.cookie
+      .split("; ")
+      .find((c) => c.startsWith("XSRF-TOKEN="));
+    return cookie ? decodeURIComponent(cookie.split("=")[1]) : "";
+  }, []);
+
+  const toggleEnv = (name: string) =>
+    setSelected((cur) =>
+      cur.includes(name) ? cur.filter((n) => n !== name) : [...cur, name],
+    );
+
+  const run = async () => {
+    setError(null);
+    let parsed: unknown;
+    try {
+      parsed = JSON.parse(context);
+    } catch (e) {
+      setError(`context is not valid JSON: ${(e as Error).message}`);
+      return;
+    }
+
+    setLoading(true);
+    try {
+      const res = await fetch(`/projects/${project.id}/playground`, {
+        method: "POST",
+        headers: {
+          "Content-Type": "application/json",
+          "X-XSRF-TOKEN": csrfToken,
+        },
+        body: JSON.stringify({
+          // Keep the environment order of the project.
+          environments: environments.map((e) => e.name).filter((n) => selected.includes(n)),
+          context: parsed,
+        }),
+      });
+      const data = await res.json();
+      if (!res.ok) {
+        const fields = data.fields ? Object.values(data.fields).join(", ") : "";
+        setError(fields || data.error || "Failed to evaluate");
+        return;
+      }
+      setResults(data.environments ?? []);
+    } finally {
+      setLoading(false);
+    }
+  };
+
+  const needle = filter.trim().toLowerCase();
+  const key = (env: string, flag: string) => `${env}/${flag}`;
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-5xl">
+        <div className="mb-6">
+          <Link
+            href="/projects"
+            className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+          >
+            projects
+          </Link>
+          <span className="text-sm text-muted-foreground mx-1">/</span>
+          <Link
+            href={`/projects/${project.id}`}
+            className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+          >
+            {project.name}
+          </Link>
+          <span className="text-sm text-muted-foreground mx-1">/</span>
+          <span className="text-sm text-foreground">playground</span>
+        </div>
+
+        <div className="mb-8">
+          <h1 className="text-2xl font-semibold tracking-tight text-foreground">
+            {">"} playground
+          </h1>
+          <p className="text-muted-foreground mt-1 text-sm">
+            # evaluate every flag of {project.name} for a context, as the SDKs would, and see why each one is on or off.
+          </p>
+        </div>
+
+        <div className="space-y-4 mb-8">
+          <div className="space-y-2">
+            <Label>environments</Label>
+            <div className="flex flex-wrap gap-2">
+              {environments.map((env) => (
+                <button
+                  key={env.id}
+                  type="button"
+                  onClick={() => toggleEnv(env.name)}
+                  className={`text-xs px-3 py-1.5 border transition-colors ${
+                    selected.includes(env.name)
+                      ? "border-primary text-primary"
+                      : "border-border text-muted-foreground hover:text-foreground"
+                  }`}
+                >
+                  {env.name}
+                </button>
+              ))}
+            </div>
+          </div>
+
+          <div className="space-y-2">
+            <Label htmlFor="context">context</Label>
+            <textarea
+              id="context"
+              value={context}
+              onChange={(e) => setContext(e.target.value)}
+              spellCheck={false}
+              className="flex w-full border border-input bg-background px-3 py-2 text-sm font-mono placeholder:text-muted-foreground focus-visible:outline-none focus-visible:ring-2 focus-visible:ring-ring focus-visible:ring-offset-2 min-h-[180px] resize-y"
+            />
+            <p className="text-xs text-muted-foreground">
+              # userId, sessionId and remoteAddress, plus any other field under properties.
+            </p>
+          </div>
+
+          {error && <p className="text-xs text-destructive">{error}</p>}
+
+          <button
+            type="button"
+            disabled={loading || selected.length === 0}
+            onClick={run}
+            className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors disabled:opacity-50"
+          >
+            {loading ? (
+              <>
+                <Loader2 className="w-4 h-4 animate-spin" />
+                evaluating...
+              </>
+            ) : (
+              "[evaluate]"
+            )}
+          </button>
+        </div>
+
+        {results && (
+          <div className="space-y-6">
+            <Input
+              placeholder="Filter flags"
+              value={filter}
+              onChange={(e) => setFilter(e.target.value)}
+              className="h-9 max-w-xs"
+            />
+
+            {results.map((env) => {
+              const flags = env.flags.filter((f) => f.flag.toLowerCase().includes(needle));
+              const on = env.flags.filter((f) => f.enabled).length;
+              return (
+                <div key={env.environment}>
+                  <h2 className="text-sm font-medium text-foreground mb-2">
+                    {env.environment}{" "}
+                    <span className="text-muted-foreground font-normal">
+                      · {on}/{env.flags.length} on
+                    </span>
+                  </h2>
+                  <div className="border border-border divide-y divide-border">
+                    {flags.length === 0 && (
+                      <p className="text-xs text-muted-foreground p-3"># no flags</p>
+                    )}
+                    {flags.map((f) => {
+                      const k = key(env.environment, f.flag);
+                      const open = expanded[k];
+                      return (
+                        <div key={f.flag}>
+                          <button
+                            type="button"
+                            onClick={() => setExpanded((cur) => ({ ...cur, [k]: !cur[k] }))}
+                            className="w-full flex items-center gap-3 px-3 py-2 text-left hover:bg-muted/50 transition-colors"
+                          >
+                            {open ? (
+                              <ChevronDown className="w-3 h-3 text-muted-foreground" />
+                            ) : (
+                              <ChevronRight className="w-3 h-3 text-muted-foreground" />
+                            )}
+                            <Result on={f.enabled} />
+                            <span className="text-sm text-foreground font-mono">{f.flag}</span>
+                            <span className="text-xs text-muted-foreground ml-auto">{f.reason}</span>
+                          </button>
+                          {open && <Trace flag={f} />}
+                        </div>
+                      );
+                    })}
+                  </div>
+                </div>
+              );
+            })}
+          </div>
+        )}
+      </div>
+    </TerminalLayout>
+  );
+}
@@ -1,13 +1,18 @@
 import { Link, usePage, router } from "@inertiajs/react";
-import { useState, useCallback } from "react";
+import { useState, useCallback, useEffect } from "react";
 import { SharedProps } from "@/types/global";
 import TerminalLayout from "@/Layouts/TerminalLayout";
+import ListControls, { ListPager } from "@/components/ListControls";
+import FreezeBanner, { Freeze } from "@/components/FreezeBanner";
+import { ListState, Page } from "@/types";
 
 interface FlagItem {
   id: number;
   name: string;
   description: string;
   flagType: string;
+  owner: string;
+  tags: string[];
   createdAt: string;
 }
 
@@ -16,28 +21,82 @@ interface EnvItem {
   name: string;
   type: string;
   sortOrder: number;
+  freeze: Freeze | null;
 }
 
 interface ToggleState {
   flagId: number;
   environmentId: number;
   enabled: boolean;
+  revision: number;
 }
 
+// ToggleCell is one cell of the matrix. The revision is sent with a toggle
+// so that a change made elsewhere since the page loaded is not overwritten.
+interface ToggleCell {
+  enabled: boolean;
+  revision: number;
+}
+
+const toggleCells = (toggles: ToggleState[] | null) => {
+  const map: Record<string, ToggleCell> = {};
+  for (const t of toggles ?? []) {
+    map[`${t.flagId}-${t.environmentId}`] = { enabled: t.enabled, revision: t.revision };
+  }
+  return map;
+};
+
 interface ProjectDetail {
   id: number;
   name: string;
   description: string;
   createdAt: string;
-  flags: FlagItem[];
+  flags: Page<FlagItem>;
   environments: EnvItem[];
   toggles: ToggleState[];
+  freeze: Freeze | null;
+}
+
+interface BulkResult {
+  flag: string;
+  environment?: string;
+  ok: boolean;
+  error?: string;
+}
+
+interface FlagFilter {
+  tag: string[] | null;
+  owner: string;
+  type: string;
+  enabled_in: string;
 }
 
 interface Props {
   project: ProjectDetail;
+  filter: FlagFilter;
+  list: ListState;
+  tags: string[];
+  owners: string[];
 }
 
+const flagSorts = [
+  { value: "name", label: "name a-z" },
+  { value: "-name", label: "name z-a" },
+  { value: "-created_at", label: "newest first" },
+  { value: "-updated_at", label: "recently updated" },
+];
+
+// filterParams turns the flag filter into query parameters, leaving out the
+// empty ones.
+const filterParams = (f: FlagFilter) => {
+  const params: Record<string, string | string[]> = {};
+  if (f.tag && f.tag.length > 0) params.tag = f.tag;
+  if (f.owner) params.owner = f.owner;
+  if (f.type) params.type = f.type;
+  if (f.enabled_in) params.enabled_in = f.enabled_in;
+  return params;
+};
+
 const flagTypeBadge: Record<string, string> = {
   release: "text-foreground border-border",
   experiment: "text-cyan-400 border-cyan-400/30",
@@ -46,17 +105,48 @@ const flagTypeBadge: Record<string, string> = {
 };
 
 export default function Show() {
-  const { project, auth } = usePage<SharedProps & Props>().props;
+  const { project, filter, list, tags, owners, auth } =
+    usePage<SharedProps & Props>().props;
+  const path = `/projects/${project.id}`;
   const canMutate = auth?.user?.role === "admin" || auth?.user?.role === "editor";
+  const isAdmin = auth?.user?.role === "admin";
+
+  // locked reports whether a freeze keeps the user from changing an
+  // environment; admins are never kept out.
+  const locked = (env: EnvItem) => !isAdmin && (!!project.freeze || !!env.freeze);
+
+  const filtered =
+    (filter.tag?.length ?? 0) > 0 ||
+    filter.owner !== "" ||
+    filter.type !== "" ||
+    filter.enabled_in !== "";
+
+  // Toggles are loaded for the flags on the page only, so the page is
+  // reloaded without preserving state. A new filter starts from the first page.
+  const applyFilter = (next: Partial<FlagFilter>) => {
+    router.get(
+      path,
+      {
+        ...filterParams({ ...filter, ...next }),
+        q: list.q || undefined,
+        sort: list.sort,
+      },
+      { preserveScroll: true },
+    );
+  };
+
+  const clearFilter = () => {
+    router.get(path, { sort: list.sort }, { preserveScroll: true });
+  };
 
   const handleDeleteProject = () => {
-    if (confirm("Are you sure you want to delete this project?")) {
+    if (confirm("Move this project to the trash? Its API tokens stop working until it is restored.")) {
       router.delete(`/projects/${project.id}`);
     }
   };
 
   const handleDeleteEnv = (envId: number) => {
-    if (confirm("Are you sure you want to delete this environment?")) {
+    if (confirm("Move this environment to the trash? It can be restored from the trash page.")) {
       router.delete(
         `/projects/${project.id}/environments/${envId}`,
       );
@@ -64,25 +154,42 @@ export default function Show() {
   };
 
   const handleDeleteFlag = (flagId: number) => {
-    if (confirm("Are you sure you want to delete this flag?")) {
+    if (confirm("Move this flag to the trash? It can be restored from the trash page.")) {
       router.delete(`/projects/${project.id}/flags/${flagId}`);
     }
   };
 
-  // Local toggle state for optimistic UI
-  const [toggleMap, setToggleMap] = useState<Record<string, boolean>>(() => {
-    const map: Record<string, boolean> = {};
-    if (project.toggles) {
-      for (const t of project.toggles) {
-        map[`${t.flagId}-${t.environmentId}`] = t.enabled;
-      }
+  const handleFreezeProject = () => {
+    const reason = prompt("Freeze the project? Only admins can change it until the freeze is lifted.\n\nReason:");
+    if (reason?.trim()) {
+      router.post(`/projects/${project.id}/freeze`, { reason }, { preserveScroll: true });
     }
-    return map;
-  });
+  };
+
+  const handleFreezeEnv = (env: EnvItem) => {
+    const reason = prompt(`Freeze ${env.name}? Only admins can change it until the freeze is lifted.\n\nReason:`);
+    if (reason?.trim()) {
+      router.post(`/projects/${project.id}/environments/${env.id}/freeze`, { reason }, { preserveScroll: true });
+    }
+  };
+
+  // handleKillSwitch turns off every kill_switch flag in the environment.
+  const handleKillSwitch = (env: EnvItem) => {
+    const reason = prompt(`Turn off every kill switch flag in ${env.name}?\n\nReason:`);
+    if (reason?.trim()) {
+      router.post(`/projects/${project.id}/environments/${env.id}/kill-switch`, { reason }, { preserveScroll: true });
+    }
+  };
+
+  // Local toggle state for optimistic UI, reset whenever the page reloads.
+  const [toggleMap, setToggleMap] = useState<Record<string, ToggleCell>>(() =>
+    toggleCells(project.toggles),
+  );
+  useEffect(() => setToggleMap(toggleCells(project.toggles)), [project.toggles]);
 
   const getToggle = useCallback(
     (flagId: number, envId: number) => {
-      return toggleMap[`${flagId}-${envId}`] ?? false;
+      return toggleMap[`${flagId}-${envId}`]?.enabled ?? false;
     },
     [toggleMap],
   );
@@ -90,11 +197,11 @@ export default function Show() {
   const handleToggle = useCallback(
     async (flagId: number, envId: number) => {
       const key = `${flagId}-${envId}`;
-      const current = toggleMap[key] ?? false;
-      const next = !current;
+      const current = toggleMap[key] ?? { enabled: false, revision: 0 };
+      const next = !current.enabled;
 
       // Optimistic update
-      setToggleMap((prev) => ({ ...prev, [key]: next }));
+      setToggleMap((prev) => ({ ...prev, [key]: { ...current, enabled: next } }));
 
       try {
         const csrfCookie = document.cookie
@@ -112,12 +219,34 @@ export default function Show() {
               "Content-Type": "application/json",
               "X-XSRF-TOKEN": csrfToken,
             },
-            body: JSON.stringify({ environmentId: envId, enabled: next }),
+            body: JSON.stringify({
+              environmentId: envId,
+              enabled: next,
+              revision: current.revision,
+            }),
           },
         );
 
-        if (!res.ok) {
+        if (res.ok) {
+          const data = await res.json();
+          setToggleMap((prev) => ({
+            ...prev,
+            [key]: { enabled: data.enabled, revision: data.revision },
+          }));
+        } else if (res.status === 409) {
+          // Someone else changed the cell: show their state instead.
+          const data = await res.json();
+          setToggleMap((prev) => ({
+            ...prev,
+            [key]: { enabled: data.current.enabled, revision: data.current.revision },
+          }));
+          alert(`${data.error}; it is now ${data.current.enabled ? "on" : "off"}.`);
+        } else {
           setToggleMap((prev) => ({ ...prev, [key]: current }));
+          if (res.status === 423) {
+            const data = await res.json();
+            alert(data.error);
+          }
         }
       } catch {
         setToggleMap((prev) => ({ ...prev, [key]: current }));
@@ -126,12 +255,86 @@ export default function Show() {
     [toggleMap, project.id],
   );
 
+  // Flags selected in the matrix for a bulk action.
+  const [selected, setSelected] = useState<number[]>([]);
+  const [bulkEnv, setBulkEnv] = useState("all");
+  const [bulkTag, setBulkTag] = useState("");
+  const [bulkBusy, setBulkBusy] = useState(false);
+  const [bulkError, setBulkError] = useState<string | null>(null);
+
+  const toggleSelected = (flagId: number) =>
+    setSelected((cur) =>
+      cur.includes(flagId) ? cur.filter((id) => id !== flagId) : [...cur, flagId],
+    );
+
+  // runBulk applies one operation to the selected flags, all or nothing, and
+  // reloads the page to show the result.
+  const runBulk = async (operation: Record<string, unknown>) => {
+    setBulkBusy(true);
+    setBulkError(null);
+    try {
+      const csrfCookie = document.cookie
+        .split("; ")
+        .find((c) => c.startsWith("XSRF-TOKEN="));
+      const csrfToken = csrfCookie
+        ? decodeURIComponent(csrfCookie.split("=")[1])
+        : "";
+
+      const res = await fetch(`/projects/${project.id}/flags/bulk`, {
+        method: "POST",
+        headers: {
+          "Content-Type": "application/json",
+          "X-XSRF-TOKEN": csrfToken,
+        },
+        body: JSON.stringify({
+          operations: [{ ...operation, flag_ids: selected }],
+        }),
+      });
+      const data = await res.json();
+      if (!res.ok) {
+        const failure = (data.results as BulkResult[] | undefined)?.find((r) => !r.ok);
+        const fields = data.fields ? Object.values(data.fields).join(", ") : "";
+        setBulkError(
+          failure
+            ? `${failure.flag}${failure.environment ? ` @ ${failure.environment}` : ""}: ${failure.error}`
+            : fields || data.error || "Failed to apply changes",
+        );
+        return;
+      }
+      router.visit(window.location.href, { preserveScroll: true });
+    } finally {
+      setBulkBusy(false);
+    }
+  };
+
+  const bulkToggle = (enabled: boolean) =>
+    runBulk({
+      op: "toggle",
+      environment_ids:
+        bulkEnv === "all" ? project.environments.map((e) => e.id) : [Number(bulkEnv)],
+      enabled,
+    });
+
+  const bulkRetag = (add: boolean) => {
+    if (!bulkTag.trim()) return;
+    runBulk({ op: "tag", [add ? "add_tags" : "remove_tags"]: [bulkTag.trim()] });
+  };
+
+  const bulkDelete = () => {
+    if (confirm(`Move ${selected.length} flag(s) to the trash? They can be restored from the trash page.`)) {
+      runBulk({ op: "delete" });
+    }
+  };
+
   const sortedEnvs = [...project.environments].sort(
     (a, b) => a.sortOrder - b.sortOrder,
   );
 
   const hasMatrix =
-    project.flags.length > 0 && project.environments.length > 0;
+    project.flags.items.length > 0 && project.environments.length > 0;
+
+  const selectClass =
+    "bg-background border border-border text-xs text-foreground px-2 py-1.5";
 
   return (
     <TerminalLayout activePage="projects">
@@ -173,6 +376,33 @@ export default function Show() {
               >
                 [api_tokens]
               </Link>
+              <Link
+                href={`/projects/${project.id}/strategy-definitions`}
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
+              >
+                [strategies]
+              </Link>
+              <Link
+                href={`/projects/${project.id}/context-fields`}
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
+              >
+                [context_fields]
+              </Link>
+              <Link
+                href={`/projects/${project.id}/playground`}
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
+              >
+                [playground]
+              </Link>
+              {isAdmin && !project.freeze && (
+                <button
+                  type="button"
+                  onClick={handleFreezeProject}
+                  className="text-xs text-amber-400 hover:opacity-80 transition-colors border border-amber-400/30 px-3 py-1.5"
+                >
+                  [freeze]
+                </button>
+              )}
               <button
                 type="button"
                 onClick={handleDeleteProject}
@@ -184,6 +414,13 @@ export default function Show() {
           )}
         </div>
 
+        <FreezeBanner
+          projectId={project.id}
+          freeze={project.freeze}
+          environments={sortedEnvs}
+          canLift={isAdmin}
+        />
+
         {/* Flag Matrix */}
         {hasMatrix && (
           <div className="bg-card border border-border mb-6 overflow-hidden">
@@ -195,10 +432,99 @@ export default function Show() {
                 Toggle flags across environments
               </p>
             </div>
+            {canMutate && selected.length > 0 && (
+              <div className="flex flex-wrap items-center gap-2 px-5 py-3 border-b border-border bg-muted/20 text-xs">
+                <span className="text-foreground">{selected.length} selected</span>
+                <select
+                  aria-label="Environment"
+                  className={selectClass}
+                  value={bulkEnv}
+                  onChange={(e) => setBulkEnv(e.target.value)}
+                >
+                  <option value="all">all environments</option>
+                  {sortedEnvs.map((env) => (
+                    <option key={env.id} value={env.id}>
+                      {env.name}
+                    </option>
+                  ))}
+                </select>
+                <button
+                  type="button"
+                  disabled={bulkBusy}
+                  onClick={() => bulkToggle(true)}
+                  className="border border-border px-2 py-1 text-primary hover:opacity-80 disabled:opacity-50"
+                >
+                  [on]
+                </button>
+                <button
+                  type="button"
+                  disabled={bulkBusy}
+                  onClick={() => bulkToggle(false)}
+                  className="border border-border px-2 py-1 text-muted-foreground hover:text-foreground disabled:opacity-50"
+                >
+                  [off]
+                </button>
+                <span className="text-muted-foreground">|</span>
+                <input
+                  aria-label="Tag"
+                  placeholder="tag"
+                  value={bulkTag}
+                  onChange={(e) => setBulkTag(e.target.value)}
+                  className="bg-background border border-border text-xs text-foreground px-2 py-1 w-28"
+                />
+                <button
+                  type="button"
+                  disabled={bulkBusy || !bulkTag.trim()}
+                  onClick={() => bulkRetag(true)}
+                  className="border border-border px-2 py-1 text-muted-foreground hover:text-foreground disabled:opacity-50"
+                >
+                  [+ tag]
+                </button>
+                <button
+                  type="button"
+                  disabled={bulkBusy || !bulkTag.trim()}
+                  onClick={() => bulkRetag(false)}
+                  className="border border-border px-2 py-1 text-muted-foreground hover:text-foreground disabled:opacity-50"
+                >
+                  [- tag]
+                </button>
+                <span className="text-muted-foreground">|</span>
+                <button
+                  type="button"
+                  disabled={bulkBusy}
+                  onClick={bulkDelete}
+                  className="border border-destructive/30 px-2 py-1 text-destructive hover:text-destructive/80 disabled:opacity-50"
+                >
+                  [delete]
+                </button>
+                <button
+                  type="button"
+                  onClick={() => setSelected([])}
+                  className="ml-auto text-muted-foreground hover:text-foreground"
+                >
+                  [clear]
+                </button>
+                {bulkError && <p className="w-full text-destructive">{bulkError}</p>}
+              </div>
+            )}
             <div className="overflow-x-auto">
               <table className="w-full">
                 <thead>
                   <tr className="border-b border-border bg-muted/30">
+                    {canMutate && (
+                      <th className="pl-5 py-3 w-8">
+                        <input
+                          type="checkbox"
+                          aria-label="Select all flags"
+                          checked={selected.length === project.flags.items.length}
+                          onChange={(e) =>
+                            setSelected(
+                              e.target.checked ? project.flags.items.map((f) => f.id) : [],
+                            )
+                          }
+                        />
+                      </th>
+                    )}
                     <th className="text-left text-xs font-medium text-muted-foreground px-5 py-3 min-w-[200px]">
                       flag
                     </th>
@@ -216,11 +542,21 @@ export default function Show() {
                   </tr>
                 </thead>
                 <tbody className="divide-y divide-border">
-                  {project.flags.map((flag) => (
+                  {project.flags.items.map((flag) => (
                     <tr
                       key={flag.id}
                       className="hover:bg-muted/20 transition-colors"
                     >
+                      {canMutate && (
+                        <td className="pl-5 py-3">
+                          <input
+                            type="checkbox"
+                            aria-label={`Select ${flag.name}`}
+                            checked={selected.includes(flag.id)}
+                            onChange={() => toggleSelected(flag.id)}
+                          />
+                        </td>
+                      )}
                       <td className="px-5 py-3">
                         <div className="flex items-center gap-2">
                           <span className="font-medium text-foreground text-sm">
@@ -240,14 +576,15 @@ export default function Show() {
                       </td>
                       {sortedEnvs.map((env) => {
                         const enabled = getToggle(flag.id, env.id);
+                        const editable = canMutate && !locked(env);
                         return (
                           <td key={env.id} className="text-center px-4 py-3">
                             <button
                               type="button"
-                              onClick={() => canMutate && handleToggle(flag.id, env.id)}
-                              disabled={!canMutate}
+                              onClick={() => editable && handleToggle(flag.id, env.id)}
+                              disabled={!editable}
                               className={`text-sm font-medium transition-colors ${
-                                !canMutate ? "opacity-60 cursor-not-allowed" : "hover:opacity-80"
+                                !editable ? "opacity-60 cursor-not-allowed" : "hover:opacity-80"
                               }`}
                               role="switch"
                               aria-checked={enabled}
@@ -285,7 +622,85 @@ export default function Show() {
               </Link>
             )}
           </div>
-          {project.flags.length === 0 ? (
+          <div className="flex flex-wrap items-center gap-3 px-5 py-3 border-b border-border">
+            <select
+              aria-label="Filter by tag"
+              className={selectClass}
+              value={filter.tag?.[0] ?? ""}
+              onChange={(e) =>
+                applyFilter({ tag: e.target.value ? [e.target.value] : [] })
+              }
+            >
+              <option value="">any tag</option>
+              {tags.map((t) => (
+                <option key={t} value={t}>
+                  #{t}
+                </option>
+              ))}
+            </select>
+            <select
+              aria-label="Filter by owner"
+              className={selectClass}
+              value={filter.owner}
+              onChange={(e) => applyFilter({ owner: e.target.value })}
+            >
+              <option value="">any owner</option>
+              {owners.map((o) => (
+                <option key={o} value={o}>
+                  @{o}
+                </option>
+              ))}
+            </select>
+            <select
+              aria-label="Filter by type"
+              className={selectClass}
+              value={filter.type}
+              onChange={(e) => applyFilter({ type: e.target.value })}
+            >
+              <option value="">any type</option>
+              <option value="release">release</option>
+              <option value="experiment">experiment</option>
+              <option value="operational">operational</option>
+              <option value="kill_switch">kill_switch</option>
+            </select>
+            <select
+              aria-label="Filter by environment"
+              className={selectClass}
+              value={filter.enabled_in}
+              onChange={(e) => applyFilter({ enabled_in: e.target.value })}
+            >
+              <option value="">any state</option>
+              {sortedEnvs.map((env) => (
+                <option key={env.id} value={env.name}>
+                  enabled in {env.name}
+                </option>
+              ))}
+            </select>
+            <div className="ml-auto">
+              <ListControls
+                path={path}
+                list={list}
+                sorts={flagSorts}
+                params={filterParams(filter)}
+              />
+            </div>
+            {filtered && (
+              <button
+                type="button"
+                onClick={clearFilter}
+                className="text-xs text-muted-foreground hover:text-foreground transition-colors"
+              >
+                [clear]
+              </button>
+            )}
+          </div>
+          {project.flags.items.length === 0 && (filtered || list.q) ? (
+            <div className="flex flex-col items-center justify-center py-12 px-6 text-center">
+              <p className="text-sm text-muted-foreground">
+                {">"} no flags match the filter or search
+              </p>
+            </div>
+          ) : project.flags.items.length === 0 ? (
             <div className="flex flex-col items-center justify-center py-12 px-6 text-center">
               <p className="text-sm text-muted-foreground mb-4">
                 {">"} no feature flags yet
@@ -299,7 +714,7 @@ export default function Show() {
             </div>
           ) : (
             <div className="divide-y divide-border">
-              {project.flags.map((flag) => (
+              {project.flags.items.map((flag) => (
                 <div
                   key={flag.id}
                   className="px-5 py-3 flex items-center justify-between"
@@ -314,6 +729,21 @@ export default function Show() {
                       >
                         [{flag.flagType.replace("_", " ")}]
                       </span>
+                      {flag.tags.map((t) => (
+                        <button
+                          key={t}
+                          type="button"
+                          onClick={() => applyFilter({ tag: [t] })}
+                          className="text-[10px] text-muted-foreground hover:text-foreground transition-colors"
+                        >
+                          #{t}
+                        </button>
+                      ))}
+                      {flag.owner && (
+                        <span className="text-[10px] text-muted-foreground">
+                          @{flag.owner}
+                        </span>
+                      )}
                     </div>
                     {flag.description && (
                       <p className="text-xs text-muted-foreground mt-0.5">
@@ -342,6 +772,14 @@ export default function Show() {
               ))}
             </div>
           )}
+          <ListPager
+            path={path}
+            list={list}
+            total={project.flags.total}
+            shown={project.flags.items.length}
+            nextCursor={project.flags.next_cursor}
+            params={filterParams(filter)}
+          />
         </div>
 
         {/* Environments section */}
@@ -350,14 +788,24 @@ export default function Show() {
             <h2 className="text-sm font-semibold text-foreground">
               // environments
             </h2>
-            {canMutate && (
-              <Link
-                href={`/projects/${project.id}/environments/create`}
-                className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
-              >
-                [+ new_env]
-              </Link>
-            )}
+            <div className="flex items-center gap-2">
+              {project.environments.length > 1 && (
+                <Link
+                  href={`/projects/${project.id}/compare`}
+                  className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
+                >
+                  [compare]
+                </Link>
+              )}
+              {canMutate && (
+                <Link
+                  href={`/projects/${project.id}/environments/create`}
+                  className="text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-3 py-1.5"
+                >
+                  [+ new_env]
+                </Link>
+              )}
+            </div>
           </div>
           {project.environments.length === 0 ? (
             <div className="flex flex-col items-center justify-center py-12 px-6 text-center">
@@ -385,9 +833,31 @@ export default function Show() {
                     <span className="text-xs text-muted-foreground border border-border px-1.5 py-0.5">
                       [{env.type}]
                     </span>
+                    {env.freeze && (
+                      <span className="text-xs text-amber-400 border border-amber-400/30 px-1.5 py-0.5">
+                        [frozen]
+                      </span>
+                    )}
                   </div>
                   {canMutate && (
                     <div className="flex items-center gap-2">
+                      <button
+                        type="button"
+                        className="text-xs text-red-400 hover:opacity-80 transition-colors"
+                        onClick={() => handleKillSwitch(env)}
+                        title="Turn off every kill switch flag in this environment"
+                      >
+                        [kill_switch]
+                      </button>
+                      {isAdmin && !env.freeze && (
+                        <button
+                          type="button"
+                          className="text-xs text-amber-400 hover:opacity-80 transition-colors"
+                          onClick={() => handleFreezeEnv(env)}
+                        >
+                          [freeze]
+                        </button>
+                      )}
                       <Link
                         href={`/projects/${project.id}/environments/${env.id}/edit`}
                         className="text-xs text-muted-foreground hover:text-foreground transition-colors"
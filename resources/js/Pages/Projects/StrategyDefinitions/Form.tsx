@@ -0,0 +1,328 @@
+import { Link, useForm, usePage } from "@inertiajs/react";
+import { FormEventHandler } from "react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Input } from "@/components/ui/input";
+import { Label } from "@/components/ui/label";
+import {
+  Select,
+  SelectContent,
+  SelectItem,
+  SelectTrigger,
+  SelectValue,
+} from "@/components/ui/select";
+import InputError from "@/components/InputError";
+import type {
+  StrategyDefinition,
+  StrategyParam,
+} from "@/Pages/Projects/Flags/components/StrategyParams";
+import { Loader2 } from "lucide-react";
+
+interface Props {
+  project: { id: number; name: string };
+  // strategy is set when editing.
+  strategy?: StrategyDefinition;
+  usedBy?: string[];
+}
+
+// ParamRow is a parameter as edited in the form, with numbers and lists kept
+// as the text typed in.
+interface ParamRow {
+  name: string;
+  label: string;
+  type: StrategyParam["type"];
+  required: boolean;
+  min: string;
+  max: string;
+  values: string;
+  default: string;
+  description: string;
+}
+
+const PARAM_TYPES: StrategyParam["type"][] = ["string", "number", "enum", "list"];
+
+const toRow = (p: StrategyParam): ParamRow => ({
+  name: p.name,
+  label: p.label === p.name ? "" : p.label,
+  type: p.type,
+  required: p.required,
+  min: p.min?.toString() ?? "",
+  max: p.max?.toString() ?? "",
+  values: (p.values ?? []).join(", "),
+  default: p.default?.toString() ?? "",
+  description: p.description ?? "",
+});
+
+const fromRow = (r: ParamRow): Partial<StrategyParam> => {
+  const num = (s: string) => (s.trim() === "" ? undefined : Number(s));
+  const values = r.values.split(",").map((v) => v.trim()).filter(Boolean);
+  return {
+    name: r.name.trim(),
+    label: r.label.trim() || undefined,
+    type: r.type,
+    required: r.required,
+    min: r.type === "number" ? num(r.min) : undefined,
+    max: r.type === "number" ? num(r.max) : undefined,
+    values: r.type === "enum" ? values : undefined,
+    default:
+      r.default === "" ? undefined : r.type === "number" ? Number(r.default) : r.default,
+    description: r.description || undefined,
+  };
+};
+
+const emptyRow = (): ParamRow => ({
+  name: "",
+  label: "",
+  type: "string",
+  required: false,
+  min: "",
+  max: "",
+  values: "",
+  default: "",
+  description: "",
+});
+
+export default function Form() {
+  const { project, strategy, usedBy } = usePage<SharedProps & Props>().props;
+  const errors = usePage().props.errors as Record<string, string[]> | undefined;
+  const path = `/projects/${project.id}/strategy-definitions`;
+  const inUse = (usedBy ?? []).length > 0;
+
+  const { data, setData, post, put, processing, transform } = useForm({
+    name: strategy?.name ?? "",
+    description: strategy?.description ?? "",
+    parameters: (strategy?.parameters ?? []).map(toRow),
+  });
+
+  transform((d) => ({ ...d, parameters: d.parameters.map(fromRow) }));
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    if (strategy) {
+      put(`${path}/${strategy.id}`);
+    } else {
+      post(path);
+    }
+  };
+
+  const updateRow = (i: number, patch: Partial<ParamRow>) =>
+    setData(
+      "parameters",
+      data.parameters.map((r, j) => (j === i ? { ...r, ...patch } : r))
+    );
+
+  const rowErrors = (i: number) =>
+    Object.entries(errors ?? {})
+      .filter(([k]) => k.startsWith(`parameters[${i}].`))
+      .flatMap(([, msgs]) => msgs);
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-3xl">
+          <div className="mb-6">
+            <Link
+              href="/projects"
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              projects
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={`/projects/${project.id}`}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              {project.name}
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={path}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              strategies
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <span className="text-sm text-foreground">{strategy ? strategy.name : "create"}</span>
+          </div>
+
+          <div className="mb-8">
+            <h1 className="text-2xl font-semibold tracking-tight text-foreground">
+              {">"} {strategy ? "edit_strategy" : "create_strategy"}
+            </h1>
+            <p className="text-muted-foreground mt-1 text-sm">
+              # flags can use the strategy once it is saved; your SDKs must implement it.
+            </p>
+          </div>
+
+          <div className="bg-card border border-border p-6">
+            <form onSubmit={submit} className="space-y-5">
+              <div className="space-y-2">
+                <Label htmlFor="name">name</Label>
+                <Input
+                  id="name"
+                  name="name"
+                  placeholder="e.g. tenantPlan"
+                  value={data.name}
+                  onChange={(e) => setData("name", e.target.value)}
+                  aria-invalid={!!errors?.Name}
+                  disabled={inUse}
+                  className="h-11"
+                />
+                {inUse && (
+                  <p className="text-xs text-muted-foreground">
+                    used by {usedBy!.join(", ")}; the name cannot change.
+                  </p>
+                )}
+                {errors?.Name?.map((msg, i) => (
+                  <InputError key={i} message={msg} />
+                ))}
+              </div>
+
+              <div className="space-y-2">
+                <Label htmlFor="description">description</Label>
+                <Input
+                  id="description"
+                  name="description"
+                  placeholder="e.g. On for tenants on the listed plans"
+                  value={data.description}
+                  onChange={(e) => setData("description", e.target.value)}
+                  className="h-11"
+                />
+              </div>
+
+              <div className="space-y-3">
+                <div className="flex items-center justify-between">
+                  <Label>// parameters</Label>
+                  <button
+                    type="button"
+                    onClick={() => setData("parameters", [...data.parameters, emptyRow()])}
+                    className="inline-flex items-center justify-center text-xs text-muted-foreground hover:text-foreground transition-colors border border-border px-2 py-1"
+                  >
+                    [+ add]
+                  </button>
+                </div>
+                {data.parameters.length === 0 && (
+                  <p className="text-xs text-muted-foreground">
+                    No parameters — the strategy is configured by its constraints only.
+                  </p>
+                )}
+                {data.parameters.map((r, i) => (
+                  <div key={i} className="border border-border p-3 space-y-2">
+                    <div className="grid grid-cols-3 gap-2">
+                      <Input
+                        placeholder="name"
+                        value={r.name}
+                        onChange={(e) => updateRow(i, { name: e.target.value })}
+                        className="h-9 text-sm"
+                      />
+                      <Input
+                        placeholder="label (optional)"
+                        value={r.label}
+                        onChange={(e) => updateRow(i, { label: e.target.value })}
+                        className="h-9 text-sm"
+                      />
+                      <Select
+                        value={r.type}
+                        onValueChange={(v) => updateRow(i, { type: v as ParamRow["type"] })}
+                      >
+                        <SelectTrigger className="h-9 text-sm">
+                          <SelectValue />
+                        </SelectTrigger>
+                        <SelectContent>
+                          {PARAM_TYPES.map((t) => (
+                            <SelectItem key={t} value={t}>
+                              {t}
+                            </SelectItem>
+                          ))}
+                        </SelectContent>
+                      </Select>
+                    </div>
+                    <div className="grid grid-cols-3 gap-2">
+                      {r.type === "number" && (
+                        <>
+                          <Input
+                            type="number"
+                            placeholder="min"
+                            value={r.min}
+                            onChange={(e) => updateRow(i, { min: e.target.value })}
+                            className="h-9 text-sm"
+                          />
+                          <Input
+                            type="number"
+                            placeholder="max"
+                            value={r.max}
+                            onChange={(e) => updateRow(i, { max: e.target.value })}
+                            className="h-9 text-sm"
+                          />
+                        </>
+                      )}
+                      {r.type === "enum" && (
+                        <Input
+                          placeholder="values, comma-separated"
+                          value={r.values}
+                          onChange={(e) => updateRow(i, { values: e.target.value })}
+                          className="h-9 text-sm col-span-2"
+                        />
+                      )}
+                      {r.type !== "list" && (
+                        <Input
+                          placeholder="default"
+                          value={r.default}
+                          onChange={(e) => updateRow(i, { default: e.target.value })}
+                          className="h-9 text-sm"
+                        />
+                      )}
+                    </div>
+                    <Input
+                      placeholder="description (optional)"
+                      value={r.description}
+                      onChange={(e) => updateRow(i, { description: e.target.value })}
+                      className="h-9 text-sm"
+                    />
+                    <div className="flex items-center justify-between">
+                      <label className="flex items-center gap-2 text-xs text-muted-foreground">
+                        <input
+                          type="checkbox"
+                          checked={r.required}
+                          onChange={(e) => updateRow(i, { required: e.target.checked })}
+                        />
+                        required
+                      </label>
+                      <button
+                        type="button"
+                        onClick={() =>
+                          setData("parameters", data.parameters.filter((_, j) => j !== i))
+                        }
+                        className="text-xs text-destructive hover:text-destructive/80 transition-colors"
+                      >
+                        [x]
+                      </button>
+                    </div>
+                    {rowErrors(i).map((msg, k) => (
+                      <InputError key={k} message={msg} />
+                    ))}
+                  </div>
+                ))}
+              </div>
+
+              <div className="flex items-center gap-3 pt-2">
+                <button
+                  type="submit"
+                  disabled={processing}
+                  className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors disabled:opacity-50"
+                >
+                  {processing && <Loader2 className="w-4 h-4 animate-spin" />}
+                  {strategy ? "[save]" : "[create]"}
+                </button>
+                <Link
+                  href={path}
+                  className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+                >
+                  [cancel]
+                </Link>
+              </div>
+            </form>
+          </div>
+      </div>
+    </TerminalLayout>
+  );
+}
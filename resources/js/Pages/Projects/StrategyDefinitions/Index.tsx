@@ -0,0 +1,152 @@
+import { Link, usePage, router } from "@inertiajs/react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+import { Button } from "@/components/ui/button";
+import type { StrategyDefinition } from "@/Pages/Projects/Flags/components/StrategyParams";
+import { Plus, Trash2, Pencil } from "lucide-react";
+
+interface Props {
+  project: { id: number; name: string };
+  strategies: StrategyDefinition[];
+  usedBy: Record<string, string[]>;
+}
+
+export default function Index() {
+  const { project, strategies, usedBy, auth } = usePage<SharedProps & Props>().props;
+  const path = `/projects/${project.id}/strategy-definitions`;
+  const isAdmin = auth?.user?.role === "admin";
+
+  const builtin = strategies.filter((s) => s.builtin);
+  const custom = strategies.filter((s) => !s.builtin);
+
+  const handleDelete = (s: StrategyDefinition) => {
+    if (confirm(`Delete strategy ${s.name}? SDKs will no longer be told about it.`)) {
+      router.delete(`${path}/${s.id}`);
+    }
+  };
+
+  const paramList = (s: StrategyDefinition) =>
+    s.parameters.length === 0
+      ? "no parameters"
+      : s.parameters
+          .map((p) => `${p.name}: ${p.type}${p.required ? "" : "?"}`)
+          .join(", ");
+
+  return (
+    <TerminalLayout activePage="projects">
+      <div className="max-w-5xl">
+          <div className="mb-6">
+            <Link
+              href="/projects"
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              projects
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <Link
+              href={`/projects/${project.id}`}
+              className="text-sm text-muted-foreground hover:text-foreground transition-colors"
+            >
+              {project.name}
+            </Link>
+            <span className="text-sm text-muted-foreground mx-1">/</span>
+            <span className="text-sm text-foreground">strategies</span>
+          </div>
+
+          <div className="flex items-start justify-between mb-8">
+            <div>
+              <h1 className="text-2xl font-semibold tracking-tight text-foreground">
+                {">"} strategies
+              </h1>
+              <p className="text-muted-foreground mt-1 text-sm">
+                # strategies the flags of {project.name} can use. custom strategies must be implemented by your SDKs.
+              </p>
+            </div>
+            {isAdmin && (
+              <Link
+                href={`${path}/create`}
+                className="inline-flex items-center gap-2 bg-primary text-primary-foreground px-4 py-2 text-sm font-medium hover:bg-primary/90 transition-colors"
+              >
+                <Plus className="w-4 h-4" />
+                [add strategy]
+              </Link>
+            )}
+          </div>
+
+          <div className="bg-card border border-border mb-6">
+            <div className="px-5 py-3 border-b border-border">
+              <h2 className="text-sm font-medium text-foreground">// custom</h2>
+            </div>
+            {custom.length === 0 ? (
+              <p className="py-12 px-6 text-center text-sm text-muted-foreground">
+                no custom strategies yet.
+              </p>
+            ) : (
+              <div className="divide-y divide-border">
+                {custom.map((s) => {
+                  const flags = usedBy[s.name] ?? [];
+                  return (
+                    <div key={s.name} className="px-5 py-3 flex items-start justify-between gap-4">
+                      <div className="min-w-0">
+                        <div className="flex items-center gap-2">
+                          <span className="font-medium text-foreground text-sm">{s.name}</span>
+                          <span className="text-xs text-muted-foreground bg-muted px-2 py-0.5">
+                            {flags.length === 0
+                              ? "unused"
+                              : `used by ${flags.length} flag${flags.length !== 1 ? "s" : ""}`}
+                          </span>
+                        </div>
+                        {s.description && (
+                          <p className="text-xs text-muted-foreground mt-0.5">{s.description}</p>
+                        )}
+                        <p className="text-xs text-muted-foreground mt-0.5 font-mono">{paramList(s)}</p>
+                      </div>
+                      {isAdmin && (
+                        <div className="flex items-center gap-1 shrink-0">
+                          <Link
+                            href={`${path}/${s.id}/edit`}
+                            className="inline-flex items-center gap-1 text-xs text-muted-foreground hover:text-foreground transition-colors px-2 py-1"
+                          >
+                            <Pencil className="w-3.5 h-3.5" />
+                            Edit
+                          </Link>
+                          <Button
+                            variant="ghost"
+                            size="sm"
+                            className="text-destructive hover:text-destructive"
+                            disabled={flags.length > 0}
+                            title={flags.length > 0 ? `Used by ${flags.join(", ")}` : "Delete strategy"}
+                            onClick={() => handleDelete(s)}
+                          >
+                            <Trash2 className="w-3.5 h-3.5" />
+                            Delete
+                          </Button>
+                        </div>
+                      )}
+                    </div>
+                  );
+                })}
+              </div>
+            )}
+          </div>
+
+          <div className="bg-card border border-border">
+            <div className="px-5 py-3 border-b border-border">
+              <h2 className="text-sm font-medium text-foreground">// built-in</h2>
+            </div>
+            <div className="divide-y divide-border">
+              {builtin.map((s) => (
+                <div key={s.name} className="px-5 py-3">
+                  <span className="font-medium text-foreground text-sm">{s.name}</span>
+                  {s.description && (
+                    <p className="text-xs text-muted-foreground mt-0.5">{s.description}</p>
+                  )}
+                  <p className="text-xs text-muted-foreground mt-0.5 font-mono">{paramList(s)}</p>
+                </div>
+              ))}
+            </div>
+          </div>
+      </div>
+    </TerminalLayout>
+  );
+}
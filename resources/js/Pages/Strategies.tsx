@@ -11,7 +11,11 @@ import {
   SelectTrigger,
   SelectValue,
 } from "@/components/ui/select";
-import StrategyParams from "@/Pages/Projects/Flags/components/StrategyParams";
+import { usePage } from "@inertiajs/react";
+import StrategyParams, {
+  defaultParameters,
+  type StrategyDefinition,
+} from "@/Pages/Projects/Flags/components/StrategyParams";
 import {
   evaluateStrategy,
   type Strategy,
@@ -57,6 +61,13 @@ const OPERATORS = [
   "NUM_LTE",
   "DATE_AFTER",
   "DATE_BEFORE",
+  "SEMVER_EQ",
+  "SEMVER_GT",
+  "SEMVER_LT",
+  "REGEX",
+  "IN_CIDR",
+  "ANY_OF",
+  "ALL_OF",
 ] as const;
 
 // ── Preset examples ───────────────────────────────────────────────────────
@@ -352,6 +363,7 @@ function ConstraintsReference() {
         { op: "STR_CONTAINS", desc: "Value contains the substring", example: 'email STR_CONTAINS "@acme.com"' },
         { op: "STR_STARTS_WITH", desc: "Value starts with prefix", example: 'domain STR_STARTS_WITH "app."' },
         { op: "STR_ENDS_WITH", desc: "Value ends with suffix", example: 'email STR_ENDS_WITH ".edu"' },
+        { op: "REGEX", desc: "Value matches an RE2 regular expression", example: 'email REGEX "^[a-z]+@acme\\.com$"' },
       ],
     },
     {
@@ -373,6 +385,30 @@ function ConstraintsReference() {
         { op: "DATE_BEFORE", desc: "Date is before target", example: 'trial_end DATE_BEFORE "2025-12-31"' },
       ],
     },
+    {
+      category: "Semver",
+      desc: "Semantic version comparisons",
+      operators: [
+        { op: "SEMVER_EQ", desc: "Same version, ignoring build metadata", example: 'appVersion SEMVER_EQ "2.4.0"' },
+        { op: "SEMVER_GT", desc: "Newer than target; pre-releases sort before their release", example: 'appVersion SEMVER_GT "2.4.0"' },
+        { op: "SEMVER_LT", desc: "Older than target", example: 'appVersion SEMVER_LT "3.0.0-beta.1"' },
+      ],
+    },
+    {
+      category: "Network",
+      desc: "IP address ranges",
+      operators: [
+        { op: "IN_CIDR", desc: "Address is inside any of the CIDR ranges", example: 'remoteAddress IN_CIDR ["10.0.0.0/8"]' },
+      ],
+    },
+    {
+      category: "List",
+      desc: "Comma-separated context values",
+      operators: [
+        { op: "ANY_OF", desc: "Context shares at least one item with the list", example: 'groups ANY_OF ["beta", "staff"]' },
+        { op: "ALL_OF", desc: "Context holds every item of the list", example: 'groups ALL_OF ["eu", "pro"]' },
+      ],
+    },
   ];
 
   return (
@@ -416,7 +452,7 @@ function ConstraintsReference() {
 
 // ── Interactive Playground ────────────────────────────────────────────────
 
-function Playground() {
+function Playground({ definitions }: { definitions: StrategyDefinition[] }) {
   const [activePreset, setActivePreset] = useState<string | null>("50% Rollout");
   const [strategyName, setStrategyName] = useState("gradualRollout");
   const [parameters, setParameters] = useState<Record<string, any>>({ rollout: 50, stickiness: "userId", groupId: "" });
@@ -491,7 +527,7 @@ function Playground() {
   const handleStrategyChange = (name: string) => {
     clearPreset();
     setStrategyName(name);
-    setParameters({});
+    setParameters(defaultParameters(definitions.find((d) => d.name === name)));
   };
 
   const handleParamsChange = (params: Record<string, any>) => {
@@ -576,7 +612,7 @@ function Playground() {
               </Select>
             </div>
             <StrategyParams
-              strategyName={strategyName}
+              definition={definitions.find((d) => d.name === strategyName)}
               parameters={parameters}
               onChange={handleParamsChange}
             />
@@ -862,6 +898,7 @@ function Playground() {
 // ── Main Page ─────────────────────────────────────────────────────────────
 
 export default function Strategies() {
+  const { strategies } = usePage<{ strategies: StrategyDefinition[] }>().props;
   return (
     <PublicLayout activePage="strategies">
       <div className="pt-8 pb-16 px-4 md:px-6">
@@ -901,7 +938,7 @@ export default function Strategies() {
               <p className="text-sm text-muted-foreground mb-4">
                 Pick an example below or build your own — the result updates live as you change any value.
               </p>
-              <Playground />
+              <Playground definitions={strategies ?? []} />
             </SectionCard>
 
             {/* Evaluation Flow */}
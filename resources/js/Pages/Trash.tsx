@@ -0,0 +1,124 @@
+import { Link, usePage, router } from "@inertiajs/react";
+import { SharedProps } from "@/types/global";
+import TerminalLayout from "@/Layouts/TerminalLayout";
+
+interface TrashItem {
+  kind: "project" | "flag" | "environment";
+  id: number;
+  name: string;
+  projectId: number;
+  projectName: string;
+  deletedAt: string;
+  purgeAt: string;
+}
+
+interface Props {
+  items: TrashItem[];
+}
+
+const kindBadge: Record<string, string> = {
+  project: "text-red-400 border-red-400/30",
+  flag: "text-cyan-400 border-cyan-400/30",
+  environment: "text-muted-foreground border-border",
+};
+
+export default function Trash() {
+  const { items, auth } = usePage<SharedProps & Props>().props;
+  const role = auth?.user?.role;
+  const canRestore = role === "admin" || role === "editor";
+  const canPurge = role === "admin";
+
+  const handleRestore = (item: TrashItem) => {
+    router.post(`/trash/${item.kind}/${item.id}/restore`);
+  };
+
+  const handlePurge = (item: TrashItem) => {
+    if (confirm(`Permanently delete ${item.kind} "${item.name}"? This cannot be undone.`)) {
+      router.delete(`/trash/${item.kind}/${item.id}`);
+    }
+  };
+
+  return (
+    <TerminalLayout activePage="trash">
+      <div className="max-w-5xl">
+        <div className="mb-8">
+          <h1 className="text-xl font-semibold text-foreground">
+            {">"} trash
+          </h1>
+          <p className="text-muted-foreground mt-1 text-sm">
+            # deleted projects, flags and environments — names stay reserved until purged
+          </p>
+        </div>
+
+        <div className="bg-card border border-border">
+          {items.length === 0 ? (
+            <div className="flex flex-col items-center justify-center py-16 px-6 text-center">
+              <p className="text-muted-foreground text-lg mb-2">
+                {">"} trash is empty
+              </p>
+            </div>
+          ) : (
+            <div className="divide-y divide-border">
+              {items.map((item) => (
+                <div
+                  key={`${item.kind}-${item.id}`}
+                  className="px-5 py-3 flex items-center justify-between"
+                >
+                  <div>
+                    <div className="flex items-center gap-2">
+                      <span className="font-medium text-foreground text-sm">
+                        {item.name}
+                      </span>
+                      <span
+                        className={`text-xs px-1.5 py-0.5 border font-medium ${kindBadge[item.kind]}`}
+                      >
+                        [{item.kind}]
+                      </span>
+                    </div>
+                    <p className="text-xs text-muted-foreground">
+                      {item.kind !== "project" && (
+                        <>
+                          <Link
+                            href={`/projects/${item.projectId}`}
+                            className="hover:text-foreground transition-colors"
+                          >
+                            {item.projectName}
+                          </Link>
+                          {" · "}
+                        </>
+                      )}
+                      deleted {item.deletedAt}
+                      {item.purgeAt
+                        ? ` · purged ${item.purgeAt}`
+                        : " · kept until purged"}
+                    </p>
+                  </div>
+                  <div className="flex items-center gap-2">
+                    {canRestore && (
+                      <button
+                        type="button"
+                        className="text-xs text-muted-foreground hover:text-foreground transition-colors"
+                        onClick={() => handleRestore(item)}
+                      >
+                        [restore]
+                      </button>
+                    )}
+                    {canPurge && (
+                      <button
+                        type="button"
+                        className="text-xs text-destructive hover:text-destructive/80 transition-colors"
+                        onClick={() => handlePurge(item)}
+                      >
+                        [purge]
+                      </button>
+                    )}
+                  </div>
+                </div>
+              ))}
+            </div>
+          )}
+        </div>
+      </div>
+    </TerminalLayout>
+  );
+}
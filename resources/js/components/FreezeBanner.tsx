@@ -0,0 +1,65 @@
+import { router } from "@inertiajs/react";
+
+export interface Freeze {
+  frozenAt: string;
+  frozenBy: string;
+  reason: string;
+}
+
+interface FrozenEnv {
+  id: number;
+  name: string;
+  freeze: Freeze | null;
+}
+
+interface Props {
+  projectId: number;
+  freeze: Freeze | null;
+  environments: FrozenEnv[];
+  // canLift shows the unfreeze buttons; only admins may lift a freeze.
+  canLift?: boolean;
+}
+
+// FreezeBanner lists the freezes of a project and its environments. While a
+// freeze lasts only admins can change what it covers.
+export default function FreezeBanner({ projectId, freeze, environments, canLift = false }: Props) {
+  const frozenEnvs = environments.filter((e) => e.freeze);
+  if (!freeze && frozenEnvs.length === 0) return null;
+
+  const line = (label: string, f: Freeze, unfreezeUrl: string) => (
+    <div className="flex items-start justify-between gap-4">
+      <p>
+        <span className="font-semibold">{label} frozen</span>: {f.reason}
+        <span className="text-amber-400/70">
+          {" "}
+          (since {f.frozenAt}
+          {f.frozenBy && ` by ${f.frozenBy}`})
+        </span>
+      </p>
+      {canLift && (
+        <button
+          type="button"
+          onClick={() => router.delete(unfreezeUrl, { preserveScroll: true })}
+          className="shrink-0 border border-amber-400/30 px-2 py-0.5 hover:opacity-80"
+        >
+          [unfreeze]
+        </button>
+      )}
+    </div>
+  );
+
+  return (
+    <div className="border border-amber-400/30 bg-amber-400/10 text-amber-400 text-xs px-4 py-3 mb-6 space-y-1">
+      {freeze && line("project", freeze, `/projects/${projectId}/freeze`)}
+      {frozenEnvs.map(
+        (e) =>
+          e.freeze && (
+            <div key={e.id}>
+              {line(e.name, e.freeze, `/projects/${projectId}/environments/${e.id}/freeze`)}
+            </div>
+          ),
+      )}
+      <p className="text-amber-400/70">Only admins can make changes until the freeze is lifted.</p>
+    </div>
+  );
+}
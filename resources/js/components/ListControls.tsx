@@ -0,0 +1,115 @@
+import { router } from "@inertiajs/react";
+import { FormEventHandler, useState } from "react";
+import { ListState } from "@/types";
+
+type Params = Record<string, string | string[] | undefined>;
+
+interface Props {
+  path: string;
+  list: ListState;
+  sorts: { value: string; label: string }[];
+  // params are kept when searching or sorting, e.g. the flag filter.
+  params?: Params;
+}
+
+// ListControls renders the search box and sort picker of a paginated list.
+// Changing either starts again from the first page.
+export default function ListControls({ path, list, sorts, params = {} }: Props) {
+  const [q, setQ] = useState(list.q);
+
+  const visit = (next: Partial<ListState>) => {
+    const merged = { ...list, q, ...next };
+    router.get(
+      path,
+      { ...params, q: merged.q || undefined, sort: merged.sort },
+      { preserveScroll: true },
+    );
+  };
+
+  const submit: FormEventHandler = (e) => {
+    e.preventDefault();
+    visit({ q });
+  };
+
+  return (
+    <form onSubmit={submit} className="flex items-center gap-2">
+      <input
+        type="search"
+        aria-label="Search"
+        placeholder="search..."
+        value={q}
+        onChange={(e) => setQ(e.target.value)}
+        className="bg-background border border-border text-xs text-foreground px-2 py-1.5 w-48"
+      />
+      <select
+        aria-label="Sort"
+        value={list.sort}
+        onChange={(e) => visit({ sort: e.target.value })}
+        className="bg-background border border-border text-xs text-foreground px-2 py-1.5"
+      >
+        {sorts.map((s) => (
+          <option key={s.value} value={s.value}>
+            {s.label}
+          </option>
+        ))}
+      </select>
+    </form>
+  );
+}
+
+interface PagerProps {
+  path: string;
+  list: ListState;
+  total: number;
+  shown: number;
+  nextCursor: string | null;
+  params?: Params;
+}
+
+// ListPager links to the next page and back to the first one. Cursors only
+// go forward, so there is no previous page.
+export function ListPager({
+  path,
+  list,
+  total,
+  shown,
+  nextCursor,
+  params = {},
+}: PagerProps) {
+  const current = { ...params, q: list.q || undefined, sort: list.sort };
+  const paged = new URLSearchParams(window.location.search).has("cursor");
+
+  if (!nextCursor && !paged) {
+    return null;
+  }
+
+  return (
+    <div className="flex items-center justify-between px-5 py-3 border-t border-border text-xs text-muted-foreground">
+      <span>
+        {shown} of {total}
+      </span>
+      <div className="flex items-center gap-3">
+        {paged && (
+          <button
+            type="button"
+            className="hover:text-foreground transition-colors"
+            onClick={() => router.get(path, current, { preserveScroll: true })}
+          >
+            [first]
+          </button>
+        )}
+        {nextCursor && (
+          <button
+            type="button"
+            className="hover:text-foreground transition-colors"
+            onClick={() =>
+              router.get(path, { ...current, cursor: nextCursor })
+            }
+          >
+            [next →]
+          </button>
+        )}
+      </div>
+    </div>
+  );
+}
@@ -279,6 +279,41 @@ describe("evaluateConstraint", () => {
       }).passed,
     ).toBe(false);
   });
+
+  const check = (operator: string, values: string[], value: string, caseInsensitive = false) =>
+    evaluateConstraint(
+      { context_name: "v", operator, values, inverted: false, case_insensitive: caseInsensitive },
+      { properties: { v: value } },
+    ).passed;
+
+  it("SEMVER_GT / SEMVER_LT / SEMVER_EQ", () => {
+    expect(check("SEMVER_GT", ["2.4.0"], "2.10.0")).toBe(true);
+    expect(check("SEMVER_GT", ["2.4.0"], "2.4.0-rc.1")).toBe(false);
+    expect(check("SEMVER_LT", ["1.0.0"], "1.0.0-beta.2")).toBe(true);
+    expect(check("SEMVER_EQ", ["1.2.3"], "v1.2.3+build.7")).toBe(true);
+    expect(check("SEMVER_GT", ["1.0.0"], "not-a-version")).toBe(false);
+  });
+
+  it("REGEX", () => {
+    expect(check("REGEX", ["^[a-z]+@acme\\.com$"], "jane@acme.com")).toBe(true);
+    expect(check("REGEX", ["^[a-z]+@acme\\.com$"], "Jane@acme.com")).toBe(false);
+    expect(check("REGEX", ["^[a-z]+@acme\\.com$"], "Jane@acme.com", true)).toBe(true);
+  });
+
+  it("IN_CIDR", () => {
+    expect(check("IN_CIDR", ["10.0.0.0/8"], "10.42.1.7")).toBe(true);
+    expect(check("IN_CIDR", ["10.0.0.0/8", "192.168.1.0/24"], "192.168.2.1")).toBe(false);
+    expect(check("IN_CIDR", ["2001:db8::/32"], "2001:db8:1::1")).toBe(true);
+    expect(check("IN_CIDR", ["10.0.0.0/8"], "2001:db8::1")).toBe(false);
+  });
+
+  it("ANY_OF / ALL_OF on list-valued context", () => {
+    expect(check("ANY_OF", ["beta", "staff"], "eu, staff")).toBe(true);
+    expect(check("ANY_OF", ["beta"], "eu, staff")).toBe(false);
+    expect(check("ALL_OF", ["eu", "staff"], "staff,eu,pro")).toBe(true);
+    expect(check("ALL_OF", ["eu", "beta"], "staff,eu")).toBe(false);
+    expect(check("ALL_OF", ["EU"], "eu", true)).toBe(true);
+  });
 });
 
 describe("constraints + strategy combined", () => {
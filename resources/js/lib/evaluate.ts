@@ -45,6 +45,70 @@ function splitMulti(s: string): string[] {
     .filter(Boolean);
 }
 
+// compareSemver orders two semantic versions, ignoring build metadata and a
+// leading "v"; null when either is not a version.
+export function compareSemver(a: string, b: string): number | null {
+  const parse = (s: string) => {
+    const m = /^v?(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$/.exec(s.trim());
+    if (!m) return null;
+    return { core: [Number(m[1]), Number(m[2]), Number(m[3])], pre: m[4] ? m[4].split(".") : [] };
+  };
+  const va = parse(a);
+  const vb = parse(b);
+  if (!va || !vb) return null;
+
+  for (let i = 0; i < 3; i++) {
+    if (va.core[i] !== vb.core[i]) return va.core[i] < vb.core[i] ? -1 : 1;
+  }
+  if (va.pre.length === 0 || vb.pre.length === 0) {
+    return va.pre.length === vb.pre.length ? 0 : va.pre.length === 0 ? 1 : -1;
+  }
+  for (let i = 0; i < va.pre.length && i < vb.pre.length; i++) {
+    const x = va.pre[i];
+    const y = vb.pre[i];
+    const nx = /^\d+$/.test(x);
+    const ny = /^\d+$/.test(y);
+    if (nx && ny) {
+      if (Number(x) !== Number(y)) return Number(x) < Number(y) ? -1 : 1;
+    } else if (nx !== ny) {
+      return nx ? -1 : 1;
+    } else if (x !== y) {
+      return x < y ? -1 : 1;
+    }
+  }
+  return Math.sign(va.pre.length - vb.pre.length);
+}
+
+// parseIP returns the address as a bigint and its width in bits, or null.
+function parseIP(s: string): { value: bigint; bits: number } | null {
+  if (/^\d{1,3}(\.\d{1,3}){3}$/.test(s)) {
+    const parts = s.split(".").map(Number);
+    if (parts.some((p) => p > 255)) return null;
+    return { value: parts.reduce((acc, p) => (acc << 8n) | BigInt(p), 0n), bits: 32 };
+  }
+  if (!s.includes(":")) return null;
+  const halves = s.split("::");
+  if (halves.length > 2) return null;
+  const head = halves[0] ? halves[0].split(":") : [];
+  const tail = halves.length === 2 && halves[1] ? halves[1].split(":") : [];
+  const missing = 8 - head.length - tail.length;
+  if (missing < 0 || (halves.length === 1 && missing !== 0)) return null;
+  const groups = [...head, ...Array(missing).fill("0"), ...tail];
+  if (groups.some((g) => !/^[0-9a-fA-F]{1,4}$/.test(g))) return null;
+  return { value: groups.reduce((acc, g) => (acc << 16n) | BigInt(parseInt(g, 16)), 0n), bits: 128 };
+}
+
+export function inCIDR(addr: string, cidr: string): boolean {
+  const [net, prefixRaw] = cidr.split("/");
+  const ip = parseIP(addr.trim());
+  const base = parseIP(net ?? "");
+  const prefix = Number(prefixRaw);
+  if (!ip || !base || ip.bits !== base.bits || !Number.isInteger(prefix)) return false;
+  if (prefix < 0 || prefix > base.bits) return false;
+  const shift = BigInt(base.bits - prefix);
+  return ip.value >> shift === base.value >> shift;
+}
+
 function getContextValue(name: string, ctx: EvalContext): string {
   switch (name) {
     case "userId":
@@ -114,6 +178,37 @@ function evalOperator(
       });
     }
 
+    case "SEMVER_EQ":
+    case "SEMVER_GT":
+    case "SEMVER_LT":
+      return values.some((v) => {
+        const cmp = compareSemver(ctxValue, v);
+        if (cmp === null) return false;
+        return op === "SEMVER_EQ" ? cmp === 0 : op === "SEMVER_GT" ? cmp > 0 : cmp < 0;
+      });
+
+    case "REGEX":
+      return values.some((v) => {
+        try {
+          return new RegExp(v, caseInsensitive ? "i" : "").test(ctxValue);
+        } catch {
+          return false;
+        }
+      });
+
+    case "IN_CIDR":
+      return values.some((v) => inCIDR(ctxValue, v));
+
+    // List-valued context holds its items comma-separated.
+    case "ANY_OF":
+    case "ALL_OF": {
+      const items = splitMulti(cv);
+      const wanted = values.map((v) => (caseInsensitive ? v.toLowerCase() : v));
+      return op === "ANY_OF"
+        ? wanted.some((v) => items.includes(v))
+        : wanted.every((v) => items.includes(v));
+    }
+
     default:
       return false;
   }
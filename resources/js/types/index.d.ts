@@ -19,3 +19,15 @@ export interface User {
   updated_at?: string;
   [key: string]: unknown;
 }
+
+// Page is the envelope of a paginated list; next_cursor is null on the last page.
+export interface Page<T> {
+  items: T[];
+  next_cursor: string | null;
+  total: number;
+}
+
+export interface ListState {
+  sort: string;
+  q: string;
+}